@@ -0,0 +1,28 @@
+BEGIN;
+
+DROP INDEX IF EXISTS "common_auditlog_organisation_id_timestamp_idx";
+
+ALTER TABLE
+    IF EXISTS "common_auditlog"
+    DROP CONSTRAINT IF EXISTS "common_auditlog_actor_id_fkey",
+    DROP CONSTRAINT IF EXISTS "common_auditlog_program_id_fkey",
+    DROP COLUMN IF EXISTS "actor_id",
+    DROP COLUMN IF EXISTS "target_id",
+    DROP COLUMN IF EXISTS "target_type",
+    DROP COLUMN IF EXISTS "old_value",
+    DROP COLUMN IF EXISTS "new_value",
+    DROP COLUMN IF EXISTS "program_id",
+    DROP CONSTRAINT IF EXISTS "common_auditlog_created_by_fkey",
+    DROP CONSTRAINT IF EXISTS "common_auditlog_updated_by_fkey";
+
+ALTER TABLE
+    IF EXISTS "common_auditlog"
+    ADD
+        CONSTRAINT "common_auditlog_created_by_fkey" FOREIGN KEY ("created_by") REFERENCES "users_user" ("id");
+
+ALTER TABLE
+    IF EXISTS "common_auditlog"
+    ADD
+        CONSTRAINT "common_auditlog_updated_by_fkey" FOREIGN KEY ("updated_by") REFERENCES "users_user" ("id");
+
+COMMIT;
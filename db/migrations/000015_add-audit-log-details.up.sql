@@ -0,0 +1,41 @@
+BEGIN;
+
+ALTER TABLE
+    IF EXISTS "common_auditlog"
+    ADD COLUMN IF NOT EXISTS "actor_id" uuid,
+    ADD COLUMN IF NOT EXISTS "target_id" text,
+    ADD COLUMN IF NOT EXISTS "target_type" text,
+    ADD COLUMN IF NOT EXISTS "old_value" jsonb,
+    ADD COLUMN IF NOT EXISTS "new_value" jsonb,
+    ADD COLUMN IF NOT EXISTS "program_id" uuid,
+    ALTER COLUMN "notes" DROP NOT NULL,
+    ALTER COLUMN "payload" DROP NOT NULL;
+
+ALTER TABLE
+    IF EXISTS "common_auditlog"
+    DROP CONSTRAINT IF EXISTS "common_auditlog_created_by_fkey",
+    DROP CONSTRAINT IF EXISTS "common_auditlog_updated_by_fkey";
+
+ALTER TABLE
+    IF EXISTS "common_auditlog"
+    ADD
+        CONSTRAINT "common_auditlog_created_by_fkey" FOREIGN KEY ("created_by") REFERENCES "users_user" ("id") ON DELETE SET NULL;
+
+ALTER TABLE
+    IF EXISTS "common_auditlog"
+    ADD
+        CONSTRAINT "common_auditlog_updated_by_fkey" FOREIGN KEY ("updated_by") REFERENCES "users_user" ("id") ON DELETE SET NULL;
+
+ALTER TABLE
+    IF EXISTS "common_auditlog"
+    ADD
+        CONSTRAINT "common_auditlog_actor_id_fkey" FOREIGN KEY ("actor_id") REFERENCES "users_user" ("id") ON DELETE SET NULL;
+
+ALTER TABLE
+    IF EXISTS "common_auditlog"
+    ADD
+        CONSTRAINT "common_auditlog_program_id_fkey" FOREIGN KEY ("program_id") REFERENCES "common_program" ("id");
+
+CREATE INDEX IF NOT EXISTS "common_auditlog_organisation_id_timestamp_idx" ON "common_auditlog" ("organisation_id", "timestamp" DESC);
+
+COMMIT;
@@ -0,0 +1,48 @@
+BEGIN;
+
+DELETE FROM "authority_authorityrole" WHERE "program_id" IS NULL;
+
+ALTER TABLE
+    IF EXISTS "authority_authorityrole"
+    ALTER COLUMN "program_id" SET NOT NULL;
+
+ALTER TABLE
+    IF EXISTS "authority_authorityrole_permissions"
+    DROP CONSTRAINT IF EXISTS "authority_authorityrole_permissions_authorityrole_id_fkey";
+
+ALTER TABLE
+    IF EXISTS "authority_authorityrole_permissions"
+    ADD
+        CONSTRAINT "authority_authorityrole_permissions_authorityrole_id_fkey" FOREIGN KEY ("authorityrole_id") REFERENCES "authority_authorityrole" ("id");
+
+ALTER TABLE
+    IF EXISTS "authority_authorityrole_staff"
+    DROP CONSTRAINT IF EXISTS "authority_authorityrole_staff_staff_authorityrole_id_fkey",
+    DROP CONSTRAINT IF EXISTS "authority_authorityrole_staff_staff_id_fkey";
+
+ALTER TABLE
+    IF EXISTS "authority_authorityrole_staff"
+    ADD
+        CONSTRAINT "authority_authorityrole_staff_staff_authorityrole_id_fkey" FOREIGN KEY ("authorityrole_id") REFERENCES "authority_authorityrole" ("id"),
+    ADD
+        CONSTRAINT "authority_authorityrole_staff_staff_id_fkey" FOREIGN KEY ("staff_id") REFERENCES "staff_staff" ("id");
+
+ALTER TABLE
+    IF EXISTS "authority_authorityrole_clients"
+    DROP CONSTRAINT IF EXISTS "authority_authorityrole_client_authorityrole_id_fkey";
+
+ALTER TABLE
+    IF EXISTS "authority_authorityrole_clients"
+    ADD
+        CONSTRAINT "authority_authorityrole_client_authorityrole_id_fkey" FOREIGN KEY ("authorityrole_id") REFERENCES "authority_authorityrole" ("id");
+
+ALTER TABLE
+    IF EXISTS "authority_authorityrole_caregivers"
+    DROP CONSTRAINT IF EXISTS "authority_authorityrole_caregiver_authorityrole_id_fkey";
+
+ALTER TABLE
+    IF EXISTS "authority_authorityrole_caregivers"
+    ADD
+        CONSTRAINT "authority_authorityrole_caregiver_authorityrole_id_fkey" FOREIGN KEY ("authorityrole_id") REFERENCES "authority_authorityrole" ("id");
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+DROP INDEX IF EXISTS "users_userotp_phonenumber_generated_at_idx";
+
+DELETE FROM "users_userotp" WHERE "salt" IS NOT NULL;
+
+ALTER TABLE
+    IF EXISTS "users_userotp"
+    DROP COLUMN IF EXISTS "failed_attempts",
+    DROP COLUMN IF EXISTS "salt",
+    ALTER COLUMN "otp" TYPE varchar(8);
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+ALTER TABLE
+    IF EXISTS "users_userotp"
+    ALTER COLUMN "otp" TYPE text,
+    ADD COLUMN IF NOT EXISTS "salt" text,
+    ADD COLUMN IF NOT EXISTS "failed_attempts" integer NOT NULL DEFAULT 0;
+
+-- OTPs generated before hashing was introduced are stored in plain text and can no longer be verified
+UPDATE "users_userotp" SET "is_valid" = false WHERE "salt" IS NULL;
+
+CREATE INDEX IF NOT EXISTS "users_userotp_phonenumber_generated_at_idx" ON "users_userotp" ("phonenumber", "generated_at");
+
+COMMIT;
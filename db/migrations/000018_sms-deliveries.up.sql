@@ -0,0 +1,20 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "common_smsdelivery" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "created" timestamp NOT NULL,
+    "created_by" uuid,
+    "updated" timestamp NOT NULL,
+    "updated_by" uuid,
+    "deleted_at" timestamp,
+    "phone_number" text NOT NULL,
+    "provider" text,
+    "provider_message_id" text,
+    "status" varchar(32) NOT NULL,
+    "attempts" integer NOT NULL DEFAULT 0,
+    "failure_reason" text
+);
+
+CREATE INDEX IF NOT EXISTS "common_smsdelivery_phone_number_created_idx" ON "common_smsdelivery" ("phone_number", "created" DESC);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "common_notificationpreference";
+
+DROP TABLE IF EXISTS "appointments_appointmentreminder";
+
+COMMIT;
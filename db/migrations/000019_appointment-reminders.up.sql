@@ -0,0 +1,32 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "appointments_appointmentreminder" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "created" timestamp NOT NULL,
+    "created_by" uuid,
+    "updated" timestamp NOT NULL,
+    "updated_by" uuid,
+    "deleted_at" timestamp,
+    "appointment_id" uuid NOT NULL REFERENCES "appointments_appointment" ("id") ON DELETE CASCADE,
+    "offset_days" integer NOT NULL,
+    "push_sent" boolean NOT NULL DEFAULT false,
+    "sms_sent" boolean NOT NULL DEFAULT false,
+    "sent_at" timestamp NOT NULL,
+    CONSTRAINT "appointments_appointmentreminder_appointment_offset_key" UNIQUE ("appointment_id", "offset_days")
+);
+
+CREATE TABLE IF NOT EXISTS "common_notificationpreference" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "created" timestamp NOT NULL,
+    "created_by" uuid,
+    "updated" timestamp NOT NULL,
+    "updated_by" uuid,
+    "deleted_at" timestamp,
+    "user_id" uuid NOT NULL REFERENCES "users_user" ("id") ON DELETE CASCADE,
+    "notification_type" varchar(64) NOT NULL,
+    "push_enabled" boolean NOT NULL DEFAULT true,
+    "sms_enabled" boolean NOT NULL DEFAULT true,
+    CONSTRAINT "common_notificationpreference_user_type_key" UNIQUE ("user_id", "notification_type")
+);
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+DROP INDEX IF EXISTS "appointments_appointment_missed_date_idx";
+
+DELETE FROM "clients_servicerequest" WHERE "request_type" = 'MISSED_APPOINTMENT';
+
+ALTER TABLE
+    IF EXISTS "appointments_appointment"
+    DROP COLUMN IF EXISTS "last_synced_at",
+    DROP COLUMN IF EXISTS "missed_at",
+    DROP COLUMN IF EXISTS "missed";
+
+COMMIT;
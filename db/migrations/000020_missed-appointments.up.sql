@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE
+    IF EXISTS "appointments_appointment"
+    ADD COLUMN IF NOT EXISTS "missed" boolean NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS "missed_at" timestamp,
+    ADD COLUMN IF NOT EXISTS "last_synced_at" timestamp;
+
+CREATE INDEX IF NOT EXISTS "appointments_appointment_missed_date_idx" ON "appointments_appointment" ("missed", "date");
+
+COMMIT;
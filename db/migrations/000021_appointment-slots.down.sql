@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP INDEX IF EXISTS "appointments_appointment_slot_date_idx";
+
+ALTER TABLE "appointments_appointment"
+    DROP COLUMN IF EXISTS "slot_id",
+    DROP COLUMN IF EXISTS "cancelled_at";
+
+DROP TABLE IF EXISTS "appointments_appointmentslot";
+
+COMMIT;
@@ -0,0 +1,27 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "appointments_appointmentslot" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "created" timestamp NOT NULL,
+    "created_by" uuid,
+    "updated" timestamp NOT NULL,
+    "updated_by" uuid,
+    "deleted_at" timestamp,
+    "active" boolean NOT NULL DEFAULT true,
+    "facility_id" uuid NOT NULL REFERENCES "common_facility" ("id") ON DELETE CASCADE,
+    "day_of_week" integer NOT NULL CHECK ("day_of_week" BETWEEN 0 AND 6),
+    "start_time" varchar(5) NOT NULL,
+    "end_time" varchar(5) NOT NULL,
+    "capacity" integer NOT NULL CHECK ("capacity" > 0),
+    "reasons" text[] NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS "appointments_appointmentslot_facility_idx" ON "appointments_appointmentslot" ("facility_id", "active");
+
+ALTER TABLE "appointments_appointment"
+    ADD COLUMN IF NOT EXISTS "slot_id" uuid REFERENCES "appointments_appointmentslot" ("id") ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS "cancelled_at" timestamp;
+
+CREATE INDEX IF NOT EXISTS "appointments_appointment_slot_date_idx" ON "appointments_appointment" ("slot_id", "date") WHERE "active" = true;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS "clients_servicerequest_pending_escalation_idx";
+
+ALTER TABLE
+    IF EXISTS "clients_servicerequest"
+    DROP COLUMN IF EXISTS "escalated_at";
+
+COMMIT;
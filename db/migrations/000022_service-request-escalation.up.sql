@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE
+    IF EXISTS "clients_servicerequest"
+    ADD COLUMN IF NOT EXISTS "escalated_at" timestamp;
+
+CREATE INDEX IF NOT EXISTS "clients_servicerequest_pending_escalation_idx" ON "clients_servicerequest" ("request_type", "created")
+WHERE "status" = 'PENDING' AND "escalated_at" IS NULL;
+
+COMMIT;
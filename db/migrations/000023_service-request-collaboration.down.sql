@@ -0,0 +1,16 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS "clients_servicerequestevent_immutable" ON "clients_servicerequestevent";
+
+DROP FUNCTION IF EXISTS "clients_servicerequestevent_prevent_update"();
+
+DROP TABLE IF EXISTS "clients_servicerequestevent";
+
+DROP TABLE IF EXISTS "clients_servicerequestcomment";
+
+ALTER TABLE
+    IF EXISTS "clients_servicerequest"
+    DROP COLUMN IF EXISTS "assigned_at",
+    DROP COLUMN IF EXISTS "assigned_to_id";
+
+COMMIT;
@@ -0,0 +1,53 @@
+BEGIN;
+
+ALTER TABLE
+    IF EXISTS "clients_servicerequest"
+    ADD COLUMN IF NOT EXISTS "assigned_to_id" uuid REFERENCES "staff_staff" ("id") ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS "assigned_at" timestamp;
+
+CREATE TABLE IF NOT EXISTS "clients_servicerequestcomment" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "created" timestamp NOT NULL,
+    "created_by" uuid,
+    "updated" timestamp NOT NULL,
+    "updated_by" uuid,
+    "deleted_at" timestamp,
+    "active" boolean NOT NULL DEFAULT true,
+    "service_request_id" uuid NOT NULL REFERENCES "clients_servicerequest" ("id") ON DELETE CASCADE,
+    "parent_id" uuid REFERENCES "clients_servicerequestcomment" ("id") ON DELETE CASCADE,
+    "staff_id" uuid NOT NULL REFERENCES "staff_staff" ("id") ON DELETE CASCADE,
+    "comment" text NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS "clients_servicerequestcomment_service_request_idx" ON "clients_servicerequestcomment" ("service_request_id", "created");
+
+CREATE TABLE IF NOT EXISTS "clients_servicerequestevent" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "created" timestamp NOT NULL,
+    "created_by" uuid,
+    "updated" timestamp NOT NULL,
+    "updated_by" uuid,
+    "deleted_at" timestamp,
+    "service_request_id" uuid NOT NULL REFERENCES "clients_servicerequest" ("id") ON DELETE CASCADE,
+    "event_type" varchar(36) NOT NULL,
+    "staff_id" uuid REFERENCES "staff_staff" ("id") ON DELETE SET NULL,
+    "status" varchar(36),
+    "assigned_to_id" uuid REFERENCES "staff_staff" ("id") ON DELETE SET NULL,
+    "notes" text
+);
+
+CREATE INDEX IF NOT EXISTS "clients_servicerequestevent_service_request_idx" ON "clients_servicerequestevent" ("service_request_id", "created");
+
+-- the event history of a service request is append only
+CREATE OR REPLACE FUNCTION "clients_servicerequestevent_prevent_update"() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'service request events cannot be modified';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS "clients_servicerequestevent_immutable" ON "clients_servicerequestevent";
+
+CREATE TRIGGER "clients_servicerequestevent_immutable" BEFORE UPDATE ON "clients_servicerequestevent"
+    FOR EACH ROW EXECUTE PROCEDURE "clients_servicerequestevent_prevent_update"();
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+ALTER TABLE
+    IF EXISTS "questionnaires_screeningtoolresponse"
+    DROP COLUMN IF EXISTS "severity";
+
+ALTER TABLE
+    IF EXISTS "questionnaires_question"
+    DROP COLUMN IF EXISTS "critical_item_threshold";
+
+DROP TABLE IF EXISTS "questionnaires_screeningtoolscoreband";
+
+COMMIT;
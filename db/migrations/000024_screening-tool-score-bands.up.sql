@@ -0,0 +1,31 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "questionnaires_screeningtoolscoreband" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "active" boolean NOT NULL DEFAULT true,
+    "created" timestamp NOT NULL,
+    "created_by" uuid REFERENCES "users_user" ("id"),
+    "updated" timestamp NOT NULL,
+    "updated_by" uuid REFERENCES "users_user" ("id"),
+    "deleted_at" timestamp,
+    "organisation_id" uuid NOT NULL REFERENCES "common_organisation" ("id"),
+    "program_id" uuid NOT NULL REFERENCES "common_program" ("id"),
+    "screeningtool_id" uuid NOT NULL REFERENCES "questionnaires_screeningtool" ("id") ON DELETE CASCADE,
+    "label" varchar(64) NOT NULL,
+    "min_score" integer NOT NULL,
+    "max_score" integer,
+    "action" varchar(36) NOT NULL DEFAULT 'NONE',
+    "priority" varchar(36)
+);
+
+CREATE INDEX IF NOT EXISTS "questionnaires_screeningtoolscoreband_screeningtool_idx" ON "questionnaires_screeningtoolscoreband" ("screeningtool_id", "min_score");
+
+ALTER TABLE
+    IF EXISTS "questionnaires_question"
+    ADD COLUMN IF NOT EXISTS "critical_item_threshold" integer;
+
+ALTER TABLE
+    IF EXISTS "questionnaires_screeningtoolresponse"
+    ADD COLUMN IF NOT EXISTS "severity" varchar(64);
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "questionnaires_questioncondition" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "active" boolean NOT NULL DEFAULT true,
+    "created" timestamp NOT NULL,
+    "created_by" uuid REFERENCES "users_user" ("id"),
+    "updated" timestamp NOT NULL,
+    "updated_by" uuid REFERENCES "users_user" ("id"),
+    "deleted_at" timestamp,
+    "organisation_id" uuid NOT NULL REFERENCES "common_organisation" ("id"),
+    "program_id" uuid NOT NULL REFERENCES "common_program" ("id"),
+    "question_id" uuid NOT NULL REFERENCES "questionnaires_question" ("id") ON DELETE CASCADE,
+    "depends_on_question_id" uuid NOT NULL REFERENCES "questionnaires_question" ("id") ON DELETE CASCADE,
+    "operator" varchar(36) NOT NULL,
+    "target" varchar(36) NOT NULL,
+    "values" text[] NOT NULL,
+    CONSTRAINT "questionnaires_questioncondition_not_self" CHECK ("question_id" <> "depends_on_question_id")
+);
+
+CREATE INDEX IF NOT EXISTS "questionnaires_questioncondition_question_idx" ON "questionnaires_questioncondition" ("question_id");
+
+COMMIT;
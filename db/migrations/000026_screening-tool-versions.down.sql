@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE
+    IF EXISTS "questionnaires_screeningtoolresponse"
+    DROP COLUMN IF EXISTS "screeningtool_version_id";
+
+DROP TABLE IF EXISTS "questionnaires_screeningtoolversion";
+
+COMMIT;
@@ -0,0 +1,44 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "questionnaires_screeningtoolversion" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "active" boolean NOT NULL DEFAULT true,
+    "created" timestamp NOT NULL,
+    "created_by" uuid REFERENCES "users_user" ("id"),
+    "updated" timestamp NOT NULL,
+    "updated_by" uuid REFERENCES "users_user" ("id"),
+    "deleted_at" timestamp,
+    "organisation_id" uuid NOT NULL REFERENCES "common_organisation" ("id"),
+    "program_id" uuid NOT NULL REFERENCES "common_program" ("id"),
+    "screeningtool_id" uuid NOT NULL REFERENCES "questionnaires_screeningtool" ("id") ON DELETE CASCADE,
+    "questionnaire_id" uuid NOT NULL REFERENCES "questionnaires_questionnaire" ("id"),
+    "version" integer NOT NULL,
+    "status" varchar(36) NOT NULL DEFAULT 'DRAFT',
+    "published_at" timestamp,
+    CONSTRAINT "questionnaires_screeningtoolversion_version_key" UNIQUE ("screeningtool_id", "version")
+);
+
+-- a screening tool can only have a single draft that is being edited
+CREATE UNIQUE INDEX IF NOT EXISTS "questionnaires_screeningtoolversion_draft_idx" ON "questionnaires_screeningtoolversion" ("screeningtool_id")
+WHERE "status" = 'DRAFT';
+
+ALTER TABLE
+    IF EXISTS "questionnaires_screeningtoolresponse"
+    ADD COLUMN IF NOT EXISTS "screeningtool_version_id" uuid REFERENCES "questionnaires_screeningtoolversion" ("id");
+
+-- the existing questionnaires are recorded as the first published version of their screening tools
+INSERT INTO "questionnaires_screeningtoolversion" (
+    "id", "active", "created", "updated", "organisation_id", "program_id", "screeningtool_id", "questionnaire_id", "version", "status", "published_at"
+)
+SELECT gen_random_uuid(), true, now(), now(), "organisation_id", "program_id", "id", "questionnaire_id", 1, 'PUBLISHED', "created"
+FROM "questionnaires_screeningtool"
+ON CONFLICT DO NOTHING;
+
+UPDATE "questionnaires_screeningtoolresponse" AS "response"
+SET "screeningtool_version_id" = "version"."id"
+FROM "questionnaires_screeningtoolversion" AS "version"
+WHERE "version"."screeningtool_id" = "response"."screeningtool_id"
+    AND "version"."version" = 1
+    AND "response"."screeningtool_version_id" IS NULL;
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "questionnaires_screeningtoolassignment";
+
+ALTER TABLE
+    IF EXISTS "questionnaires_screeningtool"
+    DROP COLUMN IF EXISTS "recurrence_interval_days",
+    DROP COLUMN IF EXISTS "recurrence";
+
+COMMIT;
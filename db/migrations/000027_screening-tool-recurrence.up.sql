@@ -0,0 +1,32 @@
+BEGIN;
+
+-- existing screening tools keep the 24 hour window they were previously hard-coded to
+ALTER TABLE
+    IF EXISTS "questionnaires_screeningtool"
+    ADD COLUMN IF NOT EXISTS "recurrence" varchar(36) NOT NULL DEFAULT 'INTERVAL',
+    ADD COLUMN IF NOT EXISTS "recurrence_interval_days" integer NOT NULL DEFAULT 1;
+
+CREATE TABLE IF NOT EXISTS "questionnaires_screeningtoolassignment" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "active" boolean NOT NULL DEFAULT true,
+    "created" timestamp NOT NULL,
+    "created_by" uuid REFERENCES "users_user" ("id"),
+    "updated" timestamp NOT NULL,
+    "updated_by" uuid REFERENCES "users_user" ("id"),
+    "deleted_at" timestamp,
+    "organisation_id" uuid NOT NULL REFERENCES "common_organisation" ("id"),
+    "program_id" uuid NOT NULL REFERENCES "common_program" ("id"),
+    "screeningtool_id" uuid NOT NULL REFERENCES "questionnaires_screeningtool" ("id") ON DELETE CASCADE,
+    "client_id" uuid NOT NULL REFERENCES "clients_client" ("id"),
+    "staff_id" uuid NOT NULL REFERENCES "staff_staff" ("id"),
+    "due_date" timestamp NOT NULL,
+    "status" varchar(36) NOT NULL DEFAULT 'PENDING',
+    "completed_at" timestamp,
+    "response_id" uuid REFERENCES "questionnaires_screeningtoolresponse" ("id"),
+    "last_reminded_at" timestamp
+);
+
+CREATE INDEX IF NOT EXISTS "questionnaires_screeningtoolassignment_pending_idx" ON "questionnaires_screeningtoolassignment" ("client_id", "screeningtool_id")
+WHERE "status" = 'PENDING';
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS "questionnaires_screeningtoolresponse_client_trend_idx";
+
+ALTER TABLE
+    IF EXISTS "questionnaires_screeningtool"
+    DROP COLUMN IF EXISTS "score_worsening_threshold";
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+-- worsening is only tracked for screening tools that have a threshold configured
+ALTER TABLE
+    IF EXISTS "questionnaires_screeningtool"
+    ADD COLUMN IF NOT EXISTS "score_worsening_threshold" integer CHECK ("score_worsening_threshold" > 0);
+
+CREATE INDEX IF NOT EXISTS "questionnaires_screeningtoolresponse_client_trend_idx"
+    ON "questionnaires_screeningtoolresponse" ("client_id", "screeningtool_id", "created");
+
+COMMIT;
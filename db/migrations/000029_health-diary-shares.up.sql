@@ -0,0 +1,41 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "clients_healthdiaryshare" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "active" boolean NOT NULL DEFAULT true,
+    "created" timestamp NOT NULL,
+    "created_by" uuid REFERENCES "users_user" ("id"),
+    "updated" timestamp NOT NULL,
+    "updated_by" uuid REFERENCES "users_user" ("id"),
+    "deleted_at" timestamp,
+    "organisation_id" uuid NOT NULL REFERENCES "common_organisation" ("id"),
+    "program_id" uuid NOT NULL REFERENCES "common_program" ("id"),
+    "client_id" uuid NOT NULL REFERENCES "clients_client" ("id"),
+    "facility_id" uuid REFERENCES "common_facility" ("id"),
+    "caregiver_id" uuid REFERENCES "caregivers_caregiver" ("id"),
+    "scope" varchar(36) NOT NULL,
+    "from_date" timestamp,
+    "to_date" timestamp,
+    "healthdiaryentry_ids" text[],
+    "expires_at" timestamp,
+    "revoked_at" timestamp,
+    -- a grant is either made to a facility or to a caregiver
+    CONSTRAINT "clients_healthdiaryshare_grantee_check" CHECK (("facility_id" IS NULL) <> ("caregiver_id" IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS "clients_healthdiaryshare_client_idx" ON "clients_healthdiaryshare" ("client_id")
+WHERE "revoked_at" IS NULL;
+
+-- entries that were previously shared with health workers stay visible to the facilities the client belongs to
+INSERT INTO "clients_healthdiaryshare" (
+    "id", "active", "created", "updated", "organisation_id", "program_id", "client_id", "facility_id", "scope", "healthdiaryentry_ids"
+)
+SELECT gen_random_uuid(), true, now(), now(), "client"."organisation_id", "client"."program_id", "entry"."client_id", "client_facility"."facility_id",
+    'ENTRIES', array_agg("entry"."id"::text)
+FROM "clients_healthdiaryentry" AS "entry"
+JOIN "clients_client" AS "client" ON "client"."id" = "entry"."client_id"
+JOIN "clients_client_facilities" AS "client_facility" ON "client_facility"."client_id" = "entry"."client_id"
+WHERE "entry"."share_with_health_worker" = true
+GROUP BY "client"."organisation_id", "client"."program_id", "entry"."client_id", "client_facility"."facility_id";
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE
+    IF EXISTS "common_usersurveys"
+    DROP COLUMN IF EXISTS "last_reminded_at",
+    DROP COLUMN IF EXISTS "expires_at",
+    DROP COLUMN IF EXISTS "campaign_id";
+
+DROP TABLE IF EXISTS "common_surveycampaign";
+
+COMMIT;
@@ -0,0 +1,42 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "common_surveycampaign" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "active" boolean NOT NULL DEFAULT true,
+    "created" timestamp NOT NULL,
+    "created_by" uuid REFERENCES "users_user" ("id"),
+    "updated" timestamp NOT NULL,
+    "updated_by" uuid REFERENCES "users_user" ("id"),
+    "deleted_at" timestamp,
+    "organisation_id" uuid NOT NULL REFERENCES "common_organisation" ("id"),
+    "program_id" uuid NOT NULL REFERENCES "common_program" ("id"),
+    "facility_id" uuid NOT NULL REFERENCES "common_facility" ("id"),
+    "project_id" integer NOT NULL,
+    "form_id" text NOT NULL,
+    "title" text NOT NULL,
+    "client_types" text[],
+    "genders" text[],
+    "min_age" integer,
+    "max_age" integer,
+    "recurrence" varchar(36) NOT NULL DEFAULT 'ONCE',
+    "status" varchar(36) NOT NULL DEFAULT 'SCHEDULED',
+    "next_send_at" timestamp,
+    "ends_at" timestamp,
+    "last_sent_at" timestamp,
+    "link_expiry_days" integer NOT NULL,
+    "reminder_interval_days" integer
+);
+
+CREATE INDEX IF NOT EXISTS "common_surveycampaign_due_idx" ON "common_surveycampaign" ("next_send_at")
+WHERE "status" IN ('SCHEDULED', 'ACTIVE');
+
+-- survey links sent by a campaign expire and are followed up with reminders until the client responds
+ALTER TABLE
+    IF EXISTS "common_usersurveys"
+    ADD COLUMN IF NOT EXISTS "campaign_id" uuid REFERENCES "common_surveycampaign" ("id"),
+    ADD COLUMN IF NOT EXISTS "expires_at" timestamp,
+    ADD COLUMN IF NOT EXISTS "last_reminded_at" timestamp;
+
+CREATE INDEX IF NOT EXISTS "common_usersurveys_campaign_idx" ON "common_usersurveys" ("campaign_id");
+
+COMMIT;
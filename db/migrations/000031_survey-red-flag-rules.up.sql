@@ -0,0 +1,22 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "common_surveyredflagrule" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "active" boolean NOT NULL DEFAULT true,
+    "created" timestamp NOT NULL,
+    "created_by" uuid REFERENCES "users_user" ("id"),
+    "updated" timestamp NOT NULL,
+    "updated_by" uuid REFERENCES "users_user" ("id"),
+    "deleted_at" timestamp,
+    "organisation_id" uuid NOT NULL REFERENCES "common_organisation" ("id"),
+    "program_id" uuid NOT NULL REFERENCES "common_program" ("id"),
+    "project_id" integer NOT NULL,
+    "form_id" text NOT NULL,
+    "name" text NOT NULL,
+    "combinator" varchar(36) NOT NULL DEFAULT 'AND',
+    "conditions" jsonb NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS "common_surveyredflagrule_form_idx" ON "common_surveyredflagrule" ("project_id", "form_id");
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE
+    IF EXISTS "communities_community"
+    DROP COLUMN IF EXISTS "facility_id";
+
+COMMIT;
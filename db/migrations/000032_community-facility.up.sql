@@ -0,0 +1,8 @@
+BEGIN;
+
+-- a community can be limited to the clients of a single facility
+ALTER TABLE
+    IF EXISTS "communities_community"
+    ADD COLUMN IF NOT EXISTS "facility_id" uuid REFERENCES "common_facility" ("id");
+
+COMMIT;
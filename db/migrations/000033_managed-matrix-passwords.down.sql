@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE
+    IF EXISTS "users_user"
+    DROP COLUMN IF EXISTS "matrix_password";
+
+COMMIT;
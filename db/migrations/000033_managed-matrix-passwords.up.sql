@@ -0,0 +1,9 @@
+BEGIN;
+
+-- Matrix passwords are generated per user and stored encrypted. Users without one still have the legacy
+-- password (their user ID) and are rotated the next time their Matrix credentials are needed
+ALTER TABLE
+    IF EXISTS "users_user"
+    ADD COLUMN IF NOT EXISTS "matrix_password" text;
+
+COMMIT;
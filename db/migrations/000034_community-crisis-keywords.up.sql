@@ -0,0 +1,20 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "communities_crisiskeyword" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "active" boolean NOT NULL DEFAULT true,
+    "created" timestamp NOT NULL,
+    "created_by" uuid REFERENCES "users_user" ("id"),
+    "updated" timestamp NOT NULL,
+    "updated_by" uuid REFERENCES "users_user" ("id"),
+    "deleted_at" timestamp,
+    "organisation_id" uuid NOT NULL REFERENCES "common_organisation" ("id"),
+    "program_id" uuid NOT NULL REFERENCES "common_program" ("id"),
+    "keyword" text NOT NULL,
+    "is_regex" boolean NOT NULL DEFAULT false,
+    "category" varchar(36) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS "communities_crisiskeyword_program_idx" ON "communities_crisiskeyword" ("program_id");
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+UPDATE "authority_authoritypermission"
+SET "scope" = 'user.create', "name" = 'Create user', "description" = 'Can create user', "updated" = now()
+WHERE "scope" = 'user.update'
+AND NOT EXISTS (
+    SELECT 1 FROM "authority_authoritypermission" WHERE "scope" = 'user.create'
+);
+
+COMMIT;
@@ -0,0 +1,31 @@
+BEGIN;
+
+-- the permission that allows users to be updated was stored with the user.create scope.
+-- Roles that were granted it keep it under the user.update scope
+INSERT INTO "authority_authorityrole_permissions" ("authorityrole_id", "authoritypermission_id")
+SELECT "role_permission"."authorityrole_id", "new_permission"."id"
+FROM "authority_authorityrole_permissions" AS "role_permission"
+JOIN "authority_authoritypermission" AS "old_permission" ON "old_permission"."id" = "role_permission"."authoritypermission_id"
+JOIN "authority_authoritypermission" AS "new_permission" ON "new_permission"."scope" = 'user.update'
+WHERE "old_permission"."scope" = 'user.create'
+ON CONFLICT DO NOTHING;
+
+DELETE FROM "authority_authorityrole_permissions"
+WHERE "authoritypermission_id" IN (
+    SELECT "id" FROM "authority_authoritypermission" WHERE "scope" = 'user.create'
+)
+AND EXISTS (
+    SELECT 1 FROM "authority_authoritypermission" WHERE "scope" = 'user.update'
+);
+
+DELETE FROM "authority_authoritypermission"
+WHERE "scope" = 'user.create'
+AND EXISTS (
+    SELECT 1 FROM "authority_authoritypermission" WHERE "scope" = 'user.update'
+);
+
+UPDATE "authority_authoritypermission"
+SET "scope" = 'user.update', "name" = 'Update user', "description" = 'Can update user', "updated" = now()
+WHERE "scope" = 'user.create';
+
+COMMIT;
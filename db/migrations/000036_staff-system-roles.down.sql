@@ -0,0 +1,9 @@
+BEGIN;
+
+-- the permissions and staff assignments of the roles are removed with them
+DELETE FROM "authority_authorityrole"
+WHERE "name" IN ('Default Admin', 'Default Staff')
+    AND "is_system_role" = true
+    AND "program_id" IS NULL;
+
+COMMIT;
@@ -0,0 +1,278 @@
+BEGIN;
+
+-- staff are only granted the permissions of the roles they have been assigned. Every organisation gets the
+-- Default Admin and Default Staff system roles, all staff are assigned the Default Staff role and superusers
+-- are assigned the Default Admin role
+INSERT INTO "authority_authoritypermission" ("id", "active", "created", "updated", "name", "description", "category", "scope")
+SELECT gen_random_uuid(), true, now(), now(), "permission"."name", "permission"."description", "permission"."category", "permission"."scope"
+FROM (
+    VALUES
+    ('Read client appointment', 'Can read client appointment', 'Appointment', 'client.appointment.read'),
+    ('Update client appointment', 'Can update client appointment', 'Appointment', 'client.appointment.update'),
+    ('Read appointment slots', 'Can read the appointment slots published by a facility', 'Appointment', 'appointment.slot.read'),
+    ('Create appointment slots', 'Can publish appointment slots for a facility', 'Appointment', 'appointment.slot.create'),
+    ('Delete appointment slots', 'Can stop a facility from accepting bookings against an appointment slot', 'Appointment', 'appointment.slot.delete'),
+    ('Read system roles', 'Can read system roles', 'Authorization', 'role.read'),
+    ('Create roles', 'Can create custom roles', 'Authorization', 'role.create'),
+    ('Update roles', 'Can update custom roles', 'Authorization', 'role.update'),
+    ('Delete roles', 'Can delete custom roles', 'Authorization', 'role.delete'),
+    ('Assign roles', 'Can assign and revoke staff roles', 'Authorization', 'role.assign'),
+    ('Read community', 'Can read community', 'Community', 'community.read'),
+    ('Create community', 'Can create community', 'Community', 'community.create'),
+    ('Moderate community', 'Can moderate community members and messages', 'Community', 'community.moderate'),
+    ('Manage community crisis keywords', 'Can manage the keywords used to flag community messages that may indicate a crisis', 'Community', 'community.crisis.manage'),
+    ('Read content', 'Can read content', 'Content', 'content.read'),
+    ('Create content interaction', 'Can like, bookmark, share and view content', 'Content', 'content.interaction.create'),
+    ('Delete facility', 'Can delete facility', 'Facility', 'facility.delete'),
+    ('Update Facility', 'Can update facility', 'Facility', 'facility.update'),
+    ('Read Facility', 'Can read facility', 'Facility', 'facility.read'),
+    ('Create program facility', 'Can create a facility in a program', 'Facility', 'program.facility.create'),
+    ('Update default facility', 'Can update a user''s default facility', 'Facility', 'facility.default.update'),
+    ('Create feedback', 'Can create feedback', 'Feedback', 'feedback.create'),
+    ('Create health diary', 'Can create health diary', 'HealthDiary', 'healthdiary.create'),
+    ('Read health diary', 'Can read health diary', 'HealthDiary', 'healthdiary.read'),
+    ('Read Client health diary', 'Can read client health diary', 'HealthDiary', 'client.healthdiary.read'),
+    ('Share health diary', 'Can share health diary with a health worker', 'HealthDiary', 'healthdiary.share'),
+    ('Create metric', 'Can create metric', 'Metric', 'metric.create'),
+    ('Read notification', 'Can read notification', 'Notification', 'notification.read'),
+    ('Update notification', 'Can update notification', 'Notification', 'notification.update'),
+    ('Send notification', 'Can send notification', 'Notification', 'notification.send'),
+    ('Read organisation', 'Can read organisation', 'Organisation', 'organisation.read'),
+    ('Create organisation', 'Can create organisation', 'Organisation', 'organisation.create'),
+    ('Delete organisation', 'Can delete organisation', 'Organisation', 'organisation.delete'),
+    ('Create organisation admin', 'Can create organisation admin', 'Organisation', 'organisation.admin.create'),
+    ('Read organisation audit log', 'Can read the audit log of sensitive changes made in the organisation', 'Organisation', 'organisation.auditlog.read'),
+    ('Create OTP', 'Can create OTP', 'OTP', 'otp.create'),
+    ('Read Program', 'Can read program', 'Program', 'program.read'),
+    ('Create Program', 'Can create program', 'Program', 'program.create'),
+    ('Update Program', 'Can update program', 'Program', 'program.update'),
+    ('Read screening tool', 'Can read screening tool', 'ScreeningTool', 'screeningtool.read'),
+    ('Create screening tool', 'Can create screening tool', 'ScreeningTool', 'screeningtool.create'),
+    ('Read screening tool response', 'Can read screening tool response', 'ScreeningTool', 'screeningtool.response.read'),
+    ('Create screening tool response', 'Can create screening tool response', 'ScreeningTool', 'screeningtool.response.create'),
+    ('Read screening tool respondent', 'Can read screening tool respondent', 'ScreeningTool', 'screeningtool.respondent.read'),
+    ('Assign screening tool', 'Can assign a screening tool to a client', 'ScreeningTool', 'screeningtool.assign'),
+    ('Read security question', 'Can read security question', 'SecurityQuestion', 'securityquestion.read'),
+    ('Create security question', 'Can create security question', 'SecurityQuestion', 'securityquestion.create'),
+    ('Read service request', 'Can read service request', 'ServiceRequest', 'servicerequest.read'),
+    ('Create service request', 'Can create service request', 'ServiceRequest', 'servicerequest.create'),
+    ('Update service request', 'Can update service request', 'ServiceRequest', 'servicerequest.update'),
+    ('Update client service request', 'Can update client service request', 'ServiceRequest', 'client.servicerequest.update'),
+    ('Update staff service request', 'Can update staff service request', 'ServiceRequest', 'staff.servicerequest.update'),
+    ('Read survey', 'Can read survey', 'Survey', 'survey.read'),
+    ('Read survey respondent', 'Can read survey respondent', 'Survey', 'survey.respondent.read'),
+    ('Read client with survey service request', 'Can read client with service request from the survey', 'Survey', 'client.servicerequest.survey.read'),
+    ('Read survey response', 'Can read survey response', 'Survey', 'survey.response.read'),
+    ('Create survey response', 'Can create survey response', 'Survey', 'survey.response.create'),
+    ('Create survey link', 'Can create survey link', 'Survey', 'survey.link.create'),
+    ('Manage survey red flag rules', 'Can create and delete the rules that raise red flags from survey responses', 'Survey', 'survey.rule.manage'),
+    ('Read terms', 'Can read terms', 'User', 'terms.read'),
+    ('Read PIN', 'Can read PIN', 'User', 'pin.read'),
+    ('Read client', 'Can read client', 'User', 'client.read'),
+    ('Read staff', 'Can read staff', 'User', 'staff.read'),
+    ('Read caregiver', 'Can read caregiver', 'User', 'caregiver.read'),
+    ('Read clients of a caregiver', 'Can read clients of a caregiver', 'User', 'client.caregiver.read'),
+    ('Read caregivers of a client', 'Can read caregivers of a client', 'User', 'caregiver.client.read'),
+    ('Read staff''s facility', 'Can read staff''s facility', 'User', 'staff.facility.read'),
+    ('Read client''s facility', 'Can read client''s facility', 'User', 'client.facility.read'),
+    ('Read client''s identifier', 'Can read client''s identifier', 'User', 'client.identifier.read'),
+    ('Update user', 'Can update user', 'User', 'user.update'),
+    ('Create client', 'Can create client', 'User', 'client.create'),
+    ('Create staff', 'Can create staff', 'User', 'staff.create'),
+    ('Create caregiver', 'Can create caregiver', 'User', 'caregiver.create'),
+    ('Delete user', 'Can delete user', 'User', 'user.delete'),
+    ('Create user invite', 'Can create user invite', 'User', 'user.invite.create'),
+    ('Update user profile', 'Can update another user''s profile', 'User', 'user.profile.update'),
+    ('Update client''s facility', 'Can update client''s facility', 'User', 'client.facility.update'),
+    ('Update staff''s facility', 'Can update staff''s facility', 'User', 'staff.facility.update'),
+    ('Update caregiver consent', 'Can give or withdraw consent to a caregiver relationship', 'User', 'caregiver.consent.update')
+) AS "permission" ("name", "description", "category", "scope")
+ON CONFLICT ("scope") DO NOTHING;
+
+INSERT INTO "authority_authorityrole" (
+    "id", "active", "created", "updated", "name", "organisation_id", "description", "is_system_role", "user_type"
+)
+SELECT gen_random_uuid(), true, now(), now(), "role"."name", "organisation"."id", "role"."description", true, 'STAFF'
+FROM "common_organisation" AS "organisation"
+CROSS JOIN (
+    VALUES
+    ('Default Admin', 'Can perform all the actions in the organisation'),
+    ('Default Staff', 'Can provide care to the clients in the organisation')
+) AS "role" ("name", "description")
+WHERE NOT EXISTS (
+    SELECT 1 FROM "authority_authorityrole" AS "existing"
+    WHERE "existing"."organisation_id" = "organisation"."id"
+        AND "existing"."program_id" IS NULL
+        AND "existing"."is_system_role" = true
+        AND "existing"."name" = "role"."name"
+);
+
+INSERT INTO "authority_authorityrole_permissions" ("authorityrole_id", "authoritypermission_id")
+SELECT "role"."id", "permission"."id"
+FROM "authority_authorityrole" AS "role"
+JOIN "authority_authoritypermission" AS "permission" ON "permission"."scope" IN (
+    'client.appointment.read',
+    'client.appointment.update',
+    'appointment.slot.read',
+    'appointment.slot.create',
+    'appointment.slot.delete',
+    'role.read',
+    'role.create',
+    'role.update',
+    'role.delete',
+    'role.assign',
+    'community.read',
+    'community.create',
+    'community.moderate',
+    'community.crisis.manage',
+    'content.read',
+    'content.interaction.create',
+    'facility.delete',
+    'facility.update',
+    'facility.read',
+    'program.facility.create',
+    'facility.default.update',
+    'feedback.create',
+    'healthdiary.create',
+    'healthdiary.read',
+    'client.healthdiary.read',
+    'healthdiary.share',
+    'metric.create',
+    'notification.read',
+    'notification.update',
+    'notification.send',
+    'organisation.read',
+    'organisation.create',
+    'organisation.delete',
+    'organisation.admin.create',
+    'organisation.auditlog.read',
+    'otp.create',
+    'program.read',
+    'program.create',
+    'program.update',
+    'screeningtool.read',
+    'screeningtool.create',
+    'screeningtool.response.read',
+    'screeningtool.response.create',
+    'screeningtool.respondent.read',
+    'screeningtool.assign',
+    'securityquestion.read',
+    'securityquestion.create',
+    'servicerequest.read',
+    'servicerequest.create',
+    'servicerequest.update',
+    'client.servicerequest.update',
+    'staff.servicerequest.update',
+    'survey.read',
+    'survey.respondent.read',
+    'client.servicerequest.survey.read',
+    'survey.response.read',
+    'survey.response.create',
+    'survey.link.create',
+    'survey.rule.manage',
+    'terms.read',
+    'pin.read',
+    'client.read',
+    'staff.read',
+    'caregiver.read',
+    'client.caregiver.read',
+    'caregiver.client.read',
+    'staff.facility.read',
+    'client.facility.read',
+    'client.identifier.read',
+    'user.update',
+    'client.create',
+    'staff.create',
+    'caregiver.create',
+    'user.delete',
+    'user.invite.create',
+    'user.profile.update',
+    'client.facility.update',
+    'staff.facility.update',
+    'caregiver.consent.update'
+)
+WHERE "role"."name" = 'Default Admin' AND "role"."is_system_role" = true AND "role"."program_id" IS NULL
+ON CONFLICT DO NOTHING;
+
+INSERT INTO "authority_authorityrole_permissions" ("authorityrole_id", "authoritypermission_id")
+SELECT "role"."id", "permission"."id"
+FROM "authority_authorityrole" AS "role"
+JOIN "authority_authoritypermission" AS "permission" ON "permission"."scope" IN (
+    'client.appointment.read',
+    'client.appointment.update',
+    'appointment.slot.read',
+    'appointment.slot.create',
+    'appointment.slot.delete',
+    'role.read',
+    'community.read',
+    'community.create',
+    'community.moderate',
+    'content.read',
+    'content.interaction.create',
+    'facility.read',
+    'facility.default.update',
+    'feedback.create',
+    'client.healthdiary.read',
+    'metric.create',
+    'notification.read',
+    'notification.update',
+    'notification.send',
+    'organisation.read',
+    'otp.create',
+    'program.read',
+    'screeningtool.read',
+    'screeningtool.response.read',
+    'screeningtool.respondent.read',
+    'screeningtool.assign',
+    'securityquestion.read',
+    'securityquestion.create',
+    'servicerequest.read',
+    'servicerequest.create',
+    'servicerequest.update',
+    'client.servicerequest.update',
+    'staff.servicerequest.update',
+    'survey.read',
+    'survey.respondent.read',
+    'client.servicerequest.survey.read',
+    'survey.response.read',
+    'survey.link.create',
+    'terms.read',
+    'pin.read',
+    'client.read',
+    'staff.read',
+    'caregiver.read',
+    'client.caregiver.read',
+    'caregiver.client.read',
+    'staff.facility.read',
+    'client.facility.read',
+    'client.identifier.read',
+    'user.update',
+    'client.create',
+    'caregiver.create',
+    'user.invite.create',
+    'user.profile.update',
+    'client.facility.update',
+    'caregiver.consent.update'
+)
+WHERE "role"."name" = 'Default Staff' AND "role"."is_system_role" = true AND "role"."program_id" IS NULL
+ON CONFLICT DO NOTHING;
+
+INSERT INTO "authority_authorityrole_staff" ("authorityrole_id", "staff_id")
+SELECT "role"."id", "staff"."id"
+FROM "staff_staff" AS "staff"
+JOIN "authority_authorityrole" AS "role" ON "role"."organisation_id" = "staff"."organisation_id"
+WHERE "role"."name" = 'Default Staff' AND "role"."is_system_role" = true AND "role"."program_id" IS NULL
+    AND "staff"."deleted_at" IS NULL
+ON CONFLICT DO NOTHING;
+
+INSERT INTO "authority_authorityrole_staff" ("authorityrole_id", "staff_id")
+SELECT "role"."id", "staff"."id"
+FROM "staff_staff" AS "staff"
+JOIN "users_user" AS "user" ON "user"."id" = "staff"."user_id"
+JOIN "authority_authorityrole" AS "role" ON "role"."organisation_id" = "staff"."organisation_id"
+WHERE "role"."name" = 'Default Admin' AND "role"."is_system_role" = true AND "role"."program_id" IS NULL
+    AND "staff"."deleted_at" IS NULL
+    AND "user"."is_superuser" = true
+ON CONFLICT DO NOTHING;
+
+COMMIT;
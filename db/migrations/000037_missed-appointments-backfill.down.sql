@@ -0,0 +1,7 @@
+BEGIN;
+
+UPDATE "appointments_appointment"
+SET "last_synced_at" = NULL
+WHERE "last_synced_at" = "date";
+
+COMMIT;
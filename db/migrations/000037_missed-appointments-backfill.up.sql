@@ -0,0 +1,11 @@
+BEGIN;
+
+-- appointments that were already in the past when missed appointments started being tracked are treated as synced on
+-- their date so that the missed appointments job does not raise service requests for them
+UPDATE "appointments_appointment"
+SET "last_synced_at" = "date"
+WHERE "last_synced_at" IS NULL
+    AND "missed" = false
+    AND "date" < now();
+
+COMMIT;
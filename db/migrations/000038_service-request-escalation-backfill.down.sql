@@ -0,0 +1,14 @@
+BEGIN;
+
+UPDATE "clients_servicerequest"
+SET "escalated_at" = NULL
+WHERE "status" = 'PENDING'
+    AND "escalated_at" IS NOT NULL
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "clients_servicerequestevent"
+        WHERE "clients_servicerequestevent"."service_request_id" = "clients_servicerequest"."id"
+            AND "clients_servicerequestevent"."event_type" = 'ESCALATED'
+    );
+
+COMMIT;
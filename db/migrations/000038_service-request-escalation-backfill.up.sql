@@ -0,0 +1,10 @@
+BEGIN;
+
+-- service requests that were already pending when escalation was rolled out are treated as escalated so that the first
+-- run of the escalation job does not notify facility staff about the whole backlog at once
+UPDATE "clients_servicerequest"
+SET "escalated_at" = now()
+WHERE "escalated_at" IS NULL
+    AND "status" = 'PENDING';
+
+COMMIT;
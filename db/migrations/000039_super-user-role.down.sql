@@ -0,0 +1,17 @@
+BEGIN;
+
+-- the Default Admin roles get the platform permissions back. Custom roles that had them are not restored
+INSERT INTO "authority_authorityrole_permissions" ("authorityrole_id", "authoritypermission_id")
+SELECT "role"."id", "permission"."id"
+FROM "authority_authorityrole" AS "role"
+JOIN "authority_authoritypermission" AS "permission" ON "permission"."scope" IN ('organisation.create', 'organisation.delete')
+WHERE "role"."name" = 'Default Admin' AND "role"."is_system_role" = true AND "role"."program_id" IS NULL
+ON CONFLICT DO NOTHING;
+
+-- the permissions and staff assignments of the roles are removed with them
+DELETE FROM "authority_authorityrole"
+WHERE "name" = 'Super User'
+    AND "is_system_role" = true
+    AND "program_id" IS NULL;
+
+COMMIT;
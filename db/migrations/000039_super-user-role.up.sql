@@ -0,0 +1,58 @@
+BEGIN;
+
+-- creating and deleting organisations reaches beyond a single organisation. The permissions move from the
+-- Default Admin role to a Super User system role that is only assigned to superusers
+INSERT INTO "authority_authorityrole" (
+    "id", "active", "created", "updated", "name", "organisation_id", "description", "is_system_role", "user_type"
+)
+SELECT DISTINCT ON ("staff"."organisation_id") gen_random_uuid(), true, now(), now(), 'Super User', "staff"."organisation_id",
+    'Can perform all the actions in the platform, including managing organisations', true, 'STAFF'
+FROM "staff_staff" AS "staff"
+JOIN "users_user" AS "user" ON "user"."id" = "staff"."user_id"
+WHERE "user"."is_superuser" = true
+    AND "staff"."deleted_at" IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM "authority_authorityrole" AS "existing"
+        WHERE "existing"."organisation_id" = "staff"."organisation_id"
+            AND "existing"."program_id" IS NULL
+            AND "existing"."is_system_role" = true
+            AND "existing"."name" = 'Super User'
+    );
+
+INSERT INTO "authority_authorityrole_permissions" ("authorityrole_id", "authoritypermission_id")
+SELECT "role"."id", "admin_permission"."authoritypermission_id"
+FROM "authority_authorityrole" AS "role"
+JOIN "authority_authorityrole" AS "admin_role" ON "admin_role"."organisation_id" = "role"."organisation_id"
+    AND "admin_role"."name" = 'Default Admin' AND "admin_role"."is_system_role" = true AND "admin_role"."program_id" IS NULL
+JOIN "authority_authorityrole_permissions" AS "admin_permission" ON "admin_permission"."authorityrole_id" = "admin_role"."id"
+WHERE "role"."name" = 'Super User' AND "role"."is_system_role" = true AND "role"."program_id" IS NULL
+ON CONFLICT DO NOTHING;
+
+INSERT INTO "authority_authorityrole_permissions" ("authorityrole_id", "authoritypermission_id")
+SELECT "role"."id", "permission"."id"
+FROM "authority_authorityrole" AS "role"
+JOIN "authority_authoritypermission" AS "permission" ON "permission"."scope" IN ('organisation.create', 'organisation.delete')
+WHERE "role"."name" = 'Super User' AND "role"."is_system_role" = true AND "role"."program_id" IS NULL
+ON CONFLICT DO NOTHING;
+
+INSERT INTO "authority_authorityrole_staff" ("authorityrole_id", "staff_id")
+SELECT "role"."id", "staff"."id"
+FROM "staff_staff" AS "staff"
+JOIN "users_user" AS "user" ON "user"."id" = "staff"."user_id"
+JOIN "authority_authorityrole" AS "role" ON "role"."organisation_id" = "staff"."organisation_id"
+WHERE "role"."name" = 'Super User' AND "role"."is_system_role" = true AND "role"."program_id" IS NULL
+    AND "staff"."deleted_at" IS NULL
+    AND "user"."is_superuser" = true
+ON CONFLICT DO NOTHING;
+
+-- no other role keeps the platform permissions, including custom roles that had been granted them
+DELETE FROM "authority_authorityrole_permissions"
+WHERE "authoritypermission_id" IN (
+    SELECT "id" FROM "authority_authoritypermission" WHERE "scope" IN ('organisation.create', 'organisation.delete')
+)
+AND "authorityrole_id" NOT IN (
+    SELECT "id" FROM "authority_authorityrole"
+    WHERE "name" = 'Super User' AND "is_system_role" = true AND "program_id" IS NULL
+);
+
+COMMIT;
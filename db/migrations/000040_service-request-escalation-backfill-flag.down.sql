@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE
+    IF EXISTS "clients_servicerequest"
+    DROP COLUMN IF EXISTS "escalation_backfilled";
+
+COMMIT;
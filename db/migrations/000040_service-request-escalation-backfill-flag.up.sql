@@ -0,0 +1,19 @@
+BEGIN;
+
+ALTER TABLE
+    IF EXISTS "clients_servicerequest"
+    ADD COLUMN IF NOT EXISTS "escalation_backfilled" boolean NOT NULL DEFAULT false;
+
+-- the service requests marked as escalated by the backfill in 000038 were never escalated to the facility staff so they
+-- are flagged and left out of the escalation metrics. Unlike them, escalated service requests have an ESCALATED event
+UPDATE "clients_servicerequest"
+SET "escalation_backfilled" = true
+WHERE "escalated_at" IS NOT NULL
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "clients_servicerequestevent"
+        WHERE "clients_servicerequestevent"."service_request_id" = "clients_servicerequest"."id"
+            AND "clients_servicerequestevent"."event_type" = 'ESCALATED'
+    );
+
+COMMIT;
@@ -5,13 +5,16 @@ import (
 	"crypto/cipher"
 	"encoding/base64"
 	"fmt"
+	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	sentry "github.com/getsentry/sentry-go"
 	"github.com/savannahghi/feedlib"
 	"github.com/savannahghi/firebasetools"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/exceptions"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"github.com/savannahghi/serverutils"
@@ -26,8 +29,58 @@ const (
 
 	// GoogleCloudStorageURL is base bucket link for the content images
 	GoogleCloudStorageURL = "GOOGLE_CLOUD_STORAGE_URL"
+
+	// OTPMaxVerifyAttempts is the number of failed verification attempts after which an OTP is invalidated
+	OTPMaxVerifyAttempts = "OTP_MAX_VERIFY_ATTEMPTS"
+
+	// OTPSendLimit is the number of OTPs that can be sent to a phone number within the OTP send window
+	OTPSendLimit = "OTP_SEND_LIMIT"
+
+	// OTPSendWindowMinutes is the length, in minutes, of the sliding window used to rate limit sending of OTPs
+	OTPSendWindowMinutes = "OTP_SEND_WINDOW_MINUTES"
+
+	// AppointmentReminderOffsetDays is a comma separated list of the number of days before an appointment
+	// when a reminder should be sent e.g "3,1,0". An offset of 0 sends a reminder on the morning of the appointment
+	AppointmentReminderOffsetDays = "APPOINTMENT_REMINDER_OFFSET_DAYS"
+
+	// AppointmentReminderHour is the hour of the day after which appointment reminders are sent
+	AppointmentReminderHour = "APPOINTMENT_REMINDER_HOUR"
+
+	// AppointmentsUTCOffsetHours is the offset from UTC, in hours, of the time zone in which appointment dates
+	// are interpreted when scheduling reminders and detecting missed appointments
+	AppointmentsUTCOffsetHours = "APPOINTMENTS_UTC_OFFSET_HOURS"
+
+	// MissedAppointmentGraceDays is the number of days after an appointment's date within which KenyaEMR is expected
+	// to sync the client's visit before the appointment is considered missed
+	MissedAppointmentGraceDays = "MISSED_APPOINTMENT_GRACE_DAYS"
+
+	// ServiceRequestSLAs is a comma separated list of the time within which service requests of a given type should be
+	// picked up before they are escalated e.g "RED_FLAG:1h,PIN_RESET:24h". Types that are not listed use the defaults
+	ServiceRequestSLAs = "SERVICE_REQUEST_SLAS"
+
+	defaultOTPMaxVerifyAttempts = 5
+	defaultOTPSendLimit         = 5
+	defaultOTPSendWindowMinutes = 60
+
+	defaultAppointmentReminderHour    = 7
+	defaultAppointmentsUTCOffsetHours = 3
+	defaultMissedAppointmentGraceDays = 1
 )
 
+var defaultAppointmentReminderOffsetDays = []int{3, 1, 0}
+
+var defaultServiceRequestSLAs = map[enums.ServiceRequestType]time.Duration{
+	enums.ServiceRequestTypeRedFlag:               time.Hour,
+	enums.ServiceRequestTypeScreeningToolsRedFlag: time.Hour,
+	enums.ServiceRequestTypeSurveyRedFlag:         time.Hour,
+	enums.ServiceRequestTypePinReset:              24 * time.Hour,
+	enums.ServiceRequestTypeStaffPinReset:         24 * time.Hour,
+	enums.ServiceRequestTypeHomePageHealthDiary:   48 * time.Hour,
+	enums.ServiceRequestTypeAppointments:          48 * time.Hour,
+	enums.ServiceRequestTypeMissedAppointment:     48 * time.Hour,
+	enums.ServiceRequestTypeMoodDeterioration:     24 * time.Hour,
+}
+
 var bytes = []byte{35, 46, 57, 24, 85, 35, 24, 74, 87, 35, 88, 98, 66, 32, 14, 05}
 
 // GetInviteLink generates a custom invite link for PRO or CONSUMER
@@ -113,6 +166,100 @@ func GetPinExpiryDate() (*time.Time, error) {
 	return &expiryDate, nil
 }
 
+// GetOTPMaxVerifyAttempts returns the number of failed verification attempts allowed before an OTP is invalidated
+func GetOTPMaxVerifyAttempts() int {
+	return getPositiveIntEnvVar(OTPMaxVerifyAttempts, defaultOTPMaxVerifyAttempts)
+}
+
+// GetOTPSendRateLimit returns the maximum number of OTPs that can be sent to a phone number and the sliding window
+// within which the limit applies
+func GetOTPSendRateLimit() (int, time.Duration) {
+	limit := getPositiveIntEnvVar(OTPSendLimit, defaultOTPSendLimit)
+	window := getPositiveIntEnvVar(OTPSendWindowMinutes, defaultOTPSendWindowMinutes)
+
+	return limit, time.Duration(window) * time.Minute
+}
+
+// GetAppointmentReminderOffsets returns the number of days before an appointment when reminders should be sent
+func GetAppointmentReminderOffsets() []int {
+	offsets := []int{}
+	seen := map[int]bool{}
+	for _, value := range strings.Split(os.Getenv(AppointmentReminderOffsetDays), ",") {
+		offset, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || offset < 0 || seen[offset] {
+			continue
+		}
+		seen[offset] = true
+		offsets = append(offsets, offset)
+	}
+
+	if len(offsets) == 0 {
+		return defaultAppointmentReminderOffsetDays
+	}
+
+	return offsets
+}
+
+// GetAppointmentReminderSchedule returns the hour of the day after which appointment reminders are sent and the
+// time zone in which the hour is expressed
+func GetAppointmentReminderSchedule() (int, *time.Location) {
+	hour, err := strconv.Atoi(os.Getenv(AppointmentReminderHour))
+	if err != nil || hour < 0 || hour > 23 {
+		hour = defaultAppointmentReminderHour
+	}
+
+	return hour, GetAppointmentsLocation()
+}
+
+// GetAppointmentsLocation returns the time zone in which appointment dates are interpreted
+func GetAppointmentsLocation() *time.Location {
+	offset, err := strconv.Atoi(os.Getenv(AppointmentsUTCOffsetHours))
+	if err != nil || offset < -12 || offset > 14 {
+		offset = defaultAppointmentsUTCOffsetHours
+	}
+
+	return time.FixedZone(fmt.Sprintf("UTC%+d", offset), offset*60*60)
+}
+
+// GetMissedAppointmentGraceDays returns the number of days after an appointment's date before it is considered missed
+func GetMissedAppointmentGraceDays() int {
+	return getPositiveIntEnvVar(MissedAppointmentGraceDays, defaultMissedAppointmentGraceDays)
+}
+
+// GetServiceRequestSLAs returns the time within which each type of service request should be picked up
+func GetServiceRequestSLAs() map[enums.ServiceRequestType]time.Duration {
+	slas := make(map[enums.ServiceRequestType]time.Duration, len(defaultServiceRequestSLAs))
+	for requestType, sla := range defaultServiceRequestSLAs {
+		slas[requestType] = sla
+	}
+
+	for _, value := range strings.Split(os.Getenv(ServiceRequestSLAs), ",") {
+		parts := strings.SplitN(strings.TrimSpace(value), ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		requestType := enums.ServiceRequestType(strings.TrimSpace(parts[0]))
+		sla, err := time.ParseDuration(strings.TrimSpace(parts[1]))
+		if !requestType.IsValid() || err != nil || sla <= 0 {
+			continue
+		}
+		slas[requestType] = sla
+	}
+
+	return slas
+}
+
+// getPositiveIntEnvVar reads an optional integer environment variable, falling back to the default value when the
+// variable is not set or is not a positive integer
+func getPositiveIntEnvVar(envVar string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(envVar))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+
+	return value
+}
+
 // RestAPIResponseHelper returns custom standardised response for frontend response consistency
 func RestAPIResponseHelper(key string, value interface{}) *dto.RestEndpointResponses {
 	response := &dto.RestEndpointResponses{
@@ -8,6 +8,7 @@ import (
 	"github.com/brianvoe/gofakeit"
 	"github.com/savannahghi/feedlib"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"github.com/savannahghi/serverutils"
 	"github.com/stretchr/testify/assert"
@@ -248,6 +249,218 @@ func TestGetPinExpiryDate(t *testing.T) {
 	}
 }
 
+func TestGetOTPMaxVerifyAttempts(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     int
+	}{
+		{
+			name:     "Happy case: configured attempts",
+			envValue: "3",
+			want:     3,
+		},
+		{
+			name:     "Sad case: invalid value falls back to default",
+			envValue: "invalid",
+			want:     defaultOTPMaxVerifyAttempts,
+		},
+		{
+			name:     "Sad case: non positive value falls back to default",
+			envValue: "0",
+			want:     defaultOTPMaxVerifyAttempts,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(OTPMaxVerifyAttempts, tt.envValue)
+
+			if got := GetOTPMaxVerifyAttempts(); got != tt.want {
+				t.Errorf("GetOTPMaxVerifyAttempts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetOTPSendRateLimit(t *testing.T) {
+	tests := []struct {
+		name       string
+		limit      string
+		window     string
+		wantLimit  int
+		wantWindow time.Duration
+	}{
+		{
+			name:       "Happy case: configured limit and window",
+			limit:      "3",
+			window:     "15",
+			wantLimit:  3,
+			wantWindow: 15 * time.Minute,
+		},
+		{
+			name:       "Sad case: missing values fall back to defaults",
+			wantLimit:  defaultOTPSendLimit,
+			wantWindow: defaultOTPSendWindowMinutes * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(OTPSendLimit, tt.limit)
+			t.Setenv(OTPSendWindowMinutes, tt.window)
+
+			limit, window := GetOTPSendRateLimit()
+			if limit != tt.wantLimit {
+				t.Errorf("GetOTPSendRateLimit() limit = %v, want %v", limit, tt.wantLimit)
+			}
+			if window != tt.wantWindow {
+				t.Errorf("GetOTPSendRateLimit() window = %v, want %v", window, tt.wantWindow)
+			}
+		})
+	}
+}
+
+func TestGetAppointmentReminderOffsets(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     []int
+	}{
+		{
+			name:     "Happy case: configured offsets",
+			envValue: "7, 2,0",
+			want:     []int{7, 2, 0},
+		},
+		{
+			name:     "Happy case: invalid and duplicate offsets are ignored",
+			envValue: "2,invalid,-1,2",
+			want:     []int{2},
+		},
+		{
+			name: "Sad case: missing value falls back to default",
+			want: defaultAppointmentReminderOffsetDays,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(AppointmentReminderOffsetDays, tt.envValue)
+
+			assert.Equal(t, tt.want, GetAppointmentReminderOffsets())
+		})
+	}
+}
+
+func TestGetAppointmentReminderSchedule(t *testing.T) {
+	tests := []struct {
+		name       string
+		hour       string
+		utcOffset  string
+		wantHour   int
+		wantOffset int
+	}{
+		{
+			name:       "Happy case: configured hour and offset",
+			hour:       "9",
+			utcOffset:  "0",
+			wantHour:   9,
+			wantOffset: 0,
+		},
+		{
+			name:       "Sad case: invalid values fall back to defaults",
+			hour:       "25",
+			utcOffset:  "invalid",
+			wantHour:   defaultAppointmentReminderHour,
+			wantOffset: defaultAppointmentsUTCOffsetHours * 60 * 60,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(AppointmentReminderHour, tt.hour)
+			t.Setenv(AppointmentsUTCOffsetHours, tt.utcOffset)
+
+			hour, location := GetAppointmentReminderSchedule()
+			if hour != tt.wantHour {
+				t.Errorf("GetAppointmentReminderSchedule() hour = %v, want %v", hour, tt.wantHour)
+			}
+			if _, offset := time.Now().In(location).Zone(); offset != tt.wantOffset {
+				t.Errorf("GetAppointmentReminderSchedule() offset = %v, want %v", offset, tt.wantOffset)
+			}
+		})
+	}
+}
+
+func TestGetMissedAppointmentGraceDays(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     int
+	}{
+		{
+			name:     "Happy case: configured grace days",
+			envValue: "3",
+			want:     3,
+		},
+		{
+			name:     "Sad case: invalid value falls back to default",
+			envValue: "invalid",
+			want:     defaultMissedAppointmentGraceDays,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(MissedAppointmentGraceDays, tt.envValue)
+
+			if got := GetMissedAppointmentGraceDays(); got != tt.want {
+				t.Errorf("GetMissedAppointmentGraceDays() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetServiceRequestSLAs(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     map[enums.ServiceRequestType]time.Duration
+	}{
+		{
+			name:     "Happy case: configured SLAs override the defaults",
+			envValue: "RED_FLAG:30m, PIN_RESET:12h",
+			want: map[enums.ServiceRequestType]time.Duration{
+				enums.ServiceRequestTypeRedFlag:  30 * time.Minute,
+				enums.ServiceRequestTypePinReset: 12 * time.Hour,
+			},
+		},
+		{
+			name:     "Sad case: invalid entries are ignored",
+			envValue: "UNKNOWN:1h,RED_FLAG:soon,PIN_RESET:-1h,APPOINTMENTS",
+			want: map[enums.ServiceRequestType]time.Duration{
+				enums.ServiceRequestTypeRedFlag:  defaultServiceRequestSLAs[enums.ServiceRequestTypeRedFlag],
+				enums.ServiceRequestTypePinReset: defaultServiceRequestSLAs[enums.ServiceRequestTypePinReset],
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(ServiceRequestSLAs, tt.envValue)
+
+			got := GetServiceRequestSLAs()
+			if len(got) != len(defaultServiceRequestSLAs) {
+				t.Errorf("GetServiceRequestSLAs() returned %v SLAs, want %v", len(got), len(defaultServiceRequestSLAs))
+			}
+			for _, requestType := range enums.AllServiceRequestType {
+				if _, ok := got[requestType]; !ok {
+					t.Errorf("GetServiceRequestSLAs() has no SLA for %v service requests", requestType)
+				}
+			}
+			for requestType, sla := range tt.want {
+				if got[requestType] != sla {
+					t.Errorf("GetServiceRequestSLAs()[%v] = %v, want %v", requestType, got[requestType], sla)
+				}
+			}
+		})
+	}
+}
+
 func TestRestAPIResponseHelper(t *testing.T) {
 	type args struct {
 		key   string
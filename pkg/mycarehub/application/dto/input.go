@@ -2,7 +2,10 @@ package dto
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/savannahghi/enumutils"
@@ -627,6 +630,9 @@ type UserSurveyInput struct {
 	Token          string `json:"token"`
 	ProgramID      string `json:"programID"`
 	OrganisationID string `json:"organisationID"`
+
+	CampaignID *string    `json:"campaignID"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
 }
 
 // VerifySurveySubmissionInput represents the payload that is to be sent when a user has filled a survey.
@@ -659,23 +665,230 @@ func (q QuestionnaireInput) Validate() error {
 			if len(question.Choices) > 0 {
 				return fmt.Errorf("choices are not supported for open ended questions")
 			}
+			if question.CriticalItemThreshold != nil {
+				return fmt.Errorf("open ended questions cannot be critical items since they are not scored")
+			}
 		case enums.QuestionTypeCloseEnded:
 			if len(question.Choices) < 2 {
 				return fmt.Errorf("at least two choices are required for close ended questions")
 			}
 		}
 	}
+	if err := q.validateConditions(); err != nil {
+		return err
+	}
 	return err
 }
 
+// validateConditions checks that the display conditions of the questions reference existing close ended questions
+// and that no question depends on itself, directly or through other questions
+func (q QuestionnaireInput) validateConditions() error {
+	questions := map[int]*QuestionInput{}
+	for _, question := range q.Questions {
+		questions[question.Sequence] = question
+	}
+
+	for _, question := range q.Questions {
+		for _, condition := range question.Conditions {
+			if err := condition.Validate(); err != nil {
+				return err
+			}
+
+			dependency, ok := questions[condition.QuestionSequence]
+			if !ok {
+				return fmt.Errorf("question %d has a condition on question %d which does not exist", question.Sequence, condition.QuestionSequence)
+			}
+			if dependency.QuestionType != enums.QuestionTypeCloseEnded {
+				return fmt.Errorf("question %d can only have conditions on close ended questions", question.Sequence)
+			}
+
+			if condition.Target == enums.QuestionConditionTargetChoice && condition.Operator != enums.QuestionConditionOperatorGreaterThan {
+				choices := map[string]bool{}
+				for _, c := range dependency.Choices {
+					choices[*c.Choice] = true
+				}
+				for _, value := range condition.Values {
+					if !choices[value] {
+						return fmt.Errorf("question %d has a condition on choice %s which is not a choice of question %d", question.Sequence, value, dependency.Sequence)
+					}
+				}
+			}
+		}
+	}
+
+	// a question's conditions are cyclic if walking its dependencies leads back to a question being visited
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[int]int{}
+	var visit func(sequence int) error
+	visit = func(sequence int) error {
+		switch state[sequence] {
+		case visiting:
+			return fmt.Errorf("question %d has cyclic conditions", sequence)
+		case visited:
+			return nil
+		}
+
+		state[sequence] = visiting
+		for _, condition := range questions[sequence].Conditions {
+			if err := visit(condition.QuestionSequence); err != nil {
+				return err
+			}
+		}
+		state[sequence] = visited
+		return nil
+	}
+	for _, question := range q.Questions {
+		if err := visit(question.Sequence); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ScreeningToolInput represents the payload that is to be used when creating a questionnaire
 type ScreeningToolInput struct {
-	Questionnaire QuestionnaireInput `json:"questionnaire"`
-	Threshold     int                `json:"threshold"`
-	ClientTypes   []enums.ClientType `json:"clientTypes"`
-	Genders       []enumutils.Gender `json:"genders"`
-	AgeRange      AgeRangeInput      `json:"ageRange"`
-	ProgramID     string             `json:"programID"`
+	Questionnaire QuestionnaireInput             `json:"questionnaire"`
+	Threshold     int                            `json:"threshold"`
+	ClientTypes   []enums.ClientType             `json:"clientTypes"`
+	Genders       []enumutils.Gender             `json:"genders"`
+	AgeRange      AgeRangeInput                  `json:"ageRange"`
+	ScoreBands    []*ScreeningToolScoreBandInput `json:"scoreBands"`
+	Recurrence    *ScreeningToolRecurrenceInput  `json:"recurrence"`
+	ProgramID     string                         `json:"programID"`
+
+	ScoreWorseningThreshold *int `json:"scoreWorseningThreshold"`
+}
+
+// Validate helps with validation of a ScreeningToolInput
+// The score bands should not overlap and only the highest band can be open ended
+func (s ScreeningToolInput) Validate() error {
+	if err := s.Questionnaire.Validate(); err != nil {
+		return err
+	}
+
+	bands := make([]*ScreeningToolScoreBandInput, len(s.ScoreBands))
+	copy(bands, s.ScoreBands)
+	sort.Slice(bands, func(i, j int) bool {
+		return bands[i].MinScore < bands[j].MinScore
+	})
+
+	for i, band := range bands {
+		if err := band.Validate(); err != nil {
+			return err
+		}
+		if i == 0 {
+			continue
+		}
+
+		previous := bands[i-1]
+		if previous.MaxScore == nil || band.MinScore <= *previous.MaxScore {
+			return fmt.Errorf("score band %s overlaps with score band %s", band.Label, previous.Label)
+		}
+	}
+
+	if s.Recurrence != nil {
+		if err := s.Recurrence.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if s.ScoreWorseningThreshold != nil && *s.ScoreWorseningThreshold < 1 {
+		return fmt.Errorf("the score worsening threshold should be at least one")
+	}
+
+	return nil
+}
+
+// ScreeningToolRecurrenceInput represents how often a screening tool is made available to a client
+// e.g every 14 days, only once or only when assigned by a staff
+type ScreeningToolRecurrenceInput struct {
+	Recurrence   enums.ScreeningToolRecurrence `json:"recurrence" validate:"required"`
+	IntervalDays *int                          `json:"intervalDays"`
+}
+
+// Validate helps with validation of a ScreeningToolRecurrenceInput
+// An interval is only required, and allowed, for screening tools that recur after a number of days
+func (r ScreeningToolRecurrenceInput) Validate() error {
+	v := validator.New()
+	if err := v.Struct(r); err != nil {
+		return err
+	}
+
+	if !r.Recurrence.IsValid() {
+		return fmt.Errorf("invalid screening tool recurrence: %s", r.Recurrence)
+	}
+
+	if r.Recurrence == enums.ScreeningToolRecurrenceInterval {
+		if r.IntervalDays == nil || *r.IntervalDays < 1 {
+			return fmt.Errorf("an interval of at least one day is required for a recurring screening tool")
+		}
+		return nil
+	}
+
+	if r.IntervalDays != nil {
+		return fmt.Errorf("an interval is only allowed for a recurring screening tool")
+	}
+
+	return nil
+}
+
+// ScreeningToolAssignmentInput represents a staff's request for a client to respond to a screening tool by a due date
+type ScreeningToolAssignmentInput struct {
+	ScreeningToolID string    `json:"screeningToolID" validate:"required"`
+	ClientID        string    `json:"clientID" validate:"required"`
+	DueDate         time.Time `json:"dueDate" validate:"required"`
+}
+
+// Validate helps with validation of a ScreeningToolAssignmentInput
+func (a ScreeningToolAssignmentInput) Validate() error {
+	v := validator.New()
+	if err := v.Struct(a); err != nil {
+		return err
+	}
+
+	if !a.DueDate.After(time.Now()) {
+		return fmt.Errorf("the due date of a screening tool assignment should be in the future")
+	}
+
+	return nil
+}
+
+// ScreeningToolScoreBandInput represents a labelled range of aggregate scores for a screening tool and the action taken
+// when a response falls within it
+type ScreeningToolScoreBandInput struct {
+	Label    string                        `json:"label" validate:"required"`
+	MinScore int                           `json:"minScore" validate:"min=0"`
+	MaxScore *int                          `json:"maxScore"`
+	Action   enums.ScreeningToolBandAction `json:"action" validate:"required"`
+	Priority *enums.ServiceRequestPriority `json:"priority"`
+}
+
+// Validate helps with validation of a ScreeningToolScoreBandInput
+func (b ScreeningToolScoreBandInput) Validate() error {
+	v := validator.New()
+	if err := v.Struct(b); err != nil {
+		return err
+	}
+
+	if !b.Action.IsValid() {
+		return fmt.Errorf("invalid score band action: %s", b.Action)
+	}
+	if b.MaxScore != nil && *b.MaxScore < b.MinScore {
+		return fmt.Errorf("the maximum score of score band %s should not be less than its minimum score", b.Label)
+	}
+	if b.Priority != nil && !b.Priority.IsValid() {
+		return fmt.Errorf("invalid score band priority: %s", *b.Priority)
+	}
+	if b.Action == enums.ScreeningToolBandActionCreateRedFlag && b.Priority == nil {
+		return fmt.Errorf("a priority is required for score band %s since it creates a red flag", b.Label)
+	}
+
+	return nil
 }
 
 // QuestionInput represents the input for a Question for a given screening tool in a questionnaire
@@ -687,7 +900,59 @@ type QuestionInput struct {
 	SelectMultiple    bool                            `json:"selectMultiple"`
 	Sequence          int                             `json:"sequence" validate:"required"`
 	Choices           []QuestionInputChoiceInput      `json:"choices"`
-	ProgramID         string                          `json:"programID"`
+	// CriticalItemThreshold is the score at or above which a response always raises a red flag
+	CriticalItemThreshold *int `json:"criticalItemThreshold"`
+	// Conditions determine whether the question is displayed based on the answers to other questions
+	Conditions []QuestionConditionInput `json:"conditions"`
+	ProgramID  string                   `json:"programID"`
+}
+
+// QuestionConditionInput represents a display condition of a question on the answer to another question
+// The question that is depended on is referenced by its sequence
+type QuestionConditionInput struct {
+	QuestionSequence int                             `json:"questionSequence" validate:"required"`
+	Operator         enums.QuestionConditionOperator `json:"operator" validate:"required"`
+	Target           enums.QuestionConditionTarget   `json:"target" validate:"required"`
+	Values           []string                        `json:"values" validate:"required,min=1"`
+}
+
+// Validate helps with validation of a QuestionConditionInput
+func (c QuestionConditionInput) Validate() error {
+	v := validator.New()
+	if err := v.Struct(c); err != nil {
+		return err
+	}
+
+	if !c.Operator.IsValid() {
+		return fmt.Errorf("invalid condition operator: %s", c.Operator)
+	}
+	if !c.Target.IsValid() {
+		return fmt.Errorf("invalid condition target: %s", c.Target)
+	}
+
+	switch c.Operator {
+	case enums.QuestionConditionOperatorEquals:
+		if len(c.Values) != 1 {
+			return fmt.Errorf("an equals condition should have exactly one value")
+		}
+	case enums.QuestionConditionOperatorGreaterThan:
+		if len(c.Values) != 1 {
+			return fmt.Errorf("a greater than condition should have exactly one value")
+		}
+		if _, err := strconv.Atoi(c.Values[0]); err != nil {
+			return fmt.Errorf("a greater than condition should have a numeric value")
+		}
+	}
+
+	if c.Target == enums.QuestionConditionTargetScore {
+		for _, value := range c.Values {
+			if _, err := strconv.Atoi(value); err != nil {
+				return fmt.Errorf("a score condition should have numeric values")
+			}
+		}
+	}
+
+	return nil
 }
 
 // Validate helps with validation of a question input
@@ -695,6 +960,10 @@ func (s QuestionInput) Validate() error {
 	v := validator.New()
 	err := v.Struct(s)
 
+	if s.CriticalItemThreshold != nil && *s.CriticalItemThreshold < 1 {
+		return fmt.Errorf("critical item threshold must be greater than zero")
+	}
+
 	// validate response value type against the the choice provided
 	for _, c := range s.Choices {
 		switch s.ResponseValueType {
@@ -814,3 +1083,296 @@ type UpdateProgramInput struct {
 	ProgramID    string `json:"programID"`
 	FHIRTenantID string `json:"fhirTenantID"`
 }
+
+// AuditLogFilterInput is used to filter the audit logs of an organisation
+type AuditLogFilterInput struct {
+	RecordType *enums.AuditLogRecordType `json:"recordType"`
+	ActorID    *string                   `json:"actorID"`
+	TargetID   *string                   `json:"targetID"`
+	ProgramID  *string                   `json:"programID"`
+	From       *time.Time                `json:"from"`
+	To         *time.Time                `json:"to"`
+}
+
+// AuthorityRoleInput is used to create or update a custom role in an organisation or program
+type AuthorityRoleInput struct {
+	Name        string   `json:"name" validate:"required"`
+	Description string   `json:"description"`
+	ProgramID   *string  `json:"programID"`
+	Permissions []string `json:"permissions" validate:"required,min=1"`
+}
+
+// Validate helps with validation of AuthorityRoleInput fields
+func (a *AuthorityRoleInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(a)
+
+	return err
+}
+
+// NotificationPreferenceInput is used to set the channels through which a user receives a type of notification
+type NotificationPreferenceInput struct {
+	NotificationType enums.NotificationType `json:"notificationType"`
+	PushEnabled      bool                   `json:"pushEnabled"`
+	SMSEnabled       bool                   `json:"smsEnabled"`
+}
+
+// AppointmentSlotInput is used to publish a weekly period during which a facility accepts appointment bookings
+type AppointmentSlotInput struct {
+	FacilityID string   `json:"facilityID" validate:"required"`
+	DayOfWeek  int      `json:"dayOfWeek" validate:"min=0,max=6"`
+	StartTime  string   `json:"startTime" validate:"required"`
+	EndTime    string   `json:"endTime" validate:"required"`
+	Capacity   int      `json:"capacity" validate:"required,min=1"`
+	Reasons    []string `json:"reasons" validate:"required,min=1,dive,required"`
+}
+
+// Validate helps with validation of appointment slot input fields.
+// The start and end times are expected in the 24 hour HH:MM format and the slot should end after it starts
+func (a *AppointmentSlotInput) Validate() error {
+	v := validator.New()
+
+	if err := v.Struct(a); err != nil {
+		return err
+	}
+
+	startTime, err := time.Parse("15:04", a.StartTime)
+	if err != nil {
+		return fmt.Errorf("invalid start time %s, expected the HH:MM format", a.StartTime)
+	}
+
+	endTime, err := time.Parse("15:04", a.EndTime)
+	if err != nil {
+		return fmt.Errorf("invalid end time %s, expected the HH:MM format", a.EndTime)
+	}
+
+	if !endTime.After(startTime) {
+		return fmt.Errorf("the end time of an appointment slot should be after its start time")
+	}
+
+	return nil
+}
+
+// ServiceRequestCommentInput is used to leave an internal comment on a service request or to reply to an existing comment
+type ServiceRequestCommentInput struct {
+	ServiceRequestID string  `json:"serviceRequestID" validate:"required"`
+	ParentID         *string `json:"parentID"`
+	Comment          string  `json:"comment" validate:"required"`
+}
+
+// Validate helps with validation of service request comment input fields
+func (s *ServiceRequestCommentInput) Validate() error {
+	s.Comment = strings.TrimSpace(s.Comment)
+
+	v := validator.New()
+	return v.Struct(s)
+}
+
+// HealthDiaryShareInput is used by a client to give a facility or one of their caregivers access to their health diary.
+// The scope decides whether the entire diary, the entries recorded within a date range or specific entries are shared
+type HealthDiaryShareInput struct {
+	ClientID    string                      `json:"clientID" validate:"required"`
+	FacilityID  *string                     `json:"facilityID"`
+	CaregiverID *string                     `json:"caregiverID"`
+	Scope       enums.HealthDiaryShareScope `json:"scope" validate:"required"`
+	From        *time.Time                  `json:"from"`
+	To          *time.Time                  `json:"to"`
+	EntryIDs    []string                    `json:"entryIDs"`
+	ExpiresAt   *time.Time                  `json:"expiresAt"`
+}
+
+// Validate helps with validation of health diary share input fields.
+// Exactly one of the facility and the caregiver should be provided and the fields required by the scope should be set
+func (h *HealthDiaryShareInput) Validate() error {
+	v := validator.New()
+
+	if err := v.Struct(h); err != nil {
+		return err
+	}
+
+	hasFacility := h.FacilityID != nil && *h.FacilityID != ""
+	hasCaregiver := h.CaregiverID != nil && *h.CaregiverID != ""
+	if hasFacility == hasCaregiver {
+		return fmt.Errorf("a health diary should be shared with either a facility or a caregiver")
+	}
+
+	if !h.Scope.IsValid() {
+		return fmt.Errorf("invalid health diary share scope: %s", h.Scope)
+	}
+
+	switch h.Scope {
+	case enums.HealthDiaryShareScopeDateRange:
+		if h.From == nil || h.To == nil {
+			return fmt.Errorf("the start and end dates are required when sharing a date range")
+		}
+		if !h.From.Before(*h.To) {
+			return fmt.Errorf("the start date of a shared date range should be before its end date")
+		}
+
+	case enums.HealthDiaryShareScopeEntries:
+		if len(h.EntryIDs) == 0 {
+			return fmt.Errorf("at least one health diary entry is required when sharing specific entries")
+		}
+	}
+
+	if h.ExpiresAt != nil && !h.ExpiresAt.After(time.Now()) {
+		return fmt.Errorf("the expiry of a health diary share should be in the future")
+	}
+
+	return nil
+}
+
+// SurveyCampaignInput is used to schedule a survey to be sent to the clients in a facility who match the filter params.
+// Recurring campaigns are sent out again after every recurrence period until they end
+type SurveyCampaignInput struct {
+	FacilityID           string                         `json:"facilityID" validate:"required"`
+	ProjectID            int                            `json:"projectID" validate:"required"`
+	FormID               string                         `json:"formID" validate:"required"`
+	FilterParams         *ClientFilterParamsInput       `json:"filterParams"`
+	ScheduledAt          time.Time                      `json:"scheduledAt" validate:"required"`
+	Recurrence           enums.SurveyCampaignRecurrence `json:"recurrence" validate:"required"`
+	EndsAt               *time.Time                     `json:"endsAt"`
+	LinkExpiryDays       int                            `json:"linkExpiryDays" validate:"required,min=1"`
+	ReminderIntervalDays *int                           `json:"reminderIntervalDays"`
+}
+
+// Validate helps with validation of survey campaign input fields.
+// Clients who have not responded can only be reminded before their survey link expires
+func (s *SurveyCampaignInput) Validate() error {
+	v := validator.New()
+
+	if err := v.Struct(s); err != nil {
+		return err
+	}
+
+	if !s.Recurrence.IsValid() {
+		return fmt.Errorf("invalid survey campaign recurrence: %s", s.Recurrence)
+	}
+
+	if s.EndsAt != nil && !s.EndsAt.After(s.ScheduledAt) {
+		return fmt.Errorf("a survey campaign should end after it is scheduled to start")
+	}
+
+	if s.ReminderIntervalDays != nil {
+		if *s.ReminderIntervalDays < 1 {
+			return fmt.Errorf("the reminder interval should be at least one day")
+		}
+		if *s.ReminderIntervalDays >= s.LinkExpiryDays {
+			return fmt.Errorf("the reminder interval should be shorter than the survey link expiry")
+		}
+	}
+
+	return nil
+}
+
+// SurveyRuleConditionInput is a condition on the answer to a field of a survey form
+type SurveyRuleConditionInput struct {
+	Field    string                   `json:"field" validate:"required"`
+	Operator enums.SurveyRuleOperator `json:"operator" validate:"required"`
+	Value    string                   `json:"value" validate:"required"`
+}
+
+// SurveyRedFlagRuleInput is used to define a rule that raises a red flag when a submission to a survey form matches its conditions
+type SurveyRedFlagRuleInput struct {
+	Name       string                      `json:"name" validate:"required"`
+	ProjectID  int                         `json:"projectID" validate:"required"`
+	FormID     string                      `json:"formID" validate:"required"`
+	Combinator enums.SurveyRuleCombinator  `json:"combinator" validate:"required"`
+	Conditions []*SurveyRuleConditionInput `json:"conditions" validate:"required,min=1,dive,required"`
+}
+
+// Validate helps with validation of survey red flag rule input fields.
+// Numeric operators can only be compared with numbers
+func (s *SurveyRedFlagRuleInput) Validate() error {
+	v := validator.New()
+
+	if err := v.Struct(s); err != nil {
+		return err
+	}
+
+	if !s.Combinator.IsValid() {
+		return fmt.Errorf("invalid survey rule combinator: %s", s.Combinator)
+	}
+
+	for _, condition := range s.Conditions {
+		if !condition.Operator.IsValid() {
+			return fmt.Errorf("invalid survey rule operator: %s", condition.Operator)
+		}
+
+		if condition.Operator.IsNumeric() {
+			if _, err := strconv.ParseFloat(strings.TrimSpace(condition.Value), 64); err != nil {
+				return fmt.Errorf("the value of %s should be a number to use the %s operator", condition.Field, condition.Operator)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CommunityCrisisKeywordInput is used to add a keyword that flags community messages that may indicate a crisis
+type CommunityCrisisKeywordInput struct {
+	Keyword  string                        `json:"keyword" validate:"required"`
+	IsRegex  bool                          `json:"isRegex"`
+	Category enums.CommunityCrisisCategory `json:"category" validate:"required"`
+}
+
+// Validate helps with validation of community crisis keyword input fields.
+// A keyword that is a regular expression must compile
+func (c *CommunityCrisisKeywordInput) Validate() error {
+	v := validator.New()
+
+	if err := v.Struct(c); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(c.Keyword) == "" {
+		return fmt.Errorf("a community crisis keyword can not be blank")
+	}
+
+	if !c.Category.IsValid() {
+		return fmt.Errorf("invalid community crisis category: %s", c.Category)
+	}
+
+	if c.IsRegex {
+		if _, err := regexp.Compile(c.Keyword); err != nil {
+			return fmt.Errorf("invalid community crisis keyword regular expression: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// MatrixTransaction is a batch of events pushed to the application service by the Matrix homeserver
+type MatrixTransaction struct {
+	Events []*MatrixEvent `json:"events"`
+}
+
+// MatrixEvent is an event that took place in a room the application service is interested in
+type MatrixEvent struct {
+	EventID string             `json:"event_id"`
+	Type    string             `json:"type"`
+	RoomID  string             `json:"room_id"`
+	Sender  string             `json:"sender"`
+	Content MatrixEventContent `json:"content"`
+}
+
+// MatrixEventContent is the content of a Matrix message event
+type MatrixEventContent struct {
+	MsgType string `json:"msgtype"`
+	Body    string `json:"body"`
+}
+
+// IsTextMessage returns true if the event is a text message sent to a room
+func (e *MatrixEvent) IsTextMessage() bool {
+	return e.Type == "m.room.message" && e.Content.MsgType == "m.text"
+}
+
+// SenderUsername returns the username of the event's sender from their fully qualified Matrix user ID
+func (e *MatrixEvent) SenderUsername() string {
+	username := strings.TrimPrefix(e.Sender, "@")
+	if i := strings.Index(username, ":"); i >= 0 {
+		username = username[:i]
+	}
+	return username
+}
@@ -2,6 +2,7 @@ package dto
 
 import (
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit"
 	"github.com/savannahghi/enumutils"
@@ -969,3 +970,1022 @@ func TestQuestionInput_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestAppointmentSlotInput_Validate(t *testing.T) {
+	type fields struct {
+		FacilityID string
+		DayOfWeek  int
+		StartTime  string
+		EndTime    string
+		Capacity   int
+		Reasons    []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+	}{
+		{
+			name: "valid: all params passed",
+			fields: fields{
+				FacilityID: gofakeit.UUID(),
+				DayOfWeek:  1,
+				StartTime:  "08:00",
+				EndTime:    "12:30",
+				Capacity:   20,
+				Reasons:    []string{"Pharmacy Visit"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid: day of week out of range",
+			fields: fields{
+				FacilityID: gofakeit.UUID(),
+				DayOfWeek:  7,
+				StartTime:  "08:00",
+				EndTime:    "12:30",
+				Capacity:   20,
+				Reasons:    []string{"Pharmacy Visit"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: no capacity",
+			fields: fields{
+				FacilityID: gofakeit.UUID(),
+				DayOfWeek:  1,
+				StartTime:  "08:00",
+				EndTime:    "12:30",
+				Reasons:    []string{"Pharmacy Visit"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: no reasons",
+			fields: fields{
+				FacilityID: gofakeit.UUID(),
+				DayOfWeek:  1,
+				StartTime:  "08:00",
+				EndTime:    "12:30",
+				Capacity:   20,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: invalid start time",
+			fields: fields{
+				FacilityID: gofakeit.UUID(),
+				DayOfWeek:  1,
+				StartTime:  "8am",
+				EndTime:    "12:30",
+				Capacity:   20,
+				Reasons:    []string{"Pharmacy Visit"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: invalid end time",
+			fields: fields{
+				FacilityID: gofakeit.UUID(),
+				DayOfWeek:  1,
+				StartTime:  "08:00",
+				EndTime:    "25:00",
+				Capacity:   20,
+				Reasons:    []string{"Pharmacy Visit"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: end time before start time",
+			fields: fields{
+				FacilityID: gofakeit.UUID(),
+				DayOfWeek:  1,
+				StartTime:  "12:00",
+				EndTime:    "08:00",
+				Capacity:   20,
+				Reasons:    []string{"Pharmacy Visit"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &AppointmentSlotInput{
+				FacilityID: tt.fields.FacilityID,
+				DayOfWeek:  tt.fields.DayOfWeek,
+				StartTime:  tt.fields.StartTime,
+				EndTime:    tt.fields.EndTime,
+				Capacity:   tt.fields.Capacity,
+				Reasons:    tt.fields.Reasons,
+			}
+			if err := a.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("AppointmentSlotInput.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServiceRequestCommentInput_Validate(t *testing.T) {
+	type fields struct {
+		ServiceRequestID string
+		Comment          string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+	}{
+		{
+			name: "valid: all params passed",
+			fields: fields{
+				ServiceRequestID: gofakeit.UUID(),
+				Comment:          "Called the client, they will come in tomorrow",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid: no service request",
+			fields: fields{
+				Comment: "Called the client, they will come in tomorrow",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: blank comment",
+			fields: fields{
+				ServiceRequestID: gofakeit.UUID(),
+				Comment:          "   ",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &ServiceRequestCommentInput{
+				ServiceRequestID: tt.fields.ServiceRequestID,
+				Comment:          tt.fields.Comment,
+			}
+			if err := s.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("ServiceRequestCommentInput.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestScreeningToolInput_Validate(t *testing.T) {
+	choice1 := "yes"
+	choice2 := "no"
+	criticalItemThreshold := 1
+	mildMax := 4
+	moderateMax := 9
+	priority := enums.ServiceRequestPriorityHigh
+	fortnight := 14
+	worseningThreshold := 5
+	noWorsening := 0
+
+	questionnaire := QuestionnaireInput{
+		Name:        gofakeit.BeerBlg(),
+		Description: gofakeit.BeerBlg(),
+		Questions: []*QuestionInput{
+			{
+				Text:                  gofakeit.BeerBlg(),
+				QuestionType:          enums.QuestionTypeCloseEnded,
+				ResponseValueType:     enums.QuestionResponseValueTypeBoolean,
+				Required:              true,
+				Sequence:              1,
+				CriticalItemThreshold: &criticalItemThreshold,
+				Choices: []QuestionInputChoiceInput{
+					{
+						Choice: &choice1,
+						Value:  "true",
+						Score:  1,
+					},
+					{
+						Choice: &choice2,
+						Value:  "false",
+						Score:  0,
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		input   ScreeningToolInput
+		wantErr bool
+	}{
+		{
+			name: "Happy case: valid score bands",
+			input: ScreeningToolInput{
+				Questionnaire: questionnaire,
+				ScoreBands: []*ScreeningToolScoreBandInput{
+					{Label: "Severe", MinScore: 10, Action: enums.ScreeningToolBandActionCreateRedFlag, Priority: &priority},
+					{Label: "Mild", MinScore: 0, MaxScore: &mildMax, Action: enums.ScreeningToolBandActionNone},
+					{Label: "Moderate", MinScore: 5, MaxScore: &moderateMax, Action: enums.ScreeningToolBandActionNotifyStaff},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: no score bands",
+			input: ScreeningToolInput{
+				Questionnaire: questionnaire,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: overlapping score bands",
+			input: ScreeningToolInput{
+				Questionnaire: questionnaire,
+				ScoreBands: []*ScreeningToolScoreBandInput{
+					{Label: "Mild", MinScore: 0, MaxScore: &moderateMax, Action: enums.ScreeningToolBandActionNone},
+					{Label: "Moderate", MinScore: 5, MaxScore: &moderateMax, Action: enums.ScreeningToolBandActionNotifyStaff},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: open ended band below another band",
+			input: ScreeningToolInput{
+				Questionnaire: questionnaire,
+				ScoreBands: []*ScreeningToolScoreBandInput{
+					{Label: "Mild", MinScore: 0, Action: enums.ScreeningToolBandActionNone},
+					{Label: "Severe", MinScore: 10, Action: enums.ScreeningToolBandActionCreateRedFlag, Priority: &priority},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: maximum score less than minimum score",
+			input: ScreeningToolInput{
+				Questionnaire: questionnaire,
+				ScoreBands: []*ScreeningToolScoreBandInput{
+					{Label: "Moderate", MinScore: 5, MaxScore: &mildMax, Action: enums.ScreeningToolBandActionNotifyStaff},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: red flag band without a priority",
+			input: ScreeningToolInput{
+				Questionnaire: questionnaire,
+				ScoreBands: []*ScreeningToolScoreBandInput{
+					{Label: "Severe", MinScore: 10, Action: enums.ScreeningToolBandActionCreateRedFlag},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid band action",
+			input: ScreeningToolInput{
+				Questionnaire: questionnaire,
+				ScoreBands: []*ScreeningToolScoreBandInput{
+					{Label: "Severe", MinScore: 10, Action: enums.ScreeningToolBandAction("INVALID")},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: band without a label",
+			input: ScreeningToolInput{
+				Questionnaire: questionnaire,
+				ScoreBands: []*ScreeningToolScoreBandInput{
+					{MinScore: 0, Action: enums.ScreeningToolBandActionNone},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Happy case: recurring screening tool",
+			input: ScreeningToolInput{
+				Questionnaire: questionnaire,
+				Recurrence: &ScreeningToolRecurrenceInput{
+					Recurrence:   enums.ScreeningToolRecurrenceInterval,
+					IntervalDays: &fortnight,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid recurrence",
+			input: ScreeningToolInput{
+				Questionnaire: questionnaire,
+				Recurrence: &ScreeningToolRecurrenceInput{
+					Recurrence: enums.ScreeningToolRecurrenceInterval,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Happy case: screening tool with a worsening threshold",
+			input: ScreeningToolInput{
+				Questionnaire:           questionnaire,
+				ScoreWorseningThreshold: &worseningThreshold,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: worsening threshold less than one",
+			input: ScreeningToolInput{
+				Questionnaire:           questionnaire,
+				ScoreWorseningThreshold: &noWorsening,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: open ended critical item",
+			input: ScreeningToolInput{
+				Questionnaire: QuestionnaireInput{
+					Name:        gofakeit.BeerBlg(),
+					Description: gofakeit.BeerBlg(),
+					Questions: []*QuestionInput{
+						{
+							Text:                  gofakeit.BeerBlg(),
+							QuestionType:          enums.QuestionTypeOpenEnded,
+							ResponseValueType:     enums.QuestionResponseValueTypeString,
+							Required:              true,
+							Sequence:              1,
+							CriticalItemThreshold: &criticalItemThreshold,
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("ScreeningToolInput.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestScreeningToolRecurrenceInput_Validate(t *testing.T) {
+	fortnight := 14
+	zero := 0
+
+	tests := []struct {
+		name    string
+		input   ScreeningToolRecurrenceInput
+		wantErr bool
+	}{
+		{
+			name: "Happy case: recurs after an interval",
+			input: ScreeningToolRecurrenceInput{
+				Recurrence:   enums.ScreeningToolRecurrenceInterval,
+				IntervalDays: &fortnight,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: once only",
+			input: ScreeningToolRecurrenceInput{
+				Recurrence: enums.ScreeningToolRecurrenceOnce,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: assigned only",
+			input: ScreeningToolRecurrenceInput{
+				Recurrence: enums.ScreeningToolRecurrenceAssigned,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: interval without days",
+			input: ScreeningToolRecurrenceInput{
+				Recurrence: enums.ScreeningToolRecurrenceInterval,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: interval of zero days",
+			input: ScreeningToolRecurrenceInput{
+				Recurrence:   enums.ScreeningToolRecurrenceInterval,
+				IntervalDays: &zero,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: once only with an interval",
+			input: ScreeningToolRecurrenceInput{
+				Recurrence:   enums.ScreeningToolRecurrenceOnce,
+				IntervalDays: &fortnight,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid recurrence",
+			input: ScreeningToolRecurrenceInput{
+				Recurrence: enums.ScreeningToolRecurrence("WEEKLY"),
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: missing recurrence",
+			input:   ScreeningToolRecurrenceInput{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("ScreeningToolRecurrenceInput.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestScreeningToolAssignmentInput_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   ScreeningToolAssignmentInput
+		wantErr bool
+	}{
+		{
+			name: "Happy case: due in a week",
+			input: ScreeningToolAssignmentInput{
+				ScreeningToolID: gofakeit.UUID(),
+				ClientID:        gofakeit.UUID(),
+				DueDate:         time.Now().AddDate(0, 0, 7),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: due date in the past",
+			input: ScreeningToolAssignmentInput{
+				ScreeningToolID: gofakeit.UUID(),
+				ClientID:        gofakeit.UUID(),
+				DueDate:         time.Now().AddDate(0, 0, -1),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: missing client",
+			input: ScreeningToolAssignmentInput{
+				ScreeningToolID: gofakeit.UUID(),
+				DueDate:         time.Now().AddDate(0, 0, 7),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("ScreeningToolAssignmentInput.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestQuestionnaireInput_Validate_Conditions(t *testing.T) {
+	yes := "1"
+	no := "0"
+	choices := []QuestionInputChoiceInput{
+		{Choice: &no, Value: "0", Score: 0},
+		{Choice: &yes, Value: "1", Score: 1},
+	}
+
+	question := func(sequence int, conditions ...QuestionConditionInput) *QuestionInput {
+		return &QuestionInput{
+			Text:              gofakeit.BeerBlg(),
+			QuestionType:      enums.QuestionTypeCloseEnded,
+			ResponseValueType: enums.QuestionResponseValueTypeNumber,
+			Required:          true,
+			Sequence:          sequence,
+			Choices:           choices,
+			Conditions:        conditions,
+		}
+	}
+	equals := func(sequence int, value string) QuestionConditionInput {
+		return QuestionConditionInput{
+			QuestionSequence: sequence,
+			Operator:         enums.QuestionConditionOperatorEquals,
+			Target:           enums.QuestionConditionTargetChoice,
+			Values:           []string{value},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		questions []*QuestionInput
+		wantErr   bool
+	}{
+		{
+			name:      "Happy case: chained conditions",
+			questions: []*QuestionInput{question(1), question(2, equals(1, "1")), question(3, equals(2, "1"))},
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: score condition",
+			questions: []*QuestionInput{question(1), question(2, QuestionConditionInput{
+				QuestionSequence: 1,
+				Operator:         enums.QuestionConditionOperatorGreaterThan,
+				Target:           enums.QuestionConditionTargetScore,
+				Values:           []string{"0"},
+			})},
+			wantErr: false,
+		},
+		{
+			name:      "Sad case: dangling condition",
+			questions: []*QuestionInput{question(1), question(2, equals(5, "1"))},
+			wantErr:   true,
+		},
+		{
+			name:      "Sad case: condition on itself",
+			questions: []*QuestionInput{question(1, equals(1, "1"))},
+			wantErr:   true,
+		},
+		{
+			name:      "Sad case: cyclic conditions",
+			questions: []*QuestionInput{question(1, equals(3, "1")), question(2, equals(1, "1")), question(3, equals(2, "1"))},
+			wantErr:   true,
+		},
+		{
+			name:      "Sad case: condition on a choice that does not exist",
+			questions: []*QuestionInput{question(1), question(2, equals(1, "7"))},
+			wantErr:   true,
+		},
+		{
+			name: "Sad case: condition on an open ended question",
+			questions: []*QuestionInput{
+				{
+					Text:              gofakeit.BeerBlg(),
+					QuestionType:      enums.QuestionTypeOpenEnded,
+					ResponseValueType: enums.QuestionResponseValueTypeString,
+					Required:          true,
+					Sequence:          1,
+				},
+				question(2, equals(1, "1")),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: non numeric greater than condition",
+			questions: []*QuestionInput{question(1), question(2, QuestionConditionInput{
+				QuestionSequence: 1,
+				Operator:         enums.QuestionConditionOperatorGreaterThan,
+				Target:           enums.QuestionConditionTargetChoice,
+				Values:           []string{"yes"},
+			})},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: equals condition with many values",
+			questions: []*QuestionInput{question(1), question(2, QuestionConditionInput{
+				QuestionSequence: 1,
+				Operator:         enums.QuestionConditionOperatorEquals,
+				Target:           enums.QuestionConditionTargetChoice,
+				Values:           []string{"0", "1"},
+			})},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: condition without values",
+			questions: []*QuestionInput{question(1), question(2, QuestionConditionInput{
+				QuestionSequence: 1,
+				Operator:         enums.QuestionConditionOperatorIn,
+				Target:           enums.QuestionConditionTargetChoice,
+			})},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := QuestionnaireInput{
+				Name:        gofakeit.BeerBlg(),
+				Description: gofakeit.BeerBlg(),
+				Questions:   tt.questions,
+			}
+			if err := q.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("QuestionnaireInput.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHealthDiaryShareInput_Validate(t *testing.T) {
+	facilityID := gofakeit.UUID()
+	caregiverID := gofakeit.UUID()
+	from := time.Now().AddDate(0, 0, -7)
+	to := time.Now()
+	future := time.Now().AddDate(0, 1, 0)
+	past := time.Now().AddDate(0, 0, -1)
+
+	tests := []struct {
+		name    string
+		input   HealthDiaryShareInput
+		wantErr bool
+	}{
+		{
+			name: "valid: share entire diary with a facility",
+			input: HealthDiaryShareInput{
+				ClientID:   gofakeit.UUID(),
+				FacilityID: &facilityID,
+				Scope:      enums.HealthDiaryShareScopeEntireDiary,
+				ExpiresAt:  &future,
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid: share a date range with a caregiver",
+			input: HealthDiaryShareInput{
+				ClientID:    gofakeit.UUID(),
+				CaregiverID: &caregiverID,
+				Scope:       enums.HealthDiaryShareScopeDateRange,
+				From:        &from,
+				To:          &to,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid: missing client ID",
+			input: HealthDiaryShareInput{
+				FacilityID: &facilityID,
+				Scope:      enums.HealthDiaryShareScopeEntireDiary,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: no grantee",
+			input: HealthDiaryShareInput{
+				ClientID: gofakeit.UUID(),
+				Scope:    enums.HealthDiaryShareScopeEntireDiary,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: both a facility and a caregiver",
+			input: HealthDiaryShareInput{
+				ClientID:    gofakeit.UUID(),
+				FacilityID:  &facilityID,
+				CaregiverID: &caregiverID,
+				Scope:       enums.HealthDiaryShareScopeEntireDiary,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: invalid scope",
+			input: HealthDiaryShareInput{
+				ClientID:   gofakeit.UUID(),
+				FacilityID: &facilityID,
+				Scope:      enums.HealthDiaryShareScope("invalid"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: date range without dates",
+			input: HealthDiaryShareInput{
+				ClientID:   gofakeit.UUID(),
+				FacilityID: &facilityID,
+				Scope:      enums.HealthDiaryShareScopeDateRange,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: date range ends before it starts",
+			input: HealthDiaryShareInput{
+				ClientID:   gofakeit.UUID(),
+				FacilityID: &facilityID,
+				Scope:      enums.HealthDiaryShareScopeDateRange,
+				From:       &to,
+				To:         &from,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: entries scope without entries",
+			input: HealthDiaryShareInput{
+				ClientID:   gofakeit.UUID(),
+				FacilityID: &facilityID,
+				Scope:      enums.HealthDiaryShareScopeEntries,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: expiry in the past",
+			input: HealthDiaryShareInput{
+				ClientID:   gofakeit.UUID(),
+				FacilityID: &facilityID,
+				Scope:      enums.HealthDiaryShareScopeEntireDiary,
+				ExpiresAt:  &past,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("HealthDiaryShareInput.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSurveyCampaignInput_Validate(t *testing.T) {
+	scheduledAt := time.Now().Add(time.Hour)
+	endsAt := scheduledAt.AddDate(0, 6, 0)
+	endsEarly := scheduledAt.Add(-time.Hour)
+	reminderInterval := 2
+	longReminderInterval := 7
+	zeroReminderInterval := 0
+
+	tests := []struct {
+		name    string
+		input   SurveyCampaignInput
+		wantErr bool
+	}{
+		{
+			name: "valid: one off campaign",
+			input: SurveyCampaignInput{
+				FacilityID:     gofakeit.UUID(),
+				ProjectID:      1,
+				FormID:         gofakeit.UUID(),
+				ScheduledAt:    scheduledAt,
+				Recurrence:     enums.SurveyCampaignRecurrenceOnce,
+				LinkExpiryDays: 7,
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid: monthly campaign with reminders",
+			input: SurveyCampaignInput{
+				FacilityID: gofakeit.UUID(),
+				ProjectID:  1,
+				FormID:     gofakeit.UUID(),
+				FilterParams: &ClientFilterParamsInput{
+					ClientTypes: []enums.ClientType{enums.ClientTypePmtct},
+				},
+				ScheduledAt:          scheduledAt,
+				Recurrence:           enums.SurveyCampaignRecurrenceMonthly,
+				EndsAt:               &endsAt,
+				LinkExpiryDays:       7,
+				ReminderIntervalDays: &reminderInterval,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid: missing form ID",
+			input: SurveyCampaignInput{
+				FacilityID:     gofakeit.UUID(),
+				ProjectID:      1,
+				ScheduledAt:    scheduledAt,
+				Recurrence:     enums.SurveyCampaignRecurrenceOnce,
+				LinkExpiryDays: 7,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: missing link expiry",
+			input: SurveyCampaignInput{
+				FacilityID:  gofakeit.UUID(),
+				ProjectID:   1,
+				FormID:      gofakeit.UUID(),
+				ScheduledAt: scheduledAt,
+				Recurrence:  enums.SurveyCampaignRecurrenceOnce,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: invalid recurrence",
+			input: SurveyCampaignInput{
+				FacilityID:     gofakeit.UUID(),
+				ProjectID:      1,
+				FormID:         gofakeit.UUID(),
+				ScheduledAt:    scheduledAt,
+				Recurrence:     enums.SurveyCampaignRecurrence("HOURLY"),
+				LinkExpiryDays: 7,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: ends before it starts",
+			input: SurveyCampaignInput{
+				FacilityID:     gofakeit.UUID(),
+				ProjectID:      1,
+				FormID:         gofakeit.UUID(),
+				ScheduledAt:    scheduledAt,
+				Recurrence:     enums.SurveyCampaignRecurrenceWeekly,
+				EndsAt:         &endsEarly,
+				LinkExpiryDays: 7,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: reminder interval less than a day",
+			input: SurveyCampaignInput{
+				FacilityID:           gofakeit.UUID(),
+				ProjectID:            1,
+				FormID:               gofakeit.UUID(),
+				ScheduledAt:          scheduledAt,
+				Recurrence:           enums.SurveyCampaignRecurrenceOnce,
+				LinkExpiryDays:       7,
+				ReminderIntervalDays: &zeroReminderInterval,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: reminder interval longer than the link expiry",
+			input: SurveyCampaignInput{
+				FacilityID:           gofakeit.UUID(),
+				ProjectID:            1,
+				FormID:               gofakeit.UUID(),
+				ScheduledAt:          scheduledAt,
+				Recurrence:           enums.SurveyCampaignRecurrenceOnce,
+				LinkExpiryDays:       7,
+				ReminderIntervalDays: &longReminderInterval,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("SurveyCampaignInput.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSurveyRedFlagRuleInput_Validate(t *testing.T) {
+	unsafe := &SurveyRuleConditionInput{Field: "feeling_unsafe", Operator: enums.SurveyRuleOperatorEquals, Value: "yes"}
+	highScore := &SurveyRuleConditionInput{Field: "phq_score", Operator: enums.SurveyRuleOperatorGreaterThanOrEqualTo, Value: "20"}
+
+	tests := []struct {
+		name    string
+		input   SurveyRedFlagRuleInput
+		wantErr bool
+	}{
+		{
+			name: "valid: rule with text and numeric conditions",
+			input: SurveyRedFlagRuleInput{
+				Name:       "Unsafe with a high score",
+				ProjectID:  1,
+				FormID:     gofakeit.UUID(),
+				Combinator: enums.SurveyRuleCombinatorAnd,
+				Conditions: []*SurveyRuleConditionInput{unsafe, highScore},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid: rule without conditions",
+			input: SurveyRedFlagRuleInput{
+				Name:       "Empty rule",
+				ProjectID:  1,
+				FormID:     gofakeit.UUID(),
+				Combinator: enums.SurveyRuleCombinatorOr,
+				Conditions: []*SurveyRuleConditionInput{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: condition without a field",
+			input: SurveyRedFlagRuleInput{
+				Name:       "Missing field",
+				ProjectID:  1,
+				FormID:     gofakeit.UUID(),
+				Combinator: enums.SurveyRuleCombinatorOr,
+				Conditions: []*SurveyRuleConditionInput{{Operator: enums.SurveyRuleOperatorEquals, Value: "yes"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: combinator",
+			input: SurveyRedFlagRuleInput{
+				Name:       "Invalid combinator",
+				ProjectID:  1,
+				FormID:     gofakeit.UUID(),
+				Combinator: enums.SurveyRuleCombinator("XOR"),
+				Conditions: []*SurveyRuleConditionInput{unsafe},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: operator",
+			input: SurveyRedFlagRuleInput{
+				Name:       "Invalid operator",
+				ProjectID:  1,
+				FormID:     gofakeit.UUID(),
+				Combinator: enums.SurveyRuleCombinatorAnd,
+				Conditions: []*SurveyRuleConditionInput{{Field: "feeling_unsafe", Operator: enums.SurveyRuleOperator("LIKE"), Value: "yes"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid: numeric operator with a text value",
+			input: SurveyRedFlagRuleInput{
+				Name:       "Text score",
+				ProjectID:  1,
+				FormID:     gofakeit.UUID(),
+				Combinator: enums.SurveyRuleCombinatorAnd,
+				Conditions: []*SurveyRuleConditionInput{{Field: "phq_score", Operator: enums.SurveyRuleOperatorLessThan, Value: "high"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("SurveyRedFlagRuleInput.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCommunityCrisisKeywordInput_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   CommunityCrisisKeywordInput
+		wantErr bool
+	}{
+		{
+			name:    "valid: phrase",
+			input:   CommunityCrisisKeywordInput{Keyword: "end my life", Category: enums.CommunityCrisisCategorySelfHarm},
+			wantErr: false,
+		},
+		{
+			name:    "valid: regular expression",
+			input:   CommunityCrisisKeywordInput{Keyword: `(beat|hit)s? me`, IsRegex: true, Category: enums.CommunityCrisisCategoryAbuse},
+			wantErr: false,
+		},
+		{
+			name:    "invalid: blank keyword",
+			input:   CommunityCrisisKeywordInput{Keyword: "  ", Category: enums.CommunityCrisisCategoryOther},
+			wantErr: true,
+		},
+		{
+			name:    "invalid: missing category",
+			input:   CommunityCrisisKeywordInput{Keyword: "hopeless"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid: unknown category",
+			input:   CommunityCrisisKeywordInput{Keyword: "hopeless", Category: enums.CommunityCrisisCategory("INVALID")},
+			wantErr: true,
+		},
+		{
+			name:    "invalid: regular expression does not compile",
+			input:   CommunityCrisisKeywordInput{Keyword: `(beat`, IsRegex: true, Category: enums.CommunityCrisisCategoryAbuse},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("CommunityCrisisKeywordInput.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMatrixEvent(t *testing.T) {
+	tests := []struct {
+		name            string
+		event           MatrixEvent
+		wantTextMessage bool
+		wantUsername    string
+	}{
+		{
+			name: "text message",
+			event: MatrixEvent{
+				Type:    "m.room.message",
+				Sender:  "@jane:example.com",
+				Content: MatrixEventContent{MsgType: "m.text", Body: "hello"},
+			},
+			wantTextMessage: true,
+			wantUsername:    "jane",
+		},
+		{
+			name: "image message",
+			event: MatrixEvent{
+				Type:    "m.room.message",
+				Sender:  "@jane:example.com",
+				Content: MatrixEventContent{MsgType: "m.image"},
+			},
+			wantTextMessage: false,
+			wantUsername:    "jane",
+		},
+		{
+			name: "membership event",
+			event: MatrixEvent{
+				Type:   "m.room.member",
+				Sender: "john",
+			},
+			wantTextMessage: false,
+			wantUsername:    "john",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.event.IsTextMessage(); got != tt.wantTextMessage {
+				t.Errorf("MatrixEvent.IsTextMessage() = %v, want %v", got, tt.wantTextMessage)
+			}
+			if got := tt.event.SenderUsername(); got != tt.wantUsername {
+				t.Errorf("MatrixEvent.SenderUsername() = %v, want %v", got, tt.wantUsername)
+			}
+		})
+	}
+}
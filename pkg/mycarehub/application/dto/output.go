@@ -425,3 +425,12 @@ type MatrixUserRegistrationOutput struct {
 	AccessToken string `json:"access_token"`
 	DeviceID    string `json:"device_id"`
 }
+
+// HealthDiaryExportOutput is the exported file of a client's health diary entries. The content is base64 encoded
+type HealthDiaryExportOutput struct {
+	FileName     string                        `json:"fileName"`
+	ContentType  string                        `json:"contentType"`
+	Format       enums.HealthDiaryExportFormat `json:"format"`
+	Content      string                        `json:"content"`
+	TotalEntries int                           `json:"totalEntries"`
+}
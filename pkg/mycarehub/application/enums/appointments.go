@@ -0,0 +1,31 @@
+package enums
+
+// AppointmentServiceRequestAction is the change to an appointment that KenyaEMR is asked to make through an appointment service request
+type AppointmentServiceRequestAction string
+
+const (
+	// AppointmentServiceRequestActionReschedule means that the client has asked to move an existing appointment to another date
+	AppointmentServiceRequestActionReschedule AppointmentServiceRequestAction = "RESCHEDULE"
+
+	// AppointmentServiceRequestActionBook means that the client has booked a new appointment against a facility slot
+	AppointmentServiceRequestActionBook AppointmentServiceRequestAction = "BOOK"
+
+	// AppointmentServiceRequestActionCancel means that the client has cancelled an appointment they had booked
+	AppointmentServiceRequestActionCancel AppointmentServiceRequestAction = "CANCEL"
+)
+
+// IsValid returns true if an appointment service request action is valid
+func (a AppointmentServiceRequestAction) IsValid() bool {
+	switch a {
+	case AppointmentServiceRequestActionReschedule,
+		AppointmentServiceRequestActionBook,
+		AppointmentServiceRequestActionCancel:
+		return true
+	}
+	return false
+}
+
+// String converts the appointment service request action enum to a string
+func (a AppointmentServiceRequestAction) String() string {
+	return string(a)
+}
@@ -0,0 +1,35 @@
+package enums
+
+import "testing"
+
+func TestAppointmentServiceRequestAction_IsValid(t *testing.T) {
+	tests := []struct {
+		name string
+		a    AppointmentServiceRequestAction
+		want bool
+	}{
+		{
+			name: "Happy Case - Valid action",
+			a:    AppointmentServiceRequestActionBook,
+			want: true,
+		},
+		{
+			name: "Sad Case - Invalid action",
+			a:    AppointmentServiceRequestAction("INVALID"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.IsValid(); got != tt.want {
+				t.Errorf("AppointmentServiceRequestAction.IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppointmentServiceRequestAction_String(t *testing.T) {
+	if got := AppointmentServiceRequestActionCancel.String(); got != "CANCEL" {
+		t.Errorf("AppointmentServiceRequestAction.String() = %v, want %v", got, "CANCEL")
+	}
+}
@@ -0,0 +1,68 @@
+package enums
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// AuditLogRecordType is a list of all the sensitive changes that are recorded in the audit log
+type AuditLogRecordType string
+
+const (
+	// AuditLogRecordTypePINReset represents the resetting of a user's PIN
+	AuditLogRecordTypePINReset AuditLogRecordType = "PIN_RESET"
+	// AuditLogRecordTypeClientFacilityTransfer represents the transfer of a client to a different facility
+	AuditLogRecordTypeClientFacilityTransfer AuditLogRecordType = "CLIENT_FACILITY_TRANSFER"
+	// AuditLogRecordTypeUserDeletion represents the deletion of a user
+	AuditLogRecordTypeUserDeletion AuditLogRecordType = "USER_DELETION"
+	// AuditLogRecordTypeCaregiverConsent represents a change in the consent between a client and their caregiver
+	AuditLogRecordTypeCaregiverConsent AuditLogRecordType = "CAREGIVER_CONSENT"
+	// AuditLogRecordTypeRoleAssignment represents the assignment or revocation of a user's role
+	AuditLogRecordTypeRoleAssignment AuditLogRecordType = "ROLE_ASSIGNMENT"
+	// AuditLogRecordTypeFacilityInactivation represents the inactivation of a facility
+	AuditLogRecordTypeFacilityInactivation AuditLogRecordType = "FACILITY_INACTIVATION"
+	// AuditLogRecordTypeHealthDiaryShare represents the sharing of a client's health diary
+	AuditLogRecordTypeHealthDiaryShare AuditLogRecordType = "HEALTH_DIARY_SHARE"
+	// AuditLogRecordTypeHealthDiaryShareRevocation represents the revocation of access to a client's health diary
+	AuditLogRecordTypeHealthDiaryShareRevocation AuditLogRecordType = "HEALTH_DIARY_SHARE_REVOCATION"
+)
+
+// IsValid returns true if an audit log record type is valid
+func (a AuditLogRecordType) IsValid() bool {
+	switch a {
+	case AuditLogRecordTypePINReset,
+		AuditLogRecordTypeClientFacilityTransfer,
+		AuditLogRecordTypeUserDeletion,
+		AuditLogRecordTypeCaregiverConsent,
+		AuditLogRecordTypeRoleAssignment,
+		AuditLogRecordTypeFacilityInactivation,
+		AuditLogRecordTypeHealthDiaryShare,
+		AuditLogRecordTypeHealthDiaryShareRevocation:
+		return true
+	}
+	return false
+}
+
+func (a AuditLogRecordType) String() string {
+	return string(a)
+}
+
+// UnmarshalGQL converts the supplied value to an audit log record type.
+func (a *AuditLogRecordType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*a = AuditLogRecordType(str)
+	if !a.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditLogRecordType", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the audit log record type to the supplied writer
+func (a AuditLogRecordType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(a.String()))
+}
@@ -0,0 +1,114 @@
+package enums
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestAuditLogRecordType_IsValid(t *testing.T) {
+	tests := []struct {
+		name string
+		f    AuditLogRecordType
+		want bool
+	}{
+		{
+			name: "valid type",
+			f:    AuditLogRecordTypePINReset,
+			want: true,
+		},
+		{
+			name: "invalid type",
+			f:    AuditLogRecordType("invalid"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.IsValid(); got != tt.want {
+				t.Errorf("AuditLogRecordType.IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuditLogRecordType_String(t *testing.T) {
+	tests := []struct {
+		name string
+		f    AuditLogRecordType
+		want string
+	}{
+		{
+			name: "PIN_RESET",
+			f:    AuditLogRecordTypePINReset,
+			want: "PIN_RESET",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.String(); got != tt.want {
+				t.Errorf("AuditLogRecordType.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuditLogRecordType_UnmarshalGQL(t *testing.T) {
+	validValue := AuditLogRecordTypePINReset
+	invalidValue := AuditLogRecordType("invalid")
+	type args struct {
+		v interface{}
+	}
+	tests := []struct {
+		name    string
+		f       *AuditLogRecordType
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "valid type",
+			f:    &validValue,
+			args: args{
+				v: "PIN_RESET",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid type",
+			f:    &invalidValue,
+			args: args{
+				v: "invalid",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.f.UnmarshalGQL(tt.args.v); (err != nil) != tt.wantErr {
+				t.Errorf("AuditLogRecordType.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAuditLogRecordType_MarshalGQL(t *testing.T) {
+	tests := []struct {
+		name  string
+		f     AuditLogRecordType
+		wantW string
+	}{
+		{
+			name:  "PIN_RESET",
+			f:     AuditLogRecordTypePINReset,
+			wantW: `"PIN_RESET"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.f.MarshalGQL(w)
+			if gotW := w.String(); gotW != tt.wantW {
+				t.Errorf("AuditLogRecordType.MarshalGQL() = %v, want %v", gotW, tt.wantW)
+			}
+		})
+	}
+}
@@ -101,3 +101,48 @@ func (p *Preset) UnmarshalGQL(v interface{}) error {
 func (p Preset) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(p.String()))
 }
+
+// CommunityCrisisCategory is the kind of crisis that a community crisis keyword looks out for
+type CommunityCrisisCategory string
+
+const (
+	// CommunityCrisisCategorySelfHarm is a keyword for messages about suicide or self-harm
+	CommunityCrisisCategorySelfHarm CommunityCrisisCategory = "SELF_HARM"
+	// CommunityCrisisCategoryAbuse is a keyword for messages about physical, sexual or emotional abuse
+	CommunityCrisisCategoryAbuse CommunityCrisisCategory = "ABUSE"
+	// CommunityCrisisCategoryOther is a keyword for messages about any other crisis
+	CommunityCrisisCategoryOther CommunityCrisisCategory = "OTHER"
+)
+
+// IsValid returns true if a CommunityCrisisCategory is valid
+func (c CommunityCrisisCategory) IsValid() bool {
+	switch c {
+	case CommunityCrisisCategorySelfHarm, CommunityCrisisCategoryAbuse, CommunityCrisisCategoryOther:
+		return true
+	}
+	return false
+}
+
+// String converts the CommunityCrisisCategory to a string
+func (c CommunityCrisisCategory) String() string {
+	return string(c)
+}
+
+// UnmarshalGQL converts the supplied value to a CommunityCrisisCategory
+func (c *CommunityCrisisCategory) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = CommunityCrisisCategory(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid CommunityCrisisCategory", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the CommunityCrisisCategory to the supplied writer
+func (c CommunityCrisisCategory) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
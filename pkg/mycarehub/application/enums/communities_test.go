@@ -237,3 +237,69 @@ func TestPreset_MarshalGQL(t *testing.T) {
 		})
 	}
 }
+
+func TestCommunityCrisisCategory_IsValid(t *testing.T) {
+	tests := []struct {
+		name string
+		c    CommunityCrisisCategory
+		want bool
+	}{
+		{
+			name: "Happy Case - Valid category",
+			c:    CommunityCrisisCategorySelfHarm,
+			want: true,
+		},
+		{
+			name: "Sad Case - Invalid category",
+			c:    CommunityCrisisCategory("INVALID"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.IsValid(); got != tt.want {
+				t.Errorf("CommunityCrisisCategory.IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommunityCrisisCategory_UnmarshalGQL(t *testing.T) {
+	category := CommunityCrisisCategoryOther
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr bool
+	}{
+		{
+			name:    "Happy Case - Valid category",
+			v:       CommunityCrisisCategoryAbuse.String(),
+			wantErr: false,
+		},
+		{
+			name:    "Sad Case - Invalid category",
+			v:       "INVALID",
+			wantErr: true,
+		},
+		{
+			name:    "Sad Case - Non string category",
+			v:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := category.UnmarshalGQL(tt.v); (err != nil) != tt.wantErr {
+				t.Errorf("CommunityCrisisCategory.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCommunityCrisisCategory_MarshalGQL(t *testing.T) {
+	w := &bytes.Buffer{}
+	CommunityCrisisCategorySelfHarm.MarshalGQL(w)
+	if got := w.String(); got != strconv.Quote("SELF_HARM") {
+		t.Errorf("CommunityCrisisCategory.MarshalGQL() = %v, want %v", got, strconv.Quote("SELF_HARM"))
+	}
+}
@@ -40,6 +40,29 @@ func (m Mood) String() string {
 	return string(m)
 }
 
+// Score ranks a mood from 1 for VERY_SAD to 5 for VERY_HAPPY so that moods can be averaged and compared.
+// Invalid moods have a score of 0
+func (m Mood) Score() int {
+	switch m {
+	case MoodVerySad:
+		return 1
+	case MoodSad:
+		return 2
+	case MoodNeutral:
+		return 3
+	case MoodHappy:
+		return 4
+	case MoodVeryHappy:
+		return 5
+	}
+	return 0
+}
+
+// IsSad returns true if the mood is SAD or VERY_SAD
+func (m Mood) IsSad() bool {
+	return m == MoodSad || m == MoodVerySad
+}
+
 // UnmarshalGQL converts the supplied value to a mood type.
 func (m *Mood) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
@@ -58,3 +81,136 @@ func (m *Mood) UnmarshalGQL(v interface{}) error {
 func (m Mood) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(m.String()))
 }
+
+// MoodDeteriorationSignal is a pattern in a client's health diary history that suggests their mental health is deteriorating
+type MoodDeteriorationSignal string
+
+const (
+	// MoodDeteriorationSignalSustainedSadness is when a client records several sad entries within a short period
+	MoodDeteriorationSignalSustainedSadness MoodDeteriorationSignal = "SUSTAINED_SADNESS"
+	// MoodDeteriorationSignalDownwardTrend is when a client's average mood drops considerably from one week to the next
+	MoodDeteriorationSignalDownwardTrend MoodDeteriorationSignal = "DOWNWARD_TREND"
+	// MoodDeteriorationSignalEntryGap is when a client who regularly recorded entries suddenly stops
+	MoodDeteriorationSignalEntryGap MoodDeteriorationSignal = "ENTRY_GAP"
+)
+
+// IsValid returns true if a MoodDeteriorationSignal is valid
+func (s MoodDeteriorationSignal) IsValid() bool {
+	switch s {
+	case MoodDeteriorationSignalSustainedSadness, MoodDeteriorationSignalDownwardTrend, MoodDeteriorationSignalEntryGap:
+		return true
+	}
+	return false
+}
+
+// String converts the MoodDeteriorationSignal to a string
+func (s MoodDeteriorationSignal) String() string {
+	return string(s)
+}
+
+// UnmarshalGQL converts the supplied value to a MoodDeteriorationSignal
+func (s *MoodDeteriorationSignal) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*s = MoodDeteriorationSignal(str)
+	if !s.IsValid() {
+		return fmt.Errorf("%s is not a valid MoodDeteriorationSignal", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the MoodDeteriorationSignal to the supplied writer
+func (s MoodDeteriorationSignal) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(s.String()))
+}
+
+// HealthDiaryExportFormat is the file format that a client's health diary is exported to
+type HealthDiaryExportFormat string
+
+const (
+	// HealthDiaryExportFormatCSV exports the health diary entries as a spreadsheet
+	HealthDiaryExportFormatCSV HealthDiaryExportFormat = "CSV"
+	// HealthDiaryExportFormatPDF exports the health diary as a printable document with a mood chart
+	HealthDiaryExportFormatPDF HealthDiaryExportFormat = "PDF"
+)
+
+// IsValid returns true if a HealthDiaryExportFormat is valid
+func (f HealthDiaryExportFormat) IsValid() bool {
+	switch f {
+	case HealthDiaryExportFormatCSV, HealthDiaryExportFormatPDF:
+		return true
+	}
+	return false
+}
+
+// String converts the HealthDiaryExportFormat to a string
+func (f HealthDiaryExportFormat) String() string {
+	return string(f)
+}
+
+// UnmarshalGQL converts the supplied value to a HealthDiaryExportFormat
+func (f *HealthDiaryExportFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*f = HealthDiaryExportFormat(str)
+	if !f.IsValid() {
+		return fmt.Errorf("%s is not a valid HealthDiaryExportFormat", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the HealthDiaryExportFormat to the supplied writer
+func (f HealthDiaryExportFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(f.String()))
+}
+
+// HealthDiaryShareScope is the part of a client's health diary that is shared with a facility or caregiver
+type HealthDiaryShareScope string
+
+const (
+	// HealthDiaryShareScopeEntireDiary shares every entry in the client's health diary
+	HealthDiaryShareScopeEntireDiary HealthDiaryShareScope = "ENTIRE_DIARY"
+	// HealthDiaryShareScopeDateRange shares the entries that were recorded within a date range
+	HealthDiaryShareScopeDateRange HealthDiaryShareScope = "DATE_RANGE"
+	// HealthDiaryShareScopeEntries shares specific entries
+	HealthDiaryShareScopeEntries HealthDiaryShareScope = "ENTRIES"
+)
+
+// IsValid returns true if a HealthDiaryShareScope is valid
+func (s HealthDiaryShareScope) IsValid() bool {
+	switch s {
+	case HealthDiaryShareScopeEntireDiary, HealthDiaryShareScopeDateRange, HealthDiaryShareScopeEntries:
+		return true
+	}
+	return false
+}
+
+// String converts the HealthDiaryShareScope to a string
+func (s HealthDiaryShareScope) String() string {
+	return string(s)
+}
+
+// UnmarshalGQL converts the supplied value to a HealthDiaryShareScope
+func (s *HealthDiaryShareScope) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*s = HealthDiaryShareScope(str)
+	if !s.IsValid() {
+		return fmt.Errorf("%s is not a valid HealthDiaryShareScope", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the HealthDiaryShareScope to the supplied writer
+func (s HealthDiaryShareScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(s.String()))
+}
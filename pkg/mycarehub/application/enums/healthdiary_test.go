@@ -121,3 +121,165 @@ func TestMood_MarshalGQL(t *testing.T) {
 		})
 	}
 }
+
+func TestMood_Score(t *testing.T) {
+	tests := []struct {
+		name string
+		m    Mood
+		want int
+	}{
+		{name: "very sad", m: MoodVerySad, want: 1},
+		{name: "sad", m: MoodSad, want: 2},
+		{name: "neutral", m: MoodNeutral, want: 3},
+		{name: "happy", m: MoodHappy, want: 4},
+		{name: "very happy", m: MoodVeryHappy, want: 5},
+		{name: "invalid", m: Mood("INVALID"), want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Score(); got != tt.want {
+				t.Errorf("Mood.Score() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMood_IsSad(t *testing.T) {
+	tests := []struct {
+		name string
+		m    Mood
+		want bool
+	}{
+		{name: "very sad", m: MoodVerySad, want: true},
+		{name: "sad", m: MoodSad, want: true},
+		{name: "neutral", m: MoodNeutral, want: false},
+		{name: "happy", m: MoodHappy, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.IsSad(); got != tt.want {
+				t.Errorf("Mood.IsSad() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoodDeteriorationSignal_UnmarshalGQL(t *testing.T) {
+	signal := MoodDeteriorationSignalEntryGap
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr bool
+	}{
+		{
+			name:    "valid signal",
+			v:       MoodDeteriorationSignalDownwardTrend.String(),
+			wantErr: false,
+		},
+		{
+			name:    "invalid signal",
+			v:       "HAPPY",
+			wantErr: true,
+		},
+		{
+			name:    "non string signal",
+			v:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := signal.UnmarshalGQL(tt.v); (err != nil) != tt.wantErr {
+				t.Errorf("MoodDeteriorationSignal.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMoodDeteriorationSignal_MarshalGQL(t *testing.T) {
+	w := &bytes.Buffer{}
+	MoodDeteriorationSignalSustainedSadness.MarshalGQL(w)
+	if got := w.String(); got != strconv.Quote("SUSTAINED_SADNESS") {
+		t.Errorf("MoodDeteriorationSignal.MarshalGQL() = %v, want %v", got, strconv.Quote("SUSTAINED_SADNESS"))
+	}
+}
+
+func TestHealthDiaryExportFormat_UnmarshalGQL(t *testing.T) {
+	format := HealthDiaryExportFormatCSV
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr bool
+	}{
+		{
+			name:    "valid format",
+			v:       HealthDiaryExportFormatPDF.String(),
+			wantErr: false,
+		},
+		{
+			name:    "invalid format",
+			v:       "DOCX",
+			wantErr: true,
+		},
+		{
+			name:    "non string format",
+			v:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := format.UnmarshalGQL(tt.v); (err != nil) != tt.wantErr {
+				t.Errorf("HealthDiaryExportFormat.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHealthDiaryExportFormat_MarshalGQL(t *testing.T) {
+	w := &bytes.Buffer{}
+	HealthDiaryExportFormatCSV.MarshalGQL(w)
+	if got := w.String(); got != strconv.Quote("CSV") {
+		t.Errorf("HealthDiaryExportFormat.MarshalGQL() = %v, want %v", got, strconv.Quote("CSV"))
+	}
+}
+
+func TestHealthDiaryShareScope_UnmarshalGQL(t *testing.T) {
+	scope := HealthDiaryShareScopeEntireDiary
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr bool
+	}{
+		{
+			name:    "valid scope",
+			v:       HealthDiaryShareScopeDateRange.String(),
+			wantErr: false,
+		},
+		{
+			name:    "invalid scope",
+			v:       "EVERYTHING",
+			wantErr: true,
+		},
+		{
+			name:    "non string scope",
+			v:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := scope.UnmarshalGQL(tt.v); (err != nil) != tt.wantErr {
+				t.Errorf("HealthDiaryShareScope.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHealthDiaryShareScope_MarshalGQL(t *testing.T) {
+	w := &bytes.Buffer{}
+	HealthDiaryShareScopeEntries.MarshalGQL(w)
+	if got := w.String(); got != strconv.Quote("ENTRIES") {
+		t.Errorf("HealthDiaryShareScope.MarshalGQL() = %v, want %v", got, strconv.Quote("ENTRIES"))
+	}
+}
@@ -33,6 +33,18 @@ const (
 
 	// NotificationTypePromoteToModerator represents a promote to moderator notification
 	NotificationTypePromoteToModerator NotificationType = "PROMOTE_TO_MODERATOR"
+
+	// NotificationTypeServiceRequestEscalation represents notifications for service requests that have breached their SLA
+	NotificationTypeServiceRequestEscalation NotificationType = "SERVICE_REQUEST_ESCALATION"
+
+	// NotificationTypeScreeningToolResponse represents notifications for screening tool responses that need a follow up
+	NotificationTypeScreeningToolResponse NotificationType = "SCREENING_TOOL_RESPONSE"
+
+	// NotificationTypeScreeningToolAssignment represents notifications for screening tools that a staff assigned to a client
+	NotificationTypeScreeningToolAssignment NotificationType = "SCREENING_TOOL_ASSIGNMENT"
+
+	// NotificationTypeCommunityCrisisAlert represents notifications for community messages that may indicate a crisis
+	NotificationTypeCommunityCrisisAlert NotificationType = "COMMUNITY_CRISIS_ALERT"
 )
 
 // AllNotificationTypes holds all types of notification
@@ -45,6 +57,10 @@ var AllNotificationTypes = []NotificationType{
 	NotificationTypeSurveys,
 	NotificationTypeDemoteModerator,
 	NotificationTypePromoteToModerator,
+	NotificationTypeServiceRequestEscalation,
+	NotificationTypeScreeningToolResponse,
+	NotificationTypeScreeningToolAssignment,
+	NotificationTypeCommunityCrisisAlert,
 }
 
 // IsValid returns true if a notification type is valid
@@ -58,7 +74,11 @@ func (n NotificationType) IsValid() bool {
 		NotificationTypeRoleAssignment,
 		NotificationTypeSurveys,
 		NotificationTypeDemoteModerator,
-		NotificationTypePromoteToModerator:
+		NotificationTypePromoteToModerator,
+		NotificationTypeServiceRequestEscalation,
+		NotificationTypeScreeningToolResponse,
+		NotificationTypeScreeningToolAssignment,
+		NotificationTypeCommunityCrisisAlert:
 		return true
 	}
 	return false
@@ -107,6 +127,14 @@ func (n NotificationType) Name() string {
 		return "Moderator Demotion"
 	case NotificationTypePromoteToModerator:
 		return "Moderator Promotion"
+	case NotificationTypeServiceRequestEscalation:
+		return "Service Request Escalations"
+	case NotificationTypeScreeningToolResponse:
+		return "Screening Tool Responses"
+	case NotificationTypeScreeningToolAssignment:
+		return "Screening Tool Assignments"
+	case NotificationTypeCommunityCrisisAlert:
+		return "Community Crisis Alerts"
 	}
 	return "UNKNOWN"
 }
@@ -100,3 +100,316 @@ func (q *QuestionResponseValueType) UnmarshalGQL(v interface{}) error {
 func (q QuestionResponseValueType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(q.String()))
 }
+
+// ScreeningToolBandAction is the action taken when a screening tool response falls within a score band
+type ScreeningToolBandAction string
+
+const (
+	// ScreeningToolBandActionNone means no follow up is required for responses in the band
+	ScreeningToolBandActionNone ScreeningToolBandAction = "NONE"
+	// ScreeningToolBandActionNotifyStaff notifies the staff at the client's facility of the response
+	ScreeningToolBandActionNotifyStaff ScreeningToolBandAction = "NOTIFY_STAFF"
+	// ScreeningToolBandActionCreateRedFlag creates a screening tool red flag service request for the response
+	ScreeningToolBandActionCreateRedFlag ScreeningToolBandAction = "CREATE_RED_FLAG"
+)
+
+// IsValid returns true if a ScreeningToolBandAction is valid
+func (a ScreeningToolBandAction) IsValid() bool {
+	switch a {
+	case ScreeningToolBandActionNone, ScreeningToolBandActionNotifyStaff, ScreeningToolBandActionCreateRedFlag:
+		return true
+	}
+	return false
+}
+
+// String converts the ScreeningToolBandAction to a string
+func (a ScreeningToolBandAction) String() string {
+	return string(a)
+}
+
+// UnmarshalGQL converts the supplied value to a ScreeningToolBandAction
+func (a *ScreeningToolBandAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*a = ScreeningToolBandAction(str)
+	if !a.IsValid() {
+		return fmt.Errorf("%s is not a valid ScreeningToolBandAction", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the ScreeningToolBandAction to the supplied writer
+func (a ScreeningToolBandAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(a.String()))
+}
+
+// QuestionConditionOperator is the comparison used by a question's display condition
+type QuestionConditionOperator string
+
+const (
+	// QuestionConditionOperatorEquals is met when the prior answer equals the condition's value
+	QuestionConditionOperatorEquals QuestionConditionOperator = "EQUALS"
+	// QuestionConditionOperatorIn is met when the prior answer is one of the condition's values
+	QuestionConditionOperatorIn QuestionConditionOperator = "IN"
+	// QuestionConditionOperatorGreaterThan is met when the prior answer is greater than the condition's value
+	QuestionConditionOperatorGreaterThan QuestionConditionOperator = "GREATER_THAN"
+)
+
+// IsValid returns true if a QuestionConditionOperator is valid
+func (o QuestionConditionOperator) IsValid() bool {
+	switch o {
+	case QuestionConditionOperatorEquals, QuestionConditionOperatorIn, QuestionConditionOperatorGreaterThan:
+		return true
+	}
+	return false
+}
+
+// String converts the QuestionConditionOperator to a string
+func (o QuestionConditionOperator) String() string {
+	return string(o)
+}
+
+// UnmarshalGQL converts the supplied value to a QuestionConditionOperator
+func (o *QuestionConditionOperator) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*o = QuestionConditionOperator(str)
+	if !o.IsValid() {
+		return fmt.Errorf("%s is not a valid QuestionConditionOperator", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the QuestionConditionOperator to the supplied writer
+func (o QuestionConditionOperator) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(o.String()))
+}
+
+// QuestionConditionTarget is the part of a prior answer that a question's display condition is evaluated against
+type QuestionConditionTarget string
+
+const (
+	// QuestionConditionTargetChoice evaluates the condition against the chosen choice(s)
+	QuestionConditionTargetChoice QuestionConditionTarget = "CHOICE"
+	// QuestionConditionTargetScore evaluates the condition against the score of the answer
+	QuestionConditionTargetScore QuestionConditionTarget = "SCORE"
+)
+
+// IsValid returns true if a QuestionConditionTarget is valid
+func (t QuestionConditionTarget) IsValid() bool {
+	switch t {
+	case QuestionConditionTargetChoice, QuestionConditionTargetScore:
+		return true
+	}
+	return false
+}
+
+// String converts the QuestionConditionTarget to a string
+func (t QuestionConditionTarget) String() string {
+	return string(t)
+}
+
+// UnmarshalGQL converts the supplied value to a QuestionConditionTarget
+func (t *QuestionConditionTarget) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*t = QuestionConditionTarget(str)
+	if !t.IsValid() {
+		return fmt.Errorf("%s is not a valid QuestionConditionTarget", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the QuestionConditionTarget to the supplied writer
+func (t QuestionConditionTarget) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(t.String()))
+}
+
+// ScreeningToolVersionStatus is the lifecycle status of a version of a screening tool's questionnaire
+type ScreeningToolVersionStatus string
+
+const (
+	// ScreeningToolVersionStatusDraft is a version that is still being edited
+	ScreeningToolVersionStatusDraft ScreeningToolVersionStatus = "DRAFT"
+	// ScreeningToolVersionStatusPublished is the version that clients currently respond to
+	ScreeningToolVersionStatusPublished ScreeningToolVersionStatus = "PUBLISHED"
+	// ScreeningToolVersionStatusRetired is a previously published version that has been superseded
+	ScreeningToolVersionStatusRetired ScreeningToolVersionStatus = "RETIRED"
+)
+
+// IsValid returns true if a ScreeningToolVersionStatus is valid
+func (s ScreeningToolVersionStatus) IsValid() bool {
+	switch s {
+	case ScreeningToolVersionStatusDraft, ScreeningToolVersionStatusPublished, ScreeningToolVersionStatusRetired:
+		return true
+	}
+	return false
+}
+
+// String converts the ScreeningToolVersionStatus to a string
+func (s ScreeningToolVersionStatus) String() string {
+	return string(s)
+}
+
+// UnmarshalGQL converts the supplied value to a ScreeningToolVersionStatus
+func (s *ScreeningToolVersionStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*s = ScreeningToolVersionStatus(str)
+	if !s.IsValid() {
+		return fmt.Errorf("%s is not a valid ScreeningToolVersionStatus", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the ScreeningToolVersionStatus to the supplied writer
+func (s ScreeningToolVersionStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(s.String()))
+}
+
+// QuestionnaireChangeType describes how a questionnaire changed between two versions
+type QuestionnaireChangeType string
+
+const (
+	// QuestionnaireChangeTypeAdded is a question that only exists in the newer version
+	QuestionnaireChangeTypeAdded QuestionnaireChangeType = "ADDED"
+	// QuestionnaireChangeTypeRemoved is a question that only exists in the older version
+	QuestionnaireChangeTypeRemoved QuestionnaireChangeType = "REMOVED"
+	// QuestionnaireChangeTypeModified is a field that has a different value in the two versions
+	QuestionnaireChangeTypeModified QuestionnaireChangeType = "MODIFIED"
+)
+
+// IsValid returns true if a QuestionnaireChangeType is valid
+func (c QuestionnaireChangeType) IsValid() bool {
+	switch c {
+	case QuestionnaireChangeTypeAdded, QuestionnaireChangeTypeRemoved, QuestionnaireChangeTypeModified:
+		return true
+	}
+	return false
+}
+
+// String converts the QuestionnaireChangeType to a string
+func (c QuestionnaireChangeType) String() string {
+	return string(c)
+}
+
+// UnmarshalGQL converts the supplied value to a QuestionnaireChangeType
+func (c *QuestionnaireChangeType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = QuestionnaireChangeType(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid QuestionnaireChangeType", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the QuestionnaireChangeType to the supplied writer
+func (c QuestionnaireChangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// ScreeningToolRecurrence is the cadence at which a screening tool is made available to a client
+type ScreeningToolRecurrence string
+
+const (
+	// ScreeningToolRecurrenceInterval is a screening tool that becomes available again a set number of days after the last response
+	ScreeningToolRecurrenceInterval ScreeningToolRecurrence = "INTERVAL"
+	// ScreeningToolRecurrenceOnce is a screening tool that a client only responds to once e.g an intake tool
+	ScreeningToolRecurrenceOnce ScreeningToolRecurrence = "ONCE"
+	// ScreeningToolRecurrenceAssigned is a screening tool that is only available when a staff assigns it to a client
+	ScreeningToolRecurrenceAssigned ScreeningToolRecurrence = "ASSIGNED"
+)
+
+// IsValid returns true if a ScreeningToolRecurrence is valid
+func (r ScreeningToolRecurrence) IsValid() bool {
+	switch r {
+	case ScreeningToolRecurrenceInterval, ScreeningToolRecurrenceOnce, ScreeningToolRecurrenceAssigned:
+		return true
+	}
+	return false
+}
+
+// String converts the ScreeningToolRecurrence to a string
+func (r ScreeningToolRecurrence) String() string {
+	return string(r)
+}
+
+// UnmarshalGQL converts the supplied value to a ScreeningToolRecurrence
+func (r *ScreeningToolRecurrence) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*r = ScreeningToolRecurrence(str)
+	if !r.IsValid() {
+		return fmt.Errorf("%s is not a valid ScreeningToolRecurrence", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the ScreeningToolRecurrence to the supplied writer
+func (r ScreeningToolRecurrence) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(r.String()))
+}
+
+// ScreeningToolAssignmentStatus is the status of a screening tool that a staff assigned to a client
+type ScreeningToolAssignmentStatus string
+
+const (
+	// ScreeningToolAssignmentStatusPending is an assignment that the client has not responded to
+	ScreeningToolAssignmentStatusPending ScreeningToolAssignmentStatus = "PENDING"
+	// ScreeningToolAssignmentStatusCompleted is an assignment that the client has responded to
+	ScreeningToolAssignmentStatusCompleted ScreeningToolAssignmentStatus = "COMPLETED"
+	// ScreeningToolAssignmentStatusCancelled is an assignment that was withdrawn by a staff
+	ScreeningToolAssignmentStatusCancelled ScreeningToolAssignmentStatus = "CANCELLED"
+)
+
+// IsValid returns true if a ScreeningToolAssignmentStatus is valid
+func (s ScreeningToolAssignmentStatus) IsValid() bool {
+	switch s {
+	case ScreeningToolAssignmentStatusPending, ScreeningToolAssignmentStatusCompleted, ScreeningToolAssignmentStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// String converts the ScreeningToolAssignmentStatus to a string
+func (s ScreeningToolAssignmentStatus) String() string {
+	return string(s)
+}
+
+// UnmarshalGQL converts the supplied value to a ScreeningToolAssignmentStatus
+func (s *ScreeningToolAssignmentStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*s = ScreeningToolAssignmentStatus(str)
+	if !s.IsValid() {
+		return fmt.Errorf("%s is not a valid ScreeningToolAssignmentStatus", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the ScreeningToolAssignmentStatus to the supplied writer
+func (s ScreeningToolAssignmentStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(s.String()))
+}
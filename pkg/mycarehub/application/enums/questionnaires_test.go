@@ -189,3 +189,266 @@ func TestQuestionnaireResponseValueChoices_UnmarshalGQL(t *testing.T) {
 		})
 	}
 }
+
+func TestScreeningToolBandAction_IsValid(t *testing.T) {
+	tests := []struct {
+		name string
+		a    ScreeningToolBandAction
+		want bool
+	}{
+		{
+			name: "valid action",
+			a:    ScreeningToolBandActionCreateRedFlag,
+			want: true,
+		},
+		{
+			name: "invalid action",
+			a:    ScreeningToolBandAction("INVALID"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.IsValid(); got != tt.want {
+				t.Errorf("ScreeningToolBandAction.IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScreeningToolBandAction_UnmarshalGQL(t *testing.T) {
+	action := ScreeningToolBandActionNone
+	type args struct {
+		v interface{}
+	}
+	tests := []struct {
+		name    string
+		a       *ScreeningToolBandAction
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "valid action",
+			a:    &action,
+			args: args{
+				v: ScreeningToolBandActionNotifyStaff.String(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid action",
+			a:    &action,
+			args: args{
+				v: "INVALID",
+			},
+			wantErr: true,
+		},
+		{
+			name: "non string action",
+			a:    &action,
+			args: args{
+				v: 45,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.a.UnmarshalGQL(tt.args.v); (err != nil) != tt.wantErr {
+				t.Errorf("ScreeningToolBandAction.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestQuestionConditionOperator_UnmarshalGQL(t *testing.T) {
+	operator := QuestionConditionOperatorEquals
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr bool
+	}{
+		{
+			name:    "valid operator",
+			v:       QuestionConditionOperatorGreaterThan.String(),
+			wantErr: false,
+		},
+		{
+			name:    "invalid operator",
+			v:       "LESS_THAN",
+			wantErr: true,
+		},
+		{
+			name:    "non string operator",
+			v:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := operator.UnmarshalGQL(tt.v); (err != nil) != tt.wantErr {
+				t.Errorf("QuestionConditionOperator.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestQuestionConditionTarget_UnmarshalGQL(t *testing.T) {
+	target := QuestionConditionTargetChoice
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr bool
+	}{
+		{
+			name:    "valid target",
+			v:       QuestionConditionTargetScore.String(),
+			wantErr: false,
+		},
+		{
+			name:    "invalid target",
+			v:       "RESPONSE",
+			wantErr: true,
+		},
+		{
+			name:    "non string target",
+			v:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := target.UnmarshalGQL(tt.v); (err != nil) != tt.wantErr {
+				t.Errorf("QuestionConditionTarget.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestScreeningToolVersionStatus_UnmarshalGQL(t *testing.T) {
+	status := ScreeningToolVersionStatusDraft
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr bool
+	}{
+		{
+			name:    "valid status",
+			v:       ScreeningToolVersionStatusPublished.String(),
+			wantErr: false,
+		},
+		{
+			name:    "invalid status",
+			v:       "ARCHIVED",
+			wantErr: true,
+		},
+		{
+			name:    "non string status",
+			v:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := status.UnmarshalGQL(tt.v); (err != nil) != tt.wantErr {
+				t.Errorf("ScreeningToolVersionStatus.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestQuestionnaireChangeType_UnmarshalGQL(t *testing.T) {
+	changeType := QuestionnaireChangeTypeAdded
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr bool
+	}{
+		{
+			name:    "valid change type",
+			v:       QuestionnaireChangeTypeModified.String(),
+			wantErr: false,
+		},
+		{
+			name:    "invalid change type",
+			v:       "RENAMED",
+			wantErr: true,
+		},
+		{
+			name:    "non string change type",
+			v:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := changeType.UnmarshalGQL(tt.v); (err != nil) != tt.wantErr {
+				t.Errorf("QuestionnaireChangeType.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestScreeningToolRecurrence_UnmarshalGQL(t *testing.T) {
+	recurrence := ScreeningToolRecurrenceInterval
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr bool
+	}{
+		{
+			name:    "valid recurrence",
+			v:       ScreeningToolRecurrenceOnce.String(),
+			wantErr: false,
+		},
+		{
+			name:    "invalid recurrence",
+			v:       "WEEKLY",
+			wantErr: true,
+		},
+		{
+			name:    "non string recurrence",
+			v:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := recurrence.UnmarshalGQL(tt.v); (err != nil) != tt.wantErr {
+				t.Errorf("ScreeningToolRecurrence.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestScreeningToolAssignmentStatus_UnmarshalGQL(t *testing.T) {
+	status := ScreeningToolAssignmentStatusPending
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr bool
+	}{
+		{
+			name:    "valid status",
+			v:       ScreeningToolAssignmentStatusCompleted.String(),
+			wantErr: false,
+		},
+		{
+			name:    "invalid status",
+			v:       "OVERDUE",
+			wantErr: true,
+		},
+		{
+			name:    "non string status",
+			v:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := status.UnmarshalGQL(tt.v); (err != nil) != tt.wantErr {
+				t.Errorf("ScreeningToolAssignmentStatus.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package enums
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ServiceRequestEventType is an action recorded in the history of a service request
+type ServiceRequestEventType string
+
+const (
+	// ServiceRequestEventTypeAssigned is recorded when a service request is assigned to a staff member for the first time
+	ServiceRequestEventTypeAssigned ServiceRequestEventType = "ASSIGNED"
+
+	// ServiceRequestEventTypeReassigned is recorded when a service request is handed over to another staff member
+	ServiceRequestEventTypeReassigned ServiceRequestEventType = "REASSIGNED"
+
+	// ServiceRequestEventTypeInProgress is recorded when a staff member starts working on a service request
+	ServiceRequestEventTypeInProgress ServiceRequestEventType = "IN_PROGRESS"
+
+	// ServiceRequestEventTypeResolved is recorded when a service request is resolved
+	ServiceRequestEventTypeResolved ServiceRequestEventType = "RESOLVED"
+
+	// ServiceRequestEventTypeCommented is recorded when a staff member comments on a service request
+	ServiceRequestEventTypeCommented ServiceRequestEventType = "COMMENTED"
+
+	// ServiceRequestEventTypeEscalated is recorded when a service request is escalated for breaching its SLA
+	ServiceRequestEventTypeEscalated ServiceRequestEventType = "ESCALATED"
+
+	// ServiceRequestEventTypeSynced is recorded when the status of a service request is updated from KenyaEMR
+	ServiceRequestEventTypeSynced ServiceRequestEventType = "SYNCED"
+)
+
+// IsValid returns true if a service request event type is valid
+func (s ServiceRequestEventType) IsValid() bool {
+	switch s {
+	case ServiceRequestEventTypeAssigned,
+		ServiceRequestEventTypeReassigned,
+		ServiceRequestEventTypeInProgress,
+		ServiceRequestEventTypeResolved,
+		ServiceRequestEventTypeCommented,
+		ServiceRequestEventTypeEscalated,
+		ServiceRequestEventTypeSynced:
+		return true
+	}
+	return false
+}
+
+// String converts the service request event type enum to a string
+func (s ServiceRequestEventType) String() string {
+	return string(s)
+}
+
+// UnmarshalGQL converts the supplied value to a service request event type.
+func (s *ServiceRequestEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*s = ServiceRequestEventType(str)
+	if !s.IsValid() {
+		return fmt.Errorf("%s is not a valid ServiceRequestEventType", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the service request event type to the supplied writer
+func (s ServiceRequestEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(s.String()))
+}
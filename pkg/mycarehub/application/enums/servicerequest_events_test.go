@@ -0,0 +1,126 @@
+package enums
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+)
+
+func TestServiceRequestEventType_IsValid(t *testing.T) {
+	tests := []struct {
+		name string
+		m    ServiceRequestEventType
+		want bool
+	}{
+		{
+			name: "valid type",
+			m:    ServiceRequestEventTypeAssigned,
+			want: true,
+		},
+		{
+			name: "invalid type",
+			m:    ServiceRequestEventType("invalid"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.IsValid(); got != tt.want {
+				t.Errorf("ServiceRequestEventType.IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServiceRequestEventType_String(t *testing.T) {
+	tests := []struct {
+		name string
+		m    ServiceRequestEventType
+		want string
+	}{
+		{
+			name: "ASSIGNED",
+			m:    ServiceRequestEventTypeAssigned,
+			want: "ASSIGNED",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.String(); got != tt.want {
+				t.Errorf("ServiceRequestEventType.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServiceRequestEventType_UnmarshalGQL(t *testing.T) {
+	value := ServiceRequestEventTypeAssigned
+	invalid := ServiceRequestEventType("invalid")
+	type args struct {
+		v interface{}
+	}
+	tests := []struct {
+		name    string
+		m       *ServiceRequestEventType
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "valid type",
+			m:    &value,
+			args: args{
+				v: "ASSIGNED",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid type",
+			m:    &invalid,
+			args: args{
+				v: "this is not a valid type",
+			},
+			wantErr: true,
+		},
+		{
+			name: "non string type",
+			m:    &invalid,
+			args: args{
+				v: 1,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.m.UnmarshalGQL(tt.args.v); (err != nil) != tt.wantErr {
+				t.Errorf("ServiceRequestEventType.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServiceRequestEventType_MarshalGQL(t *testing.T) {
+	w := &bytes.Buffer{}
+	tests := []struct {
+		name  string
+		m     ServiceRequestEventType
+		b     *bytes.Buffer
+		wantW string
+	}{
+		{
+			name:  "valid type enums",
+			m:     ServiceRequestEventTypeAssigned,
+			b:     w,
+			wantW: strconv.Quote("ASSIGNED"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.m.MarshalGQL(w)
+			if gotW := w.String(); gotW != tt.wantW {
+				t.Errorf("ServiceRequestEventType.MarshalGQL() = %v, want %v", gotW, tt.wantW)
+			}
+		})
+	}
+}
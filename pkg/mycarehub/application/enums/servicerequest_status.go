@@ -96,3 +96,47 @@ func (e *PINResetVerificationStatus) UnmarshalGQL(v interface{}) error {
 func (e PINResetVerificationStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+// ServiceRequestPriority indicates how urgently a service request should be attended to
+type ServiceRequestPriority string
+
+// Valid ServiceRequestPriority
+const (
+	ServiceRequestPriorityLow    ServiceRequestPriority = "LOW"
+	ServiceRequestPriorityMedium ServiceRequestPriority = "MEDIUM"
+	ServiceRequestPriorityHigh   ServiceRequestPriority = "HIGH"
+	ServiceRequestPriorityUrgent ServiceRequestPriority = "URGENT"
+)
+
+// IsValid checks if the ServiceRequestPriority is valid
+func (e ServiceRequestPriority) IsValid() bool {
+	switch e {
+	case ServiceRequestPriorityLow, ServiceRequestPriorityMedium, ServiceRequestPriorityHigh, ServiceRequestPriorityUrgent:
+		return true
+	}
+	return false
+}
+
+// String returns the string
+func (e ServiceRequestPriority) String() string {
+	return string(e)
+}
+
+// UnmarshalGQL converts the input, if valid, into an ServiceRequestPriority value
+func (e *ServiceRequestPriority) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ServiceRequestPriority(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ServiceRequestPriority", str)
+	}
+	return nil
+}
+
+// MarshalGQL converts ServiceRequestPriority into a valid JSON string
+func (e ServiceRequestPriority) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -222,3 +222,97 @@ func TestPINResetVerificationStatus_UnmarshalGQL(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceRequestPriority_IsValid(t *testing.T) {
+	tests := []struct {
+		name string
+		e    ServiceRequestPriority
+		want bool
+	}{
+		{
+			name: "valid priority",
+			e:    ServiceRequestPriorityUrgent,
+			want: true,
+		},
+		{
+			name: "invalid priority",
+			e:    ServiceRequestPriority("invalid"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.IsValid(); got != tt.want {
+				t.Errorf("ServiceRequestPriority.IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServiceRequestPriority_UnmarshalGQL(t *testing.T) {
+	priority := ServiceRequestPriorityLow
+	type args struct {
+		v interface{}
+	}
+	tests := []struct {
+		name    string
+		e       *ServiceRequestPriority
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			e:    &priority,
+			args: args{
+				v: "HIGH",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid",
+			e:    &priority,
+			args: args{
+				v: "this is not a real priority",
+			},
+			wantErr: true,
+		},
+		{
+			name: "non string",
+			e:    &priority,
+			args: args{
+				v: 1,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.e.UnmarshalGQL(tt.args.v); (err != nil) != tt.wantErr {
+				t.Errorf("ServiceRequestPriority.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServiceRequestPriority_MarshalGQL(t *testing.T) {
+	tests := []struct {
+		name  string
+		e     ServiceRequestPriority
+		wantW string
+	}{
+		{
+			name:  "valid priority",
+			e:     ServiceRequestPriorityMedium,
+			wantW: strconv.Quote("MEDIUM"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.e.MarshalGQL(w)
+			if gotW := w.String(); gotW != tt.wantW {
+				t.Errorf("ServiceRequestPriority.MarshalGQL() = %v, want %v", gotW, tt.wantW)
+			}
+		})
+	}
+}
@@ -24,6 +24,10 @@ const (
 	ServiceRequestTypeScreeningToolsRedFlag ServiceRequestType = "SCREENING_TOOLS_RED_FLAG"
 	// ServiceRequestTypeSurveyRedFlag represents the survey service request
 	ServiceRequestTypeSurveyRedFlag ServiceRequestType = "SURVEY_RED_FLAG"
+	// ServiceRequestTypeMissedAppointment represents a follow up service request for a missed appointment
+	ServiceRequestTypeMissedAppointment ServiceRequestType = "MISSED_APPOINTMENT"
+	// ServiceRequestTypeMoodDeterioration represents a follow up service request for a client whose health diary shows a deteriorating mood
+	ServiceRequestTypeMoodDeterioration ServiceRequestType = "MOOD_DETERIORATION"
 )
 
 // AllServiceRequestType is a set of a  valid and known service request types.
@@ -35,6 +39,8 @@ var AllServiceRequestType = []ServiceRequestType{
 	ServiceRequestTypeAppointments,
 	ServiceRequestTypeScreeningToolsRedFlag,
 	ServiceRequestTypeSurveyRedFlag,
+	ServiceRequestTypeMissedAppointment,
+	ServiceRequestTypeMoodDeterioration,
 }
 
 // IsValid returns true if a request type is valid
@@ -46,7 +52,9 @@ func (m ServiceRequestType) IsValid() bool {
 		ServiceRequestTypeHomePageHealthDiary,
 		ServiceRequestTypeAppointments,
 		ServiceRequestTypeScreeningToolsRedFlag,
-		ServiceRequestTypeSurveyRedFlag:
+		ServiceRequestTypeSurveyRedFlag,
+		ServiceRequestTypeMissedAppointment,
+		ServiceRequestTypeMoodDeterioration:
 		return true
 	}
 	return false
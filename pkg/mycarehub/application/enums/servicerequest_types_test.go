@@ -38,6 +38,16 @@ func TestServiceRequestType_IsValid(t *testing.T) {
 			e:    ServiceRequestTypeRedFlag,
 			want: true,
 		},
+		{
+			name: "valid missed appointment type",
+			e:    ServiceRequestTypeMissedAppointment,
+			want: true,
+		},
+		{
+			name: "valid mood deterioration type",
+			e:    ServiceRequestTypeMoodDeterioration,
+			want: true,
+		},
 		{
 			name: "invalid type",
 			e:    ServiceRequestType("invalid"),
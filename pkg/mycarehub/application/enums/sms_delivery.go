@@ -0,0 +1,54 @@
+package enums
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// SMSDeliveryStatus is the delivery state of an SMS sent through the SMS gateway
+type SMSDeliveryStatus string
+
+const (
+	// SMSDeliveryStatusPending means that the SMS has not yet been accepted by any provider
+	SMSDeliveryStatusPending SMSDeliveryStatus = "PENDING"
+
+	// SMSDeliveryStatusSent means that the SMS has been accepted by a provider for delivery
+	SMSDeliveryStatusSent SMSDeliveryStatus = "SENT"
+
+	// SMSDeliveryStatusFailed means that all the providers in the chain failed to send the SMS
+	SMSDeliveryStatusFailed SMSDeliveryStatus = "FAILED"
+)
+
+// IsValid returns true if an SMS delivery status is valid
+func (s SMSDeliveryStatus) IsValid() bool {
+	switch s {
+	case SMSDeliveryStatusPending, SMSDeliveryStatusSent, SMSDeliveryStatusFailed:
+		return true
+	}
+	return false
+}
+
+// String converts the SMS delivery status enum to a string
+func (s SMSDeliveryStatus) String() string {
+	return string(s)
+}
+
+// UnmarshalGQL converts the supplied value to an SMS delivery status.
+func (s *SMSDeliveryStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*s = SMSDeliveryStatus(str)
+	if !s.IsValid() {
+		return fmt.Errorf("%s is not a valid SMS delivery status", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the SMS delivery status to the supplied writer
+func (s SMSDeliveryStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(s.String()))
+}
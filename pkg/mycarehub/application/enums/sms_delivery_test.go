@@ -0,0 +1,78 @@
+package enums
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+)
+
+func TestSMSDeliveryStatus_IsValid(t *testing.T) {
+	tests := []struct {
+		name string
+		s    SMSDeliveryStatus
+		want bool
+	}{
+		{
+			name: "Happy Case - Valid status",
+			s:    SMSDeliveryStatusSent,
+			want: true,
+		},
+		{
+			name: "Sad Case - Invalid status",
+			s:    SMSDeliveryStatus("INVALID"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.IsValid(); got != tt.want {
+				t.Errorf("SMSDeliveryStatus.IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSMSDeliveryStatus_String(t *testing.T) {
+	if got := SMSDeliveryStatusFailed.String(); got != "FAILED" {
+		t.Errorf("SMSDeliveryStatus.String() = %v, want %v", got, "FAILED")
+	}
+}
+
+func TestSMSDeliveryStatus_UnmarshalGQL(t *testing.T) {
+	value := SMSDeliveryStatusPending
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr bool
+	}{
+		{
+			name: "Happy Case - Valid status",
+			v:    "PENDING",
+		},
+		{
+			name:    "Sad Case - Invalid status",
+			v:       "INVALID",
+			wantErr: true,
+		},
+		{
+			name:    "Sad Case - Non string value",
+			v:       45,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := value.UnmarshalGQL(tt.v); (err != nil) != tt.wantErr {
+				t.Errorf("SMSDeliveryStatus.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSMSDeliveryStatus_MarshalGQL(t *testing.T) {
+	w := &bytes.Buffer{}
+	SMSDeliveryStatusSent.MarshalGQL(w)
+	if got := w.String(); got != strconv.Quote("SENT") {
+		t.Errorf("SMSDeliveryStatus.MarshalGQL() = %v, want %v", got, strconv.Quote("SENT"))
+	}
+}
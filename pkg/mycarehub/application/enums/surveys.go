@@ -0,0 +1,209 @@
+package enums
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// SurveyCampaignRecurrence is how often a survey campaign is sent out again
+type SurveyCampaignRecurrence string
+
+const (
+	// SurveyCampaignRecurrenceOnce is a campaign that is only sent out at its scheduled time
+	SurveyCampaignRecurrenceOnce SurveyCampaignRecurrence = "ONCE"
+	// SurveyCampaignRecurrenceDaily is a campaign that is sent out every day
+	SurveyCampaignRecurrenceDaily SurveyCampaignRecurrence = "DAILY"
+	// SurveyCampaignRecurrenceWeekly is a campaign that is sent out every week
+	SurveyCampaignRecurrenceWeekly SurveyCampaignRecurrence = "WEEKLY"
+	// SurveyCampaignRecurrenceMonthly is a campaign that is sent out every month
+	SurveyCampaignRecurrenceMonthly SurveyCampaignRecurrence = "MONTHLY"
+)
+
+// IsValid returns true if a SurveyCampaignRecurrence is valid
+func (r SurveyCampaignRecurrence) IsValid() bool {
+	switch r {
+	case SurveyCampaignRecurrenceOnce, SurveyCampaignRecurrenceDaily, SurveyCampaignRecurrenceWeekly, SurveyCampaignRecurrenceMonthly:
+		return true
+	}
+	return false
+}
+
+// String converts the SurveyCampaignRecurrence to a string
+func (r SurveyCampaignRecurrence) String() string {
+	return string(r)
+}
+
+// UnmarshalGQL converts the supplied value to a SurveyCampaignRecurrence
+func (r *SurveyCampaignRecurrence) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*r = SurveyCampaignRecurrence(str)
+	if !r.IsValid() {
+		return fmt.Errorf("%s is not a valid SurveyCampaignRecurrence", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the SurveyCampaignRecurrence to the supplied writer
+func (r SurveyCampaignRecurrence) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(r.String()))
+}
+
+// SurveyCampaignStatus is the stage of a survey campaign
+type SurveyCampaignStatus string
+
+const (
+	// SurveyCampaignStatusScheduled is a campaign that has not been sent out yet
+	SurveyCampaignStatusScheduled SurveyCampaignStatus = "SCHEDULED"
+	// SurveyCampaignStatusActive is a campaign that has been sent out and is still collecting responses or has more rounds to send
+	SurveyCampaignStatusActive SurveyCampaignStatus = "ACTIVE"
+	// SurveyCampaignStatusCompleted is a campaign whose last round of survey links has expired
+	SurveyCampaignStatusCompleted SurveyCampaignStatus = "COMPLETED"
+	// SurveyCampaignStatusCancelled is a campaign that was stopped by a staff member
+	SurveyCampaignStatusCancelled SurveyCampaignStatus = "CANCELLED"
+)
+
+// IsValid returns true if a SurveyCampaignStatus is valid
+func (s SurveyCampaignStatus) IsValid() bool {
+	switch s {
+	case SurveyCampaignStatusScheduled, SurveyCampaignStatusActive, SurveyCampaignStatusCompleted, SurveyCampaignStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// String converts the SurveyCampaignStatus to a string
+func (s SurveyCampaignStatus) String() string {
+	return string(s)
+}
+
+// UnmarshalGQL converts the supplied value to a SurveyCampaignStatus
+func (s *SurveyCampaignStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*s = SurveyCampaignStatus(str)
+	if !s.IsValid() {
+		return fmt.Errorf("%s is not a valid SurveyCampaignStatus", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the SurveyCampaignStatus to the supplied writer
+func (s SurveyCampaignStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(s.String()))
+}
+
+// SurveyRuleOperator is how a survey red flag rule condition compares the answer to a field with its value
+type SurveyRuleOperator string
+
+const (
+	// SurveyRuleOperatorEquals matches an answer that is the same as the value
+	SurveyRuleOperatorEquals SurveyRuleOperator = "EQUALS"
+	// SurveyRuleOperatorNotEquals matches an answer that is different from the value
+	SurveyRuleOperatorNotEquals SurveyRuleOperator = "NOT_EQUALS"
+	// SurveyRuleOperatorContains matches an answer that contains the value
+	SurveyRuleOperatorContains SurveyRuleOperator = "CONTAINS"
+	// SurveyRuleOperatorGreaterThan matches a numeric answer that is greater than the value
+	SurveyRuleOperatorGreaterThan SurveyRuleOperator = "GREATER_THAN"
+	// SurveyRuleOperatorGreaterThanOrEqualTo matches a numeric answer that is greater than or equal to the value
+	SurveyRuleOperatorGreaterThanOrEqualTo SurveyRuleOperator = "GREATER_THAN_OR_EQUAL_TO"
+	// SurveyRuleOperatorLessThan matches a numeric answer that is less than the value
+	SurveyRuleOperatorLessThan SurveyRuleOperator = "LESS_THAN"
+	// SurveyRuleOperatorLessThanOrEqualTo matches a numeric answer that is less than or equal to the value
+	SurveyRuleOperatorLessThanOrEqualTo SurveyRuleOperator = "LESS_THAN_OR_EQUAL_TO"
+)
+
+// IsValid returns true if a SurveyRuleOperator is valid
+func (o SurveyRuleOperator) IsValid() bool {
+	switch o {
+	case SurveyRuleOperatorEquals, SurveyRuleOperatorNotEquals, SurveyRuleOperatorContains,
+		SurveyRuleOperatorGreaterThan, SurveyRuleOperatorGreaterThanOrEqualTo,
+		SurveyRuleOperatorLessThan, SurveyRuleOperatorLessThanOrEqualTo:
+		return true
+	}
+	return false
+}
+
+// IsNumeric returns true if the operator compares answers as numbers
+func (o SurveyRuleOperator) IsNumeric() bool {
+	switch o {
+	case SurveyRuleOperatorGreaterThan, SurveyRuleOperatorGreaterThanOrEqualTo,
+		SurveyRuleOperatorLessThan, SurveyRuleOperatorLessThanOrEqualTo:
+		return true
+	}
+	return false
+}
+
+// String converts the SurveyRuleOperator to a string
+func (o SurveyRuleOperator) String() string {
+	return string(o)
+}
+
+// UnmarshalGQL converts the supplied value to a SurveyRuleOperator
+func (o *SurveyRuleOperator) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*o = SurveyRuleOperator(str)
+	if !o.IsValid() {
+		return fmt.Errorf("%s is not a valid SurveyRuleOperator", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the SurveyRuleOperator to the supplied writer
+func (o SurveyRuleOperator) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(o.String()))
+}
+
+// SurveyRuleCombinator is how the conditions of a survey red flag rule are combined
+type SurveyRuleCombinator string
+
+const (
+	// SurveyRuleCombinatorAnd is a rule that matches when all of its conditions match
+	SurveyRuleCombinatorAnd SurveyRuleCombinator = "AND"
+	// SurveyRuleCombinatorOr is a rule that matches when any of its conditions match
+	SurveyRuleCombinatorOr SurveyRuleCombinator = "OR"
+)
+
+// IsValid returns true if a SurveyRuleCombinator is valid
+func (c SurveyRuleCombinator) IsValid() bool {
+	switch c {
+	case SurveyRuleCombinatorAnd, SurveyRuleCombinatorOr:
+		return true
+	}
+	return false
+}
+
+// String converts the SurveyRuleCombinator to a string
+func (c SurveyRuleCombinator) String() string {
+	return string(c)
+}
+
+// UnmarshalGQL converts the supplied value to a SurveyRuleCombinator
+func (c *SurveyRuleCombinator) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = SurveyRuleCombinator(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid SurveyRuleCombinator", str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the SurveyRuleCombinator to the supplied writer
+func (c SurveyRuleCombinator) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
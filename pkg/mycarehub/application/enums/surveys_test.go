@@ -0,0 +1,176 @@
+package enums
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+)
+
+func TestSurveyCampaignRecurrence_UnmarshalGQL(t *testing.T) {
+	recurrence := SurveyCampaignRecurrenceOnce
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr bool
+	}{
+		{
+			name:    "valid recurrence",
+			v:       SurveyCampaignRecurrenceMonthly.String(),
+			wantErr: false,
+		},
+		{
+			name:    "invalid recurrence",
+			v:       "YEARLY",
+			wantErr: true,
+		},
+		{
+			name:    "non string recurrence",
+			v:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := recurrence.UnmarshalGQL(tt.v); (err != nil) != tt.wantErr {
+				t.Errorf("SurveyCampaignRecurrence.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSurveyCampaignRecurrence_MarshalGQL(t *testing.T) {
+	w := &bytes.Buffer{}
+	SurveyCampaignRecurrenceWeekly.MarshalGQL(w)
+	if got := w.String(); got != strconv.Quote("WEEKLY") {
+		t.Errorf("SurveyCampaignRecurrence.MarshalGQL() = %v, want %v", got, strconv.Quote("WEEKLY"))
+	}
+}
+
+func TestSurveyCampaignStatus_UnmarshalGQL(t *testing.T) {
+	status := SurveyCampaignStatusScheduled
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr bool
+	}{
+		{
+			name:    "valid status",
+			v:       SurveyCampaignStatusCancelled.String(),
+			wantErr: false,
+		},
+		{
+			name:    "invalid status",
+			v:       "PAUSED",
+			wantErr: true,
+		},
+		{
+			name:    "non string status",
+			v:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := status.UnmarshalGQL(tt.v); (err != nil) != tt.wantErr {
+				t.Errorf("SurveyCampaignStatus.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSurveyCampaignStatus_MarshalGQL(t *testing.T) {
+	w := &bytes.Buffer{}
+	SurveyCampaignStatusActive.MarshalGQL(w)
+	if got := w.String(); got != strconv.Quote("ACTIVE") {
+		t.Errorf("SurveyCampaignStatus.MarshalGQL() = %v, want %v", got, strconv.Quote("ACTIVE"))
+	}
+}
+
+func TestSurveyRuleOperator_UnmarshalGQL(t *testing.T) {
+	operator := SurveyRuleOperatorEquals
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr bool
+	}{
+		{
+			name:    "valid operator",
+			v:       SurveyRuleOperatorGreaterThan.String(),
+			wantErr: false,
+		},
+		{
+			name:    "invalid operator",
+			v:       "MATCHES",
+			wantErr: true,
+		},
+		{
+			name:    "non string operator",
+			v:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := operator.UnmarshalGQL(tt.v); (err != nil) != tt.wantErr {
+				t.Errorf("SurveyRuleOperator.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSurveyRuleOperator_IsNumeric(t *testing.T) {
+	if !SurveyRuleOperatorLessThanOrEqualTo.IsNumeric() {
+		t.Errorf("expected %v to be a numeric operator", SurveyRuleOperatorLessThanOrEqualTo)
+	}
+	if SurveyRuleOperatorContains.IsNumeric() {
+		t.Errorf("expected %v not to be a numeric operator", SurveyRuleOperatorContains)
+	}
+}
+
+func TestSurveyRuleOperator_MarshalGQL(t *testing.T) {
+	w := &bytes.Buffer{}
+	SurveyRuleOperatorContains.MarshalGQL(w)
+	if got := w.String(); got != strconv.Quote("CONTAINS") {
+		t.Errorf("SurveyRuleOperator.MarshalGQL() = %v, want %v", got, strconv.Quote("CONTAINS"))
+	}
+}
+
+func TestSurveyRuleCombinator_UnmarshalGQL(t *testing.T) {
+	combinator := SurveyRuleCombinatorAnd
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr bool
+	}{
+		{
+			name:    "valid combinator",
+			v:       SurveyRuleCombinatorOr.String(),
+			wantErr: false,
+		},
+		{
+			name:    "invalid combinator",
+			v:       "XOR",
+			wantErr: true,
+		},
+		{
+			name:    "non string combinator",
+			v:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := combinator.UnmarshalGQL(tt.v); (err != nil) != tt.wantErr {
+				t.Errorf("SurveyRuleCombinator.UnmarshalGQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSurveyRuleCombinator_MarshalGQL(t *testing.T) {
+	w := &bytes.Buffer{}
+	SurveyRuleCombinatorOr.MarshalGQL(w)
+	if got := w.String(); got != strconv.Quote("OR") {
+		t.Errorf("SurveyRuleCombinator.MarshalGQL() = %v, want %v", got, strconv.Quote("OR"))
+	}
+}
@@ -0,0 +1,43 @@
+package exceptions
+
+import "fmt"
+
+// PermissionDeniedErr returns an error message when the logged in user has not been granted the permission required to perform an action
+func PermissionDeniedErr(permission string) error {
+	return &CustomError{
+		Err:     fmt.Errorf("user does not have the %s permission", permission),
+		Code:    int(PermissionDeniedError),
+		Message: "permission denied",
+		Detail:  fmt.Sprintf("you do not have the %s permission required to perform this action", permission),
+	}
+}
+
+// RoleNotFoundErr returns an error message when a role cannot be found in the logged in user's organisation
+func RoleNotFoundErr(err error) error {
+	return &CustomError{
+		Err:     err,
+		Code:    int(RoleNotFoundError),
+		Message: "role not found",
+		Detail:  "the role does not exist in your organisation",
+	}
+}
+
+// SystemRoleModificationErr returns an error message when an attempt is made to change or delete a system role
+func SystemRoleModificationErr(err error) error {
+	return &CustomError{
+		Err:     err,
+		Code:    int(SystemRoleModificationError),
+		Message: "system roles cannot be modified",
+		Detail:  "create a custom role instead",
+	}
+}
+
+// InvalidRoleAssignmentErr returns an error message when a role cannot be assigned to or revoked from a staff
+func InvalidRoleAssignmentErr(err error) error {
+	return &CustomError{
+		Err:     err,
+		Code:    int(InvalidRoleAssignmentError),
+		Message: "invalid role assignment",
+		Detail:  err.Error(),
+	}
+}
@@ -535,3 +535,35 @@ func CreateOrganisationErr(err error) error {
 			"If the problem persists, please contact support.",
 	}
 }
+
+// OTPRateLimitExceededErr returns an error message when the number of OTPs sent to a phone number within the
+// OTP send window has been exceeded
+func OTPRateLimitExceededErr(err error) error {
+	return &CustomError{
+		Err:     err,
+		Message: OTPRateLimitExceededErrorMsg,
+		Code:    OTPRateLimitExceededError,
+		Detail:  err.Error(),
+	}
+}
+
+// AppointmentSlotFullyBookedErr returns an error message when a booking is made against an appointment slot that has
+// no capacity left on the chosen date
+func AppointmentSlotFullyBookedErr(err error) error {
+	return &CustomError{
+		Err:     err,
+		Message: AppointmentSlotFullyBookedErrorMsg,
+		Code:    AppointmentSlotFullyBookedError,
+		Detail:  err.Error(),
+	}
+}
+
+// AppointmentSlotUnavailableErr returns an error message when an appointment slot cannot be booked on the chosen date
+func AppointmentSlotUnavailableErr(err error) error {
+	return &CustomError{
+		Err:     err,
+		Message: AppointmentSlotUnavailableErrorMsg,
+		Code:    AppointmentSlotUnavailableError,
+		Detail:  err.Error(),
+	}
+}
@@ -422,9 +422,36 @@ const (
 	// CreateProgramError means that the system is unable to create a new program
 	// it is error code 85
 	CreateProgramError
+
+	// PermissionDeniedError means that the logged in user has not been granted the permission required to perform an action
+	// it is error code 86
+	PermissionDeniedError
+
+	// RoleNotFoundError means that the role could not be found in the logged in user's organisation
+	// it is error code 87
+	RoleNotFoundError
+
+	// SystemRoleModificationError means that an attempt was made to change or delete a system role
+	// it is error code 88
+	SystemRoleModificationError
+
+	// InvalidRoleAssignmentError means that a role cannot be assigned to or revoked from a staff
+	// it is error code 89
+	InvalidRoleAssignmentError
 )
 
 const (
 	// FailToCreateOrganisation is the error code for use when the system is unable to create an organisation
 	FailToCreateOrganisation = 90
+
+	// OTPRateLimitExceededError is the error code for use when more OTPs than allowed have been requested for a phone number
+	// within the OTP send window
+	OTPRateLimitExceededError = 91
+
+	// AppointmentSlotFullyBookedError is the error code for use when a booking is made against an appointment slot that
+	// has no capacity left on the chosen date
+	AppointmentSlotFullyBookedError = 92
+
+	// AppointmentSlotUnavailableError is the error code for use when an appointment slot cannot be booked on the chosen date
+	AppointmentSlotUnavailableError = 93
 )
@@ -180,4 +180,13 @@ const (
 
 	// FailedToCreateAnOrganizationErrorMsg is the error message displayed when an organization is not created
 	FailedToCreateAnOrganizationErrorMsg = "failed to create an organization"
+
+	// OTPRateLimitExceededErrorMsg is the error message displayed when too many OTPs have been requested for a phone number
+	OTPRateLimitExceededErrorMsg = "too many OTP requests, please try again later"
+
+	// AppointmentSlotFullyBookedErrorMsg is the error message displayed when an appointment slot has no capacity left
+	AppointmentSlotFullyBookedErrorMsg = "the appointment slot is fully booked, please choose another slot"
+
+	// AppointmentSlotUnavailableErrorMsg is the error message displayed when an appointment slot cannot be booked
+	AppointmentSlotUnavailableErrorMsg = "the appointment slot is not available on the chosen date"
 )
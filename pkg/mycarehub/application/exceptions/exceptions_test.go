@@ -129,4 +129,25 @@ func TestCustomErrors(t *testing.T) {
 
 	err = exceptions.ClientCCCIdentifierNotFoundErr(fmt.Errorf("error"))
 	assert.NotNil(t, err)
+
+	err = exceptions.PermissionDeniedErr("organisation.delete")
+	assert.NotNil(t, err)
+
+	err = exceptions.RoleNotFoundErr(fmt.Errorf("error"))
+	assert.NotNil(t, err)
+
+	err = exceptions.SystemRoleModificationErr(fmt.Errorf("error"))
+	assert.NotNil(t, err)
+
+	err = exceptions.InvalidRoleAssignmentErr(fmt.Errorf("error"))
+	assert.NotNil(t, err)
+
+	err = exceptions.OTPRateLimitExceededErr(fmt.Errorf("error"))
+	assert.NotNil(t, err)
+
+	err = exceptions.AppointmentSlotFullyBookedErr(fmt.Errorf("error"))
+	assert.NotNil(t, err)
+
+	err = exceptions.AppointmentSlotUnavailableErr(fmt.Errorf("error"))
+	assert.NotNil(t, err)
 }
@@ -0,0 +1,174 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	// PDFPageWidth is the width of an A4 page in points
+	PDFPageWidth = 595.0
+
+	// PDFPageHeight is the height of an A4 page in points
+	PDFPageHeight = 842.0
+
+	// helveticaAverageWidth is the approximate width of a helvetica character relative to the font size.
+	// It is used to wrap text since the exact glyph widths are not embedded in the document
+	helveticaAverageWidth = 0.5
+)
+
+// PDFDocument is a minimal PDF writer that lays out text, lines and rectangles on A4 pages using the
+// standard Helvetica fonts. Coordinates are in points with the origin at the bottom left of the page
+type PDFDocument struct {
+	pages []*bytes.Buffer
+}
+
+// NewPDFDocument creates a new PDF document with a single blank page
+func NewPDFDocument() *PDFDocument {
+	d := &PDFDocument{}
+	d.AddPage()
+	return d
+}
+
+// AddPage starts a new page. Anything drawn afterwards is added to the new page
+func (d *PDFDocument) AddPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+}
+
+// PageCount returns the number of pages in the document
+func (d *PDFDocument) PageCount() int {
+	return len(d.pages)
+}
+
+func (d *PDFDocument) page() *bytes.Buffer {
+	return d.pages[len(d.pages)-1]
+}
+
+// SetColor sets the RGB colour, with each component between 0 and 1, used to draw and fill subsequent shapes and text
+func (d *PDFDocument) SetColor(r, g, b float64) {
+	fmt.Fprintf(d.page(), "%.3f %.3f %.3f RG %.3f %.3f %.3f rg\n", r, g, b, r, g, b)
+}
+
+// Text writes a single line of text with its baseline starting at the given position
+func (d *PDFDocument) Text(x, y, size float64, bold bool, text string) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	fmt.Fprintf(d.page(), "BT /%s %.2f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, y, escapePDFText(text))
+}
+
+// Line draws a straight line between two points
+func (d *PDFDocument) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(d.page(), "%.2f w %.2f %.2f m %.2f %.2f l S\n", width, x1, y1, x2, y2)
+}
+
+// Rect draws a rectangle whose bottom left corner is at the given position. The rectangle is filled
+// with the current colour if fill is true, otherwise only its outline is drawn
+func (d *PDFDocument) Rect(x, y, width, height float64, fill bool) {
+	operator := "S"
+	if fill {
+		operator = "f"
+	}
+	fmt.Fprintf(d.page(), "%.2f %.2f %.2f %.2f re %s\n", x, y, width, height, operator)
+}
+
+// Bytes renders the document
+func (d *PDFDocument) Bytes() []byte {
+	var (
+		buf     bytes.Buffer
+		offsets []int
+	)
+
+	writeObject := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// the catalog, page tree and fonts come first, followed by a page and its content stream for every page
+	const firstPageObject = 5
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPageObject+2*i)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	writeObject("<< /Type /Catalog /Pages 2 0 R >>")
+	writeObject(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	writeObject("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	writeObject("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range d.pages {
+		writeObject(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			PDFPageWidth, PDFPageHeight, firstPageObject+2*i+1,
+		))
+		writeObject(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.Bytes()
+}
+
+// WrapPDFText splits text into lines that fit within the given width when written at the given font size
+func WrapPDFText(text string, size, width float64) []string {
+	maxChars := int(width / (size * helveticaAverageWidth))
+	if maxChars < 1 {
+		maxChars = 1
+	}
+
+	lines := []string{}
+	for _, paragraph := range strings.Split(text, "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			for len([]rune(word)) > maxChars {
+				if line != "" {
+					lines = append(lines, line)
+					line = ""
+				}
+				lines = append(lines, string([]rune(word)[:maxChars]))
+				word = string([]rune(word)[maxChars:])
+			}
+
+			switch {
+			case line == "":
+				line = word
+			case len([]rune(line))+1+len([]rune(word)) <= maxChars:
+				line += " " + word
+			default:
+				lines = append(lines, line)
+				line = word
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// escapePDFText escapes the characters that have a special meaning in PDF strings and replaces characters
+// that cannot be represented by the standard fonts
+func escapePDFText(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r == '\t':
+			b.WriteRune(' ')
+		case r < 0x20 || r > 0x7e:
+			b.WriteRune('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestPDFDocument_Bytes(t *testing.T) {
+	doc := NewPDFDocument()
+	doc.SetColor(0.2, 0.4, 0.6)
+	doc.Text(40, 800, 12, true, "Health diary (export)")
+	doc.Line(40, 790, 555, 790, 1)
+	doc.AddPage()
+	doc.Rect(40, 40, 100, 100, true)
+
+	if doc.PageCount() != 2 {
+		t.Errorf("expected 2 pages, got %d", doc.PageCount())
+		return
+	}
+
+	got := doc.Bytes()
+	for _, want := range []string{
+		"%PDF-1.4",
+		"/Type /Pages /Kids [5 0 R 7 0 R] /Count 2",
+		"(Health diary \\(export\\)) Tj",
+		"40.00 40.00 100.00 100.00 re f",
+		"xref\n0 9\n",
+		"%%EOF",
+	} {
+		if !bytes.Contains(got, []byte(want)) {
+			t.Errorf("expected the document to contain %q", want)
+		}
+	}
+}
+
+func TestWrapPDFText(t *testing.T) {
+	type args struct {
+		text  string
+		size  float64
+		width float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "Happy case: text fits on a line",
+			args: args{
+				text:  "feeling good",
+				size:  10,
+				width: 100,
+			},
+			want: []string{"feeling good"},
+		},
+		{
+			name: "Happy case: text is wrapped",
+			args: args{
+				text:  "I had a long day at the clinic",
+				size:  10,
+				width: 50,
+			},
+			want: []string{"I had a", "long day", "at the", "clinic"},
+		},
+		{
+			name: "Happy case: long words are split",
+			args: args{
+				text:  "exhausted",
+				size:  10,
+				width: 25,
+			},
+			want: []string{"exhau", "sted"},
+		},
+		{
+			name: "Happy case: new lines are kept",
+			args: args{
+				text:  "first\nsecond",
+				size:  10,
+				width: 100,
+			},
+			want: []string{"first", "second"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WrapPDFText(tt.args.text, tt.args.size, tt.args.width); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WrapPDFText() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
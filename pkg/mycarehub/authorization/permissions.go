@@ -15,10 +15,12 @@ type PermissionCategory string
 const (
 	PermissionCategoryAppointment      PermissionCategory = "Appointment"
 	PermissionCategoryAuthorization    PermissionCategory = "Authorization"
+	PermissionCategoryCommunity        PermissionCategory = "Community"
 	PermissionCategoryContent          PermissionCategory = "Content"
 	PermissionCategoryFacility         PermissionCategory = "Facility"
 	PermissionCategoryFeedback         PermissionCategory = "Feedback"
 	PermissionCategoryHealthDiary      PermissionCategory = "HealthDiary"
+	PermissionCategoryMetric           PermissionCategory = "Metric"
 	PermissionCategoryNotification     PermissionCategory = "Notification"
 	PermissionCategoryOrganisation     PermissionCategory = "Organisation"
 	PermissionCategoryOTP              PermissionCategory = "OTP"
@@ -35,10 +37,12 @@ func (p PermissionCategory) IsValid() bool {
 	switch p {
 	case PermissionCategoryAppointment,
 		PermissionCategoryAuthorization,
+		PermissionCategoryCommunity,
 		PermissionCategoryContent,
 		PermissionCategoryFacility,
 		PermissionCategoryFeedback,
 		PermissionCategoryHealthDiary,
+		PermissionCategoryMetric,
 		PermissionCategoryNotification,
 		PermissionCategoryOrganisation,
 		PermissionCategoryOTP,
@@ -91,6 +95,24 @@ var (
 		Category:    PermissionCategoryAppointment.String(),
 		Scope:       "client.appointment.update",
 	}
+	canReadAppointmentSlot = domain.AuthorityPermission{
+		Name:        "Read appointment slots",
+		Description: "Can read the appointment slots published by a facility",
+		Category:    PermissionCategoryAppointment.String(),
+		Scope:       "appointment.slot.read",
+	}
+	canCreateAppointmentSlot = domain.AuthorityPermission{
+		Name:        "Create appointment slots",
+		Description: "Can publish appointment slots for a facility",
+		Category:    PermissionCategoryAppointment.String(),
+		Scope:       "appointment.slot.create",
+	}
+	canDeleteAppointmentSlot = domain.AuthorityPermission{
+		Name:        "Delete appointment slots",
+		Description: "Can stop a facility from accepting bookings against an appointment slot",
+		Category:    PermissionCategoryAppointment.String(),
+		Scope:       "appointment.slot.delete",
+	}
 )
 
 // Authorization Permissions
@@ -101,6 +123,58 @@ var (
 		Category:    PermissionCategoryAuthorization.String(),
 		Scope:       "role.read",
 	}
+	canCreateRole = domain.AuthorityPermission{
+		Name:        "Create roles",
+		Description: "Can create custom roles",
+		Category:    PermissionCategoryAuthorization.String(),
+		Scope:       "role.create",
+	}
+	canUpdateRole = domain.AuthorityPermission{
+		Name:        "Update roles",
+		Description: "Can update custom roles",
+		Category:    PermissionCategoryAuthorization.String(),
+		Scope:       "role.update",
+	}
+	canDeleteRole = domain.AuthorityPermission{
+		Name:        "Delete roles",
+		Description: "Can delete custom roles",
+		Category:    PermissionCategoryAuthorization.String(),
+		Scope:       "role.delete",
+	}
+	canAssignRole = domain.AuthorityPermission{
+		Name:        "Assign roles",
+		Description: "Can assign and revoke staff roles",
+		Category:    PermissionCategoryAuthorization.String(),
+		Scope:       "role.assign",
+	}
+)
+
+// Community Permissions
+var (
+	canReadCommunity = domain.AuthorityPermission{
+		Name:        "Read community",
+		Description: "Can read community",
+		Category:    PermissionCategoryCommunity.String(),
+		Scope:       "community.read",
+	}
+	canCreateCommunity = domain.AuthorityPermission{
+		Name:        "Create community",
+		Description: "Can create community",
+		Category:    PermissionCategoryCommunity.String(),
+		Scope:       "community.create",
+	}
+	canModerateCommunity = domain.AuthorityPermission{
+		Name:        "Moderate community",
+		Description: "Can moderate community members and messages",
+		Category:    PermissionCategoryCommunity.String(),
+		Scope:       "community.moderate",
+	}
+	canManageCommunityCrisisKeywords = domain.AuthorityPermission{
+		Name:        "Manage community crisis keywords",
+		Description: "Can manage the keywords used to flag community messages that may indicate a crisis",
+		Category:    PermissionCategoryCommunity.String(),
+		Scope:       "community.crisis.manage",
+	}
 )
 
 // Content Permissions
@@ -111,6 +185,12 @@ var (
 		Category:    PermissionCategoryContent.String(),
 		Scope:       "content.read",
 	}
+	canCreateContentInteraction = domain.AuthorityPermission{
+		Name:        "Create content interaction",
+		Description: "Can like, bookmark, share and view content",
+		Category:    PermissionCategoryContent.String(),
+		Scope:       "content.interaction.create",
+	}
 )
 
 // Facility Permissions
@@ -139,6 +219,12 @@ var (
 		Category:    PermissionCategoryFacility.String(),
 		Scope:       "program.facility.create",
 	}
+	canUpdateDefaultFacility = domain.AuthorityPermission{
+		Name:        "Update default facility",
+		Description: "Can update a user's default facility",
+		Category:    PermissionCategoryFacility.String(),
+		Scope:       "facility.default.update",
+	}
 )
 
 // Feedback Permissions
@@ -171,6 +257,22 @@ var (
 		Category:    PermissionCategoryHealthDiary.String(),
 		Scope:       "client.healthdiary.read",
 	}
+	canShareHealthDiary = domain.AuthorityPermission{
+		Name:        "Share health diary",
+		Description: "Can share health diary with a health worker",
+		Category:    PermissionCategoryHealthDiary.String(),
+		Scope:       "healthdiary.share",
+	}
+)
+
+// Metric Permissions
+var (
+	canCreateMetric = domain.AuthorityPermission{
+		Name:        "Create metric",
+		Description: "Can create metric",
+		Category:    PermissionCategoryMetric.String(),
+		Scope:       "metric.create",
+	}
 )
 
 // Notification Permissions
@@ -181,6 +283,18 @@ var (
 		Category:    PermissionCategoryNotification.String(),
 		Scope:       "notification.read",
 	}
+	canUpdateNotification = domain.AuthorityPermission{
+		Name:        "Update notification",
+		Description: "Can update notification",
+		Category:    PermissionCategoryNotification.String(),
+		Scope:       "notification.update",
+	}
+	canSendNotification = domain.AuthorityPermission{
+		Name:        "Send notification",
+		Description: "Can send notification",
+		Category:    PermissionCategoryNotification.String(),
+		Scope:       "notification.send",
+	}
 )
 
 // Organisation Permissions
@@ -203,6 +317,18 @@ var (
 		Category:    PermissionCategoryOrganisation.String(),
 		Scope:       "organisation.delete",
 	}
+	canCreateOrganisationAdmin = domain.AuthorityPermission{
+		Name:        "Create organisation admin",
+		Description: "Can create organisation admin",
+		Category:    PermissionCategoryOrganisation.String(),
+		Scope:       "organisation.admin.create",
+	}
+	canReadOrganisationAuditLog = domain.AuthorityPermission{
+		Name:        "Read organisation audit log",
+		Description: "Can read the audit log of sensitive changes made in the organisation",
+		Category:    PermissionCategoryOrganisation.String(),
+		Scope:       "organisation.auditlog.read",
+	}
 )
 
 // OTP Permissions
@@ -269,6 +395,12 @@ var (
 		Category:    PermissionCategoryScreeningTool.String(),
 		Scope:       "screeningtool.respondent.read",
 	}
+	canAssignScreeningTool = domain.AuthorityPermission{
+		Name:        "Assign screening tool",
+		Description: "Can assign a screening tool to a client",
+		Category:    PermissionCategoryScreeningTool.String(),
+		Scope:       "screeningtool.assign",
+	}
 )
 
 // SecurityQuestion Permissions
@@ -347,12 +479,24 @@ var (
 		Category:    PermissionCategorySurvey.String(),
 		Scope:       "survey.response.read",
 	}
+	canCreateSurveyResponse = domain.AuthorityPermission{
+		Name:        "Create survey response",
+		Description: "Can create survey response",
+		Category:    PermissionCategorySurvey.String(),
+		Scope:       "survey.response.create",
+	}
 	canCreateSurveyLink = domain.AuthorityPermission{
 		Name:        "Create survey link",
 		Description: "Can create survey link",
 		Category:    PermissionCategorySurvey.String(),
 		Scope:       "survey.link.create",
 	}
+	canManageSurveyRedFlagRules = domain.AuthorityPermission{
+		Name:        "Manage survey red flag rules",
+		Description: "Can create and delete the rules that raise red flags from survey responses",
+		Category:    PermissionCategorySurvey.String(),
+		Scope:       "survey.rule.manage",
+	}
 )
 
 // User Permissions
@@ -418,10 +562,34 @@ var (
 		Scope:       "client.identifier.read",
 	}
 	canUpdateUser = domain.AuthorityPermission{
-		Name:        "Create user",
-		Description: "Can create user",
+		Name:        "Update user",
+		Description: "Can update user",
+		Category:    PermissionCategoryUser.String(),
+		Scope:       "user.update",
+	}
+	canUpdateUserProfile = domain.AuthorityPermission{
+		Name:        "Update user profile",
+		Description: "Can update another user's profile",
+		Category:    PermissionCategoryUser.String(),
+		Scope:       "user.profile.update",
+	}
+	canUpdateClientFacility = domain.AuthorityPermission{
+		Name:        "Update client's facility",
+		Description: "Can update client's facility",
+		Category:    PermissionCategoryUser.String(),
+		Scope:       "client.facility.update",
+	}
+	canUpdateStaffFacility = domain.AuthorityPermission{
+		Name:        "Update staff's facility",
+		Description: "Can update staff's facility",
+		Category:    PermissionCategoryUser.String(),
+		Scope:       "staff.facility.update",
+	}
+	canUpdateCaregiverConsent = domain.AuthorityPermission{
+		Name:        "Update caregiver consent",
+		Description: "Can give or withdraw consent to a caregiver relationship",
 		Category:    PermissionCategoryUser.String(),
-		Scope:       "user.create",
+		Scope:       "caregiver.consent.update",
 	}
 	canCreateClient = domain.AuthorityPermission{
 		Name:        "Create client",
@@ -461,18 +629,33 @@ func AllPermissions(ctx context.Context) []domain.AuthorityPermission {
 		// Appointment Permissions
 		canReadClientAppointment,
 		canUpdateClientAppointment,
+		canReadAppointmentSlot,
+		canCreateAppointmentSlot,
+		canDeleteAppointmentSlot,
 
 		// Authorization Permissions
 		canReadSystemRole,
+		canCreateRole,
+		canUpdateRole,
+		canDeleteRole,
+		canAssignRole,
+
+		// Community Permissions
+		canReadCommunity,
+		canCreateCommunity,
+		canModerateCommunity,
+		canManageCommunityCrisisKeywords,
 
 		// Content Permissions
 		canReadContent,
+		canCreateContentInteraction,
 
 		// Facility Permissions
 		canDeleteFacility,
 		canUpdateFacility,
 		canReadFacility,
 		canCreateProgramFacility,
+		canUpdateDefaultFacility,
 
 		// Feedback Permissions
 		canCreateFeedback,
@@ -481,14 +664,22 @@ func AllPermissions(ctx context.Context) []domain.AuthorityPermission {
 		canCreateHealthDiary,
 		canReadHealthDiary,
 		canReadClientHealthDiary,
+		canShareHealthDiary,
+
+		// Metric Permissions
+		canCreateMetric,
 
 		// Notification Permissions
 		canReadNotification,
+		canUpdateNotification,
+		canSendNotification,
 
 		// Organisation Permissions
 		canReadOrganisation,
 		canCreateOrganisation,
 		canDeleteOrganisation,
+		canCreateOrganisationAdmin,
+		canReadOrganisationAuditLog,
 
 		// OTP Permissions
 		canCreateOTP,
@@ -504,6 +695,7 @@ func AllPermissions(ctx context.Context) []domain.AuthorityPermission {
 		canReadScreeningToolResponse,
 		canCreateScreeningToolResponse,
 		canReadScreeningToolRespondent,
+		canAssignScreeningTool,
 
 		// SecurityQuestion Permissions
 		canReadSecurityQuestion,
@@ -521,7 +713,9 @@ func AllPermissions(ctx context.Context) []domain.AuthorityPermission {
 		canReadSurveyRespondent,
 		canReadClientWithServiceRequest,
 		canReadSurveyResponse,
+		canCreateSurveyResponse,
 		canCreateSurveyLink,
+		canManageSurveyRedFlagRules,
 
 		// User Permissions
 		canReadTerms,
@@ -539,21 +733,155 @@ func AllPermissions(ctx context.Context) []domain.AuthorityPermission {
 		canCreateStaff,
 		canCreateCaregiver,
 		canDeleteUser,
+		canCreateUserInvite,
+		canUpdateUserProfile,
+		canUpdateClientFacility,
+		canUpdateStaffFacility,
+		canUpdateCaregiverConsent,
 	}
 }
 
+// DefaultStaffPermissions return default staff permissions. They exclude the permissions used to administer
+// organisations, programs, facilities and roles
+func DefaultStaffPermissions(ctx context.Context) []domain.AuthorityPermission {
+	return []domain.AuthorityPermission{
+		// Appointment Permissions
+		canReadClientAppointment,
+		canUpdateClientAppointment,
+		canReadAppointmentSlot,
+		canCreateAppointmentSlot,
+		canDeleteAppointmentSlot,
+
+		// Authorization Permissions
+		canReadSystemRole,
+
+		// Community Permissions
+		canReadCommunity,
+		canCreateCommunity,
+		canModerateCommunity,
+
+		// Content Permissions
+		canReadContent,
+		canCreateContentInteraction,
+
+		// Facility Permissions
+		canReadFacility,
+		canUpdateDefaultFacility,
+
+		// Feedback Permissions
+		canCreateFeedback,
+
+		// HealthDiary Permissions
+		canReadClientHealthDiary,
+
+		// Metric Permissions
+		canCreateMetric,
+
+		// Notification Permissions
+		canReadNotification,
+		canUpdateNotification,
+		canSendNotification,
+
+		// Organisation Permissions
+		canReadOrganisation,
+
+		// OTP Permissions
+		canCreateOTP,
+
+		// Program Permissions
+		canReadProgram,
+
+		// ScreeningTool Permissions
+		canReadScreeningTool,
+		canReadScreeningToolResponse,
+		canReadScreeningToolRespondent,
+		canAssignScreeningTool,
+
+		// SecurityQuestion Permissions
+		canReadSecurityQuestion,
+		canCreateSecurityQuestion,
+
+		// ServiceRequest Permissions
+		canReadServiceRequest,
+		canCreateServiceRequest,
+		canUpdateServiceRequest,
+		canUpdateClientServiceRequest,
+		canUpdateStaffServiceRequest,
+
+		// Survey Permissions
+		canReadSurvey,
+		canReadSurveyRespondent,
+		canReadClientWithServiceRequest,
+		canReadSurveyResponse,
+		canCreateSurveyLink,
+
+		// User Permissions
+		canReadTerms,
+		canReadPin,
+		canReadClient,
+		canReadStaff,
+		canReadCaregiver,
+		canReadClientOfCaregiver,
+		canReadCaregiverOfClient,
+		canReadStaffFacility,
+		canReadClientFacility,
+		canReadClientIdentifier,
+		canUpdateUser,
+		canCreateClient,
+		canCreateCaregiver,
+		canCreateUserInvite,
+		canUpdateUserProfile,
+		canUpdateClientFacility,
+		canUpdateCaregiverConsent,
+	}
+}
+
+// platformPermissions are the permissions whose actions reach beyond a single organisation
+var platformPermissions = []domain.AuthorityPermission{
+	canCreateOrganisation,
+	canDeleteOrganisation,
+}
+
+// IsPlatformPermission checks whether a permission scope is a platform wide permission that is only granted to the superuser
+func IsPlatformPermission(scope string) bool {
+	for _, permission := range platformPermissions {
+		if permission.Scope == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultAdminPermissions return default organisation admin permissions. These are all the defined permissions
+// except the platform wide ones
+func DefaultAdminPermissions(ctx context.Context) []domain.AuthorityPermission {
+	permissions := []domain.AuthorityPermission{}
+	for _, permission := range AllPermissions(ctx) {
+		if !IsPlatformPermission(permission.Scope) {
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions
+}
+
 // DefaultClientPermissions return default client permissions
 func DefaultClientPermissions(ctx context.Context) []domain.AuthorityPermission {
 	return []domain.AuthorityPermission{
 		// Appointment Permissions
 		canReadClientAppointment,
 		canUpdateClientAppointment,
+		canReadAppointmentSlot,
+
+		// Community Permissions
+		canReadCommunity,
 
 		// Content Permissions
 		canReadContent,
+		canCreateContentInteraction,
 
 		// Facility Permissions
 		canReadFacility,
+		canUpdateDefaultFacility,
 
 		// Feedback Permissions
 		canCreateFeedback,
@@ -561,9 +889,14 @@ func DefaultClientPermissions(ctx context.Context) []domain.AuthorityPermission
 		// HealthDiary Permissions
 		canCreateHealthDiary,
 		canReadHealthDiary,
+		canShareHealthDiary,
+
+		// Metric Permissions
+		canCreateMetric,
 
 		// Notification Permissions
 		canReadNotification,
+		canUpdateNotification,
 
 		// Organisation Permissions
 		canReadOrganisation,
@@ -587,6 +920,7 @@ func DefaultClientPermissions(ctx context.Context) []domain.AuthorityPermission
 
 		// Survey Permissions
 		canReadSurvey,
+		canCreateSurveyResponse,
 
 		// User Permissions
 		canReadTerms,
@@ -595,6 +929,7 @@ func DefaultClientPermissions(ctx context.Context) []domain.AuthorityPermission
 		canReadCaregiverOfClient,
 		canReadClientFacility,
 		canUpdateUser,
+		canUpdateCaregiverConsent,
 	}
 }
 
@@ -604,9 +939,11 @@ func DefaultCaregiverPermissions(ctx context.Context) []domain.AuthorityPermissi
 		// Appointment Permissions
 		canReadClientAppointment,
 		canUpdateClientAppointment,
+		canReadAppointmentSlot,
 
 		// Facility Permissions
 		canReadFacility,
+		canUpdateDefaultFacility,
 
 		// Feedback Permissions
 		canCreateFeedback,
@@ -614,8 +951,12 @@ func DefaultCaregiverPermissions(ctx context.Context) []domain.AuthorityPermissi
 		// HealthDiary Permissions
 		canReadHealthDiary,
 
+		// Metric Permissions
+		canCreateMetric,
+
 		// Notification Permissions
 		canReadNotification,
+		canUpdateNotification,
 
 		// Organisation Permissions
 		canReadOrganisation,
@@ -640,5 +981,16 @@ func DefaultCaregiverPermissions(ctx context.Context) []domain.AuthorityPermissi
 		canReadClientOfCaregiver,
 		canReadClientFacility,
 		canUpdateUser,
+		canUpdateCaregiverConsent,
+	}
+}
+
+// GetPermissionByScope returns the defined permission that has the provided scope
+func GetPermissionByScope(ctx context.Context, scope string) (*domain.AuthorityPermission, bool) {
+	for _, permission := range AllPermissions(ctx) {
+		if permission.Scope == scope {
+			return &permission, true
+		}
 	}
+	return nil, false
 }
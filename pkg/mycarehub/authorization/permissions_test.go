@@ -2,6 +2,7 @@ package authorization
 
 import (
 	"bytes"
+	"context"
 	"strconv"
 	"testing"
 )
@@ -121,3 +122,72 @@ func TestPermissionCategory_MarshalGQL(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPermissionByScope(t *testing.T) {
+	tests := []struct {
+		name   string
+		scope  string
+		wantOk bool
+	}{
+		{
+			name:   "defined permission",
+			scope:  "role.create",
+			wantOk: true,
+		},
+		{
+			name:   "undefined permission",
+			scope:  "role.invalid",
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := GetPermissionByScope(context.Background(), tt.scope)
+			if ok != tt.wantOk {
+				t.Errorf("GetPermissionByScope() ok = %v, want %v", ok, tt.wantOk)
+				return
+			}
+			if ok && got.Scope != tt.scope {
+				t.Errorf("GetPermissionByScope() = %v, want %v", got.Scope, tt.scope)
+			}
+		})
+	}
+}
+
+func TestIsPlatformPermission(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope string
+		want  bool
+	}{
+		{
+			name:  "platform permission",
+			scope: "organisation.delete",
+			want:  true,
+		},
+		{
+			name:  "organisation permission",
+			scope: "organisation.read",
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPlatformPermission(tt.scope); got != tt.want {
+				t.Errorf("IsPlatformPermission() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultAdminPermissions(t *testing.T) {
+	permissions := DefaultAdminPermissions(context.Background())
+	if len(permissions) != len(AllPermissions(context.Background()))-len(platformPermissions) {
+		t.Errorf("DefaultAdminPermissions() returned %d permissions", len(permissions))
+	}
+	for _, permission := range permissions {
+		if IsPlatformPermission(permission.Scope) {
+			t.Errorf("DefaultAdminPermissions() should not include %s", permission.Scope)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"strconv"
 
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 )
 
@@ -13,7 +14,9 @@ import (
 type DefaultRole string
 
 const (
+	DefaultRoleSuperUser DefaultRole = "Super User"
 	DefaultRoleAdmin     DefaultRole = "Default Admin"
+	DefaultRoleStaff     DefaultRole = "Default Staff"
 	DefaultRoleClient    DefaultRole = "Default Client"
 	DefaultRoleCaregiver DefaultRole = "Default Caregiver"
 )
@@ -21,7 +24,9 @@ const (
 // IsValid checks if a string of type DefaultRole is of the valid type
 func (p DefaultRole) IsValid() bool {
 	switch p {
-	case DefaultRoleAdmin,
+	case DefaultRoleSuperUser,
+		DefaultRoleAdmin,
+		DefaultRoleStaff,
 		DefaultRoleClient,
 		DefaultRoleCaregiver:
 		return true
@@ -54,10 +59,25 @@ func (p DefaultRole) MarshalGQL(w io.Writer) {
 }
 
 var (
+	// DefaultSuperUserRole defines the role assigned to the platform superuser
+	DefaultSuperUserRole = domain.AuthorityRole{
+		Name:        DefaultRoleSuperUser.String(),
+		Description: "Can perform all the actions in the platform, including managing organisations",
+		Permissions: AllPermissions(context.Background()),
+	}
+
 	// DefaultAdminRole defines the default role assigned to an admin
 	DefaultAdminRole = domain.AuthorityRole{
 		Name:        DefaultRoleAdmin.String(),
-		Permissions: AllPermissions(context.Background()),
+		Description: "Can perform all the actions in the organisation",
+		Permissions: DefaultAdminPermissions(context.Background()),
+	}
+
+	// DefaultStaffRole defines the default role assigned to a staff
+	DefaultStaffRole = domain.AuthorityRole{
+		Name:        DefaultRoleStaff.String(),
+		Description: "Can provide care to the clients in the organisation",
+		Permissions: DefaultStaffPermissions(context.Background()),
 	}
 
 	// DefaultClientRole defines the default role assigned to a client
@@ -71,3 +91,28 @@ var (
 		Permissions: DefaultCaregiverPermissions(context.Background()),
 	}
 )
+
+// DefaultUserTypeRole returns the default role that is granted to users of the provided user type.
+// Staff do not have a default role since they are only granted the roles they have been assigned
+func DefaultUserTypeRole(userType enums.UsersType) (*domain.AuthorityRole, bool) {
+	switch userType {
+	case enums.ClientUser:
+		return &DefaultClientRole, true
+	case enums.CaregiverUser:
+		return &DefaultCaregiverRole, true
+	}
+	return nil, false
+}
+
+// SystemStaffRole returns the definition of an organisation wide system role that can be assigned to staff
+func SystemStaffRole(role DefaultRole) (*domain.AuthorityRole, bool) {
+	switch role {
+	case DefaultRoleSuperUser:
+		return &DefaultSuperUserRole, true
+	case DefaultRoleAdmin:
+		return &DefaultAdminRole, true
+	case DefaultRoleStaff:
+		return &DefaultStaffRole, true
+	}
+	return nil, false
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"strconv"
 	"testing"
+
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 )
 
 func TestDefaultRole_IsValid(t *testing.T) {
@@ -121,3 +123,88 @@ func TestDefaultRole_MarshalGQL(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultUserTypeRole(t *testing.T) {
+	tests := []struct {
+		name     string
+		userType enums.UsersType
+		wantRole string
+		wantOk   bool
+	}{
+		{
+			name:     "staff user",
+			userType: enums.StaffUser,
+			wantOk:   false,
+		},
+		{
+			name:     "client user",
+			userType: enums.ClientUser,
+			wantRole: DefaultRoleClient.String(),
+			wantOk:   true,
+		},
+		{
+			name:     "caregiver user",
+			userType: enums.CaregiverUser,
+			wantRole: DefaultRoleCaregiver.String(),
+			wantOk:   true,
+		},
+		{
+			name:     "user type without a default role",
+			userType: enums.UsersType(""),
+			wantOk:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := DefaultUserTypeRole(tt.userType)
+			if ok != tt.wantOk {
+				t.Errorf("DefaultUserTypeRole() ok = %v, want %v", ok, tt.wantOk)
+				return
+			}
+			if ok && got.Name != tt.wantRole {
+				t.Errorf("DefaultUserTypeRole() = %v, want %v", got.Name, tt.wantRole)
+			}
+		})
+	}
+}
+
+func TestSystemStaffRole(t *testing.T) {
+	tests := []struct {
+		name   string
+		role   DefaultRole
+		wantOk bool
+	}{
+		{
+			name:   "super user role",
+			role:   DefaultRoleSuperUser,
+			wantOk: true,
+		},
+		{
+			name:   "default admin role",
+			role:   DefaultRoleAdmin,
+			wantOk: true,
+		},
+		{
+			name:   "default staff role",
+			role:   DefaultRoleStaff,
+			wantOk: true,
+		},
+		{
+			name:   "role that is not a staff system role",
+			role:   DefaultRoleClient,
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := SystemStaffRole(tt.role)
+			if ok != tt.wantOk {
+				t.Errorf("SystemStaffRole() ok = %v, want %v", ok, tt.wantOk)
+				return
+			}
+			if ok && got.Name != tt.role.String() {
+				t.Errorf("SystemStaffRole() = %v, want %v", got.Name, tt.role.String())
+			}
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package domain
 
 import (
+	"errors"
 	"time"
 
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 	"github.com/savannahghi/scalarutils"
 )
 
@@ -13,11 +15,13 @@ type Appointment struct {
 	Reason     string           `json:"reason"`
 	Date       scalarutils.Date `json:"date"`
 
-	ClientID                  string `json:"clientID"`
-	FacilityID                string `json:"facilityID"`
-	Provider                  string `json:"provider"`
-	HasRescheduledAppointment bool   `json:"hasRescheduledApointment"`
-	ProgramID                 string `json:"programID"`
+	ClientID                  string  `json:"clientID"`
+	FacilityID                string  `json:"facilityID"`
+	Provider                  string  `json:"provider"`
+	HasRescheduledAppointment bool    `json:"hasRescheduledApointment"`
+	ProgramID                 string  `json:"programID"`
+	Missed                    bool    `json:"missed"`
+	SlotID                    *string `json:"slotID"`
 }
 
 // AppointmentsPage is a list of paginated appointments
@@ -42,4 +46,49 @@ type AppointmentServiceRequests struct {
 	ClientContact *string    `json:"ClientContact"`
 	CCCNumber     string     `json:"CCCNumber"`
 	MFLCODE       string     `json:"MFLCODE"`
+
+	Action enums.AppointmentServiceRequestAction `json:"Action"`
+}
+
+// AppointmentReminder records a reminder that has been sent to a client ahead of an appointment
+type AppointmentReminder struct {
+	ID            string    `json:"id"`
+	AppointmentID string    `json:"appointmentID"`
+	OffsetDays    int       `json:"offsetDays"`
+	PushSent      bool      `json:"pushSent"`
+	SMSSent       bool      `json:"smsSent"`
+	SentAt        time.Time `json:"sentAt"`
+}
+
+// ErrAppointmentSlotFullyBooked is returned when a booking is made against a slot that has no capacity left on the chosen date
+var ErrAppointmentSlotFullyBooked = errors.New("appointment slot is fully booked")
+
+// AppointmentSlot is a recurring weekly period during which a facility accepts appointment bookings
+type AppointmentSlot struct {
+	ID         string   `json:"id"`
+	Active     bool     `json:"active"`
+	FacilityID string   `json:"facilityID"`
+	DayOfWeek  int      `json:"dayOfWeek"`
+	StartTime  string   `json:"startTime"`
+	EndTime    string   `json:"endTime"`
+	Capacity   int      `json:"capacity"`
+	Reasons    []string `json:"reasons"`
+}
+
+// AvailableAppointmentSlot is an appointment slot on a specific date that still has room for bookings
+type AvailableAppointmentSlot struct {
+	SlotID    string           `json:"slotID"`
+	Date      scalarutils.Date `json:"date"`
+	StartTime string           `json:"startTime"`
+	EndTime   string           `json:"endTime"`
+	Reasons   []string         `json:"reasons"`
+	Capacity  int              `json:"capacity"`
+	Available int              `json:"available"`
+}
+
+// AppointmentSlotBookingCount is the number of active bookings made against an appointment slot on a date
+type AppointmentSlotBookingCount struct {
+	SlotID string    `json:"slotID"`
+	Date   time.Time `json:"date"`
+	Count  int       `json:"count"`
 }
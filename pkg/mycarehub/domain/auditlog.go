@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+)
+
+// AuditLog is a record of a sensitive change made in the system e.g a client being transferred to a different facility.
+// It records who made the change, what was changed and what the values were before and after the change
+type AuditLog struct {
+	ID             string                   `json:"id"`
+	Timestamp      time.Time                `json:"timestamp"`
+	RecordType     enums.AuditLogRecordType `json:"recordType"`
+	Notes          string                   `json:"notes"`
+	ActorID        string                   `json:"actorID"`
+	TargetID       string                   `json:"targetID"`
+	TargetType     string                   `json:"targetType"`
+	OldValue       map[string]interface{}   `json:"oldValue"`
+	NewValue       map[string]interface{}   `json:"newValue"`
+	OrganisationID string                   `json:"organisationID"`
+	ProgramID      string                   `json:"programID"`
+}
+
+// AuditLogPage is used to return a paginated list of audit logs
+type AuditLogPage struct {
+	Pagination Pagination  `json:"pagination"`
+	AuditLogs  []*AuditLog `json:"auditLogs"`
+}
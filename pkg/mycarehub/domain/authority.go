@@ -6,12 +6,20 @@ import "github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 type AuthorityRole struct {
 	AuthorityRoleID string                `json:"authorityRoleID"`
 	Name            string                `json:"name"`
+	Description     string                `json:"description"`
 	Active          bool                  `json:"active"`
+	IsSystemRole    bool                  `json:"isSystemRole"`
 	OrganisationID  string                `json:"organisationID"`
 	ProgramID       string                `json:"programID"`
 	Permissions     []AuthorityPermission `json:"permissions"`
 }
 
+// AuthorityRoleMembersPage is a paginated list of the staff that have been assigned a role
+type AuthorityRoleMembersPage struct {
+	Pagination Pagination      `json:"pagination"`
+	Staff      []*StaffProfile `json:"staff"`
+}
+
 // AuthorityPermission defines user permissions
 type AuthorityPermission struct {
 	PermissionID string               `json:"permissionID"`
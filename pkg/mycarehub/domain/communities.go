@@ -1,6 +1,10 @@
 package domain
 
 import (
+	"regexp"
+	"strings"
+	"time"
+
 	"github.com/savannahghi/enumutils"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 )
@@ -19,6 +23,81 @@ type Community struct {
 	OrganisationID string             `json:"organisationID"`
 	ProgramID      string             `json:"programID"`
 	FacilityID     string             `json:"facilityID"`
+
+	// The fields below are computed for the user viewing the community
+	MemberCount int  `json:"memberCount"`
+	UnreadCount int  `json:"unreadCount"`
+	Eligible    bool `json:"eligible"`
+}
+
+// CommunityPage is a paginated list of communities
+type CommunityPage struct {
+	Pagination  Pagination   `json:"pagination"`
+	Communities []*Community `json:"communities"`
+}
+
+// IsEligible returns true if a client meets the community's membership criteria as at the supplied time.
+// A criterion that has not been set does not restrict membership.
+func (c Community) IsEligible(client *ClientProfile, now time.Time) bool {
+	if client == nil || !client.Active || client.User == nil || client.ProgramID != c.ProgramID {
+		return false
+	}
+
+	if c.FacilityID != "" {
+		if client.DefaultFacility == nil || client.DefaultFacility.ID == nil || *client.DefaultFacility.ID != c.FacilityID {
+			return false
+		}
+	}
+
+	if len(c.ClientType) > 0 && !hasClientType(c.ClientType, client.ClientTypes) {
+		return false
+	}
+
+	if len(c.Gender) > 0 {
+		eligibleGender := false
+		for _, gender := range c.Gender {
+			if strings.EqualFold(string(gender), string(client.User.Gender)) {
+				eligibleGender = true
+				break
+			}
+		}
+		if !eligibleGender {
+			return false
+		}
+	}
+
+	if c.AgeRange != nil && c.AgeRange.UpperBound > 0 {
+		if client.User.DateOfBirth == nil {
+			return false
+		}
+		age := ageAt(*client.User.DateOfBirth, now)
+		if age < c.AgeRange.LowerBound || age > c.AgeRange.UpperBound {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasClientType returns true if any of the client's types is in the allowed types
+func hasClientType(allowed []enums.ClientType, clientTypes []enums.ClientType) bool {
+	for _, allowedType := range allowed {
+		for _, clientType := range clientTypes {
+			if strings.EqualFold(string(allowedType), string(clientType)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ageAt returns the age in complete years of a person born on dob at the supplied time
+func ageAt(dob time.Time, now time.Time) int {
+	age := now.Year() - dob.Year()
+	if now.Month() < dob.Month() || (now.Month() == dob.Month() && now.Day() < dob.Day()) {
+		age--
+	}
+	return age
 }
 
 // AgeRange defines the channel users age input
@@ -39,3 +118,57 @@ type MatrixUserRegistration struct {
 	Password string `json:"password"`
 	Admin    bool   `json:"admin"`
 }
+
+// MatrixRoomMember is a user's membership of a Matrix room
+type MatrixRoomMember struct {
+	Username string `json:"username"`
+	// Membership is one of Matrix's membership states i.e join, invite, leave or ban
+	Membership string `json:"membership"`
+	// SelfChanged is true when the membership was last changed by the user themselves e.g. by leaving the room
+	// or declining an invite, rather than by a moderator
+	SelfChanged bool `json:"selfChanged"`
+}
+
+// IsJoined returns true if the user has joined the room
+func (m MatrixRoomMember) IsJoined() bool {
+	return m.Membership == "join"
+}
+
+// IsMember returns true if the user has joined or been invited to the room
+func (m MatrixRoomMember) IsMember() bool {
+	return m.Membership == "join" || m.Membership == "invite"
+}
+
+// HasOptedOut returns true if the user chose to leave the room or was banned from it and should therefore not be invited again
+func (m MatrixRoomMember) HasOptedOut() bool {
+	return m.Membership == "ban" || (m.Membership == "leave" && m.SelfChanged)
+}
+
+// CommunityCrisisKeyword is a word, phrase or regular expression used to flag community messages that may indicate a crisis
+type CommunityCrisisKeyword struct {
+	ID      string `json:"id"`
+	Active  bool   `json:"active"`
+	Keyword string `json:"keyword"`
+	// IsRegex is true when the keyword is a regular expression rather than a word or phrase
+	IsRegex   bool                          `json:"isRegex"`
+	Category  enums.CommunityCrisisCategory `json:"category"`
+	CreatedAt time.Time                     `json:"createdAt"`
+
+	ProgramID      string `json:"programID"`
+	OrganisationID string `json:"organisationID"`
+}
+
+// Pattern compiles the keyword to the case insensitive regular expression that messages are matched against.
+// A word or phrase only matches whole words so that e.g. "cut" does not match "shortcut"
+func (k CommunityCrisisKeyword) Pattern() (*regexp.Regexp, error) {
+	if k.IsRegex {
+		return regexp.Compile("(?i)" + k.Keyword)
+	}
+
+	words := strings.Fields(k.Keyword)
+	for i, word := range words {
+		words[i] = regexp.QuoteMeta(word)
+	}
+
+	return regexp.Compile(`(?i)\b` + strings.Join(words, `\s+`) + `\b`)
+}
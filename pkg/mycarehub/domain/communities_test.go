@@ -0,0 +1,261 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+)
+
+func TestCommunity_IsEligible(t *testing.T) {
+	now := time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC)
+	facilityID := "facility-1"
+	otherFacilityID := "facility-2"
+
+	newClient := func() *ClientProfile {
+		dob := time.Date(2005, time.March, 16, 0, 0, 0, 0, time.UTC)
+		return &ClientProfile{
+			Active:      true,
+			ClientTypes: []enums.ClientType{enums.ClientTypePmtct},
+			ProgramID:   "program-1",
+			User: &User{
+				Gender:      enumutils.GenderFemale,
+				DateOfBirth: &dob,
+			},
+			DefaultFacility: &Facility{ID: &facilityID},
+		}
+	}
+
+	community := Community{
+		ProgramID:  "program-1",
+		FacilityID: facilityID,
+		AgeRange:   &AgeRange{LowerBound: 14, UpperBound: 17},
+		Gender:     []enumutils.Gender{enumutils.Gender("FEMALE")},
+		ClientType: []enums.ClientType{enums.ClientTypePmtct, enums.ClientTypeOvc},
+	}
+
+	tests := []struct {
+		name      string
+		community Community
+		client    func() *ClientProfile
+		want      bool
+	}{
+		{
+			name:      "client meets all criteria",
+			community: community,
+			client:    newClient,
+			want:      true,
+		},
+		{
+			name:      "community without criteria only checks the program",
+			community: Community{ProgramID: "program-1"},
+			client: func() *ClientProfile {
+				client := newClient()
+				client.User.DateOfBirth = nil
+				client.DefaultFacility = nil
+				return client
+			},
+			want: true,
+		},
+		{
+			name:      "nil client",
+			community: community,
+			client:    func() *ClientProfile { return nil },
+			want:      false,
+		},
+		{
+			name:      "inactive client",
+			community: community,
+			client: func() *ClientProfile {
+				client := newClient()
+				client.Active = false
+				return client
+			},
+			want: false,
+		},
+		{
+			name:      "client in another program",
+			community: community,
+			client: func() *ClientProfile {
+				client := newClient()
+				client.ProgramID = "program-2"
+				return client
+			},
+			want: false,
+		},
+		{
+			name:      "client in another facility",
+			community: community,
+			client: func() *ClientProfile {
+				client := newClient()
+				client.DefaultFacility = &Facility{ID: &otherFacilityID}
+				return client
+			},
+			want: false,
+		},
+		{
+			name:      "client of another type",
+			community: community,
+			client: func() *ClientProfile {
+				client := newClient()
+				client.ClientTypes = []enums.ClientType{enums.ClientTypeDreams}
+				return client
+			},
+			want: false,
+		},
+		{
+			name:      "client of another gender",
+			community: community,
+			client: func() *ClientProfile {
+				client := newClient()
+				client.User.Gender = enumutils.GenderMale
+				return client
+			},
+			want: false,
+		},
+		{
+			name:      "client whose birthday makes them too old",
+			community: community,
+			client: func() *ClientProfile {
+				client := newClient()
+				dob := time.Date(2005, time.March, 15, 0, 0, 0, 0, time.UTC)
+				client.User.DateOfBirth = &dob
+				return client
+			},
+			want: false,
+		},
+		{
+			name:      "client too young",
+			community: community,
+			client: func() *ClientProfile {
+				client := newClient()
+				dob := time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC)
+				client.User.DateOfBirth = &dob
+				return client
+			},
+			want: false,
+		},
+		{
+			name:      "client without a date of birth",
+			community: community,
+			client: func() *ClientProfile {
+				client := newClient()
+				client.User.DateOfBirth = nil
+				return client
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.community.IsEligible(tt.client(), now); got != tt.want {
+				t.Errorf("Community.IsEligible() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatrixRoomMember(t *testing.T) {
+	tests := []struct {
+		name            string
+		member          MatrixRoomMember
+		wantJoined      bool
+		wantMember      bool
+		wantHasOptedOut bool
+	}{
+		{
+			name:       "joined member",
+			member:     MatrixRoomMember{Membership: "join", SelfChanged: true},
+			wantJoined: true,
+			wantMember: true,
+		},
+		{
+			name:       "invited user",
+			member:     MatrixRoomMember{Membership: "invite"},
+			wantMember: true,
+		},
+		{
+			name:            "user who left the room",
+			member:          MatrixRoomMember{Membership: "leave", SelfChanged: true},
+			wantHasOptedOut: true,
+		},
+		{
+			name:   "user removed by a moderator",
+			member: MatrixRoomMember{Membership: "leave"},
+		},
+		{
+			name:            "banned user",
+			member:          MatrixRoomMember{Membership: "ban"},
+			wantHasOptedOut: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.member.IsJoined(); got != tt.wantJoined {
+				t.Errorf("MatrixRoomMember.IsJoined() = %v, want %v", got, tt.wantJoined)
+			}
+			if got := tt.member.IsMember(); got != tt.wantMember {
+				t.Errorf("MatrixRoomMember.IsMember() = %v, want %v", got, tt.wantMember)
+			}
+			if got := tt.member.HasOptedOut(); got != tt.wantHasOptedOut {
+				t.Errorf("MatrixRoomMember.HasOptedOut() = %v, want %v", got, tt.wantHasOptedOut)
+			}
+		})
+	}
+}
+
+func TestCommunityCrisisKeyword_Pattern(t *testing.T) {
+	tests := []struct {
+		name      string
+		keyword   CommunityCrisisKeyword
+		message   string
+		wantMatch bool
+		wantErr   bool
+	}{
+		{
+			name:      "phrase matches regardless of case and spacing",
+			keyword:   CommunityCrisisKeyword{Keyword: "end my life"},
+			message:   "I want to END  my life",
+			wantMatch: true,
+		},
+		{
+			name:      "word does not match part of another word",
+			keyword:   CommunityCrisisKeyword{Keyword: "cut"},
+			message:   "Is there a shortcut to the clinic?",
+			wantMatch: false,
+		},
+		{
+			name:      "special characters in a phrase are matched literally",
+			keyword:   CommunityCrisisKeyword{Keyword: "kill.me"},
+			message:   "kill me",
+			wantMatch: false,
+		},
+		{
+			name:      "regular expression",
+			keyword:   CommunityCrisisKeyword{Keyword: `(beat|hit)s? me`, IsRegex: true},
+			message:   "He hits me every night",
+			wantMatch: true,
+		},
+		{
+			name:    "invalid regular expression",
+			keyword: CommunityCrisisKeyword{Keyword: `(beat`, IsRegex: true},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pattern, err := tt.keyword.Pattern()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CommunityCrisisKeyword.Pattern() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := pattern.MatchString(tt.message); got != tt.wantMatch {
+				t.Errorf("CommunityCrisisKeyword.Pattern() matched %q = %v, want %v", tt.message, got, tt.wantMatch)
+			}
+		})
+	}
+}
@@ -1,6 +1,34 @@
 package domain
 
-import "time"
+import (
+	"sort"
+	"time"
+
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+)
+
+const (
+	// sustainedSadnessEntries is the number of sad entries within sustainedSadnessWindow that signal sustained sadness
+	sustainedSadnessEntries = 3
+	sustainedSadnessWindow  = 7 * 24 * time.Hour
+
+	// downwardTrendDrop is the drop in average mood score between the previous week and the last week that signals a downward trend.
+	// Each of the two weeks should have at least downwardTrendMinimumEntries entries
+	downwardTrendDrop           = 1.0
+	downwardTrendMinimumEntries = 2
+
+	// entryGapWindow is how long a client who was recording regularly should go without an entry for it to be considered a gap.
+	// A client is recording regularly when they had at least entryGapRegularEntries entries in the entryGapHistory before the gap
+	entryGapWindow         = 5 * 24 * time.Hour
+	entryGapRegularEntries = 4
+	entryGapHistory        = 14 * 24 * time.Hour
+
+	// moodWeek is the period over which a client's mood is averaged
+	moodWeek = 7 * 24 * time.Hour
+)
+
+// MoodDeteriorationHistory is how far back a client's health diary entries need to go for all the mood deterioration signals to be detected
+const MoodDeteriorationHistory = entryGapWindow + entryGapHistory
 
 // ClientHealthDiaryEntry models the health diary entry. It is used to capture the
 // client's moods on a day-by-day basis
@@ -20,3 +48,219 @@ type ClientHealthDiaryEntry struct {
 	ProgramID             string     `json:"programID"`
 	OrganisationID        string     `json:"organisationID"`
 }
+
+// ClientMoodSummary summarises a client's health diary entries over a period of time
+type ClientMoodSummary struct {
+	ClientID       string                          `json:"clientID"`
+	From           time.Time                       `json:"from"`
+	To             time.Time                       `json:"to"`
+	TotalEntries   int                             `json:"totalEntries"`
+	MoodCounts     []*MoodCount                    `json:"moodCounts"`
+	CurrentStreak  int                             `json:"currentStreak"`
+	LongestStreak  int                             `json:"longestStreak"`
+	SadStreak      int                             `json:"sadStreak"`
+	WeeklyAverages []*WeeklyMoodAverage            `json:"weeklyAverages"`
+	Signals        []enums.MoodDeteriorationSignal `json:"signals"`
+}
+
+// MoodCount is the number of health diary entries a client recorded with a mood
+type MoodCount struct {
+	Mood  enums.Mood `json:"mood"`
+	Count int        `json:"count"`
+}
+
+// WeeklyMoodAverage is a client's average mood score over a week. AverageScore is nil for weeks without entries
+type WeeklyMoodAverage struct {
+	WeekStart    time.Time `json:"weekStart"`
+	Entries      int       `json:"entries"`
+	AverageScore *float64  `json:"averageScore"`
+}
+
+// HealthDiaryShare is a grant that gives a facility or one of the client's caregivers access to part of the client's
+// health diary. A grant can expire and the client can revoke it at any time
+type HealthDiaryShare struct {
+	ID             *string                     `json:"id"`
+	Active         bool                        `json:"active"`
+	ClientID       string                      `json:"clientID"`
+	FacilityID     *string                     `json:"facilityID"`
+	CaregiverID    *string                     `json:"caregiverID"`
+	Scope          enums.HealthDiaryShareScope `json:"scope"`
+	From           *time.Time                  `json:"from"`
+	To             *time.Time                  `json:"to"`
+	EntryIDs       []string                    `json:"entryIDs"`
+	ExpiresAt      *time.Time                  `json:"expiresAt"`
+	RevokedAt      *time.Time                  `json:"revokedAt"`
+	CreatedAt      time.Time                   `json:"createdAt"`
+	ProgramID      string                      `json:"programID"`
+	OrganisationID string                      `json:"organisationID"`
+}
+
+// SummarizeMoods builds a client's mood summary from their health diary entries between from and to.
+// Streaks are counted in consecutive calendar days with at least one entry and the sad streak is the number of
+// consecutive sad entries leading up to the most recent entry.
+// The entries should go back at least MoodDeteriorationHistory before the end of the summary for all signals to be detected
+func SummarizeMoods(clientID string, entries []*ClientHealthDiaryEntry, from, to time.Time) *ClientMoodSummary {
+	// deterioration is detected over the client's entire history as the signals may look further back than the summary
+	signals := DetectMoodDeterioration(entries, to)
+	entries = entriesBetween(entries, from, to)
+
+	summary := &ClientMoodSummary{
+		ClientID:       clientID,
+		From:           from,
+		To:             to,
+		TotalEntries:   len(entries),
+		MoodCounts:     []*MoodCount{},
+		WeeklyAverages: []*WeeklyMoodAverage{},
+		Signals:        signals,
+	}
+
+	counts := map[enums.Mood]int{}
+	for _, entry := range entries {
+		counts[enums.Mood(entry.Mood)]++
+	}
+	for _, mood := range []enums.Mood{enums.MoodVeryHappy, enums.MoodHappy, enums.MoodNeutral, enums.MoodSad, enums.MoodVerySad} {
+		summary.MoodCounts = append(summary.MoodCounts, &MoodCount{Mood: mood, Count: counts[mood]})
+	}
+
+	for weekStart := from; weekStart.Before(to); weekStart = weekStart.Add(moodWeek) {
+		weekEntries := entriesBetween(entries, weekStart, weekStart.Add(moodWeek))
+		summary.WeeklyAverages = append(summary.WeeklyAverages, &WeeklyMoodAverage{
+			WeekStart:    weekStart,
+			Entries:      len(weekEntries),
+			AverageScore: averageMoodScore(weekEntries),
+		})
+	}
+
+	days := map[time.Time]bool{}
+	for _, entry := range entries {
+		days[calendarDay(entry.CreatedAt)] = true
+	}
+
+	streak := 0
+	for day := calendarDay(from); !day.After(to); day = day.AddDate(0, 0, 1) {
+		if !days[day] {
+			streak = 0
+			continue
+		}
+		streak++
+		if streak > summary.LongestStreak {
+			summary.LongestStreak = streak
+		}
+	}
+
+	// the current streak is not broken until the client misses a whole day
+	day := calendarDay(to)
+	if !days[day] {
+		day = day.AddDate(0, 0, -1)
+	}
+	for ; days[day]; day = day.AddDate(0, 0, -1) {
+		summary.CurrentStreak++
+	}
+
+	for i := len(entries) - 1; i >= 0 && enums.Mood(entries[i].Mood).IsSad(); i-- {
+		summary.SadStreak++
+	}
+
+	return summary
+}
+
+// DetectMoodDeterioration checks a client's health diary entries for patterns that suggest their mental health is deteriorating
+func DetectMoodDeterioration(entries []*ClientHealthDiaryEntry, now time.Time) []enums.MoodDeteriorationSignal {
+	signals := []enums.MoodDeteriorationSignal{}
+
+	sadEntries := 0
+	for _, entry := range entriesBetween(entries, now.Add(-sustainedSadnessWindow), now) {
+		if enums.Mood(entry.Mood).IsSad() {
+			sadEntries++
+		}
+	}
+	if sadEntries >= sustainedSadnessEntries {
+		signals = append(signals, enums.MoodDeteriorationSignalSustainedSadness)
+	}
+
+	lastWeek := entriesBetween(entries, now.Add(-moodWeek), now)
+	previousWeek := entriesBetween(entries, now.Add(-2*moodWeek), now.Add(-moodWeek))
+	if len(lastWeek) >= downwardTrendMinimumEntries && len(previousWeek) >= downwardTrendMinimumEntries &&
+		*averageMoodScore(previousWeek)-*averageMoodScore(lastWeek) >= downwardTrendDrop {
+		signals = append(signals, enums.MoodDeteriorationSignalDownwardTrend)
+	}
+
+	gap := entriesBetween(entries, now.Add(-entryGapWindow), now)
+	beforeGap := entriesBetween(entries, now.Add(-entryGapWindow-entryGapHistory), now.Add(-entryGapWindow))
+	if len(gap) == 0 && len(beforeGap) >= entryGapRegularEntries {
+		signals = append(signals, enums.MoodDeteriorationSignalEntryGap)
+	}
+
+	return signals
+}
+
+// entriesBetween returns the entries created after the start and up to the end ordered from the oldest to the most recent
+func entriesBetween(entries []*ClientHealthDiaryEntry, start, end time.Time) []*ClientHealthDiaryEntry {
+	filtered := []*ClientHealthDiaryEntry{}
+	for _, entry := range entries {
+		if entry.CreatedAt.After(start) && !entry.CreatedAt.After(end) {
+			filtered = append(filtered, entry)
+		}
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].CreatedAt.Before(filtered[j].CreatedAt)
+	})
+	return filtered
+}
+
+// averageMoodScore returns the average mood score of the entries or nil if there are no entries
+func averageMoodScore(entries []*ClientHealthDiaryEntry) *float64 {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	total := 0
+	for _, entry := range entries {
+		total += enums.Mood(entry.Mood).Score()
+	}
+	average := float64(total) / float64(len(entries))
+	return &average
+}
+
+// calendarDay truncates a time to the start of its day in its location
+func calendarDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// IsActive returns true if the grant has neither been revoked nor expired
+func (s *HealthDiaryShare) IsActive(now time.Time) bool {
+	if !s.Active || s.RevokedAt != nil {
+		return false
+	}
+	return s.ExpiresAt == nil || now.Before(*s.ExpiresAt)
+}
+
+// Covers returns true if the entry is part of what the grant shares
+func (s *HealthDiaryShare) Covers(entry *ClientHealthDiaryEntry) bool {
+	if entry.ClientID != s.ClientID {
+		return false
+	}
+
+	switch s.Scope {
+	case enums.HealthDiaryShareScopeEntireDiary:
+		return true
+
+	case enums.HealthDiaryShareScopeDateRange:
+		if s.From == nil || s.To == nil {
+			return false
+		}
+		return !entry.CreatedAt.Before(*s.From) && !entry.CreatedAt.After(*s.To)
+
+	case enums.HealthDiaryShareScopeEntries:
+		if entry.ID == nil {
+			return false
+		}
+		for _, id := range s.EntryIDs {
+			if id == *entry.ID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
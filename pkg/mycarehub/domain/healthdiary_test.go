@@ -0,0 +1,259 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+)
+
+func diaryEntries(now time.Time, moodsByDaysAgo map[int]enums.Mood) []*ClientHealthDiaryEntry {
+	entries := []*ClientHealthDiaryEntry{}
+	for daysAgo, mood := range moodsByDaysAgo {
+		entries = append(entries, &ClientHealthDiaryEntry{
+			Mood:      mood.String(),
+			CreatedAt: now.Add(-time.Duration(daysAgo) * 24 * time.Hour),
+		})
+	}
+	return entries
+}
+
+func TestDetectMoodDeterioration(t *testing.T) {
+	now := time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		entries []*ClientHealthDiaryEntry
+		want    []enums.MoodDeteriorationSignal
+	}{
+		{
+			name:    "no entries",
+			entries: []*ClientHealthDiaryEntry{},
+			want:    []enums.MoodDeteriorationSignal{},
+		},
+		{
+			name: "three sad entries within a week",
+			entries: diaryEntries(now, map[int]enums.Mood{
+				0: enums.MoodSad,
+				2: enums.MoodVerySad,
+				6: enums.MoodSad,
+			}),
+			want: []enums.MoodDeteriorationSignal{enums.MoodDeteriorationSignalSustainedSadness},
+		},
+		{
+			name: "sad entries spread over more than a week",
+			entries: diaryEntries(now, map[int]enums.Mood{
+				0: enums.MoodSad,
+				3: enums.MoodSad,
+				9: enums.MoodSad,
+			}),
+			want: []enums.MoodDeteriorationSignal{},
+		},
+		{
+			name: "average mood dropped from the previous week",
+			entries: diaryEntries(now, map[int]enums.Mood{
+				1:  enums.MoodNeutral,
+				3:  enums.MoodSad,
+				8:  enums.MoodVeryHappy,
+				10: enums.MoodHappy,
+			}),
+			want: []enums.MoodDeteriorationSignal{enums.MoodDeteriorationSignalDownwardTrend},
+		},
+		{
+			name: "average mood dropped slightly from the previous week",
+			entries: diaryEntries(now, map[int]enums.Mood{
+				1:  enums.MoodHappy,
+				3:  enums.MoodNeutral,
+				8:  enums.MoodHappy,
+				10: enums.MoodHappy,
+			}),
+			want: []enums.MoodDeteriorationSignal{},
+		},
+		{
+			name: "regular client stopped recording entries",
+			entries: diaryEntries(now, map[int]enums.Mood{
+				6:  enums.MoodHappy,
+				7:  enums.MoodHappy,
+				9:  enums.MoodHappy,
+				12: enums.MoodHappy,
+			}),
+			want: []enums.MoodDeteriorationSignal{enums.MoodDeteriorationSignalEntryGap},
+		},
+		{
+			name: "occasional client has not recorded entries",
+			entries: diaryEntries(now, map[int]enums.Mood{
+				6:  enums.MoodHappy,
+				12: enums.MoodHappy,
+			}),
+			want: []enums.MoodDeteriorationSignal{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectMoodDeterioration(tt.entries, now); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DetectMoodDeterioration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummarizeMoods(t *testing.T) {
+	now := time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC)
+	from := now.AddDate(0, 0, -14)
+
+	entries := diaryEntries(now, map[int]enums.Mood{
+		0:  enums.MoodSad,
+		1:  enums.MoodVerySad,
+		2:  enums.MoodHappy,
+		5:  enums.MoodNeutral,
+		6:  enums.MoodHappy,
+		7:  enums.MoodHappy,
+		8:  enums.MoodVeryHappy,
+		20: enums.MoodVerySad,
+	})
+
+	summary := SummarizeMoods("client", entries, from, now)
+
+	if summary.TotalEntries != 7 {
+		t.Errorf("expected 7 entries within the summary, got %d", summary.TotalEntries)
+	}
+
+	counts := map[enums.Mood]int{}
+	for _, count := range summary.MoodCounts {
+		counts[count.Mood] = count.Count
+	}
+	wantCounts := map[enums.Mood]int{
+		enums.MoodVeryHappy: 1,
+		enums.MoodHappy:     3,
+		enums.MoodNeutral:   1,
+		enums.MoodSad:       1,
+		enums.MoodVerySad:   1,
+	}
+	if !reflect.DeepEqual(counts, wantCounts) {
+		t.Errorf("expected mood counts %v, got %v", wantCounts, counts)
+	}
+
+	if summary.CurrentStreak != 3 {
+		t.Errorf("expected a current streak of 3 days, got %d", summary.CurrentStreak)
+	}
+	if summary.LongestStreak != 4 {
+		t.Errorf("expected a longest streak of 4 days, got %d", summary.LongestStreak)
+	}
+	if summary.SadStreak != 2 {
+		t.Errorf("expected a sad streak of 2 entries, got %d", summary.SadStreak)
+	}
+
+	if len(summary.WeeklyAverages) != 2 {
+		t.Errorf("expected 2 weekly averages, got %d", len(summary.WeeklyAverages))
+		return
+	}
+	firstWeek, lastWeek := summary.WeeklyAverages[0], summary.WeeklyAverages[1]
+	if firstWeek.Entries != 2 || firstWeek.AverageScore == nil || *firstWeek.AverageScore != 4.5 {
+		t.Errorf("expected the first week to have 2 entries averaging 4.5, got %d entries averaging %v", firstWeek.Entries, firstWeek.AverageScore)
+	}
+	if lastWeek.Entries != 5 || lastWeek.AverageScore == nil || *lastWeek.AverageScore != 2.8 {
+		t.Errorf("expected the last week to have 5 entries averaging 2.8, got %d entries averaging %v", lastWeek.Entries, lastWeek.AverageScore)
+	}
+
+	wantSignals := []enums.MoodDeteriorationSignal{enums.MoodDeteriorationSignalDownwardTrend}
+	if !reflect.DeepEqual(summary.Signals, wantSignals) {
+		t.Errorf("expected signals %v, got %v", wantSignals, summary.Signals)
+	}
+
+	empty := SummarizeMoods("client", nil, from, now)
+	if empty.TotalEntries != 0 || empty.CurrentStreak != 0 || empty.WeeklyAverages[0].AverageScore != nil {
+		t.Errorf("expected an empty summary, got %v", empty)
+	}
+}
+
+func TestHealthDiaryShare_IsActive(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name  string
+		share *HealthDiaryShare
+		want  bool
+	}{
+		{
+			name:  "Happy case: grant without expiry",
+			share: &HealthDiaryShare{Active: true},
+			want:  true,
+		},
+		{
+			name:  "Happy case: grant that has not expired",
+			share: &HealthDiaryShare{Active: true, ExpiresAt: &future},
+			want:  true,
+		},
+		{
+			name:  "Sad case: expired grant",
+			share: &HealthDiaryShare{Active: true, ExpiresAt: &past},
+			want:  false,
+		},
+		{
+			name:  "Sad case: revoked grant",
+			share: &HealthDiaryShare{Active: false, RevokedAt: &past},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.share.IsActive(now); got != tt.want {
+				t.Errorf("HealthDiaryShare.IsActive() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHealthDiaryShare_Covers(t *testing.T) {
+	now := time.Now()
+	from := now.AddDate(0, 0, -7)
+	entryID := "entry"
+	entry := &ClientHealthDiaryEntry{ID: &entryID, ClientID: "client", CreatedAt: now.AddDate(0, 0, -1)}
+
+	tests := []struct {
+		name  string
+		share *HealthDiaryShare
+		want  bool
+	}{
+		{
+			name:  "Happy case: entire diary",
+			share: &HealthDiaryShare{ClientID: "client", Scope: enums.HealthDiaryShareScopeEntireDiary},
+			want:  true,
+		},
+		{
+			name:  "Happy case: entry within the date range",
+			share: &HealthDiaryShare{ClientID: "client", Scope: enums.HealthDiaryShareScopeDateRange, From: &from, To: &now},
+			want:  true,
+		},
+		{
+			name:  "Happy case: specific entry",
+			share: &HealthDiaryShare{ClientID: "client", Scope: enums.HealthDiaryShareScopeEntries, EntryIDs: []string{"other", entryID}},
+			want:  true,
+		},
+		{
+			name:  "Sad case: entry outside the date range",
+			share: &HealthDiaryShare{ClientID: "client", Scope: enums.HealthDiaryShareScopeDateRange, From: &from, To: &from},
+			want:  false,
+		},
+		{
+			name:  "Sad case: entry was not shared",
+			share: &HealthDiaryShare{ClientID: "client", Scope: enums.HealthDiaryShareScopeEntries, EntryIDs: []string{"other"}},
+			want:  false,
+		},
+		{
+			name:  "Sad case: another client's grant",
+			share: &HealthDiaryShare{ClientID: "another", Scope: enums.HealthDiaryShareScopeEntireDiary},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.share.Covers(entry); got != tt.want {
+				t.Errorf("HealthDiaryShare.Covers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -40,3 +40,12 @@ type NotificationFilters struct {
 	IsRead            *bool                     `json:"isRead"`
 	NotificationTypes []*enums.NotificationType `json:"notificationTypes"`
 }
+
+// NotificationPreference represents the channels through which a user has opted to receive a type of notification
+type NotificationPreference struct {
+	ID               string                 `json:"id"`
+	UserID           string                 `json:"userID"`
+	NotificationType enums.NotificationType `json:"notificationType"`
+	PushEnabled      bool                   `json:"pushEnabled"`
+	SMSEnabled       bool                   `json:"smsEnabled"`
+}
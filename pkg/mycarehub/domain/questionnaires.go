@@ -2,6 +2,7 @@ package domain
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -32,18 +33,157 @@ func (q Questionnaire) GetQuestionByID(id string) (Question, error) {
 	return Question{}, fmt.Errorf("question not found")
 }
 
+// ReachableQuestions determines which questions are displayed for the given responses, keyed by question ID.
+// A question is reachable when every question it depends on is reachable, has been answered and meets the condition.
+// Dangling or cyclic conditions are never met
+func (q Questionnaire) ReachableQuestions(responses map[string]string) map[string]bool {
+	questions := map[string]Question{}
+	for _, question := range q.Questions {
+		questions[question.ID] = question
+	}
+
+	reachable := map[string]bool{}
+	visiting := map[string]bool{}
+
+	var isReachable func(id string) bool
+	isReachable = func(id string) bool {
+		if result, ok := reachable[id]; ok {
+			return result
+		}
+		question, ok := questions[id]
+		if !ok || visiting[id] {
+			return false
+		}
+
+		visiting[id] = true
+		result := true
+		for _, condition := range question.Conditions {
+			dependency, ok := questions[condition.DependsOnQuestionID]
+			if !ok {
+				result = false
+				break
+			}
+			response := responses[condition.DependsOnQuestionID]
+			if response == "" || !isReachable(dependency.ID) || !condition.IsMet(dependency, response) {
+				result = false
+				break
+			}
+		}
+		visiting[id] = false
+
+		reachable[id] = result
+		return result
+	}
+
+	for id := range questions {
+		isReachable(id)
+	}
+	return reachable
+}
+
+// ValidateResponses checks that only reachable questions were answered and that every required reachable question
+// has a response. The responses are keyed by question ID
+func (q Questionnaire) ValidateResponses(responses map[string]string) error {
+	reachable := q.ReachableQuestions(responses)
+
+	for _, question := range q.Questions {
+		_, answered := responses[question.ID]
+		if answered && !reachable[question.ID] {
+			return fmt.Errorf("question %d should not be answered since its display conditions are not met", question.Sequence)
+		}
+		if !answered && reachable[question.ID] && question.Required {
+			return fmt.Errorf("a response to question %d is required", question.Sequence)
+		}
+	}
+	return nil
+}
+
 // ScreeningTool defines the structure of a screening tool that belongs to the questionnaire
 type ScreeningTool struct {
-	ID              string             `json:"id"`
-	Active          bool               `json:"active"`
-	QuestionnaireID string             `json:"questionnaireID"`
-	Threshold       int                `json:"threshold"`
-	ClientTypes     []enums.ClientType `json:"clientTypes"`
-	Genders         []enumutils.Gender `json:"genders"`
-	AgeRange        AgeRange           `json:"ageRange"`
-	Questionnaire   Questionnaire      `json:"questionnaire"`
-	ProgramID       string             `json:"programID"`
-	OrganisationID  string             `json:"organisationID"`
+	ID              string                   `json:"id"`
+	Active          bool                     `json:"active"`
+	QuestionnaireID string                   `json:"questionnaireID"`
+	Threshold       int                      `json:"threshold"`
+	ClientTypes     []enums.ClientType       `json:"clientTypes"`
+	Genders         []enumutils.Gender       `json:"genders"`
+	AgeRange        AgeRange                 `json:"ageRange"`
+	Questionnaire   Questionnaire            `json:"questionnaire"`
+	ScoreBands      []ScreeningToolScoreBand `json:"scoreBands"`
+	ProgramID       string                   `json:"programID"`
+	OrganisationID  string                   `json:"organisationID"`
+	// VersionID and Version identify the published version of the questionnaire that clients currently respond to
+	VersionID *string `json:"versionID"`
+	Version   int     `json:"version"`
+	// Recurrence and RecurrenceIntervalDays control how often a client is offered the screening tool
+	Recurrence             enums.ScreeningToolRecurrence `json:"recurrence"`
+	RecurrenceIntervalDays int                           `json:"recurrenceIntervalDays"`
+	// ScoreWorseningThreshold is the increase in aggregate score between two consecutive responses that flags a client as worsening.
+	// Worsening is not tracked for screening tools without a threshold
+	ScoreWorseningThreshold *int `json:"scoreWorseningThreshold"`
+	// Assignment is the pending assignment that made the screening tool available to a client, if any
+	Assignment *ScreeningToolAssignment `json:"assignment"`
+}
+
+// IsDue returns true if the screening tool's recurrence allows a client to respond to it at the given time.
+// lastResponse is when the client last responded to the screening tool and is nil if they never have.
+// Screening tools with an ASSIGNED recurrence are never due on their own and are only offered through an assignment
+func (s ScreeningTool) IsDue(lastResponse *time.Time, now time.Time) bool {
+	switch s.Recurrence {
+	case enums.ScreeningToolRecurrenceAssigned:
+		return false
+
+	case enums.ScreeningToolRecurrenceOnce:
+		return lastResponse == nil
+
+	default:
+		if lastResponse == nil {
+			return true
+		}
+		return !now.Before(lastResponse.AddDate(0, 0, s.RecurrenceIntervalDays))
+	}
+}
+
+// HasWorsened checks whether the aggregate score of a response increased from the client's previous score by at least the worsening threshold
+func (s ScreeningTool) HasWorsened(previousScore, score int) bool {
+	if s.ScoreWorseningThreshold == nil {
+		return false
+	}
+	return score-previousScore >= *s.ScoreWorseningThreshold
+}
+
+// GetScoreBand returns the score band that an aggregate score falls within
+// nil is returned if the screening tool has no band covering the score
+func (s ScreeningTool) GetScoreBand(score int) *ScreeningToolScoreBand {
+	for _, band := range s.ScoreBands {
+		if band.Contains(score) {
+			band := band
+			return &band
+		}
+	}
+	return nil
+}
+
+// ScreeningToolScoreBand defines a labelled range of aggregate scores for a screening tool e.g mild, moderate or severe
+// and the action taken when a response falls within it
+type ScreeningToolScoreBand struct {
+	ID              string                        `json:"id"`
+	Active          bool                          `json:"active"`
+	ScreeningToolID string                        `json:"screeningToolID"`
+	Label           string                        `json:"label"`
+	MinScore        int                           `json:"minScore"`
+	MaxScore        *int                          `json:"maxScore"`
+	Action          enums.ScreeningToolBandAction `json:"action"`
+	Priority        *enums.ServiceRequestPriority `json:"priority"`
+	ProgramID       string                        `json:"programID"`
+	OrganisationID  string                        `json:"organisationID"`
+}
+
+// Contains checks whether a score falls within the band. A band without a maximum score is open ended
+func (b ScreeningToolScoreBand) Contains(score int) bool {
+	if score < b.MinScore {
+		return false
+	}
+	return b.MaxScore == nil || score <= *b.MaxScore
 }
 
 // GetQuestion returns the question details for a given screening tool question
@@ -87,8 +227,19 @@ type Question struct {
 	SelectMultiple    bool                            `json:"selectMultiple"`
 	Sequence          int                             `json:"sequence"`
 	Choices           []QuestionInputChoice           `json:"choices"`
-	ProgramID         string                          `json:"programID"`
-	OrganisationID    string                          `json:"organisationID"`
+	// CriticalItemThreshold marks the question as a critical item. A response scoring at or above it
+	// always raises a red flag regardless of the aggregate score e.g any non-zero answer to a self-harm question
+	CriticalItemThreshold *int `json:"criticalItemThreshold"`
+	// Conditions determine whether the question is displayed based on the answers to other questions.
+	// All the conditions must be met for the question to be displayed
+	Conditions     []QuestionCondition `json:"conditions"`
+	ProgramID      string              `json:"programID"`
+	OrganisationID string              `json:"organisationID"`
+}
+
+// IsCriticalResponse checks whether a response score to a critical item question should raise a red flag
+func (s Question) IsCriticalResponse(score int) bool {
+	return s.CriticalItemThreshold != nil && score >= *s.CriticalItemThreshold
 }
 
 // ValidateResponse helps with validation of a question response input
@@ -199,6 +350,72 @@ func (s Question) GetNormalizedResponseForMultipleChoice(response string) map[st
 	return choices
 }
 
+// QuestionCondition defines a display condition of a question based on the answer to another question
+type QuestionCondition struct {
+	ID                  string `json:"id"`
+	Active              bool   `json:"active"`
+	QuestionID          string `json:"questionID"`
+	DependsOnQuestionID string `json:"dependsOnQuestionID"`
+	// DependsOnSequence references the question that is depended on by its sequence.
+	// It is used when creating a questionnaire since the questions do not have IDs yet
+	DependsOnSequence int                             `json:"dependsOnSequence"`
+	Operator          enums.QuestionConditionOperator `json:"operator"`
+	Target            enums.QuestionConditionTarget   `json:"target"`
+	Values            []string                        `json:"values"`
+	ProgramID         string                          `json:"programID"`
+	OrganisationID    string                          `json:"organisationID"`
+}
+
+// IsMet checks whether the condition holds for the response given to the question it depends on.
+// For multiple choice questions the condition is met if any of the chosen choices meets it
+func (c QuestionCondition) IsMet(dependency Question, response string) bool {
+	answers := []string{}
+	switch {
+	case c.Target == enums.QuestionConditionTargetScore:
+		answers = append(answers, strconv.Itoa(dependency.GetScore(response)))
+	case dependency.SelectMultiple:
+		for _, choice := range strings.Split(response, ",") {
+			if choice != "" {
+				answers = append(answers, choice)
+			}
+		}
+	default:
+		answers = append(answers, response)
+	}
+
+	if len(c.Values) == 0 {
+		return false
+	}
+
+	for _, answer := range answers {
+		switch c.Operator {
+		case enums.QuestionConditionOperatorEquals:
+			if answer == c.Values[0] {
+				return true
+			}
+		case enums.QuestionConditionOperatorIn:
+			for _, value := range c.Values {
+				if answer == value {
+					return true
+				}
+			}
+		case enums.QuestionConditionOperatorGreaterThan:
+			got, err := strconv.Atoi(answer)
+			if err != nil {
+				continue
+			}
+			want, err := strconv.Atoi(c.Values[0])
+			if err != nil {
+				return false
+			}
+			if got > want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // QuestionInputChoice defines the structure of choices for the Question
 type QuestionInputChoice struct {
 	ID             string `json:"id"`
@@ -221,9 +438,12 @@ type QuestionnaireScreeningToolResponse struct {
 	ClientID          string                                        `json:"clientID"`
 	DateOfResponse    time.Time                                     `json:"dateOfResponse"`
 	AggregateScore    int                                           `json:"aggregateScore"`
+	Severity          *string                                       `json:"severity"`
 	QuestionResponses []*QuestionnaireScreeningToolQuestionResponse `json:"questionResponses"`
 	ProgramID         string                                        `json:"programID"`
 	OrganisationID    string                                        `json:"organisationID"`
+	// ScreeningToolVersionID pins the response to the version of the questionnaire it was answered against
+	ScreeningToolVersionID *string `json:"screeningToolVersionID"`
 }
 
 // QuestionnaireScreeningToolQuestionResponse defines the structure of a screening tool question response
@@ -269,3 +489,232 @@ type ScreeningToolRespondentsPage struct {
 	ScreeningToolRespondents []*ScreeningToolRespondent `json:"screeningToolRespondents"`
 	Pagination               Pagination                 `json:"pagination"`
 }
+
+// ScreeningToolVersion is an immutable snapshot of a screening tool's questionnaire once published.
+// Only the draft version of a screening tool can be edited
+type ScreeningToolVersion struct {
+	ID              string                           `json:"id"`
+	Active          bool                             `json:"active"`
+	ScreeningToolID string                           `json:"screeningToolID"`
+	QuestionnaireID string                           `json:"questionnaireID"`
+	Version         int                              `json:"version"`
+	Status          enums.ScreeningToolVersionStatus `json:"status"`
+	PublishedAt     *time.Time                       `json:"publishedAt"`
+	CreatedAt       time.Time                        `json:"createdAt"`
+	Questionnaire   *Questionnaire                   `json:"questionnaire"`
+	ProgramID       string                           `json:"programID"`
+	OrganisationID  string                           `json:"organisationID"`
+}
+
+// ScreeningToolVersionDiff lists the changes between two versions of a screening tool's questionnaire
+type ScreeningToolVersionDiff struct {
+	ScreeningToolID string                 `json:"screeningToolID"`
+	FromVersion     int                    `json:"fromVersion"`
+	ToVersion       int                    `json:"toVersion"`
+	Changes         []*QuestionnaireChange `json:"changes"`
+}
+
+// QuestionnaireChange is a single change between two versions of a questionnaire.
+// Questions are matched by their sequence. QuestionSequence is nil for changes to the questionnaire itself
+type QuestionnaireChange struct {
+	QuestionSequence *int                          `json:"questionSequence"`
+	ChangeType       enums.QuestionnaireChangeType `json:"changeType"`
+	Field            string                        `json:"field"`
+	From             string                        `json:"from"`
+	To               string                        `json:"to"`
+}
+
+// DiffQuestionnaires compares two versions of a questionnaire. Questions are matched by their sequence
+// and the changes are ordered by the question sequence
+func DiffQuestionnaires(from, to Questionnaire) []*QuestionnaireChange {
+	changes := []*QuestionnaireChange{}
+	modified := func(sequence *int, field, previous, current string) {
+		if previous != current {
+			changes = append(changes, &QuestionnaireChange{
+				QuestionSequence: sequence,
+				ChangeType:       enums.QuestionnaireChangeTypeModified,
+				Field:            field,
+				From:             previous,
+				To:               current,
+			})
+		}
+	}
+
+	modified(nil, "name", from.Name, to.Name)
+	modified(nil, "description", from.Description, to.Description)
+
+	fromQuestions, toQuestions := from.questionsBySequence(), to.questionsBySequence()
+	sequences := []int{}
+	for sequence := range fromQuestions {
+		sequences = append(sequences, sequence)
+	}
+	for sequence := range toQuestions {
+		if _, ok := fromQuestions[sequence]; !ok {
+			sequences = append(sequences, sequence)
+		}
+	}
+	sort.Ints(sequences)
+
+	for _, sequence := range sequences {
+		sequence := sequence
+		previous, inFrom := fromQuestions[sequence]
+		current, inTo := toQuestions[sequence]
+
+		switch {
+		case !inFrom:
+			changes = append(changes, &QuestionnaireChange{
+				QuestionSequence: &sequence,
+				ChangeType:       enums.QuestionnaireChangeTypeAdded,
+				To:               current.Text,
+			})
+		case !inTo:
+			changes = append(changes, &QuestionnaireChange{
+				QuestionSequence: &sequence,
+				ChangeType:       enums.QuestionnaireChangeTypeRemoved,
+				From:             previous.Text,
+			})
+		default:
+			modified(&sequence, "text", previous.Text, current.Text)
+			modified(&sequence, "questionType", previous.QuestionType.String(), current.QuestionType.String())
+			modified(&sequence, "responseValueType", previous.ResponseValueType.String(), current.ResponseValueType.String())
+			modified(&sequence, "required", strconv.FormatBool(previous.Required), strconv.FormatBool(current.Required))
+			modified(&sequence, "selectMultiple", strconv.FormatBool(previous.SelectMultiple), strconv.FormatBool(current.SelectMultiple))
+			modified(&sequence, "criticalItemThreshold", formatOptionalInt(previous.CriticalItemThreshold), formatOptionalInt(current.CriticalItemThreshold))
+			modified(&sequence, "choices", previous.describeChoices(), current.describeChoices())
+			modified(&sequence, "conditions", from.describeConditions(previous), to.describeConditions(current))
+		}
+	}
+
+	return changes
+}
+
+// questionsBySequence indexes the questionnaire's questions by their sequence
+func (q Questionnaire) questionsBySequence() map[int]Question {
+	questions := map[int]Question{}
+	for _, question := range q.Questions {
+		questions[question.Sequence] = question
+	}
+	return questions
+}
+
+// describeChoices renders a question's choices in a comparable form e.g `0=No (0); 1=Yes (1)`
+func (s Question) describeChoices() string {
+	choices := []string{}
+	for _, c := range s.Choices {
+		choices = append(choices, fmt.Sprintf("%s=%s (%d)", c.Choice, c.Value, c.Score))
+	}
+	sort.Strings(choices)
+	return strings.Join(choices, "; ")
+}
+
+// describeConditions renders a question's display conditions in a comparable form e.g `question 1 EQUALS CHOICE [1]`.
+// The questions depended on are referenced by their sequence since question IDs differ between versions
+func (q Questionnaire) describeConditions(question Question) string {
+	sequences := map[string]int{}
+	for _, qn := range q.Questions {
+		sequences[qn.ID] = qn.Sequence
+	}
+
+	conditions := []string{}
+	for _, c := range question.Conditions {
+		dependsOn := c.DependsOnSequence
+		if sequence, ok := sequences[c.DependsOnQuestionID]; ok {
+			dependsOn = sequence
+		}
+		conditions = append(conditions, fmt.Sprintf("question %d %s %s [%s]", dependsOn, c.Operator, c.Target, strings.Join(c.Values, ", ")))
+	}
+	sort.Strings(conditions)
+	return strings.Join(conditions, "; ")
+}
+
+// formatOptionalInt renders an optional integer, returning an empty string when it is not set
+func formatOptionalInt(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
+
+// ScreeningToolAssignment is a screening tool that a staff asked a specific client to respond to by a due date.
+// The assignment makes the screening tool available to the client regardless of its recurrence
+type ScreeningToolAssignment struct {
+	ID              string                              `json:"id"`
+	Active          bool                                `json:"active"`
+	ScreeningToolID string                              `json:"screeningToolID"`
+	ClientID        string                              `json:"clientID"`
+	StaffID         string                              `json:"staffID"`
+	DueDate         time.Time                           `json:"dueDate"`
+	Status          enums.ScreeningToolAssignmentStatus `json:"status"`
+	CompletedAt     *time.Time                          `json:"completedAt"`
+	ResponseID      *string                             `json:"responseID"`
+	LastRemindedAt  *time.Time                          `json:"lastRemindedAt"`
+	CreatedAt       time.Time                           `json:"createdAt"`
+	ProgramID       string                              `json:"programID"`
+	OrganisationID  string                              `json:"organisationID"`
+}
+
+// NeedsReminder returns true if a pending assignment is due within the reminder window and the client
+// has not been reminded about it within the last reminder interval
+func (a ScreeningToolAssignment) NeedsReminder(now time.Time, window, interval time.Duration) bool {
+	if a.Status != enums.ScreeningToolAssignmentStatusPending {
+		return false
+	}
+	if a.DueDate.After(now.Add(window)) {
+		return false
+	}
+	return a.LastRemindedAt == nil || !a.LastRemindedAt.After(now.Add(-interval))
+}
+
+// ScreeningToolScoreTrend is the series of a client's aggregate scores for a screening tool over time
+type ScreeningToolScoreTrend struct {
+	ScreeningToolID         string                     `json:"screeningToolID"`
+	ScreeningToolName       string                     `json:"screeningToolName"`
+	ScoreWorseningThreshold *int                       `json:"scoreWorseningThreshold"`
+	Points                  []*ScreeningToolScorePoint `json:"points"`
+}
+
+// ScreeningToolScorePoint is a single response in a client's screening tool score trend.
+// Delta is the change in aggregate score from the previous response and is nil for the first response
+type ScreeningToolScorePoint struct {
+	ResponseID     string    `json:"responseID"`
+	DateOfResponse time.Time `json:"dateOfResponse"`
+	AggregateScore int       `json:"aggregateScore"`
+	Severity       *string   `json:"severity"`
+	Delta          *int      `json:"delta"`
+	Worsened       bool      `json:"worsened"`
+}
+
+// ScoreTrend builds a client's score trend for the screening tool from their responses to it.
+// The points are ordered from the oldest to the most recent response
+func (s ScreeningTool) ScoreTrend(responses []*QuestionnaireScreeningToolResponse) *ScreeningToolScoreTrend {
+	sorted := make([]*QuestionnaireScreeningToolResponse, len(responses))
+	copy(sorted, responses)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].DateOfResponse.Before(sorted[j].DateOfResponse)
+	})
+
+	trend := &ScreeningToolScoreTrend{
+		ScreeningToolID:         s.ID,
+		ScreeningToolName:       s.Questionnaire.Name,
+		ScoreWorseningThreshold: s.ScoreWorseningThreshold,
+		Points:                  []*ScreeningToolScorePoint{},
+	}
+
+	for i, response := range sorted {
+		point := &ScreeningToolScorePoint{
+			ResponseID:     response.ID,
+			DateOfResponse: response.DateOfResponse,
+			AggregateScore: response.AggregateScore,
+			Severity:       response.Severity,
+		}
+		if i > 0 {
+			previousScore := sorted[i-1].AggregateScore
+			delta := response.AggregateScore - previousScore
+			point.Delta = &delta
+			point.Worsened = s.HasWorsened(previousScore, response.AggregateScore)
+		}
+		trend.Points = append(trend.Points, point)
+	}
+
+	return trend
+}
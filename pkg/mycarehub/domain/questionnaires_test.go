@@ -3,6 +3,7 @@ package domain
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit"
 	"github.com/google/uuid"
@@ -1256,3 +1257,629 @@ func TestQuestion_GetNormalizedResponseForMultipleChoice(t *testing.T) {
 		})
 	}
 }
+
+func TestScreeningTool_GetScoreBand(t *testing.T) {
+	mildMax := 4
+	moderateMax := 14
+	tool := ScreeningTool{
+		ScoreBands: []ScreeningToolScoreBand{
+			{Label: "Mild", MinScore: 0, MaxScore: &mildMax, Action: enums.ScreeningToolBandActionNone},
+			{Label: "Moderate", MinScore: 5, MaxScore: &moderateMax, Action: enums.ScreeningToolBandActionNotifyStaff},
+			{Label: "Severe", MinScore: 15, Action: enums.ScreeningToolBandActionCreateRedFlag},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		tool      ScreeningTool
+		score     int
+		wantLabel string
+	}{
+		{
+			name:      "lower bound of a band",
+			tool:      tool,
+			score:     5,
+			wantLabel: "Moderate",
+		},
+		{
+			name:      "upper bound of a band",
+			tool:      tool,
+			score:     4,
+			wantLabel: "Mild",
+		},
+		{
+			name:      "open ended band",
+			tool:      tool,
+			score:     27,
+			wantLabel: "Severe",
+		},
+		{
+			name:  "score below all bands",
+			tool:  tool,
+			score: -1,
+		},
+		{
+			name:  "tool without bands",
+			tool:  ScreeningTool{},
+			score: 10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.tool.GetScoreBand(tt.score)
+			if tt.wantLabel == "" {
+				if got != nil {
+					t.Errorf("ScreeningTool.GetScoreBand() = %v, want nil", got)
+				}
+				return
+			}
+			if got == nil || got.Label != tt.wantLabel {
+				t.Errorf("ScreeningTool.GetScoreBand() = %v, want %v", got, tt.wantLabel)
+			}
+		})
+	}
+}
+
+func TestQuestion_IsCriticalResponse(t *testing.T) {
+	threshold := 1
+	tests := []struct {
+		name     string
+		question Question
+		score    int
+		want     bool
+	}{
+		{
+			name:     "critical item with a score at the threshold",
+			question: Question{CriticalItemThreshold: &threshold},
+			score:    1,
+			want:     true,
+		},
+		{
+			name:     "critical item with a score below the threshold",
+			question: Question{CriticalItemThreshold: &threshold},
+			score:    0,
+			want:     false,
+		},
+		{
+			name:     "question that is not a critical item",
+			question: Question{},
+			score:    3,
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.question.IsCriticalResponse(tt.score); got != tt.want {
+				t.Errorf("Question.IsCriticalResponse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuestionCondition_IsMet(t *testing.T) {
+	singleChoice := Question{
+		QuestionType: enums.QuestionTypeCloseEnded,
+		Choices: []QuestionInputChoice{
+			{Choice: "0", Value: "no", Score: 0},
+			{Choice: "1", Value: "sometimes", Score: 2},
+			{Choice: "2", Value: "often", Score: 3},
+		},
+	}
+	multipleChoice := singleChoice
+	multipleChoice.SelectMultiple = true
+
+	tests := []struct {
+		name       string
+		condition  QuestionCondition
+		dependency Question
+		response   string
+		want       bool
+	}{
+		{
+			name:       "choice equals",
+			condition:  QuestionCondition{Operator: enums.QuestionConditionOperatorEquals, Target: enums.QuestionConditionTargetChoice, Values: []string{"1"}},
+			dependency: singleChoice,
+			response:   "1",
+			want:       true,
+		},
+		{
+			name:       "choice does not equal",
+			condition:  QuestionCondition{Operator: enums.QuestionConditionOperatorEquals, Target: enums.QuestionConditionTargetChoice, Values: []string{"1"}},
+			dependency: singleChoice,
+			response:   "0",
+			want:       false,
+		},
+		{
+			name:       "choice in",
+			condition:  QuestionCondition{Operator: enums.QuestionConditionOperatorIn, Target: enums.QuestionConditionTargetChoice, Values: []string{"1", "2"}},
+			dependency: singleChoice,
+			response:   "2",
+			want:       true,
+		},
+		{
+			name:       "one of multiple choices in",
+			condition:  QuestionCondition{Operator: enums.QuestionConditionOperatorIn, Target: enums.QuestionConditionTargetChoice, Values: []string{"2"}},
+			dependency: multipleChoice,
+			response:   "0,2",
+			want:       true,
+		},
+		{
+			name:       "choice greater than",
+			condition:  QuestionCondition{Operator: enums.QuestionConditionOperatorGreaterThan, Target: enums.QuestionConditionTargetChoice, Values: []string{"0"}},
+			dependency: singleChoice,
+			response:   "1",
+			want:       true,
+		},
+		{
+			name:       "score greater than",
+			condition:  QuestionCondition{Operator: enums.QuestionConditionOperatorGreaterThan, Target: enums.QuestionConditionTargetScore, Values: []string{"2"}},
+			dependency: singleChoice,
+			response:   "2",
+			want:       true,
+		},
+		{
+			name:       "score not greater than",
+			condition:  QuestionCondition{Operator: enums.QuestionConditionOperatorGreaterThan, Target: enums.QuestionConditionTargetScore, Values: []string{"2"}},
+			dependency: singleChoice,
+			response:   "1",
+			want:       false,
+		},
+		{
+			name:       "condition without values",
+			condition:  QuestionCondition{Operator: enums.QuestionConditionOperatorEquals, Target: enums.QuestionConditionTargetChoice},
+			dependency: singleChoice,
+			response:   "1",
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.IsMet(tt.dependency, tt.response); got != tt.want {
+				t.Errorf("QuestionCondition.IsMet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuestionnaire_ValidateResponses(t *testing.T) {
+	choices := []QuestionInputChoice{
+		{Choice: "0", Value: "no", Score: 0},
+		{Choice: "1", Value: "yes", Score: 1},
+	}
+	// question 2 is displayed when question 1 is answered with yes and question 3 when question 2 is answered with yes
+	questionnaire := Questionnaire{
+		Questions: []Question{
+			{ID: "q1", Sequence: 1, Required: true, QuestionType: enums.QuestionTypeCloseEnded, Choices: choices},
+			{
+				ID: "q2", Sequence: 2, Required: true, QuestionType: enums.QuestionTypeCloseEnded, Choices: choices,
+				Conditions: []QuestionCondition{
+					{DependsOnQuestionID: "q1", Operator: enums.QuestionConditionOperatorEquals, Target: enums.QuestionConditionTargetChoice, Values: []string{"1"}},
+				},
+			},
+			{
+				ID: "q3", Sequence: 3, Required: true, QuestionType: enums.QuestionTypeCloseEnded, Choices: choices,
+				Conditions: []QuestionCondition{
+					{DependsOnQuestionID: "q2", Operator: enums.QuestionConditionOperatorEquals, Target: enums.QuestionConditionTargetChoice, Values: []string{"1"}},
+				},
+			},
+			{ID: "q4", Sequence: 4, Required: false, QuestionType: enums.QuestionTypeCloseEnded, Choices: choices},
+		},
+	}
+	cyclic := Questionnaire{
+		Questions: []Question{
+			{
+				ID: "q1", Sequence: 1, QuestionType: enums.QuestionTypeCloseEnded, Choices: choices,
+				Conditions: []QuestionCondition{
+					{DependsOnQuestionID: "q2", Operator: enums.QuestionConditionOperatorEquals, Target: enums.QuestionConditionTargetChoice, Values: []string{"1"}},
+				},
+			},
+			{
+				ID: "q2", Sequence: 2, QuestionType: enums.QuestionTypeCloseEnded, Choices: choices,
+				Conditions: []QuestionCondition{
+					{DependsOnQuestionID: "q1", Operator: enums.QuestionConditionOperatorEquals, Target: enums.QuestionConditionTargetChoice, Values: []string{"1"}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		questionnaire Questionnaire
+		responses     map[string]string
+		wantErr       bool
+	}{
+		{
+			name:          "all conditions met",
+			questionnaire: questionnaire,
+			responses:     map[string]string{"q1": "1", "q2": "1", "q3": "0"},
+			wantErr:       false,
+		},
+		{
+			name:          "unreachable questions skipped",
+			questionnaire: questionnaire,
+			responses:     map[string]string{"q1": "0", "q4": "1"},
+			wantErr:       false,
+		},
+		{
+			name:          "unreachable question answered",
+			questionnaire: questionnaire,
+			responses:     map[string]string{"q1": "0", "q2": "1"},
+			wantErr:       true,
+		},
+		{
+			name:          "question behind an unmet chained condition answered",
+			questionnaire: questionnaire,
+			responses:     map[string]string{"q1": "1", "q2": "0", "q3": "1"},
+			wantErr:       true,
+		},
+		{
+			name:          "required reachable question not answered",
+			questionnaire: questionnaire,
+			responses:     map[string]string{"q1": "1"},
+			wantErr:       true,
+		},
+		{
+			name:          "required question without conditions not answered",
+			questionnaire: questionnaire,
+			responses:     map[string]string{},
+			wantErr:       true,
+		},
+		{
+			name:          "cyclic conditions are never met",
+			questionnaire: cyclic,
+			responses:     map[string]string{"q1": "1", "q2": "1"},
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.questionnaire.ValidateResponses(tt.responses); (err != nil) != tt.wantErr {
+				t.Errorf("Questionnaire.ValidateResponses() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDiffQuestionnaires(t *testing.T) {
+	threshold := 1
+	from := Questionnaire{
+		Name:        "PHQ-9",
+		Description: "Depression screening",
+		Questions: []Question{
+			{
+				ID:                "q1",
+				Text:              "Little interest or pleasure in doing things?",
+				QuestionType:      enums.QuestionTypeCloseEnded,
+				ResponseValueType: enums.QuestionResponseValueTypeNumber,
+				Required:          true,
+				Sequence:          1,
+				Choices: []QuestionInputChoice{
+					{Choice: "0", Value: "Not at all", Score: 0},
+					{Choice: "1", Value: "Several days", Score: 1},
+				},
+			},
+			{
+				ID:                "q2",
+				Text:              "Feeling down?",
+				QuestionType:      enums.QuestionTypeCloseEnded,
+				ResponseValueType: enums.QuestionResponseValueTypeNumber,
+				Sequence:          2,
+				Choices: []QuestionInputChoice{
+					{Choice: "0", Value: "Not at all", Score: 0},
+				},
+				Conditions: []QuestionCondition{
+					{DependsOnQuestionID: "q1", Operator: enums.QuestionConditionOperatorEquals, Target: enums.QuestionConditionTargetChoice, Values: []string{"1"}},
+				},
+			},
+			{
+				ID:                "q3",
+				Text:              "Trouble sleeping?",
+				QuestionType:      enums.QuestionTypeOpenEnded,
+				ResponseValueType: enums.QuestionResponseValueTypeString,
+				Sequence:          3,
+			},
+		},
+	}
+	to := Questionnaire{
+		Name:        "PHQ-9",
+		Description: "Patient health questionnaire",
+		Questions: []Question{
+			{
+				ID:                "v2q1",
+				Text:              "Little interest or pleasure in doing things?",
+				QuestionType:      enums.QuestionTypeCloseEnded,
+				ResponseValueType: enums.QuestionResponseValueTypeNumber,
+				Required:          true,
+				Sequence:          1,
+				Choices: []QuestionInputChoice{
+					{Choice: "0", Value: "Not at all", Score: 0},
+					{Choice: "1", Value: "Several days", Score: 2},
+				},
+				CriticalItemThreshold: &threshold,
+			},
+			{
+				ID:                "v2q2",
+				Text:              "Feeling down?",
+				QuestionType:      enums.QuestionTypeCloseEnded,
+				ResponseValueType: enums.QuestionResponseValueTypeNumber,
+				Sequence:          2,
+				Choices: []QuestionInputChoice{
+					{Choice: "0", Value: "Not at all", Score: 0},
+				},
+				Conditions: []QuestionCondition{
+					{DependsOnQuestionID: "v2q1", Operator: enums.QuestionConditionOperatorEquals, Target: enums.QuestionConditionTargetChoice, Values: []string{"1"}},
+				},
+			},
+			{
+				ID:                "v2q4",
+				Text:              "Feeling tired?",
+				QuestionType:      enums.QuestionTypeOpenEnded,
+				ResponseValueType: enums.QuestionResponseValueTypeString,
+				Sequence:          4,
+			},
+		},
+	}
+
+	changes := DiffQuestionnaires(from, to)
+
+	type change struct {
+		sequence   int
+		changeType enums.QuestionnaireChangeType
+		field      string
+		from       string
+		to         string
+	}
+	want := []change{
+		{0, enums.QuestionnaireChangeTypeModified, "description", "Depression screening", "Patient health questionnaire"},
+		{1, enums.QuestionnaireChangeTypeModified, "criticalItemThreshold", "", "1"},
+		{1, enums.QuestionnaireChangeTypeModified, "choices", "0=Not at all (0); 1=Several days (1)", "0=Not at all (0); 1=Several days (2)"},
+		{3, enums.QuestionnaireChangeTypeRemoved, "", "Trouble sleeping?", ""},
+		{4, enums.QuestionnaireChangeTypeAdded, "", "", "Feeling tired?"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("DiffQuestionnaires() returned %d changes, want %d: %+v", len(changes), len(want), changes)
+	}
+	for i, w := range want {
+		got := changes[i]
+		sequence := 0
+		if got.QuestionSequence != nil {
+			sequence = *got.QuestionSequence
+		}
+		if sequence != w.sequence || got.ChangeType != w.changeType || got.Field != w.field || got.From != w.from || got.To != w.to {
+			t.Errorf("DiffQuestionnaires() change %d = %+v, want %+v", i, *got, w)
+		}
+	}
+
+	if changes := DiffQuestionnaires(from, from); len(changes) != 0 {
+		t.Errorf("expected no changes between identical questionnaires, got %+v", changes)
+	}
+}
+
+func TestScreeningTool_IsDue(t *testing.T) {
+	now := time.Now()
+	yesterday := now.AddDate(0, 0, -1)
+	lastWeek := now.AddDate(0, 0, -7)
+	twoWeeksAgo := now.AddDate(0, 0, -14)
+
+	fortnightly := ScreeningTool{Recurrence: enums.ScreeningToolRecurrenceInterval, RecurrenceIntervalDays: 14}
+	daily := ScreeningTool{Recurrence: enums.ScreeningToolRecurrenceInterval, RecurrenceIntervalDays: 1}
+	once := ScreeningTool{Recurrence: enums.ScreeningToolRecurrenceOnce}
+	assigned := ScreeningTool{Recurrence: enums.ScreeningToolRecurrenceAssigned}
+
+	tests := []struct {
+		name         string
+		tool         ScreeningTool
+		lastResponse *time.Time
+		want         bool
+	}{
+		{
+			name: "interval tool that was never responded to",
+			tool: fortnightly,
+			want: true,
+		},
+		{
+			name:         "interval tool responded to within the interval",
+			tool:         fortnightly,
+			lastResponse: &lastWeek,
+			want:         false,
+		},
+		{
+			name:         "interval tool whose interval has elapsed",
+			tool:         fortnightly,
+			lastResponse: &twoWeeksAgo,
+			want:         true,
+		},
+		{
+			name:         "daily tool responded to yesterday",
+			tool:         daily,
+			lastResponse: &yesterday,
+			want:         true,
+		},
+		{
+			name: "once only tool that was never responded to",
+			tool: once,
+			want: true,
+		},
+		{
+			name:         "once only tool that was responded to",
+			tool:         once,
+			lastResponse: &twoWeeksAgo,
+			want:         false,
+		},
+		{
+			name: "assigned tool",
+			tool: assigned,
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tool.IsDue(tt.lastResponse, now); got != tt.want {
+				t.Errorf("ScreeningTool.IsDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScreeningToolAssignment_NeedsReminder(t *testing.T) {
+	now := time.Now()
+	window := 24 * time.Hour
+	interval := 24 * time.Hour
+	anHourAgo := now.Add(-time.Hour)
+	twoDaysAgo := now.AddDate(0, 0, -2)
+
+	tests := []struct {
+		name       string
+		assignment ScreeningToolAssignment
+		want       bool
+	}{
+		{
+			name: "pending assignment due within the window",
+			assignment: ScreeningToolAssignment{
+				Status:  enums.ScreeningToolAssignmentStatusPending,
+				DueDate: now.Add(12 * time.Hour),
+			},
+			want: true,
+		},
+		{
+			name: "overdue assignment last reminded before the interval",
+			assignment: ScreeningToolAssignment{
+				Status:         enums.ScreeningToolAssignmentStatusPending,
+				DueDate:        twoDaysAgo,
+				LastRemindedAt: &twoDaysAgo,
+			},
+			want: true,
+		},
+		{
+			name: "assignment reminded within the interval",
+			assignment: ScreeningToolAssignment{
+				Status:         enums.ScreeningToolAssignmentStatusPending,
+				DueDate:        now,
+				LastRemindedAt: &anHourAgo,
+			},
+			want: false,
+		},
+		{
+			name: "assignment due after the window",
+			assignment: ScreeningToolAssignment{
+				Status:  enums.ScreeningToolAssignmentStatusPending,
+				DueDate: now.AddDate(0, 0, 7),
+			},
+			want: false,
+		},
+		{
+			name: "completed assignment",
+			assignment: ScreeningToolAssignment{
+				Status:  enums.ScreeningToolAssignmentStatusCompleted,
+				DueDate: now,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.assignment.NeedsReminder(now, window, interval); got != tt.want {
+				t.Errorf("ScreeningToolAssignment.NeedsReminder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScreeningTool_HasWorsened(t *testing.T) {
+	threshold := 5
+
+	tests := []struct {
+		name          string
+		tool          ScreeningTool
+		previousScore int
+		score         int
+		want          bool
+	}{
+		{
+			name:          "score increased by the threshold",
+			tool:          ScreeningTool{ScoreWorseningThreshold: &threshold},
+			previousScore: 4,
+			score:         9,
+			want:          true,
+		},
+		{
+			name:          "score increased by less than the threshold",
+			tool:          ScreeningTool{ScoreWorseningThreshold: &threshold},
+			previousScore: 4,
+			score:         8,
+			want:          false,
+		},
+		{
+			name:          "score improved",
+			tool:          ScreeningTool{ScoreWorseningThreshold: &threshold},
+			previousScore: 14,
+			score:         4,
+			want:          false,
+		},
+		{
+			name:          "screening tool without a threshold",
+			tool:          ScreeningTool{},
+			previousScore: 0,
+			score:         27,
+			want:          false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tool.HasWorsened(tt.previousScore, tt.score); got != tt.want {
+				t.Errorf("ScreeningTool.HasWorsened() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScreeningTool_ScoreTrend(t *testing.T) {
+	threshold := 5
+	now := time.Now()
+	mild := "Mild"
+	severe := "Severe"
+
+	tool := ScreeningTool{
+		ID:                      "tool",
+		Questionnaire:           Questionnaire{Name: "PHQ-9"},
+		ScoreWorseningThreshold: &threshold,
+	}
+
+	// responses are deliberately out of order
+	responses := []*QuestionnaireScreeningToolResponse{
+		{ID: "third", DateOfResponse: now, AggregateScore: 21, Severity: &severe},
+		{ID: "first", DateOfResponse: now.AddDate(0, -2, 0), AggregateScore: 6, Severity: &mild},
+		{ID: "second", DateOfResponse: now.AddDate(0, -1, 0), AggregateScore: 8, Severity: &mild},
+	}
+
+	trend := tool.ScoreTrend(responses)
+
+	if trend.ScreeningToolID != "tool" || trend.ScreeningToolName != "PHQ-9" {
+		t.Errorf("ScreeningTool.ScoreTrend() returned the wrong screening tool: %v", trend)
+		return
+	}
+	if len(trend.Points) != 3 {
+		t.Errorf("ScreeningTool.ScoreTrend() got %d points, want 3", len(trend.Points))
+		return
+	}
+
+	wantIDs := []string{"first", "second", "third"}
+	smallIncrease, largeIncrease := 2, 13
+	wantDeltas := []*int{nil, &smallIncrease, &largeIncrease}
+	wantWorsened := []bool{false, false, true}
+	for i, point := range trend.Points {
+		if point.ResponseID != wantIDs[i] {
+			t.Errorf("point %d: ResponseID = %s, want %s", i, point.ResponseID, wantIDs[i])
+		}
+		if (point.Delta == nil) != (wantDeltas[i] == nil) || (point.Delta != nil && *point.Delta != *wantDeltas[i]) {
+			t.Errorf("point %d: Delta = %v, want %v", i, point.Delta, wantDeltas[i])
+		}
+		if point.Worsened != wantWorsened[i] {
+			t.Errorf("point %d: Worsened = %v, want %v", i, point.Worsened, wantWorsened[i])
+		}
+	}
+
+	if empty := tool.ScoreTrend(nil); len(empty.Points) != 0 {
+		t.Errorf("ScreeningTool.ScoreTrend() got %d points for no responses, want 0", len(empty.Points))
+	}
+}
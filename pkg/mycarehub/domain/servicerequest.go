@@ -8,30 +8,92 @@ import (
 
 // ServiceRequest is a domain entity that represents a service request.
 type ServiceRequest struct {
-	ID                 string                 `json:"id"`
-	RequestType        string                 `json:"requestType"`
-	Request            string                 `json:"request"`
-	Status             string                 `json:"status"`
-	Active             bool                   `json:"active"`
-	ClientID           string                 `json:"clientID,omitempty"`
-	StaffID            string                 `json:"staffID,omitempty"`
-	CreatedAt          time.Time              `json:"created"`
-	InProgressAt       *time.Time             `json:"inProgressAt"`
-	InProgressBy       *string                `json:"inProgressBy"`
-	ResolvedAt         *time.Time             `json:"resolvedAt"`
-	ResolvedBy         *string                `json:"resolvedBy"`
-	ResolvedByName     *string                `string:"resolvedByName"`
-	FacilityID         string                 `json:"facilityID,omitempty"`
-	ClientName         *string                `json:"clientName,omitempty"`
-	StaffName          *string                `json:"staffName,omitempty"`
-	StaffContact       *string                `json:"staffContact,omitempty"`
-	ClientContact      *string                `json:"clientContact,omitempty"`
-	CCCNumber          *string                `json:"cccNumber,omitempty"`
-	ScreeningToolName  string                 `json:"screeningToolName"`
-	ScreeningToolScore string                 `json:"screeningToolScore"`
-	ProgramID          string                 `json:"programID,omitempty"`
-	OrganisationID     string                 `json:"organisationID,omitempty"`
-	Meta               map[string]interface{} `json:"meta"`
+	ID                   string                 `json:"id"`
+	RequestType          string                 `json:"requestType"`
+	Request              string                 `json:"request"`
+	Status               string                 `json:"status"`
+	Active               bool                   `json:"active"`
+	ClientID             string                 `json:"clientID,omitempty"`
+	StaffID              string                 `json:"staffID,omitempty"`
+	CreatedAt            time.Time              `json:"created"`
+	InProgressAt         *time.Time             `json:"inProgressAt"`
+	InProgressBy         *string                `json:"inProgressBy"`
+	ResolvedAt           *time.Time             `json:"resolvedAt"`
+	ResolvedBy           *string                `json:"resolvedBy"`
+	ResolvedByName       *string                `string:"resolvedByName"`
+	FacilityID           string                 `json:"facilityID,omitempty"`
+	ClientName           *string                `json:"clientName,omitempty"`
+	StaffName            *string                `json:"staffName,omitempty"`
+	StaffContact         *string                `json:"staffContact,omitempty"`
+	ClientContact        *string                `json:"clientContact,omitempty"`
+	CCCNumber            *string                `json:"cccNumber,omitempty"`
+	ScreeningToolName    string                 `json:"screeningToolName"`
+	ScreeningToolScore   string                 `json:"screeningToolScore"`
+	ProgramID            string                 `json:"programID,omitempty"`
+	OrganisationID       string                 `json:"organisationID,omitempty"`
+	Meta                 map[string]interface{} `json:"meta"`
+	EscalatedAt          *time.Time             `json:"escalatedAt"`
+	EscalationBackfilled bool                   `json:"escalationBackfilled"`
+	SLADeadline          *time.Time             `json:"slaDeadline"`
+	SLABreached          bool                   `json:"slaBreached"`
+	AssignedTo           *string                `json:"assignedTo"`
+	AssignedToName       *string                `json:"assignedToName"`
+	AssignedAt           *time.Time             `json:"assignedAt"`
+	History              []*ServiceRequestEvent `json:"history,omitempty"`
+}
+
+// ApplySLA sets the time by which the service request should have been picked up and whether that deadline was missed.
+// A request is picked up when it is first marked as in progress or resolved; pending requests are compared against now
+func (s *ServiceRequest) ApplySLA(sla time.Duration, now time.Time) {
+	deadline := s.CreatedAt.Add(sla)
+	s.SLADeadline = &deadline
+
+	pickedUpAt := now
+	if s.InProgressAt != nil && !s.InProgressAt.IsZero() {
+		pickedUpAt = *s.InProgressAt
+	}
+	if s.ResolvedAt != nil && !s.ResolvedAt.IsZero() && s.ResolvedAt.Before(pickedUpAt) {
+		pickedUpAt = *s.ResolvedAt
+	}
+
+	s.SLABreached = pickedUpAt.After(deadline)
+}
+
+// ServiceRequestComment is an internal note left by a staff member on a service request. Replies to a comment are
+// nested under it
+type ServiceRequestComment struct {
+	ID               string                   `json:"id"`
+	ServiceRequestID string                   `json:"serviceRequestID"`
+	ParentID         *string                  `json:"parentID"`
+	StaffID          string                   `json:"staffID"`
+	StaffName        string                   `json:"staffName"`
+	Comment          string                   `json:"comment"`
+	CreatedAt        time.Time                `json:"createdAt"`
+	Replies          []*ServiceRequestComment `json:"replies"`
+}
+
+// ServiceRequestEvent is an entry in the history of the actions taken on a service request
+type ServiceRequestEvent struct {
+	ID               string                        `json:"id"`
+	ServiceRequestID string                        `json:"serviceRequestID"`
+	EventType        enums.ServiceRequestEventType `json:"eventType"`
+	StaffID          *string                       `json:"staffID"`
+	StaffName        *string                       `json:"staffName"`
+	Status           string                        `json:"status"`
+	AssignedToID     *string                       `json:"assignedToID"`
+	AssignedToName   *string                       `json:"assignedToName"`
+	Notes            string                        `json:"notes"`
+	CreatedAt        time.Time                     `json:"createdAt"`
+}
+
+// ServiceRequestSLAMetric summarises how well service requests of a given type were picked up within their SLA
+type ServiceRequestSLAMetric struct {
+	RequestType    enums.ServiceRequestType `json:"requestType"`
+	SLAMinutes     int                      `json:"slaMinutes"`
+	Total          int                      `json:"total"`
+	Breached       int                      `json:"breached"`
+	Escalated      int                      `json:"escalated"`
+	ComplianceRate float64                  `json:"complianceRate"`
 }
 
 // RequestTypeCount ...
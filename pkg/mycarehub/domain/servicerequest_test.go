@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestServiceRequest_ApplySLA(t *testing.T) {
+	now := time.Now()
+	createdAt := now.Add(-3 * time.Hour)
+	pickedUpInTime := createdAt.Add(30 * time.Minute)
+	pickedUpLate := createdAt.Add(2 * time.Hour)
+
+	tests := []struct {
+		name           string
+		serviceRequest ServiceRequest
+		wantBreached   bool
+	}{
+		{
+			name: "picked up within the SLA",
+			serviceRequest: ServiceRequest{
+				CreatedAt:    createdAt,
+				InProgressAt: &pickedUpInTime,
+				ResolvedAt:   &pickedUpLate,
+			},
+			wantBreached: false,
+		},
+		{
+			name: "resolved within the SLA without being marked in progress",
+			serviceRequest: ServiceRequest{
+				CreatedAt:  createdAt,
+				ResolvedAt: &pickedUpInTime,
+			},
+			wantBreached: false,
+		},
+		{
+			name: "picked up after the SLA",
+			serviceRequest: ServiceRequest{
+				CreatedAt:    createdAt,
+				InProgressAt: &pickedUpLate,
+			},
+			wantBreached: true,
+		},
+		{
+			name: "still pending after the SLA",
+			serviceRequest: ServiceRequest{
+				CreatedAt: createdAt,
+			},
+			wantBreached: true,
+		},
+		{
+			name: "still pending within the SLA",
+			serviceRequest: ServiceRequest{
+				CreatedAt: now.Add(-30 * time.Minute),
+			},
+			wantBreached: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.serviceRequest.ApplySLA(time.Hour, now)
+
+			if got := *tt.serviceRequest.SLADeadline; !got.Equal(tt.serviceRequest.CreatedAt.Add(time.Hour)) {
+				t.Errorf("ServiceRequest.ApplySLA() deadline = %v, want %v", got, tt.serviceRequest.CreatedAt.Add(time.Hour))
+			}
+			if tt.serviceRequest.SLABreached != tt.wantBreached {
+				t.Errorf("ServiceRequest.ApplySLA() breached = %v, want %v", tt.serviceRequest.SLABreached, tt.wantBreached)
+			}
+		})
+	}
+}
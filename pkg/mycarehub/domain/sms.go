@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+)
+
+// SMSDelivery tracks the delivery of an SMS sent through the SMS gateway.
+// The message body is deliberately not stored since it may contain sensitive data such as OTPs
+type SMSDelivery struct {
+	ID                string                  `json:"id"`
+	PhoneNumber       string                  `json:"phoneNumber"`
+	Provider          string                  `json:"provider"`
+	ProviderMessageID string                  `json:"providerMessageID"`
+	Status            enums.SMSDeliveryStatus `json:"status"`
+	Attempts          int                     `json:"attempts"`
+	FailureReason     string                  `json:"failureReason"`
+	CreatedAt         time.Time               `json:"createdAt"`
+}
@@ -1,7 +1,13 @@
 package domain
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 )
 
 // SurveyForm is contains the information about a survey form
@@ -29,6 +35,109 @@ type UserSurvey struct {
 	SubmittedAt    time.Time `json:"submittedAt"`
 	ProgramID      string    `json:"programID"`
 	OrganisationID string    `json:"organisationID"`
+	// CampaignID, ExpiresAt and LastRemindedAt are only set on surveys that were sent out by a survey campaign
+	CampaignID     *string    `json:"campaignID"`
+	ExpiresAt      *time.Time `json:"expiresAt"`
+	LastRemindedAt *time.Time `json:"lastRemindedAt"`
+}
+
+// IsExpired returns true if the survey was not submitted before its link expired
+func (u UserSurvey) IsExpired(now time.Time) bool {
+	return !u.HasSubmitted && u.ExpiresAt != nil && !now.Before(*u.ExpiresAt)
+}
+
+// NeedsReminder returns true if the client has neither submitted the survey nor been reminded about it,
+// or received it, within the last reminder interval. Expired surveys are not followed up
+func (u UserSurvey) NeedsReminder(now time.Time, interval time.Duration) bool {
+	if u.HasSubmitted || !u.Active || u.IsExpired(now) {
+		return false
+	}
+
+	last := u.Created
+	if u.LastRemindedAt != nil {
+		last = *u.LastRemindedAt
+	}
+	return !last.After(now.Add(-interval))
+}
+
+// SurveyCampaign sends a survey to the clients in a facility who match its filters at a scheduled time and,
+// for recurring campaigns, again after every recurrence period until the campaign ends
+type SurveyCampaign struct {
+	ID         string `json:"id"`
+	Active     bool   `json:"active"`
+	Title      string `json:"title"`
+	ProjectID  int    `json:"projectID"`
+	FormID     string `json:"formID"`
+	FacilityID string `json:"facilityID"`
+
+	// ClientTypes, Genders and AgeRange select the clients that the survey is sent to
+	ClientTypes []enums.ClientType `json:"clientTypes"`
+	Genders     []enumutils.Gender `json:"genders"`
+	AgeRange    *AgeRange          `json:"ageRange"`
+
+	Recurrence enums.SurveyCampaignRecurrence `json:"recurrence"`
+	Status     enums.SurveyCampaignStatus     `json:"status"`
+	NextSendAt *time.Time                     `json:"nextSendAt"`
+	EndsAt     *time.Time                     `json:"endsAt"`
+	LastSentAt *time.Time                     `json:"lastSentAt"`
+
+	// LinkExpiryDays is how long the clients have to respond before their survey link is deleted.
+	// Clients who have not responded are reminded every ReminderIntervalDays, if set, until the link expires
+	LinkExpiryDays       int  `json:"linkExpiryDays"`
+	ReminderIntervalDays *int `json:"reminderIntervalDays"`
+
+	// Sent, Submitted and Expired count the survey links that the campaign sent out
+	Sent      int `json:"sent"`
+	Submitted int `json:"submitted"`
+	Expired   int `json:"expired"`
+
+	CreatedAt      time.Time `json:"createdAt"`
+	ProgramID      string    `json:"programID"`
+	OrganisationID string    `json:"organisationID"`
+}
+
+// NextRound returns when the campaign should be sent out again after the round that was due at NextSendAt.
+// Rounds that were missed before now are skipped and nil is returned when the campaign does not recur or has ended
+func (c SurveyCampaign) NextRound(now time.Time) *time.Time {
+	if c.NextSendAt == nil {
+		return nil
+	}
+
+	next := *c.NextSendAt
+	for {
+		switch c.Recurrence {
+		case enums.SurveyCampaignRecurrenceDaily:
+			next = next.AddDate(0, 0, 1)
+		case enums.SurveyCampaignRecurrenceWeekly:
+			next = next.AddDate(0, 0, 7)
+		case enums.SurveyCampaignRecurrenceMonthly:
+			next = next.AddDate(0, 1, 0)
+		default:
+			return nil
+		}
+
+		if next.After(now) {
+			break
+		}
+	}
+
+	if c.EndsAt != nil && next.After(*c.EndsAt) {
+		return nil
+	}
+	return &next
+}
+
+// LinkExpiry returns when the survey links sent out at the given time expire
+func (c SurveyCampaign) LinkExpiry(sentAt time.Time) time.Time {
+	return sentAt.AddDate(0, 0, c.LinkExpiryDays)
+}
+
+// IsComplete returns true if the campaign has no more rounds to send and the links from its last round have expired
+func (c SurveyCampaign) IsComplete(now time.Time) bool {
+	if c.NextSendAt != nil || c.LastSentAt == nil {
+		return false
+	}
+	return !now.Before(c.LinkExpiry(*c.LastSentAt))
 }
 
 // Submission represents a survey's submission domain model
@@ -91,3 +200,108 @@ type SurveyServiceRequestUserPage struct {
 	Users      []*SurveyServiceRequestUser `json:"users"`
 	Pagination Pagination                  `json:"pagination"`
 }
+
+// SurveyRuleCondition compares the answer to a field of a survey submission with a value.
+// Fields in a group of the form are referenced by their path e.g `group/field`
+type SurveyRuleCondition struct {
+	Field    string                   `json:"field"`
+	Operator enums.SurveyRuleOperator `json:"operator"`
+	Value    string                   `json:"value"`
+}
+
+// Matches returns true if the answer satisfies the condition. Equality checks ignore case and numeric operators
+// only match answers that are numbers
+func (c SurveyRuleCondition) Matches(answer string) bool {
+	answer = strings.TrimSpace(answer)
+	value := strings.TrimSpace(c.Value)
+
+	switch c.Operator {
+	case enums.SurveyRuleOperatorEquals:
+		return strings.EqualFold(answer, value)
+	case enums.SurveyRuleOperatorNotEquals:
+		return !strings.EqualFold(answer, value)
+	case enums.SurveyRuleOperatorContains:
+		return strings.Contains(strings.ToLower(answer), strings.ToLower(value))
+	}
+
+	number, err := strconv.ParseFloat(answer, 64)
+	if err != nil {
+		return false
+	}
+	threshold, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false
+	}
+
+	switch c.Operator {
+	case enums.SurveyRuleOperatorGreaterThan:
+		return number > threshold
+	case enums.SurveyRuleOperatorGreaterThanOrEqualTo:
+		return number >= threshold
+	case enums.SurveyRuleOperatorLessThan:
+		return number < threshold
+	case enums.SurveyRuleOperatorLessThanOrEqualTo:
+		return number <= threshold
+	}
+	return false
+}
+
+// SurveyRedFlagRule raises a survey red flag service request when a submission to a survey form matches its conditions
+type SurveyRedFlagRule struct {
+	ID         string                     `json:"id"`
+	Active     bool                       `json:"active"`
+	Name       string                     `json:"name"`
+	ProjectID  int                        `json:"projectID"`
+	FormID     string                     `json:"formID"`
+	Combinator enums.SurveyRuleCombinator `json:"combinator"`
+	Conditions []SurveyRuleCondition      `json:"conditions"`
+
+	CreatedAt      time.Time `json:"createdAt"`
+	ProgramID      string    `json:"programID"`
+	OrganisationID string    `json:"organisationID"`
+}
+
+// Match checks a submission's data against the rule. When the rule matches, the answers to the fields of the
+// conditions that matched are returned keyed by the field. A condition on a field that was not answered does not match
+func (r SurveyRedFlagRule) Match(data map[string]interface{}) (map[string]string, bool) {
+	matched := map[string]string{}
+	for _, condition := range r.Conditions {
+		answer, ok := SubmissionAnswer(data, condition.Field)
+		if ok && condition.Matches(answer) {
+			matched[condition.Field] = answer
+			continue
+		}
+
+		if r.Combinator == enums.SurveyRuleCombinatorAnd {
+			return nil, false
+		}
+	}
+
+	if len(matched) == 0 {
+		return nil, false
+	}
+	return matched, true
+}
+
+// SubmissionAnswer returns the answer to a field of a survey submission's data. The field is a path through the groups of the form
+func SubmissionAnswer(data map[string]interface{}, field string) (string, bool) {
+	var value interface{} = data
+	for _, key := range strings.Split(strings.Trim(field, "/"), "/") {
+		group, ok := value.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		value, ok = group[key]
+		if !ok {
+			return "", false
+		}
+	}
+
+	switch answer := value.(type) {
+	case string:
+		return answer, true
+	case float64, int, bool:
+		return fmt.Sprint(answer), true
+	}
+	return "", false
+}
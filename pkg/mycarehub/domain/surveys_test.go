@@ -0,0 +1,312 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+)
+
+func TestSurveyCampaign_NextRound(t *testing.T) {
+	now := time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC)
+	due := now.Add(-time.Hour)
+	longAgo := now.AddDate(0, 0, -20)
+	endsSoon := now.AddDate(0, 0, 3)
+
+	tests := []struct {
+		name     string
+		campaign SurveyCampaign
+		want     *time.Time
+	}{
+		{
+			name:     "one off campaign",
+			campaign: SurveyCampaign{Recurrence: enums.SurveyCampaignRecurrenceOnce, NextSendAt: &due},
+			want:     nil,
+		},
+		{
+			name:     "campaign without a scheduled round",
+			campaign: SurveyCampaign{Recurrence: enums.SurveyCampaignRecurrenceDaily},
+			want:     nil,
+		},
+		{
+			name:     "daily campaign",
+			campaign: SurveyCampaign{Recurrence: enums.SurveyCampaignRecurrenceDaily, NextSendAt: &due},
+			want:     timePtr(due.AddDate(0, 0, 1)),
+		},
+		{
+			name:     "monthly campaign",
+			campaign: SurveyCampaign{Recurrence: enums.SurveyCampaignRecurrenceMonthly, NextSendAt: &due},
+			want:     timePtr(due.AddDate(0, 1, 0)),
+		},
+		{
+			name:     "weekly campaign skips missed rounds",
+			campaign: SurveyCampaign{Recurrence: enums.SurveyCampaignRecurrenceWeekly, NextSendAt: &longAgo},
+			want:     timePtr(longAgo.AddDate(0, 0, 21)),
+		},
+		{
+			name:     "weekly campaign that ends before the next round",
+			campaign: SurveyCampaign{Recurrence: enums.SurveyCampaignRecurrenceWeekly, NextSendAt: &due, EndsAt: &endsSoon},
+			want:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.campaign.NextRound(now)
+			if (got == nil) != (tt.want == nil) || (got != nil && !got.Equal(*tt.want)) {
+				t.Errorf("SurveyCampaign.NextRound() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSurveyCampaign_IsComplete(t *testing.T) {
+	now := time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC)
+	next := now.AddDate(0, 0, 1)
+	sentRecently := now.AddDate(0, 0, -2)
+	sentLongAgo := now.AddDate(0, 0, -10)
+
+	tests := []struct {
+		name     string
+		campaign SurveyCampaign
+		want     bool
+	}{
+		{
+			name:     "campaign that has not been sent",
+			campaign: SurveyCampaign{LinkExpiryDays: 7},
+			want:     false,
+		},
+		{
+			name:     "campaign with another round scheduled",
+			campaign: SurveyCampaign{LinkExpiryDays: 7, NextSendAt: &next, LastSentAt: &sentLongAgo},
+			want:     false,
+		},
+		{
+			name:     "links from the last round have not expired",
+			campaign: SurveyCampaign{LinkExpiryDays: 7, LastSentAt: &sentRecently},
+			want:     false,
+		},
+		{
+			name:     "links from the last round have expired",
+			campaign: SurveyCampaign{LinkExpiryDays: 7, LastSentAt: &sentLongAgo},
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.campaign.IsComplete(now); got != tt.want {
+				t.Errorf("SurveyCampaign.IsComplete() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserSurvey_NeedsReminder(t *testing.T) {
+	now := time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC)
+	interval := 2 * 24 * time.Hour
+	expiresLater := now.AddDate(0, 0, 5)
+	expired := now.Add(-time.Hour)
+	remindedRecently := now.Add(-time.Hour)
+	remindedLongAgo := now.AddDate(0, 0, -3)
+
+	tests := []struct {
+		name   string
+		survey UserSurvey
+		want   bool
+	}{
+		{
+			name:   "submitted survey",
+			survey: UserSurvey{Active: true, HasSubmitted: true, Created: now.AddDate(0, 0, -3), ExpiresAt: &expiresLater},
+			want:   false,
+		},
+		{
+			name:   "inactive survey",
+			survey: UserSurvey{Created: now.AddDate(0, 0, -3), ExpiresAt: &expiresLater},
+			want:   false,
+		},
+		{
+			name:   "expired survey",
+			survey: UserSurvey{Active: true, Created: now.AddDate(0, 0, -3), ExpiresAt: &expired},
+			want:   false,
+		},
+		{
+			name:   "survey sent within the interval",
+			survey: UserSurvey{Active: true, Created: now.Add(-time.Hour), ExpiresAt: &expiresLater},
+			want:   false,
+		},
+		{
+			name:   "survey sent before the interval",
+			survey: UserSurvey{Active: true, Created: now.AddDate(0, 0, -3), ExpiresAt: &expiresLater},
+			want:   true,
+		},
+		{
+			name:   "client reminded within the interval",
+			survey: UserSurvey{Active: true, Created: now.AddDate(0, 0, -5), ExpiresAt: &expiresLater, LastRemindedAt: &remindedRecently},
+			want:   false,
+		},
+		{
+			name:   "client reminded before the interval",
+			survey: UserSurvey{Active: true, Created: now.AddDate(0, 0, -5), ExpiresAt: &expiresLater, LastRemindedAt: &remindedLongAgo},
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.survey.NeedsReminder(now, interval); got != tt.want {
+				t.Errorf("UserSurvey.NeedsReminder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSurveyRuleCondition_Matches(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition SurveyRuleCondition
+		answer    string
+		want      bool
+	}{
+		{
+			name:      "equal answers ignore case",
+			condition: SurveyRuleCondition{Operator: enums.SurveyRuleOperatorEquals, Value: "Yes"},
+			answer:    " yes",
+			want:      true,
+		},
+		{
+			name:      "different answer",
+			condition: SurveyRuleCondition{Operator: enums.SurveyRuleOperatorNotEquals, Value: "no"},
+			answer:    "yes",
+			want:      true,
+		},
+		{
+			name:      "answer contains value",
+			condition: SurveyRuleCondition{Operator: enums.SurveyRuleOperatorContains, Value: "headache"},
+			answer:    "Severe Headache and fever",
+			want:      true,
+		},
+		{
+			name:      "answer greater than value",
+			condition: SurveyRuleCondition{Operator: enums.SurveyRuleOperatorGreaterThan, Value: "10"},
+			answer:    "15",
+			want:      true,
+		},
+		{
+			name:      "answer equal to value is not greater",
+			condition: SurveyRuleCondition{Operator: enums.SurveyRuleOperatorGreaterThan, Value: "10"},
+			answer:    "10",
+			want:      false,
+		},
+		{
+			name:      "answer greater than or equal to value",
+			condition: SurveyRuleCondition{Operator: enums.SurveyRuleOperatorGreaterThanOrEqualTo, Value: "10"},
+			answer:    "10",
+			want:      true,
+		},
+		{
+			name:      "answer less than value",
+			condition: SurveyRuleCondition{Operator: enums.SurveyRuleOperatorLessThan, Value: "36.5"},
+			answer:    "35.2",
+			want:      true,
+		},
+		{
+			name:      "answer less than or equal to value",
+			condition: SurveyRuleCondition{Operator: enums.SurveyRuleOperatorLessThanOrEqualTo, Value: "3"},
+			answer:    "4",
+			want:      false,
+		},
+		{
+			name:      "non numeric answer",
+			condition: SurveyRuleCondition{Operator: enums.SurveyRuleOperatorGreaterThan, Value: "10"},
+			answer:    "many",
+			want:      false,
+		},
+		{
+			name:      "non numeric value",
+			condition: SurveyRuleCondition{Operator: enums.SurveyRuleOperatorLessThan, Value: "few"},
+			answer:    "2",
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Matches(tt.answer); got != tt.want {
+				t.Errorf("SurveyRuleCondition.Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSurveyRedFlagRule_Match(t *testing.T) {
+	data := map[string]interface{}{
+		"feeling_unsafe": "yes",
+		"phq_score":      "21",
+		"vitals": map[string]interface{}{
+			"temperature": "39.5",
+		},
+	}
+	conditions := []SurveyRuleCondition{
+		{Field: "feeling_unsafe", Operator: enums.SurveyRuleOperatorEquals, Value: "yes"},
+		{Field: "phq_score", Operator: enums.SurveyRuleOperatorGreaterThanOrEqualTo, Value: "20"},
+	}
+
+	tests := []struct {
+		name      string
+		rule      SurveyRedFlagRule
+		want      map[string]string
+		wantMatch bool
+	}{
+		{
+			name:      "all conditions match",
+			rule:      SurveyRedFlagRule{Combinator: enums.SurveyRuleCombinatorAnd, Conditions: conditions},
+			want:      map[string]string{"feeling_unsafe": "yes", "phq_score": "21"},
+			wantMatch: true,
+		},
+		{
+			name: "one condition does not match",
+			rule: SurveyRedFlagRule{
+				Combinator: enums.SurveyRuleCombinatorAnd,
+				Conditions: append(conditions, SurveyRuleCondition{Field: "phq_score", Operator: enums.SurveyRuleOperatorLessThan, Value: "5"}),
+			},
+			wantMatch: false,
+		},
+		{
+			name: "any condition matches",
+			rule: SurveyRedFlagRule{
+				Combinator: enums.SurveyRuleCombinatorOr,
+				Conditions: []SurveyRuleCondition{
+					{Field: "feeling_unsafe", Operator: enums.SurveyRuleOperatorEquals, Value: "no"},
+					{Field: "vitals/temperature", Operator: enums.SurveyRuleOperatorGreaterThan, Value: "38"},
+				},
+			},
+			want:      map[string]string{"vitals/temperature": "39.5"},
+			wantMatch: true,
+		},
+		{
+			name: "unanswered field",
+			rule: SurveyRedFlagRule{
+				Combinator: enums.SurveyRuleCombinatorOr,
+				Conditions: []SurveyRuleCondition{
+					{Field: "self_harm", Operator: enums.SurveyRuleOperatorNotEquals, Value: "no"},
+					{Field: "vitals/pulse", Operator: enums.SurveyRuleOperatorGreaterThan, Value: "100"},
+				},
+			},
+			wantMatch: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, matched := tt.rule.Match(data)
+			if matched != tt.wantMatch {
+				t.Errorf("SurveyRedFlagRule.Match() matched = %v, want %v", matched, tt.wantMatch)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SurveyRedFlagRule.Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -95,6 +95,8 @@ var (
 	mflIdentifierType              = enums.FacilityIdentifierTypeMFLCode.String()
 	// Pin variables
 	salt, encryptedPin string
+	// OTP variables
+	otpSalt, encryptedOTP string
 	// Securityquestions variables
 	securityQuestionID  = "26b20a42-cbb8-4553-aedb-c539602d04fb"
 	securityQuestionID2 = "fada0b8a-4f3c-4df2-82be-35b82753f66c"
@@ -122,6 +124,16 @@ var (
 	clientsServiceRequestID   = "8ecbbc10-24c8-421a-9f1a-e17f12678ef1"
 	staffServiceRequestID     = "8ecbbc10-24c8-421a-9f1a-e17f12678ef1"
 	clientsHealthDiaryEntryID = "8ecbbc10-24c8-421a-9f1a-e17f12678ef1"
+
+	healthDiaryShareID          = "0fd7a5a1-3b4f-4b7e-9a55-8fd40d3a1c21"
+	caregiverHealthDiaryShareID = "6a1d1c55-77f6-4f5e-9a0c-1e6b0e4b2d90"
+
+	surveyCampaignID = "3c9e7d2a-5b1f-4c8e-8f0a-2d6b9e4a7c13"
+
+	surveyRedFlagRuleID = "7b2e4c91-0d3a-4f6e-b8c5-1a9f2e6d4b70"
+
+	crisisKeywordID = "5d8f2a63-9c4e-4b1a-a7e2-3f6c0b9d1e84"
+
 	// Service Request
 	serviceRequestID               = "8ecbbc80-24c8-421a-9f1a-e14e12678ef2"
 	clientServiceRequestIDToUpdate = "fffbb75c-9138-47e8-a75b-d7ee5df5e9a0"
@@ -138,6 +150,8 @@ var (
 	defaultClientRole      = authorization.DefaultRoleClient.String()
 	defaultCaregiverRoleID = "6337eda5-9520-44a6-a4f2-81c32da8dbf2"
 	defaultCaregiverRole   = authorization.DefaultRoleCaregiver.String()
+	customRoleID           = "b1d8e4a6-3f0c-4d2e-9a7b-5c6e8f1a2d3b"
+	customRoleIDToDelete   = "c2e9f5b7-4a1d-4e3f-8b8c-6d7f9a2b3e4c"
 
 	communityID         = "043f12aa-6f51-434f-8e96-35030306f161"
 	communityIDToDelete = "043f12aa-6f51-434f-8e96-35030306f162"
@@ -319,6 +333,7 @@ func TestMain(m *testing.M) {
 
 	// setup test variables
 	salt, encryptedPin = utils.EncryptPIN("0000", nil)
+	otpSalt, encryptedOTP = utils.EncryptPIN(testOTP, nil)
 
 	fixtures, err = testfixtures.New(
 		testfixtures.Database(db),
@@ -346,7 +361,8 @@ func TestMain(m *testing.M) {
 			"test_flavour":         testFlavour,
 			"test_organisation_id": orgID,
 			"future_time":          futureTime.String(),
-			"test_otp":             "\"" + testOTP + "\"",
+			"test_otp":             encryptedOTP,
+			"test_otp_salt":        otpSalt,
 
 			"treatment_buddy_id":  treatmentBuddyID,
 			"treatment_buddy_id2": treatmentBuddyID2,
@@ -403,6 +419,15 @@ func TestMain(m *testing.M) {
 
 			"test_service_request_id": serviceRequestID,
 
+			"clients_healthdiaryshare_id":           healthDiaryShareID,
+			"clients_caregiver_healthdiaryshare_id": caregiverHealthDiaryShareID,
+
+			"common_surveycampaign_id": surveyCampaignID,
+
+			"common_surveyredflagrule_id": surveyRedFlagRuleID,
+
+			"communities_crisiskeyword_id": crisisKeywordID,
+
 			"test_client_id": clientID,
 			"test_client_id_same_user_different_program": clientSameUserDifferentProgramID,
 			"test_client_id_different_user_same_program": clientDifferentUserSameProgramID,
@@ -418,6 +443,8 @@ func TestMain(m *testing.M) {
 			"default_client_role":       defaultClientRole,
 			"default_caregiver_role_id": defaultCaregiverRoleID,
 			"default_caregiver_role":    defaultCaregiverRole,
+			"custom_role_id":            customRoleID,
+			"custom_role_id_to_delete":  customRoleIDToDelete,
 
 			"community_id":           communityID,
 			"community_id_to_delete": communityIDToDelete,
@@ -611,8 +638,13 @@ func TestMain(m *testing.M) {
 			"../../../../../../fixtures/questionnaires_screeningtoolquestionresponse.yml",
 			"../../../../../../fixtures/caregivers_caregiver.yml",
 			"../../../../../../fixtures/caregivers_caregiver_client.yml",
+			"../../../../../../fixtures/clients_healthdiaryshare.yml",
+			"../../../../../../fixtures/common_surveycampaign.yml",
+			"../../../../../../fixtures/common_surveyredflagrule.yml",
+			"../../../../../../fixtures/communities_crisiskeyword.yml",
 			"../../../../../../fixtures/common_program.yml",
 			"../../../../../../fixtures/common_program_facility.yml",
+			"../../../../../../fixtures/common_auditlog.yml",
 		),
 		// uncomment when running tests locally, if your db is not a test db
 		// Ensure the testing db in the ci is named `test`
@@ -2,9 +2,13 @@ package gorm
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/utils"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // Create contains all the methods used to perform a create operation in DB
@@ -46,6 +50,24 @@ type Create interface {
 	CreateFacilities(ctx context.Context, facilities []*Facility) ([]*Facility, error)
 	CreateSecurityQuestions(ctx context.Context, securityQuestions []*SecurityQuestion) ([]*SecurityQuestion, error)
 	CreateTermsOfService(ctx context.Context, termsOfService *TermsOfService) (*TermsOfService, error)
+	CreateAuditLog(ctx context.Context, auditLog *AuditLog) error
+	CreateAuthorityRole(ctx context.Context, role *AuthorityRole, permissions []*AuthorityPermission) error
+	AssignStaffRole(ctx context.Context, staffID string, roleID string) error
+	CreateSMSDelivery(ctx context.Context, delivery *SMSDelivery) (*SMSDelivery, error)
+	CreateAppointmentReminder(ctx context.Context, reminder *AppointmentReminder) error
+	SaveNotificationPreference(ctx context.Context, preference *NotificationPreference) error
+	CreateAppointmentSlot(ctx context.Context, slot *AppointmentSlot) error
+	BookAppointmentSlot(ctx context.Context, appointment *Appointment, serviceRequest *ClientServiceRequest) error
+	CreateServiceRequestComment(ctx context.Context, comment *ServiceRequestComment) error
+	CreateServiceRequestEvent(ctx context.Context, event *ServiceRequestEvent) error
+	CreateScreeningToolScoreBand(ctx context.Context, input *ScreeningToolScoreBand) error
+	CreateQuestionCondition(ctx context.Context, input *QuestionCondition) error
+	CreateScreeningToolVersion(ctx context.Context, version *ScreeningToolVersion) error
+	CreateScreeningToolAssignment(ctx context.Context, assignment *ScreeningToolAssignment) error
+	CreateHealthDiaryShare(ctx context.Context, share *HealthDiaryShare) error
+	CreateSurveyCampaign(ctx context.Context, campaign *SurveyCampaign) error
+	CreateSurveyRedFlagRule(ctx context.Context, rule *SurveyRedFlagRule) error
+	CreateCommunityCrisisKeyword(ctx context.Context, keyword *CommunityCrisisKeyword) error
 }
 
 // SaveTemporaryUserPin is used to save a temporary user pin
@@ -79,7 +101,7 @@ func (db *PGInstance) SaveFeedback(ctx context.Context, feedback *Feedback) erro
 	return nil
 }
 
-// SaveOTP saves the generated otp to the database
+// SaveOTP saves the generated otp to the database. The OTP is salted and hashed before it is persisted
 func (db *PGInstance) SaveOTP(ctx context.Context, otpInput *UserOTP) error {
 	err := db.DB.WithContext(ctx).Model(&UserOTP{}).Where(&UserOTP{PhoneNumber: otpInput.PhoneNumber, Flavour: otpInput.Flavour}).
 		Updates(map[string]interface{}{"is_valid": false}).Error
@@ -87,6 +109,8 @@ func (db *PGInstance) SaveOTP(ctx context.Context, otpInput *UserOTP) error {
 		return fmt.Errorf("failed to update OTP data: %w", err)
 	}
 
+	otpInput.Salt, otpInput.OTP = utils.EncryptPIN(otpInput.OTP, nil)
+
 	//Save the OTP by setting valid to true
 	err = db.DB.WithContext(ctx).Create(otpInput).Error
 	if err != nil {
@@ -862,3 +886,273 @@ func (db *PGInstance) CreateTermsOfService(ctx context.Context, termsOfService *
 	}
 	return termsOfService, nil
 }
+
+// CreateAuditLog saves a record of a sensitive change in the database
+func (db *PGInstance) CreateAuditLog(ctx context.Context, auditLog *AuditLog) error {
+	err := db.DB.WithContext(ctx).Create(auditLog).Error
+	if err != nil {
+		return fmt.Errorf("failed to create audit log: %w", err)
+	}
+
+	return nil
+}
+
+// CreateAuthorityRole creates a custom role and grants it the provided permissions
+func (db *PGInstance) CreateAuthorityRole(ctx context.Context, role *AuthorityRole, permissions []*AuthorityPermission) error {
+	tx := db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+	if err := tx.Error; err != nil {
+		return fmt.Errorf("failed to initialize database transaction %w", err)
+	}
+
+	if err := tx.Create(role).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to create authority role: %w", err)
+	}
+
+	if err := grantRolePermissions(tx, *role.AuthorityRoleID, permissions); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// grantRolePermissions links a role to the provided permissions. A permission that has not been saved yet is created using its scope
+func grantRolePermissions(tx *gorm.DB, roleID string, permissions []*AuthorityPermission) error {
+	for _, permission := range permissions {
+		record := AuthorityPermission{}
+		err := tx.Where(&AuthorityPermission{Scope: permission.Scope}).Attrs(AuthorityPermission{
+			Active:      true,
+			Name:        permission.Name,
+			Description: permission.Description,
+			Category:    permission.Category,
+		}).FirstOrCreate(&record).Error
+		if err != nil {
+			return fmt.Errorf("failed to get or create %s permission: %w", permission.Scope, err)
+		}
+
+		rolePermission := &AuthorityRolePermission{
+			PermissionID: record.AuthorityPermissionID,
+			RoleID:       &roleID,
+			Active:       true,
+		}
+		if err := tx.Create(rolePermission).Error; err != nil {
+			return fmt.Errorf("failed to grant %s permission to role: %w", permission.Scope, err)
+		}
+	}
+
+	return nil
+}
+
+// AssignStaffRole assigns a role to a staff
+func (db *PGInstance) AssignStaffRole(ctx context.Context, staffID string, roleID string) error {
+	assignment := &AuthorityRoleStaff{
+		RoleID:  &roleID,
+		StaffID: &staffID,
+	}
+
+	if err := db.DB.WithContext(ctx).Create(assignment).Error; err != nil {
+		return fmt.Errorf("failed to assign role to staff: %w", err)
+	}
+
+	return nil
+}
+
+// CreateSMSDelivery creates a record used to track the delivery of an SMS
+func (db *PGInstance) CreateSMSDelivery(ctx context.Context, delivery *SMSDelivery) (*SMSDelivery, error) {
+	if err := db.DB.WithContext(ctx).Create(delivery).Error; err != nil {
+		return nil, fmt.Errorf("failed to create sms delivery: %w", err)
+	}
+	return delivery, nil
+}
+
+// CreateAppointmentReminder records a reminder that has been sent for an appointment
+func (db *PGInstance) CreateAppointmentReminder(ctx context.Context, reminder *AppointmentReminder) error {
+	if err := db.DB.WithContext(ctx).Create(reminder).Error; err != nil {
+		return fmt.Errorf("failed to create appointment reminder: %w", err)
+	}
+	return nil
+}
+
+// SaveNotificationPreference creates or updates a user's preference for a type of notification
+func (db *PGInstance) SaveNotificationPreference(ctx context.Context, preference *NotificationPreference) error {
+	err := db.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "notification_type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"push_enabled", "sms_enabled", "updated", "updated_by"}),
+	}).Create(preference).Error
+	if err != nil {
+		return fmt.Errorf("failed to save notification preference: %w", err)
+	}
+	return nil
+}
+
+// CreateAppointmentSlot creates a slot during which a facility accepts appointment bookings
+func (db *PGInstance) CreateAppointmentSlot(ctx context.Context, slot *AppointmentSlot) error {
+	if err := db.DB.WithContext(ctx).Create(slot).Error; err != nil {
+		return fmt.Errorf("failed to create appointment slot: %w", err)
+	}
+	return nil
+}
+
+// BookAppointmentSlot creates an appointment against a facility slot together with the service request used to
+// share the booking with KenyaEMR.
+//
+// The slot is locked for the duration of the transaction so that concurrent bookings cannot exceed its capacity.
+// The ID of the new appointment is added to the service request meta under `appointmentID`
+func (db *PGInstance) BookAppointmentSlot(ctx context.Context, appointment *Appointment, serviceRequest *ClientServiceRequest) error {
+	if appointment.SlotID == nil {
+		return fmt.Errorf("an appointment slot is required to book an appointment")
+	}
+
+	tx := db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var slot AppointmentSlot
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND active = ?", *appointment.SlotID, true).
+		First(&slot).Error
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to get appointment slot: %w", err)
+	}
+
+	var booked int64
+	err = tx.Model(&Appointment{}).
+		Where("slot_id = ? AND date = ? AND active = ?", slot.ID, appointment.Date, true).
+		Count(&booked).Error
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to count appointment slot bookings: %w", err)
+	}
+	if booked >= int64(slot.Capacity) {
+		tx.Rollback()
+		return domain.ErrAppointmentSlotFullyBooked
+	}
+
+	if err := tx.Create(appointment).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to create an appointment: %w", err)
+	}
+
+	meta := map[string]interface{}{}
+	if serviceRequest.Meta != "" {
+		if err := json.Unmarshal([]byte(serviceRequest.Meta), &meta); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to unmarshal service request meta: %w", err)
+		}
+	}
+	meta["appointmentID"] = appointment.ID
+
+	metaJSON, err := json.Marshal(meta)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to marshal service request meta: %w", err)
+	}
+	serviceRequest.Meta = string(metaJSON)
+
+	if err := tx.Create(serviceRequest).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to create appointment booking service request: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CreateServiceRequestComment saves a comment left by a staff member on a service request
+func (db *PGInstance) CreateServiceRequestComment(ctx context.Context, comment *ServiceRequestComment) error {
+	if err := db.DB.WithContext(ctx).Create(comment).Error; err != nil {
+		return fmt.Errorf("failed to create service request comment: %w", err)
+	}
+	return nil
+}
+
+// CreateServiceRequestEvent appends an entry to the history of a service request
+func (db *PGInstance) CreateServiceRequestEvent(ctx context.Context, event *ServiceRequestEvent) error {
+	if err := db.DB.WithContext(ctx).Create(event).Error; err != nil {
+		return fmt.Errorf("failed to create service request event: %w", err)
+	}
+	return nil
+}
+
+// CreateScreeningToolScoreBand saves a screening tool score band to the database
+func (db *PGInstance) CreateScreeningToolScoreBand(ctx context.Context, input *ScreeningToolScoreBand) error {
+	if err := db.DB.WithContext(ctx).Create(&input).Error; err != nil {
+		return fmt.Errorf("failed to create screening tool score band: %w", err)
+	}
+	return nil
+}
+
+// CreateQuestionCondition saves a question display condition to the database
+func (db *PGInstance) CreateQuestionCondition(ctx context.Context, input *QuestionCondition) error {
+	if err := db.DB.WithContext(ctx).Create(&input).Error; err != nil {
+		return fmt.Errorf("failed to create question condition: %w", err)
+	}
+	return nil
+}
+
+// CreateScreeningToolVersion records a version of a screening tool's questionnaire
+func (db *PGInstance) CreateScreeningToolVersion(ctx context.Context, version *ScreeningToolVersion) error {
+	if err := db.DB.WithContext(ctx).Create(&version).Error; err != nil {
+		return fmt.Errorf("failed to create screening tool version: %w", err)
+	}
+	return nil
+}
+
+// CreateScreeningToolAssignment records a screening tool that a staff assigned to a client
+func (db *PGInstance) CreateScreeningToolAssignment(ctx context.Context, assignment *ScreeningToolAssignment) error {
+	if err := db.DB.WithContext(ctx).Create(&assignment).Error; err != nil {
+		return fmt.Errorf("failed to create screening tool assignment: %w", err)
+	}
+	return nil
+}
+
+// CreateHealthDiaryShare records a grant that gives a facility or caregiver access to part of a client's health diary
+func (db *PGInstance) CreateHealthDiaryShare(ctx context.Context, share *HealthDiaryShare) error {
+	if err := db.DB.WithContext(ctx).Create(&share).Error; err != nil {
+		return fmt.Errorf("failed to create health diary share: %w", err)
+	}
+	return nil
+}
+
+// CreateSurveyCampaign saves a survey campaign that sends a survey to clients at a scheduled time
+func (db *PGInstance) CreateSurveyCampaign(ctx context.Context, campaign *SurveyCampaign) error {
+	if err := db.DB.WithContext(ctx).Create(&campaign).Error; err != nil {
+		return fmt.Errorf("failed to create survey campaign: %w", err)
+	}
+	return nil
+}
+
+// CreateSurveyRedFlagRule saves a rule used to flag submissions to a survey form
+func (db *PGInstance) CreateSurveyRedFlagRule(ctx context.Context, rule *SurveyRedFlagRule) error {
+	if err := db.DB.WithContext(ctx).Create(&rule).Error; err != nil {
+		return fmt.Errorf("failed to create survey red flag rule: %w", err)
+	}
+	return nil
+}
+
+// CreateCommunityCrisisKeyword saves a keyword used to flag community messages that may indicate a crisis
+func (db *PGInstance) CreateCommunityCrisisKeyword(ctx context.Context, keyword *CommunityCrisisKeyword) error {
+	if err := db.DB.WithContext(ctx).Create(&keyword).Error; err != nil {
+		return fmt.Errorf("failed to create community crisis keyword: %w", err)
+	}
+	return nil
+}
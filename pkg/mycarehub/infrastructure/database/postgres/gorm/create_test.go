@@ -2,7 +2,9 @@ package gorm_test
 
 import (
 	"context"
+	"errors"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/savannahghi/interserviceclient"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/utils"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/gorm"
 	"github.com/segmentio/ksuid"
 )
@@ -2563,3 +2566,834 @@ func TestPGInstance_CreateTermsOfService(t *testing.T) {
 		})
 	}
 }
+
+func TestPGInstance_CreateAuditLog(t *testing.T) {
+	invalidID := "invalid-id"
+
+	type args struct {
+		ctx      context.Context
+		auditLog *gorm.AuditLog
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create an audit log",
+			args: args{
+				ctx: addRequiredContext(context.Background(), t),
+				auditLog: &gorm.AuditLog{
+					Active:         true,
+					RecordType:     enums.AuditLogRecordTypeClientFacilityTransfer.String(),
+					TargetID:       clientID,
+					TargetType:     "CLIENT",
+					OldValue:       `{"facilityID": "` + facilityID + `"}`,
+					NewValue:       `{"facilityID": "` + facilityToAddToUserProfile + `"}`,
+					OrganisationID: orgID,
+					ProgramID:      &programID,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid actor id",
+			args: args{
+				ctx: context.Background(),
+				auditLog: &gorm.AuditLog{
+					Active:         true,
+					RecordType:     enums.AuditLogRecordTypeClientFacilityTransfer.String(),
+					ActorID:        &invalidID,
+					TargetID:       clientID,
+					TargetType:     "CLIENT",
+					OldValue:       "null",
+					NewValue:       "null",
+					OrganisationID: orgID,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := testingDB.CreateAuditLog(tt.args.ctx, tt.args.auditLog); (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.CreateAuditLog() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPGInstance_CreateAuthorityRole(t *testing.T) {
+	invalidOrgID := "invalid-id"
+	permissions := []*gorm.AuthorityPermission{
+		{
+			Name:        "Read facility",
+			Description: "Can read facility",
+			Category:    "Facility",
+			Scope:       "facility.read",
+		},
+	}
+
+	type args struct {
+		ctx         context.Context
+		role        *gorm.AuthorityRole
+		permissions []*gorm.AuthorityPermission
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create a program role",
+			args: args{
+				ctx: context.Background(),
+				role: &gorm.AuthorityRole{
+					Name:           "Program Manager",
+					Active:         true,
+					UserType:       "STAFF",
+					OrganisationID: orgID,
+					ProgramID:      &programID,
+				},
+				permissions: permissions,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: create an organisation role",
+			args: args{
+				ctx: context.Background(),
+				role: &gorm.AuthorityRole{
+					Name:           "Organisation Manager",
+					Active:         true,
+					UserType:       "STAFF",
+					OrganisationID: orgID,
+				},
+				permissions: permissions,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid organisation id",
+			args: args{
+				ctx: context.Background(),
+				role: &gorm.AuthorityRole{
+					Name:           "Program Manager",
+					UserType:       "STAFF",
+					OrganisationID: invalidOrgID,
+				},
+				permissions: permissions,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := testingDB.CreateAuthorityRole(tt.args.ctx, tt.args.role, tt.args.permissions); (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.CreateAuthorityRole() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPGInstance_AssignStaffRole(t *testing.T) {
+	type args struct {
+		ctx     context.Context
+		staffID string
+		roleID  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: assign staff role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: staffID,
+				roleID:  customRoleIDToDelete,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: role already assigned",
+			args: args{
+				ctx:     context.Background(),
+				staffID: staffWithRolesID,
+				roleID:  systemAdminRoleID,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid role id",
+			args: args{
+				ctx:     context.Background(),
+				staffID: staffID,
+				roleID:  "invalid-id",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := testingDB.AssignStaffRole(tt.args.ctx, tt.args.staffID, tt.args.roleID); (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.AssignStaffRole() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPGInstance_CreateSMSDelivery(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		delivery *gorm.SMSDelivery
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create sms delivery",
+			args: args{
+				ctx: context.Background(),
+				delivery: &gorm.SMSDelivery{
+					PhoneNumber: testPhone,
+					Status:      enums.SMSDeliveryStatusPending.String(),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.CreateSMSDelivery(tt.args.ctx, tt.args.delivery)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.CreateSMSDelivery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.ID == "" {
+				t.Errorf("expected the sms delivery to have an ID")
+				return
+			}
+
+			if !tt.wantErr {
+				if err := testingDB.DB.Where("id = ?", got.ID).Unscoped().Delete(&gorm.SMSDelivery{}).Error; err != nil {
+					t.Errorf("failed to delete sms delivery: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestPGInstance_CreateAppointmentReminder(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		reminder *gorm.AppointmentReminder
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create appointment reminder",
+			args: args{
+				ctx: context.Background(),
+				reminder: &gorm.AppointmentReminder{
+					AppointmentID: appointmentID,
+					OffsetDays:    1,
+					PushSent:      true,
+					SentAt:        time.Now(),
+				},
+			},
+		},
+		{
+			name: "Sad case: reminder already sent at the offset",
+			args: args{
+				ctx: context.Background(),
+				reminder: &gorm.AppointmentReminder{
+					AppointmentID: appointmentID,
+					OffsetDays:    1,
+					SentAt:        time.Now(),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testingDB.CreateAppointmentReminder(tt.args.ctx, tt.args.reminder)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.CreateAppointmentReminder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+
+	if err := testingDB.DB.Where("appointment_id = ?", appointmentID).Unscoped().Delete(&gorm.AppointmentReminder{}).Error; err != nil {
+		t.Errorf("failed to delete appointment reminders: %v", err)
+	}
+}
+
+func TestPGInstance_SaveNotificationPreference(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		preference *gorm.NotificationPreference
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create notification preference",
+			args: args{
+				ctx: context.Background(),
+				preference: &gorm.NotificationPreference{
+					UserID:           userID,
+					NotificationType: enums.NotificationTypeAppointment.String(),
+					PushEnabled:      true,
+					SMSEnabled:       true,
+				},
+			},
+		},
+		{
+			name: "Happy case: update existing notification preference",
+			args: args{
+				ctx: context.Background(),
+				preference: &gorm.NotificationPreference{
+					UserID:           userID,
+					NotificationType: enums.NotificationTypeAppointment.String(),
+					PushEnabled:      true,
+					SMSEnabled:       false,
+				},
+			},
+		},
+		{
+			name: "Sad case: invalid user",
+			args: args{
+				ctx: context.Background(),
+				preference: &gorm.NotificationPreference{
+					UserID:           uuid.New().String(),
+					NotificationType: enums.NotificationTypeAppointment.String(),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testingDB.SaveNotificationPreference(tt.args.ctx, tt.args.preference)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.SaveNotificationPreference() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				var saved gorm.NotificationPreference
+				err := testingDB.DB.Where("user_id = ? AND notification_type = ?", tt.args.preference.UserID, tt.args.preference.NotificationType).First(&saved).Error
+				if err != nil {
+					t.Errorf("failed to get notification preference: %v", err)
+					return
+				}
+				if saved.SMSEnabled != tt.args.preference.SMSEnabled {
+					t.Errorf("expected sms enabled to be %v, got %v", tt.args.preference.SMSEnabled, saved.SMSEnabled)
+				}
+			}
+		})
+	}
+
+	if err := testingDB.DB.Where("user_id = ?", userID).Unscoped().Delete(&gorm.NotificationPreference{}).Error; err != nil {
+		t.Errorf("failed to delete notification preferences: %v", err)
+	}
+}
+
+func TestPGInstance_CreateAppointmentSlot(t *testing.T) {
+	ctx := context.Background()
+
+	slot := &gorm.AppointmentSlot{
+		Active:     true,
+		FacilityID: facilityID,
+		DayOfWeek:  int(time.Monday),
+		StartTime:  "08:00",
+		EndTime:    "12:00",
+		Capacity:   10,
+		Reasons:    pq.StringArray{"Pharmacy Visit"},
+	}
+
+	if err := testingDB.CreateAppointmentSlot(ctx, slot); err != nil {
+		t.Errorf("PGInstance.CreateAppointmentSlot() error = %v", err)
+		return
+	}
+	if slot.ID == "" {
+		t.Errorf("expected the appointment slot to be assigned an ID")
+	}
+
+	if err := testingDB.DB.Where("id = ?", slot.ID).Unscoped().Delete(&gorm.AppointmentSlot{}).Error; err != nil {
+		t.Errorf("failed to delete appointment slot: %v", err)
+	}
+}
+
+func TestPGInstance_BookAppointmentSlot(t *testing.T) {
+	ctx := context.Background()
+	date := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+
+	slot := &gorm.AppointmentSlot{
+		Active:     true,
+		FacilityID: facilityID,
+		DayOfWeek:  int(date.Weekday()),
+		StartTime:  "08:00",
+		EndTime:    "12:00",
+		Capacity:   1,
+		Reasons:    pq.StringArray{"Pharmacy Visit"},
+	}
+	if err := testingDB.CreateAppointmentSlot(ctx, slot); err != nil {
+		t.Errorf("failed to create appointment slot: %v", err)
+		return
+	}
+
+	newBooking := func() (*gorm.Appointment, *gorm.ClientServiceRequest) {
+		appointment := &gorm.Appointment{
+			Active:         true,
+			Reason:         "Pharmacy Visit",
+			Date:           date,
+			ClientID:       clientID,
+			FacilityID:     facilityID,
+			ProgramID:      programID,
+			OrganisationID: orgID,
+			SlotID:         &slot.ID,
+		}
+		serviceRequest := &gorm.ClientServiceRequest{
+			Active:         true,
+			RequestType:    enums.ServiceRequestTypeAppointments.String(),
+			Request:        gofakeit.Sentence(5),
+			Status:         enums.ServiceRequestStatusPending.String(),
+			ClientID:       clientID,
+			FacilityID:     facilityID,
+			ProgramID:      programID,
+			OrganisationID: orgID,
+			Meta:           `{"action": "BOOK"}`,
+		}
+		return appointment, serviceRequest
+	}
+
+	appointment, serviceRequest := newBooking()
+	if err := testingDB.BookAppointmentSlot(ctx, appointment, serviceRequest); err != nil {
+		t.Errorf("PGInstance.BookAppointmentSlot() error = %v", err)
+		return
+	}
+	if !strings.Contains(serviceRequest.Meta, appointment.ID) {
+		t.Errorf("expected the service request meta to reference appointment %s", appointment.ID)
+	}
+
+	overbooking, overbookingRequest := newBooking()
+	err := testingDB.BookAppointmentSlot(ctx, overbooking, overbookingRequest)
+	if !errors.Is(err, domain.ErrAppointmentSlotFullyBooked) {
+		t.Errorf("expected booking a full appointment slot to fail with %v, got %v", domain.ErrAppointmentSlotFullyBooked, err)
+	}
+
+	if err := testingDB.DB.Where("id = ?", serviceRequest.ID).Unscoped().Delete(&gorm.ClientServiceRequest{}).Error; err != nil {
+		t.Errorf("failed to delete service request: %v", err)
+	}
+	if err := testingDB.DB.Where("slot_id = ?", slot.ID).Unscoped().Delete(&gorm.Appointment{}).Error; err != nil {
+		t.Errorf("failed to delete appointments: %v", err)
+	}
+	if err := testingDB.DB.Where("id = ?", slot.ID).Unscoped().Delete(&gorm.AppointmentSlot{}).Error; err != nil {
+		t.Errorf("failed to delete appointment slot: %v", err)
+	}
+}
+
+func TestPGInstance_CreateServiceRequestComment(t *testing.T) {
+	ctx := context.Background()
+
+	comment := &gorm.ServiceRequestComment{
+		Active:           true,
+		ServiceRequestID: serviceRequestID,
+		StaffID:          staffID,
+		Comment:          gofakeit.Sentence(5),
+	}
+	if err := testingDB.CreateServiceRequestComment(ctx, comment); err != nil {
+		t.Errorf("PGInstance.CreateServiceRequestComment() error = %v", err)
+		return
+	}
+
+	reply := &gorm.ServiceRequestComment{
+		Active:           true,
+		ServiceRequestID: serviceRequestID,
+		ParentID:         &comment.ID,
+		StaffID:          staffID,
+		Comment:          gofakeit.Sentence(5),
+	}
+	if err := testingDB.CreateServiceRequestComment(ctx, reply); err != nil {
+		t.Errorf("PGInstance.CreateServiceRequestComment() error = %v", err)
+		return
+	}
+
+	invalidParentID := uuid.New().String()
+	if err := testingDB.CreateServiceRequestComment(ctx, &gorm.ServiceRequestComment{
+		Active:           true,
+		ServiceRequestID: serviceRequestID,
+		ParentID:         &invalidParentID,
+		StaffID:          staffID,
+		Comment:          gofakeit.Sentence(5),
+	}); err == nil {
+		t.Errorf("expected an error replying to a comment that does not exist")
+	}
+
+	if err := testingDB.DB.Where("id IN ?", []string{reply.ID, comment.ID}).Unscoped().Delete(&gorm.ServiceRequestComment{}).Error; err != nil {
+		t.Errorf("failed to delete service request comments: %v", err)
+	}
+}
+
+func TestPGInstance_CreateServiceRequestEvent(t *testing.T) {
+	ctx := context.Background()
+
+	event := &gorm.ServiceRequestEvent{
+		ServiceRequestID: serviceRequestID,
+		EventType:        enums.ServiceRequestEventTypeAssigned.String(),
+		StaffID:          &staffID,
+		Status:           enums.ServiceRequestStatusPending.String(),
+		AssignedToID:     &staffID,
+	}
+	if err := testingDB.CreateServiceRequestEvent(ctx, event); err != nil {
+		t.Errorf("PGInstance.CreateServiceRequestEvent() error = %v", err)
+		return
+	}
+
+	if err := testingDB.DB.Model(&gorm.ServiceRequestEvent{}).Where("id = ?", event.ID).Update("notes", gofakeit.Sentence(5)).Error; err == nil {
+		t.Errorf("expected service request events to be immutable")
+	}
+
+	if err := testingDB.DB.Where("id = ?", event.ID).Unscoped().Delete(&gorm.ServiceRequestEvent{}).Error; err != nil {
+		t.Errorf("failed to delete service request event: %v", err)
+	}
+}
+
+func TestPGInstance_CreateScreeningToolScoreBand(t *testing.T) {
+	ctx := addRequiredContext(context.Background(), t)
+	priority := enums.ServiceRequestPriorityHigh.String()
+
+	scoreBand := &gorm.ScreeningToolScoreBand{
+		Active:          true,
+		ScreeningToolID: screeningToolID,
+		Label:           "Severe",
+		MinScore:        20,
+		Action:          enums.ScreeningToolBandActionCreateRedFlag.String(),
+		Priority:        &priority,
+		ProgramID:       programID,
+		OrganisationID:  orgID,
+	}
+	if err := testingDB.CreateScreeningToolScoreBand(ctx, scoreBand); err != nil {
+		t.Errorf("PGInstance.CreateScreeningToolScoreBand() error = %v", err)
+		return
+	}
+
+	if err := testingDB.CreateScreeningToolScoreBand(ctx, &gorm.ScreeningToolScoreBand{
+		Active:          true,
+		ScreeningToolID: uuid.NewString(),
+		Label:           "Severe",
+		MinScore:        20,
+		Action:          enums.ScreeningToolBandActionNone.String(),
+		ProgramID:       programID,
+		OrganisationID:  orgID,
+	}); err == nil {
+		t.Errorf("expected an error creating a score band for a screening tool that does not exist")
+	}
+
+	if err := testingDB.DB.Where("id = ?", scoreBand.ID).Unscoped().Delete(&gorm.ScreeningToolScoreBand{}).Error; err != nil {
+		t.Errorf("failed to delete screening tool score band: %v", err)
+	}
+}
+
+func TestPGInstance_CreateQuestionCondition(t *testing.T) {
+	ctx := addRequiredContext(context.Background(), t)
+
+	question := &gorm.Question{
+		Active:            true,
+		QuestionnaireID:   questionnaireID,
+		Text:              "How often do you have a fever?",
+		QuestionType:      enums.QuestionTypeOpenEnded.String(),
+		ResponseValueType: enums.QuestionResponseValueTypeString.String(),
+		Sequence:          2,
+		ProgramID:         programID,
+		OrganisationID:    orgID,
+	}
+	if err := testingDB.DB.Create(question).Error; err != nil {
+		t.Errorf("failed to create question: %v", err)
+		return
+	}
+
+	condition := &gorm.QuestionCondition{
+		Active:              true,
+		QuestionID:          question.ID,
+		DependsOnQuestionID: questionID,
+		Operator:            enums.QuestionConditionOperatorEquals.String(),
+		Target:              enums.QuestionConditionTargetChoice.String(),
+		Values:              []string{"0"},
+		ProgramID:           programID,
+		OrganisationID:      orgID,
+	}
+	if err := testingDB.CreateQuestionCondition(ctx, condition); err != nil {
+		t.Errorf("PGInstance.CreateQuestionCondition() error = %v", err)
+	}
+
+	if err := testingDB.CreateQuestionCondition(ctx, &gorm.QuestionCondition{
+		Active:              true,
+		QuestionID:          question.ID,
+		DependsOnQuestionID: uuid.NewString(),
+		Operator:            enums.QuestionConditionOperatorEquals.String(),
+		Target:              enums.QuestionConditionTargetChoice.String(),
+		Values:              []string{"0"},
+		ProgramID:           programID,
+		OrganisationID:      orgID,
+	}); err == nil {
+		t.Errorf("expected an error creating a condition on a question that does not exist")
+	}
+
+	if err := testingDB.DB.Where("id = ?", condition.ID).Unscoped().Delete(&gorm.QuestionCondition{}).Error; err != nil {
+		t.Errorf("failed to delete question condition: %v", err)
+	}
+	if err := testingDB.DB.Where("id = ?", question.ID).Unscoped().Delete(&gorm.Question{}).Error; err != nil {
+		t.Errorf("failed to delete question: %v", err)
+	}
+}
+
+func TestPGInstance_CreateScreeningToolVersion(t *testing.T) {
+	ctx := addRequiredContext(context.Background(), t)
+
+	version := &gorm.ScreeningToolVersion{
+		Active:          true,
+		ScreeningToolID: screeningToolID,
+		QuestionnaireID: questionnaireID,
+		Version:         100,
+		Status:          enums.ScreeningToolVersionStatusRetired.String(),
+		ProgramID:       programID,
+		OrganisationID:  orgID,
+	}
+	if err := testingDB.CreateScreeningToolVersion(ctx, version); err != nil {
+		t.Errorf("PGInstance.CreateScreeningToolVersion() error = %v", err)
+		return
+	}
+
+	if err := testingDB.CreateScreeningToolVersion(ctx, &gorm.ScreeningToolVersion{
+		Active:          true,
+		ScreeningToolID: screeningToolID,
+		QuestionnaireID: questionnaireID,
+		Version:         100,
+		Status:          enums.ScreeningToolVersionStatusRetired.String(),
+		ProgramID:       programID,
+		OrganisationID:  orgID,
+	}); err == nil {
+		t.Errorf("expected an error creating a duplicate screening tool version")
+	}
+
+	if err := testingDB.DB.Where("id = ?", version.ID).Unscoped().Delete(&gorm.ScreeningToolVersion{}).Error; err != nil {
+		t.Errorf("failed to delete screening tool version: %v", err)
+	}
+}
+
+func TestPGInstance_CreateScreeningToolAssignment(t *testing.T) {
+	ctx := addRequiredContext(context.Background(), t)
+
+	assignment := &gorm.ScreeningToolAssignment{
+		Active:          true,
+		ScreeningToolID: screeningToolID,
+		ClientID:        clientID,
+		StaffID:         staffID,
+		DueDate:         time.Now().AddDate(0, 0, 7),
+		Status:          enums.ScreeningToolAssignmentStatusPending.String(),
+		ProgramID:       programID,
+		OrganisationID:  orgID,
+	}
+	if err := testingDB.CreateScreeningToolAssignment(ctx, assignment); err != nil {
+		t.Errorf("PGInstance.CreateScreeningToolAssignment() error = %v", err)
+		return
+	}
+
+	if err := testingDB.CreateScreeningToolAssignment(ctx, &gorm.ScreeningToolAssignment{
+		Active:          true,
+		ScreeningToolID: screeningToolID,
+		ClientID:        "invalid",
+		StaffID:         staffID,
+		DueDate:         time.Now().AddDate(0, 0, 7),
+		Status:          enums.ScreeningToolAssignmentStatusPending.String(),
+		ProgramID:       programID,
+		OrganisationID:  orgID,
+	}); err == nil {
+		t.Errorf("expected an error creating a screening tool assignment for an invalid client")
+	}
+
+	if err := testingDB.DB.Where("id = ?", assignment.ID).Unscoped().Delete(&gorm.ScreeningToolAssignment{}).Error; err != nil {
+		t.Errorf("failed to delete screening tool assignment: %v", err)
+	}
+}
+
+func TestPGInstance_CreateHealthDiaryShare(t *testing.T) {
+	ctx := addRequiredContext(context.Background(), t)
+
+	expiresAt := time.Now().AddDate(0, 0, 7)
+	share := &gorm.HealthDiaryShare{
+		Active:         true,
+		ClientID:       clientID,
+		FacilityID:     &facilityID,
+		Scope:          enums.HealthDiaryShareScopeEntries.String(),
+		EntryIDs:       pq.StringArray{clientsHealthDiaryEntryID},
+		ExpiresAt:      &expiresAt,
+		ProgramID:      programID,
+		OrganisationID: orgID,
+	}
+
+	err := testingDB.CreateHealthDiaryShare(ctx, share)
+	if err != nil {
+		t.Errorf("PGInstance.CreateHealthDiaryShare() error = %v", err)
+		return
+	}
+	if share.ID == "" {
+		t.Errorf("expected the health diary share to have an ID")
+	}
+
+	invalidShare := &gorm.HealthDiaryShare{
+		Active:         true,
+		ClientID:       gofakeit.HipsterSentence(10),
+		FacilityID:     &facilityID,
+		Scope:          enums.HealthDiaryShareScopeEntireDiary.String(),
+		ProgramID:      programID,
+		OrganisationID: orgID,
+	}
+	if err := testingDB.CreateHealthDiaryShare(ctx, invalidShare); err == nil {
+		t.Errorf("expected an error creating a health diary share for an invalid client")
+	}
+
+	if err := testingDB.DB.Where("id = ?", share.ID).Unscoped().Delete(&gorm.HealthDiaryShare{}).Error; err != nil {
+		t.Errorf("failed to delete health diary share: %v", err)
+	}
+}
+
+func TestPGInstance_CreateSurveyCampaign(t *testing.T) {
+	ctx := addRequiredContext(context.Background(), t)
+
+	minAge, maxAge := 18, 30
+	nextSendAt := time.Now().Add(time.Hour)
+	campaign := &gorm.SurveyCampaign{
+		Active:         true,
+		FacilityID:     facilityID,
+		ProjectID:      1,
+		FormID:         formID,
+		Title:          gofakeit.HipsterSentence(3),
+		ClientTypes:    pq.StringArray{enums.ClientTypePmtct.String()},
+		MinimumAge:     &minAge,
+		MaximumAge:     &maxAge,
+		Recurrence:     enums.SurveyCampaignRecurrenceWeekly.String(),
+		Status:         enums.SurveyCampaignStatusScheduled.String(),
+		NextSendAt:     &nextSendAt,
+		LinkExpiryDays: 7,
+		ProgramID:      programID,
+		OrganisationID: orgID,
+	}
+
+	err := testingDB.CreateSurveyCampaign(ctx, campaign)
+	if err != nil {
+		t.Errorf("PGInstance.CreateSurveyCampaign() error = %v", err)
+		return
+	}
+	if campaign.ID == "" {
+		t.Errorf("expected the survey campaign to have an ID")
+	}
+
+	invalidCampaign := &gorm.SurveyCampaign{
+		Active:         true,
+		FacilityID:     gofakeit.HipsterSentence(10),
+		ProjectID:      1,
+		FormID:         formID,
+		Title:          gofakeit.HipsterSentence(3),
+		LinkExpiryDays: 7,
+		ProgramID:      programID,
+		OrganisationID: orgID,
+	}
+	if err := testingDB.CreateSurveyCampaign(ctx, invalidCampaign); err == nil {
+		t.Errorf("expected an error creating a survey campaign for an invalid facility")
+	}
+
+	if err := testingDB.DB.Where("id = ?", campaign.ID).Unscoped().Delete(&gorm.SurveyCampaign{}).Error; err != nil {
+		t.Errorf("failed to delete survey campaign: %v", err)
+	}
+}
+
+func TestPGInstance_CreateSurveyRedFlagRule(t *testing.T) {
+	ctx := addRequiredContext(context.Background(), t)
+
+	rule := &gorm.SurveyRedFlagRule{
+		Active:         true,
+		Name:           gofakeit.HipsterSentence(3),
+		ProjectID:      1,
+		FormID:         formID,
+		Combinator:     enums.SurveyRuleCombinatorOr.String(),
+		Conditions:     `[{"field": "feeling_unsafe", "operator": "EQUALS", "value": "yes"}]`,
+		ProgramID:      programID,
+		OrganisationID: orgID,
+	}
+
+	err := testingDB.CreateSurveyRedFlagRule(ctx, rule)
+	if err != nil {
+		t.Errorf("PGInstance.CreateSurveyRedFlagRule() error = %v", err)
+		return
+	}
+	if rule.ID == "" {
+		t.Errorf("expected the survey red flag rule to have an ID")
+	}
+
+	invalidRule := &gorm.SurveyRedFlagRule{
+		Active:         true,
+		Name:           gofakeit.HipsterSentence(3),
+		ProjectID:      1,
+		FormID:         formID,
+		Conditions:     "[]",
+		ProgramID:      gofakeit.HipsterSentence(10),
+		OrganisationID: orgID,
+	}
+	if err := testingDB.CreateSurveyRedFlagRule(ctx, invalidRule); err == nil {
+		t.Errorf("expected an error creating a survey red flag rule for an invalid program")
+	}
+
+	if err := testingDB.DB.Where("id = ?", rule.ID).Unscoped().Delete(&gorm.SurveyRedFlagRule{}).Error; err != nil {
+		t.Errorf("failed to delete survey red flag rule: %v", err)
+	}
+}
+
+func TestPGInstance_CreateCommunityCrisisKeyword(t *testing.T) {
+	ctx := addRequiredContext(context.Background(), t)
+
+	keyword := &gorm.CommunityCrisisKeyword{
+		Active:         true,
+		Keyword:        `(beat|hit)s? me`,
+		IsRegex:        true,
+		Category:       enums.CommunityCrisisCategoryAbuse.String(),
+		ProgramID:      programID,
+		OrganisationID: orgID,
+	}
+
+	err := testingDB.CreateCommunityCrisisKeyword(ctx, keyword)
+	if err != nil {
+		t.Errorf("PGInstance.CreateCommunityCrisisKeyword() error = %v", err)
+		return
+	}
+	if keyword.ID == "" {
+		t.Errorf("expected the community crisis keyword to have an ID")
+	}
+
+	invalidKeyword := &gorm.CommunityCrisisKeyword{
+		Active:         true,
+		Keyword:        "hopeless",
+		Category:       enums.CommunityCrisisCategorySelfHarm.String(),
+		ProgramID:      gofakeit.HipsterSentence(10),
+		OrganisationID: orgID,
+	}
+	if err := testingDB.CreateCommunityCrisisKeyword(ctx, invalidKeyword); err == nil {
+		t.Errorf("expected an error creating a community crisis keyword for an invalid program")
+	}
+
+	if err := testingDB.DB.Where("id = ?", keyword.ID).Unscoped().Delete(&gorm.CommunityCrisisKeyword{}).Error; err != nil {
+		t.Errorf("failed to delete community crisis keyword: %v", err)
+	}
+}
@@ -19,6 +19,9 @@ type Delete interface {
 	RemoveFacilitiesFromClientProfile(ctx context.Context, clientID string, facilities []string) error
 	RemoveFacilitiesFromStaffProfile(ctx context.Context, staffID string, facilities []string) error
 	DeleteOrganisation(ctx context.Context, organisation *Organisation) error
+	DeleteAuthorityRole(ctx context.Context, roleID string) error
+	RevokeStaffRole(ctx context.Context, staffID string, roleID string) error
+	DeleteQuestionnaire(ctx context.Context, questionnaireID string) error
 }
 
 // DeleteFacility will do the actual deletion of a facility from the database
@@ -217,3 +220,61 @@ func (db *PGInstance) DeleteOrganisation(ctx context.Context, organisation *Orga
 
 	return nil
 }
+
+// DeleteAuthorityRole deletes a role. The role's permissions and assignments are removed with it
+func (db *PGInstance) DeleteAuthorityRole(ctx context.Context, roleID string) error {
+	err := db.DB.WithContext(ctx).Where(&AuthorityRole{AuthorityRoleID: &roleID}).Delete(&AuthorityRole{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete authority role: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeStaffRole removes a role that had been assigned to a staff
+func (db *PGInstance) RevokeStaffRole(ctx context.Context, staffID string, roleID string) error {
+	err := db.DB.WithContext(ctx).Where(&AuthorityRoleStaff{RoleID: &roleID, StaffID: &staffID}).Delete(&AuthorityRoleStaff{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to revoke staff role: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteQuestionnaire deletes a questionnaire together with its questions, their choices and display conditions
+func (db *PGInstance) DeleteQuestionnaire(ctx context.Context, questionnaireID string) error {
+	if questionnaireID == "" {
+		return fmt.Errorf("questionnaire ID must be provided")
+	}
+
+	tx := db.DB.WithContext(ctx).Begin()
+
+	questions := tx.Model(&Question{}).Select("id").Where(&Question{QuestionnaireID: questionnaireID})
+
+	if err := tx.Where("question_id IN (?)", questions).Delete(&QuestionInputChoice{}).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to delete question choices: %w", err)
+	}
+
+	if err := tx.Where("question_id IN (?) OR depends_on_question_id IN (?)", questions, questions).Delete(&QuestionCondition{}).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to delete question conditions: %w", err)
+	}
+
+	if err := tx.Where(&Question{QuestionnaireID: questionnaireID}).Delete(&Question{}).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to delete questions: %w", err)
+	}
+
+	if err := tx.Where(&Questionnaire{ID: questionnaireID}).Delete(&Questionnaire{}).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to delete questionnaire: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to commit delete questionnaire transaction: %w", err)
+	}
+
+	return nil
+}
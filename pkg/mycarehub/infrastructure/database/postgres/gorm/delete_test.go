@@ -209,3 +209,156 @@ func TestPGInstance_DeleteOrganisation(t *testing.T) {
 		})
 	}
 }
+
+func TestPGInstance_RevokeStaffRole(t *testing.T) {
+	type args struct {
+		ctx     context.Context
+		staffID string
+		roleID  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: revoke staff role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: staffID,
+				roleID:  customRoleIDToDelete,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid role id",
+			args: args{
+				ctx:     context.Background(),
+				staffID: staffID,
+				roleID:  "invalid-id",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := testingDB.RevokeStaffRole(tt.args.ctx, tt.args.staffID, tt.args.roleID); (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.RevokeStaffRole() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPGInstance_DeleteAuthorityRole(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		roleID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: delete role",
+			args: args{
+				ctx:    context.Background(),
+				roleID: customRoleIDToDelete,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid role id",
+			args: args{
+				ctx:    context.Background(),
+				roleID: "invalid-id",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := testingDB.DeleteAuthorityRole(tt.args.ctx, tt.args.roleID); (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.DeleteAuthorityRole() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPGInstance_DeleteQuestionnaire(t *testing.T) {
+	ctx := context.Background()
+
+	questionnaire := &gorm.Questionnaire{
+		Active:         true,
+		Name:           "Discarded draft",
+		ProgramID:      programID,
+		OrganisationID: orgID,
+	}
+	if err := testingDB.DB.Create(questionnaire).Error; err != nil {
+		t.Errorf("failed to create questionnaire: %v", err)
+		return
+	}
+
+	questions := []*gorm.Question{}
+	for sequence := 1; sequence <= 2; sequence++ {
+		question := &gorm.Question{
+			Active:            true,
+			QuestionnaireID:   questionnaire.ID,
+			Text:              "Do you have a fever?",
+			QuestionType:      "CLOSE_ENDED",
+			ResponseValueType: "NUMBER",
+			Sequence:          sequence,
+			ProgramID:         programID,
+			OrganisationID:    orgID,
+		}
+		if err := testingDB.DB.Create(question).Error; err != nil {
+			t.Errorf("failed to create question: %v", err)
+			return
+		}
+		if err := testingDB.DB.Create(&gorm.QuestionInputChoice{
+			Active:         true,
+			QuestionID:     question.ID,
+			Choice:         "1",
+			Value:          "1",
+			Score:          1,
+			ProgramID:      programID,
+			OrganisationID: orgID,
+		}).Error; err != nil {
+			t.Errorf("failed to create question choice: %v", err)
+			return
+		}
+		questions = append(questions, question)
+	}
+	if err := testingDB.DB.Create(&gorm.QuestionCondition{
+		Active:              true,
+		QuestionID:          questions[1].ID,
+		DependsOnQuestionID: questions[0].ID,
+		Operator:            "EQUALS",
+		Target:              "CHOICE",
+		Values:              []string{"1"},
+		ProgramID:           programID,
+		OrganisationID:      orgID,
+	}).Error; err != nil {
+		t.Errorf("failed to create question condition: %v", err)
+		return
+	}
+
+	if err := testingDB.DeleteQuestionnaire(ctx, ""); err == nil {
+		t.Errorf("expected an error deleting a questionnaire without an ID")
+	}
+
+	if err := testingDB.DeleteQuestionnaire(ctx, questionnaire.ID); err != nil {
+		t.Errorf("PGInstance.DeleteQuestionnaire() error = %v", err)
+		return
+	}
+
+	var count int64
+	testingDB.DB.Model(&gorm.Question{}).Where("questionnaire_id = ?", questionnaire.ID).Count(&count)
+	if count != 0 {
+		t.Errorf("expected the questionnaire's questions to be deleted, found %d", count)
+	}
+	testingDB.DB.Model(&gorm.Questionnaire{}).Where("id = ?", questionnaire.ID).Count(&count)
+	if count != 0 {
+		t.Errorf("expected the questionnaire to be deleted")
+	}
+}
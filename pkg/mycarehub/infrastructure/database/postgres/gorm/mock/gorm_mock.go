@@ -67,7 +67,7 @@ type GormMock struct {
 	MockCreateServiceRequestFn                                func(ctx context.Context, serviceRequestInput *gorm.ClientServiceRequest) error
 	MockCanRecordHeathDiaryFn                                 func(ctx context.Context, clientID string) (bool, error)
 	MockGetClientHealthDiaryQuoteFn                           func(ctx context.Context, limit int) ([]*gorm.ClientHealthDiaryQuote, error)
-	MockGetClientHealthDiaryEntriesFn                         func(ctx context.Context, params map[string]interface{}) ([]*gorm.ClientHealthDiaryEntry, error)
+	MockGetClientHealthDiaryEntriesFn                         func(ctx context.Context, params map[string]interface{}, shared *bool) ([]*gorm.ClientHealthDiaryEntry, error)
 	MockUpdateClientCaregiverFn                               func(ctx context.Context, caregiverInput *dto.CaregiverInput) error
 	MockInProgressByFn                                        func(ctx context.Context, requestID string, staffID string) (bool, error)
 	MockGetClientProfileByClientIDFn                          func(ctx context.Context, clientID string) (*gorm.Client, error)
@@ -81,7 +81,7 @@ type GormMock struct {
 	MockGetOrCreateNextOfKin                                  func(ctx context.Context, person *gorm.RelatedPerson, clientID, contactID string) error
 	MockGetOrCreateContact                                    func(ctx context.Context, contact *gorm.Contact) (*gorm.Contact, error)
 	MockGetClientsInAFacilityFn                               func(ctx context.Context, facilityID string) ([]*gorm.Client, error)
-	MockGetRecentHealthDiaryEntriesFn                         func(ctx context.Context, lastSyncTime time.Time, clientID string) ([]*gorm.ClientHealthDiaryEntry, error)
+	MockGetRecentHealthDiaryEntriesFn                         func(ctx context.Context, lastSyncTime time.Time, clientID string, facilityID string) ([]*gorm.ClientHealthDiaryEntry, error)
 	MockGetClientsByParams                                    func(ctx context.Context, params gorm.Client, lastSyncTime *time.Time) ([]*gorm.Client, error)
 	MockGetClientIdentifiers                                  func(ctx context.Context, clientID string) ([]*gorm.Identifier, error)
 	MockGetServiceRequestsForKenyaEMRFn                       func(ctx context.Context, facilityID string, lastSyncTime time.Time) ([]*gorm.ClientServiceRequest, error)
@@ -147,7 +147,7 @@ type GormMock struct {
 	MockGetQuestionInputChoicesByQuestionIDFn                 func(ctx context.Context, questionID string) ([]*gorm.QuestionInputChoice, error)
 	MockCreateScreeningToolResponseFn                         func(ctx context.Context, screeningToolResponse *gorm.ScreeningToolResponse, screeningToolQuestionResponses []*gorm.ScreeningToolQuestionResponse) (*string, error)
 	MockGetAvailableScreeningToolsFn                          func(ctx context.Context, clientID string, screeningTool gorm.ScreeningTool, screeningToolIDs []string) ([]*gorm.ScreeningTool, error)
-	MockGetScreeningToolResponsesWithin24HoursFn              func(ctx context.Context, clientID, programID string) ([]*gorm.ScreeningToolResponse, error)
+	MockGetLatestScreeningToolResponsesFn                     func(ctx context.Context, clientID, programID string) ([]*gorm.ScreeningToolResponse, error)
 	MockGetScreeningToolResponsesWithPendingServiceRequestsFn func(ctx context.Context, clientID, programID string) ([]*gorm.ScreeningToolResponse, error)
 	MockGetFacilityRespondedScreeningToolsFn                  func(ctx context.Context, facilityID, programID string, pagination *domain.Pagination) ([]*gorm.ScreeningTool, *domain.Pagination, error)
 	MockListSurveyRespondentsFn                               func(ctx context.Context, params *gorm.UserSurvey, facilityID string, pagination *domain.Pagination) ([]*gorm.UserSurvey, *domain.Pagination, error)
@@ -203,6 +203,84 @@ type GormMock struct {
 	MockCheckPhoneExistsFn                                    func(ctx context.Context, phone string) (bool, error)
 	MockUpdateProgramFn                                       func(ctx context.Context, program *gorm.Program, updateData map[string]interface{}) error
 	MockGetStaffServiceRequestByIDFn                          func(ctx context.Context, serviceRequestID string) (*gorm.StaffServiceRequest, error)
+	MockGetUserPermissionsFn                                  func(ctx context.Context, userID string, programID string) ([]*gorm.AuthorityPermission, error)
+	MockCreateAuditLogFn                                      func(ctx context.Context, auditLog *gorm.AuditLog) error
+	MockListAuditLogsFn                                       func(ctx context.Context, organisationID string, filter *dto.AuditLogFilterInput, pagination *domain.Pagination) ([]*gorm.AuditLog, *domain.Pagination, error)
+	MockCreateAuthorityRoleFn                                 func(ctx context.Context, role *gorm.AuthorityRole, permissions []*gorm.AuthorityPermission) error
+	MockAssignStaffRoleFn                                     func(ctx context.Context, staffID string, roleID string) error
+	MockGetAuthorityRoleByIDFn                                func(ctx context.Context, roleID string) (*gorm.AuthorityRole, error)
+	MockGetAuthorityRolePermissionsFn                         func(ctx context.Context, roleID string) ([]*gorm.AuthorityPermission, error)
+	MockListAuthorityRolesFn                                  func(ctx context.Context, organisationID string, programID string) ([]*gorm.AuthorityRole, error)
+	MockListAuthorityRoleStaffFn                              func(ctx context.Context, roleID string, pagination *domain.Pagination) ([]*gorm.StaffProfile, *domain.Pagination, error)
+	MockCheckStaffHasRoleFn                                   func(ctx context.Context, staffID string, roleID string) (bool, error)
+	MockUpdateAuthorityRoleFn                                 func(ctx context.Context, roleID string, updateData map[string]interface{}, permissions []*gorm.AuthorityPermission) error
+	MockDeleteAuthorityRoleFn                                 func(ctx context.Context, roleID string) error
+	MockRevokeStaffRoleFn                                     func(ctx context.Context, staffID string, roleID string) error
+	MockGetRecentOTPsCountFn                                  func(ctx context.Context, phoneNumber string, since time.Time) (int, error)
+	MockCreateSMSDeliveryFn                                   func(ctx context.Context, delivery *gorm.SMSDelivery) (*gorm.SMSDelivery, error)
+	MockUpdateSMSDeliveryFn                                   func(ctx context.Context, delivery *gorm.SMSDelivery, updateData map[string]interface{}) error
+	MockListAppointmentsPendingReminderFn                     func(ctx context.Context, appointmentDate time.Time, offsetDays int) ([]*gorm.Appointment, error)
+	MockCreateAppointmentReminderFn                           func(ctx context.Context, reminder *gorm.AppointmentReminder) error
+	MockGetNotificationPreferencesFn                          func(ctx context.Context, userID string) ([]*gorm.NotificationPreference, error)
+	MockSaveNotificationPreferenceFn                          func(ctx context.Context, preference *gorm.NotificationPreference) error
+	MockListMissedAppointmentsFn                              func(ctx context.Context, before time.Time) ([]*gorm.Appointment, error)
+	MockMarkAppointmentMissedFn                               func(ctx context.Context, appointmentID string, serviceRequest *gorm.ClientServiceRequest) error
+	MockCreateAppointmentSlotFn                               func(ctx context.Context, slot *gorm.AppointmentSlot) error
+	MockBookAppointmentSlotFn                                 func(ctx context.Context, appointment *gorm.Appointment, serviceRequest *gorm.ClientServiceRequest) error
+	MockGetAppointmentSlotFn                                  func(ctx context.Context, slotID string) (*gorm.AppointmentSlot, error)
+	MockListAppointmentSlotsFn                                func(ctx context.Context, facilityID string) ([]*gorm.AppointmentSlot, error)
+	MockGetAppointmentSlotBookingCountsFn                     func(ctx context.Context, facilityID string, startDate, endDate time.Time) ([]*gorm.AppointmentSlotBookingCount, error)
+	MockDeactivateAppointmentSlotFn                           func(ctx context.Context, slotID string) error
+	MockCancelAppointmentBookingFn                            func(ctx context.Context, appointmentID string, serviceRequest *gorm.ClientServiceRequest) error
+	MockListOverdueServiceRequestsFn                          func(ctx context.Context, requestType string, createdBefore time.Time) ([]*gorm.ClientServiceRequest, error)
+	MockMarkServiceRequestEscalatedFn                         func(ctx context.Context, serviceRequestID string, escalatedAt time.Time) (bool, error)
+	MockCreateServiceRequestCommentFn                         func(ctx context.Context, comment *gorm.ServiceRequestComment) error
+	MockCreateServiceRequestEventFn                           func(ctx context.Context, event *gorm.ServiceRequestEvent) error
+	MockListServiceRequestCommentsFn                          func(ctx context.Context, serviceRequestID string) ([]*gorm.ServiceRequestComment, error)
+	MockListServiceRequestEventsFn                            func(ctx context.Context, serviceRequestID string) ([]*gorm.ServiceRequestEvent, error)
+	MockCreateScreeningToolScoreBandFn                        func(ctx context.Context, input *gorm.ScreeningToolScoreBand) error
+	MockGetScreeningToolScoreBandsFn                          func(ctx context.Context, screeningToolID string) ([]*gorm.ScreeningToolScoreBand, error)
+	MockCreateQuestionConditionFn                             func(ctx context.Context, input *gorm.QuestionCondition) error
+	MockGetQuestionConditionsByQuestionIDFn                   func(ctx context.Context, questionID string) ([]*gorm.QuestionCondition, error)
+	MockCreateScreeningToolVersionFn                          func(ctx context.Context, version *gorm.ScreeningToolVersion) error
+	MockListScreeningToolVersionsFn                           func(ctx context.Context, screeningToolID string) ([]*gorm.ScreeningToolVersion, error)
+	MockUpdateScreeningToolVersionFn                          func(ctx context.Context, version *gorm.ScreeningToolVersion, updateData map[string]interface{}) error
+	MockPublishScreeningToolVersionFn                         func(ctx context.Context, version *gorm.ScreeningToolVersion) error
+	MockDeleteQuestionnaireFn                                 func(ctx context.Context, questionnaireID string) error
+	MockCreateScreeningToolAssignmentFn                       func(ctx context.Context, assignment *gorm.ScreeningToolAssignment) error
+	MockListScreeningToolAssignmentsFn                        func(ctx context.Context, params *gorm.ScreeningToolAssignment) ([]*gorm.ScreeningToolAssignment, error)
+	MockListPendingScreeningToolAssignmentsDueBeforeFn        func(ctx context.Context, dueBefore time.Time) ([]*gorm.ScreeningToolAssignment, error)
+	MockUpdateScreeningToolAssignmentFn                       func(ctx context.Context, assignment *gorm.ScreeningToolAssignment, updateData map[string]interface{}) error
+	MockCompleteScreeningToolAssignmentsFn                    func(ctx context.Context, clientID, screeningToolID, responseID string) error
+	MockUpdateScreeningToolFn                                 func(ctx context.Context, screeningTool *gorm.ScreeningTool, updateData map[string]interface{}) error
+	MockListClientScreeningToolResponsesFn                    func(ctx context.Context, clientID, programID string, screeningToolID *string) ([]*gorm.ScreeningToolResponse, error)
+	MockListClientHealthDiaryEntriesSinceFn                   func(ctx context.Context, clientID string, since time.Time) ([]*gorm.ClientHealthDiaryEntry, error)
+	MockListClientIDsWithHealthDiaryEntriesSinceFn            func(ctx context.Context, since time.Time) ([]string, error)
+	MockCreateHealthDiaryShareFn                              func(ctx context.Context, share *gorm.HealthDiaryShare) error
+	MockGetHealthDiaryShareByIDFn                             func(ctx context.Context, shareID string) (*gorm.HealthDiaryShare, error)
+	MockListClientHealthDiarySharesFn                         func(ctx context.Context, clientID string) ([]*gorm.HealthDiaryShare, error)
+	MockGetCaregiverSharedHealthDiaryEntriesFn                func(ctx context.Context, clientID string, caregiverID string) ([]*gorm.ClientHealthDiaryEntry, error)
+	MockUpdateHealthDiaryShareFn                              func(ctx context.Context, share *gorm.HealthDiaryShare, updateData map[string]interface{}) error
+	MockCreateSurveyCampaignFn                                func(ctx context.Context, campaign *gorm.SurveyCampaign) error
+	MockGetSurveyCampaignByIDFn                               func(ctx context.Context, campaignID string) (*gorm.SurveyCampaign, error)
+	MockListSurveyCampaignsFn                                 func(ctx context.Context, facilityID, programID string) ([]*gorm.SurveyCampaign, error)
+	MockListOngoingSurveyCampaignsFn                          func(ctx context.Context) ([]*gorm.SurveyCampaign, error)
+	MockUpdateSurveyCampaignFn                                func(ctx context.Context, campaign *gorm.SurveyCampaign, updateData map[string]interface{}) error
+	MockCreateSurveyRedFlagRuleFn                             func(ctx context.Context, rule *gorm.SurveyRedFlagRule) error
+	MockListSurveyRedFlagRulesFn                              func(ctx context.Context, projectID int, formID, programID string) ([]*gorm.SurveyRedFlagRule, error)
+	MockUpdateSurveyRedFlagRuleFn                             func(ctx context.Context, rule *gorm.SurveyRedFlagRule, updateData map[string]interface{}) error
+	MockListAllCommunitiesFn                                  func(ctx context.Context) ([]*gorm.Community, error)
+	MockListProgramClientsFn                                  func(ctx context.Context, programID string) ([]*gorm.Client, error)
+	MockGetUserMatrixPasswordFn                               func(ctx context.Context, userID string) (*string, error)
+	MockListUsersWithoutMatrixPasswordFn                      func(ctx context.Context) ([]*gorm.User, error)
+	MockCreateCommunityCrisisKeywordFn                        func(ctx context.Context, keyword *gorm.CommunityCrisisKeyword) error
+	MockListCommunityCrisisKeywordsFn                         func(ctx context.Context, programID string) ([]*gorm.CommunityCrisisKeyword, error)
+	MockGetCommunityByRoomIDFn                                func(ctx context.Context, roomID string) (*gorm.Community, error)
+	MockUpdateCommunityCrisisKeywordFn                        func(ctx context.Context, keyword *gorm.CommunityCrisisKeyword, updateData map[string]interface{}) error
+	MockCheckCrisisMessageFlaggedFn                           func(ctx context.Context, eventID string) (bool, error)
+	MockSetUserMatrixPasswordIfUnsetFn                        func(ctx context.Context, userID string, encryptedPassword string) (bool, error)
+	MockGetSurveyRedFlagRuleByIDFn                            func(ctx context.Context, ruleID string) (*gorm.SurveyRedFlagRule, error)
+	MockClaimSurveyCampaignRoundFn                            func(ctx context.Context, campaign *gorm.SurveyCampaign, updateData map[string]interface{}) (bool, error)
 }
 
 // NewGormMock initializes a new instance of `GormMock` then mocking the case of success.
@@ -442,46 +520,46 @@ func NewGormMock() *GormMock {
 		},
 		MockGetFacilityRespondedScreeningToolsFn: func(ctx context.Context, facilityID, programID string, pagination *domain.Pagination) ([]*gorm.ScreeningTool, *domain.Pagination, error) {
 			return []*gorm.ScreeningTool{
-					{
-						ID:              UUID,
-						Active:          true,
-						QuestionnaireID: UUID,
-						Threshold:       1,
-						ClientTypes:     []string{enums.ClientTypeHighRisk.String()},
-						Genders:         []string{enumutils.GenderMale.String()},
-						MinimumAge:      18,
-						MaximumAge:      25,
-					},
-				}, &domain.Pagination{
-					Limit:       10,
-					CurrentPage: 1,
-				}, nil
+				{
+					ID:              UUID,
+					Active:          true,
+					QuestionnaireID: UUID,
+					Threshold:       1,
+					ClientTypes:     []string{enums.ClientTypeHighRisk.String()},
+					Genders:         []string{enumutils.GenderMale.String()},
+					MinimumAge:      18,
+					MaximumAge:      25,
+				},
+			}, &domain.Pagination{
+				Limit:       10,
+				CurrentPage: 1,
+			}, nil
 		},
 		MockListSurveyRespondentsFn: func(ctx context.Context, params *gorm.UserSurvey, facilityID string, pagination *domain.Pagination) ([]*gorm.UserSurvey, *domain.Pagination, error) {
 			return []*gorm.UserSurvey{
-					{
-						Base: gorm.Base{
-							UpdatedAt: time.Now(),
-						},
-
-						ID:             UUID,
-						Active:         true,
-						Link:           "https://www.google.com",
-						Title:          "Test",
-						Description:    description,
-						HasSubmitted:   true,
-						FormID:         "1",
-						ProjectID:      ID,
-						LinkID:         ID,
-						Token:          "",
-						SubmittedAt:    &time.Time{},
-						UserID:         UUID,
-						OrganisationID: UUID,
+				{
+					Base: gorm.Base{
+						UpdatedAt: time.Now(),
 					},
-				}, &domain.Pagination{
-					Limit:       10,
-					CurrentPage: 1,
-				}, nil
+
+					ID:             UUID,
+					Active:         true,
+					Link:           "https://www.google.com",
+					Title:          "Test",
+					Description:    description,
+					HasSubmitted:   true,
+					FormID:         "1",
+					ProjectID:      ID,
+					LinkID:         ID,
+					Token:          "",
+					SubmittedAt:    &time.Time{},
+					UserID:         UUID,
+					OrganisationID: UUID,
+				},
+			}, &domain.Pagination{
+				Limit:       10,
+				CurrentPage: 1,
+			}, nil
 		},
 		MockRetrieveFacilityFn: func(ctx context.Context, id *string, isActive bool) (*gorm.Facility, error) {
 
@@ -564,7 +642,7 @@ func NewGormMock() *GormMock {
 				},
 			}, nil
 		},
-		MockGetScreeningToolResponsesWithin24HoursFn: func(ctx context.Context, clientID, programID string) ([]*gorm.ScreeningToolResponse, error) {
+		MockGetLatestScreeningToolResponsesFn: func(ctx context.Context, clientID, programID string) ([]*gorm.ScreeningToolResponse, error) {
 			return []*gorm.ScreeningToolResponse{
 				{
 					ID:              UUID,
@@ -701,23 +779,23 @@ func NewGormMock() *GormMock {
 		MockListClientsCaregiversFn: func(ctx context.Context, clientID string, pagination *domain.Pagination) ([]*gorm.CaregiverClient, *domain.Pagination, error) {
 			now := time.Now()
 			return []*gorm.CaregiverClient{
-					{
-						CaregiverID:        uuid.New().String(),
-						ClientID:           UUID,
-						Active:             true,
-						RelationshipType:   enums.CaregiverTypeFather,
-						CaregiverConsent:   enums.ConsentStateAccepted,
-						CaregiverConsentAt: &now,
-						ClientConsent:      enums.ConsentStateAccepted,
-						ClientConsentAt:    &now,
-						OrganisationID:     UUID,
-						AssignedBy:         UUID,
-					},
-				}, &domain.Pagination{
-					Limit:       10,
-					CurrentPage: 2,
-					TotalPages:  20,
-				}, nil
+				{
+					CaregiverID:        uuid.New().String(),
+					ClientID:           UUID,
+					Active:             true,
+					RelationshipType:   enums.CaregiverTypeFather,
+					CaregiverConsent:   enums.ConsentStateAccepted,
+					CaregiverConsentAt: &now,
+					ClientConsent:      enums.ConsentStateAccepted,
+					ClientConsentAt:    &now,
+					OrganisationID:     UUID,
+					AssignedBy:         UUID,
+				},
+			}, &domain.Pagination{
+				Limit:       10,
+				CurrentPage: 2,
+				TotalPages:  20,
+			}, nil
 		},
 		MockUpdateCaregiverClientFn: func(ctx context.Context, caregiverClient *gorm.CaregiverClient, updates map[string]interface{}) error {
 			return nil
@@ -946,7 +1024,7 @@ func NewGormMock() *GormMock {
 				},
 			}, nil
 		},
-		MockGetClientHealthDiaryEntriesFn: func(ctx context.Context, params map[string]interface{}) ([]*gorm.ClientHealthDiaryEntry, error) {
+		MockGetClientHealthDiaryEntriesFn: func(ctx context.Context, params map[string]interface{}, shared *bool) ([]*gorm.ClientHealthDiaryEntry, error) {
 			return []*gorm.ClientHealthDiaryEntry{
 				{
 					Active: true,
@@ -1009,7 +1087,7 @@ func NewGormMock() *GormMock {
 				clientProfile,
 			}, nil
 		},
-		MockGetRecentHealthDiaryEntriesFn: func(ctx context.Context, lastSyncTime time.Time, clientID string) ([]*gorm.ClientHealthDiaryEntry, error) {
+		MockGetRecentHealthDiaryEntriesFn: func(ctx context.Context, lastSyncTime time.Time, clientID string, facilityID string) ([]*gorm.ClientHealthDiaryEntry, error) {
 			return []*gorm.ClientHealthDiaryEntry{
 				{
 					Active: true,
@@ -1431,27 +1509,27 @@ func NewGormMock() *GormMock {
 		},
 		MockGetStaffFacilitiesFn: func(ctx context.Context, staffFacility gorm.StaffFacilities, pagination *domain.Pagination) ([]*gorm.StaffFacilities, *domain.Pagination, error) {
 			return []*gorm.StaffFacilities{
-					{
-						ID:         ID,
-						StaffID:    &UUID,
-						FacilityID: &UUID,
-					},
-				}, &domain.Pagination{
-					Limit:       10,
-					CurrentPage: 2,
-				}, nil
+				{
+					ID:         ID,
+					StaffID:    &UUID,
+					FacilityID: &UUID,
+				},
+			}, &domain.Pagination{
+				Limit:       10,
+				CurrentPage: 2,
+			}, nil
 		},
 		MockGetClientFacilitiesFn: func(ctx context.Context, clientFacility gorm.ClientFacilities, pagination *domain.Pagination) ([]*gorm.ClientFacilities, *domain.Pagination, error) {
 			return []*gorm.ClientFacilities{
-					{
-						ID:         ID,
-						ClientID:   &UUID,
-						FacilityID: &UUID,
-					},
-				}, &domain.Pagination{
-					Limit:       10,
-					CurrentPage: 2,
-				}, nil
+				{
+					ID:         ID,
+					ClientID:   &UUID,
+					FacilityID: &UUID,
+				},
+			}, &domain.Pagination{
+				Limit:       10,
+				CurrentPage: 2,
+			}, nil
 		},
 		MockUpdateStaffFn: func(ctx context.Context, staff *gorm.StaffProfile, updates map[string]interface{}) (*gorm.StaffProfile, error) {
 			return staff, nil
@@ -1636,6 +1714,536 @@ func NewGormMock() *GormMock {
 				ProgramID:         "",
 			}, nil
 		},
+		MockGetUserPermissionsFn: func(ctx context.Context, userID string, programID string) ([]*gorm.AuthorityPermission, error) {
+			return []*gorm.AuthorityPermission{
+				{
+					AuthorityPermissionID: &UUID,
+					Name:                  gofakeit.Name(),
+					Description:           gofakeit.Sentence(5),
+					Category:              "User",
+					Scope:                 "client.read",
+				},
+			}, nil
+		},
+		MockCreateAuditLogFn: func(ctx context.Context, auditLog *gorm.AuditLog) error {
+			return nil
+		},
+		MockListAuditLogsFn: func(ctx context.Context, organisationID string, filter *dto.AuditLogFilterInput, pagination *domain.Pagination) ([]*gorm.AuditLog, *domain.Pagination, error) {
+			return []*gorm.AuditLog{
+				{
+					ID:             &UUID,
+					Active:         true,
+					Timestamp:      currentTime,
+					RecordType:     enums.AuditLogRecordTypeClientFacilityTransfer.String(),
+					Notes:          description,
+					ActorID:        &UUID,
+					TargetID:       UUID,
+					TargetType:     "CLIENT",
+					OldValue:       `{"facilityID": "` + UUID + `"}`,
+					NewValue:       `{"facilityID": "` + UUID + `"}`,
+					OrganisationID: UUID,
+					ProgramID:      &UUID,
+				},
+			}, &domain.Pagination{Limit: 10, CurrentPage: 1}, nil
+		},
+		MockCreateAuthorityRoleFn: func(ctx context.Context, role *gorm.AuthorityRole, permissions []*gorm.AuthorityPermission) error {
+			return nil
+		},
+		MockAssignStaffRoleFn: func(ctx context.Context, staffID string, roleID string) error {
+			return nil
+		},
+		MockGetAuthorityRoleByIDFn: func(ctx context.Context, roleID string) (*gorm.AuthorityRole, error) {
+			return &gorm.AuthorityRole{
+				AuthorityRoleID: &UUID,
+				Name:            name,
+				Description:     description,
+				Active:          true,
+				UserType:        "STAFF",
+				OrganisationID:  UUID,
+				ProgramID:       &UUID,
+			}, nil
+		},
+		MockGetAuthorityRolePermissionsFn: func(ctx context.Context, roleID string) ([]*gorm.AuthorityPermission, error) {
+			return []*gorm.AuthorityPermission{
+				{
+					AuthorityPermissionID: &UUID,
+					Active:                true,
+					Name:                  name,
+					Description:           description,
+					Category:              "User",
+					Scope:                 "client.read",
+				},
+			}, nil
+		},
+		MockListAuthorityRolesFn: func(ctx context.Context, organisationID string, programID string) ([]*gorm.AuthorityRole, error) {
+			return []*gorm.AuthorityRole{
+				{
+					AuthorityRoleID: &UUID,
+					Name:            name,
+					Description:     description,
+					Active:          true,
+					UserType:        "STAFF",
+					OrganisationID:  UUID,
+					ProgramID:       &UUID,
+				},
+			}, nil
+		},
+		MockListAuthorityRoleStaffFn: func(ctx context.Context, roleID string, pagination *domain.Pagination) ([]*gorm.StaffProfile, *domain.Pagination, error) {
+			return []*gorm.StaffProfile{
+				{
+					ID:                &UUID,
+					Active:            true,
+					StaffNumber:       "TEST-00",
+					DefaultFacilityID: UUID,
+					OrganisationID:    UUID,
+					UserID:            UUID,
+					ProgramID:         UUID,
+				},
+			}, pagination, nil
+		},
+		MockCheckStaffHasRoleFn: func(ctx context.Context, staffID string, roleID string) (bool, error) {
+			return true, nil
+		},
+		MockUpdateAuthorityRoleFn: func(ctx context.Context, roleID string, updateData map[string]interface{}, permissions []*gorm.AuthorityPermission) error {
+			return nil
+		},
+		MockDeleteAuthorityRoleFn: func(ctx context.Context, roleID string) error {
+			return nil
+		},
+		MockRevokeStaffRoleFn: func(ctx context.Context, staffID string, roleID string) error {
+			return nil
+		},
+		MockGetRecentOTPsCountFn: func(ctx context.Context, phoneNumber string, since time.Time) (int, error) {
+			return 0, nil
+		},
+		MockCreateSMSDeliveryFn: func(ctx context.Context, delivery *gorm.SMSDelivery) (*gorm.SMSDelivery, error) {
+			return &gorm.SMSDelivery{
+				ID:          UUID,
+				PhoneNumber: delivery.PhoneNumber,
+				Status:      delivery.Status,
+			}, nil
+		},
+		MockUpdateSMSDeliveryFn: func(ctx context.Context, delivery *gorm.SMSDelivery, updateData map[string]interface{}) error {
+			return nil
+		},
+		MockListAppointmentsPendingReminderFn: func(ctx context.Context, appointmentDate time.Time, offsetDays int) ([]*gorm.Appointment, error) {
+			return []*gorm.Appointment{
+				{
+					ID:       UUID,
+					Reason:   "Pharmacy Visit",
+					Date:     appointmentDate,
+					ClientID: UUID,
+				},
+			}, nil
+		},
+		MockCreateAppointmentReminderFn: func(ctx context.Context, reminder *gorm.AppointmentReminder) error {
+			return nil
+		},
+		MockGetNotificationPreferencesFn: func(ctx context.Context, userID string) ([]*gorm.NotificationPreference, error) {
+			return []*gorm.NotificationPreference{
+				{
+					ID:               UUID,
+					UserID:           userID,
+					NotificationType: "APPOINTMENT",
+					PushEnabled:      true,
+					SMSEnabled:       true,
+				},
+			}, nil
+		},
+		MockSaveNotificationPreferenceFn: func(ctx context.Context, preference *gorm.NotificationPreference) error {
+			return nil
+		},
+		MockListMissedAppointmentsFn: func(ctx context.Context, before time.Time) ([]*gorm.Appointment, error) {
+			return []*gorm.Appointment{
+				{
+					ID:         UUID,
+					Reason:     "Pharmacy Visit",
+					Date:       before.AddDate(0, 0, -1),
+					ClientID:   UUID,
+					FacilityID: UUID,
+				},
+			}, nil
+		},
+		MockMarkAppointmentMissedFn: func(ctx context.Context, appointmentID string, serviceRequest *gorm.ClientServiceRequest) error {
+			return nil
+		},
+		MockCreateAppointmentSlotFn: func(ctx context.Context, slot *gorm.AppointmentSlot) error {
+			slot.ID = UUID
+			return nil
+		},
+		MockBookAppointmentSlotFn: func(ctx context.Context, appointment *gorm.Appointment, serviceRequest *gorm.ClientServiceRequest) error {
+			appointment.ID = UUID
+			return nil
+		},
+		MockGetAppointmentSlotFn: func(ctx context.Context, slotID string) (*gorm.AppointmentSlot, error) {
+			return &gorm.AppointmentSlot{
+				ID:         slotID,
+				Active:     true,
+				FacilityID: UUID,
+				DayOfWeek:  int(time.Monday),
+				StartTime:  "08:00",
+				EndTime:    "12:00",
+				Capacity:   10,
+				Reasons:    []string{"Pharmacy Visit"},
+			}, nil
+		},
+		MockListAppointmentSlotsFn: func(ctx context.Context, facilityID string) ([]*gorm.AppointmentSlot, error) {
+			return []*gorm.AppointmentSlot{
+				{
+					ID:         UUID,
+					Active:     true,
+					FacilityID: facilityID,
+					DayOfWeek:  int(time.Monday),
+					StartTime:  "08:00",
+					EndTime:    "12:00",
+					Capacity:   10,
+					Reasons:    []string{"Pharmacy Visit"},
+				},
+			}, nil
+		},
+		MockGetAppointmentSlotBookingCountsFn: func(ctx context.Context, facilityID string, startDate, endDate time.Time) ([]*gorm.AppointmentSlotBookingCount, error) {
+			return []*gorm.AppointmentSlotBookingCount{
+				{
+					SlotID: UUID,
+					Date:   startDate,
+					Count:  1,
+				},
+			}, nil
+		},
+		MockDeactivateAppointmentSlotFn: func(ctx context.Context, slotID string) error {
+			return nil
+		},
+		MockCancelAppointmentBookingFn: func(ctx context.Context, appointmentID string, serviceRequest *gorm.ClientServiceRequest) error {
+			return nil
+		},
+		MockListOverdueServiceRequestsFn: func(ctx context.Context, requestType string, createdBefore time.Time) ([]*gorm.ClientServiceRequest, error) {
+			return []*gorm.ClientServiceRequest{
+				{
+					Base: gorm.Base{
+						CreatedAt: createdBefore.Add(-time.Hour),
+					},
+					ID:          &UUID,
+					Active:      true,
+					RequestType: requestType,
+					Request:     gofakeit.Sentence(5),
+					Status:      enums.ServiceRequestStatusPending.String(),
+					ClientID:    UUID,
+					FacilityID:  UUID,
+				},
+			}, nil
+		},
+		MockMarkServiceRequestEscalatedFn: func(ctx context.Context, serviceRequestID string, escalatedAt time.Time) (bool, error) {
+			return true, nil
+		},
+		MockCreateServiceRequestCommentFn: func(ctx context.Context, comment *gorm.ServiceRequestComment) error {
+			comment.ID = UUID
+			return nil
+		},
+		MockCreateServiceRequestEventFn: func(ctx context.Context, event *gorm.ServiceRequestEvent) error {
+			event.ID = UUID
+			return nil
+		},
+		MockListServiceRequestCommentsFn: func(ctx context.Context, serviceRequestID string) ([]*gorm.ServiceRequestComment, error) {
+			return []*gorm.ServiceRequestComment{
+				{
+					ID:               UUID,
+					Active:           true,
+					ServiceRequestID: serviceRequestID,
+					StaffID:          UUID,
+					Comment:          gofakeit.Sentence(5),
+				},
+			}, nil
+		},
+		MockListServiceRequestEventsFn: func(ctx context.Context, serviceRequestID string) ([]*gorm.ServiceRequestEvent, error) {
+			return []*gorm.ServiceRequestEvent{
+				{
+					ID:               UUID,
+					ServiceRequestID: serviceRequestID,
+					EventType:        enums.ServiceRequestEventTypeAssigned.String(),
+					StaffID:          &UUID,
+					Status:           enums.ServiceRequestStatusPending.String(),
+					AssignedToID:     &UUID,
+				},
+			}, nil
+		},
+		MockCreateScreeningToolScoreBandFn: func(ctx context.Context, input *gorm.ScreeningToolScoreBand) error {
+			return nil
+		},
+		MockGetScreeningToolScoreBandsFn: func(ctx context.Context, screeningToolID string) ([]*gorm.ScreeningToolScoreBand, error) {
+			return []*gorm.ScreeningToolScoreBand{}, nil
+		},
+		MockCreateQuestionConditionFn: func(ctx context.Context, input *gorm.QuestionCondition) error {
+			return nil
+		},
+		MockGetQuestionConditionsByQuestionIDFn: func(ctx context.Context, questionID string) ([]*gorm.QuestionCondition, error) {
+			return []*gorm.QuestionCondition{}, nil
+		},
+		MockCreateScreeningToolVersionFn: func(ctx context.Context, version *gorm.ScreeningToolVersion) error {
+			return nil
+		},
+		MockListScreeningToolVersionsFn: func(ctx context.Context, screeningToolID string) ([]*gorm.ScreeningToolVersion, error) {
+			return []*gorm.ScreeningToolVersion{
+				{
+					ID:              UUID,
+					Active:          true,
+					ScreeningToolID: screeningToolID,
+					QuestionnaireID: UUID,
+					Version:         1,
+					Status:          enums.ScreeningToolVersionStatusPublished.String(),
+				},
+			}, nil
+		},
+		MockUpdateScreeningToolVersionFn: func(ctx context.Context, version *gorm.ScreeningToolVersion, updateData map[string]interface{}) error {
+			return nil
+		},
+		MockPublishScreeningToolVersionFn: func(ctx context.Context, version *gorm.ScreeningToolVersion) error {
+			return nil
+		},
+		MockDeleteQuestionnaireFn: func(ctx context.Context, questionnaireID string) error {
+			return nil
+		},
+		MockCreateScreeningToolAssignmentFn: func(ctx context.Context, assignment *gorm.ScreeningToolAssignment) error {
+			return nil
+		},
+		MockListScreeningToolAssignmentsFn: func(ctx context.Context, params *gorm.ScreeningToolAssignment) ([]*gorm.ScreeningToolAssignment, error) {
+			return []*gorm.ScreeningToolAssignment{
+				{
+					ID:              UUID,
+					Active:          true,
+					ScreeningToolID: UUID,
+					ClientID:        UUID,
+					StaffID:         UUID,
+					DueDate:         time.Now().AddDate(0, 0, 7),
+					Status:          enums.ScreeningToolAssignmentStatusPending.String(),
+				},
+			}, nil
+		},
+		MockListPendingScreeningToolAssignmentsDueBeforeFn: func(ctx context.Context, dueBefore time.Time) ([]*gorm.ScreeningToolAssignment, error) {
+			return []*gorm.ScreeningToolAssignment{
+				{
+					ID:              UUID,
+					Active:          true,
+					ScreeningToolID: UUID,
+					ClientID:        UUID,
+					StaffID:         UUID,
+					DueDate:         dueBefore,
+					Status:          enums.ScreeningToolAssignmentStatusPending.String(),
+				},
+			}, nil
+		},
+		MockUpdateScreeningToolAssignmentFn: func(ctx context.Context, assignment *gorm.ScreeningToolAssignment, updateData map[string]interface{}) error {
+			return nil
+		},
+		MockCompleteScreeningToolAssignmentsFn: func(ctx context.Context, clientID, screeningToolID, responseID string) error {
+			return nil
+		},
+		MockUpdateScreeningToolFn: func(ctx context.Context, screeningTool *gorm.ScreeningTool, updateData map[string]interface{}) error {
+			return nil
+		},
+		MockListClientScreeningToolResponsesFn: func(ctx context.Context, clientID, programID string, screeningToolID *string) ([]*gorm.ScreeningToolResponse, error) {
+			return []*gorm.ScreeningToolResponse{
+				{
+					ID:              UUID,
+					Active:          true,
+					ScreeningToolID: UUID,
+					FacilityID:      UUID,
+					ClientID:        clientID,
+					AggregateScore:  3,
+				},
+			}, nil
+		},
+		MockListClientHealthDiaryEntriesSinceFn: func(ctx context.Context, clientID string, since time.Time) ([]*gorm.ClientHealthDiaryEntry, error) {
+			return []*gorm.ClientHealthDiaryEntry{
+				{
+					ClientHealthDiaryEntryID: &UUID,
+					Active:                   true,
+					Mood:                     enums.MoodHappy.String(),
+					Note:                     "test",
+					EntryType:                enums.ServiceRequestTypeHomePageHealthDiary.String(),
+					ClientID:                 clientID,
+				},
+			}, nil
+		},
+		MockListClientIDsWithHealthDiaryEntriesSinceFn: func(ctx context.Context, since time.Time) ([]string, error) {
+			return []string{UUID}, nil
+		},
+		MockCreateHealthDiaryShareFn: func(ctx context.Context, share *gorm.HealthDiaryShare) error {
+			return nil
+		},
+		MockGetHealthDiaryShareByIDFn: func(ctx context.Context, shareID string) (*gorm.HealthDiaryShare, error) {
+			return &gorm.HealthDiaryShare{
+				ID:         shareID,
+				Active:     true,
+				ClientID:   UUID,
+				FacilityID: &UUID,
+				Scope:      enums.HealthDiaryShareScopeEntireDiary.String(),
+			}, nil
+		},
+		MockListClientHealthDiarySharesFn: func(ctx context.Context, clientID string) ([]*gorm.HealthDiaryShare, error) {
+			return []*gorm.HealthDiaryShare{
+				{
+					ID:         UUID,
+					Active:     true,
+					ClientID:   clientID,
+					FacilityID: &UUID,
+					Scope:      enums.HealthDiaryShareScopeEntireDiary.String(),
+				},
+			}, nil
+		},
+		MockGetCaregiverSharedHealthDiaryEntriesFn: func(ctx context.Context, clientID string, caregiverID string) ([]*gorm.ClientHealthDiaryEntry, error) {
+			return []*gorm.ClientHealthDiaryEntry{
+				{
+					ClientHealthDiaryEntryID: &UUID,
+					Active:                   true,
+					Mood:                     enums.MoodHappy.String(),
+					Note:                     "test",
+					EntryType:                enums.ServiceRequestTypeHomePageHealthDiary.String(),
+					ClientID:                 clientID,
+				},
+			}, nil
+		},
+		MockUpdateHealthDiaryShareFn: func(ctx context.Context, share *gorm.HealthDiaryShare, updateData map[string]interface{}) error {
+			return nil
+		},
+		MockCreateSurveyCampaignFn: func(ctx context.Context, campaign *gorm.SurveyCampaign) error {
+			return nil
+		},
+		MockGetSurveyCampaignByIDFn: func(ctx context.Context, campaignID string) (*gorm.SurveyCampaign, error) {
+			return &gorm.SurveyCampaign{
+				ID:             campaignID,
+				Active:         true,
+				Title:          "Monthly check in",
+				ProjectID:      1,
+				FormID:         UUID,
+				FacilityID:     UUID,
+				Recurrence:     enums.SurveyCampaignRecurrenceMonthly.String(),
+				Status:         enums.SurveyCampaignStatusScheduled.String(),
+				NextSendAt:     &currentTime,
+				LinkExpiryDays: 7,
+			}, nil
+		},
+		MockListSurveyCampaignsFn: func(ctx context.Context, facilityID, programID string) ([]*gorm.SurveyCampaign, error) {
+			return []*gorm.SurveyCampaign{
+				{
+					ID:             UUID,
+					Active:         true,
+					Title:          "Monthly check in",
+					ProjectID:      1,
+					FormID:         UUID,
+					FacilityID:     facilityID,
+					Recurrence:     enums.SurveyCampaignRecurrenceMonthly.String(),
+					Status:         enums.SurveyCampaignStatusScheduled.String(),
+					NextSendAt:     &currentTime,
+					LinkExpiryDays: 7,
+				},
+			}, nil
+		},
+		MockListOngoingSurveyCampaignsFn: func(ctx context.Context) ([]*gorm.SurveyCampaign, error) {
+			return []*gorm.SurveyCampaign{
+				{
+					ID:             UUID,
+					Active:         true,
+					Title:          "Monthly check in",
+					ProjectID:      1,
+					FormID:         UUID,
+					FacilityID:     UUID,
+					Recurrence:     enums.SurveyCampaignRecurrenceMonthly.String(),
+					Status:         enums.SurveyCampaignStatusScheduled.String(),
+					NextSendAt:     &currentTime,
+					LinkExpiryDays: 7,
+				},
+			}, nil
+		},
+		MockUpdateSurveyCampaignFn: func(ctx context.Context, campaign *gorm.SurveyCampaign, updateData map[string]interface{}) error {
+			return nil
+		},
+		MockCreateSurveyRedFlagRuleFn: func(ctx context.Context, rule *gorm.SurveyRedFlagRule) error {
+			return nil
+		},
+		MockListSurveyRedFlagRulesFn: func(ctx context.Context, projectID int, formID, programID string) ([]*gorm.SurveyRedFlagRule, error) {
+			return []*gorm.SurveyRedFlagRule{
+				{
+					ID:         UUID,
+					Active:     true,
+					Name:       "Feels unsafe",
+					ProjectID:  projectID,
+					FormID:     formID,
+					Combinator: enums.SurveyRuleCombinatorAnd.String(),
+					Conditions: `[{"field": "feeling_unsafe", "operator": "EQUALS", "value": "yes"}]`,
+				},
+			}, nil
+		},
+		MockUpdateSurveyRedFlagRuleFn: func(ctx context.Context, rule *gorm.SurveyRedFlagRule, updateData map[string]interface{}) error {
+			return nil
+		},
+		MockListAllCommunitiesFn: func(ctx context.Context) ([]*gorm.Community, error) {
+			return []*gorm.Community{
+				{
+					ID:     uuid.NewString(),
+					RoomID: uuid.NewString(),
+					Active: true,
+				},
+			}, nil
+		},
+		MockListProgramClientsFn: func(ctx context.Context, programID string) ([]*gorm.Client, error) {
+			return []*gorm.Client{clientProfile}, nil
+		},
+		MockGetUserMatrixPasswordFn: func(ctx context.Context, userID string) (*string, error) {
+			password := gofakeit.Password(true, true, true, false, false, 32)
+			return &password, nil
+		},
+		MockListUsersWithoutMatrixPasswordFn: func(ctx context.Context) ([]*gorm.User, error) {
+			return []*gorm.User{
+				{
+					UserID:   &UUID,
+					Username: gofakeit.Username(),
+				},
+			}, nil
+		},
+		MockCreateCommunityCrisisKeywordFn: func(ctx context.Context, keyword *gorm.CommunityCrisisKeyword) error {
+			return nil
+		},
+		MockListCommunityCrisisKeywordsFn: func(ctx context.Context, programID string) ([]*gorm.CommunityCrisisKeyword, error) {
+			return []*gorm.CommunityCrisisKeyword{
+				{
+					ID:        UUID,
+					Active:    true,
+					Keyword:   "end my life",
+					Category:  enums.CommunityCrisisCategorySelfHarm.String(),
+					ProgramID: programID,
+				},
+			}, nil
+		},
+		MockGetCommunityByRoomIDFn: func(ctx context.Context, roomID string) (*gorm.Community, error) {
+			return &gorm.Community{
+				ID:     UUID,
+				RoomID: roomID,
+				Active: true,
+			}, nil
+		},
+		MockUpdateCommunityCrisisKeywordFn: func(ctx context.Context, keyword *gorm.CommunityCrisisKeyword, updateData map[string]interface{}) error {
+			return nil
+		},
+		MockCheckCrisisMessageFlaggedFn: func(ctx context.Context, eventID string) (bool, error) {
+			return false, nil
+		},
+		MockSetUserMatrixPasswordIfUnsetFn: func(ctx context.Context, userID string, encryptedPassword string) (bool, error) {
+			return true, nil
+		},
+		MockGetSurveyRedFlagRuleByIDFn: func(ctx context.Context, ruleID string) (*gorm.SurveyRedFlagRule, error) {
+			return &gorm.SurveyRedFlagRule{
+				ID:         ruleID,
+				Active:     true,
+				Name:       "Feels unsafe",
+				ProjectID:  1,
+				FormID:     UUID,
+				Combinator: enums.SurveyRuleCombinatorAnd.String(),
+				Conditions: `[{"field": "feeling_unsafe", "operator": "EQUALS", "value": "yes"}]`,
+			}, nil
+		},
+		MockClaimSurveyCampaignRoundFn: func(ctx context.Context, campaign *gorm.SurveyCampaign, updateData map[string]interface{}) (bool, error) {
+			return true, nil
+		},
 	}
 }
 
@@ -1870,8 +2478,8 @@ func (gm *GormMock) GetClientHealthDiaryQuote(ctx context.Context, limit int) ([
 }
 
 // GetClientHealthDiaryEntries mocks the implementation of getting all health diary entries that belong to a specific user
-func (gm *GormMock) GetClientHealthDiaryEntries(ctx context.Context, params map[string]interface{}) ([]*gorm.ClientHealthDiaryEntry, error) {
-	return gm.MockGetClientHealthDiaryEntriesFn(ctx, params)
+func (gm *GormMock) GetClientHealthDiaryEntries(ctx context.Context, params map[string]interface{}, shared *bool) ([]*gorm.ClientHealthDiaryEntry, error) {
+	return gm.MockGetClientHealthDiaryEntriesFn(ctx, params, shared)
 }
 
 // UpdateClientCaregiver mocks the implementation of updating a caregiver
@@ -1940,8 +2548,8 @@ func (gm *GormMock) GetClientsInAFacility(ctx context.Context, facilityID string
 }
 
 // GetRecentHealthDiaryEntries mocks getting the most recent health diary entries
-func (gm *GormMock) GetRecentHealthDiaryEntries(ctx context.Context, lastSyncTime time.Time, clientID string) ([]*gorm.ClientHealthDiaryEntry, error) {
-	return gm.MockGetRecentHealthDiaryEntriesFn(ctx, lastSyncTime, clientID)
+func (gm *GormMock) GetRecentHealthDiaryEntries(ctx context.Context, lastSyncTime time.Time, clientID string, facilityID string) ([]*gorm.ClientHealthDiaryEntry, error) {
+	return gm.MockGetRecentHealthDiaryEntriesFn(ctx, lastSyncTime, clientID, facilityID)
 }
 
 // GetClientsByParams retrieves clients using the parameters provided
@@ -2270,9 +2878,9 @@ func (gm *GormMock) GetAvailableScreeningTools(ctx context.Context, clientID str
 	return gm.MockGetAvailableScreeningToolsFn(ctx, clientID, screeningTool, screeningToolIDs)
 }
 
-// GetScreeningToolResponsesWithin24Hours mocks the implementation of GetScreeningToolResponsesWithin24Hours method
-func (gm *GormMock) GetScreeningToolResponsesWithin24Hours(ctx context.Context, clientID, programID string) ([]*gorm.ScreeningToolResponse, error) {
-	return gm.MockGetScreeningToolResponsesWithin24HoursFn(ctx, clientID, programID)
+// GetLatestScreeningToolResponses mocks the implementation of GetLatestScreeningToolResponses method
+func (gm *GormMock) GetLatestScreeningToolResponses(ctx context.Context, clientID, programID string) ([]*gorm.ScreeningToolResponse, error) {
+	return gm.MockGetLatestScreeningToolResponsesFn(ctx, clientID, programID)
 }
 
 // GetScreeningToolResponsesWithPendingServiceRequests mocks the implementation of GetScreeningToolResponsesWithPendingServiceRequests method
@@ -2539,3 +3147,393 @@ func (gm *GormMock) UpdateProgram(ctx context.Context, program *gorm.Program, up
 func (gm *GormMock) GetStaffServiceRequestByID(ctx context.Context, serviceRequestID string) (*gorm.StaffServiceRequest, error) {
 	return gm.MockGetStaffServiceRequestByIDFn(ctx, serviceRequestID)
 }
+
+// GetUserPermissions mocks the implementation of retrieving the permissions granted to a user
+func (gm *GormMock) GetUserPermissions(ctx context.Context, userID string, programID string) ([]*gorm.AuthorityPermission, error) {
+	return gm.MockGetUserPermissionsFn(ctx, userID, programID)
+}
+
+// CreateAuditLog mocks the implementation of saving an audit log
+func (gm *GormMock) CreateAuditLog(ctx context.Context, auditLog *gorm.AuditLog) error {
+	return gm.MockCreateAuditLogFn(ctx, auditLog)
+}
+
+// ListAuditLogs mocks the implementation of listing audit logs
+func (gm *GormMock) ListAuditLogs(ctx context.Context, organisationID string, filter *dto.AuditLogFilterInput, pagination *domain.Pagination) ([]*gorm.AuditLog, *domain.Pagination, error) {
+	return gm.MockListAuditLogsFn(ctx, organisationID, filter, pagination)
+}
+
+// CreateAuthorityRole mocks the implementation of creating a custom role
+func (gm *GormMock) CreateAuthorityRole(ctx context.Context, role *gorm.AuthorityRole, permissions []*gorm.AuthorityPermission) error {
+	return gm.MockCreateAuthorityRoleFn(ctx, role, permissions)
+}
+
+// AssignStaffRole mocks the implementation of assigning a role to a staff
+func (gm *GormMock) AssignStaffRole(ctx context.Context, staffID string, roleID string) error {
+	return gm.MockAssignStaffRoleFn(ctx, staffID, roleID)
+}
+
+// GetAuthorityRoleByID mocks the implementation of retrieving a role using its ID
+func (gm *GormMock) GetAuthorityRoleByID(ctx context.Context, roleID string) (*gorm.AuthorityRole, error) {
+	return gm.MockGetAuthorityRoleByIDFn(ctx, roleID)
+}
+
+// GetAuthorityRolePermissions mocks the implementation of retrieving the permissions granted to a role
+func (gm *GormMock) GetAuthorityRolePermissions(ctx context.Context, roleID string) ([]*gorm.AuthorityPermission, error) {
+	return gm.MockGetAuthorityRolePermissionsFn(ctx, roleID)
+}
+
+// ListAuthorityRoles mocks the implementation of listing the roles in an organisation
+func (gm *GormMock) ListAuthorityRoles(ctx context.Context, organisationID string, programID string) ([]*gorm.AuthorityRole, error) {
+	return gm.MockListAuthorityRolesFn(ctx, organisationID, programID)
+}
+
+// ListAuthorityRoleStaff mocks the implementation of listing the staff that have been assigned a role
+func (gm *GormMock) ListAuthorityRoleStaff(ctx context.Context, roleID string, pagination *domain.Pagination) ([]*gorm.StaffProfile, *domain.Pagination, error) {
+	return gm.MockListAuthorityRoleStaffFn(ctx, roleID, pagination)
+}
+
+// CheckStaffHasRole mocks the implementation of checking whether a staff has been assigned a role
+func (gm *GormMock) CheckStaffHasRole(ctx context.Context, staffID string, roleID string) (bool, error) {
+	return gm.MockCheckStaffHasRoleFn(ctx, staffID, roleID)
+}
+
+// UpdateAuthorityRole mocks the implementation of updating a custom role
+func (gm *GormMock) UpdateAuthorityRole(ctx context.Context, roleID string, updateData map[string]interface{}, permissions []*gorm.AuthorityPermission) error {
+	return gm.MockUpdateAuthorityRoleFn(ctx, roleID, updateData, permissions)
+}
+
+// DeleteAuthorityRole mocks the implementation of deleting a role
+func (gm *GormMock) DeleteAuthorityRole(ctx context.Context, roleID string) error {
+	return gm.MockDeleteAuthorityRoleFn(ctx, roleID)
+}
+
+// RevokeStaffRole mocks the implementation of revoking a staff role
+func (gm *GormMock) RevokeStaffRole(ctx context.Context, staffID string, roleID string) error {
+	return gm.MockRevokeStaffRoleFn(ctx, staffID, roleID)
+}
+
+// GetRecentOTPsCount mocks the implementation of counting the OTPs generated for a phone number since a given time
+func (gm *GormMock) GetRecentOTPsCount(ctx context.Context, phoneNumber string, since time.Time) (int, error) {
+	return gm.MockGetRecentOTPsCountFn(ctx, phoneNumber, since)
+}
+
+// CreateSMSDelivery mocks the implementation of creating an SMS delivery record
+func (gm *GormMock) CreateSMSDelivery(ctx context.Context, delivery *gorm.SMSDelivery) (*gorm.SMSDelivery, error) {
+	return gm.MockCreateSMSDeliveryFn(ctx, delivery)
+}
+
+// UpdateSMSDelivery mocks the implementation of updating an SMS delivery record
+func (gm *GormMock) UpdateSMSDelivery(ctx context.Context, delivery *gorm.SMSDelivery, updateData map[string]interface{}) error {
+	return gm.MockUpdateSMSDeliveryFn(ctx, delivery, updateData)
+}
+
+// ListAppointmentsPendingReminder mocks the implementation of listing appointments that are due for a reminder
+func (gm *GormMock) ListAppointmentsPendingReminder(ctx context.Context, appointmentDate time.Time, offsetDays int) ([]*gorm.Appointment, error) {
+	return gm.MockListAppointmentsPendingReminderFn(ctx, appointmentDate, offsetDays)
+}
+
+// CreateAppointmentReminder mocks the implementation of recording an appointment reminder
+func (gm *GormMock) CreateAppointmentReminder(ctx context.Context, reminder *gorm.AppointmentReminder) error {
+	return gm.MockCreateAppointmentReminderFn(ctx, reminder)
+}
+
+// GetNotificationPreferences mocks the implementation of retrieving the notification preferences of a user
+func (gm *GormMock) GetNotificationPreferences(ctx context.Context, userID string) ([]*gorm.NotificationPreference, error) {
+	return gm.MockGetNotificationPreferencesFn(ctx, userID)
+}
+
+// SaveNotificationPreference mocks the implementation of saving a notification preference
+func (gm *GormMock) SaveNotificationPreference(ctx context.Context, preference *gorm.NotificationPreference) error {
+	return gm.MockSaveNotificationPreferenceFn(ctx, preference)
+}
+
+// ListMissedAppointments mocks the implementation of listing missed appointments
+func (gm *GormMock) ListMissedAppointments(ctx context.Context, before time.Time) ([]*gorm.Appointment, error) {
+	return gm.MockListMissedAppointmentsFn(ctx, before)
+}
+
+// MarkAppointmentMissed mocks the implementation of marking an appointment as missed
+func (gm *GormMock) MarkAppointmentMissed(ctx context.Context, appointmentID string, serviceRequest *gorm.ClientServiceRequest) error {
+	return gm.MockMarkAppointmentMissedFn(ctx, appointmentID, serviceRequest)
+}
+
+// CreateAppointmentSlot mocks the implementation of creating an appointment slot
+func (gm *GormMock) CreateAppointmentSlot(ctx context.Context, slot *gorm.AppointmentSlot) error {
+	return gm.MockCreateAppointmentSlotFn(ctx, slot)
+}
+
+// BookAppointmentSlot mocks the implementation of booking an appointment slot
+func (gm *GormMock) BookAppointmentSlot(ctx context.Context, appointment *gorm.Appointment, serviceRequest *gorm.ClientServiceRequest) error {
+	return gm.MockBookAppointmentSlotFn(ctx, appointment, serviceRequest)
+}
+
+// GetAppointmentSlot mocks the implementation of getting an appointment slot
+func (gm *GormMock) GetAppointmentSlot(ctx context.Context, slotID string) (*gorm.AppointmentSlot, error) {
+	return gm.MockGetAppointmentSlotFn(ctx, slotID)
+}
+
+// ListAppointmentSlots mocks the implementation of listing the appointment slots of a facility
+func (gm *GormMock) ListAppointmentSlots(ctx context.Context, facilityID string) ([]*gorm.AppointmentSlot, error) {
+	return gm.MockListAppointmentSlotsFn(ctx, facilityID)
+}
+
+// GetAppointmentSlotBookingCounts mocks the implementation of counting appointment slot bookings
+func (gm *GormMock) GetAppointmentSlotBookingCounts(ctx context.Context, facilityID string, startDate, endDate time.Time) ([]*gorm.AppointmentSlotBookingCount, error) {
+	return gm.MockGetAppointmentSlotBookingCountsFn(ctx, facilityID, startDate, endDate)
+}
+
+// DeactivateAppointmentSlot mocks the implementation of deactivating an appointment slot
+func (gm *GormMock) DeactivateAppointmentSlot(ctx context.Context, slotID string) error {
+	return gm.MockDeactivateAppointmentSlotFn(ctx, slotID)
+}
+
+// CancelAppointmentBooking mocks the implementation of cancelling a booked appointment
+func (gm *GormMock) CancelAppointmentBooking(ctx context.Context, appointmentID string, serviceRequest *gorm.ClientServiceRequest) error {
+	return gm.MockCancelAppointmentBookingFn(ctx, appointmentID, serviceRequest)
+}
+
+// ListOverdueServiceRequests mocks the implementation of listing overdue service requests
+func (gm *GormMock) ListOverdueServiceRequests(ctx context.Context, requestType string, createdBefore time.Time) ([]*gorm.ClientServiceRequest, error) {
+	return gm.MockListOverdueServiceRequestsFn(ctx, requestType, createdBefore)
+}
+
+// MarkServiceRequestEscalated mocks the implementation of marking a service request as escalated
+func (gm *GormMock) MarkServiceRequestEscalated(ctx context.Context, serviceRequestID string, escalatedAt time.Time) (bool, error) {
+	return gm.MockMarkServiceRequestEscalatedFn(ctx, serviceRequestID, escalatedAt)
+}
+
+// CreateServiceRequestComment mocks the implementation of creating a service request comment
+func (gm *GormMock) CreateServiceRequestComment(ctx context.Context, comment *gorm.ServiceRequestComment) error {
+	return gm.MockCreateServiceRequestCommentFn(ctx, comment)
+}
+
+// CreateServiceRequestEvent mocks the implementation of creating a service request event
+func (gm *GormMock) CreateServiceRequestEvent(ctx context.Context, event *gorm.ServiceRequestEvent) error {
+	return gm.MockCreateServiceRequestEventFn(ctx, event)
+}
+
+// ListServiceRequestComments mocks the implementation of listing service request comments
+func (gm *GormMock) ListServiceRequestComments(ctx context.Context, serviceRequestID string) ([]*gorm.ServiceRequestComment, error) {
+	return gm.MockListServiceRequestCommentsFn(ctx, serviceRequestID)
+}
+
+// ListServiceRequestEvents mocks the implementation of listing service request events
+func (gm *GormMock) ListServiceRequestEvents(ctx context.Context, serviceRequestID string) ([]*gorm.ServiceRequestEvent, error) {
+	return gm.MockListServiceRequestEventsFn(ctx, serviceRequestID)
+}
+
+// CreateScreeningToolScoreBand mocks the implementation of creating a screening tool score band
+func (gm *GormMock) CreateScreeningToolScoreBand(ctx context.Context, input *gorm.ScreeningToolScoreBand) error {
+	return gm.MockCreateScreeningToolScoreBandFn(ctx, input)
+}
+
+// GetScreeningToolScoreBands mocks the implementation of getting the score bands of a screening tool
+func (gm *GormMock) GetScreeningToolScoreBands(ctx context.Context, screeningToolID string) ([]*gorm.ScreeningToolScoreBand, error) {
+	return gm.MockGetScreeningToolScoreBandsFn(ctx, screeningToolID)
+}
+
+// CreateQuestionCondition mocks the implementation of creating a question condition
+func (gm *GormMock) CreateQuestionCondition(ctx context.Context, input *gorm.QuestionCondition) error {
+	return gm.MockCreateQuestionConditionFn(ctx, input)
+}
+
+// GetQuestionConditionsByQuestionID mocks the implementation of getting the display conditions of a question
+func (gm *GormMock) GetQuestionConditionsByQuestionID(ctx context.Context, questionID string) ([]*gorm.QuestionCondition, error) {
+	return gm.MockGetQuestionConditionsByQuestionIDFn(ctx, questionID)
+}
+
+// CreateScreeningToolVersion mocks the implementation of recording a screening tool version
+func (gm *GormMock) CreateScreeningToolVersion(ctx context.Context, version *gorm.ScreeningToolVersion) error {
+	return gm.MockCreateScreeningToolVersionFn(ctx, version)
+}
+
+// ListScreeningToolVersions mocks the implementation of listing the versions of a screening tool
+func (gm *GormMock) ListScreeningToolVersions(ctx context.Context, screeningToolID string) ([]*gorm.ScreeningToolVersion, error) {
+	return gm.MockListScreeningToolVersionsFn(ctx, screeningToolID)
+}
+
+// UpdateScreeningToolVersion mocks the implementation of updating a screening tool version
+func (gm *GormMock) UpdateScreeningToolVersion(ctx context.Context, version *gorm.ScreeningToolVersion, updateData map[string]interface{}) error {
+	return gm.MockUpdateScreeningToolVersionFn(ctx, version, updateData)
+}
+
+// PublishScreeningToolVersion mocks the implementation of publishing a screening tool version
+func (gm *GormMock) PublishScreeningToolVersion(ctx context.Context, version *gorm.ScreeningToolVersion) error {
+	return gm.MockPublishScreeningToolVersionFn(ctx, version)
+}
+
+// DeleteQuestionnaire mocks the implementation of deleting a questionnaire
+func (gm *GormMock) DeleteQuestionnaire(ctx context.Context, questionnaireID string) error {
+	return gm.MockDeleteQuestionnaireFn(ctx, questionnaireID)
+}
+
+// CreateScreeningToolAssignment mocks the implementation of recording a screening tool assignment
+func (gm *GormMock) CreateScreeningToolAssignment(ctx context.Context, assignment *gorm.ScreeningToolAssignment) error {
+	return gm.MockCreateScreeningToolAssignmentFn(ctx, assignment)
+}
+
+// ListScreeningToolAssignments mocks the implementation of listing screening tool assignments
+func (gm *GormMock) ListScreeningToolAssignments(ctx context.Context, params *gorm.ScreeningToolAssignment) ([]*gorm.ScreeningToolAssignment, error) {
+	return gm.MockListScreeningToolAssignmentsFn(ctx, params)
+}
+
+// ListPendingScreeningToolAssignmentsDueBefore mocks the implementation of listing pending screening tool assignments that are due
+func (gm *GormMock) ListPendingScreeningToolAssignmentsDueBefore(ctx context.Context, dueBefore time.Time) ([]*gorm.ScreeningToolAssignment, error) {
+	return gm.MockListPendingScreeningToolAssignmentsDueBeforeFn(ctx, dueBefore)
+}
+
+// UpdateScreeningToolAssignment mocks the implementation of updating a screening tool assignment
+func (gm *GormMock) UpdateScreeningToolAssignment(ctx context.Context, assignment *gorm.ScreeningToolAssignment, updateData map[string]interface{}) error {
+	return gm.MockUpdateScreeningToolAssignmentFn(ctx, assignment, updateData)
+}
+
+// CompleteScreeningToolAssignments mocks the implementation of completing the pending assignments of a screening tool
+func (gm *GormMock) CompleteScreeningToolAssignments(ctx context.Context, clientID, screeningToolID, responseID string) error {
+	return gm.MockCompleteScreeningToolAssignmentsFn(ctx, clientID, screeningToolID, responseID)
+}
+
+// UpdateScreeningTool mocks the implementation of updating a screening tool
+func (gm *GormMock) UpdateScreeningTool(ctx context.Context, screeningTool *gorm.ScreeningTool, updateData map[string]interface{}) error {
+	return gm.MockUpdateScreeningToolFn(ctx, screeningTool, updateData)
+}
+
+// ListClientScreeningToolResponses mocks the implementation of listing a client's screening tool responses
+func (gm *GormMock) ListClientScreeningToolResponses(ctx context.Context, clientID, programID string, screeningToolID *string) ([]*gorm.ScreeningToolResponse, error) {
+	return gm.MockListClientScreeningToolResponsesFn(ctx, clientID, programID, screeningToolID)
+}
+
+// ListClientHealthDiaryEntriesSince mocks the implementation of listing the health diary entries a client recorded after a time
+func (gm *GormMock) ListClientHealthDiaryEntriesSince(ctx context.Context, clientID string, since time.Time) ([]*gorm.ClientHealthDiaryEntry, error) {
+	return gm.MockListClientHealthDiaryEntriesSinceFn(ctx, clientID, since)
+}
+
+// ListClientIDsWithHealthDiaryEntriesSince mocks the implementation of listing the clients who recorded health diary entries after a time
+func (gm *GormMock) ListClientIDsWithHealthDiaryEntriesSince(ctx context.Context, since time.Time) ([]string, error) {
+	return gm.MockListClientIDsWithHealthDiaryEntriesSinceFn(ctx, since)
+}
+
+// CreateHealthDiaryShare mocks the implementation of recording a health diary share
+func (gm *GormMock) CreateHealthDiaryShare(ctx context.Context, share *gorm.HealthDiaryShare) error {
+	return gm.MockCreateHealthDiaryShareFn(ctx, share)
+}
+
+// GetHealthDiaryShareByID mocks the implementation of getting a health diary share by ID
+func (gm *GormMock) GetHealthDiaryShareByID(ctx context.Context, shareID string) (*gorm.HealthDiaryShare, error) {
+	return gm.MockGetHealthDiaryShareByIDFn(ctx, shareID)
+}
+
+// ListClientHealthDiaryShares mocks the implementation of listing the grants to a client's health diary
+func (gm *GormMock) ListClientHealthDiaryShares(ctx context.Context, clientID string) ([]*gorm.HealthDiaryShare, error) {
+	return gm.MockListClientHealthDiarySharesFn(ctx, clientID)
+}
+
+// GetCaregiverSharedHealthDiaryEntries mocks the implementation of getting the health diary entries a client shares with a caregiver
+func (gm *GormMock) GetCaregiverSharedHealthDiaryEntries(ctx context.Context, clientID string, caregiverID string) ([]*gorm.ClientHealthDiaryEntry, error) {
+	return gm.MockGetCaregiverSharedHealthDiaryEntriesFn(ctx, clientID, caregiverID)
+}
+
+// UpdateHealthDiaryShare mocks the implementation of updating a health diary share
+func (gm *GormMock) UpdateHealthDiaryShare(ctx context.Context, share *gorm.HealthDiaryShare, updateData map[string]interface{}) error {
+	return gm.MockUpdateHealthDiaryShareFn(ctx, share, updateData)
+}
+
+// CreateSurveyCampaign mocks the implementation of saving a survey campaign
+func (gm *GormMock) CreateSurveyCampaign(ctx context.Context, campaign *gorm.SurveyCampaign) error {
+	return gm.MockCreateSurveyCampaignFn(ctx, campaign)
+}
+
+// GetSurveyCampaignByID mocks the implementation of getting a survey campaign by ID
+func (gm *GormMock) GetSurveyCampaignByID(ctx context.Context, campaignID string) (*gorm.SurveyCampaign, error) {
+	return gm.MockGetSurveyCampaignByIDFn(ctx, campaignID)
+}
+
+// ListSurveyCampaigns mocks the implementation of listing the survey campaigns of a facility
+func (gm *GormMock) ListSurveyCampaigns(ctx context.Context, facilityID, programID string) ([]*gorm.SurveyCampaign, error) {
+	return gm.MockListSurveyCampaignsFn(ctx, facilityID, programID)
+}
+
+// ListOngoingSurveyCampaigns mocks the implementation of listing the scheduled and active survey campaigns
+func (gm *GormMock) ListOngoingSurveyCampaigns(ctx context.Context) ([]*gorm.SurveyCampaign, error) {
+	return gm.MockListOngoingSurveyCampaignsFn(ctx)
+}
+
+// UpdateSurveyCampaign mocks the implementation of updating a survey campaign
+func (gm *GormMock) UpdateSurveyCampaign(ctx context.Context, campaign *gorm.SurveyCampaign, updateData map[string]interface{}) error {
+	return gm.MockUpdateSurveyCampaignFn(ctx, campaign, updateData)
+}
+
+// CreateSurveyRedFlagRule mocks the implementation of saving a survey red flag rule
+func (gm *GormMock) CreateSurveyRedFlagRule(ctx context.Context, rule *gorm.SurveyRedFlagRule) error {
+	return gm.MockCreateSurveyRedFlagRuleFn(ctx, rule)
+}
+
+// ListSurveyRedFlagRules mocks the implementation of listing the red flag rules of a survey form
+func (gm *GormMock) ListSurveyRedFlagRules(ctx context.Context, projectID int, formID, programID string) ([]*gorm.SurveyRedFlagRule, error) {
+	return gm.MockListSurveyRedFlagRulesFn(ctx, projectID, formID, programID)
+}
+
+// UpdateSurveyRedFlagRule mocks the implementation of updating a survey red flag rule
+func (gm *GormMock) UpdateSurveyRedFlagRule(ctx context.Context, rule *gorm.SurveyRedFlagRule, updateData map[string]interface{}) error {
+	return gm.MockUpdateSurveyRedFlagRuleFn(ctx, rule, updateData)
+}
+
+// ListAllCommunities mocks the implementation of listing all the active communities
+func (gm *GormMock) ListAllCommunities(ctx context.Context) ([]*gorm.Community, error) {
+	return gm.MockListAllCommunitiesFn(ctx)
+}
+
+// ListProgramClients mocks the implementation of listing the clients of a program
+func (gm *GormMock) ListProgramClients(ctx context.Context, programID string) ([]*gorm.Client, error) {
+	return gm.MockListProgramClientsFn(ctx, programID)
+}
+
+// GetUserMatrixPassword mocks the implementation of getting the encrypted Matrix password of a user
+func (gm *GormMock) GetUserMatrixPassword(ctx context.Context, userID string) (*string, error) {
+	return gm.MockGetUserMatrixPasswordFn(ctx, userID)
+}
+
+// ListUsersWithoutMatrixPassword mocks the implementation of listing the users whose Matrix password is not managed yet
+func (gm *GormMock) ListUsersWithoutMatrixPassword(ctx context.Context) ([]*gorm.User, error) {
+	return gm.MockListUsersWithoutMatrixPasswordFn(ctx)
+}
+
+// CreateCommunityCrisisKeyword mocks the implementation of saving a community crisis keyword
+func (gm *GormMock) CreateCommunityCrisisKeyword(ctx context.Context, keyword *gorm.CommunityCrisisKeyword) error {
+	return gm.MockCreateCommunityCrisisKeywordFn(ctx, keyword)
+}
+
+// ListCommunityCrisisKeywords mocks the implementation of listing the crisis keywords of a program
+func (gm *GormMock) ListCommunityCrisisKeywords(ctx context.Context, programID string) ([]*gorm.CommunityCrisisKeyword, error) {
+	return gm.MockListCommunityCrisisKeywordsFn(ctx, programID)
+}
+
+// GetCommunityByRoomID mocks the implementation of fetching a community by its Matrix room ID
+func (gm *GormMock) GetCommunityByRoomID(ctx context.Context, roomID string) (*gorm.Community, error) {
+	return gm.MockGetCommunityByRoomIDFn(ctx, roomID)
+}
+
+// UpdateCommunityCrisisKeyword mocks the implementation of updating a community crisis keyword
+func (gm *GormMock) UpdateCommunityCrisisKeyword(ctx context.Context, keyword *gorm.CommunityCrisisKeyword, updateData map[string]interface{}) error {
+	return gm.MockUpdateCommunityCrisisKeywordFn(ctx, keyword, updateData)
+}
+
+// CheckCrisisMessageFlagged mocks the implementation of checking whether a community message has already been flagged
+func (gm *GormMock) CheckCrisisMessageFlagged(ctx context.Context, eventID string) (bool, error) {
+	return gm.MockCheckCrisisMessageFlaggedFn(ctx, eventID)
+}
+
+// SetUserMatrixPasswordIfUnset mocks the implementation of saving the Matrix password of a user who does not have one yet
+func (gm *GormMock) SetUserMatrixPasswordIfUnset(ctx context.Context, userID string, encryptedPassword string) (bool, error) {
+	return gm.MockSetUserMatrixPasswordIfUnsetFn(ctx, userID, encryptedPassword)
+}
+
+// GetSurveyRedFlagRuleByID mocks the implementation of getting a survey red flag rule by its ID
+func (gm *GormMock) GetSurveyRedFlagRuleByID(ctx context.Context, ruleID string) (*gorm.SurveyRedFlagRule, error) {
+	return gm.MockGetSurveyRedFlagRuleByIDFn(ctx, ruleID)
+}
+
+// ClaimSurveyCampaignRound mocks the implementation of claiming the next round of a survey campaign
+func (gm *GormMock) ClaimSurveyCampaignRound(ctx context.Context, campaign *gorm.SurveyCampaign, updateData map[string]interface{}) (bool, error) {
+	return gm.MockClaimSurveyCampaignRoundFn(ctx, campaign, updateData)
+}
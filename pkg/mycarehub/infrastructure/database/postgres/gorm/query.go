@@ -19,6 +19,7 @@ import (
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/exceptions"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/utils"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"github.com/savannahghi/serverutils"
 )
@@ -65,7 +66,7 @@ type Query interface {
 	FindContacts(ctx context.Context, contactType string, contactValue string) ([]*Contact, error)
 	CanRecordHeathDiary(ctx context.Context, clientID string) (bool, error)
 	GetClientHealthDiaryQuote(ctx context.Context, limit int) ([]*ClientHealthDiaryQuote, error)
-	GetClientHealthDiaryEntries(ctx context.Context, params map[string]interface{}) ([]*ClientHealthDiaryEntry, error)
+	GetClientHealthDiaryEntries(ctx context.Context, params map[string]interface{}, shared *bool) ([]*ClientHealthDiaryEntry, error)
 	GetClientProfileByClientID(ctx context.Context, clientID string) (*Client, error)
 	GetServiceRequests(ctx context.Context, requestType, requestStatus *string, facilityID string) ([]*ClientServiceRequest, error)
 	GetStaffServiceRequests(ctx context.Context, requestType, requestStatus *string, facilityID string) ([]*StaffServiceRequest, error)
@@ -74,7 +75,7 @@ type Query interface {
 	CheckIdentifierExists(ctx context.Context, identifierType string, identifierValue string) (bool, error)
 	CheckFacilityExistsByIdentifier(ctx context.Context, identifier *FacilityIdentifier) (bool, error)
 	GetClientsInAFacility(ctx context.Context, facilityID string) ([]*Client, error)
-	GetRecentHealthDiaryEntries(ctx context.Context, lastSyncTime time.Time, clientID string) ([]*ClientHealthDiaryEntry, error)
+	GetRecentHealthDiaryEntries(ctx context.Context, lastSyncTime time.Time, clientID string, facilityID string) ([]*ClientHealthDiaryEntry, error)
 	GetClientsByParams(ctx context.Context, query Client, lastSyncTime *time.Time) ([]*Client, error)
 	GetClientIdentifiers(ctx context.Context, clientID string) ([]*Identifier, error)
 	SearchClientProfile(ctx context.Context, searchParameter string) ([]*Client, error)
@@ -103,7 +104,7 @@ type Query interface {
 	GetQuestionsByQuestionnaireID(ctx context.Context, questionnaireID string) ([]*Question, error)
 	GetQuestionInputChoicesByQuestionID(ctx context.Context, questionID string) ([]*QuestionInputChoice, error)
 	GetAvailableScreeningTools(ctx context.Context, clientID string, screeningTool ScreeningTool, screeningToolIDs []string) ([]*ScreeningTool, error)
-	GetScreeningToolResponsesWithin24Hours(ctx context.Context, clientID, programID string) ([]*ScreeningToolResponse, error)
+	GetLatestScreeningToolResponses(ctx context.Context, clientID, programID string) ([]*ScreeningToolResponse, error)
 	GetScreeningToolResponsesWithPendingServiceRequests(ctx context.Context, clientID, programID string) ([]*ScreeningToolResponse, error)
 	GetFacilityRespondedScreeningTools(ctx context.Context, facilityID, programID string, pagination *domain.Pagination) ([]*ScreeningTool, *domain.Pagination, error)
 	GetScreeningToolServiceRequestOfRespondents(ctx context.Context, facilityID, programID string, screeningToolID string, searchTerm string, pagination *domain.Pagination) ([]*ClientServiceRequest, *domain.Pagination, error)
@@ -135,6 +136,46 @@ type Query interface {
 	ListCommunities(ctx context.Context, programID string, organisationID string) ([]*Community, error)
 	CheckPhoneExists(ctx context.Context, phone string) (bool, error)
 	GetStaffServiceRequestByID(ctx context.Context, serviceRequestID string) (*StaffServiceRequest, error)
+	GetUserPermissions(ctx context.Context, userID string, programID string) ([]*AuthorityPermission, error)
+	ListAuditLogs(ctx context.Context, organisationID string, filter *dto.AuditLogFilterInput, pagination *domain.Pagination) ([]*AuditLog, *domain.Pagination, error)
+	GetAuthorityRoleByID(ctx context.Context, roleID string) (*AuthorityRole, error)
+	GetAuthorityRolePermissions(ctx context.Context, roleID string) ([]*AuthorityPermission, error)
+	ListAuthorityRoles(ctx context.Context, organisationID string, programID string) ([]*AuthorityRole, error)
+	ListAuthorityRoleStaff(ctx context.Context, roleID string, pagination *domain.Pagination) ([]*StaffProfile, *domain.Pagination, error)
+	CheckStaffHasRole(ctx context.Context, staffID string, roleID string) (bool, error)
+	GetRecentOTPsCount(ctx context.Context, phoneNumber string, since time.Time) (int, error)
+	ListAppointmentsPendingReminder(ctx context.Context, appointmentDate time.Time, offsetDays int) ([]*Appointment, error)
+	GetNotificationPreferences(ctx context.Context, userID string) ([]*NotificationPreference, error)
+	ListMissedAppointments(ctx context.Context, before time.Time) ([]*Appointment, error)
+	GetAppointmentSlot(ctx context.Context, slotID string) (*AppointmentSlot, error)
+	ListAppointmentSlots(ctx context.Context, facilityID string) ([]*AppointmentSlot, error)
+	GetAppointmentSlotBookingCounts(ctx context.Context, facilityID string, startDate, endDate time.Time) ([]*AppointmentSlotBookingCount, error)
+	ListOverdueServiceRequests(ctx context.Context, requestType string, createdBefore time.Time) ([]*ClientServiceRequest, error)
+	ListServiceRequestComments(ctx context.Context, serviceRequestID string) ([]*ServiceRequestComment, error)
+	ListServiceRequestEvents(ctx context.Context, serviceRequestID string) ([]*ServiceRequestEvent, error)
+	GetScreeningToolScoreBands(ctx context.Context, screeningToolID string) ([]*ScreeningToolScoreBand, error)
+	GetQuestionConditionsByQuestionID(ctx context.Context, questionID string) ([]*QuestionCondition, error)
+	ListScreeningToolVersions(ctx context.Context, screeningToolID string) ([]*ScreeningToolVersion, error)
+	ListScreeningToolAssignments(ctx context.Context, params *ScreeningToolAssignment) ([]*ScreeningToolAssignment, error)
+	ListPendingScreeningToolAssignmentsDueBefore(ctx context.Context, dueBefore time.Time) ([]*ScreeningToolAssignment, error)
+	ListClientScreeningToolResponses(ctx context.Context, clientID, programID string, screeningToolID *string) ([]*ScreeningToolResponse, error)
+	ListClientHealthDiaryEntriesSince(ctx context.Context, clientID string, since time.Time) ([]*ClientHealthDiaryEntry, error)
+	ListClientIDsWithHealthDiaryEntriesSince(ctx context.Context, since time.Time) ([]string, error)
+	GetHealthDiaryShareByID(ctx context.Context, shareID string) (*HealthDiaryShare, error)
+	ListClientHealthDiaryShares(ctx context.Context, clientID string) ([]*HealthDiaryShare, error)
+	GetCaregiverSharedHealthDiaryEntries(ctx context.Context, clientID string, caregiverID string) ([]*ClientHealthDiaryEntry, error)
+	GetSurveyCampaignByID(ctx context.Context, campaignID string) (*SurveyCampaign, error)
+	ListSurveyCampaigns(ctx context.Context, facilityID, programID string) ([]*SurveyCampaign, error)
+	ListOngoingSurveyCampaigns(ctx context.Context) ([]*SurveyCampaign, error)
+	ListSurveyRedFlagRules(ctx context.Context, projectID int, formID, programID string) ([]*SurveyRedFlagRule, error)
+	ListAllCommunities(ctx context.Context) ([]*Community, error)
+	ListProgramClients(ctx context.Context, programID string) ([]*Client, error)
+	GetUserMatrixPassword(ctx context.Context, userID string) (*string, error)
+	ListUsersWithoutMatrixPassword(ctx context.Context) ([]*User, error)
+	ListCommunityCrisisKeywords(ctx context.Context, programID string) ([]*CommunityCrisisKeyword, error)
+	GetCommunityByRoomID(ctx context.Context, roomID string) (*Community, error)
+	CheckCrisisMessageFlagged(ctx context.Context, eventID string) (bool, error)
+	GetSurveyRedFlagRuleByID(ctx context.Context, ruleID string) (*SurveyRedFlagRule, error)
 }
 
 // GetFacilityStaffs returns a list of staff at a particular facility
@@ -582,7 +623,8 @@ func (db *PGInstance) GetSecurityQuestionResponse(ctx context.Context, questionI
 	return &questionResponse, nil
 }
 
-// VerifyOTP checks from the database for the validity of the provided OTP
+// VerifyOTP checks the provided OTP against the latest valid OTP issued to the phone number.
+// Every failed attempt is recorded and the OTP is invalidated once the maximum number of attempts is reached
 func (db *PGInstance) VerifyOTP(ctx context.Context, payload *dto.VerifyOTPInput) (bool, error) {
 	var userOTP UserOTP
 	if payload.PhoneNumber == "" || payload.OTP == "" {
@@ -592,15 +634,63 @@ func (db *PGInstance) VerifyOTP(ctx context.Context, payload *dto.VerifyOTPInput
 		return false, exceptions.InvalidFlavourDefinedErr(fmt.Errorf("flavour is not valid"))
 	}
 
-	err := db.DB.Model(&UserOTP{}).Where(&UserOTP{PhoneNumber: payload.PhoneNumber, Valid: true, OTP: payload.OTP, Flavour: payload.Flavour}).First(&userOTP).Error
+	tx := db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+	if err := tx.Error; err != nil {
+		return false, fmt.Errorf("failed to initialize database transaction %w", err)
+	}
+
+	err := tx.Model(&UserOTP{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(&UserOTP{PhoneNumber: payload.PhoneNumber, Valid: true, Flavour: payload.Flavour}).
+		Order("generated_at DESC").First(&userOTP).Error
 	if err != nil {
+		tx.Rollback()
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return false, nil
 		}
-		return false, fmt.Errorf("failed to verify otp with %v: %v", payload.OTP, payload.Flavour)
+		return false, fmt.Errorf("failed to verify otp with %v: %v", payload.Flavour, err)
 	}
 
-	return true, nil
+	if time.Now().After(userOTP.ValidUntil) {
+		if err := tx.Model(&UserOTP{}).Where("id = ?", userOTP.OTPID).Updates(map[string]interface{}{"is_valid": false}).Error; err != nil {
+			tx.Rollback()
+			return false, fmt.Errorf("failed to invalidate expired otp: %w", err)
+		}
+		if err := tx.Commit().Error; err != nil {
+			tx.Rollback()
+			return false, fmt.Errorf("failed to commit transaction: %w", err)
+		}
+		return false, nil
+	}
+
+	if utils.ComparePIN(payload.OTP, userOTP.Salt, userOTP.OTP, nil) {
+		if err := tx.Commit().Error; err != nil {
+			tx.Rollback()
+			return false, fmt.Errorf("failed to commit transaction: %w", err)
+		}
+		return true, nil
+	}
+
+	failedAttempts := userOTP.FailedAttempts + 1
+	err = tx.Model(&UserOTP{}).Where("id = ?", userOTP.OTPID).Updates(map[string]interface{}{
+		"failed_attempts": failedAttempts,
+		"is_valid":        failedAttempts < helpers.GetOTPMaxVerifyAttempts(),
+	}).Error
+	if err != nil {
+		tx.Rollback()
+		return false, fmt.Errorf("failed to record failed otp attempt: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return false, nil
 }
 
 // GetClientProfile returns the client profile based on the user ID provided
@@ -764,11 +854,21 @@ func (db *PGInstance) GetClientHealthDiaryQuote(ctx context.Context, limit int)
 	return healthDiaryQuote, nil
 }
 
-// GetClientHealthDiaryEntries gets all health diary entries that belong to a specific client
-func (db *PGInstance) GetClientHealthDiaryEntries(ctx context.Context, params map[string]interface{}) ([]*ClientHealthDiaryEntry, error) {
+// GetClientHealthDiaryEntries gets all health diary entries that belong to a specific client.
+// When shared is provided, the entries are filtered on whether they are covered by an active grant to a facility
+func (db *PGInstance) GetClientHealthDiaryEntries(ctx context.Context, params map[string]interface{}, shared *bool) ([]*ClientHealthDiaryEntry, error) {
 	var healthDiaryEntry []*ClientHealthDiaryEntry
 
-	err := db.DB.Where(params).Order(clause.OrderByColumn{Column: clause.Column{Name: "created"}, Desc: true}).Find(&healthDiaryEntry).Error
+	tx := db.DB.Where(params)
+	if shared != nil {
+		condition := healthDiaryShareCondition("facility_id IS NOT NULL")
+		if !*shared {
+			condition = "NOT " + condition
+		}
+		tx = tx.Where(condition, time.Now())
+	}
+
+	err := tx.Order(clause.OrderByColumn{Column: clause.Column{Name: "created"}, Desc: true}).Find(&healthDiaryEntry).Error
 	if err != nil {
 		return nil, fmt.Errorf("failed to get all client health diary entries: %v", err)
 	}
@@ -827,57 +927,35 @@ func (db *PGInstance) GetClientsPendingServiceRequestsCount(ctx context.Context,
 		return nil, fmt.Errorf("failed to get client's service requests:: %v", err)
 	}
 
+	clientRequestTypes := []enums.ServiceRequestType{
+		enums.ServiceRequestTypeRedFlag,
+		enums.ServiceRequestTypePinReset,
+		enums.ServiceRequestTypeScreeningToolsRedFlag,
+		enums.ServiceRequestTypeSurveyRedFlag,
+		enums.ServiceRequestTypeHomePageHealthDiary,
+		enums.ServiceRequestTypeStaffPinReset,
+		enums.ServiceRequestTypeAppointments,
+		enums.ServiceRequestTypeMissedAppointment,
+		enums.ServiceRequestTypeMoodDeterioration,
+	}
+
 	serviceRequestsCount := domain.ServiceRequestsCount{
-		Total: len(serviceRequests),
-		RequestsTypeCount: []*domain.RequestTypeCount{
-			{
-				RequestType: enums.ServiceRequestTypeRedFlag,
-				Total:       0,
-			},
-			{
-				RequestType: enums.ServiceRequestTypePinReset,
-				Total:       0,
-			},
-			{
-				RequestType: enums.ServiceRequestTypeScreeningToolsRedFlag,
-				Total:       0,
-			},
-			{
-				RequestType: enums.ServiceRequestTypeSurveyRedFlag,
-			},
-			{
-				RequestType: enums.ServiceRequestTypeHomePageHealthDiary,
-			},
-			{
-				RequestType: enums.ServiceRequestTypeStaffPinReset,
-			},
-			{
-				RequestType: enums.ServiceRequestTypeAppointments,
-			},
-		},
+		Total:             len(serviceRequests),
+		RequestsTypeCount: []*domain.RequestTypeCount{},
+	}
+	countsByType := map[string]*domain.RequestTypeCount{}
+	for _, requestType := range clientRequestTypes {
+		count := &domain.RequestTypeCount{
+			RequestType: requestType,
+			Total:       0,
+		}
+		serviceRequestsCount.RequestsTypeCount = append(serviceRequestsCount.RequestsTypeCount, count)
+		countsByType[requestType.String()] = count
 	}
 
 	for _, request := range serviceRequests {
-		if request.RequestType == enums.ServiceRequestTypeRedFlag.String() {
-			serviceRequestsCount.RequestsTypeCount[0].Total++
-		}
-		if request.RequestType == enums.ServiceRequestTypePinReset.String() {
-			serviceRequestsCount.RequestsTypeCount[1].Total++
-		}
-		if request.RequestType == enums.ServiceRequestTypeScreeningToolsRedFlag.String() {
-			serviceRequestsCount.RequestsTypeCount[2].Total++
-		}
-		if request.RequestType == enums.ServiceRequestTypeSurveyRedFlag.String() {
-			serviceRequestsCount.RequestsTypeCount[3].Total++
-		}
-		if request.RequestType == enums.ServiceRequestTypeHomePageHealthDiary.String() {
-			serviceRequestsCount.RequestsTypeCount[4].Total++
-		}
-		if request.RequestType == enums.ServiceRequestTypeStaffPinReset.String() {
-			serviceRequestsCount.RequestsTypeCount[5].Total++
-		}
-		if request.RequestType == enums.ServiceRequestTypeAppointments.String() {
-			serviceRequestsCount.RequestsTypeCount[6].Total++
+		if count, ok := countsByType[request.RequestType]; ok {
+			count.Total++
 		}
 	}
 
@@ -1048,11 +1126,12 @@ func (db *PGInstance) GetClientsInAFacility(ctx context.Context, facilityID stri
 }
 
 // GetRecentHealthDiaryEntries fetches the health diary entries that were added after the last time the entries were
-// synced to KenyaEMR
-func (db *PGInstance) GetRecentHealthDiaryEntries(ctx context.Context, lastSyncTime time.Time, clientID string) ([]*ClientHealthDiaryEntry, error) {
+// synced to KenyaEMR. Only the entries that the client currently shares with the facility are returned
+func (db *PGInstance) GetRecentHealthDiaryEntries(ctx context.Context, lastSyncTime time.Time, clientID string, facilityID string) ([]*ClientHealthDiaryEntry, error) {
 	var healthDiaryEntry []*ClientHealthDiaryEntry
 
 	err := db.DB.Where(&ClientHealthDiaryEntry{ClientID: clientID, Active: true}).Where("? > ?", clause.Column{Name: "created"}, lastSyncTime).
+		Where(sharedHealthDiaryEntryCondition("facility_id"), facilityID, time.Now()).
 		Order(clause.OrderByColumn{Column: clause.Column{Name: "created"}, Desc: true}).Find(&healthDiaryEntry).Error
 	if err != nil {
 		return nil, fmt.Errorf("failed to get all client health diary entries: %v", err)
@@ -1186,15 +1265,44 @@ func (db *PGInstance) GetHealthDiaryEntryByID(ctx context.Context, healthDiaryEn
 	return healthDiaryEntry, nil
 }
 
-// GetSharedHealthDiaryEntries gets the recently shared health diary entry shared by the client to a health care worker
-// and returns the entry.
-// The health care worker will only see the entry as long as they share the same facility with the health care worker
+// sharedHealthDiaryEntryCondition matches the health diary entries that are covered by an active grant to a facility or caregiver.
+// The grantee column is either facility_id or caregiver_id and the condition expects the grantee's ID and the current time as arguments
+func sharedHealthDiaryEntryCondition(granteeColumn string) string {
+	return healthDiaryShareCondition(granteeColumn + " = ?")
+}
+
+// healthDiaryShareCondition matches the health diary entries that are covered by an active grant whose grantee matches
+// the provided condition. The condition is followed by the current time in the arguments
+func healthDiaryShareCondition(granteeCondition string) string {
+	return fmt.Sprintf(`EXISTS (
+		SELECT 1 FROM clients_healthdiaryshare
+		WHERE clients_healthdiaryshare.client_id = clients_healthdiaryentry.client_id
+		AND clients_healthdiaryshare.%s
+		AND clients_healthdiaryshare.active = true
+		AND clients_healthdiaryshare.deleted_at IS NULL
+		AND clients_healthdiaryshare.revoked_at IS NULL
+		AND (clients_healthdiaryshare.expires_at IS NULL OR clients_healthdiaryshare.expires_at > ?)
+		AND (
+			clients_healthdiaryshare.scope = '%s'
+			OR (clients_healthdiaryshare.scope = '%s' AND clients_healthdiaryentry.created BETWEEN clients_healthdiaryshare.from_date AND clients_healthdiaryshare.to_date)
+			OR (clients_healthdiaryshare.scope = '%s' AND clients_healthdiaryentry.id::text = ANY(clients_healthdiaryshare.healthdiaryentry_ids))
+		)
+	)`,
+		granteeCondition,
+		enums.HealthDiaryShareScopeEntireDiary,
+		enums.HealthDiaryShareScopeDateRange,
+		enums.HealthDiaryShareScopeEntries,
+	)
+}
+
+// GetSharedHealthDiaryEntries gets the health diary entries that the client currently shares with a facility.
+// The health care workers in the facility will only see the entries as long as the client's grant has neither expired nor been revoked
 func (db *PGInstance) GetSharedHealthDiaryEntries(ctx context.Context, clientID string, facilityID string) ([]*ClientHealthDiaryEntry, error) {
 	var healthDiaryEntry []*ClientHealthDiaryEntry
 
-	err := db.DB.Joins("JOIN clients_clientfacility on clients_healthdiaryentry.client_id = clients_clientfacility.client_id").
-		Where("clients_healthdiaryentry.share_with_health_worker = ? AND clients_healthdiaryentry.client_id = ? AND clients_clientfacility.facility_id = ? ", true, clientID, facilityID).
-		Order(clause.OrderByColumn{Column: clause.Column{Name: "shared_at"}, Desc: true}).Find(&healthDiaryEntry).Error
+	err := db.DB.Where(&ClientHealthDiaryEntry{ClientID: clientID, Active: true}).
+		Where(sharedHealthDiaryEntryCondition("facility_id"), facilityID, time.Now()).
+		Order(clause.OrderByColumn{Column: clause.Column{Name: "created"}, Desc: true}).Find(&healthDiaryEntry).Error
 	if err != nil {
 		return nil, fmt.Errorf("failed to get shared health diary entry: %v", err)
 	}
@@ -1341,13 +1449,13 @@ func (db *PGInstance) GetAvailableScreeningTools(ctx context.Context, clientID s
 	return screeningTools, nil
 }
 
-// GetScreeningToolResponsesWithin24Hours gets the user screening response that are within 24 hours
-func (db *PGInstance) GetScreeningToolResponsesWithin24Hours(ctx context.Context, clientID, programID string) ([]*ScreeningToolResponse, error) {
+// GetLatestScreeningToolResponses gets a client's most recent response to each of the screening tools they have responded to
+func (db *PGInstance) GetLatestScreeningToolResponses(ctx context.Context, clientID, programID string) ([]*ScreeningToolResponse, error) {
 	var screeningToolResponses []*ScreeningToolResponse
-	twentyFourHoursAgo := time.Now().Add(time.Hour * -24)
 
-	err := db.DB.Where(&ScreeningToolResponse{ClientID: clientID, ProgramID: programID}).
-		Where("questionnaires_screeningtoolresponse.created > ?", twentyFourHoursAgo).
+	err := db.DB.WithContext(ctx).Where(&ScreeningToolResponse{ClientID: clientID, ProgramID: programID}).
+		Select("DISTINCT ON (questionnaires_screeningtoolresponse.screeningtool_id) questionnaires_screeningtoolresponse.*").
+		Order("questionnaires_screeningtoolresponse.screeningtool_id, questionnaires_screeningtoolresponse.created DESC").
 		Find(&screeningToolResponses).Error
 	if err != nil {
 		return nil, fmt.Errorf("failed to get screening tool responses: %w", err)
@@ -2071,3 +2179,606 @@ func (db *PGInstance) GetStaffServiceRequestByID(ctx context.Context, serviceReq
 	}
 	return &serviceRequest, nil
 }
+
+// GetUserPermissions retrieves the permissions that have been granted to a user through the roles assigned to
+// their staff, client or caregiver profiles in a program. Organisation wide roles apply to all the programs in the organisation
+func (db *PGInstance) GetUserPermissions(ctx context.Context, userID string, programID string) ([]*AuthorityPermission, error) {
+	var permissions []*AuthorityPermission
+
+	roles := db.DB.Raw(`
+	SELECT authority_authorityrole_staff.authorityrole_id FROM authority_authorityrole_staff
+	JOIN staff_staff ON staff_staff.id = authority_authorityrole_staff.staff_id WHERE staff_staff.user_id = ?
+	UNION
+	SELECT authority_authorityrole_clients.authorityrole_id FROM authority_authorityrole_clients
+	JOIN clients_client ON clients_client.id = authority_authorityrole_clients.client_id WHERE clients_client.user_id = ?
+	UNION
+	SELECT authority_authorityrole_caregivers.authorityrole_id FROM authority_authorityrole_caregivers
+	JOIN caregivers_caregiver ON caregivers_caregiver.id = authority_authorityrole_caregivers.caregiver_id WHERE caregivers_caregiver.user_id = ?
+	`, userID, userID, userID)
+
+	if err := db.DB.WithContext(ctx).Distinct("authority_authoritypermission.*").
+		Joins("JOIN authority_authorityrole_permissions ON authority_authorityrole_permissions.authoritypermission_id = authority_authoritypermission.id").
+		Joins("JOIN authority_authorityrole ON authority_authorityrole.id = authority_authorityrole_permissions.authorityrole_id").
+		Where("authority_authorityrole.active = ?", true).
+		Where(
+			"(authority_authorityrole.program_id = ? OR (authority_authorityrole.program_id IS NULL AND authority_authorityrole.organisation_id = (SELECT organisation_id FROM common_program WHERE id = ?)))",
+			programID, programID,
+		).
+		Where("authority_authorityrole.id IN (?)", roles).
+		Find(&permissions).Error; err != nil {
+		return nil, fmt.Errorf("failed to get user permissions: %w", err)
+	}
+
+	return permissions, nil
+}
+
+// ListAuditLogs returns the audit logs recorded in an organisation, most recent first
+func (db *PGInstance) ListAuditLogs(ctx context.Context, organisationID string, filter *dto.AuditLogFilterInput, pagination *domain.Pagination) ([]*AuditLog, *domain.Pagination, error) {
+	var count int64
+	var auditLogs []*AuditLog
+
+	tx := db.DB.WithContext(ctx).Model(&AuditLog{}).Where("organisation_id = ?", organisationID)
+
+	if filter != nil {
+		if filter.RecordType != nil {
+			tx = tx.Where("record_type = ?", filter.RecordType.String())
+		}
+		if filter.ActorID != nil {
+			tx = tx.Where("actor_id = ?", *filter.ActorID)
+		}
+		if filter.TargetID != nil {
+			tx = tx.Where("target_id = ?", *filter.TargetID)
+		}
+		if filter.ProgramID != nil {
+			tx = tx.Where("program_id = ?", *filter.ProgramID)
+		}
+		if filter.From != nil {
+			tx = tx.Where("timestamp >= ?", *filter.From)
+		}
+		if filter.To != nil {
+			tx = tx.Where("timestamp <= ?", *filter.To)
+		}
+	}
+
+	if pagination != nil {
+		if err := tx.Count(&count).Error; err != nil {
+			return nil, nil, fmt.Errorf("failed to execute count query: %w", err)
+		}
+
+		pagination.Count = count
+		paginateQuery(tx, pagination)
+	}
+
+	if err := tx.Order("timestamp DESC").Find(&auditLogs).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to list audit logs: %w", err)
+	}
+
+	return auditLogs, pagination, nil
+}
+
+// GetAuthorityRoleByID retrieves a role using its ID
+func (db *PGInstance) GetAuthorityRoleByID(ctx context.Context, roleID string) (*AuthorityRole, error) {
+	var role AuthorityRole
+
+	if err := db.DB.WithContext(ctx).Where(&AuthorityRole{AuthorityRoleID: &roleID}).First(&role).Error; err != nil {
+		return nil, fmt.Errorf("failed to get authority role: %w", err)
+	}
+
+	return &role, nil
+}
+
+// GetAuthorityRolePermissions retrieves the permissions that have been granted to a role
+func (db *PGInstance) GetAuthorityRolePermissions(ctx context.Context, roleID string) ([]*AuthorityPermission, error) {
+	var permissions []*AuthorityPermission
+
+	if err := db.DB.WithContext(ctx).
+		Joins("JOIN authority_authorityrole_permissions ON authority_authorityrole_permissions.authoritypermission_id = authority_authoritypermission.id").
+		Where("authority_authorityrole_permissions.authorityrole_id = ?", roleID).
+		Order("authority_authoritypermission.scope").
+		Find(&permissions).Error; err != nil {
+		return nil, fmt.Errorf("failed to get role permissions: %w", err)
+	}
+
+	return permissions, nil
+}
+
+// ListAuthorityRoles retrieves the roles in an organisation. When a program is provided, the program's roles are returned
+// alongside the organisation wide roles
+func (db *PGInstance) ListAuthorityRoles(ctx context.Context, organisationID string, programID string) ([]*AuthorityRole, error) {
+	var roles []*AuthorityRole
+
+	tx := db.DB.WithContext(ctx).Where("organisation_id = ?", organisationID)
+	if programID != "" {
+		tx = tx.Where("(program_id = ? OR program_id IS NULL)", programID)
+	} else {
+		tx = tx.Where("program_id IS NULL")
+	}
+
+	if err := tx.Order("name").Find(&roles).Error; err != nil {
+		return nil, fmt.Errorf("failed to list authority roles: %w", err)
+	}
+
+	return roles, nil
+}
+
+// ListAuthorityRoleStaff retrieves the staff that have been assigned a role
+func (db *PGInstance) ListAuthorityRoleStaff(ctx context.Context, roleID string, pagination *domain.Pagination) ([]*StaffProfile, *domain.Pagination, error) {
+	var count int64
+	var staff []*StaffProfile
+
+	tx := db.DB.WithContext(ctx).Model(&StaffProfile{}).
+		Joins("JOIN authority_authorityrole_staff ON authority_authorityrole_staff.staff_id = staff_staff.id").
+		Where("authority_authorityrole_staff.authorityrole_id = ?", roleID)
+
+	if pagination != nil {
+		if err := tx.Count(&count).Error; err != nil {
+			return nil, nil, fmt.Errorf("failed to execute count query: %w", err)
+		}
+
+		pagination.Count = count
+		paginateQuery(tx, pagination)
+	}
+
+	if err := tx.Preload("UserProfile.Contacts").Order("staff_staff.created DESC").Find(&staff).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to list role staff: %w", err)
+	}
+
+	return staff, pagination, nil
+}
+
+// CheckStaffHasRole checks whether a staff has been assigned a role
+func (db *PGInstance) CheckStaffHasRole(ctx context.Context, staffID string, roleID string) (bool, error) {
+	var count int64
+
+	if err := db.DB.WithContext(ctx).Model(&AuthorityRoleStaff{}).
+		Where(&AuthorityRoleStaff{RoleID: &roleID, StaffID: &staffID}).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check staff role: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// GetRecentOTPsCount returns the number of OTPs that have been generated for a phone number since the provided time
+func (db *PGInstance) GetRecentOTPsCount(ctx context.Context, phoneNumber string, since time.Time) (int, error) {
+	var count int64
+	if err := db.DB.WithContext(ctx).Model(&UserOTP{}).
+		Where("phonenumber = ? AND generated_at >= ?", phoneNumber, since).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count recent otps: %w", err)
+	}
+	return int(count), nil
+}
+
+// ListAppointmentsPendingReminder retrieves the active appointments scheduled on the provided date
+// for which a reminder has not yet been sent at the given offset
+func (db *PGInstance) ListAppointmentsPendingReminder(ctx context.Context, appointmentDate time.Time, offsetDays int) ([]*Appointment, error) {
+	var appointments []*Appointment
+
+	start := time.Date(appointmentDate.Year(), appointmentDate.Month(), appointmentDate.Day(), 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+
+	sentReminders := db.DB.Model(&AppointmentReminder{}).Select("appointment_id").Where("offset_days = ?", offsetDays)
+
+	err := db.DB.WithContext(ctx).Model(&Appointment{}).
+		Where("active = ?", true).
+		Where("date >= ? AND date < ?", start, end).
+		Where("id NOT IN (?)", sentReminders).
+		Find(&appointments).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list appointments pending reminder: %w", err)
+	}
+
+	return appointments, nil
+}
+
+// GetNotificationPreferences retrieves the notification preferences that a user has set
+func (db *PGInstance) GetNotificationPreferences(ctx context.Context, userID string) ([]*NotificationPreference, error) {
+	var preferences []*NotificationPreference
+	if err := db.DB.WithContext(ctx).Where("user_id = ?", userID).Find(&preferences).Error; err != nil {
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+	return preferences, nil
+}
+
+// ListMissedAppointments retrieves the active appointments scheduled before the provided date that have not been
+// updated by KenyaEMR since they were due and have not already been marked as missed
+func (db *PGInstance) ListMissedAppointments(ctx context.Context, before time.Time) ([]*Appointment, error) {
+	var appointments []*Appointment
+
+	err := db.DB.WithContext(ctx).Model(&Appointment{}).
+		Where("active = ?", true).
+		Where("missed = ?", false).
+		Where("date < ?", before).
+		Where("COALESCE(last_synced_at, created) < date").
+		Find(&appointments).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list missed appointments: %w", err)
+	}
+
+	return appointments, nil
+}
+
+// GetAppointmentSlot retrieves an appointment slot using its ID
+func (db *PGInstance) GetAppointmentSlot(ctx context.Context, slotID string) (*AppointmentSlot, error) {
+	var slot AppointmentSlot
+	if err := db.DB.WithContext(ctx).Where("id = ?", slotID).First(&slot).Error; err != nil {
+		return nil, fmt.Errorf("failed to get appointment slot: %w", err)
+	}
+	return &slot, nil
+}
+
+// ListAppointmentSlots retrieves the active appointment slots of a facility ordered by the day of the week and the start time
+func (db *PGInstance) ListAppointmentSlots(ctx context.Context, facilityID string) ([]*AppointmentSlot, error) {
+	var slots []*AppointmentSlot
+
+	err := db.DB.WithContext(ctx).
+		Where("facility_id = ? AND active = ?", facilityID, true).
+		Order("day_of_week").Order("start_time").
+		Find(&slots).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list appointment slots: %w", err)
+	}
+
+	return slots, nil
+}
+
+// GetAppointmentSlotBookingCounts retrieves the number of active bookings made against each of a facility's
+// appointment slots on every date between the start and end dates, inclusive
+func (db *PGInstance) GetAppointmentSlotBookingCounts(ctx context.Context, facilityID string, startDate, endDate time.Time) ([]*AppointmentSlotBookingCount, error) {
+	var counts []*AppointmentSlotBookingCount
+
+	err := db.DB.WithContext(ctx).Model(&Appointment{}).
+		Select("slot_id, date, COUNT(*) AS count").
+		Where("facility_id = ? AND active = ? AND slot_id IS NOT NULL", facilityID, true).
+		Where("date >= ? AND date <= ?", startDate, endDate).
+		Group("slot_id, date").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get appointment slot booking counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// ListOverdueServiceRequests retrieves the active pending service requests of the given type that were created before the
+// provided time and have not been escalated yet
+func (db *PGInstance) ListOverdueServiceRequests(ctx context.Context, requestType string, createdBefore time.Time) ([]*ClientServiceRequest, error) {
+	var serviceRequests []*ClientServiceRequest
+
+	err := db.DB.WithContext(ctx).Model(&ClientServiceRequest{}).
+		Where("active = ?", true).
+		Where("request_type = ?", requestType).
+		Where("status = ?", enums.ServiceRequestStatusPending.String()).
+		Where("escalated_at IS NULL").
+		Where("created < ?", createdBefore).
+		Order("created ASC").
+		Find(&serviceRequests).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list overdue service requests: %w", err)
+	}
+
+	return serviceRequests, nil
+}
+
+// ListServiceRequestComments retrieves the active comments on a service request, oldest first
+func (db *PGInstance) ListServiceRequestComments(ctx context.Context, serviceRequestID string) ([]*ServiceRequestComment, error) {
+	var comments []*ServiceRequestComment
+
+	err := db.DB.WithContext(ctx).
+		Where("service_request_id = ?", serviceRequestID).
+		Where("active = ?", true).
+		Order("created ASC").
+		Find(&comments).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list service request comments: %w", err)
+	}
+
+	return comments, nil
+}
+
+// ListServiceRequestEvents retrieves the history of a service request in the order in which it happened
+func (db *PGInstance) ListServiceRequestEvents(ctx context.Context, serviceRequestID string) ([]*ServiceRequestEvent, error) {
+	var events []*ServiceRequestEvent
+
+	err := db.DB.WithContext(ctx).
+		Where("service_request_id = ?", serviceRequestID).
+		Order("created ASC").
+		Find(&events).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list service request events: %w", err)
+	}
+
+	return events, nil
+}
+
+// GetScreeningToolScoreBands is used to get the score bands of a screening tool ordered from the lowest to the highest
+func (db *PGInstance) GetScreeningToolScoreBands(ctx context.Context, screeningToolID string) ([]*ScreeningToolScoreBand, error) {
+	var scoreBands []*ScreeningToolScoreBand
+
+	err := db.DB.WithContext(ctx).Where(&ScreeningToolScoreBand{ScreeningToolID: screeningToolID, Active: true}).
+		Order("min_score ASC").Find(&scoreBands).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get screening tool score bands: %w", err)
+	}
+
+	return scoreBands, nil
+}
+
+// GetQuestionConditionsByQuestionID is used to get the display conditions of a question
+func (db *PGInstance) GetQuestionConditionsByQuestionID(ctx context.Context, questionID string) ([]*QuestionCondition, error) {
+	var conditions []*QuestionCondition
+
+	if err := db.DB.WithContext(ctx).Where(&QuestionCondition{QuestionID: questionID, Active: true}).Find(&conditions).Error; err != nil {
+		return nil, fmt.Errorf("failed to get question conditions: %w", err)
+	}
+
+	return conditions, nil
+}
+
+// ListScreeningToolVersions is used to get the versions of a screening tool ordered from the oldest to the newest
+func (db *PGInstance) ListScreeningToolVersions(ctx context.Context, screeningToolID string) ([]*ScreeningToolVersion, error) {
+	var versions []*ScreeningToolVersion
+
+	err := db.DB.WithContext(ctx).Where(&ScreeningToolVersion{ScreeningToolID: screeningToolID}).
+		Order("version ASC").Find(&versions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list screening tool versions: %w", err)
+	}
+
+	return versions, nil
+}
+
+// ListScreeningToolAssignments is used to get the screening tool assignments matching the provided parameters ordered by their due date
+func (db *PGInstance) ListScreeningToolAssignments(ctx context.Context, params *ScreeningToolAssignment) ([]*ScreeningToolAssignment, error) {
+	var assignments []*ScreeningToolAssignment
+
+	err := db.DB.WithContext(ctx).Where(params).Order("due_date ASC").Find(&assignments).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list screening tool assignments: %w", err)
+	}
+
+	return assignments, nil
+}
+
+// ListPendingScreeningToolAssignmentsDueBefore is used to get the screening tool assignments that clients have not responded to
+// and are due before the provided time. Overdue assignments are included
+func (db *PGInstance) ListPendingScreeningToolAssignmentsDueBefore(ctx context.Context, dueBefore time.Time) ([]*ScreeningToolAssignment, error) {
+	var assignments []*ScreeningToolAssignment
+
+	err := db.DB.WithContext(ctx).Where(&ScreeningToolAssignment{Status: enums.ScreeningToolAssignmentStatusPending.String()}).
+		Where("due_date <= ?", dueBefore).Order("due_date ASC").Find(&assignments).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending screening tool assignments: %w", err)
+	}
+
+	return assignments, nil
+}
+
+// ListClientScreeningToolResponses gets all of a client's screening tool responses ordered from the oldest to the most recent.
+// The responses can optionally be limited to a single screening tool
+func (db *PGInstance) ListClientScreeningToolResponses(ctx context.Context, clientID, programID string, screeningToolID *string) ([]*ScreeningToolResponse, error) {
+	var screeningToolResponses []*ScreeningToolResponse
+
+	tx := db.DB.WithContext(ctx).Where(&ScreeningToolResponse{ClientID: clientID, ProgramID: programID})
+	if screeningToolID != nil {
+		tx = tx.Where("screeningtool_id = ?", *screeningToolID)
+	}
+
+	err := tx.Order("created ASC").Find(&screeningToolResponses).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list client screening tool responses: %w", err)
+	}
+
+	return screeningToolResponses, nil
+}
+
+// ListClientHealthDiaryEntriesSince gets the active health diary entries a client recorded after the provided time ordered from the oldest to the most recent
+func (db *PGInstance) ListClientHealthDiaryEntriesSince(ctx context.Context, clientID string, since time.Time) ([]*ClientHealthDiaryEntry, error) {
+	var healthDiaryEntries []*ClientHealthDiaryEntry
+
+	err := db.DB.WithContext(ctx).Where(&ClientHealthDiaryEntry{ClientID: clientID}).Where("active = ?", true).Where("created > ?", since).
+		Order("created ASC").Find(&healthDiaryEntries).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list client health diary entries: %w", err)
+	}
+
+	return healthDiaryEntries, nil
+}
+
+// ListClientIDsWithHealthDiaryEntriesSince gets the IDs of the clients who recorded an active health diary entry after the provided time
+func (db *PGInstance) ListClientIDsWithHealthDiaryEntriesSince(ctx context.Context, since time.Time) ([]string, error) {
+	var clientIDs []string
+
+	err := db.DB.WithContext(ctx).Model(&ClientHealthDiaryEntry{}).Where("active = ?", true).Where("created > ?", since).
+		Distinct().Pluck("client_id", &clientIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clients with health diary entries: %w", err)
+	}
+
+	return clientIDs, nil
+}
+
+// GetHealthDiaryShareByID gets a health diary share using its ID
+func (db *PGInstance) GetHealthDiaryShareByID(ctx context.Context, shareID string) (*HealthDiaryShare, error) {
+	var share HealthDiaryShare
+
+	err := db.DB.WithContext(ctx).Where(&HealthDiaryShare{ID: shareID}).First(&share).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get health diary share: %w", err)
+	}
+
+	return &share, nil
+}
+
+// ListClientHealthDiaryShares gets the grants a client made to their health diary, including the revoked and expired ones, from the most recent
+func (db *PGInstance) ListClientHealthDiaryShares(ctx context.Context, clientID string) ([]*HealthDiaryShare, error) {
+	var shares []*HealthDiaryShare
+
+	err := db.DB.WithContext(ctx).Where(&HealthDiaryShare{ClientID: clientID}).Order("created DESC").Find(&shares).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list client health diary shares: %w", err)
+	}
+
+	return shares, nil
+}
+
+// GetCaregiverSharedHealthDiaryEntries gets the health diary entries that a client currently shares with one of their caregivers
+func (db *PGInstance) GetCaregiverSharedHealthDiaryEntries(ctx context.Context, clientID string, caregiverID string) ([]*ClientHealthDiaryEntry, error) {
+	var healthDiaryEntries []*ClientHealthDiaryEntry
+
+	err := db.DB.WithContext(ctx).Where(&ClientHealthDiaryEntry{ClientID: clientID, Active: true}).
+		Where(sharedHealthDiaryEntryCondition("caregiver_id"), caregiverID, time.Now()).
+		Order(clause.OrderByColumn{Column: clause.Column{Name: "created"}, Desc: true}).Find(&healthDiaryEntries).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get health diary entries shared with caregiver: %w", err)
+	}
+
+	return healthDiaryEntries, nil
+}
+
+// GetSurveyCampaignByID gets a survey campaign using its ID
+func (db *PGInstance) GetSurveyCampaignByID(ctx context.Context, campaignID string) (*SurveyCampaign, error) {
+	var campaign SurveyCampaign
+
+	err := db.DB.WithContext(ctx).Where(&SurveyCampaign{ID: campaignID}).First(&campaign).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get survey campaign: %w", err)
+	}
+
+	return &campaign, nil
+}
+
+// ListSurveyCampaigns gets the survey campaigns that a program runs in a facility, from the most recent
+func (db *PGInstance) ListSurveyCampaigns(ctx context.Context, facilityID, programID string) ([]*SurveyCampaign, error) {
+	var campaigns []*SurveyCampaign
+
+	err := db.DB.WithContext(ctx).Where(&SurveyCampaign{FacilityID: facilityID, ProgramID: programID}).Order("created DESC").Find(&campaigns).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list survey campaigns: %w", err)
+	}
+
+	return campaigns, nil
+}
+
+// ListOngoingSurveyCampaigns gets the scheduled and active survey campaigns, that is those that still have rounds to send or links to follow up
+func (db *PGInstance) ListOngoingSurveyCampaigns(ctx context.Context) ([]*SurveyCampaign, error) {
+	var campaigns []*SurveyCampaign
+
+	err := db.DB.WithContext(ctx).Where("active = ?", true).
+		Where("status IN ?", []string{enums.SurveyCampaignStatusScheduled.String(), enums.SurveyCampaignStatusActive.String()}).
+		Order("next_send_at ASC").Find(&campaigns).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ongoing survey campaigns: %w", err)
+	}
+
+	return campaigns, nil
+}
+
+// ListSurveyRedFlagRules gets the active red flag rules that a program defined for a survey form
+func (db *PGInstance) ListSurveyRedFlagRules(ctx context.Context, projectID int, formID, programID string) ([]*SurveyRedFlagRule, error) {
+	var rules []*SurveyRedFlagRule
+
+	err := db.DB.WithContext(ctx).Where(&SurveyRedFlagRule{ProjectID: projectID, FormID: formID, ProgramID: programID}).
+		Where("active = ?", true).Order("created ASC").Find(&rules).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list survey red flag rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// ListAllCommunities gets all the active communities across every program
+func (db *PGInstance) ListAllCommunities(ctx context.Context) ([]*Community, error) {
+	var communities []*Community
+
+	if err := db.DB.WithContext(ctx).Where("active = ?", true).Find(&communities).Error; err != nil {
+		return nil, fmt.Errorf("failed to list communities: %w", err)
+	}
+
+	return communities, nil
+}
+
+// ListProgramClients gets all the clients of a program, active or not, together with their user records
+func (db *PGInstance) ListProgramClients(ctx context.Context, programID string) ([]*Client, error) {
+	var clients []*Client
+
+	if err := db.DB.WithContext(ctx).Where(&Client{ProgramID: programID}).Preload("User").Find(&clients).Error; err != nil {
+		return nil, fmt.Errorf("failed to list program clients: %w", err)
+	}
+
+	return clients, nil
+}
+
+// GetUserMatrixPassword gets the encrypted Matrix password of a user. It is nil when the user's Matrix password is not managed yet
+func (db *PGInstance) GetUserMatrixPassword(ctx context.Context, userID string) (*string, error) {
+	var user User
+
+	if err := db.DB.WithContext(ctx).Select("id", "matrix_password").Where("id = ?", userID).First(&user).Error; err != nil {
+		return nil, fmt.Errorf("failed to get user matrix password: %w", err)
+	}
+
+	return user.MatrixPassword, nil
+}
+
+// ListUsersWithoutMatrixPassword gets the users whose Matrix password is not managed yet
+func (db *PGInstance) ListUsersWithoutMatrixPassword(ctx context.Context) ([]*User, error) {
+	var users []*User
+
+	if err := db.DB.WithContext(ctx).Where("matrix_password IS NULL").Find(&users).Error; err != nil {
+		return nil, fmt.Errorf("failed to list users without a matrix password: %w", err)
+	}
+
+	return users, nil
+}
+
+// ListCommunityCrisisKeywords gets the active crisis keywords of a program
+func (db *PGInstance) ListCommunityCrisisKeywords(ctx context.Context, programID string) ([]*CommunityCrisisKeyword, error) {
+	var keywords []*CommunityCrisisKeyword
+
+	err := db.DB.WithContext(ctx).Where(&CommunityCrisisKeyword{ProgramID: programID}).
+		Where("active = ?", true).Order("created ASC").Find(&keywords).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list community crisis keywords: %w", err)
+	}
+
+	return keywords, nil
+}
+
+// GetCommunityByRoomID fetches the active community backed by a Matrix room
+func (db *PGInstance) GetCommunityByRoomID(ctx context.Context, roomID string) (*Community, error) {
+	var community *Community
+
+	err := db.DB.WithContext(ctx).Where(&Community{RoomID: roomID}).Where("active = ?", true).First(&community).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find community by room ID %s: %w", roomID, err)
+	}
+
+	return community, nil
+}
+
+// CheckCrisisMessageFlagged checks whether a red flag service request has already been raised for a community message
+func (db *PGInstance) CheckCrisisMessageFlagged(ctx context.Context, eventID string) (bool, error) {
+	var count int64
+
+	err := db.DB.WithContext(ctx).Model(&ClientServiceRequest{}).
+		Where("request_type = ? AND meta->>'eventID' = ?", enums.ServiceRequestTypeRedFlag.String(), eventID).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("failed to check whether message %s has been flagged: %w", eventID, err)
+	}
+
+	return count > 0, nil
+}
+
+// GetSurveyRedFlagRuleByID gets a survey red flag rule by its ID
+func (db *PGInstance) GetSurveyRedFlagRuleByID(ctx context.Context, ruleID string) (*SurveyRedFlagRule, error) {
+	var rule SurveyRedFlagRule
+
+	err := db.DB.WithContext(ctx).Where(&SurveyRedFlagRule{ID: ruleID}).First(&rule).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get survey red flag rule: %w", err)
+	}
+
+	return &rule, nil
+}
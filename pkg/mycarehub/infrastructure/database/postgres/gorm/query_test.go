@@ -11,6 +11,7 @@ import (
 	"github.com/savannahghi/enumutils"
 	"github.com/savannahghi/feedlib"
 	"github.com/savannahghi/firebasetools"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
@@ -1327,9 +1328,11 @@ func TestPGInstance_GetClientHealthDiaryEntries(t *testing.T) {
 	params := map[string]interface{}{
 		"client_id": clientID,
 	}
+	shared := true
 	type args struct {
 		ctx    context.Context
 		params map[string]interface{}
+		shared *bool
 	}
 	tests := []struct {
 		name    string
@@ -1344,10 +1347,19 @@ func TestPGInstance_GetClientHealthDiaryEntries(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "happy case: get client health diary entries shared with a facility",
+			args: args{
+				ctx:    context.Background(),
+				params: params,
+				shared: &shared,
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := testingDB.GetClientHealthDiaryEntries(tt.args.ctx, tt.args.params)
+			got, err := testingDB.GetClientHealthDiaryEntries(tt.args.ctx, tt.args.params, tt.args.shared)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("PGInstance.GetClientHealthDiaryEntries() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1433,12 +1445,27 @@ func TestPGInstance_GetClientsPendingServiceRequestsCount(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := testingDB.GetClientsPendingServiceRequestsCount(tt.args.ctx, tt.args.facilityID)
+			got, err := testingDB.GetClientsPendingServiceRequestsCount(tt.args.ctx, tt.args.facilityID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("PGInstance.GetClientsPendingServiceRequestsCount() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if tt.wantErr {
+				return
+			}
 
+			counted := 0
+			countedTypes := map[enums.ServiceRequestType]bool{}
+			for _, count := range got.RequestsTypeCount {
+				counted += count.Total
+				countedTypes[count.RequestType] = true
+			}
+			if counted > got.Total {
+				t.Errorf("PGInstance.GetClientsPendingServiceRequestsCount() counted %d requests by type, more than the total %d", counted, got.Total)
+			}
+			if !countedTypes[enums.ServiceRequestTypeMoodDeterioration] {
+				t.Errorf("PGInstance.GetClientsPendingServiceRequestsCount() did not count %s service requests", enums.ServiceRequestTypeMoodDeterioration)
+			}
 		})
 	}
 }
@@ -1966,7 +1993,7 @@ func TestPGInstance_GetRecentHealthDiaryEntries(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := testingDB.GetRecentHealthDiaryEntries(tt.args.ctx, tt.args.lastSyncTime, tt.args.clientID)
+			got, err := testingDB.GetRecentHealthDiaryEntries(tt.args.ctx, tt.args.lastSyncTime, tt.args.clientID, facilityID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("PGInstance.GetRecentHealthDiaryEntries() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -5486,7 +5513,7 @@ func TestPGInstance_GetAvailableScreeningTools(t *testing.T) {
 	}
 }
 
-func TestPGInstance_GetScreeningToolResponsesWithin24Hours(t *testing.T) {
+func TestPGInstance_GetLatestScreeningToolResponses(t *testing.T) {
 	type args struct {
 		ctx       context.Context
 		clientID  string
@@ -5498,7 +5525,49 @@ func TestPGInstance_GetScreeningToolResponsesWithin24Hours(t *testing.T) {
 		wantErr bool
 	}{
 		{
-			name: "Happy case: get screening tool responses within 24 hours",
+			name: "Happy case: get latest screening tool responses",
+			args: args{
+				ctx:       context.Background(),
+				clientID:  clientID,
+				programID: programID,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: failed to get latest screening tool responses, invalid client id",
+			args: args{
+				ctx:       context.Background(),
+				clientID:  "invalid",
+				programID: programID,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testingDB.GetLatestScreeningToolResponses(tt.args.ctx, tt.args.clientID, tt.args.programID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.GetLatestScreeningToolResponses() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestPGInstance_ListClientScreeningToolResponses(t *testing.T) {
+	type args struct {
+		ctx             context.Context
+		clientID        string
+		programID       string
+		screeningToolID *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list client screening tool responses",
 			args: args{
 				ctx:       context.Background(),
 				clientID:  clientID,
@@ -5507,7 +5576,17 @@ func TestPGInstance_GetScreeningToolResponsesWithin24Hours(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "Sad case: failed to get screening tool responses within 24 hours, invalid client id",
+			name: "Happy case: list client responses to a screening tool",
+			args: args{
+				ctx:             context.Background(),
+				clientID:        clientID,
+				programID:       programID,
+				screeningToolID: &screeningToolID,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: failed to list client screening tool responses, invalid client id",
 			args: args{
 				ctx:       context.Background(),
 				clientID:  "invalid",
@@ -5518,9 +5597,9 @@ func TestPGInstance_GetScreeningToolResponsesWithin24Hours(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := testingDB.GetScreeningToolResponsesWithin24Hours(tt.args.ctx, tt.args.clientID, tt.args.programID)
+			_, err := testingDB.ListClientScreeningToolResponses(tt.args.ctx, tt.args.clientID, tt.args.programID, tt.args.screeningToolID)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("PGInstance.GetScreeningToolResponsesWithin24Hours() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("PGInstance.ListClientScreeningToolResponses() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 		})
@@ -5611,3 +5690,1822 @@ func TestPGInstance_GetStaffServiceRequestByID(t *testing.T) {
 		})
 	}
 }
+
+func TestPGInstance_GetUserPermissions(t *testing.T) {
+	type args struct {
+		ctx       context.Context
+		userID    string
+		programID string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name: "Happy case: get permissions granted through a staff role",
+			args: args{
+				ctx:       context.Background(),
+				userID:    userWithRolesID,
+				programID: programID,
+			},
+			wantCount: 3,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: user without roles in the program",
+			args: args{
+				ctx:       context.Background(),
+				userID:    userID,
+				programID: programID,
+			},
+			wantCount: 0,
+			wantErr:   false,
+		},
+		{
+			name: "Sad case: invalid program id",
+			args: args{
+				ctx:       context.Background(),
+				userID:    userWithRolesID,
+				programID: "programID",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.GetUserPermissions(tt.args.ctx, tt.args.userID, tt.args.programID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.GetUserPermissions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) != tt.wantCount {
+				t.Errorf("PGInstance.GetUserPermissions() got %v permissions, want %v", len(got), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestPGInstance_ListAuditLogs(t *testing.T) {
+	recordType := enums.AuditLogRecordTypeClientFacilityTransfer
+	invalidProgramID := "invalid-id"
+
+	type args struct {
+		ctx            context.Context
+		organisationID string
+		filter         *dto.AuditLogFilterInput
+		pagination     *domain.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list audit logs",
+			args: args{
+				ctx:            context.Background(),
+				organisationID: orgID,
+				pagination: &domain.Pagination{
+					Limit:       10,
+					CurrentPage: 1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: list filtered audit logs",
+			args: args{
+				ctx:            context.Background(),
+				organisationID: orgID,
+				filter: &dto.AuditLogFilterInput{
+					RecordType: &recordType,
+					TargetID:   &clientID,
+					ProgramID:  &programID,
+				},
+				pagination: &domain.Pagination{
+					Limit:       10,
+					CurrentPage: 1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid program id",
+			args: args{
+				ctx:            context.Background(),
+				organisationID: orgID,
+				filter: &dto.AuditLogFilterInput{
+					ProgramID: &invalidProgramID,
+				},
+				pagination: &domain.Pagination{
+					Limit:       10,
+					CurrentPage: 1,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := testingDB.ListAuditLogs(tt.args.ctx, tt.args.organisationID, tt.args.filter, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.ListAuditLogs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected audit logs to be returned")
+			}
+		})
+	}
+}
+
+func TestPGInstance_GetAuthorityRoleByID(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		roleID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get role by id",
+			args: args{
+				ctx:    context.Background(),
+				roleID: customRoleID,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: role not found",
+			args: args{
+				ctx:    context.Background(),
+				roleID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.GetAuthorityRoleByID(tt.args.ctx, tt.args.roleID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.GetAuthorityRoleByID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && *got.AuthorityRoleID != tt.args.roleID {
+				t.Errorf("PGInstance.GetAuthorityRoleByID() got %v, want %v", *got.AuthorityRoleID, tt.args.roleID)
+			}
+		})
+	}
+}
+
+func TestPGInstance_GetAuthorityRolePermissions(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		roleID string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name: "Happy case: get role permissions",
+			args: args{
+				ctx:    context.Background(),
+				roleID: customRoleID,
+			},
+			wantCount: 1,
+			wantErr:   false,
+		},
+		{
+			name: "Sad case: invalid role id",
+			args: args{
+				ctx:    context.Background(),
+				roleID: "invalid-id",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.GetAuthorityRolePermissions(tt.args.ctx, tt.args.roleID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.GetAuthorityRolePermissions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) != tt.wantCount {
+				t.Errorf("PGInstance.GetAuthorityRolePermissions() got %v permissions, want %v", len(got), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestPGInstance_ListAuthorityRoles(t *testing.T) {
+	type args struct {
+		ctx            context.Context
+		organisationID string
+		programID      string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list program and organisation roles",
+			args: args{
+				ctx:            context.Background(),
+				organisationID: orgID,
+				programID:      programID,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: list organisation roles",
+			args: args{
+				ctx:            context.Background(),
+				organisationID: orgID,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid organisation id",
+			args: args{
+				ctx:            context.Background(),
+				organisationID: "invalid-id",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.ListAuthorityRoles(tt.args.ctx, tt.args.organisationID, tt.args.programID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.ListAuthorityRoles() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected roles to be returned")
+			}
+		})
+	}
+}
+
+func TestPGInstance_ListAuthorityRoleStaff(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		roleID     string
+		pagination *domain.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list role staff",
+			args: args{
+				ctx:    context.Background(),
+				roleID: systemAdminRoleID,
+				pagination: &domain.Pagination{
+					Limit:       10,
+					CurrentPage: 1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid role id",
+			args: args{
+				ctx:    context.Background(),
+				roleID: "invalid-id",
+				pagination: &domain.Pagination{
+					Limit:       10,
+					CurrentPage: 1,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := testingDB.ListAuthorityRoleStaff(tt.args.ctx, tt.args.roleID, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.ListAuthorityRoleStaff() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected role staff to be returned")
+			}
+		})
+	}
+}
+
+func TestPGInstance_CheckStaffHasRole(t *testing.T) {
+	type args struct {
+		ctx     context.Context
+		staffID string
+		roleID  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "Happy case: staff has role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: staffWithRolesID,
+				roleID:  systemAdminRoleID,
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Happy case: staff does not have role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: staffWithRolesID,
+				roleID:  customRoleID,
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid staff id",
+			args: args{
+				ctx:     context.Background(),
+				staffID: "invalid-id",
+				roleID:  systemAdminRoleID,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.CheckStaffHasRole(tt.args.ctx, tt.args.staffID, tt.args.roleID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.CheckStaffHasRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("PGInstance.CheckStaffHasRole() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPGInstance_VerifyOTP_MaxAttempts(t *testing.T) {
+	ctx := addRequiredContext(context.Background(), t)
+	phone := "+254710000222"
+
+	otp := &gorm.UserOTP{
+		UserID:      userID,
+		Valid:       true,
+		GeneratedAt: time.Now(),
+		ValidUntil:  time.Now().Add(time.Hour),
+		Channel:     "SMS",
+		PhoneNumber: phone,
+		OTP:         "4321",
+		Flavour:     feedlib.FlavourConsumer,
+	}
+	if err := testingDB.SaveOTP(ctx, otp); err != nil {
+		t.Errorf("failed to save otp: %v", err)
+		return
+	}
+
+	for i := 0; i < helpers.GetOTPMaxVerifyAttempts(); i++ {
+		ok, err := testingDB.VerifyOTP(ctx, &dto.VerifyOTPInput{PhoneNumber: phone, OTP: "0000", Flavour: feedlib.FlavourConsumer})
+		if err != nil || ok {
+			t.Errorf("expected failed verification, got %v, %v", ok, err)
+			return
+		}
+	}
+
+	ok, err := testingDB.VerifyOTP(ctx, &dto.VerifyOTPInput{PhoneNumber: phone, OTP: "4321", Flavour: feedlib.FlavourConsumer})
+	if err != nil {
+		t.Errorf("PGInstance.VerifyOTP() error = %v", err)
+		return
+	}
+	if ok {
+		t.Errorf("expected the otp to be invalidated after the maximum number of attempts")
+	}
+
+	// Teardown
+	if err = testingDB.DB.Where("phonenumber", phone).Unscoped().Delete(&gorm.UserOTP{}).Error; err != nil {
+		t.Errorf("failed to delete otp: %v", err)
+	}
+}
+
+func TestPGInstance_GetRecentOTPsCount(t *testing.T) {
+	type args struct {
+		ctx         context.Context
+		phoneNumber string
+		since       time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Happy case: count otps sent in the last hour",
+			args: args{
+				ctx:         context.Background(),
+				phoneNumber: testPhone,
+				since:       time.Now().Add(-time.Hour),
+			},
+			want: 1,
+		},
+		{
+			name: "Happy case: no otps sent to phone",
+			args: args{
+				ctx:         context.Background(),
+				phoneNumber: gofakeit.Phone(),
+				since:       time.Now().Add(-time.Hour),
+			},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.GetRecentOTPsCount(tt.args.ctx, tt.args.phoneNumber, tt.args.since)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.GetRecentOTPsCount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got < tt.want {
+				t.Errorf("PGInstance.GetRecentOTPsCount() = %v, want at least %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPGInstance_ListAppointmentsPendingReminder(t *testing.T) {
+	ctx := context.Background()
+	offsetDays := 30
+
+	var appointment gorm.Appointment
+	if err := testingDB.DB.Where("id = ?", appointmentID).First(&appointment).Error; err != nil {
+		t.Errorf("failed to get appointment: %v", err)
+		return
+	}
+
+	appointments, err := testingDB.ListAppointmentsPendingReminder(ctx, appointment.Date, offsetDays)
+	if err != nil {
+		t.Errorf("PGInstance.ListAppointmentsPendingReminder() error = %v", err)
+		return
+	}
+	if !containsAppointment(appointments, appointmentID) {
+		t.Errorf("expected appointment %s to be pending a reminder", appointmentID)
+		return
+	}
+
+	err = testingDB.CreateAppointmentReminder(ctx, &gorm.AppointmentReminder{
+		AppointmentID: appointmentID,
+		OffsetDays:    offsetDays,
+		SentAt:        time.Now(),
+	})
+	if err != nil {
+		t.Errorf("failed to create appointment reminder: %v", err)
+		return
+	}
+
+	appointments, err = testingDB.ListAppointmentsPendingReminder(ctx, appointment.Date, offsetDays)
+	if err != nil {
+		t.Errorf("PGInstance.ListAppointmentsPendingReminder() error = %v", err)
+		return
+	}
+	if containsAppointment(appointments, appointmentID) {
+		t.Errorf("expected appointment %s not to be pending a reminder after one was sent", appointmentID)
+	}
+
+	if err := testingDB.DB.Where("appointment_id = ?", appointmentID).Unscoped().Delete(&gorm.AppointmentReminder{}).Error; err != nil {
+		t.Errorf("failed to delete appointment reminders: %v", err)
+	}
+}
+
+func containsAppointment(appointments []*gorm.Appointment, id string) bool {
+	for _, appointment := range appointments {
+		if appointment.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestPGInstance_GetNotificationPreferences(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		userID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get notification preferences",
+			args: args{
+				ctx:    context.Background(),
+				userID: userID,
+			},
+		},
+		{
+			name: "Sad case: invalid user id",
+			args: args{
+				ctx:    context.Background(),
+				userID: "invalid",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testingDB.GetNotificationPreferences(tt.args.ctx, tt.args.userID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.GetNotificationPreferences() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPGInstance_ListMissedAppointments(t *testing.T) {
+	ctx := context.Background()
+
+	var appointment gorm.Appointment
+	if err := testingDB.DB.Where("id = ?", appointmentID).First(&appointment).Error; err != nil {
+		t.Errorf("failed to get appointment: %v", err)
+		return
+	}
+
+	appointments, err := testingDB.ListMissedAppointments(ctx, appointment.Date.AddDate(0, 0, 1))
+	if err != nil {
+		t.Errorf("PGInstance.ListMissedAppointments() error = %v", err)
+		return
+	}
+	if !containsAppointment(appointments, appointmentID) {
+		t.Errorf("expected appointment %s to be missed", appointmentID)
+		return
+	}
+
+	appointments, err = testingDB.ListMissedAppointments(ctx, appointment.Date)
+	if err != nil {
+		t.Errorf("PGInstance.ListMissedAppointments() error = %v", err)
+		return
+	}
+	if containsAppointment(appointments, appointmentID) {
+		t.Errorf("expected appointment %s not to be missed before its date", appointmentID)
+		return
+	}
+
+	// an appointment updated from KenyaEMR after its date was attended
+	if err := testingDB.DB.Model(&gorm.Appointment{}).Where("id = ?", appointmentID).Update("last_synced_at", appointment.Date.AddDate(0, 0, 1)).Error; err != nil {
+		t.Errorf("failed to update appointment: %v", err)
+		return
+	}
+
+	appointments, err = testingDB.ListMissedAppointments(ctx, appointment.Date.AddDate(0, 0, 1))
+	if err != nil {
+		t.Errorf("PGInstance.ListMissedAppointments() error = %v", err)
+		return
+	}
+	if containsAppointment(appointments, appointmentID) {
+		t.Errorf("expected appointment %s synced after its date not to be missed", appointmentID)
+	}
+
+	if err := testingDB.DB.Model(&gorm.Appointment{}).Where("id = ?", appointmentID).Update("last_synced_at", nil).Error; err != nil {
+		t.Errorf("failed to reset appointment: %v", err)
+	}
+}
+
+func TestPGInstance_GetAppointmentSlot(t *testing.T) {
+	ctx := context.Background()
+
+	slot := &gorm.AppointmentSlot{
+		Active:     true,
+		FacilityID: facilityID,
+		DayOfWeek:  int(time.Tuesday),
+		StartTime:  "08:00",
+		EndTime:    "12:00",
+		Capacity:   10,
+		Reasons:    []string{"Pharmacy Visit"},
+	}
+	if err := testingDB.CreateAppointmentSlot(ctx, slot); err != nil {
+		t.Errorf("failed to create appointment slot: %v", err)
+		return
+	}
+
+	got, err := testingDB.GetAppointmentSlot(ctx, slot.ID)
+	if err != nil {
+		t.Errorf("PGInstance.GetAppointmentSlot() error = %v", err)
+		return
+	}
+	if got.ID != slot.ID || len(got.Reasons) != 1 {
+		t.Errorf("PGInstance.GetAppointmentSlot() = %v, want %v", got, slot)
+	}
+
+	_, err = testingDB.GetAppointmentSlot(ctx, uuid.New().String())
+	if err == nil {
+		t.Errorf("expected an error when getting an appointment slot that does not exist")
+	}
+
+	if err := testingDB.DB.Where("id = ?", slot.ID).Unscoped().Delete(&gorm.AppointmentSlot{}).Error; err != nil {
+		t.Errorf("failed to delete appointment slot: %v", err)
+	}
+}
+
+func TestPGInstance_ListAppointmentSlots(t *testing.T) {
+	ctx := context.Background()
+
+	active := &gorm.AppointmentSlot{
+		Active:     true,
+		FacilityID: facilityID,
+		DayOfWeek:  int(time.Wednesday),
+		StartTime:  "08:00",
+		EndTime:    "12:00",
+		Capacity:   10,
+		Reasons:    []string{"Pharmacy Visit"},
+	}
+	inactive := &gorm.AppointmentSlot{
+		Active:     true,
+		FacilityID: facilityID,
+		DayOfWeek:  int(time.Wednesday),
+		StartTime:  "13:00",
+		EndTime:    "16:00",
+		Capacity:   10,
+		Reasons:    []string{"Pharmacy Visit"},
+	}
+	for _, slot := range []*gorm.AppointmentSlot{active, inactive} {
+		if err := testingDB.CreateAppointmentSlot(ctx, slot); err != nil {
+			t.Errorf("failed to create appointment slot: %v", err)
+			return
+		}
+	}
+	if err := testingDB.DeactivateAppointmentSlot(ctx, inactive.ID); err != nil {
+		t.Errorf("failed to deactivate appointment slot: %v", err)
+		return
+	}
+
+	slots, err := testingDB.ListAppointmentSlots(ctx, facilityID)
+	if err != nil {
+		t.Errorf("PGInstance.ListAppointmentSlots() error = %v", err)
+		return
+	}
+
+	listed := map[string]bool{}
+	for _, slot := range slots {
+		listed[slot.ID] = true
+	}
+	if !listed[active.ID] {
+		t.Errorf("expected active appointment slot %s to be listed", active.ID)
+	}
+	if listed[inactive.ID] {
+		t.Errorf("expected inactive appointment slot %s not to be listed", inactive.ID)
+	}
+
+	if err := testingDB.DB.Where("id IN ?", []string{active.ID, inactive.ID}).Unscoped().Delete(&gorm.AppointmentSlot{}).Error; err != nil {
+		t.Errorf("failed to delete appointment slots: %v", err)
+	}
+}
+
+func TestPGInstance_GetAppointmentSlotBookingCounts(t *testing.T) {
+	ctx := context.Background()
+	date := time.Date(2030, 1, 9, 0, 0, 0, 0, time.UTC)
+
+	slot := &gorm.AppointmentSlot{
+		Active:     true,
+		FacilityID: facilityID,
+		DayOfWeek:  int(date.Weekday()),
+		StartTime:  "08:00",
+		EndTime:    "12:00",
+		Capacity:   10,
+		Reasons:    []string{"Pharmacy Visit"},
+	}
+	if err := testingDB.CreateAppointmentSlot(ctx, slot); err != nil {
+		t.Errorf("failed to create appointment slot: %v", err)
+		return
+	}
+
+	for i := 0; i < 2; i++ {
+		err := testingDB.DB.Create(&gorm.Appointment{
+			Active:         true,
+			Reason:         "Pharmacy Visit",
+			Date:           date,
+			ClientID:       clientID,
+			FacilityID:     facilityID,
+			ProgramID:      programID,
+			OrganisationID: orgID,
+			SlotID:         &slot.ID,
+		}).Error
+		if err != nil {
+			t.Errorf("failed to create appointment: %v", err)
+			return
+		}
+	}
+
+	counts, err := testingDB.GetAppointmentSlotBookingCounts(ctx, facilityID, date, date)
+	if err != nil {
+		t.Errorf("PGInstance.GetAppointmentSlotBookingCounts() error = %v", err)
+		return
+	}
+
+	var booked int
+	for _, count := range counts {
+		if count.SlotID == slot.ID {
+			booked = count.Count
+		}
+	}
+	if booked != 2 {
+		t.Errorf("PGInstance.GetAppointmentSlotBookingCounts() = %v bookings, want %v", booked, 2)
+	}
+
+	if err := testingDB.DB.Where("slot_id = ?", slot.ID).Unscoped().Delete(&gorm.Appointment{}).Error; err != nil {
+		t.Errorf("failed to delete appointments: %v", err)
+	}
+	if err := testingDB.DB.Where("id = ?", slot.ID).Unscoped().Delete(&gorm.AppointmentSlot{}).Error; err != nil {
+		t.Errorf("failed to delete appointment slot: %v", err)
+	}
+}
+
+func TestPGInstance_ListOverdueServiceRequests(t *testing.T) {
+	ctx := context.Background()
+
+	serviceRequest := &gorm.ClientServiceRequest{
+		Active:         true,
+		RequestType:    enums.ServiceRequestTypeRedFlag.String(),
+		Request:        gofakeit.Sentence(5),
+		Status:         enums.ServiceRequestStatusPending.String(),
+		ClientID:       clientID,
+		FacilityID:     facilityID,
+		ProgramID:      programID,
+		OrganisationID: orgID,
+	}
+	if err := testingDB.DB.Create(serviceRequest).Error; err != nil {
+		t.Errorf("failed to create service request: %v", err)
+		return
+	}
+
+	containsServiceRequest := func(serviceRequests []*gorm.ClientServiceRequest) bool {
+		for _, overdue := range serviceRequests {
+			if *overdue.ID == *serviceRequest.ID {
+				return true
+			}
+		}
+		return false
+	}
+
+	serviceRequests, err := testingDB.ListOverdueServiceRequests(ctx, serviceRequest.RequestType, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Errorf("PGInstance.ListOverdueServiceRequests() error = %v", err)
+		return
+	}
+	if !containsServiceRequest(serviceRequests) {
+		t.Errorf("expected service request %s to be overdue", *serviceRequest.ID)
+	}
+
+	serviceRequests, err = testingDB.ListOverdueServiceRequests(ctx, serviceRequest.RequestType, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Errorf("PGInstance.ListOverdueServiceRequests() error = %v", err)
+		return
+	}
+	if containsServiceRequest(serviceRequests) {
+		t.Errorf("expected service request %s not to be overdue before its SLA", *serviceRequest.ID)
+	}
+
+	if err := testingDB.DB.Model(&gorm.ClientServiceRequest{}).Where("id = ?", serviceRequest.ID).Update("escalated_at", time.Now()).Error; err != nil {
+		t.Errorf("failed to update service request: %v", err)
+		return
+	}
+
+	serviceRequests, err = testingDB.ListOverdueServiceRequests(ctx, serviceRequest.RequestType, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Errorf("PGInstance.ListOverdueServiceRequests() error = %v", err)
+		return
+	}
+	if containsServiceRequest(serviceRequests) {
+		t.Errorf("expected escalated service request %s not to be listed", *serviceRequest.ID)
+	}
+
+	if err := testingDB.DB.Where("id = ?", serviceRequest.ID).Unscoped().Delete(&gorm.ClientServiceRequest{}).Error; err != nil {
+		t.Errorf("failed to delete service request: %v", err)
+	}
+}
+
+func TestPGInstance_ListServiceRequestComments(t *testing.T) {
+	ctx := context.Background()
+
+	comment := &gorm.ServiceRequestComment{
+		Active:           true,
+		ServiceRequestID: serviceRequestID,
+		StaffID:          staffID,
+		Comment:          gofakeit.Sentence(5),
+	}
+	if err := testingDB.DB.Create(comment).Error; err != nil {
+		t.Errorf("failed to create service request comment: %v", err)
+		return
+	}
+
+	comments, err := testingDB.ListServiceRequestComments(ctx, serviceRequestID)
+	if err != nil {
+		t.Errorf("PGInstance.ListServiceRequestComments() error = %v", err)
+		return
+	}
+	found := false
+	for _, c := range comments {
+		if c.ID == comment.ID {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected comment %s to be listed", comment.ID)
+	}
+
+	if err := testingDB.DB.Where("id = ?", comment.ID).Unscoped().Delete(&gorm.ServiceRequestComment{}).Error; err != nil {
+		t.Errorf("failed to delete service request comment: %v", err)
+	}
+}
+
+func TestPGInstance_ListServiceRequestEvents(t *testing.T) {
+	ctx := context.Background()
+
+	event := &gorm.ServiceRequestEvent{
+		ServiceRequestID: serviceRequestID,
+		EventType:        enums.ServiceRequestEventTypeInProgress.String(),
+		StaffID:          &staffID,
+		Status:           enums.ServiceRequestStatusInProgress.String(),
+	}
+	if err := testingDB.DB.Create(event).Error; err != nil {
+		t.Errorf("failed to create service request event: %v", err)
+		return
+	}
+
+	events, err := testingDB.ListServiceRequestEvents(ctx, serviceRequestID)
+	if err != nil {
+		t.Errorf("PGInstance.ListServiceRequestEvents() error = %v", err)
+		return
+	}
+	if len(events) == 0 || events[len(events)-1].ID != event.ID {
+		t.Errorf("expected event %s to be the latest in the history", event.ID)
+	}
+
+	if err := testingDB.DB.Where("id = ?", event.ID).Unscoped().Delete(&gorm.ServiceRequestEvent{}).Error; err != nil {
+		t.Errorf("failed to delete service request event: %v", err)
+	}
+}
+
+func TestPGInstance_GetScreeningToolScoreBands(t *testing.T) {
+	ctx := context.Background()
+	maxScore := 4
+
+	scoreBands := []*gorm.ScreeningToolScoreBand{
+		{
+			Active:          true,
+			ScreeningToolID: screeningToolID,
+			Label:           "Moderate",
+			MinScore:        5,
+			Action:          enums.ScreeningToolBandActionNotifyStaff.String(),
+			ProgramID:       programID,
+			OrganisationID:  orgID,
+		},
+		{
+			Active:          true,
+			ScreeningToolID: screeningToolID,
+			Label:           "Mild",
+			MinScore:        0,
+			MaxScore:        &maxScore,
+			Action:          enums.ScreeningToolBandActionNone.String(),
+			ProgramID:       programID,
+			OrganisationID:  orgID,
+		},
+	}
+	for _, scoreBand := range scoreBands {
+		if err := testingDB.DB.Create(scoreBand).Error; err != nil {
+			t.Errorf("failed to create screening tool score band: %v", err)
+			return
+		}
+	}
+
+	got, err := testingDB.GetScreeningToolScoreBands(ctx, screeningToolID)
+	if err != nil {
+		t.Errorf("PGInstance.GetScreeningToolScoreBands() error = %v", err)
+		return
+	}
+	if len(got) != 2 || got[0].Label != "Mild" || got[1].Label != "Moderate" {
+		t.Errorf("expected score bands ordered from the lowest score, got %v", got)
+	}
+
+	for _, scoreBand := range scoreBands {
+		if err := testingDB.DB.Where("id = ?", scoreBand.ID).Unscoped().Delete(&gorm.ScreeningToolScoreBand{}).Error; err != nil {
+			t.Errorf("failed to delete screening tool score band: %v", err)
+		}
+	}
+}
+
+func TestPGInstance_GetQuestionConditionsByQuestionID(t *testing.T) {
+	ctx := context.Background()
+
+	question := &gorm.Question{
+		Active:            true,
+		QuestionnaireID:   questionnaireID,
+		Text:              "How long have you had a fever?",
+		QuestionType:      enums.QuestionTypeOpenEnded.String(),
+		ResponseValueType: enums.QuestionResponseValueTypeString.String(),
+		Sequence:          2,
+		ProgramID:         programID,
+		OrganisationID:    orgID,
+	}
+	if err := testingDB.DB.Create(question).Error; err != nil {
+		t.Errorf("failed to create question: %v", err)
+		return
+	}
+
+	condition := &gorm.QuestionCondition{
+		Active:              true,
+		QuestionID:          question.ID,
+		DependsOnQuestionID: questionID,
+		Operator:            enums.QuestionConditionOperatorIn.String(),
+		Target:              enums.QuestionConditionTargetChoice.String(),
+		Values:              []string{"0", "1"},
+		ProgramID:           programID,
+		OrganisationID:      orgID,
+	}
+	if err := testingDB.DB.Create(condition).Error; err != nil {
+		t.Errorf("failed to create question condition: %v", err)
+		return
+	}
+
+	got, err := testingDB.GetQuestionConditionsByQuestionID(ctx, question.ID)
+	if err != nil {
+		t.Errorf("PGInstance.GetQuestionConditionsByQuestionID() error = %v", err)
+		return
+	}
+	if len(got) != 1 || got[0].DependsOnQuestionID != questionID || len(got[0].Values) != 2 {
+		t.Errorf("expected the question condition to be returned, got %v", got)
+	}
+
+	got, err = testingDB.GetQuestionConditionsByQuestionID(ctx, questionID)
+	if err != nil {
+		t.Errorf("PGInstance.GetQuestionConditionsByQuestionID() error = %v", err)
+		return
+	}
+	if len(got) != 0 {
+		t.Errorf("expected no conditions for an unconditional question, got %v", got)
+	}
+
+	if err := testingDB.DB.Where("id = ?", condition.ID).Unscoped().Delete(&gorm.QuestionCondition{}).Error; err != nil {
+		t.Errorf("failed to delete question condition: %v", err)
+	}
+	if err := testingDB.DB.Where("id = ?", question.ID).Unscoped().Delete(&gorm.Question{}).Error; err != nil {
+		t.Errorf("failed to delete question: %v", err)
+	}
+}
+
+func TestPGInstance_ListScreeningToolVersions(t *testing.T) {
+	ctx := context.Background()
+
+	versions := []*gorm.ScreeningToolVersion{
+		{
+			Active:          true,
+			ScreeningToolID: screeningToolID,
+			QuestionnaireID: questionnaireID,
+			Version:         201,
+			Status:          enums.ScreeningToolVersionStatusRetired.String(),
+			ProgramID:       programID,
+			OrganisationID:  orgID,
+		},
+		{
+			Active:          true,
+			ScreeningToolID: screeningToolID,
+			QuestionnaireID: questionnaireID,
+			Version:         200,
+			Status:          enums.ScreeningToolVersionStatusRetired.String(),
+			ProgramID:       programID,
+			OrganisationID:  orgID,
+		},
+	}
+	for _, version := range versions {
+		if err := testingDB.DB.Create(version).Error; err != nil {
+			t.Errorf("failed to create screening tool version: %v", err)
+			return
+		}
+	}
+
+	got, err := testingDB.ListScreeningToolVersions(ctx, screeningToolID)
+	if err != nil {
+		t.Errorf("PGInstance.ListScreeningToolVersions() error = %v", err)
+		return
+	}
+	if len(got) < 2 || got[len(got)-2].Version != 200 || got[len(got)-1].Version != 201 {
+		t.Errorf("expected screening tool versions ordered from the oldest, got %v", got)
+	}
+
+	for _, version := range versions {
+		if err := testingDB.DB.Where("id = ?", version.ID).Unscoped().Delete(&gorm.ScreeningToolVersion{}).Error; err != nil {
+			t.Errorf("failed to delete screening tool version: %v", err)
+		}
+	}
+}
+
+func TestPGInstance_ListScreeningToolAssignments(t *testing.T) {
+	ctx := context.Background()
+
+	assignment := &gorm.ScreeningToolAssignment{
+		Active:          true,
+		ScreeningToolID: screeningToolID,
+		ClientID:        clientID,
+		StaffID:         staffID,
+		DueDate:         time.Now().AddDate(0, 0, 7),
+		Status:          enums.ScreeningToolAssignmentStatusPending.String(),
+		ProgramID:       programID,
+		OrganisationID:  orgID,
+	}
+	if err := testingDB.DB.Create(assignment).Error; err != nil {
+		t.Errorf("failed to create screening tool assignment: %v", err)
+		return
+	}
+
+	assignments, err := testingDB.ListScreeningToolAssignments(ctx, &gorm.ScreeningToolAssignment{
+		ClientID: clientID,
+		Status:   enums.ScreeningToolAssignmentStatusPending.String(),
+	})
+	if err != nil {
+		t.Errorf("PGInstance.ListScreeningToolAssignments() error = %v", err)
+	}
+	if len(assignments) == 0 {
+		t.Errorf("expected the pending screening tool assignment to be listed")
+	}
+
+	assignments, err = testingDB.ListScreeningToolAssignments(ctx, &gorm.ScreeningToolAssignment{
+		ClientID: clientID,
+		Status:   enums.ScreeningToolAssignmentStatusCancelled.String(),
+	})
+	if err != nil {
+		t.Errorf("PGInstance.ListScreeningToolAssignments() error = %v", err)
+	}
+	if len(assignments) != 0 {
+		t.Errorf("expected no cancelled screening tool assignments, got %d", len(assignments))
+	}
+
+	if err := testingDB.DB.Where("id = ?", assignment.ID).Unscoped().Delete(&gorm.ScreeningToolAssignment{}).Error; err != nil {
+		t.Errorf("failed to delete screening tool assignment: %v", err)
+	}
+}
+
+func TestPGInstance_ListPendingScreeningToolAssignmentsDueBefore(t *testing.T) {
+	ctx := context.Background()
+
+	assignment := &gorm.ScreeningToolAssignment{
+		Active:          true,
+		ScreeningToolID: screeningToolID,
+		ClientID:        clientID,
+		StaffID:         staffID,
+		DueDate:         time.Now().Add(time.Hour),
+		Status:          enums.ScreeningToolAssignmentStatusPending.String(),
+		ProgramID:       programID,
+		OrganisationID:  orgID,
+	}
+	if err := testingDB.DB.Create(assignment).Error; err != nil {
+		t.Errorf("failed to create screening tool assignment: %v", err)
+		return
+	}
+
+	isListed := func(assignments []*gorm.ScreeningToolAssignment) bool {
+		for _, a := range assignments {
+			if a.ID == assignment.ID {
+				return true
+			}
+		}
+		return false
+	}
+
+	assignments, err := testingDB.ListPendingScreeningToolAssignmentsDueBefore(ctx, time.Now().AddDate(0, 0, 1))
+	if err != nil {
+		t.Errorf("PGInstance.ListPendingScreeningToolAssignmentsDueBefore() error = %v", err)
+	}
+	if !isListed(assignments) {
+		t.Errorf("expected the screening tool assignment due within a day to be listed")
+	}
+
+	assignments, err = testingDB.ListPendingScreeningToolAssignmentsDueBefore(ctx, time.Now())
+	if err != nil {
+		t.Errorf("PGInstance.ListPendingScreeningToolAssignmentsDueBefore() error = %v", err)
+	}
+	if isListed(assignments) {
+		t.Errorf("expected the screening tool assignment due in an hour not to be listed")
+	}
+
+	if err := testingDB.DB.Where("id = ?", assignment.ID).Unscoped().Delete(&gorm.ScreeningToolAssignment{}).Error; err != nil {
+		t.Errorf("failed to delete screening tool assignment: %v", err)
+	}
+}
+
+func TestPGInstance_ListClientHealthDiaryEntriesSince(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		clientID string
+		since    time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list client health diary entries",
+			args: args{
+				ctx:      context.Background(),
+				clientID: clientID,
+				since:    time.Now().AddDate(0, 0, -30),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: failed to list client health diary entries, invalid client id",
+			args: args{
+				ctx:      context.Background(),
+				clientID: "invalid",
+				since:    time.Now().AddDate(0, 0, -30),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testingDB.ListClientHealthDiaryEntriesSince(tt.args.ctx, tt.args.clientID, tt.args.since)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.ListClientHealthDiaryEntriesSince() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestPGInstance_ListClientIDsWithHealthDiaryEntriesSince(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		since time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list clients with health diary entries",
+			args: args{
+				ctx:   context.Background(),
+				since: time.Now().AddDate(0, 0, -30),
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testingDB.ListClientIDsWithHealthDiaryEntriesSince(tt.args.ctx, tt.args.since)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.ListClientIDsWithHealthDiaryEntriesSince() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestPGInstance_GetHealthDiaryShareByID(t *testing.T) {
+	type args struct {
+		ctx     context.Context
+		shareID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get health diary share",
+			args: args{
+				ctx:     context.Background(),
+				shareID: healthDiaryShareID,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid share ID",
+			args: args{
+				ctx:     context.Background(),
+				shareID: gofakeit.HipsterSentence(10),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.GetHealthDiaryShareByID(tt.args.ctx, tt.args.shareID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.GetHealthDiaryShareByID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected a health diary share to be returned")
+			}
+		})
+	}
+}
+
+func TestPGInstance_ListClientHealthDiaryShares(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		clientID string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name: "Happy case: list client health diary shares",
+			args: args{
+				ctx:      context.Background(),
+				clientID: clientID,
+			},
+			wantCount: 2,
+			wantErr:   false,
+		},
+		{
+			name: "Sad case: invalid client ID",
+			args: args{
+				ctx:      context.Background(),
+				clientID: gofakeit.HipsterSentence(10),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.ListClientHealthDiaryShares(tt.args.ctx, tt.args.clientID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.ListClientHealthDiaryShares() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) < tt.wantCount {
+				t.Errorf("expected at least %v health diary shares, got %v", tt.wantCount, len(got))
+			}
+		})
+	}
+}
+
+func TestPGInstance_GetCaregiverSharedHealthDiaryEntries(t *testing.T) {
+	type args struct {
+		ctx         context.Context
+		clientID    string
+		caregiverID string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name: "Happy case: get entries shared with caregiver",
+			args: args{
+				ctx:         context.Background(),
+				clientID:    clientID,
+				caregiverID: testCaregiverID,
+			},
+			wantCount: 1,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: caregiver without a grant",
+			args: args{
+				ctx:         context.Background(),
+				clientID:    clientID,
+				caregiverID: uuid.New().String(),
+			},
+			wantCount: 0,
+			wantErr:   false,
+		},
+		{
+			name: "Sad case: invalid caregiver ID",
+			args: args{
+				ctx:         context.Background(),
+				clientID:    clientID,
+				caregiverID: gofakeit.HipsterSentence(10),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.GetCaregiverSharedHealthDiaryEntries(tt.args.ctx, tt.args.clientID, tt.args.caregiverID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.GetCaregiverSharedHealthDiaryEntries() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) != tt.wantCount {
+				t.Errorf("expected %v shared health diary entries, got %v", tt.wantCount, len(got))
+			}
+		})
+	}
+}
+
+func TestPGInstance_GetSurveyCampaignByID(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		campaignID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get survey campaign",
+			args: args{
+				ctx:        context.Background(),
+				campaignID: surveyCampaignID,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid campaign ID",
+			args: args{
+				ctx:        context.Background(),
+				campaignID: gofakeit.HipsterSentence(10),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.GetSurveyCampaignByID(tt.args.ctx, tt.args.campaignID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.GetSurveyCampaignByID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected a survey campaign to be returned")
+			}
+		})
+	}
+}
+
+func TestPGInstance_ListSurveyCampaigns(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		facilityID string
+		programID  string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name: "Happy case: list survey campaigns",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: facilityID,
+				programID:  programID,
+			},
+			wantCount: 1,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: campaigns of another program are not returned",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: facilityID,
+				programID:  gofakeit.UUID(),
+			},
+			wantCount: 0,
+			wantErr:   false,
+		},
+		{
+			name: "Sad case: invalid facility ID",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.HipsterSentence(10),
+				programID:  programID,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.ListSurveyCampaigns(tt.args.ctx, tt.args.facilityID, tt.args.programID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.ListSurveyCampaigns() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) < tt.wantCount {
+				t.Errorf("expected at least %v survey campaigns, got %v", tt.wantCount, len(got))
+			}
+			if !tt.wantErr && tt.wantCount == 0 && len(got) != 0 {
+				t.Errorf("expected no survey campaigns, got %v", len(got))
+			}
+		})
+	}
+}
+
+func TestPGInstance_ListOngoingSurveyCampaigns(t *testing.T) {
+	got, err := testingDB.ListOngoingSurveyCampaigns(context.Background())
+	if err != nil {
+		t.Errorf("PGInstance.ListOngoingSurveyCampaigns() error = %v", err)
+		return
+	}
+	if len(got) == 0 {
+		t.Errorf("expected ongoing survey campaigns to be returned")
+	}
+	for _, campaign := range got {
+		if campaign.Status != enums.SurveyCampaignStatusScheduled.String() && campaign.Status != enums.SurveyCampaignStatusActive.String() {
+			t.Errorf("expected only scheduled and active survey campaigns, got %v", campaign.Status)
+		}
+	}
+}
+
+func TestPGInstance_ListSurveyRedFlagRules(t *testing.T) {
+	type args struct {
+		ctx       context.Context
+		projectID int
+		formID    string
+		programID string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name: "Happy case: list survey red flag rules",
+			args: args{
+				ctx:       context.Background(),
+				projectID: 1,
+				formID:    formID,
+				programID: programID,
+			},
+			wantCount: 1,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: form without rules",
+			args: args{
+				ctx:       context.Background(),
+				projectID: 1,
+				formID:    gofakeit.UUID(),
+				programID: programID,
+			},
+			wantCount: 0,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: rules of another program are not returned",
+			args: args{
+				ctx:       context.Background(),
+				projectID: 1,
+				formID:    formID,
+				programID: gofakeit.UUID(),
+			},
+			wantCount: 0,
+			wantErr:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.ListSurveyRedFlagRules(tt.args.ctx, tt.args.projectID, tt.args.formID, tt.args.programID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.ListSurveyRedFlagRules() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != tt.wantCount {
+				t.Errorf("PGInstance.ListSurveyRedFlagRules() got %d rules, want %d", len(got), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestPGInstance_GetSurveyRedFlagRuleByID(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		ruleID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get survey red flag rule",
+			args: args{
+				ctx:    context.Background(),
+				ruleID: surveyRedFlagRuleID,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: survey red flag rule not found",
+			args: args{
+				ctx:    context.Background(),
+				ruleID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.GetSurveyRedFlagRuleByID(tt.args.ctx, tt.args.ruleID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.GetSurveyRedFlagRuleByID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.ProgramID != programID {
+				t.Errorf("PGInstance.GetSurveyRedFlagRuleByID() got program %v, want %v", got.ProgramID, programID)
+			}
+		})
+	}
+}
+
+func TestPGInstance_ListAllCommunities(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list all communities",
+			ctx:     context.Background(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.ListAllCommunities(tt.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.ListAllCommunities() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("PGInstance.ListAllCommunities() expected communities")
+			}
+		})
+	}
+}
+
+func TestPGInstance_ListProgramClients(t *testing.T) {
+	type args struct {
+		ctx       context.Context
+		programID string
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantClients bool
+		wantErr     bool
+	}{
+		{
+			name: "Happy case: list program clients",
+			args: args{
+				ctx:       context.Background(),
+				programID: programID,
+			},
+			wantClients: true,
+			wantErr:     false,
+		},
+		{
+			name: "Happy case: program without clients",
+			args: args{
+				ctx:       context.Background(),
+				programID: gofakeit.UUID(),
+			},
+			wantClients: false,
+			wantErr:     false,
+		},
+		{
+			name: "Sad case: invalid program id",
+			args: args{
+				ctx:       context.Background(),
+				programID: "programID",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.ListProgramClients(tt.args.ctx, tt.args.programID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.ListProgramClients() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if (len(got) > 0) != tt.wantClients {
+				t.Errorf("PGInstance.ListProgramClients() got %d clients, wantClients %v", len(got), tt.wantClients)
+				return
+			}
+			for _, client := range got {
+				if client.User.UserID == nil {
+					t.Errorf("PGInstance.ListProgramClients() expected the client's user to be loaded")
+				}
+			}
+		})
+	}
+}
+
+func TestPGInstance_GetUserMatrixPassword(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		userID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get user matrix password",
+			args: args{
+				ctx:    context.Background(),
+				userID: userID,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: user does not exist",
+			args: args{
+				ctx:    context.Background(),
+				userID: uuid.NewString(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testingDB.GetUserMatrixPassword(tt.args.ctx, tt.args.userID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.GetUserMatrixPassword() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestPGInstance_ListUsersWithoutMatrixPassword(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list users without a matrix password",
+			ctx:     context.Background(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.ListUsersWithoutMatrixPassword(tt.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.ListUsersWithoutMatrixPassword() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			for _, user := range got {
+				if user.MatrixPassword != nil {
+					t.Errorf("PGInstance.ListUsersWithoutMatrixPassword() returned a user with a matrix password")
+				}
+			}
+		})
+	}
+}
+
+func TestPGInstance_ListCommunityCrisisKeywords(t *testing.T) {
+	type args struct {
+		ctx       context.Context
+		programID string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name: "Happy case: list community crisis keywords",
+			args: args{
+				ctx:       context.Background(),
+				programID: programID,
+			},
+			wantCount: 1,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: program without keywords",
+			args: args{
+				ctx:       context.Background(),
+				programID: gofakeit.UUID(),
+			},
+			wantCount: 0,
+			wantErr:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.ListCommunityCrisisKeywords(tt.args.ctx, tt.args.programID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.ListCommunityCrisisKeywords() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != tt.wantCount {
+				t.Errorf("PGInstance.ListCommunityCrisisKeywords() got %d keywords, want %d", len(got), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestPGInstance_CheckCrisisMessageFlagged(t *testing.T) {
+	type args struct {
+		ctx     context.Context
+		eventID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "Happy case: message that has not been flagged",
+			args: args{
+				ctx:     context.Background(),
+				eventID: "$" + gofakeit.UUID(),
+			},
+			want:    false,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.CheckCrisisMessageFlagged(tt.args.ctx, tt.args.eventID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.CheckCrisisMessageFlagged() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("PGInstance.CheckCrisisMessageFlagged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPGInstance_GetCommunityByRoomID(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		roomID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get community by room ID",
+			args: args{
+				ctx:    context.Background(),
+				roomID: roomID,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: room without a community",
+			args: args{
+				ctx:    context.Background(),
+				roomID: "!unknown:example.com",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testingDB.GetCommunityByRoomID(tt.args.ctx, tt.args.roomID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.GetCommunityByRoomID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.RoomID != tt.args.roomID {
+				t.Errorf("PGInstance.GetCommunityByRoomID() got room %s, want %s", got.RoomID, tt.args.roomID)
+			}
+		})
+	}
+}
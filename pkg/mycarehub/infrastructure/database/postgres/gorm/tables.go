@@ -121,16 +121,48 @@ type AuditLog struct {
 	RecordType string       `gorm:"column:record_type;not null"`
 	Notes      string       `gorm:"column:notes"`
 	Payload    pgtype.JSONB `gorm:"column:payload"`
+	ActorID    *string      `gorm:"column:actor_id"`
+	TargetID   string       `gorm:"column:target_id"`
+	TargetType string       `gorm:"column:target_type"`
+	OldValue   string       `gorm:"column:old_value"`
+	NewValue   string       `gorm:"column:new_value"`
 
-	OrganisationID string `gorm:"column:organisation_id;not null"`
+	OrganisationID string  `gorm:"column:organisation_id;not null"`
+	ProgramID      *string `gorm:"column:program_id"`
 }
 
-// BeforeCreate is a hook run before creating a new facility
+// BeforeCreate is a hook run before creating a new audit log
+// The actor defaults to the logged in user and the organisation and program default to the actor's current organisation and program
 func (a *AuditLog) BeforeCreate(tx *gorm.DB) (err error) {
 	ctx := tx.Statement.Context
 	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
 		a.CreatedBy = userID
+
+		if a.ActorID == nil {
+			a.ActorID = userID
+		}
+	}
+
+	if a.ActorID != nil && a.OrganisationID == "" {
+		var actor User
+		err = tx.Session(&gorm.Session{NewDB: true}).Where("id = ?", a.ActorID).Find(&actor).Error
+		if err != nil {
+			logrus.Println("could not get audit log actor profile")
+		}
+		a.OrganisationID = actor.CurrentOrganisationID
+		if a.ProgramID == nil && actor.CurrentProgramID != "" {
+			a.ProgramID = &actor.CurrentProgramID
+		}
+	}
+
+	if a.Timestamp.IsZero() {
+		a.Timestamp = time.Now()
 	}
+
+	if a.Payload.Status == pgtype.Undefined {
+		a.Payload.Status = pgtype.Null
+	}
+
 	id := uuid.New().String()
 	a.ID = &id
 
@@ -236,6 +268,9 @@ type User struct {
 	FailedSecurityCount    int        `gorm:"column:failed_security_count"`
 	PinUpdateRequired      bool       `gorm:"column:pin_update_required"`
 
+	// the encrypted password of the user's Matrix account. It is deliberately left out of the domain user
+	MatrixPassword *string `gorm:"column:matrix_password"`
+
 	CurrentOrganisationID string `gorm:"column:current_organisation_id"`
 	CurrentProgramID      string `gorm:"column:current_program_id"`
 	CurrentUserType       string `gorm:"column:current_usertype"`
@@ -526,14 +561,16 @@ func (SecurityQuestion) TableName() string {
 type UserOTP struct {
 	Base
 
-	OTPID       int             `gorm:"unique;column:id;autoincrement"`
-	Valid       bool            `gorm:"column:is_valid"`
-	GeneratedAt time.Time       `gorm:"column:generated_at"`
-	ValidUntil  time.Time       `gorm:"column:valid_until"`
-	Channel     string          `gorm:"column:channel"`
-	Flavour     feedlib.Flavour `gorm:"column:flavour"`
-	PhoneNumber string          `gorm:"column:phonenumber"`
-	OTP         string          `gorm:"column:otp"`
+	OTPID          int             `gorm:"unique;column:id;autoincrement"`
+	Valid          bool            `gorm:"column:is_valid"`
+	GeneratedAt    time.Time       `gorm:"column:generated_at"`
+	ValidUntil     time.Time       `gorm:"column:valid_until"`
+	Channel        string          `gorm:"column:channel"`
+	Flavour        feedlib.Flavour `gorm:"column:flavour"`
+	PhoneNumber    string          `gorm:"column:phonenumber"`
+	OTP            string          `gorm:"column:otp"`
+	Salt           string          `gorm:"column:salt"`
+	FailedAttempts int             `gorm:"column:failed_attempts"`
 
 	UserID string `gorm:"column:user_id"`
 }
@@ -830,25 +867,74 @@ func (c *ClientHealthDiaryEntry) TableName() string {
 	return "clients_healthdiaryentry"
 }
 
+// HealthDiaryShare models a grant that gives a facility or caregiver access to part of a client's health diary
+type HealthDiaryShare struct {
+	Base
+	OrganisationID string `gorm:"column:organisation_id"`
+
+	ID          string         `gorm:"primaryKey;column:id"`
+	Active      bool           `gorm:"column:active"`
+	ClientID    string         `gorm:"column:client_id"`
+	FacilityID  *string        `gorm:"column:facility_id"`
+	CaregiverID *string        `gorm:"column:caregiver_id"`
+	Scope       string         `gorm:"column:scope"`
+	From        *time.Time     `gorm:"column:from_date"`
+	To          *time.Time     `gorm:"column:to_date"`
+	EntryIDs    pq.StringArray `gorm:"type:text[];column:healthdiaryentry_ids"`
+	ExpiresAt   *time.Time     `gorm:"column:expires_at"`
+	RevokedAt   *time.Time     `gorm:"column:revoked_at"`
+	ProgramID   string         `gorm:"column:program_id"`
+}
+
+// BeforeCreate is a hook run before creating a health diary share
+func (h *HealthDiaryShare) BeforeCreate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		h.CreatedBy = userID
+	}
+	id := uuid.New().String()
+	h.ID = id
+
+	return
+}
+
+// BeforeUpdate is a hook called before updating a health diary share
+func (h *HealthDiaryShare) BeforeUpdate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		h.UpdatedBy = userID
+	}
+	return
+}
+
+// TableName references the table that we map data from
+func (HealthDiaryShare) TableName() string {
+	return "clients_healthdiaryshare"
+}
+
 // ClientServiceRequest maps the client service request table. It is used to
 // store the tasks for the healthcare staff on the platform
 type ClientServiceRequest struct {
 	Base
 
-	ID             *string    `gorm:"column:id"`
-	Active         bool       `gorm:"column:active"`
-	RequestType    string     `gorm:"column:request_type"`
-	Request        string     `gorm:"column:request"`
-	Status         string     `gorm:"column:status"`
-	InProgressAt   *time.Time `gorm:"column:in_progress_at"`
-	ResolvedAt     *time.Time `gorm:"column:resolved_at"`
-	InProgressByID *string    `gorm:"column:in_progress_by_id"`
-	Meta           string     `gorm:"column:meta"`
-	ProgramID      string     `gorm:"column:program_id"`
-	OrganisationID string     `gorm:"column:organisation_id"`
-	ResolvedByID   *string    `gorm:"column:resolved_by_id"`
-	FacilityID     string     `gorm:"column:facility_id"`
-	ClientID       string     `gorm:"column:client_id"`
+	ID                   *string    `gorm:"column:id"`
+	Active               bool       `gorm:"column:active"`
+	RequestType          string     `gorm:"column:request_type"`
+	Request              string     `gorm:"column:request"`
+	Status               string     `gorm:"column:status"`
+	InProgressAt         *time.Time `gorm:"column:in_progress_at"`
+	ResolvedAt           *time.Time `gorm:"column:resolved_at"`
+	InProgressByID       *string    `gorm:"column:in_progress_by_id"`
+	Meta                 string     `gorm:"column:meta"`
+	ProgramID            string     `gorm:"column:program_id"`
+	OrganisationID       string     `gorm:"column:organisation_id"`
+	ResolvedByID         *string    `gorm:"column:resolved_by_id"`
+	EscalatedAt          *time.Time `gorm:"column:escalated_at"`
+	EscalationBackfilled bool       `gorm:"column:escalation_backfilled"`
+	AssignedToID         *string    `gorm:"column:assigned_to_id"`
+	AssignedAt           *time.Time `gorm:"column:assigned_at"`
+	FacilityID           string     `gorm:"column:facility_id"`
+	ClientID             string     `gorm:"column:client_id"`
 }
 
 // BeforeCreate is a hook called before creating a service request.
@@ -877,6 +963,65 @@ func (ClientServiceRequest) TableName() string {
 	return "clients_servicerequest"
 }
 
+// ServiceRequestComment maps the internal comments left by staff on a client's service request
+type ServiceRequestComment struct {
+	Base
+
+	ID               string  `gorm:"primaryKey;column:id"`
+	Active           bool    `gorm:"column:active;not null"`
+	ServiceRequestID string  `gorm:"column:service_request_id;not null"`
+	ParentID         *string `gorm:"column:parent_id"`
+	StaffID          string  `gorm:"column:staff_id;not null"`
+	Comment          string  `gorm:"column:comment;not null"`
+}
+
+// BeforeCreate is a hook run before creating a service request comment
+func (c *ServiceRequestComment) BeforeCreate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		c.CreatedBy = userID
+	}
+
+	c.ID = uuid.New().String()
+
+	return
+}
+
+// TableName references the table that we map data from
+func (ServiceRequestComment) TableName() string {
+	return "clients_servicerequestcomment"
+}
+
+// ServiceRequestEvent maps the append only history of the actions taken on a client's service request
+type ServiceRequestEvent struct {
+	Base
+
+	ID               string  `gorm:"primaryKey;column:id"`
+	ServiceRequestID string  `gorm:"column:service_request_id;not null"`
+	EventType        string  `gorm:"column:event_type;not null"`
+	StaffID          *string `gorm:"column:staff_id"`
+	Status           string  `gorm:"column:status"`
+	AssignedToID     *string `gorm:"column:assigned_to_id"`
+	Notes            string  `gorm:"column:notes"`
+}
+
+// BeforeCreate is a hook run before creating a service request event
+func (e *ServiceRequestEvent) BeforeCreate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		e.CreatedBy = userID
+	}
+
+	e.ID = uuid.New().String()
+
+	return
+}
+
+// TableName references the table that we map data from
+func (ServiceRequestEvent) TableName() string {
+	return "clients_servicerequestevent"
+}
+
 // StaffServiceRequest maps the staffs's service request table. It is used to
 // store the tasks for the healthcare staff on the platform
 type StaffServiceRequest struct {
@@ -975,10 +1120,13 @@ type AuthorityRole struct {
 	Base
 	AuthorityRoleID *string `gorm:"column:id"`
 	Name            string  `gorm:"column:name"`
+	Description     string  `gorm:"column:description"`
 	Active          bool    `gorm:"column:active"`
+	IsSystemRole    bool    `gorm:"column:is_system_role"`
+	UserType        string  `gorm:"column:user_type"`
 
-	OrganisationID string `gorm:"column:organisation_id"`
-	ProgramID      string `gorm:"column:program_id"`
+	OrganisationID string  `gorm:"column:organisation_id"`
+	ProgramID      *string `gorm:"column:program_id"`
 }
 
 // BeforeCreate is a hook run before creating authority role
@@ -1012,6 +1160,7 @@ func (AuthorityRole) TableName() string {
 type AuthorityPermission struct {
 	Base
 	AuthorityPermissionID *string `gorm:"column:id"`
+	Active                bool    `gorm:"column:active"`
 	Name                  string  `gorm:"column:name"`
 	Description           string  `gorm:"column:description"`
 	Category              string  `gorm:"column:category"`
@@ -1056,6 +1205,18 @@ func (AuthorityRoleUser) TableName() string {
 	return "authority_authorityrole_users"
 }
 
+// AuthorityRoleStaff is the gorms authority role staff model
+type AuthorityRoleStaff struct {
+	ID      int     `gorm:"primaryKey;column:id;autoincrement"`
+	RoleID  *string `gorm:"column:authorityrole_id"`
+	StaffID *string `gorm:"column:staff_id"`
+}
+
+// TableName references the table that we map data from
+func (AuthorityRoleStaff) TableName() string {
+	return "authority_authorityrole_staff"
+}
+
 // AuthorityRolePermission is the gorms authority role permission model
 type AuthorityRolePermission struct {
 	ID           int     `gorm:"primaryKey;column:id;autoincrement"`
@@ -1084,6 +1245,7 @@ type Community struct {
 	ClientTypes    pq.StringArray `gorm:"type:text[];column:client_types"`
 	ProgramID      string         `gorm:"column:program_id"`
 	OrganisationID string         `gorm:"column:organisation_id"`
+	FacilityID     *string        `gorm:"column:facility_id"`
 }
 
 // BeforeCreate is a hook run before creating a community
@@ -1251,17 +1413,22 @@ func (r *RelatedPersonAddresses) TableName() string {
 type Appointment struct {
 	Base
 
-	ID                        string    `gorm:"primaryKey;column:id;"`
-	Active                    bool      `gorm:"column:active;not null"`
-	ExternalID                string    `gorm:"column:external_id"`
-	Reason                    string    `gorm:"column:reason"`
-	Provider                  string    `gorm:"column:provider"`
-	Date                      time.Time `gorm:"column:date"`
-	HasRescheduledAppointment bool      `gorm:"column:has_rescheduled_appointment"`
-	ProgramID                 string    `gorm:"column:program_id"`
-	OrganisationID            string    `gorm:"column:organisation_id;not null"`
-	ClientID                  string    `gorm:"column:client_id"`
-	FacilityID                string    `gorm:"column:facility_id"`
+	ID                        string     `gorm:"primaryKey;column:id;"`
+	Active                    bool       `gorm:"column:active;not null"`
+	ExternalID                string     `gorm:"column:external_id"`
+	Reason                    string     `gorm:"column:reason"`
+	Provider                  string     `gorm:"column:provider"`
+	Date                      time.Time  `gorm:"column:date"`
+	HasRescheduledAppointment bool       `gorm:"column:has_rescheduled_appointment"`
+	ProgramID                 string     `gorm:"column:program_id"`
+	OrganisationID            string     `gorm:"column:organisation_id;not null"`
+	ClientID                  string     `gorm:"column:client_id"`
+	FacilityID                string     `gorm:"column:facility_id"`
+	Missed                    bool       `gorm:"column:missed"`
+	MissedAt                  *time.Time `gorm:"column:missed_at"`
+	LastSyncedAt              *time.Time `gorm:"column:last_synced_at"`
+	SlotID                    *string    `gorm:"column:slot_id"`
+	CancelledAt               *time.Time `gorm:"column:cancelled_at"`
 }
 
 // BeforeCreate is a hook run before creating an appointment
@@ -1377,6 +1544,10 @@ type UserSurvey struct {
 	ProgramID      string     `gorm:"program_id"`
 	UserID         string     `gorm:"user_id"`
 	OrganisationID string     `gorm:"organisation_id"`
+
+	CampaignID     *string    `gorm:"column:campaign_id"`
+	ExpiresAt      *time.Time `gorm:"column:expires_at"`
+	LastRemindedAt *time.Time `gorm:"column:last_reminded_at"`
 }
 
 // BeforeCreate is a hook run before creating a user survey model
@@ -1406,6 +1577,139 @@ func (UserSurvey) TableName() string {
 	return "common_usersurveys"
 }
 
+// SurveyCampaign defines the survey campaign database model
+type SurveyCampaign struct {
+	Base
+	OrganisationID string `gorm:"column:organisation_id"`
+
+	ID          string         `gorm:"primaryKey;column:id"`
+	Active      bool           `gorm:"column:active"`
+	ProgramID   string         `gorm:"column:program_id"`
+	FacilityID  string         `gorm:"column:facility_id"`
+	ProjectID   int            `gorm:"column:project_id"`
+	FormID      string         `gorm:"column:form_id"`
+	Title       string         `gorm:"column:title"`
+	ClientTypes pq.StringArray `gorm:"type:text[];column:client_types"`
+	Genders     pq.StringArray `gorm:"type:text[];column:genders"`
+	MinimumAge  *int           `gorm:"column:min_age"`
+	MaximumAge  *int           `gorm:"column:max_age"`
+
+	Recurrence string     `gorm:"column:recurrence;default:ONCE"`
+	Status     string     `gorm:"column:status;default:SCHEDULED"`
+	NextSendAt *time.Time `gorm:"column:next_send_at"`
+	EndsAt     *time.Time `gorm:"column:ends_at"`
+	LastSentAt *time.Time `gorm:"column:last_sent_at"`
+
+	LinkExpiryDays       int  `gorm:"column:link_expiry_days"`
+	ReminderIntervalDays *int `gorm:"column:reminder_interval_days"`
+}
+
+// BeforeCreate is a hook run before creating a survey campaign
+func (s *SurveyCampaign) BeforeCreate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		s.CreatedBy = userID
+	}
+	id := uuid.New().String()
+	s.ID = id
+
+	return
+}
+
+// BeforeUpdate is a hook called before updating a SurveyCampaign.
+func (s *SurveyCampaign) BeforeUpdate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		s.UpdatedBy = userID
+	}
+	return
+}
+
+// TableName references the table that we map data from
+func (SurveyCampaign) TableName() string {
+	return "common_surveycampaign"
+}
+
+// SurveyRedFlagRule is the rule used to flag submissions to a survey form that need a follow up
+type SurveyRedFlagRule struct {
+	Base
+	OrganisationID string `gorm:"column:organisation_id"`
+
+	ID         string `gorm:"primaryKey;column:id"`
+	Active     bool   `gorm:"column:active"`
+	ProgramID  string `gorm:"column:program_id"`
+	ProjectID  int    `gorm:"column:project_id"`
+	FormID     string `gorm:"column:form_id"`
+	Name       string `gorm:"column:name"`
+	Combinator string `gorm:"column:combinator;default:AND"`
+	Conditions string `gorm:"column:conditions"`
+}
+
+// BeforeCreate is a hook run before creating a survey red flag rule
+func (s *SurveyRedFlagRule) BeforeCreate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		s.CreatedBy = userID
+	}
+	id := uuid.New().String()
+	s.ID = id
+
+	return
+}
+
+// BeforeUpdate is a hook called before updating a SurveyRedFlagRule.
+func (s *SurveyRedFlagRule) BeforeUpdate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		s.UpdatedBy = userID
+	}
+	return
+}
+
+// TableName references the table that we map data from
+func (SurveyRedFlagRule) TableName() string {
+	return "common_surveyredflagrule"
+}
+
+// CommunityCrisisKeyword is a keyword used to flag community messages that may indicate a crisis
+type CommunityCrisisKeyword struct {
+	Base
+	OrganisationID string `gorm:"column:organisation_id"`
+
+	ID        string `gorm:"primaryKey;column:id"`
+	Active    bool   `gorm:"column:active"`
+	ProgramID string `gorm:"column:program_id"`
+	Keyword   string `gorm:"column:keyword"`
+	IsRegex   bool   `gorm:"column:is_regex"`
+	Category  string `gorm:"column:category"`
+}
+
+// BeforeCreate is a hook run before creating a community crisis keyword
+func (c *CommunityCrisisKeyword) BeforeCreate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		c.CreatedBy = userID
+	}
+	id := uuid.New().String()
+	c.ID = id
+
+	return
+}
+
+// BeforeUpdate is a hook called before updating a CommunityCrisisKeyword.
+func (c *CommunityCrisisKeyword) BeforeUpdate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		c.UpdatedBy = userID
+	}
+	return
+}
+
+// TableName references the table that we map data from
+func (CommunityCrisisKeyword) TableName() string {
+	return "communities_crisiskeyword"
+}
+
 // Metric is a recording of an event that occurs within the platform
 type Metric struct {
 	Base
@@ -1562,6 +1866,11 @@ type ScreeningTool struct {
 	MinimumAge      int            `gorm:"column:min_age"`
 	MaximumAge      int            `gorm:"column:max_age"`
 	ProgramID       string         `gorm:"column:program_id"`
+
+	Recurrence             string `gorm:"column:recurrence;default:INTERVAL"`
+	RecurrenceIntervalDays int    `gorm:"column:recurrence_interval_days;default:1"`
+
+	ScoreWorseningThreshold *int `gorm:"column:score_worsening_threshold"`
 }
 
 // BeforeCreate is a hook run before creating a screening tool
@@ -1590,6 +1899,115 @@ func (ScreeningTool) TableName() string {
 	return "questionnaires_screeningtool"
 }
 
+// ScreeningToolVersion defines the screening tool version database models
+type ScreeningToolVersion struct {
+	Base
+	OrganisationID string `gorm:"column:organisation_id"`
+
+	ID              string     `gorm:"primaryKey;column:id"`
+	Active          bool       `gorm:"column:active"`
+	ScreeningToolID string     `gorm:"column:screeningtool_id"`
+	QuestionnaireID string     `gorm:"column:questionnaire_id"`
+	Version         int        `gorm:"column:version"`
+	Status          string     `gorm:"column:status"`
+	PublishedAt     *time.Time `gorm:"column:published_at"`
+	ProgramID       string     `gorm:"column:program_id"`
+}
+
+// BeforeCreate is a hook run before creating a screening tool version
+func (s *ScreeningToolVersion) BeforeCreate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		s.CreatedBy = userID
+	}
+	id := uuid.New().String()
+	s.ID = id
+
+	return
+}
+
+// TableName references the table that we map data from
+func (ScreeningToolVersion) TableName() string {
+	return "questionnaires_screeningtoolversion"
+}
+
+// ScreeningToolAssignment is a screening tool that a staff assigned to a client to respond to by a due date
+type ScreeningToolAssignment struct {
+	Base
+	OrganisationID string `gorm:"column:organisation_id"`
+
+	ID              string     `gorm:"primaryKey;column:id"`
+	Active          bool       `gorm:"column:active"`
+	ScreeningToolID string     `gorm:"column:screeningtool_id"`
+	ClientID        string     `gorm:"column:client_id"`
+	StaffID         string     `gorm:"column:staff_id"`
+	DueDate         time.Time  `gorm:"column:due_date"`
+	Status          string     `gorm:"column:status"`
+	CompletedAt     *time.Time `gorm:"column:completed_at"`
+	ResponseID      *string    `gorm:"column:response_id"`
+	LastRemindedAt  *time.Time `gorm:"column:last_reminded_at"`
+	ProgramID       string     `gorm:"column:program_id"`
+}
+
+// BeforeCreate is a hook run before creating a screening tool assignment
+func (s *ScreeningToolAssignment) BeforeCreate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		s.CreatedBy = userID
+	}
+	id := uuid.New().String()
+	s.ID = id
+
+	return
+}
+
+// BeforeUpdate is a hook called before updating a screening tool assignment
+func (s *ScreeningToolAssignment) BeforeUpdate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		s.UpdatedBy = userID
+	}
+	return
+}
+
+// TableName references the table that we map data from
+func (ScreeningToolAssignment) TableName() string {
+	return "questionnaires_screeningtoolassignment"
+}
+
+// ScreeningToolScoreBand defines the screening tool score band database models
+type ScreeningToolScoreBand struct {
+	Base
+	OrganisationID string `gorm:"column:organisation_id"`
+
+	ID              string  `gorm:"primaryKey;column:id"`
+	Active          bool    `gorm:"column:active"`
+	ScreeningToolID string  `gorm:"column:screeningtool_id"`
+	Label           string  `gorm:"column:label"`
+	MinScore        int     `gorm:"column:min_score"`
+	MaxScore        *int    `gorm:"column:max_score"`
+	Action          string  `gorm:"column:action"`
+	Priority        *string `gorm:"column:priority"`
+	ProgramID       string  `gorm:"column:program_id"`
+}
+
+// BeforeCreate is a hook run before creating a screening tool score band
+func (s *ScreeningToolScoreBand) BeforeCreate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		s.CreatedBy = userID
+	}
+	id := uuid.New().String()
+	s.ID = id
+
+	return
+}
+
+// TableName references the table that we map data from
+func (ScreeningToolScoreBand) TableName() string {
+	return "questionnaires_screeningtoolscoreband"
+}
+
 // Question defines the question database models
 type Question struct {
 	Base
@@ -1605,6 +2023,8 @@ type Question struct {
 	Required          bool   `gorm:"column:required"`
 	Sequence          int    `gorm:"column:sequence"`
 	ProgramID         string `gorm:"column:program_id"`
+
+	CriticalItemThreshold *int `gorm:"column:critical_item_threshold"`
 }
 
 // BeforeCreate is a hook run before creating a question
@@ -1633,6 +2053,38 @@ func (Question) TableName() string {
 	return "questionnaires_question"
 }
 
+// QuestionCondition defines the question display condition database models
+type QuestionCondition struct {
+	Base
+	OrganisationID string `gorm:"column:organisation_id"`
+
+	ID                  string         `gorm:"primaryKey;column:id"`
+	Active              bool           `gorm:"column:active"`
+	QuestionID          string         `gorm:"column:question_id"`
+	DependsOnQuestionID string         `gorm:"column:depends_on_question_id"`
+	Operator            string         `gorm:"column:operator"`
+	Target              string         `gorm:"column:target"`
+	Values              pq.StringArray `gorm:"type:text[];column:values"`
+	ProgramID           string         `gorm:"column:program_id"`
+}
+
+// BeforeCreate is a hook run before creating a question condition
+func (c *QuestionCondition) BeforeCreate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		c.CreatedBy = userID
+	}
+	id := uuid.New().String()
+	c.ID = id
+
+	return
+}
+
+// TableName references the table that we map data from
+func (QuestionCondition) TableName() string {
+	return "questionnaires_questioncondition"
+}
+
 // QuestionInputChoice defines the question input choice database models
 type QuestionInputChoice struct {
 	Base
@@ -1678,13 +2130,16 @@ type ScreeningToolResponse struct {
 	Base
 	OrganisationID string `gorm:"column:organisation_id"`
 
-	ID              string `gorm:"primaryKey;column:id"`
-	Active          bool   `gorm:"column:active"`
-	ScreeningToolID string `gorm:"column:screeningtool_id"`
-	FacilityID      string `gorm:"column:facility_id"`
-	ClientID        string `gorm:"column:client_id"`
-	AggregateScore  int    `gorm:"column:aggregate_score"`
-	ProgramID       string `gorm:"column:program_id"`
+	ID              string  `gorm:"primaryKey;column:id"`
+	Active          bool    `gorm:"column:active"`
+	ScreeningToolID string  `gorm:"column:screeningtool_id"`
+	FacilityID      string  `gorm:"column:facility_id"`
+	ClientID        string  `gorm:"column:client_id"`
+	AggregateScore  int     `gorm:"column:aggregate_score"`
+	Severity        *string `gorm:"column:severity"`
+	ProgramID       string  `gorm:"column:program_id"`
+
+	ScreeningToolVersionID *string `gorm:"column:screeningtool_version_id"`
 }
 
 // BeforeCreate is a hook run before creating a screening tool response
@@ -1889,3 +2344,155 @@ func (p *Program) BeforeUpdate(tx *gorm.DB) (err error) {
 func (p *Program) TableName() string {
 	return "common_program"
 }
+
+// SMSDelivery tracks the delivery of an SMS sent through the SMS gateway
+type SMSDelivery struct {
+	Base
+
+	ID                string `gorm:"primaryKey;column:id"`
+	PhoneNumber       string `gorm:"column:phone_number;not null"`
+	Provider          string `gorm:"column:provider"`
+	ProviderMessageID string `gorm:"column:provider_message_id"`
+	Status            string `gorm:"column:status;not null"`
+	Attempts          int    `gorm:"column:attempts"`
+	FailureReason     string `gorm:"column:failure_reason"`
+}
+
+// BeforeCreate is a hook run before creating an SMS delivery
+func (s *SMSDelivery) BeforeCreate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		s.CreatedBy = userID
+	}
+
+	s.ID = uuid.New().String()
+
+	return
+}
+
+// BeforeUpdate is a hook called before updating an SMS delivery
+func (s *SMSDelivery) BeforeUpdate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		s.UpdatedBy = userID
+	}
+	return
+}
+
+// TableName references the table that we map data from
+func (SMSDelivery) TableName() string {
+	return "common_smsdelivery"
+}
+
+// AppointmentReminder records a reminder sent to a client ahead of an appointment
+type AppointmentReminder struct {
+	Base
+
+	ID            string    `gorm:"primaryKey;column:id"`
+	AppointmentID string    `gorm:"column:appointment_id;not null"`
+	OffsetDays    int       `gorm:"column:offset_days;not null"`
+	PushSent      bool      `gorm:"column:push_sent"`
+	SMSSent       bool      `gorm:"column:sms_sent"`
+	SentAt        time.Time `gorm:"column:sent_at;not null"`
+}
+
+// BeforeCreate is a hook run before creating an appointment reminder
+func (a *AppointmentReminder) BeforeCreate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		a.CreatedBy = userID
+	}
+
+	a.ID = uuid.New().String()
+
+	return
+}
+
+// TableName references the table that we map data from
+func (AppointmentReminder) TableName() string {
+	return "appointments_appointmentreminder"
+}
+
+// AppointmentSlot is a recurring weekly period during which a facility accepts appointment bookings
+type AppointmentSlot struct {
+	Base
+
+	ID         string         `gorm:"primaryKey;column:id"`
+	Active     bool           `gorm:"column:active;not null"`
+	FacilityID string         `gorm:"column:facility_id;not null"`
+	DayOfWeek  int            `gorm:"column:day_of_week;not null"`
+	StartTime  string         `gorm:"column:start_time;not null"`
+	EndTime    string         `gorm:"column:end_time;not null"`
+	Capacity   int            `gorm:"column:capacity;not null"`
+	Reasons    pq.StringArray `gorm:"type:text[];column:reasons;not null"`
+}
+
+// BeforeCreate is a hook run before creating an appointment slot
+func (a *AppointmentSlot) BeforeCreate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		a.CreatedBy = userID
+	}
+
+	a.ID = uuid.New().String()
+
+	return
+}
+
+// BeforeUpdate is a hook called before updating an appointment slot
+func (a *AppointmentSlot) BeforeUpdate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		a.UpdatedBy = userID
+	}
+	return
+}
+
+// TableName references the table that we map data from
+func (AppointmentSlot) TableName() string {
+	return "appointments_appointmentslot"
+}
+
+// AppointmentSlotBookingCount is the number of active bookings made against an appointment slot on a date
+type AppointmentSlotBookingCount struct {
+	SlotID string    `gorm:"column:slot_id"`
+	Date   time.Time `gorm:"column:date"`
+	Count  int       `gorm:"column:count"`
+}
+
+// NotificationPreference holds the channels through which a user wants to receive a type of notification
+type NotificationPreference struct {
+	Base
+
+	ID               string `gorm:"primaryKey;column:id"`
+	UserID           string `gorm:"column:user_id;not null"`
+	NotificationType string `gorm:"column:notification_type;not null"`
+	PushEnabled      bool   `gorm:"column:push_enabled"`
+	SMSEnabled       bool   `gorm:"column:sms_enabled"`
+}
+
+// BeforeCreate is a hook run before creating a notification preference
+func (n *NotificationPreference) BeforeCreate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		n.CreatedBy = userID
+	}
+
+	n.ID = uuid.New().String()
+
+	return
+}
+
+// BeforeUpdate is a hook called before updating a notification preference
+func (n *NotificationPreference) BeforeUpdate(tx *gorm.DB) (err error) {
+	ctx := tx.Statement.Context
+	if userID := utils.GetLoggedInUserID(ctx); userID != nil {
+		n.UpdatedBy = userID
+	}
+	return
+}
+
+// TableName references the table that we map data from
+func (NotificationPreference) TableName() string {
+	return "common_notificationpreference"
+}
@@ -41,6 +41,23 @@ type Update interface {
 	UpdateUserContact(ctx context.Context, contact *Contact, updateData map[string]interface{}) error
 	UpdateClientIdentifier(ctx context.Context, clientID string, identifierType string, identifierValue string, programID string) error
 	UpdateProgram(ctx context.Context, program *Program, updateData map[string]interface{}) error
+	UpdateAuthorityRole(ctx context.Context, roleID string, updateData map[string]interface{}, permissions []*AuthorityPermission) error
+	UpdateSMSDelivery(ctx context.Context, delivery *SMSDelivery, updateData map[string]interface{}) error
+	MarkAppointmentMissed(ctx context.Context, appointmentID string, serviceRequest *ClientServiceRequest) error
+	DeactivateAppointmentSlot(ctx context.Context, slotID string) error
+	CancelAppointmentBooking(ctx context.Context, appointmentID string, serviceRequest *ClientServiceRequest) error
+	MarkServiceRequestEscalated(ctx context.Context, serviceRequestID string, escalatedAt time.Time) (bool, error)
+	UpdateScreeningToolVersion(ctx context.Context, version *ScreeningToolVersion, updateData map[string]interface{}) error
+	PublishScreeningToolVersion(ctx context.Context, version *ScreeningToolVersion) error
+	UpdateScreeningToolAssignment(ctx context.Context, assignment *ScreeningToolAssignment, updateData map[string]interface{}) error
+	CompleteScreeningToolAssignments(ctx context.Context, clientID, screeningToolID, responseID string) error
+	UpdateScreeningTool(ctx context.Context, screeningTool *ScreeningTool, updateData map[string]interface{}) error
+	UpdateHealthDiaryShare(ctx context.Context, share *HealthDiaryShare, updateData map[string]interface{}) error
+	UpdateSurveyCampaign(ctx context.Context, campaign *SurveyCampaign, updateData map[string]interface{}) error
+	UpdateSurveyRedFlagRule(ctx context.Context, rule *SurveyRedFlagRule, updateData map[string]interface{}) error
+	UpdateCommunityCrisisKeyword(ctx context.Context, keyword *CommunityCrisisKeyword, updateData map[string]interface{}) error
+	SetUserMatrixPasswordIfUnset(ctx context.Context, userID string, encryptedPassword string) (bool, error)
+	ClaimSurveyCampaignRound(ctx context.Context, campaign *SurveyCampaign, updateData map[string]interface{}) (bool, error)
 }
 
 // ReactivateFacility performs the actual re-activation of the facility in the database
@@ -591,3 +608,339 @@ func (db *PGInstance) UpdateProgram(ctx context.Context, program *Program, updat
 
 	return nil
 }
+
+// UpdateAuthorityRole updates a custom role. When permissions are provided, they replace the permissions that the role had been granted
+func (db *PGInstance) UpdateAuthorityRole(ctx context.Context, roleID string, updateData map[string]interface{}, permissions []*AuthorityPermission) error {
+	tx := db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+	if err := tx.Error; err != nil {
+		return fmt.Errorf("failed to initialize database transaction %w", err)
+	}
+
+	if len(updateData) > 0 {
+		err := tx.Model(&AuthorityRole{}).Where(&AuthorityRole{AuthorityRoleID: &roleID}).Updates(updateData).Error
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to update authority role: %w", err)
+		}
+	}
+
+	if permissions != nil {
+		err := tx.Where(&AuthorityRolePermission{RoleID: &roleID}).Delete(&AuthorityRolePermission{}).Error
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to remove role permissions: %w", err)
+		}
+
+		if err := grantRolePermissions(tx, roleID, permissions); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateSMSDelivery updates the delivery details of an SMS
+func (db *PGInstance) UpdateSMSDelivery(ctx context.Context, delivery *SMSDelivery, updateData map[string]interface{}) error {
+	err := db.DB.WithContext(ctx).Model(&SMSDelivery{}).Where(&SMSDelivery{ID: delivery.ID}).Updates(updateData).Error
+	if err != nil {
+		return fmt.Errorf("unable to update sms delivery: %w", err)
+	}
+
+	return nil
+}
+
+// MarkAppointmentMissed marks an appointment as missed and creates the service request used to follow up
+// with the client. Both changes are made in a single transaction so that a follow up is created only once
+func (db *PGInstance) MarkAppointmentMissed(ctx context.Context, appointmentID string, serviceRequest *ClientServiceRequest) error {
+	tx := db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	result := tx.Model(&Appointment{}).
+		Where("id = ? AND missed = ?", appointmentID, false).
+		Updates(map[string]interface{}{"missed": true, "missed_at": time.Now()})
+	if result.Error != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to mark appointment as missed: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return fmt.Errorf("appointment %s does not exist or has already been marked as missed", appointmentID)
+	}
+
+	if err := tx.Create(serviceRequest).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to create missed appointment service request: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeactivateAppointmentSlot stops a facility from accepting further bookings against an appointment slot.
+// Appointments that have already been booked against the slot are left as they are
+func (db *PGInstance) DeactivateAppointmentSlot(ctx context.Context, slotID string) error {
+	err := db.DB.WithContext(ctx).Model(&AppointmentSlot{}).Where("id = ?", slotID).Updates(map[string]interface{}{"active": false}).Error
+	if err != nil {
+		return fmt.Errorf("failed to deactivate appointment slot: %w", err)
+	}
+	return nil
+}
+
+// CancelAppointmentBooking cancels an active appointment that was booked against a facility slot, freeing up its place
+// in the slot, and creates the service request used to share the cancellation with KenyaEMR
+func (db *PGInstance) CancelAppointmentBooking(ctx context.Context, appointmentID string, serviceRequest *ClientServiceRequest) error {
+	tx := db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	result := tx.Model(&Appointment{}).
+		Where("id = ? AND active = ? AND slot_id IS NOT NULL", appointmentID, true).
+		Updates(map[string]interface{}{"active": false, "cancelled_at": time.Now()})
+	if result.Error != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to cancel appointment: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return fmt.Errorf("appointment %s does not exist, was not booked or has already been cancelled", appointmentID)
+	}
+
+	if err := tx.Create(serviceRequest).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to create appointment cancellation service request: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// MarkServiceRequestEscalated records when a service request was escalated. It returns false when the service request
+// had already been escalated so that an escalation is recorded only once
+func (db *PGInstance) MarkServiceRequestEscalated(ctx context.Context, serviceRequestID string, escalatedAt time.Time) (bool, error) {
+	result := db.DB.WithContext(ctx).Model(&ClientServiceRequest{}).
+		Where("id = ?", serviceRequestID).
+		Where("escalated_at IS NULL").
+		Updates(map[string]interface{}{"escalated_at": escalatedAt})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to mark service request as escalated: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// UpdateScreeningToolVersion updates a screening tool version with the new data
+func (db *PGInstance) UpdateScreeningToolVersion(ctx context.Context, version *ScreeningToolVersion, updateData map[string]interface{}) error {
+	err := db.DB.WithContext(ctx).Model(&ScreeningToolVersion{}).Where(&ScreeningToolVersion{ID: version.ID}).Updates(updateData).Error
+	if err != nil {
+		return fmt.Errorf("unable to update screening tool version: %w", err)
+	}
+
+	return nil
+}
+
+// PublishScreeningToolVersion publishes a draft version of a screening tool.
+// The previously published version is retired and the screening tool is pointed to the published questionnaire
+func (db *PGInstance) PublishScreeningToolVersion(ctx context.Context, version *ScreeningToolVersion) error {
+	tx := db.DB.WithContext(ctx).Begin()
+
+	err := tx.Model(&ScreeningToolVersion{}).
+		Where(&ScreeningToolVersion{ScreeningToolID: version.ScreeningToolID, Status: enums.ScreeningToolVersionStatusPublished.String()}).
+		Updates(map[string]interface{}{"status": enums.ScreeningToolVersionStatusRetired.String()}).Error
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to retire the published screening tool version: %w", err)
+	}
+
+	result := tx.Model(&ScreeningToolVersion{}).
+		Where(&ScreeningToolVersion{ID: version.ID, Status: enums.ScreeningToolVersionStatusDraft.String()}).
+		Updates(map[string]interface{}{
+			"status":       enums.ScreeningToolVersionStatusPublished.String(),
+			"published_at": time.Now(),
+		})
+	if result.Error != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to publish screening tool version: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return fmt.Errorf("screening tool version %s is not a draft", version.ID)
+	}
+
+	err = tx.Model(&ScreeningTool{}).Where(&ScreeningTool{ID: version.ScreeningToolID}).
+		Updates(map[string]interface{}{"questionnaire_id": version.QuestionnaireID}).Error
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to update the screening tool questionnaire: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to commit publish screening tool version transaction: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateScreeningToolAssignment updates a screening tool assignment with the new data
+func (db *PGInstance) UpdateScreeningToolAssignment(ctx context.Context, assignment *ScreeningToolAssignment, updateData map[string]interface{}) error {
+	if assignment.ID == "" {
+		return fmt.Errorf("a screening tool assignment ID is required")
+	}
+
+	err := db.DB.WithContext(ctx).Model(&ScreeningToolAssignment{}).Where(&ScreeningToolAssignment{ID: assignment.ID}).Updates(updateData).Error
+	if err != nil {
+		return fmt.Errorf("unable to update screening tool assignment: %w", err)
+	}
+
+	return nil
+}
+
+// CompleteScreeningToolAssignments marks a client's pending assignments of a screening tool as completed by the provided response
+func (db *PGInstance) CompleteScreeningToolAssignments(ctx context.Context, clientID, screeningToolID, responseID string) error {
+	if clientID == "" || screeningToolID == "" {
+		return fmt.Errorf("a client ID and screening tool ID are required")
+	}
+
+	err := db.DB.WithContext(ctx).Model(&ScreeningToolAssignment{}).
+		Where(&ScreeningToolAssignment{
+			ClientID:        clientID,
+			ScreeningToolID: screeningToolID,
+			Status:          enums.ScreeningToolAssignmentStatusPending.String(),
+		}).
+		Updates(map[string]interface{}{
+			"status":       enums.ScreeningToolAssignmentStatusCompleted.String(),
+			"completed_at": time.Now(),
+			"response_id":  responseID,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("unable to complete screening tool assignments: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateScreeningTool updates a screening tool with the new data
+func (db *PGInstance) UpdateScreeningTool(ctx context.Context, screeningTool *ScreeningTool, updateData map[string]interface{}) error {
+	if screeningTool.ID == "" {
+		return fmt.Errorf("a screening tool ID is required")
+	}
+
+	err := db.DB.WithContext(ctx).Model(&ScreeningTool{}).Where(&ScreeningTool{ID: screeningTool.ID}).Updates(updateData).Error
+	if err != nil {
+		return fmt.Errorf("unable to update screening tool: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateHealthDiaryShare updates a health diary share with the new data
+func (db *PGInstance) UpdateHealthDiaryShare(ctx context.Context, share *HealthDiaryShare, updateData map[string]interface{}) error {
+	if share.ID == "" {
+		return fmt.Errorf("a health diary share ID is required")
+	}
+
+	err := db.DB.WithContext(ctx).Model(&HealthDiaryShare{}).Where(&HealthDiaryShare{ID: share.ID}).Updates(updateData).Error
+	if err != nil {
+		return fmt.Errorf("unable to update health diary share: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateSurveyCampaign updates a survey campaign with the new data
+func (db *PGInstance) UpdateSurveyCampaign(ctx context.Context, campaign *SurveyCampaign, updateData map[string]interface{}) error {
+	if campaign.ID == "" {
+		return fmt.Errorf("a survey campaign ID is required")
+	}
+
+	err := db.DB.WithContext(ctx).Model(&SurveyCampaign{}).Where(&SurveyCampaign{ID: campaign.ID}).Updates(updateData).Error
+	if err != nil {
+		return fmt.Errorf("unable to update survey campaign: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateSurveyRedFlagRule updates a survey red flag rule with the new data
+func (db *PGInstance) UpdateSurveyRedFlagRule(ctx context.Context, rule *SurveyRedFlagRule, updateData map[string]interface{}) error {
+	if rule.ID == "" {
+		return fmt.Errorf("a survey red flag rule ID is required")
+	}
+
+	err := db.DB.WithContext(ctx).Model(&SurveyRedFlagRule{}).Where(&SurveyRedFlagRule{ID: rule.ID}).Updates(updateData).Error
+	if err != nil {
+		return fmt.Errorf("unable to update survey red flag rule: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateCommunityCrisisKeyword updates a community crisis keyword with the new data
+func (db *PGInstance) UpdateCommunityCrisisKeyword(ctx context.Context, keyword *CommunityCrisisKeyword, updateData map[string]interface{}) error {
+	if keyword.ID == "" {
+		return fmt.Errorf("a community crisis keyword ID is required")
+	}
+
+	err := db.DB.WithContext(ctx).Model(&CommunityCrisisKeyword{}).Where(&CommunityCrisisKeyword{ID: keyword.ID}).Updates(updateData).Error
+	if err != nil {
+		return fmt.Errorf("unable to update community crisis keyword: %w", err)
+	}
+
+	return nil
+}
+
+// SetUserMatrixPasswordIfUnset saves the encrypted Matrix password of a user who does not have one yet.
+// It reports whether the password was saved so that only one of several concurrent rotations of a user goes ahead
+func (db *PGInstance) SetUserMatrixPasswordIfUnset(ctx context.Context, userID string, encryptedPassword string) (bool, error) {
+	result := db.DB.WithContext(ctx).Model(&User{}).
+		Where("id = ? AND matrix_password IS NULL", userID).
+		Update("matrix_password", encryptedPassword)
+	if result.Error != nil {
+		return false, fmt.Errorf("unable to set user matrix password: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// ClaimSurveyCampaignRound updates a survey campaign only if its next round is still due at the time it was read with.
+// It reports whether the campaign was updated so that a round is only sent by one of several concurrent processors
+func (db *PGInstance) ClaimSurveyCampaignRound(ctx context.Context, campaign *SurveyCampaign, updateData map[string]interface{}) (bool, error) {
+	if campaign.ID == "" || campaign.NextSendAt == nil {
+		return false, fmt.Errorf("a survey campaign ID and its next send time are required")
+	}
+
+	result := db.DB.WithContext(ctx).Model(&SurveyCampaign{}).
+		Where("id = ? AND next_send_at = ?", campaign.ID, *campaign.NextSendAt).
+		Updates(updateData)
+	if result.Error != nil {
+		return false, fmt.Errorf("unable to claim survey campaign round: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -2,6 +2,7 @@ package gorm_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -1532,3 +1533,833 @@ func TestPGInstance_UpdateProgram(t *testing.T) {
 		})
 	}
 }
+
+func TestPGInstance_UpdateAuthorityRole(t *testing.T) {
+	type args struct {
+		ctx         context.Context
+		roleID      string
+		updateData  map[string]interface{}
+		permissions []*gorm.AuthorityPermission
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update role and its permissions",
+			args: args{
+				ctx:    context.Background(),
+				roleID: customRoleID,
+				updateData: map[string]interface{}{
+					"description": "manages the facilities in a program",
+				},
+				permissions: []*gorm.AuthorityPermission{
+					{
+						Name:        "Read facility",
+						Description: "Can read facility",
+						Category:    "Facility",
+						Scope:       "facility.read",
+					},
+					{
+						Name:        "Update facility",
+						Description: "Can update facility",
+						Category:    "Facility",
+						Scope:       "facility.update",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: update role without changing its permissions",
+			args: args{
+				ctx:    context.Background(),
+				roleID: customRoleID,
+				updateData: map[string]interface{}{
+					"name": "Program Facility Manager",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid role id",
+			args: args{
+				ctx:    context.Background(),
+				roleID: "invalid-id",
+				updateData: map[string]interface{}{
+					"name": "Program Facility Manager",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := testingDB.UpdateAuthorityRole(tt.args.ctx, tt.args.roleID, tt.args.updateData, tt.args.permissions); (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.UpdateAuthorityRole() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPGInstance_UpdateSMSDelivery(t *testing.T) {
+	delivery, err := testingDB.CreateSMSDelivery(context.Background(), &gorm.SMSDelivery{
+		PhoneNumber: testPhone,
+		Status:      enums.SMSDeliveryStatusPending.String(),
+	})
+	if err != nil {
+		t.Errorf("failed to create sms delivery: %v", err)
+		return
+	}
+
+	type args struct {
+		ctx        context.Context
+		delivery   *gorm.SMSDelivery
+		updateData map[string]interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update sms delivery",
+			args: args{
+				ctx:      context.Background(),
+				delivery: delivery,
+				updateData: map[string]interface{}{
+					"status":   enums.SMSDeliveryStatusSent.String(),
+					"provider": "TWILIO",
+					"attempts": 1,
+				},
+			},
+		},
+		{
+			name: "Sad case: invalid column",
+			args: args{
+				ctx:        context.Background(),
+				delivery:   delivery,
+				updateData: map[string]interface{}{"invalid": "value"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := testingDB.UpdateSMSDelivery(tt.args.ctx, tt.args.delivery, tt.args.updateData); (err != nil) != tt.wantErr {
+				t.Errorf("PGInstance.UpdateSMSDelivery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := testingDB.DB.Where("id = ?", delivery.ID).Unscoped().Delete(&gorm.SMSDelivery{}).Error; err != nil {
+		t.Errorf("failed to delete sms delivery: %v", err)
+	}
+}
+
+func TestPGInstance_MarkAppointmentMissed(t *testing.T) {
+	ctx := context.Background()
+
+	serviceRequest := &gorm.ClientServiceRequest{
+		Active:         true,
+		RequestType:    enums.ServiceRequestTypeMissedAppointment.String(),
+		Request:        gofakeit.Sentence(5),
+		Status:         enums.ServiceRequestStatusPending.String(),
+		ClientID:       clientID,
+		FacilityID:     facilityID,
+		ProgramID:      programID,
+		OrganisationID: orgID,
+		Meta:           fmt.Sprintf(`{"appointmentID": "%s"}`, appointmentID),
+	}
+
+	err := testingDB.MarkAppointmentMissed(ctx, appointmentID, serviceRequest)
+	if err != nil {
+		t.Errorf("PGInstance.MarkAppointmentMissed() error = %v", err)
+		return
+	}
+
+	var appointment gorm.Appointment
+	if err := testingDB.DB.Where("id = ?", appointmentID).First(&appointment).Error; err != nil {
+		t.Errorf("failed to get appointment: %v", err)
+		return
+	}
+	if !appointment.Missed || appointment.MissedAt == nil {
+		t.Errorf("expected appointment %s to be marked as missed", appointmentID)
+	}
+
+	err = testingDB.MarkAppointmentMissed(ctx, appointmentID, &gorm.ClientServiceRequest{
+		Active:         true,
+		RequestType:    enums.ServiceRequestTypeMissedAppointment.String(),
+		Request:        gofakeit.Sentence(5),
+		Status:         enums.ServiceRequestStatusPending.String(),
+		ClientID:       clientID,
+		FacilityID:     facilityID,
+		ProgramID:      programID,
+		OrganisationID: orgID,
+	})
+	if err == nil {
+		t.Errorf("expected an error when marking an appointment that is already missed")
+	}
+
+	err = testingDB.MarkAppointmentMissed(ctx, uuid.New().String(), &gorm.ClientServiceRequest{})
+	if err == nil {
+		t.Errorf("expected an error when marking an appointment that does not exist")
+	}
+
+	if err := testingDB.DB.Where("id = ?", serviceRequest.ID).Unscoped().Delete(&gorm.ClientServiceRequest{}).Error; err != nil {
+		t.Errorf("failed to delete service request: %v", err)
+	}
+	err = testingDB.DB.Model(&gorm.Appointment{}).Where("id = ?", appointmentID).
+		Updates(map[string]interface{}{"missed": false, "missed_at": nil}).Error
+	if err != nil {
+		t.Errorf("failed to reset appointment: %v", err)
+	}
+}
+
+func TestPGInstance_DeactivateAppointmentSlot(t *testing.T) {
+	ctx := context.Background()
+
+	slot := &gorm.AppointmentSlot{
+		Active:     true,
+		FacilityID: facilityID,
+		DayOfWeek:  int(time.Thursday),
+		StartTime:  "08:00",
+		EndTime:    "12:00",
+		Capacity:   10,
+		Reasons:    []string{"Pharmacy Visit"},
+	}
+	if err := testingDB.CreateAppointmentSlot(ctx, slot); err != nil {
+		t.Errorf("failed to create appointment slot: %v", err)
+		return
+	}
+
+	if err := testingDB.DeactivateAppointmentSlot(ctx, slot.ID); err != nil {
+		t.Errorf("PGInstance.DeactivateAppointmentSlot() error = %v", err)
+		return
+	}
+
+	got, err := testingDB.GetAppointmentSlot(ctx, slot.ID)
+	if err != nil {
+		t.Errorf("failed to get appointment slot: %v", err)
+		return
+	}
+	if got.Active {
+		t.Errorf("expected appointment slot %s to be inactive", slot.ID)
+	}
+
+	if err := testingDB.DB.Where("id = ?", slot.ID).Unscoped().Delete(&gorm.AppointmentSlot{}).Error; err != nil {
+		t.Errorf("failed to delete appointment slot: %v", err)
+	}
+}
+
+func TestPGInstance_CancelAppointmentBooking(t *testing.T) {
+	ctx := context.Background()
+	date := time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	slot := &gorm.AppointmentSlot{
+		Active:     true,
+		FacilityID: facilityID,
+		DayOfWeek:  int(date.Weekday()),
+		StartTime:  "08:00",
+		EndTime:    "12:00",
+		Capacity:   10,
+		Reasons:    []string{"Pharmacy Visit"},
+	}
+	if err := testingDB.CreateAppointmentSlot(ctx, slot); err != nil {
+		t.Errorf("failed to create appointment slot: %v", err)
+		return
+	}
+
+	appointment := &gorm.Appointment{
+		Active:         true,
+		Reason:         "Pharmacy Visit",
+		Date:           date,
+		ClientID:       clientID,
+		FacilityID:     facilityID,
+		ProgramID:      programID,
+		OrganisationID: orgID,
+		SlotID:         &slot.ID,
+	}
+	if err := testingDB.DB.Create(appointment).Error; err != nil {
+		t.Errorf("failed to create appointment: %v", err)
+		return
+	}
+
+	newServiceRequest := func() *gorm.ClientServiceRequest {
+		return &gorm.ClientServiceRequest{
+			Active:         true,
+			RequestType:    enums.ServiceRequestTypeAppointments.String(),
+			Request:        gofakeit.Sentence(5),
+			Status:         enums.ServiceRequestStatusPending.String(),
+			ClientID:       clientID,
+			FacilityID:     facilityID,
+			ProgramID:      programID,
+			OrganisationID: orgID,
+			Meta:           fmt.Sprintf(`{"appointmentID": "%s", "action": "CANCEL"}`, appointment.ID),
+		}
+	}
+
+	serviceRequest := newServiceRequest()
+	if err := testingDB.CancelAppointmentBooking(ctx, appointment.ID, serviceRequest); err != nil {
+		t.Errorf("PGInstance.CancelAppointmentBooking() error = %v", err)
+		return
+	}
+
+	var cancelled gorm.Appointment
+	if err := testingDB.DB.Where("id = ?", appointment.ID).First(&cancelled).Error; err != nil {
+		t.Errorf("failed to get appointment: %v", err)
+		return
+	}
+	if cancelled.Active || cancelled.CancelledAt == nil {
+		t.Errorf("expected appointment %s to be cancelled", appointment.ID)
+	}
+
+	if err := testingDB.CancelAppointmentBooking(ctx, appointment.ID, newServiceRequest()); err == nil {
+		t.Errorf("expected an error when cancelling an appointment that has already been cancelled")
+	}
+
+	if err := testingDB.DB.Where("id = ?", serviceRequest.ID).Unscoped().Delete(&gorm.ClientServiceRequest{}).Error; err != nil {
+		t.Errorf("failed to delete service request: %v", err)
+	}
+	if err := testingDB.DB.Where("id = ?", appointment.ID).Unscoped().Delete(&gorm.Appointment{}).Error; err != nil {
+		t.Errorf("failed to delete appointment: %v", err)
+	}
+	if err := testingDB.DB.Where("id = ?", slot.ID).Unscoped().Delete(&gorm.AppointmentSlot{}).Error; err != nil {
+		t.Errorf("failed to delete appointment slot: %v", err)
+	}
+}
+
+func TestPGInstance_MarkServiceRequestEscalated(t *testing.T) {
+	ctx := context.Background()
+
+	serviceRequest := &gorm.ClientServiceRequest{
+		Active:         true,
+		RequestType:    enums.ServiceRequestTypeRedFlag.String(),
+		Request:        gofakeit.Sentence(5),
+		Status:         enums.ServiceRequestStatusPending.String(),
+		ClientID:       clientID,
+		FacilityID:     facilityID,
+		ProgramID:      programID,
+		OrganisationID: orgID,
+	}
+	if err := testingDB.DB.Create(serviceRequest).Error; err != nil {
+		t.Errorf("failed to create service request: %v", err)
+		return
+	}
+
+	escalated, err := testingDB.MarkServiceRequestEscalated(ctx, *serviceRequest.ID, time.Now())
+	if err != nil {
+		t.Errorf("PGInstance.MarkServiceRequestEscalated() error = %v", err)
+		return
+	}
+	if !escalated {
+		t.Errorf("expected service request %s to be escalated", *serviceRequest.ID)
+	}
+
+	escalated, err = testingDB.MarkServiceRequestEscalated(ctx, *serviceRequest.ID, time.Now())
+	if err != nil {
+		t.Errorf("PGInstance.MarkServiceRequestEscalated() error = %v", err)
+		return
+	}
+	if escalated {
+		t.Errorf("expected service request %s not to be escalated twice", *serviceRequest.ID)
+	}
+
+	if err := testingDB.DB.Where("id = ?", serviceRequest.ID).Unscoped().Delete(&gorm.ClientServiceRequest{}).Error; err != nil {
+		t.Errorf("failed to delete service request: %v", err)
+	}
+}
+
+func TestPGInstance_UpdateScreeningToolVersion(t *testing.T) {
+	ctx := context.Background()
+
+	version := &gorm.ScreeningToolVersion{
+		Active:          true,
+		ScreeningToolID: screeningToolID,
+		QuestionnaireID: questionnaireID,
+		Version:         300,
+		Status:          enums.ScreeningToolVersionStatusRetired.String(),
+		ProgramID:       programID,
+		OrganisationID:  orgID,
+	}
+	if err := testingDB.DB.Create(version).Error; err != nil {
+		t.Errorf("failed to create screening tool version: %v", err)
+		return
+	}
+
+	if err := testingDB.UpdateScreeningToolVersion(ctx, version, map[string]interface{}{"active": false}); err != nil {
+		t.Errorf("PGInstance.UpdateScreeningToolVersion() error = %v", err)
+	}
+
+	var updated gorm.ScreeningToolVersion
+	if err := testingDB.DB.Where("id = ?", version.ID).First(&updated).Error; err != nil {
+		t.Errorf("failed to get screening tool version: %v", err)
+	} else if updated.Active {
+		t.Errorf("expected the screening tool version to be updated")
+	}
+
+	if err := testingDB.DB.Where("id = ?", version.ID).Unscoped().Delete(&gorm.ScreeningToolVersion{}).Error; err != nil {
+		t.Errorf("failed to delete screening tool version: %v", err)
+	}
+}
+
+func TestPGInstance_PublishScreeningToolVersion(t *testing.T) {
+	ctx := addRequiredContext(context.Background(), t)
+
+	var tool gorm.ScreeningTool
+	if err := testingDB.DB.Where("id = ?", screeningToolID).First(&tool).Error; err != nil {
+		t.Errorf("failed to get screening tool: %v", err)
+		return
+	}
+
+	questionnaire := &gorm.Questionnaire{
+		Active:         true,
+		Name:           "Draft questionnaire",
+		ProgramID:      programID,
+		OrganisationID: orgID,
+	}
+	if err := testingDB.DB.Create(questionnaire).Error; err != nil {
+		t.Errorf("failed to create questionnaire: %v", err)
+		return
+	}
+
+	published := &gorm.ScreeningToolVersion{
+		Active:          true,
+		ScreeningToolID: screeningToolID,
+		QuestionnaireID: tool.QuestionnaireID,
+		Version:         400,
+		Status:          enums.ScreeningToolVersionStatusPublished.String(),
+		ProgramID:       programID,
+		OrganisationID:  orgID,
+	}
+	draft := &gorm.ScreeningToolVersion{
+		Active:          true,
+		ScreeningToolID: screeningToolID,
+		QuestionnaireID: questionnaire.ID,
+		Version:         401,
+		Status:          enums.ScreeningToolVersionStatusDraft.String(),
+		ProgramID:       programID,
+		OrganisationID:  orgID,
+	}
+	for _, version := range []*gorm.ScreeningToolVersion{published, draft} {
+		if err := testingDB.DB.Create(version).Error; err != nil {
+			t.Errorf("failed to create screening tool version: %v", err)
+			return
+		}
+	}
+
+	if err := testingDB.PublishScreeningToolVersion(ctx, draft); err != nil {
+		t.Errorf("PGInstance.PublishScreeningToolVersion() error = %v", err)
+		return
+	}
+
+	var previous, current gorm.ScreeningToolVersion
+	if err := testingDB.DB.Where("id = ?", published.ID).First(&previous).Error; err != nil {
+		t.Errorf("failed to get screening tool version: %v", err)
+	}
+	if err := testingDB.DB.Where("id = ?", draft.ID).First(&current).Error; err != nil {
+		t.Errorf("failed to get screening tool version: %v", err)
+	}
+	if previous.Status != enums.ScreeningToolVersionStatusRetired.String() {
+		t.Errorf("expected the previously published version to be retired, got %s", previous.Status)
+	}
+	if current.Status != enums.ScreeningToolVersionStatusPublished.String() || current.PublishedAt == nil {
+		t.Errorf("expected the draft to be published, got %s", current.Status)
+	}
+
+	var updatedTool gorm.ScreeningTool
+	if err := testingDB.DB.Where("id = ?", screeningToolID).First(&updatedTool).Error; err != nil {
+		t.Errorf("failed to get screening tool: %v", err)
+	} else if updatedTool.QuestionnaireID != questionnaire.ID {
+		t.Errorf("expected the screening tool to use the published questionnaire")
+	}
+
+	if err := testingDB.PublishScreeningToolVersion(ctx, draft); err == nil {
+		t.Errorf("expected an error publishing a version that is not a draft")
+	}
+
+	if err := testingDB.DB.Model(&gorm.ScreeningTool{}).Where("id = ?", screeningToolID).Update("questionnaire_id", tool.QuestionnaireID).Error; err != nil {
+		t.Errorf("failed to restore the screening tool questionnaire: %v", err)
+	}
+	for _, version := range []*gorm.ScreeningToolVersion{published, draft} {
+		if err := testingDB.DB.Where("id = ?", version.ID).Unscoped().Delete(&gorm.ScreeningToolVersion{}).Error; err != nil {
+			t.Errorf("failed to delete screening tool version: %v", err)
+		}
+	}
+	if err := testingDB.DB.Where("id = ?", questionnaire.ID).Unscoped().Delete(&gorm.Questionnaire{}).Error; err != nil {
+		t.Errorf("failed to delete questionnaire: %v", err)
+	}
+}
+
+func TestPGInstance_UpdateScreeningToolAssignment(t *testing.T) {
+	ctx := context.Background()
+
+	assignment := &gorm.ScreeningToolAssignment{
+		Active:          true,
+		ScreeningToolID: screeningToolID,
+		ClientID:        clientID,
+		StaffID:         staffID,
+		DueDate:         time.Now().AddDate(0, 0, 7),
+		Status:          enums.ScreeningToolAssignmentStatusPending.String(),
+		ProgramID:       programID,
+		OrganisationID:  orgID,
+	}
+	if err := testingDB.DB.Create(assignment).Error; err != nil {
+		t.Errorf("failed to create screening tool assignment: %v", err)
+		return
+	}
+
+	err := testingDB.UpdateScreeningToolAssignment(ctx, assignment, map[string]interface{}{
+		"status": enums.ScreeningToolAssignmentStatusCancelled.String(),
+	})
+	if err != nil {
+		t.Errorf("PGInstance.UpdateScreeningToolAssignment() error = %v", err)
+	}
+
+	var updated gorm.ScreeningToolAssignment
+	if err := testingDB.DB.Where("id = ?", assignment.ID).First(&updated).Error; err != nil {
+		t.Errorf("failed to get screening tool assignment: %v", err)
+	} else if updated.Status != enums.ScreeningToolAssignmentStatusCancelled.String() {
+		t.Errorf("expected the screening tool assignment to be cancelled, got %s", updated.Status)
+	}
+
+	if err := testingDB.UpdateScreeningToolAssignment(ctx, &gorm.ScreeningToolAssignment{}, map[string]interface{}{"active": false}); err == nil {
+		t.Errorf("expected an error updating a screening tool assignment without an ID")
+	}
+
+	if err := testingDB.DB.Where("id = ?", assignment.ID).Unscoped().Delete(&gorm.ScreeningToolAssignment{}).Error; err != nil {
+		t.Errorf("failed to delete screening tool assignment: %v", err)
+	}
+}
+
+func TestPGInstance_CompleteScreeningToolAssignments(t *testing.T) {
+	ctx := context.Background()
+
+	response := &gorm.ScreeningToolResponse{
+		Active:          true,
+		ScreeningToolID: screeningToolID,
+		FacilityID:      facilityID,
+		ClientID:        clientID,
+		ProgramID:       programID,
+		OrganisationID:  orgID,
+	}
+	if err := testingDB.DB.Create(response).Error; err != nil {
+		t.Errorf("failed to create screening tool response: %v", err)
+		return
+	}
+
+	assignment := &gorm.ScreeningToolAssignment{
+		Active:          true,
+		ScreeningToolID: screeningToolID,
+		ClientID:        clientID,
+		StaffID:         staffID,
+		DueDate:         time.Now().AddDate(0, 0, 7),
+		Status:          enums.ScreeningToolAssignmentStatusPending.String(),
+		ProgramID:       programID,
+		OrganisationID:  orgID,
+	}
+	if err := testingDB.DB.Create(assignment).Error; err != nil {
+		t.Errorf("failed to create screening tool assignment: %v", err)
+		return
+	}
+
+	if err := testingDB.CompleteScreeningToolAssignments(ctx, clientID, screeningToolID, response.ID); err != nil {
+		t.Errorf("PGInstance.CompleteScreeningToolAssignments() error = %v", err)
+	}
+
+	var completed gorm.ScreeningToolAssignment
+	if err := testingDB.DB.Where("id = ?", assignment.ID).First(&completed).Error; err != nil {
+		t.Errorf("failed to get screening tool assignment: %v", err)
+	} else if completed.Status != enums.ScreeningToolAssignmentStatusCompleted.String() || completed.ResponseID == nil || *completed.ResponseID != response.ID {
+		t.Errorf("expected the screening tool assignment to be completed by the response")
+	}
+
+	if err := testingDB.CompleteScreeningToolAssignments(ctx, "", screeningToolID, response.ID); err == nil {
+		t.Errorf("expected an error completing screening tool assignments without a client ID")
+	}
+
+	if err := testingDB.DB.Where("id = ?", assignment.ID).Unscoped().Delete(&gorm.ScreeningToolAssignment{}).Error; err != nil {
+		t.Errorf("failed to delete screening tool assignment: %v", err)
+	}
+	if err := testingDB.DB.Where("id = ?", response.ID).Unscoped().Delete(&gorm.ScreeningToolResponse{}).Error; err != nil {
+		t.Errorf("failed to delete screening tool response: %v", err)
+	}
+}
+
+func TestPGInstance_UpdateScreeningTool(t *testing.T) {
+	ctx := context.Background()
+
+	var tool gorm.ScreeningTool
+	if err := testingDB.DB.Where("id = ?", screeningToolID).First(&tool).Error; err != nil {
+		t.Errorf("failed to get screening tool: %v", err)
+		return
+	}
+
+	err := testingDB.UpdateScreeningTool(ctx, &tool, map[string]interface{}{
+		"recurrence":               enums.ScreeningToolRecurrenceInterval.String(),
+		"recurrence_interval_days": 14,
+	})
+	if err != nil {
+		t.Errorf("PGInstance.UpdateScreeningTool() error = %v", err)
+	}
+
+	var updated gorm.ScreeningTool
+	if err := testingDB.DB.Where("id = ?", screeningToolID).First(&updated).Error; err != nil {
+		t.Errorf("failed to get screening tool: %v", err)
+	} else if updated.RecurrenceIntervalDays != 14 {
+		t.Errorf("expected the screening tool's recurrence to be updated, got %d days", updated.RecurrenceIntervalDays)
+	}
+
+	if err := testingDB.UpdateScreeningTool(ctx, &gorm.ScreeningTool{}, map[string]interface{}{"active": false}); err == nil {
+		t.Errorf("expected an error updating a screening tool without an ID")
+	}
+
+	err = testingDB.UpdateScreeningTool(ctx, &tool, map[string]interface{}{
+		"recurrence":               tool.Recurrence,
+		"recurrence_interval_days": tool.RecurrenceIntervalDays,
+	})
+	if err != nil {
+		t.Errorf("failed to restore the screening tool's recurrence: %v", err)
+	}
+}
+
+func TestPGInstance_UpdateHealthDiaryShare(t *testing.T) {
+	ctx := context.Background()
+
+	share := &gorm.HealthDiaryShare{
+		Active:         true,
+		ClientID:       clientID,
+		FacilityID:     &facilityID,
+		Scope:          enums.HealthDiaryShareScopeEntireDiary.String(),
+		ProgramID:      programID,
+		OrganisationID: orgID,
+	}
+	if err := testingDB.DB.Create(share).Error; err != nil {
+		t.Errorf("failed to create health diary share: %v", err)
+		return
+	}
+
+	revokedAt := time.Now()
+	err := testingDB.UpdateHealthDiaryShare(ctx, share, map[string]interface{}{
+		"active":     false,
+		"revoked_at": revokedAt,
+	})
+	if err != nil {
+		t.Errorf("PGInstance.UpdateHealthDiaryShare() error = %v", err)
+	}
+
+	var updated gorm.HealthDiaryShare
+	if err := testingDB.DB.Where("id = ?", share.ID).First(&updated).Error; err != nil {
+		t.Errorf("failed to get health diary share: %v", err)
+	} else if updated.RevokedAt == nil || updated.Active {
+		t.Errorf("expected the health diary share to be revoked")
+	}
+
+	if err := testingDB.UpdateHealthDiaryShare(ctx, &gorm.HealthDiaryShare{}, map[string]interface{}{"active": false}); err == nil {
+		t.Errorf("expected an error updating a health diary share without an ID")
+	}
+
+	if err := testingDB.DB.Where("id = ?", share.ID).Unscoped().Delete(&gorm.HealthDiaryShare{}).Error; err != nil {
+		t.Errorf("failed to delete health diary share: %v", err)
+	}
+}
+
+func TestPGInstance_UpdateSurveyCampaign(t *testing.T) {
+	ctx := context.Background()
+
+	campaign := &gorm.SurveyCampaign{
+		Active:         true,
+		FacilityID:     facilityID,
+		ProjectID:      1,
+		FormID:         formID,
+		Title:          gofakeit.HipsterSentence(3),
+		Recurrence:     enums.SurveyCampaignRecurrenceOnce.String(),
+		Status:         enums.SurveyCampaignStatusScheduled.String(),
+		LinkExpiryDays: 7,
+		ProgramID:      programID,
+		OrganisationID: orgID,
+	}
+	if err := testingDB.DB.Create(campaign).Error; err != nil {
+		t.Errorf("failed to create survey campaign: %v", err)
+		return
+	}
+
+	err := testingDB.UpdateSurveyCampaign(ctx, campaign, map[string]interface{}{
+		"status":       enums.SurveyCampaignStatusCancelled.String(),
+		"next_send_at": nil,
+	})
+	if err != nil {
+		t.Errorf("PGInstance.UpdateSurveyCampaign() error = %v", err)
+	}
+
+	var updated gorm.SurveyCampaign
+	if err := testingDB.DB.Where("id = ?", campaign.ID).First(&updated).Error; err != nil {
+		t.Errorf("failed to get survey campaign: %v", err)
+	} else if updated.Status != enums.SurveyCampaignStatusCancelled.String() {
+		t.Errorf("expected the survey campaign to be cancelled")
+	}
+
+	if err := testingDB.UpdateSurveyCampaign(ctx, &gorm.SurveyCampaign{}, map[string]interface{}{"active": false}); err == nil {
+		t.Errorf("expected an error updating a survey campaign without an ID")
+	}
+
+	if err := testingDB.DB.Where("id = ?", campaign.ID).Unscoped().Delete(&gorm.SurveyCampaign{}).Error; err != nil {
+		t.Errorf("failed to delete survey campaign: %v", err)
+	}
+}
+
+func TestPGInstance_ClaimSurveyCampaignRound(t *testing.T) {
+	ctx := context.Background()
+
+	nextSendAt := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+	campaign := &gorm.SurveyCampaign{
+		Active:         true,
+		FacilityID:     facilityID,
+		ProjectID:      1,
+		FormID:         formID,
+		Title:          gofakeit.HipsterSentence(3),
+		Recurrence:     enums.SurveyCampaignRecurrenceWeekly.String(),
+		Status:         enums.SurveyCampaignStatusScheduled.String(),
+		NextSendAt:     &nextSendAt,
+		LinkExpiryDays: 7,
+		ProgramID:      programID,
+		OrganisationID: orgID,
+	}
+	if err := testingDB.DB.Create(campaign).Error; err != nil {
+		t.Errorf("failed to create survey campaign: %v", err)
+		return
+	}
+
+	updateData := map[string]interface{}{
+		"status":       enums.SurveyCampaignStatusActive.String(),
+		"next_send_at": nextSendAt.AddDate(0, 0, 7),
+	}
+
+	claimed, err := testingDB.ClaimSurveyCampaignRound(ctx, campaign, updateData)
+	if err != nil {
+		t.Errorf("PGInstance.ClaimSurveyCampaignRound() error = %v", err)
+	}
+	if !claimed {
+		t.Errorf("expected the due survey campaign round to be claimed")
+	}
+
+	claimed, err = testingDB.ClaimSurveyCampaignRound(ctx, campaign, updateData)
+	if err != nil {
+		t.Errorf("PGInstance.ClaimSurveyCampaignRound() error = %v", err)
+	}
+	if claimed {
+		t.Errorf("expected a survey campaign round to be claimed only once")
+	}
+
+	if _, err := testingDB.ClaimSurveyCampaignRound(ctx, &gorm.SurveyCampaign{ID: campaign.ID}, updateData); err == nil {
+		t.Errorf("expected an error claiming a survey campaign round without a next send time")
+	}
+
+	if err := testingDB.DB.Where("id = ?", campaign.ID).Unscoped().Delete(&gorm.SurveyCampaign{}).Error; err != nil {
+		t.Errorf("failed to delete survey campaign: %v", err)
+	}
+}
+
+func TestPGInstance_UpdateSurveyRedFlagRule(t *testing.T) {
+	ctx := context.Background()
+
+	rule := &gorm.SurveyRedFlagRule{
+		Active:         true,
+		Name:           gofakeit.HipsterSentence(3),
+		ProjectID:      1,
+		FormID:         formID,
+		Combinator:     enums.SurveyRuleCombinatorAnd.String(),
+		Conditions:     `[{"field": "feeling_unsafe", "operator": "EQUALS", "value": "yes"}]`,
+		ProgramID:      programID,
+		OrganisationID: orgID,
+	}
+	if err := testingDB.DB.Create(rule).Error; err != nil {
+		t.Errorf("failed to create survey red flag rule: %v", err)
+		return
+	}
+
+	err := testingDB.UpdateSurveyRedFlagRule(ctx, rule, map[string]interface{}{"active": false})
+	if err != nil {
+		t.Errorf("PGInstance.UpdateSurveyRedFlagRule() error = %v", err)
+	}
+
+	var updated gorm.SurveyRedFlagRule
+	if err := testingDB.DB.Where("id = ?", rule.ID).First(&updated).Error; err != nil {
+		t.Errorf("failed to get survey red flag rule: %v", err)
+	} else if updated.Active {
+		t.Errorf("expected the survey red flag rule to be deactivated")
+	}
+
+	if err := testingDB.UpdateSurveyRedFlagRule(ctx, &gorm.SurveyRedFlagRule{}, map[string]interface{}{"active": false}); err == nil {
+		t.Errorf("expected an error updating a survey red flag rule without an ID")
+	}
+
+	if err := testingDB.DB.Where("id = ?", rule.ID).Unscoped().Delete(&gorm.SurveyRedFlagRule{}).Error; err != nil {
+		t.Errorf("failed to delete survey red flag rule: %v", err)
+	}
+}
+
+func TestPGInstance_UpdateCommunityCrisisKeyword(t *testing.T) {
+	ctx := context.Background()
+
+	keyword := &gorm.CommunityCrisisKeyword{
+		Active:         true,
+		Keyword:        "hopeless",
+		Category:       enums.CommunityCrisisCategorySelfHarm.String(),
+		ProgramID:      programID,
+		OrganisationID: orgID,
+	}
+	if err := testingDB.DB.Create(keyword).Error; err != nil {
+		t.Errorf("failed to create community crisis keyword: %v", err)
+		return
+	}
+
+	err := testingDB.UpdateCommunityCrisisKeyword(ctx, keyword, map[string]interface{}{"active": false})
+	if err != nil {
+		t.Errorf("PGInstance.UpdateCommunityCrisisKeyword() error = %v", err)
+	}
+
+	var updated gorm.CommunityCrisisKeyword
+	if err := testingDB.DB.Where("id = ?", keyword.ID).First(&updated).Error; err != nil {
+		t.Errorf("failed to get community crisis keyword: %v", err)
+	} else if updated.Active {
+		t.Errorf("expected the community crisis keyword to be deactivated")
+	}
+
+	if err := testingDB.UpdateCommunityCrisisKeyword(ctx, &gorm.CommunityCrisisKeyword{}, map[string]interface{}{"active": false}); err == nil {
+		t.Errorf("expected an error updating a community crisis keyword without an ID")
+	}
+
+	if err := testingDB.DB.Where("id = ?", keyword.ID).Unscoped().Delete(&gorm.CommunityCrisisKeyword{}).Error; err != nil {
+		t.Errorf("failed to delete community crisis keyword: %v", err)
+	}
+}
+
+func TestPGInstance_SetUserMatrixPasswordIfUnset(t *testing.T) {
+	ctx := context.Background()
+
+	if err := testingDB.DB.Model(&gorm.User{}).Where("id = ?", userID).Update("matrix_password", nil).Error; err != nil {
+		t.Errorf("failed to clear user matrix password: %v", err)
+		return
+	}
+
+	saved, err := testingDB.SetUserMatrixPasswordIfUnset(ctx, userID, "first")
+	if err != nil || !saved {
+		t.Errorf("PGInstance.SetUserMatrixPasswordIfUnset() = %v, %v, want the password to be saved", saved, err)
+	}
+
+	saved, err = testingDB.SetUserMatrixPasswordIfUnset(ctx, userID, "second")
+	if err != nil || saved {
+		t.Errorf("PGInstance.SetUserMatrixPasswordIfUnset() = %v, %v, want an existing password to be kept", saved, err)
+	}
+
+	password, err := testingDB.GetUserMatrixPassword(ctx, userID)
+	if err != nil || password == nil || *password != "first" {
+		t.Errorf("expected the first matrix password to be kept, got %v", password)
+	}
+
+	if err := testingDB.DB.Model(&gorm.User{}).Where("id = ?", userID).Update("matrix_password", nil).Error; err != nil {
+		t.Errorf("failed to clear user matrix password: %v", err)
+	}
+}
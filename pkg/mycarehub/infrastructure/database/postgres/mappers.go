@@ -1,9 +1,14 @@
 package postgres
 
 import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/savannahghi/enumutils"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/gorm"
+	"github.com/savannahghi/scalarutils"
 )
 
 // a helper method to create mapped user
@@ -78,3 +83,314 @@ func (d *MyCareHubDb) mapProfileObjectToDomain(profileObject *gorm.User) *domain
 	}
 	return createMapUser(profileObject)
 }
+
+// mapAuthorityRole maps a db role to a domain role. The role's permissions are taken from the db permissions when provided
+func mapAuthorityRole(role *gorm.AuthorityRole, permissions []*gorm.AuthorityPermission, domainPermissions []domain.AuthorityPermission) *domain.AuthorityRole {
+	authorityRole := &domain.AuthorityRole{
+		AuthorityRoleID: *role.AuthorityRoleID,
+		Name:            role.Name,
+		Description:     role.Description,
+		Active:          role.Active,
+		IsSystemRole:    role.IsSystemRole,
+		OrganisationID:  role.OrganisationID,
+		Permissions:     domainPermissions,
+	}
+	if role.ProgramID != nil {
+		authorityRole.ProgramID = *role.ProgramID
+	}
+
+	if permissions != nil {
+		authorityRole.Permissions = []domain.AuthorityPermission{}
+		for _, permission := range permissions {
+			authorityRole.Permissions = append(authorityRole.Permissions, domain.AuthorityPermission{
+				PermissionID: *permission.AuthorityPermissionID,
+				Active:       permission.Active,
+				Name:         enums.PermissionType(permission.Name),
+				Description:  permission.Description,
+				Category:     permission.Category,
+				Scope:        permission.Scope,
+			})
+		}
+	}
+
+	return authorityRole
+}
+
+// mapRolePermissionsToGorm maps the permissions granted to a role to db permissions
+func mapRolePermissionsToGorm(permissions []domain.AuthorityPermission) []*gorm.AuthorityPermission {
+	records := []*gorm.AuthorityPermission{}
+	for _, permission := range permissions {
+		records = append(records, &gorm.AuthorityPermission{
+			Active:      true,
+			Name:        string(permission.Name),
+			Description: permission.Description,
+			Category:    permission.Category,
+			Scope:       permission.Scope,
+		})
+	}
+
+	return records
+}
+
+// mapAppointment maps the db appointment to a domain model.
+func mapAppointment(appointment *gorm.Appointment) *domain.Appointment {
+	return &domain.Appointment{
+		ID:         appointment.ID,
+		ExternalID: appointment.ExternalID,
+		Reason:     appointment.Reason,
+		Date: scalarutils.Date{
+			Year:  appointment.Date.Year(),
+			Month: int(appointment.Date.Month()),
+			Day:   appointment.Date.Day(),
+		},
+		ClientID:                  appointment.ClientID,
+		FacilityID:                appointment.FacilityID,
+		Provider:                  appointment.Provider,
+		HasRescheduledAppointment: appointment.HasRescheduledAppointment,
+		ProgramID:                 appointment.ProgramID,
+		Missed:                    appointment.Missed,
+		SlotID:                    appointment.SlotID,
+	}
+}
+
+// mapAppointmentSlot maps an appointment slot record to its domain representation
+func mapAppointmentSlot(slot *gorm.AppointmentSlot) *domain.AppointmentSlot {
+	return &domain.AppointmentSlot{
+		ID:         slot.ID,
+		Active:     slot.Active,
+		FacilityID: slot.FacilityID,
+		DayOfWeek:  slot.DayOfWeek,
+		StartTime:  slot.StartTime,
+		EndTime:    slot.EndTime,
+		Capacity:   slot.Capacity,
+		Reasons:    slot.Reasons,
+	}
+}
+
+// mapServiceRequestComment maps a service request comment record to its domain representation
+func mapServiceRequestComment(comment *gorm.ServiceRequestComment) *domain.ServiceRequestComment {
+	return &domain.ServiceRequestComment{
+		ID:               comment.ID,
+		ServiceRequestID: comment.ServiceRequestID,
+		ParentID:         comment.ParentID,
+		StaffID:          comment.StaffID,
+		Comment:          comment.Comment,
+		CreatedAt:        comment.CreatedAt,
+	}
+}
+
+// mapServiceRequestEvent maps a service request event record to its domain representation
+func mapServiceRequestEvent(event *gorm.ServiceRequestEvent) *domain.ServiceRequestEvent {
+	return &domain.ServiceRequestEvent{
+		ID:               event.ID,
+		ServiceRequestID: event.ServiceRequestID,
+		EventType:        enums.ServiceRequestEventType(event.EventType),
+		StaffID:          event.StaffID,
+		Status:           event.Status,
+		AssignedToID:     event.AssignedToID,
+		Notes:            event.Notes,
+		CreatedAt:        event.CreatedAt,
+	}
+}
+
+// mapScreeningToolScoreBand maps a screening tool score band record to its domain representation
+func mapScreeningToolScoreBand(band *gorm.ScreeningToolScoreBand) domain.ScreeningToolScoreBand {
+	scoreBand := domain.ScreeningToolScoreBand{
+		ID:              band.ID,
+		Active:          band.Active,
+		ScreeningToolID: band.ScreeningToolID,
+		Label:           band.Label,
+		MinScore:        band.MinScore,
+		MaxScore:        band.MaxScore,
+		Action:          enums.ScreeningToolBandAction(band.Action),
+		ProgramID:       band.ProgramID,
+		OrganisationID:  band.OrganisationID,
+	}
+	if band.Priority != nil {
+		priority := enums.ServiceRequestPriority(*band.Priority)
+		scoreBand.Priority = &priority
+	}
+
+	return scoreBand
+}
+
+// mapQuestionCondition maps a question condition record to its domain representation
+func mapQuestionCondition(condition *gorm.QuestionCondition) domain.QuestionCondition {
+	return domain.QuestionCondition{
+		ID:                  condition.ID,
+		Active:              condition.Active,
+		QuestionID:          condition.QuestionID,
+		DependsOnQuestionID: condition.DependsOnQuestionID,
+		Operator:            enums.QuestionConditionOperator(condition.Operator),
+		Target:              enums.QuestionConditionTarget(condition.Target),
+		Values:              condition.Values,
+		ProgramID:           condition.ProgramID,
+		OrganisationID:      condition.OrganisationID,
+	}
+}
+
+// mapScreeningToolVersion maps a screening tool version record to its domain representation
+func mapScreeningToolVersion(version *gorm.ScreeningToolVersion) *domain.ScreeningToolVersion {
+	return &domain.ScreeningToolVersion{
+		ID:              version.ID,
+		Active:          version.Active,
+		ScreeningToolID: version.ScreeningToolID,
+		QuestionnaireID: version.QuestionnaireID,
+		Version:         version.Version,
+		Status:          enums.ScreeningToolVersionStatus(version.Status),
+		PublishedAt:     version.PublishedAt,
+		CreatedAt:       version.CreatedAt,
+		ProgramID:       version.ProgramID,
+		OrganisationID:  version.OrganisationID,
+	}
+}
+
+// mapScreeningToolAssignment maps a screening tool assignment record to its domain representation
+func mapScreeningToolAssignment(assignment *gorm.ScreeningToolAssignment) *domain.ScreeningToolAssignment {
+	return &domain.ScreeningToolAssignment{
+		ID:              assignment.ID,
+		Active:          assignment.Active,
+		ScreeningToolID: assignment.ScreeningToolID,
+		ClientID:        assignment.ClientID,
+		StaffID:         assignment.StaffID,
+		DueDate:         assignment.DueDate,
+		Status:          enums.ScreeningToolAssignmentStatus(assignment.Status),
+		CompletedAt:     assignment.CompletedAt,
+		ResponseID:      assignment.ResponseID,
+		LastRemindedAt:  assignment.LastRemindedAt,
+		CreatedAt:       assignment.CreatedAt,
+		ProgramID:       assignment.ProgramID,
+		OrganisationID:  assignment.OrganisationID,
+	}
+}
+
+func mapHealthDiaryShare(share *gorm.HealthDiaryShare) *domain.HealthDiaryShare {
+	return &domain.HealthDiaryShare{
+		ID:             &share.ID,
+		Active:         share.Active,
+		ClientID:       share.ClientID,
+		FacilityID:     share.FacilityID,
+		CaregiverID:    share.CaregiverID,
+		Scope:          enums.HealthDiaryShareScope(share.Scope),
+		From:           share.From,
+		To:             share.To,
+		EntryIDs:       share.EntryIDs,
+		ExpiresAt:      share.ExpiresAt,
+		RevokedAt:      share.RevokedAt,
+		CreatedAt:      share.CreatedAt,
+		ProgramID:      share.ProgramID,
+		OrganisationID: share.OrganisationID,
+	}
+}
+
+// mapSurveyCampaign maps a survey campaign record to its domain representation
+func mapSurveyCampaign(campaign *gorm.SurveyCampaign) *domain.SurveyCampaign {
+	clientTypes := []enums.ClientType{}
+	for _, t := range campaign.ClientTypes {
+		clientTypes = append(clientTypes, enums.ClientType(t))
+	}
+
+	genders := []enumutils.Gender{}
+	for _, g := range campaign.Genders {
+		genders = append(genders, enumutils.Gender(g))
+	}
+
+	var ageRange *domain.AgeRange
+	if campaign.MinimumAge != nil && campaign.MaximumAge != nil {
+		ageRange = &domain.AgeRange{
+			LowerBound: *campaign.MinimumAge,
+			UpperBound: *campaign.MaximumAge,
+		}
+	}
+
+	return &domain.SurveyCampaign{
+		ID:                   campaign.ID,
+		Active:               campaign.Active,
+		Title:                campaign.Title,
+		ProjectID:            campaign.ProjectID,
+		FormID:               campaign.FormID,
+		FacilityID:           campaign.FacilityID,
+		ClientTypes:          clientTypes,
+		Genders:              genders,
+		AgeRange:             ageRange,
+		Recurrence:           enums.SurveyCampaignRecurrence(campaign.Recurrence),
+		Status:               enums.SurveyCampaignStatus(campaign.Status),
+		NextSendAt:           campaign.NextSendAt,
+		EndsAt:               campaign.EndsAt,
+		LastSentAt:           campaign.LastSentAt,
+		LinkExpiryDays:       campaign.LinkExpiryDays,
+		ReminderIntervalDays: campaign.ReminderIntervalDays,
+		CreatedAt:            campaign.CreatedAt,
+		ProgramID:            campaign.ProgramID,
+		OrganisationID:       campaign.OrganisationID,
+	}
+}
+
+// mapSurveyRedFlagRule maps a survey red flag rule record to its domain representation
+func mapSurveyRedFlagRule(rule *gorm.SurveyRedFlagRule) (*domain.SurveyRedFlagRule, error) {
+	conditions := []domain.SurveyRuleCondition{}
+	if err := json.Unmarshal([]byte(rule.Conditions), &conditions); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal survey red flag rule conditions: %w", err)
+	}
+
+	return &domain.SurveyRedFlagRule{
+		ID:             rule.ID,
+		Active:         rule.Active,
+		Name:           rule.Name,
+		ProjectID:      rule.ProjectID,
+		FormID:         rule.FormID,
+		Combinator:     enums.SurveyRuleCombinator(rule.Combinator),
+		Conditions:     conditions,
+		CreatedAt:      rule.CreatedAt,
+		ProgramID:      rule.ProgramID,
+		OrganisationID: rule.OrganisationID,
+	}, nil
+}
+
+// mapCommunity maps a community record to its domain representation
+func mapCommunity(community *gorm.Community) *domain.Community {
+	clientTypes := []enums.ClientType{}
+	for _, t := range community.ClientTypes {
+		clientTypes = append(clientTypes, enums.ClientType(t))
+	}
+
+	genders := []enumutils.Gender{}
+	for _, g := range community.Gender {
+		genders = append(genders, enumutils.Gender(g))
+	}
+
+	var facilityID string
+	if community.FacilityID != nil {
+		facilityID = *community.FacilityID
+	}
+
+	return &domain.Community{
+		ID:          community.ID,
+		RoomID:      community.RoomID,
+		Name:        community.Name,
+		Description: community.Description,
+		AgeRange: &domain.AgeRange{
+			LowerBound: community.MinimumAge,
+			UpperBound: community.MaximumAge,
+		},
+		Gender:         genders,
+		ClientType:     clientTypes,
+		OrganisationID: community.OrganisationID,
+		ProgramID:      community.ProgramID,
+		FacilityID:     facilityID,
+	}
+}
+
+// mapCommunityCrisisKeyword maps a community crisis keyword record to its domain representation
+func mapCommunityCrisisKeyword(keyword *gorm.CommunityCrisisKeyword) *domain.CommunityCrisisKeyword {
+	return &domain.CommunityCrisisKeyword{
+		ID:             keyword.ID,
+		Active:         keyword.Active,
+		Keyword:        keyword.Keyword,
+		IsRegex:        keyword.IsRegex,
+		Category:       enums.CommunityCrisisCategory(keyword.Category),
+		CreatedAt:      keyword.CreatedAt,
+		ProgramID:      keyword.ProgramID,
+		OrganisationID: keyword.OrganisationID,
+	}
+}
@@ -84,7 +84,7 @@ type PostgresMock struct {
 	MockGetOrCreateNextOfKin                                  func(ctx context.Context, person *dto.NextOfKinPayload, clientID, contactID string) error
 	MockGetOrCreateContactFn                                  func(ctx context.Context, contact *domain.Contact) (*domain.Contact, error)
 	MockGetClientsInAFacilityFn                               func(ctx context.Context, facilityID string) ([]*domain.ClientProfile, error)
-	MockGetRecentHealthDiaryEntriesFn                         func(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile) ([]*domain.ClientHealthDiaryEntry, error)
+	MockGetRecentHealthDiaryEntriesFn                         func(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile, facilityID string) ([]*domain.ClientHealthDiaryEntry, error)
 	MockGetClientsByParams                                    func(ctx context.Context, params gorm.Client, lastSyncTime *time.Time) ([]*domain.ClientProfile, error)
 	MockGetClientIdentifiers                                  func(ctx context.Context, clientID string) ([]*domain.Identifier, error)
 	MockGetServiceRequestsForKenyaEMRFn                       func(ctx context.Context, payload *dto.ServiceRequestPayload) ([]*domain.ServiceRequest, error)
@@ -143,7 +143,7 @@ type PostgresMock struct {
 	MockCreateScreeningToolResponseFn                         func(ctx context.Context, input *domain.QuestionnaireScreeningToolResponse) (*string, error)
 	MockGetScreeningToolByIDFn                                func(ctx context.Context, toolID string) (*domain.ScreeningTool, error)
 	MockGetAvailableScreeningToolsFn                          func(ctx context.Context, clientID string, screeningTool domain.ScreeningTool, screeningToolIDs []string) ([]*domain.ScreeningTool, error)
-	MockGetScreeningToolResponsesWithin24HoursFn              func(ctx context.Context, clientID, programID string) ([]*domain.QuestionnaireScreeningToolResponse, error)
+	MockGetLatestScreeningToolResponsesFn                     func(ctx context.Context, clientID, programID string) ([]*domain.QuestionnaireScreeningToolResponse, error)
 	MockGetScreeningToolResponsesWithPendingServiceRequestsFn func(ctx context.Context, clientID, programID string) ([]*domain.QuestionnaireScreeningToolResponse, error)
 	MockGetFacilityRespondedScreeningToolsFn                  func(ctx context.Context, facilityID, programID string, pagination *domain.Pagination) ([]*domain.ScreeningTool, *domain.Pagination, error)
 	MockListSurveyRespondentsFn                               func(ctx context.Context, params *domain.UserSurvey, facilityID string, pagination *domain.Pagination) ([]*domain.SurveyRespondent, *domain.Pagination, error)
@@ -196,6 +196,78 @@ type PostgresMock struct {
 	MockCheckPhoneExistsFn                                    func(ctx context.Context, phone string) (bool, error)
 	MockUpdateProgramFn                                       func(ctx context.Context, program *domain.Program, updateData map[string]interface{}) error
 	MockGetStaffServiceRequestByIDFn                          func(ctx context.Context, id string) (*domain.ServiceRequest, error)
+	MockGetUserPermissionsFn                                  func(ctx context.Context, userID string, programID string) ([]*domain.AuthorityPermission, error)
+	MockCreateAuditLogFn                                      func(ctx context.Context, auditLog *domain.AuditLog) error
+	MockListAuditLogsFn                                       func(ctx context.Context, organisationID string, filter *dto.AuditLogFilterInput, pagination *domain.Pagination) ([]*domain.AuditLog, *domain.Pagination, error)
+	MockCreateAuthorityRoleFn                                 func(ctx context.Context, role *domain.AuthorityRole) (*domain.AuthorityRole, error)
+	MockAssignStaffRoleFn                                     func(ctx context.Context, staffID string, roleID string) error
+	MockGetAuthorityRoleByIDFn                                func(ctx context.Context, roleID string) (*domain.AuthorityRole, error)
+	MockListAuthorityRolesFn                                  func(ctx context.Context, organisationID string, programID string) ([]*domain.AuthorityRole, error)
+	MockListAuthorityRoleStaffFn                              func(ctx context.Context, roleID string, pagination *domain.Pagination) ([]*domain.StaffProfile, *domain.Pagination, error)
+	MockCheckStaffHasRoleFn                                   func(ctx context.Context, staffID string, roleID string) (bool, error)
+	MockUpdateAuthorityRoleFn                                 func(ctx context.Context, roleID string, updateData map[string]interface{}, permissions []domain.AuthorityPermission) error
+	MockDeleteAuthorityRoleFn                                 func(ctx context.Context, roleID string) error
+	MockRevokeStaffRoleFn                                     func(ctx context.Context, staffID string, roleID string) error
+	MockGetRecentOTPsCountFn                                  func(ctx context.Context, phoneNumber string, since time.Time) (int, error)
+	MockCreateSMSDeliveryFn                                   func(ctx context.Context, delivery *domain.SMSDelivery) (*domain.SMSDelivery, error)
+	MockUpdateSMSDeliveryFn                                   func(ctx context.Context, delivery *domain.SMSDelivery, updateData map[string]interface{}) error
+	MockListAppointmentsPendingReminderFn                     func(ctx context.Context, appointmentDate time.Time, offsetDays int) ([]*domain.Appointment, error)
+	MockCreateAppointmentReminderFn                           func(ctx context.Context, reminder *domain.AppointmentReminder) error
+	MockGetNotificationPreferencesFn                          func(ctx context.Context, userID string) ([]*domain.NotificationPreference, error)
+	MockSaveNotificationPreferenceFn                          func(ctx context.Context, preference *domain.NotificationPreference) error
+	MockListMissedAppointmentsFn                              func(ctx context.Context, before time.Time) ([]*domain.Appointment, error)
+	MockMarkAppointmentMissedFn                               func(ctx context.Context, appointmentID string, serviceRequestInput *dto.ServiceRequestInput) error
+	MockCreateAppointmentSlotFn                               func(ctx context.Context, slot *domain.AppointmentSlot) (*domain.AppointmentSlot, error)
+	MockBookAppointmentSlotFn                                 func(ctx context.Context, appointment *domain.Appointment, serviceRequestInput *dto.ServiceRequestInput) (*domain.Appointment, error)
+	MockGetAppointmentSlotFn                                  func(ctx context.Context, slotID string) (*domain.AppointmentSlot, error)
+	MockListAppointmentSlotsFn                                func(ctx context.Context, facilityID string) ([]*domain.AppointmentSlot, error)
+	MockGetAppointmentSlotBookingCountsFn                     func(ctx context.Context, facilityID string, startDate, endDate time.Time) ([]*domain.AppointmentSlotBookingCount, error)
+	MockDeactivateAppointmentSlotFn                           func(ctx context.Context, slotID string) error
+	MockCancelAppointmentBookingFn                            func(ctx context.Context, appointmentID string, serviceRequestInput *dto.ServiceRequestInput) error
+	MockListOverdueServiceRequestsFn                          func(ctx context.Context, requestType string, createdBefore time.Time) ([]*domain.ServiceRequest, error)
+	MockMarkServiceRequestEscalatedFn                         func(ctx context.Context, serviceRequestID string, escalatedAt time.Time) (bool, error)
+	MockCreateServiceRequestCommentFn                         func(ctx context.Context, comment *domain.ServiceRequestComment) (*domain.ServiceRequestComment, error)
+	MockCreateServiceRequestEventFn                           func(ctx context.Context, event *domain.ServiceRequestEvent) error
+	MockListServiceRequestCommentsFn                          func(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestComment, error)
+	MockListServiceRequestEventsFn                            func(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestEvent, error)
+	MockSaveScreeningToolDraftFn                              func(ctx context.Context, screeningToolID string, questionnaire *domain.Questionnaire) (*domain.ScreeningToolVersion, error)
+	MockPublishScreeningToolDraftFn                           func(ctx context.Context, screeningToolID string) (*domain.ScreeningToolVersion, error)
+	MockListScreeningToolVersionsFn                           func(ctx context.Context, screeningToolID string) ([]*domain.ScreeningToolVersion, error)
+	MockGetScreeningToolVersionFn                             func(ctx context.Context, screeningToolID string, version int) (*domain.ScreeningToolVersion, error)
+	MockCreateScreeningToolAssignmentFn                       func(ctx context.Context, assignment *domain.ScreeningToolAssignment) (*domain.ScreeningToolAssignment, error)
+	MockListScreeningToolAssignmentsFn                        func(ctx context.Context, params *domain.ScreeningToolAssignment) ([]*domain.ScreeningToolAssignment, error)
+	MockListPendingScreeningToolAssignmentsDueBeforeFn        func(ctx context.Context, dueBefore time.Time) ([]*domain.ScreeningToolAssignment, error)
+	MockUpdateScreeningToolAssignmentFn                       func(ctx context.Context, assignment *domain.ScreeningToolAssignment, updateData map[string]interface{}) error
+	MockCompleteScreeningToolAssignmentsFn                    func(ctx context.Context, clientID, screeningToolID, responseID string) error
+	MockUpdateScreeningToolFn                                 func(ctx context.Context, screeningTool *domain.ScreeningTool, updateData map[string]interface{}) error
+	MockListClientScreeningToolResponsesFn                    func(ctx context.Context, clientID, programID string, screeningToolID *string) ([]*domain.QuestionnaireScreeningToolResponse, error)
+	MockListClientHealthDiaryEntriesSinceFn                   func(ctx context.Context, clientID string, since time.Time) ([]*domain.ClientHealthDiaryEntry, error)
+	MockListClientIDsWithHealthDiaryEntriesSinceFn            func(ctx context.Context, since time.Time) ([]string, error)
+	MockCreateHealthDiaryShareFn                              func(ctx context.Context, share *domain.HealthDiaryShare) (*domain.HealthDiaryShare, error)
+	MockGetHealthDiaryShareByIDFn                             func(ctx context.Context, shareID string) (*domain.HealthDiaryShare, error)
+	MockListClientHealthDiarySharesFn                         func(ctx context.Context, clientID string) ([]*domain.HealthDiaryShare, error)
+	MockGetCaregiverSharedHealthDiaryEntriesFn                func(ctx context.Context, clientID string, caregiverID string) ([]*domain.ClientHealthDiaryEntry, error)
+	MockUpdateHealthDiaryShareFn                              func(ctx context.Context, share *domain.HealthDiaryShare, updateData map[string]interface{}) error
+	MockCreateSurveyCampaignFn                                func(ctx context.Context, campaign *domain.SurveyCampaign) (*domain.SurveyCampaign, error)
+	MockGetSurveyCampaignByIDFn                               func(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error)
+	MockListSurveyCampaignsFn                                 func(ctx context.Context, facilityID, programID string) ([]*domain.SurveyCampaign, error)
+	MockListOngoingSurveyCampaignsFn                          func(ctx context.Context) ([]*domain.SurveyCampaign, error)
+	MockUpdateSurveyCampaignFn                                func(ctx context.Context, campaign *domain.SurveyCampaign, updateData map[string]interface{}) error
+	MockCreateSurveyRedFlagRuleFn                             func(ctx context.Context, rule *domain.SurveyRedFlagRule) (*domain.SurveyRedFlagRule, error)
+	MockListSurveyRedFlagRulesFn                              func(ctx context.Context, projectID int, formID, programID string) ([]*domain.SurveyRedFlagRule, error)
+	MockUpdateSurveyRedFlagRuleFn                             func(ctx context.Context, rule *domain.SurveyRedFlagRule, updateData map[string]interface{}) error
+	MockListAllCommunitiesFn                                  func(ctx context.Context) ([]*domain.Community, error)
+	MockListProgramClientsFn                                  func(ctx context.Context, programID string) ([]*domain.ClientProfile, error)
+	MockGetUserMatrixPasswordFn                               func(ctx context.Context, userID string) (string, error)
+	MockListUsersWithoutMatrixPasswordFn                      func(ctx context.Context) ([]*domain.User, error)
+	MockCreateCommunityCrisisKeywordFn                        func(ctx context.Context, keyword *domain.CommunityCrisisKeyword) (*domain.CommunityCrisisKeyword, error)
+	MockListCommunityCrisisKeywordsFn                         func(ctx context.Context, programID string) ([]*domain.CommunityCrisisKeyword, error)
+	MockGetCommunityByRoomIDFn                                func(ctx context.Context, roomID string) (*domain.Community, error)
+	MockUpdateCommunityCrisisKeywordFn                        func(ctx context.Context, keyword *domain.CommunityCrisisKeyword, updateData map[string]interface{}) error
+	MockCheckCrisisMessageFlaggedFn                           func(ctx context.Context, eventID string) (bool, error)
+	MockSetUserMatrixPasswordIfUnsetFn                        func(ctx context.Context, userID string, encryptedPassword string) (bool, error)
+	MockGetSurveyRedFlagRuleByIDFn                            func(ctx context.Context, ruleID string) (*domain.SurveyRedFlagRule, error)
+	MockClaimSurveyCampaignRoundFn                            func(ctx context.Context, campaign *domain.SurveyCampaign, updateData map[string]interface{}) (bool, error)
 }
 
 // NewPostgresMock initializes a new instance of `GormMock` then mocking the case of success.
@@ -584,20 +656,20 @@ func NewPostgresMock() *PostgresMock {
 		},
 		MockListClientsCaregiversFn: func(ctx context.Context, clientID string, pagination *domain.Pagination) (*domain.ClientCaregivers, *domain.Pagination, error) {
 			return &domain.ClientCaregivers{
-					Caregivers: []*domain.CaregiverProfile{
-						{
-							ID:              ID,
-							User:            *userProfile,
-							CaregiverNumber: gofakeit.SSN(),
-							Consent: domain.ConsentStatus{
-								ConsentStatus: enums.ConsentStateAccepted,
-							},
+				Caregivers: []*domain.CaregiverProfile{
+					{
+						ID:              ID,
+						User:            *userProfile,
+						CaregiverNumber: gofakeit.SSN(),
+						Consent: domain.ConsentStatus{
+							ConsentStatus: enums.ConsentStateAccepted,
 						},
 					},
-				}, &domain.Pagination{
-					Limit:       10,
-					CurrentPage: 1,
-				}, nil
+				},
+			}, &domain.Pagination{
+				Limit:       10,
+				CurrentPage: 1,
+			}, nil
 		},
 		MockGetStaffProfileByStaffIDFn: func(ctx context.Context, staffID string) (*domain.StaffProfile, error) {
 			return &domain.StaffProfile{
@@ -608,6 +680,8 @@ func NewPostgresMock() *PostgresMock {
 				StaffNumber:     "TEST-00",
 				Facilities:      []*domain.Facility{},
 				DefaultFacility: facilityInput,
+				OrganisationID:  ID,
+				ProgramID:       ID,
 			}, nil
 		},
 		MockGetCurrentTermsFn: func(ctx context.Context) (*domain.TermsOfService, error) {
@@ -658,18 +732,18 @@ func NewPostgresMock() *PostgresMock {
 		},
 		MockListSurveyRespondentsFn: func(ctx context.Context, params *domain.UserSurvey, facilityID string, pagination *domain.Pagination) ([]*domain.SurveyRespondent, *domain.Pagination, error) {
 			return []*domain.SurveyRespondent{
-					{
-						ID:          ID,
-						Name:        name,
-						SubmittedAt: time.Time{},
-						ProjectID:   1,
-						SubmitterID: 10,
-						FormID:      uuid.New().String(),
-					},
-				}, &domain.Pagination{
-					Limit:       10,
-					CurrentPage: 1,
-				}, nil
+				{
+					ID:          ID,
+					Name:        name,
+					SubmittedAt: time.Time{},
+					ProjectID:   1,
+					SubmitterID: 10,
+					FormID:      uuid.New().String(),
+				},
+			}, &domain.Pagination{
+				Limit:       10,
+				CurrentPage: 1,
+			}, nil
 		},
 		MockCreateIdentifierFn: func(ctx context.Context, identifier domain.Identifier) (*domain.Identifier, error) {
 			return &domain.Identifier{
@@ -950,7 +1024,7 @@ func NewPostgresMock() *PostgresMock {
 		MockGetFacilitiesWithoutFHIRIDFn: func(ctx context.Context) ([]*domain.Facility, error) {
 			return []*domain.Facility{facilityInput}, nil
 		},
-		MockGetRecentHealthDiaryEntriesFn: func(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile) ([]*domain.ClientHealthDiaryEntry, error) {
+		MockGetRecentHealthDiaryEntriesFn: func(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile, facilityID string) ([]*domain.ClientHealthDiaryEntry, error) {
 			return []*domain.ClientHealthDiaryEntry{
 				{
 					Active: true,
@@ -1068,6 +1142,7 @@ func NewPostgresMock() *PostgresMock {
 				ResolvedAt:   &currentTime,
 				ResolvedBy:   &staffID,
 				FacilityID:   facilityID,
+				ProgramID:    ID,
 			}
 			return serviceReq, nil
 		},
@@ -1231,7 +1306,7 @@ func NewPostgresMock() *PostgresMock {
 				},
 			}, nil
 		},
-		MockGetScreeningToolResponsesWithin24HoursFn: func(ctx context.Context, clientID, programID string) ([]*domain.QuestionnaireScreeningToolResponse, error) {
+		MockGetLatestScreeningToolResponsesFn: func(ctx context.Context, clientID, programID string) ([]*domain.QuestionnaireScreeningToolResponse, error) {
 			return []*domain.QuestionnaireScreeningToolResponse{
 				{
 					ID:              ID,
@@ -1350,36 +1425,36 @@ func NewPostgresMock() *PostgresMock {
 		},
 		MockGetFacilityRespondedScreeningToolsFn: func(ctx context.Context, facilityID, programID string, pagination *domain.Pagination) ([]*domain.ScreeningTool, *domain.Pagination, error) {
 			return []*domain.ScreeningTool{
-					{
-						ID:              screeningUUID,
-						Active:          true,
-						QuestionnaireID: screeningUUID,
-						Questionnaire: domain.Questionnaire{
-							ID:          screeningUUID,
-							Active:      true,
-							Name:        name,
-							Description: description,
-						},
+				{
+					ID:              screeningUUID,
+					Active:          true,
+					QuestionnaireID: screeningUUID,
+					Questionnaire: domain.Questionnaire{
+						ID:          screeningUUID,
+						Active:      true,
+						Name:        name,
+						Description: description,
 					},
-				}, &domain.Pagination{
-					CurrentPage: 1,
-					Limit:       10,
-				}, nil
+				},
+			}, &domain.Pagination{
+				CurrentPage: 1,
+				Limit:       10,
+			}, nil
 		},
 		MockGetUsersWithSurveyServiceRequestFn: func(ctx context.Context, facilityID string, projectID int, formID string, pagination *domain.Pagination) ([]*domain.SurveyServiceRequestUser, *domain.Pagination, error) {
 			return []*domain.SurveyServiceRequestUser{
-					{
-						Name:        name,
-						FormID:      "test",
-						ProjectID:   1,
-						SubmitterID: 1,
-						SurveyName:  "test",
-					},
-				}, &domain.Pagination{
-					Limit:       10,
-					CurrentPage: 1,
-					TotalPages:  20,
-				}, nil
+				{
+					Name:        name,
+					FormID:      "test",
+					ProjectID:   1,
+					SubmitterID: 1,
+					SurveyName:  "test",
+				},
+			}, &domain.Pagination{
+				Limit:       10,
+				CurrentPage: 1,
+				TotalPages:  20,
+			}, nil
 		},
 		MockGetScreeningToolRespondentsFn: func(ctx context.Context, facilityID, programID string, screeningToolID string, searchTerm string, paginationInput *dto.PaginationsInput) ([]*domain.ScreeningToolRespondent, *domain.Pagination, error) {
 			return []*domain.ScreeningToolRespondent{
@@ -1429,35 +1504,35 @@ func NewPostgresMock() *PostgresMock {
 		},
 		MockGetStaffFacilitiesFn: func(ctx context.Context, input dto.StaffFacilityInput, pagination *domain.Pagination) ([]*domain.Facility, *domain.Pagination, error) {
 			return []*domain.Facility{
-					{
-						ID:                 &ID,
-						Name:               name,
-						Phone:              phone,
-						Active:             true,
-						Country:            country,
-						Description:        description,
-						FHIROrganisationID: ID,
-					},
-				}, &domain.Pagination{
-					CurrentPage: 1,
-					Limit:       10,
-				}, nil
+				{
+					ID:                 &ID,
+					Name:               name,
+					Phone:              phone,
+					Active:             true,
+					Country:            country,
+					Description:        description,
+					FHIROrganisationID: ID,
+				},
+			}, &domain.Pagination{
+				CurrentPage: 1,
+				Limit:       10,
+			}, nil
 		},
 		MockGetClientFacilitiesFn: func(ctx context.Context, input dto.ClientFacilityInput, pagination *domain.Pagination) ([]*domain.Facility, *domain.Pagination, error) {
 			return []*domain.Facility{
-					{
-						ID:                 &ID,
-						Name:               name,
-						Phone:              phone,
-						Active:             true,
-						Country:            country,
-						Description:        description,
-						FHIROrganisationID: ID,
-					},
-				}, &domain.Pagination{
-					CurrentPage: 1,
-					Limit:       10,
-				}, nil
+				{
+					ID:                 &ID,
+					Name:               name,
+					Phone:              phone,
+					Active:             true,
+					Country:            country,
+					Description:        description,
+					FHIROrganisationID: ID,
+				},
+			}, &domain.Pagination{
+				CurrentPage: 1,
+				Limit:       10,
+			}, nil
 		},
 		MockUpdateStaffFn: func(ctx context.Context, st *domain.StaffProfile, updates map[string]interface{}) error {
 			return nil
@@ -1467,22 +1542,22 @@ func NewPostgresMock() *PostgresMock {
 		},
 		MockListOrganisationsFn: func(ctx context.Context, pagination *domain.Pagination) ([]*domain.Organisation, *domain.Pagination, error) {
 			return []*domain.Organisation{
-					{
-						ID:              ID,
-						Active:          true,
-						Code:            "",
-						Name:            "Test Organisation",
-						Description:     description,
-						EmailAddress:    gofakeit.Email(),
-						PhoneNumber:     interserviceclient.TestUserPhoneNumber,
-						PostalAddress:   gofakeit.BeerAlcohol(),
-						PhysicalAddress: gofakeit.BeerAlcohol(),
-						DefaultCountry:  gofakeit.Country(),
-					},
-				}, &domain.Pagination{
-					CurrentPage: 1,
-					Limit:       10,
-				}, nil
+				{
+					ID:              ID,
+					Active:          true,
+					Code:            "",
+					Name:            "Test Organisation",
+					Description:     description,
+					EmailAddress:    gofakeit.Email(),
+					PhoneNumber:     interserviceclient.TestUserPhoneNumber,
+					PostalAddress:   gofakeit.BeerAlcohol(),
+					PhysicalAddress: gofakeit.BeerAlcohol(),
+					DefaultCountry:  gofakeit.Country(),
+				},
+			}, &domain.Pagination{
+				CurrentPage: 1,
+				Limit:       10,
+			}, nil
 		},
 		MockAddFacilitiesToClientProfileFn: func(ctx context.Context, clientID string, facilities []string) error {
 			return nil
@@ -1651,6 +1726,590 @@ func NewPostgresMock() *PostgresMock {
 			}
 			return serviceReq, nil
 		},
+		MockGetUserPermissionsFn: func(ctx context.Context, userID string, programID string) ([]*domain.AuthorityPermission, error) {
+			return []*domain.AuthorityPermission{
+				{
+					PermissionID: ID,
+					Active:       true,
+					Name:         enums.PermissionType(name),
+					Description:  description,
+					Category:     "User",
+					Scope:        "client.read",
+				},
+			}, nil
+		},
+		MockCreateAuditLogFn: func(ctx context.Context, auditLog *domain.AuditLog) error {
+			return nil
+		},
+		MockListAuditLogsFn: func(ctx context.Context, organisationID string, filter *dto.AuditLogFilterInput, pagination *domain.Pagination) ([]*domain.AuditLog, *domain.Pagination, error) {
+			return []*domain.AuditLog{
+				{
+					ID:             ID,
+					Timestamp:      currentTime,
+					RecordType:     enums.AuditLogRecordTypeClientFacilityTransfer,
+					Notes:          description,
+					ActorID:        ID,
+					TargetID:       ID,
+					TargetType:     "CLIENT",
+					OldValue:       map[string]interface{}{"facilityID": ID},
+					NewValue:       map[string]interface{}{"facilityID": ID},
+					OrganisationID: ID,
+					ProgramID:      ID,
+				},
+			}, paginationOutput, nil
+		},
+		MockCreateAuthorityRoleFn: func(ctx context.Context, role *domain.AuthorityRole) (*domain.AuthorityRole, error) {
+			role.AuthorityRoleID = ID
+			return role, nil
+		},
+		MockAssignStaffRoleFn: func(ctx context.Context, staffID string, roleID string) error {
+			return nil
+		},
+		MockGetAuthorityRoleByIDFn: func(ctx context.Context, roleID string) (*domain.AuthorityRole, error) {
+			return &domain.AuthorityRole{
+				AuthorityRoleID: ID,
+				Name:            name,
+				Description:     description,
+				Active:          true,
+				OrganisationID:  ID,
+				ProgramID:       ID,
+				Permissions: []domain.AuthorityPermission{
+					{
+						PermissionID: ID,
+						Active:       true,
+						Name:         enums.PermissionType(name),
+						Description:  description,
+						Category:     "User",
+						Scope:        "client.read",
+					},
+				},
+			}, nil
+		},
+		MockListAuthorityRolesFn: func(ctx context.Context, organisationID string, programID string) ([]*domain.AuthorityRole, error) {
+			return []*domain.AuthorityRole{
+				{
+					AuthorityRoleID: ID,
+					Name:            name,
+					Description:     description,
+					Active:          true,
+					OrganisationID:  ID,
+					ProgramID:       ID,
+				},
+			}, nil
+		},
+		MockListAuthorityRoleStaffFn: func(ctx context.Context, roleID string, pagination *domain.Pagination) ([]*domain.StaffProfile, *domain.Pagination, error) {
+			return []*domain.StaffProfile{staff}, paginationOutput, nil
+		},
+		MockCheckStaffHasRoleFn: func(ctx context.Context, staffID string, roleID string) (bool, error) {
+			return false, nil
+		},
+		MockUpdateAuthorityRoleFn: func(ctx context.Context, roleID string, updateData map[string]interface{}, permissions []domain.AuthorityPermission) error {
+			return nil
+		},
+		MockDeleteAuthorityRoleFn: func(ctx context.Context, roleID string) error {
+			return nil
+		},
+		MockRevokeStaffRoleFn: func(ctx context.Context, staffID string, roleID string) error {
+			return nil
+		},
+		MockGetRecentOTPsCountFn: func(ctx context.Context, phoneNumber string, since time.Time) (int, error) {
+			return 0, nil
+		},
+		MockCreateSMSDeliveryFn: func(ctx context.Context, delivery *domain.SMSDelivery) (*domain.SMSDelivery, error) {
+			return &domain.SMSDelivery{
+				ID:          ID,
+				PhoneNumber: delivery.PhoneNumber,
+				Status:      delivery.Status,
+				CreatedAt:   time.Now(),
+			}, nil
+		},
+		MockUpdateSMSDeliveryFn: func(ctx context.Context, delivery *domain.SMSDelivery, updateData map[string]interface{}) error {
+			return nil
+		},
+		MockListAppointmentsPendingReminderFn: func(ctx context.Context, appointmentDate time.Time, offsetDays int) ([]*domain.Appointment, error) {
+			date, _ := scalarutils.NewDate(appointmentDate.Day(), int(appointmentDate.Month()), appointmentDate.Year())
+			return []*domain.Appointment{
+				{
+					ID:       ID,
+					Reason:   "Pharmacy Visit",
+					Date:     *date,
+					ClientID: ID,
+				},
+			}, nil
+		},
+		MockCreateAppointmentReminderFn: func(ctx context.Context, reminder *domain.AppointmentReminder) error {
+			return nil
+		},
+		MockGetNotificationPreferencesFn: func(ctx context.Context, userID string) ([]*domain.NotificationPreference, error) {
+			return []*domain.NotificationPreference{
+				{
+					ID:               ID,
+					UserID:           userID,
+					NotificationType: enums.NotificationTypeAppointment,
+					PushEnabled:      true,
+					SMSEnabled:       true,
+				},
+			}, nil
+		},
+		MockSaveNotificationPreferenceFn: func(ctx context.Context, preference *domain.NotificationPreference) error {
+			return nil
+		},
+		MockListMissedAppointmentsFn: func(ctx context.Context, before time.Time) ([]*domain.Appointment, error) {
+			date := before.AddDate(0, 0, -1)
+			return []*domain.Appointment{
+				{
+					ID:     ID,
+					Reason: "Pharmacy Visit",
+					Date: scalarutils.Date{
+						Year:  date.Year(),
+						Month: int(date.Month()),
+						Day:   date.Day(),
+					},
+					ClientID:   ID,
+					FacilityID: ID,
+				},
+			}, nil
+		},
+		MockMarkAppointmentMissedFn: func(ctx context.Context, appointmentID string, serviceRequestInput *dto.ServiceRequestInput) error {
+			return nil
+		},
+		MockCreateAppointmentSlotFn: func(ctx context.Context, slot *domain.AppointmentSlot) (*domain.AppointmentSlot, error) {
+			return &domain.AppointmentSlot{
+				ID:         ID,
+				Active:     true,
+				FacilityID: slot.FacilityID,
+				DayOfWeek:  slot.DayOfWeek,
+				StartTime:  slot.StartTime,
+				EndTime:    slot.EndTime,
+				Capacity:   slot.Capacity,
+				Reasons:    slot.Reasons,
+			}, nil
+		},
+		MockBookAppointmentSlotFn: func(ctx context.Context, appointment *domain.Appointment, serviceRequestInput *dto.ServiceRequestInput) (*domain.Appointment, error) {
+			return &domain.Appointment{
+				ID:         ID,
+				Reason:     appointment.Reason,
+				Date:       appointment.Date,
+				ClientID:   appointment.ClientID,
+				FacilityID: appointment.FacilityID,
+				ProgramID:  appointment.ProgramID,
+				SlotID:     appointment.SlotID,
+			}, nil
+		},
+		MockGetAppointmentSlotFn: func(ctx context.Context, slotID string) (*domain.AppointmentSlot, error) {
+			return &domain.AppointmentSlot{
+				ID:         slotID,
+				Active:     true,
+				FacilityID: ID,
+				DayOfWeek:  int(time.Now().Weekday()),
+				StartTime:  "08:00",
+				EndTime:    "12:00",
+				Capacity:   10,
+				Reasons:    []string{"Pharmacy Visit"},
+			}, nil
+		},
+		MockListAppointmentSlotsFn: func(ctx context.Context, facilityID string) ([]*domain.AppointmentSlot, error) {
+			slots := []*domain.AppointmentSlot{}
+			for day := time.Sunday; day <= time.Saturday; day++ {
+				slots = append(slots, &domain.AppointmentSlot{
+					ID:         ID,
+					Active:     true,
+					FacilityID: facilityID,
+					DayOfWeek:  int(day),
+					StartTime:  "08:00",
+					EndTime:    "12:00",
+					Capacity:   10,
+					Reasons:    []string{"Pharmacy Visit"},
+				})
+			}
+			return slots, nil
+		},
+		MockGetAppointmentSlotBookingCountsFn: func(ctx context.Context, facilityID string, startDate, endDate time.Time) ([]*domain.AppointmentSlotBookingCount, error) {
+			return []*domain.AppointmentSlotBookingCount{
+				{
+					SlotID: ID,
+					Date:   startDate,
+					Count:  1,
+				},
+			}, nil
+		},
+		MockDeactivateAppointmentSlotFn: func(ctx context.Context, slotID string) error {
+			return nil
+		},
+		MockCancelAppointmentBookingFn: func(ctx context.Context, appointmentID string, serviceRequestInput *dto.ServiceRequestInput) error {
+			return nil
+		},
+		MockListOverdueServiceRequestsFn: func(ctx context.Context, requestType string, createdBefore time.Time) ([]*domain.ServiceRequest, error) {
+			return []*domain.ServiceRequest{
+				{
+					ID:          ID,
+					RequestType: requestType,
+					Request:     gofakeit.Sentence(5),
+					Status:      enums.ServiceRequestStatusPending.String(),
+					Active:      true,
+					ClientID:    ID,
+					CreatedAt:   createdBefore.Add(-time.Hour),
+					FacilityID:  ID,
+				},
+			}, nil
+		},
+		MockMarkServiceRequestEscalatedFn: func(ctx context.Context, serviceRequestID string, escalatedAt time.Time) (bool, error) {
+			return true, nil
+		},
+		MockCreateServiceRequestCommentFn: func(ctx context.Context, comment *domain.ServiceRequestComment) (*domain.ServiceRequestComment, error) {
+			return &domain.ServiceRequestComment{
+				ID:               ID,
+				ServiceRequestID: comment.ServiceRequestID,
+				ParentID:         comment.ParentID,
+				StaffID:          comment.StaffID,
+				Comment:          comment.Comment,
+				CreatedAt:        time.Now(),
+			}, nil
+		},
+		MockCreateServiceRequestEventFn: func(ctx context.Context, event *domain.ServiceRequestEvent) error {
+			return nil
+		},
+		MockListServiceRequestCommentsFn: func(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestComment, error) {
+			return []*domain.ServiceRequestComment{
+				{
+					ID:               ID,
+					ServiceRequestID: serviceRequestID,
+					StaffID:          ID,
+					StaffName:        name,
+					Comment:          gofakeit.Sentence(5),
+					CreatedAt:        time.Now(),
+				},
+			}, nil
+		},
+		MockListServiceRequestEventsFn: func(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestEvent, error) {
+			return []*domain.ServiceRequestEvent{
+				{
+					ID:               ID,
+					ServiceRequestID: serviceRequestID,
+					EventType:        enums.ServiceRequestEventTypeAssigned,
+					StaffID:          &ID,
+					StaffName:        &name,
+					Status:           enums.ServiceRequestStatusPending.String(),
+					AssignedToID:     &ID,
+					AssignedToName:   &name,
+					CreatedAt:        time.Now(),
+				},
+			}, nil
+		},
+		MockSaveScreeningToolDraftFn: func(ctx context.Context, screeningToolID string, questionnaire *domain.Questionnaire) (*domain.ScreeningToolVersion, error) {
+			return &domain.ScreeningToolVersion{
+				ID:              ID,
+				Active:          true,
+				ScreeningToolID: screeningToolID,
+				QuestionnaireID: ID,
+				Version:         2,
+				Status:          enums.ScreeningToolVersionStatusDraft,
+				Questionnaire:   questionnaire,
+			}, nil
+		},
+		MockPublishScreeningToolDraftFn: func(ctx context.Context, screeningToolID string) (*domain.ScreeningToolVersion, error) {
+			publishedAt := time.Now()
+			return &domain.ScreeningToolVersion{
+				ID:              ID,
+				Active:          true,
+				ScreeningToolID: screeningToolID,
+				QuestionnaireID: ID,
+				Version:         2,
+				Status:          enums.ScreeningToolVersionStatusPublished,
+				PublishedAt:     &publishedAt,
+			}, nil
+		},
+		MockListScreeningToolVersionsFn: func(ctx context.Context, screeningToolID string) ([]*domain.ScreeningToolVersion, error) {
+			return []*domain.ScreeningToolVersion{
+				{
+					ID:              ID,
+					Active:          true,
+					ScreeningToolID: screeningToolID,
+					QuestionnaireID: ID,
+					Version:         1,
+					Status:          enums.ScreeningToolVersionStatusPublished,
+				},
+			}, nil
+		},
+		MockGetScreeningToolVersionFn: func(ctx context.Context, screeningToolID string, version int) (*domain.ScreeningToolVersion, error) {
+			return &domain.ScreeningToolVersion{
+				ID:              ID,
+				Active:          true,
+				ScreeningToolID: screeningToolID,
+				QuestionnaireID: ID,
+				Version:         version,
+				Status:          enums.ScreeningToolVersionStatusPublished,
+				Questionnaire: &domain.Questionnaire{
+					ID:     ID,
+					Active: true,
+					Name:   name,
+				},
+			}, nil
+		},
+		MockCreateScreeningToolAssignmentFn: func(ctx context.Context, assignment *domain.ScreeningToolAssignment) (*domain.ScreeningToolAssignment, error) {
+			assignment.ID = ID
+			return assignment, nil
+		},
+		MockListScreeningToolAssignmentsFn: func(ctx context.Context, params *domain.ScreeningToolAssignment) ([]*domain.ScreeningToolAssignment, error) {
+			return []*domain.ScreeningToolAssignment{
+				{
+					ID:              ID,
+					Active:          true,
+					ScreeningToolID: ID,
+					ClientID:        ID,
+					StaffID:         ID,
+					DueDate:         time.Now().AddDate(0, 0, 7),
+					Status:          enums.ScreeningToolAssignmentStatusPending,
+				},
+			}, nil
+		},
+		MockListPendingScreeningToolAssignmentsDueBeforeFn: func(ctx context.Context, dueBefore time.Time) ([]*domain.ScreeningToolAssignment, error) {
+			return []*domain.ScreeningToolAssignment{
+				{
+					ID:              ID,
+					Active:          true,
+					ScreeningToolID: ID,
+					ClientID:        ID,
+					StaffID:         ID,
+					DueDate:         dueBefore,
+					Status:          enums.ScreeningToolAssignmentStatusPending,
+				},
+			}, nil
+		},
+		MockUpdateScreeningToolAssignmentFn: func(ctx context.Context, assignment *domain.ScreeningToolAssignment, updateData map[string]interface{}) error {
+			return nil
+		},
+		MockCompleteScreeningToolAssignmentsFn: func(ctx context.Context, clientID, screeningToolID, responseID string) error {
+			return nil
+		},
+		MockUpdateScreeningToolFn: func(ctx context.Context, screeningTool *domain.ScreeningTool, updateData map[string]interface{}) error {
+			return nil
+		},
+		MockListClientScreeningToolResponsesFn: func(ctx context.Context, clientID, programID string, screeningToolID *string) ([]*domain.QuestionnaireScreeningToolResponse, error) {
+			return []*domain.QuestionnaireScreeningToolResponse{
+				{
+					ID:              ID,
+					Active:          true,
+					ScreeningToolID: ID,
+					FacilityID:      ID,
+					ClientID:        clientID,
+					DateOfResponse:  time.Now().AddDate(0, -1, 0),
+					AggregateScore:  3,
+				},
+				{
+					ID:              ID,
+					Active:          true,
+					ScreeningToolID: ID,
+					FacilityID:      ID,
+					ClientID:        clientID,
+					DateOfResponse:  time.Now(),
+					AggregateScore:  9,
+				},
+			}, nil
+		},
+		MockListClientHealthDiaryEntriesSinceFn: func(ctx context.Context, clientID string, since time.Time) ([]*domain.ClientHealthDiaryEntry, error) {
+			return []*domain.ClientHealthDiaryEntry{
+				{
+					ID:        &ID,
+					Active:    true,
+					Mood:      enums.MoodHappy.String(),
+					Note:      "test",
+					EntryType: enums.ServiceRequestTypeHomePageHealthDiary.String(),
+					ClientID:  clientID,
+					CreatedAt: time.Now().AddDate(0, 0, -1),
+				},
+			}, nil
+		},
+		MockListClientIDsWithHealthDiaryEntriesSinceFn: func(ctx context.Context, since time.Time) ([]string, error) {
+			return []string{ID}, nil
+		},
+		MockCreateHealthDiaryShareFn: func(ctx context.Context, share *domain.HealthDiaryShare) (*domain.HealthDiaryShare, error) {
+			share.ID = &ID
+			return share, nil
+		},
+		MockGetHealthDiaryShareByIDFn: func(ctx context.Context, shareID string) (*domain.HealthDiaryShare, error) {
+			return &domain.HealthDiaryShare{
+				ID:         &shareID,
+				Active:     true,
+				ClientID:   ID,
+				FacilityID: &ID,
+				Scope:      enums.HealthDiaryShareScopeEntireDiary,
+			}, nil
+		},
+		MockListClientHealthDiarySharesFn: func(ctx context.Context, clientID string) ([]*domain.HealthDiaryShare, error) {
+			return []*domain.HealthDiaryShare{
+				{
+					ID:         &ID,
+					Active:     true,
+					ClientID:   clientID,
+					FacilityID: &ID,
+					Scope:      enums.HealthDiaryShareScopeEntireDiary,
+				},
+			}, nil
+		},
+		MockGetCaregiverSharedHealthDiaryEntriesFn: func(ctx context.Context, clientID string, caregiverID string) ([]*domain.ClientHealthDiaryEntry, error) {
+			return []*domain.ClientHealthDiaryEntry{
+				{
+					ID:        &ID,
+					Active:    true,
+					Mood:      enums.MoodHappy.String(),
+					Note:      "test",
+					EntryType: enums.ServiceRequestTypeHomePageHealthDiary.String(),
+					ClientID:  clientID,
+					CreatedAt: time.Now().AddDate(0, 0, -1),
+				},
+			}, nil
+		},
+		MockUpdateHealthDiaryShareFn: func(ctx context.Context, share *domain.HealthDiaryShare, updateData map[string]interface{}) error {
+			return nil
+		},
+		MockCreateSurveyCampaignFn: func(ctx context.Context, campaign *domain.SurveyCampaign) (*domain.SurveyCampaign, error) {
+			campaign.ID = ID
+			return campaign, nil
+		},
+		MockGetSurveyCampaignByIDFn: func(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error) {
+			return &domain.SurveyCampaign{
+				ID:             campaignID,
+				Active:         true,
+				Title:          "Monthly check in",
+				ProjectID:      1,
+				FormID:         ID,
+				FacilityID:     ID,
+				Recurrence:     enums.SurveyCampaignRecurrenceMonthly,
+				Status:         enums.SurveyCampaignStatusScheduled,
+				NextSendAt:     &currentTime,
+				LinkExpiryDays: 7,
+			}, nil
+		},
+		MockListSurveyCampaignsFn: func(ctx context.Context, facilityID, programID string) ([]*domain.SurveyCampaign, error) {
+			return []*domain.SurveyCampaign{
+				{
+					ID:             ID,
+					Active:         true,
+					Title:          "Monthly check in",
+					ProjectID:      1,
+					FormID:         ID,
+					FacilityID:     facilityID,
+					Recurrence:     enums.SurveyCampaignRecurrenceMonthly,
+					Status:         enums.SurveyCampaignStatusScheduled,
+					NextSendAt:     &currentTime,
+					LinkExpiryDays: 7,
+				},
+			}, nil
+		},
+		MockListOngoingSurveyCampaignsFn: func(ctx context.Context) ([]*domain.SurveyCampaign, error) {
+			return []*domain.SurveyCampaign{
+				{
+					ID:             ID,
+					Active:         true,
+					Title:          "Monthly check in",
+					ProjectID:      1,
+					FormID:         ID,
+					FacilityID:     ID,
+					Recurrence:     enums.SurveyCampaignRecurrenceMonthly,
+					Status:         enums.SurveyCampaignStatusScheduled,
+					NextSendAt:     &currentTime,
+					LinkExpiryDays: 7,
+				},
+			}, nil
+		},
+		MockUpdateSurveyCampaignFn: func(ctx context.Context, campaign *domain.SurveyCampaign, updateData map[string]interface{}) error {
+			return nil
+		},
+		MockCreateSurveyRedFlagRuleFn: func(ctx context.Context, rule *domain.SurveyRedFlagRule) (*domain.SurveyRedFlagRule, error) {
+			rule.ID = ID
+			return rule, nil
+		},
+		MockListSurveyRedFlagRulesFn: func(ctx context.Context, projectID int, formID, programID string) ([]*domain.SurveyRedFlagRule, error) {
+			return []*domain.SurveyRedFlagRule{
+				{
+					ID:         ID,
+					Active:     true,
+					Name:       "Feels unsafe",
+					ProjectID:  projectID,
+					FormID:     formID,
+					Combinator: enums.SurveyRuleCombinatorAnd,
+					Conditions: []domain.SurveyRuleCondition{
+						{Field: "feeling_unsafe", Operator: enums.SurveyRuleOperatorEquals, Value: "yes"},
+					},
+				},
+			}, nil
+		},
+		MockUpdateSurveyRedFlagRuleFn: func(ctx context.Context, rule *domain.SurveyRedFlagRule, updateData map[string]interface{}) error {
+			return nil
+		},
+		MockListAllCommunitiesFn: func(ctx context.Context) ([]*domain.Community, error) {
+			return []*domain.Community{
+				{
+					ID:        ID,
+					RoomID:    ID,
+					Name:      gofakeit.Name(),
+					ProgramID: ID,
+				},
+			}, nil
+		},
+		MockListProgramClientsFn: func(ctx context.Context, programID string) ([]*domain.ClientProfile, error) {
+			return []*domain.ClientProfile{clientProfile}, nil
+		},
+		MockGetUserMatrixPasswordFn: func(ctx context.Context, userID string) (string, error) {
+			return gofakeit.Password(true, true, true, false, false, 32), nil
+		},
+		MockListUsersWithoutMatrixPasswordFn: func(ctx context.Context) ([]*domain.User, error) {
+			return []*domain.User{
+				{
+					ID:       &ID,
+					Username: gofakeit.Username(),
+				},
+			}, nil
+		},
+		MockCreateCommunityCrisisKeywordFn: func(ctx context.Context, keyword *domain.CommunityCrisisKeyword) (*domain.CommunityCrisisKeyword, error) {
+			keyword.ID = ID
+			return keyword, nil
+		},
+		MockListCommunityCrisisKeywordsFn: func(ctx context.Context, programID string) ([]*domain.CommunityCrisisKeyword, error) {
+			return []*domain.CommunityCrisisKeyword{
+				{
+					ID:        ID,
+					Active:    true,
+					Keyword:   "end my life",
+					Category:  enums.CommunityCrisisCategorySelfHarm,
+					ProgramID: programID,
+				},
+			}, nil
+		},
+		MockGetCommunityByRoomIDFn: func(ctx context.Context, roomID string) (*domain.Community, error) {
+			return &domain.Community{
+				ID:        ID,
+				RoomID:    roomID,
+				Name:      gofakeit.Name(),
+				ProgramID: ID,
+			}, nil
+		},
+		MockUpdateCommunityCrisisKeywordFn: func(ctx context.Context, keyword *domain.CommunityCrisisKeyword, updateData map[string]interface{}) error {
+			return nil
+		},
+		MockCheckCrisisMessageFlaggedFn: func(ctx context.Context, eventID string) (bool, error) {
+			return false, nil
+		},
+		MockSetUserMatrixPasswordIfUnsetFn: func(ctx context.Context, userID string, encryptedPassword string) (bool, error) {
+			return true, nil
+		},
+		MockGetSurveyRedFlagRuleByIDFn: func(ctx context.Context, ruleID string) (*domain.SurveyRedFlagRule, error) {
+			return &domain.SurveyRedFlagRule{
+				ID:         ruleID,
+				Active:     true,
+				Name:       "Feels unsafe",
+				ProjectID:  1,
+				FormID:     ID,
+				Combinator: enums.SurveyRuleCombinatorAnd,
+				Conditions: []domain.SurveyRuleCondition{
+					{Field: "feeling_unsafe", Operator: enums.SurveyRuleOperatorEquals, Value: "yes"},
+				},
+			}, nil
+		},
+		MockClaimSurveyCampaignRoundFn: func(ctx context.Context, campaign *domain.SurveyCampaign, updateData map[string]interface{}) (bool, error) {
+			return true, nil
+		},
 	}
 }
 
@@ -1986,8 +2645,8 @@ func (gm *PostgresMock) GetClientsInAFacility(ctx context.Context, facilityID st
 }
 
 // GetRecentHealthDiaryEntries mocks getting the most recent health diary entry
-func (gm *PostgresMock) GetRecentHealthDiaryEntries(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile) ([]*domain.ClientHealthDiaryEntry, error) {
-	return gm.MockGetRecentHealthDiaryEntriesFn(ctx, lastSyncTime, client)
+func (gm *PostgresMock) GetRecentHealthDiaryEntries(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile, facilityID string) ([]*domain.ClientHealthDiaryEntry, error) {
+	return gm.MockGetRecentHealthDiaryEntriesFn(ctx, lastSyncTime, client, facilityID)
 }
 
 // GetClientsByParams retrieves client profiles matching the provided parameters
@@ -2266,9 +2925,9 @@ func (gm *PostgresMock) GetAvailableScreeningTools(ctx context.Context, clientID
 	return gm.MockGetAvailableScreeningToolsFn(ctx, clientID, screeningTool, screeningToolIDs)
 }
 
-// GetScreeningToolResponsesWithin24Hours mocks the implementation of GetScreeningToolResponsesWithin24Hours method
-func (gm *PostgresMock) GetScreeningToolResponsesWithin24Hours(ctx context.Context, clientID, programID string) ([]*domain.QuestionnaireScreeningToolResponse, error) {
-	return gm.MockGetScreeningToolResponsesWithin24HoursFn(ctx, clientID, programID)
+// GetLatestScreeningToolResponses mocks the implementation of GetLatestScreeningToolResponses method
+func (gm *PostgresMock) GetLatestScreeningToolResponses(ctx context.Context, clientID, programID string) ([]*domain.QuestionnaireScreeningToolResponse, error) {
+	return gm.MockGetLatestScreeningToolResponsesFn(ctx, clientID, programID)
 }
 
 // GetScreeningToolResponsesWithPendingServiceRequests mocks the implementation of GetScreeningToolResponsesWithPendingServiceRequests method
@@ -2525,3 +3184,363 @@ func (gm *PostgresMock) UpdateProgram(ctx context.Context, program *domain.Progr
 func (gm *PostgresMock) GetStaffServiceRequestByID(ctx context.Context, serviceRequestID string) (*domain.ServiceRequest, error) {
 	return gm.MockGetStaffServiceRequestByIDFn(ctx, serviceRequestID)
 }
+
+// GetUserPermissions mocks the implementation of retrieving the permissions granted to a user
+func (gm *PostgresMock) GetUserPermissions(ctx context.Context, userID string, programID string) ([]*domain.AuthorityPermission, error) {
+	return gm.MockGetUserPermissionsFn(ctx, userID, programID)
+}
+
+// CreateAuditLog mocks the implementation of saving an audit log
+func (gm *PostgresMock) CreateAuditLog(ctx context.Context, auditLog *domain.AuditLog) error {
+	return gm.MockCreateAuditLogFn(ctx, auditLog)
+}
+
+// ListAuditLogs mocks the implementation of listing audit logs
+func (gm *PostgresMock) ListAuditLogs(ctx context.Context, organisationID string, filter *dto.AuditLogFilterInput, pagination *domain.Pagination) ([]*domain.AuditLog, *domain.Pagination, error) {
+	return gm.MockListAuditLogsFn(ctx, organisationID, filter, pagination)
+}
+
+// CreateAuthorityRole mocks the implementation of creating a custom role
+func (gm *PostgresMock) CreateAuthorityRole(ctx context.Context, role *domain.AuthorityRole) (*domain.AuthorityRole, error) {
+	return gm.MockCreateAuthorityRoleFn(ctx, role)
+}
+
+// AssignStaffRole mocks the implementation of assigning a role to a staff
+func (gm *PostgresMock) AssignStaffRole(ctx context.Context, staffID string, roleID string) error {
+	return gm.MockAssignStaffRoleFn(ctx, staffID, roleID)
+}
+
+// GetAuthorityRoleByID mocks the implementation of retrieving a role using its ID
+func (gm *PostgresMock) GetAuthorityRoleByID(ctx context.Context, roleID string) (*domain.AuthorityRole, error) {
+	return gm.MockGetAuthorityRoleByIDFn(ctx, roleID)
+}
+
+// ListAuthorityRoles mocks the implementation of listing the roles in an organisation
+func (gm *PostgresMock) ListAuthorityRoles(ctx context.Context, organisationID string, programID string) ([]*domain.AuthorityRole, error) {
+	return gm.MockListAuthorityRolesFn(ctx, organisationID, programID)
+}
+
+// ListAuthorityRoleStaff mocks the implementation of listing the staff that have been assigned a role
+func (gm *PostgresMock) ListAuthorityRoleStaff(ctx context.Context, roleID string, pagination *domain.Pagination) ([]*domain.StaffProfile, *domain.Pagination, error) {
+	return gm.MockListAuthorityRoleStaffFn(ctx, roleID, pagination)
+}
+
+// CheckStaffHasRole mocks the implementation of checking whether a staff has been assigned a role
+func (gm *PostgresMock) CheckStaffHasRole(ctx context.Context, staffID string, roleID string) (bool, error) {
+	return gm.MockCheckStaffHasRoleFn(ctx, staffID, roleID)
+}
+
+// UpdateAuthorityRole mocks the implementation of updating a custom role
+func (gm *PostgresMock) UpdateAuthorityRole(ctx context.Context, roleID string, updateData map[string]interface{}, permissions []domain.AuthorityPermission) error {
+	return gm.MockUpdateAuthorityRoleFn(ctx, roleID, updateData, permissions)
+}
+
+// DeleteAuthorityRole mocks the implementation of deleting a role
+func (gm *PostgresMock) DeleteAuthorityRole(ctx context.Context, roleID string) error {
+	return gm.MockDeleteAuthorityRoleFn(ctx, roleID)
+}
+
+// RevokeStaffRole mocks the implementation of revoking a staff role
+func (gm *PostgresMock) RevokeStaffRole(ctx context.Context, staffID string, roleID string) error {
+	return gm.MockRevokeStaffRoleFn(ctx, staffID, roleID)
+}
+
+// GetRecentOTPsCount mocks the implementation of counting the OTPs generated for a phone number since a given time
+func (gm *PostgresMock) GetRecentOTPsCount(ctx context.Context, phoneNumber string, since time.Time) (int, error) {
+	return gm.MockGetRecentOTPsCountFn(ctx, phoneNumber, since)
+}
+
+// CreateSMSDelivery mocks the implementation of creating an SMS delivery record
+func (gm *PostgresMock) CreateSMSDelivery(ctx context.Context, delivery *domain.SMSDelivery) (*domain.SMSDelivery, error) {
+	return gm.MockCreateSMSDeliveryFn(ctx, delivery)
+}
+
+// UpdateSMSDelivery mocks the implementation of updating an SMS delivery record
+func (gm *PostgresMock) UpdateSMSDelivery(ctx context.Context, delivery *domain.SMSDelivery, updateData map[string]interface{}) error {
+	return gm.MockUpdateSMSDeliveryFn(ctx, delivery, updateData)
+}
+
+// ListAppointmentsPendingReminder mocks the implementation of listing appointments that are due for a reminder
+func (gm *PostgresMock) ListAppointmentsPendingReminder(ctx context.Context, appointmentDate time.Time, offsetDays int) ([]*domain.Appointment, error) {
+	return gm.MockListAppointmentsPendingReminderFn(ctx, appointmentDate, offsetDays)
+}
+
+// CreateAppointmentReminder mocks the implementation of recording an appointment reminder
+func (gm *PostgresMock) CreateAppointmentReminder(ctx context.Context, reminder *domain.AppointmentReminder) error {
+	return gm.MockCreateAppointmentReminderFn(ctx, reminder)
+}
+
+// GetNotificationPreferences mocks the implementation of retrieving the notification preferences of a user
+func (gm *PostgresMock) GetNotificationPreferences(ctx context.Context, userID string) ([]*domain.NotificationPreference, error) {
+	return gm.MockGetNotificationPreferencesFn(ctx, userID)
+}
+
+// SaveNotificationPreference mocks the implementation of saving a notification preference
+func (gm *PostgresMock) SaveNotificationPreference(ctx context.Context, preference *domain.NotificationPreference) error {
+	return gm.MockSaveNotificationPreferenceFn(ctx, preference)
+}
+
+// ListMissedAppointments mocks the implementation of listing missed appointments
+func (gm *PostgresMock) ListMissedAppointments(ctx context.Context, before time.Time) ([]*domain.Appointment, error) {
+	return gm.MockListMissedAppointmentsFn(ctx, before)
+}
+
+// MarkAppointmentMissed mocks the implementation of marking an appointment as missed
+func (gm *PostgresMock) MarkAppointmentMissed(ctx context.Context, appointmentID string, serviceRequestInput *dto.ServiceRequestInput) error {
+	return gm.MockMarkAppointmentMissedFn(ctx, appointmentID, serviceRequestInput)
+}
+
+// CreateAppointmentSlot mocks the implementation of creating an appointment slot
+func (gm *PostgresMock) CreateAppointmentSlot(ctx context.Context, slot *domain.AppointmentSlot) (*domain.AppointmentSlot, error) {
+	return gm.MockCreateAppointmentSlotFn(ctx, slot)
+}
+
+// BookAppointmentSlot mocks the implementation of booking an appointment slot
+func (gm *PostgresMock) BookAppointmentSlot(ctx context.Context, appointment *domain.Appointment, serviceRequestInput *dto.ServiceRequestInput) (*domain.Appointment, error) {
+	return gm.MockBookAppointmentSlotFn(ctx, appointment, serviceRequestInput)
+}
+
+// GetAppointmentSlot mocks the implementation of getting an appointment slot
+func (gm *PostgresMock) GetAppointmentSlot(ctx context.Context, slotID string) (*domain.AppointmentSlot, error) {
+	return gm.MockGetAppointmentSlotFn(ctx, slotID)
+}
+
+// ListAppointmentSlots mocks the implementation of listing the appointment slots of a facility
+func (gm *PostgresMock) ListAppointmentSlots(ctx context.Context, facilityID string) ([]*domain.AppointmentSlot, error) {
+	return gm.MockListAppointmentSlotsFn(ctx, facilityID)
+}
+
+// GetAppointmentSlotBookingCounts mocks the implementation of counting appointment slot bookings
+func (gm *PostgresMock) GetAppointmentSlotBookingCounts(ctx context.Context, facilityID string, startDate, endDate time.Time) ([]*domain.AppointmentSlotBookingCount, error) {
+	return gm.MockGetAppointmentSlotBookingCountsFn(ctx, facilityID, startDate, endDate)
+}
+
+// DeactivateAppointmentSlot mocks the implementation of deactivating an appointment slot
+func (gm *PostgresMock) DeactivateAppointmentSlot(ctx context.Context, slotID string) error {
+	return gm.MockDeactivateAppointmentSlotFn(ctx, slotID)
+}
+
+// CancelAppointmentBooking mocks the implementation of cancelling a booked appointment
+func (gm *PostgresMock) CancelAppointmentBooking(ctx context.Context, appointmentID string, serviceRequestInput *dto.ServiceRequestInput) error {
+	return gm.MockCancelAppointmentBookingFn(ctx, appointmentID, serviceRequestInput)
+}
+
+// ListOverdueServiceRequests mocks the implementation of listing overdue service requests
+func (gm *PostgresMock) ListOverdueServiceRequests(ctx context.Context, requestType string, createdBefore time.Time) ([]*domain.ServiceRequest, error) {
+	return gm.MockListOverdueServiceRequestsFn(ctx, requestType, createdBefore)
+}
+
+// MarkServiceRequestEscalated mocks the implementation of marking a service request as escalated
+func (gm *PostgresMock) MarkServiceRequestEscalated(ctx context.Context, serviceRequestID string, escalatedAt time.Time) (bool, error) {
+	return gm.MockMarkServiceRequestEscalatedFn(ctx, serviceRequestID, escalatedAt)
+}
+
+// CreateServiceRequestComment mocks the implementation of creating a service request comment
+func (gm *PostgresMock) CreateServiceRequestComment(ctx context.Context, comment *domain.ServiceRequestComment) (*domain.ServiceRequestComment, error) {
+	return gm.MockCreateServiceRequestCommentFn(ctx, comment)
+}
+
+// CreateServiceRequestEvent mocks the implementation of creating a service request event
+func (gm *PostgresMock) CreateServiceRequestEvent(ctx context.Context, event *domain.ServiceRequestEvent) error {
+	return gm.MockCreateServiceRequestEventFn(ctx, event)
+}
+
+// ListServiceRequestComments mocks the implementation of listing service request comments
+func (gm *PostgresMock) ListServiceRequestComments(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestComment, error) {
+	return gm.MockListServiceRequestCommentsFn(ctx, serviceRequestID)
+}
+
+// ListServiceRequestEvents mocks the implementation of listing service request events
+func (gm *PostgresMock) ListServiceRequestEvents(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestEvent, error) {
+	return gm.MockListServiceRequestEventsFn(ctx, serviceRequestID)
+}
+
+// SaveScreeningToolDraft mocks the implementation of saving the draft version of a screening tool
+func (gm *PostgresMock) SaveScreeningToolDraft(ctx context.Context, screeningToolID string, questionnaire *domain.Questionnaire) (*domain.ScreeningToolVersion, error) {
+	return gm.MockSaveScreeningToolDraftFn(ctx, screeningToolID, questionnaire)
+}
+
+// PublishScreeningToolDraft mocks the implementation of publishing the draft version of a screening tool
+func (gm *PostgresMock) PublishScreeningToolDraft(ctx context.Context, screeningToolID string) (*domain.ScreeningToolVersion, error) {
+	return gm.MockPublishScreeningToolDraftFn(ctx, screeningToolID)
+}
+
+// ListScreeningToolVersions mocks the implementation of listing the versions of a screening tool
+func (gm *PostgresMock) ListScreeningToolVersions(ctx context.Context, screeningToolID string) ([]*domain.ScreeningToolVersion, error) {
+	return gm.MockListScreeningToolVersionsFn(ctx, screeningToolID)
+}
+
+// GetScreeningToolVersion mocks the implementation of getting a version of a screening tool
+func (gm *PostgresMock) GetScreeningToolVersion(ctx context.Context, screeningToolID string, version int) (*domain.ScreeningToolVersion, error) {
+	return gm.MockGetScreeningToolVersionFn(ctx, screeningToolID, version)
+}
+
+// CreateScreeningToolAssignment mocks the implementation of recording a screening tool assignment
+func (gm *PostgresMock) CreateScreeningToolAssignment(ctx context.Context, assignment *domain.ScreeningToolAssignment) (*domain.ScreeningToolAssignment, error) {
+	return gm.MockCreateScreeningToolAssignmentFn(ctx, assignment)
+}
+
+// ListScreeningToolAssignments mocks the implementation of listing screening tool assignments
+func (gm *PostgresMock) ListScreeningToolAssignments(ctx context.Context, params *domain.ScreeningToolAssignment) ([]*domain.ScreeningToolAssignment, error) {
+	return gm.MockListScreeningToolAssignmentsFn(ctx, params)
+}
+
+// ListPendingScreeningToolAssignmentsDueBefore mocks the implementation of listing pending screening tool assignments that are due
+func (gm *PostgresMock) ListPendingScreeningToolAssignmentsDueBefore(ctx context.Context, dueBefore time.Time) ([]*domain.ScreeningToolAssignment, error) {
+	return gm.MockListPendingScreeningToolAssignmentsDueBeforeFn(ctx, dueBefore)
+}
+
+// UpdateScreeningToolAssignment mocks the implementation of updating a screening tool assignment
+func (gm *PostgresMock) UpdateScreeningToolAssignment(ctx context.Context, assignment *domain.ScreeningToolAssignment, updateData map[string]interface{}) error {
+	return gm.MockUpdateScreeningToolAssignmentFn(ctx, assignment, updateData)
+}
+
+// CompleteScreeningToolAssignments mocks the implementation of completing the pending assignments of a screening tool
+func (gm *PostgresMock) CompleteScreeningToolAssignments(ctx context.Context, clientID, screeningToolID, responseID string) error {
+	return gm.MockCompleteScreeningToolAssignmentsFn(ctx, clientID, screeningToolID, responseID)
+}
+
+// UpdateScreeningTool mocks the implementation of updating a screening tool
+func (gm *PostgresMock) UpdateScreeningTool(ctx context.Context, screeningTool *domain.ScreeningTool, updateData map[string]interface{}) error {
+	return gm.MockUpdateScreeningToolFn(ctx, screeningTool, updateData)
+}
+
+// ListClientScreeningToolResponses mocks the implementation of listing a client's screening tool responses
+func (gm *PostgresMock) ListClientScreeningToolResponses(ctx context.Context, clientID, programID string, screeningToolID *string) ([]*domain.QuestionnaireScreeningToolResponse, error) {
+	return gm.MockListClientScreeningToolResponsesFn(ctx, clientID, programID, screeningToolID)
+}
+
+// ListClientHealthDiaryEntriesSince mocks the implementation of listing the health diary entries a client recorded after a time
+func (gm *PostgresMock) ListClientHealthDiaryEntriesSince(ctx context.Context, clientID string, since time.Time) ([]*domain.ClientHealthDiaryEntry, error) {
+	return gm.MockListClientHealthDiaryEntriesSinceFn(ctx, clientID, since)
+}
+
+// ListClientIDsWithHealthDiaryEntriesSince mocks the implementation of listing the clients who recorded health diary entries after a time
+func (gm *PostgresMock) ListClientIDsWithHealthDiaryEntriesSince(ctx context.Context, since time.Time) ([]string, error) {
+	return gm.MockListClientIDsWithHealthDiaryEntriesSinceFn(ctx, since)
+}
+
+// CreateHealthDiaryShare mocks the implementation of recording a health diary share
+func (gm *PostgresMock) CreateHealthDiaryShare(ctx context.Context, share *domain.HealthDiaryShare) (*domain.HealthDiaryShare, error) {
+	return gm.MockCreateHealthDiaryShareFn(ctx, share)
+}
+
+// GetHealthDiaryShareByID mocks the implementation of getting a health diary share by ID
+func (gm *PostgresMock) GetHealthDiaryShareByID(ctx context.Context, shareID string) (*domain.HealthDiaryShare, error) {
+	return gm.MockGetHealthDiaryShareByIDFn(ctx, shareID)
+}
+
+// ListClientHealthDiaryShares mocks the implementation of listing the grants to a client's health diary
+func (gm *PostgresMock) ListClientHealthDiaryShares(ctx context.Context, clientID string) ([]*domain.HealthDiaryShare, error) {
+	return gm.MockListClientHealthDiarySharesFn(ctx, clientID)
+}
+
+// GetCaregiverSharedHealthDiaryEntries mocks the implementation of getting the health diary entries a client shares with a caregiver
+func (gm *PostgresMock) GetCaregiverSharedHealthDiaryEntries(ctx context.Context, clientID string, caregiverID string) ([]*domain.ClientHealthDiaryEntry, error) {
+	return gm.MockGetCaregiverSharedHealthDiaryEntriesFn(ctx, clientID, caregiverID)
+}
+
+// UpdateHealthDiaryShare mocks the implementation of updating a health diary share
+func (gm *PostgresMock) UpdateHealthDiaryShare(ctx context.Context, share *domain.HealthDiaryShare, updateData map[string]interface{}) error {
+	return gm.MockUpdateHealthDiaryShareFn(ctx, share, updateData)
+}
+
+// CreateSurveyCampaign mocks the implementation of saving a survey campaign
+func (gm *PostgresMock) CreateSurveyCampaign(ctx context.Context, campaign *domain.SurveyCampaign) (*domain.SurveyCampaign, error) {
+	return gm.MockCreateSurveyCampaignFn(ctx, campaign)
+}
+
+// GetSurveyCampaignByID mocks the implementation of getting a survey campaign by ID
+func (gm *PostgresMock) GetSurveyCampaignByID(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error) {
+	return gm.MockGetSurveyCampaignByIDFn(ctx, campaignID)
+}
+
+// ListSurveyCampaigns mocks the implementation of listing the survey campaigns of a facility
+func (gm *PostgresMock) ListSurveyCampaigns(ctx context.Context, facilityID, programID string) ([]*domain.SurveyCampaign, error) {
+	return gm.MockListSurveyCampaignsFn(ctx, facilityID, programID)
+}
+
+// ListOngoingSurveyCampaigns mocks the implementation of listing the scheduled and active survey campaigns
+func (gm *PostgresMock) ListOngoingSurveyCampaigns(ctx context.Context) ([]*domain.SurveyCampaign, error) {
+	return gm.MockListOngoingSurveyCampaignsFn(ctx)
+}
+
+// UpdateSurveyCampaign mocks the implementation of updating a survey campaign
+func (gm *PostgresMock) UpdateSurveyCampaign(ctx context.Context, campaign *domain.SurveyCampaign, updateData map[string]interface{}) error {
+	return gm.MockUpdateSurveyCampaignFn(ctx, campaign, updateData)
+}
+
+// CreateSurveyRedFlagRule mocks the implementation of saving a survey red flag rule
+func (gm *PostgresMock) CreateSurveyRedFlagRule(ctx context.Context, rule *domain.SurveyRedFlagRule) (*domain.SurveyRedFlagRule, error) {
+	return gm.MockCreateSurveyRedFlagRuleFn(ctx, rule)
+}
+
+// ListSurveyRedFlagRules mocks the implementation of listing the red flag rules of a survey form
+func (gm *PostgresMock) ListSurveyRedFlagRules(ctx context.Context, projectID int, formID, programID string) ([]*domain.SurveyRedFlagRule, error) {
+	return gm.MockListSurveyRedFlagRulesFn(ctx, projectID, formID, programID)
+}
+
+// UpdateSurveyRedFlagRule mocks the implementation of updating a survey red flag rule
+func (gm *PostgresMock) UpdateSurveyRedFlagRule(ctx context.Context, rule *domain.SurveyRedFlagRule, updateData map[string]interface{}) error {
+	return gm.MockUpdateSurveyRedFlagRuleFn(ctx, rule, updateData)
+}
+
+// ListAllCommunities mocks the implementation of listing all the active communities
+func (gm *PostgresMock) ListAllCommunities(ctx context.Context) ([]*domain.Community, error) {
+	return gm.MockListAllCommunitiesFn(ctx)
+}
+
+// ListProgramClients mocks the implementation of listing the clients of a program
+func (gm *PostgresMock) ListProgramClients(ctx context.Context, programID string) ([]*domain.ClientProfile, error) {
+	return gm.MockListProgramClientsFn(ctx, programID)
+}
+
+// GetUserMatrixPassword mocks the implementation of getting the encrypted Matrix password of a user
+func (gm *PostgresMock) GetUserMatrixPassword(ctx context.Context, userID string) (string, error) {
+	return gm.MockGetUserMatrixPasswordFn(ctx, userID)
+}
+
+// ListUsersWithoutMatrixPassword mocks the implementation of listing the users whose Matrix password is not managed yet
+func (gm *PostgresMock) ListUsersWithoutMatrixPassword(ctx context.Context) ([]*domain.User, error) {
+	return gm.MockListUsersWithoutMatrixPasswordFn(ctx)
+}
+
+// CreateCommunityCrisisKeyword mocks the implementation of saving a community crisis keyword
+func (gm *PostgresMock) CreateCommunityCrisisKeyword(ctx context.Context, keyword *domain.CommunityCrisisKeyword) (*domain.CommunityCrisisKeyword, error) {
+	return gm.MockCreateCommunityCrisisKeywordFn(ctx, keyword)
+}
+
+// ListCommunityCrisisKeywords mocks the implementation of listing the crisis keywords of a program
+func (gm *PostgresMock) ListCommunityCrisisKeywords(ctx context.Context, programID string) ([]*domain.CommunityCrisisKeyword, error) {
+	return gm.MockListCommunityCrisisKeywordsFn(ctx, programID)
+}
+
+// GetCommunityByRoomID mocks the implementation of fetching a community by its Matrix room ID
+func (gm *PostgresMock) GetCommunityByRoomID(ctx context.Context, roomID string) (*domain.Community, error) {
+	return gm.MockGetCommunityByRoomIDFn(ctx, roomID)
+}
+
+// UpdateCommunityCrisisKeyword mocks the implementation of updating a community crisis keyword
+func (gm *PostgresMock) UpdateCommunityCrisisKeyword(ctx context.Context, keyword *domain.CommunityCrisisKeyword, updateData map[string]interface{}) error {
+	return gm.MockUpdateCommunityCrisisKeywordFn(ctx, keyword, updateData)
+}
+
+// CheckCrisisMessageFlagged mocks the implementation of checking whether a community message has already been flagged
+func (gm *PostgresMock) CheckCrisisMessageFlagged(ctx context.Context, eventID string) (bool, error) {
+	return gm.MockCheckCrisisMessageFlaggedFn(ctx, eventID)
+}
+
+// SetUserMatrixPasswordIfUnset mocks the implementation of saving the Matrix password of a user who does not have one yet
+func (gm *PostgresMock) SetUserMatrixPasswordIfUnset(ctx context.Context, userID string, encryptedPassword string) (bool, error) {
+	return gm.MockSetUserMatrixPasswordIfUnsetFn(ctx, userID, encryptedPassword)
+}
+
+// GetSurveyRedFlagRuleByID mocks the implementation of getting a survey red flag rule by its ID
+func (gm *PostgresMock) GetSurveyRedFlagRuleByID(ctx context.Context, ruleID string) (*domain.SurveyRedFlagRule, error) {
+	return gm.MockGetSurveyRedFlagRuleByIDFn(ctx, ruleID)
+}
+
+// ClaimSurveyCampaignRound mocks the implementation of claiming the next round of a survey campaign
+func (gm *PostgresMock) ClaimSurveyCampaignRound(ctx context.Context, campaign *domain.SurveyCampaign, updateData map[string]interface{}) (bool, error) {
+	return gm.MockClaimSurveyCampaignRoundFn(ctx, campaign, updateData)
+}
@@ -5,9 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/lib/pq"
-	"github.com/savannahghi/enumutils"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
@@ -217,36 +217,16 @@ func (d *MyCareHubDb) CreateCommunity(ctx context.Context, community *domain.Com
 		ProgramID:      community.ProgramID,
 		OrganisationID: community.OrganisationID,
 	}
+	if community.FacilityID != "" {
+		input.FacilityID = &community.FacilityID
+	}
 
 	record, err := d.create.CreateCommunity(ctx, input)
 	if err != nil {
 		return nil, err
 	}
 
-	var genders []enumutils.Gender
-	for _, k := range record.Gender {
-		genders = append(genders, enumutils.Gender(k))
-	}
-
-	var clientTypes []enums.ClientType
-	for _, k := range record.ClientTypes {
-		clientTypes = append(clientTypes, enums.ClientType(k))
-	}
-
-	return &domain.Community{
-		ID:          record.ID,
-		Name:        record.Name,
-		RoomID:      record.RoomID,
-		Description: record.Description,
-		AgeRange: &domain.AgeRange{
-			LowerBound: record.MinimumAge,
-			UpperBound: record.MaximumAge,
-		},
-		Gender:         genders,
-		ClientType:     clientTypes,
-		OrganisationID: record.OrganisationID,
-		ProgramID:      record.ProgramID,
-	}, nil
+	return mapCommunity(record), nil
 }
 
 // GetOrCreateNextOfKin creates a related person who is a next of kin
@@ -654,6 +634,8 @@ func (d *MyCareHubDb) CreateUserSurveys(ctx context.Context, surveys []*dto.User
 			Token:          survey.Token,
 			ProgramID:      survey.ProgramID,
 			OrganisationID: survey.OrganisationID,
+			CampaignID:     survey.CampaignID,
+			ExpiresAt:      survey.ExpiresAt,
 		})
 	}
 
@@ -795,15 +777,7 @@ func (d *MyCareHubDb) RegisterExistingUserAsStaff(ctx context.Context, payload *
 
 // CreateScreeningTool maps the screening tool domain model to database model to create screening tools
 func (d *MyCareHubDb) CreateScreeningTool(ctx context.Context, input *domain.ScreeningTool) error {
-	questionnaire := &gorm.Questionnaire{
-		Active:         input.Questionnaire.Active,
-		Name:           input.Questionnaire.Name,
-		Description:    input.Questionnaire.Description,
-		ProgramID:      input.ProgramID,
-		OrganisationID: input.OrganisationID,
-	}
-
-	err := d.create.CreateQuestionnaire(ctx, questionnaire)
+	questionnaireID, err := d.createQuestionnaire(ctx, &input.Questionnaire, input.ProgramID, input.OrganisationID)
 	if err != nil {
 		return err
 	}
@@ -818,7 +792,7 @@ func (d *MyCareHubDb) CreateScreeningTool(ctx context.Context, input *domain.Scr
 	}
 	screeningtool := &gorm.ScreeningTool{
 		Active:          input.Active,
-		QuestionnaireID: questionnaire.ID,
+		QuestionnaireID: questionnaireID,
 		Threshold:       input.Threshold,
 		ClientTypes:     clientTypes,
 		Genders:         genders,
@@ -826,6 +800,11 @@ func (d *MyCareHubDb) CreateScreeningTool(ctx context.Context, input *domain.Scr
 		MaximumAge:      input.AgeRange.UpperBound,
 		ProgramID:       input.ProgramID,
 		OrganisationID:  input.OrganisationID,
+
+		Recurrence:             input.Recurrence.String(),
+		RecurrenceIntervalDays: input.RecurrenceIntervalDays,
+
+		ScoreWorseningThreshold: input.ScoreWorseningThreshold,
 	}
 
 	err = d.create.CreateScreeningTool(ctx, screeningtool)
@@ -833,22 +812,83 @@ func (d *MyCareHubDb) CreateScreeningTool(ctx context.Context, input *domain.Scr
 		return err
 	}
 
-	for _, q := range input.Questionnaire.Questions {
+	// the initial questionnaire is the first published version of the screening tool
+	publishedAt := time.Now()
+	err = d.create.CreateScreeningToolVersion(ctx, &gorm.ScreeningToolVersion{
+		Active:          true,
+		ScreeningToolID: screeningtool.ID,
+		QuestionnaireID: questionnaireID,
+		Version:         1,
+		Status:          enums.ScreeningToolVersionStatusPublished.String(),
+		PublishedAt:     &publishedAt,
+		ProgramID:       input.ProgramID,
+		OrganisationID:  input.OrganisationID,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, b := range input.ScoreBands {
+		var priority *string
+		if b.Priority != nil {
+			p := b.Priority.String()
+			priority = &p
+		}
+		scoreBand := &gorm.ScreeningToolScoreBand{
+			Active:          true,
+			ScreeningToolID: screeningtool.ID,
+			Label:           b.Label,
+			MinScore:        b.MinScore,
+			MaxScore:        b.MaxScore,
+			Action:          b.Action.String(),
+			Priority:        priority,
+			ProgramID:       input.ProgramID,
+			OrganisationID:  input.OrganisationID,
+		}
+		err := d.create.CreateScreeningToolScoreBand(ctx, scoreBand)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
+
+// createQuestionnaire saves a questionnaire together with its questions, their choices and display conditions.
+// It returns the ID of the created questionnaire
+func (d *MyCareHubDb) createQuestionnaire(ctx context.Context, input *domain.Questionnaire, programID, organisationID string) (string, error) {
+	questionnaire := &gorm.Questionnaire{
+		Active:         input.Active,
+		Name:           input.Name,
+		Description:    input.Description,
+		ProgramID:      programID,
+		OrganisationID: organisationID,
+	}
+
+	err := d.create.CreateQuestionnaire(ctx, questionnaire)
+	if err != nil {
+		return "", err
+	}
+
+	questionIDs := map[int]string{}
+	for _, q := range input.Questions {
 		question := &gorm.Question{
-			Active:            q.Active,
-			QuestionnaireID:   questionnaire.ID,
-			Text:              q.Text,
-			QuestionType:      q.QuestionType.String(),
-			ResponseValueType: q.ResponseValueType.String(),
-			SelectMultiple:    q.SelectMultiple,
-			Required:          q.Required,
-			Sequence:          q.Sequence,
-			ProgramID:         q.ProgramID,
-			OrganisationID:    q.OrganisationID,
+			Active:                q.Active,
+			QuestionnaireID:       questionnaire.ID,
+			Text:                  q.Text,
+			QuestionType:          q.QuestionType.String(),
+			ResponseValueType:     q.ResponseValueType.String(),
+			SelectMultiple:        q.SelectMultiple,
+			Required:              q.Required,
+			Sequence:              q.Sequence,
+			ProgramID:             q.ProgramID,
+			OrganisationID:        q.OrganisationID,
+			CriticalItemThreshold: q.CriticalItemThreshold,
 		}
 		err := d.create.CreateQuestion(ctx, question)
 		if err != nil {
-			return err
+			return "", err
 		}
 		for _, c := range q.Choices {
 			choice := &gorm.QuestionInputChoice{
@@ -862,14 +902,37 @@ func (d *MyCareHubDb) CreateScreeningTool(ctx context.Context, input *domain.Scr
 			}
 			err := d.create.CreateQuestionChoice(ctx, choice)
 			if err != nil {
-				return err
+				return "", err
 			}
 		}
-
+		questionIDs[q.Sequence] = question.ID
 	}
 
-	return nil
+	// conditions are created once all the questions exist since they reference each other
+	for _, q := range input.Questions {
+		for _, c := range q.Conditions {
+			dependsOnQuestionID, ok := questionIDs[c.DependsOnSequence]
+			if !ok {
+				return "", fmt.Errorf("question %d depends on an unknown question %d", q.Sequence, c.DependsOnSequence)
+			}
+			condition := &gorm.QuestionCondition{
+				Active:              true,
+				QuestionID:          questionIDs[q.Sequence],
+				DependsOnQuestionID: dependsOnQuestionID,
+				Operator:            c.Operator.String(),
+				Target:              c.Target.String(),
+				Values:              c.Values,
+				ProgramID:           q.ProgramID,
+				OrganisationID:      q.OrganisationID,
+			}
+			err := d.create.CreateQuestionCondition(ctx, condition)
+			if err != nil {
+				return "", err
+			}
+		}
+	}
 
+	return questionnaire.ID, nil
 }
 
 // CreateScreeningToolResponse saves a screening tool response to the database
@@ -880,8 +943,11 @@ func (d *MyCareHubDb) CreateScreeningToolResponse(ctx context.Context, input *do
 		FacilityID:      input.FacilityID,
 		ClientID:        input.ClientID,
 		AggregateScore:  input.AggregateScore,
+		Severity:        input.Severity,
 		ProgramID:       input.ProgramID,
 		OrganisationID:  input.OrganisationID,
+
+		ScreeningToolVersionID: input.ScreeningToolVersionID,
 	}
 
 	screeningToolQuestionResponses := []*gorm.ScreeningToolQuestionResponse{}
@@ -1110,3 +1176,398 @@ func (d *MyCareHubDb) CreateTermsOfService(ctx context.Context, termsOfService *
 		ValidTo:   *termsOfServiceObj.ValidTo,
 	}, nil
 }
+
+// CreateAuditLog saves a record of a sensitive change in the database
+func (d *MyCareHubDb) CreateAuditLog(ctx context.Context, auditLog *domain.AuditLog) error {
+	oldValue, err := json.Marshal(auditLog.OldValue)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit log old value: %w", err)
+	}
+
+	newValue, err := json.Marshal(auditLog.NewValue)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit log new value: %w", err)
+	}
+
+	record := &gorm.AuditLog{
+		Active:         true,
+		Timestamp:      auditLog.Timestamp,
+		RecordType:     auditLog.RecordType.String(),
+		Notes:          auditLog.Notes,
+		TargetID:       auditLog.TargetID,
+		TargetType:     auditLog.TargetType,
+		OldValue:       string(oldValue),
+		NewValue:       string(newValue),
+		OrganisationID: auditLog.OrganisationID,
+	}
+
+	if auditLog.ActorID != "" {
+		record.ActorID = &auditLog.ActorID
+	}
+
+	if auditLog.ProgramID != "" {
+		record.ProgramID = &auditLog.ProgramID
+	}
+
+	return d.create.CreateAuditLog(ctx, record)
+}
+
+// CreateAuthorityRole creates a custom role in an organisation or program and grants it the role's permissions.
+// A role without a program applies to all the programs in the organisation
+func (d *MyCareHubDb) CreateAuthorityRole(ctx context.Context, role *domain.AuthorityRole) (*domain.AuthorityRole, error) {
+	record := &gorm.AuthorityRole{
+		Name:           role.Name,
+		Description:    role.Description,
+		Active:         role.Active,
+		IsSystemRole:   role.IsSystemRole,
+		UserType:       enums.StaffUser.String(),
+		OrganisationID: role.OrganisationID,
+	}
+	if role.ProgramID != "" {
+		record.ProgramID = &role.ProgramID
+	}
+
+	if err := d.create.CreateAuthorityRole(ctx, record, mapRolePermissionsToGorm(role.Permissions)); err != nil {
+		return nil, err
+	}
+
+	return mapAuthorityRole(record, nil, role.Permissions), nil
+}
+
+// AssignStaffRole assigns a role to a staff
+func (d *MyCareHubDb) AssignStaffRole(ctx context.Context, staffID string, roleID string) error {
+	return d.create.AssignStaffRole(ctx, staffID, roleID)
+}
+
+// CreateSMSDelivery creates a record used to track the delivery of an SMS
+func (d *MyCareHubDb) CreateSMSDelivery(ctx context.Context, delivery *domain.SMSDelivery) (*domain.SMSDelivery, error) {
+	record, err := d.create.CreateSMSDelivery(ctx, &gorm.SMSDelivery{
+		PhoneNumber:       delivery.PhoneNumber,
+		Provider:          delivery.Provider,
+		ProviderMessageID: delivery.ProviderMessageID,
+		Status:            delivery.Status.String(),
+		Attempts:          delivery.Attempts,
+		FailureReason:     delivery.FailureReason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.SMSDelivery{
+		ID:                record.ID,
+		PhoneNumber:       record.PhoneNumber,
+		Provider:          record.Provider,
+		ProviderMessageID: record.ProviderMessageID,
+		Status:            enums.SMSDeliveryStatus(record.Status),
+		Attempts:          record.Attempts,
+		FailureReason:     record.FailureReason,
+		CreatedAt:         record.CreatedAt,
+	}, nil
+}
+
+// CreateAppointmentReminder records a reminder that has been sent for an appointment
+func (d *MyCareHubDb) CreateAppointmentReminder(ctx context.Context, reminder *domain.AppointmentReminder) error {
+	return d.create.CreateAppointmentReminder(ctx, &gorm.AppointmentReminder{
+		AppointmentID: reminder.AppointmentID,
+		OffsetDays:    reminder.OffsetDays,
+		PushSent:      reminder.PushSent,
+		SMSSent:       reminder.SMSSent,
+		SentAt:        reminder.SentAt,
+	})
+}
+
+// SaveNotificationPreference creates or updates a user's preference for a type of notification
+func (d *MyCareHubDb) SaveNotificationPreference(ctx context.Context, preference *domain.NotificationPreference) error {
+	return d.create.SaveNotificationPreference(ctx, &gorm.NotificationPreference{
+		UserID:           preference.UserID,
+		NotificationType: preference.NotificationType.String(),
+		PushEnabled:      preference.PushEnabled,
+		SMSEnabled:       preference.SMSEnabled,
+	})
+}
+
+// CreateAppointmentSlot creates a slot during which a facility accepts appointment bookings
+func (d *MyCareHubDb) CreateAppointmentSlot(ctx context.Context, slot *domain.AppointmentSlot) (*domain.AppointmentSlot, error) {
+	record := &gorm.AppointmentSlot{
+		Active:     true,
+		FacilityID: slot.FacilityID,
+		DayOfWeek:  slot.DayOfWeek,
+		StartTime:  slot.StartTime,
+		EndTime:    slot.EndTime,
+		Capacity:   slot.Capacity,
+		Reasons:    slot.Reasons,
+	}
+
+	if err := d.create.CreateAppointmentSlot(ctx, record); err != nil {
+		return nil, err
+	}
+
+	return mapAppointmentSlot(record), nil
+}
+
+// BookAppointmentSlot creates an appointment against a facility slot and the service request used to share the
+// booking with KenyaEMR
+func (d *MyCareHubDb) BookAppointmentSlot(ctx context.Context, appointment *domain.Appointment, serviceRequestInput *dto.ServiceRequestInput) (*domain.Appointment, error) {
+	meta, err := json.Marshal(serviceRequestInput.Meta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal meta data: %w", err)
+	}
+
+	record := &gorm.Appointment{
+		Active:     true,
+		ClientID:   appointment.ClientID,
+		FacilityID: appointment.FacilityID,
+		Reason:     appointment.Reason,
+		Date:       appointment.Date.AsTime(),
+		ProgramID:  appointment.ProgramID,
+		SlotID:     appointment.SlotID,
+	}
+
+	serviceRequest := &gorm.ClientServiceRequest{
+		Active:         serviceRequestInput.Active,
+		RequestType:    serviceRequestInput.RequestType,
+		Request:        serviceRequestInput.Request,
+		Status:         serviceRequestInput.Status,
+		ClientID:       serviceRequestInput.ClientID,
+		FacilityID:     serviceRequestInput.FacilityID,
+		ProgramID:      serviceRequestInput.ProgramID,
+		Meta:           string(meta),
+		OrganisationID: serviceRequestInput.OrganisationID,
+	}
+
+	if err := d.create.BookAppointmentSlot(ctx, record, serviceRequest); err != nil {
+		return nil, err
+	}
+
+	return mapAppointment(record), nil
+}
+
+// CreateServiceRequestComment saves a comment left by a staff member on a service request
+func (d *MyCareHubDb) CreateServiceRequestComment(ctx context.Context, comment *domain.ServiceRequestComment) (*domain.ServiceRequestComment, error) {
+	record := &gorm.ServiceRequestComment{
+		Active:           true,
+		ServiceRequestID: comment.ServiceRequestID,
+		ParentID:         comment.ParentID,
+		StaffID:          comment.StaffID,
+		Comment:          comment.Comment,
+	}
+
+	if err := d.create.CreateServiceRequestComment(ctx, record); err != nil {
+		return nil, err
+	}
+
+	return mapServiceRequestComment(record), nil
+}
+
+// CreateServiceRequestEvent appends an entry to the history of a service request
+func (d *MyCareHubDb) CreateServiceRequestEvent(ctx context.Context, event *domain.ServiceRequestEvent) error {
+	record := &gorm.ServiceRequestEvent{
+		ServiceRequestID: event.ServiceRequestID,
+		EventType:        event.EventType.String(),
+		StaffID:          event.StaffID,
+		Status:           event.Status,
+		AssignedToID:     event.AssignedToID,
+		Notes:            event.Notes,
+	}
+
+	return d.create.CreateServiceRequestEvent(ctx, record)
+}
+
+// SaveScreeningToolDraft saves a questionnaire as the draft version of a screening tool.
+// Drafts are the only versions that can be edited so an existing draft has its questionnaire replaced
+func (d *MyCareHubDb) SaveScreeningToolDraft(ctx context.Context, screeningToolID string, questionnaire *domain.Questionnaire) (*domain.ScreeningToolVersion, error) {
+	tool, err := d.query.GetScreeningToolByID(ctx, screeningToolID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := d.query.ListScreeningToolVersions(ctx, tool.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var draft *gorm.ScreeningToolVersion
+	latestVersion := 0
+	for _, v := range versions {
+		if v.Status == enums.ScreeningToolVersionStatusDraft.String() {
+			draft = v
+		}
+		if v.Version > latestVersion {
+			latestVersion = v.Version
+		}
+	}
+
+	questionnaireID, err := d.createQuestionnaire(ctx, questionnaire, tool.ProgramID, tool.OrganisationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if draft == nil {
+		draft = &gorm.ScreeningToolVersion{
+			Active:          true,
+			ScreeningToolID: tool.ID,
+			QuestionnaireID: questionnaireID,
+			Version:         latestVersion + 1,
+			Status:          enums.ScreeningToolVersionStatusDraft.String(),
+			ProgramID:       tool.ProgramID,
+			OrganisationID:  tool.OrganisationID,
+		}
+		err = d.create.CreateScreeningToolVersion(ctx, draft)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		previousQuestionnaireID := draft.QuestionnaireID
+
+		err = d.update.UpdateScreeningToolVersion(ctx, draft, map[string]interface{}{"questionnaire_id": questionnaireID})
+		if err != nil {
+			return nil, err
+		}
+		draft.QuestionnaireID = questionnaireID
+
+		err = d.delete.DeleteQuestionnaire(ctx, previousQuestionnaireID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	savedQuestionnaire, err := d.getQuestionnaire(ctx, questionnaireID)
+	if err != nil {
+		return nil, err
+	}
+
+	version := mapScreeningToolVersion(draft)
+	version.Questionnaire = savedQuestionnaire
+	return version, nil
+}
+
+// CreateScreeningToolAssignment records a screening tool that a staff assigned to a client
+func (d *MyCareHubDb) CreateScreeningToolAssignment(ctx context.Context, assignment *domain.ScreeningToolAssignment) (*domain.ScreeningToolAssignment, error) {
+	record := &gorm.ScreeningToolAssignment{
+		Active:          assignment.Active,
+		ScreeningToolID: assignment.ScreeningToolID,
+		ClientID:        assignment.ClientID,
+		StaffID:         assignment.StaffID,
+		DueDate:         assignment.DueDate,
+		Status:          assignment.Status.String(),
+		ProgramID:       assignment.ProgramID,
+		OrganisationID:  assignment.OrganisationID,
+	}
+
+	err := d.create.CreateScreeningToolAssignment(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapScreeningToolAssignment(record), nil
+}
+
+// CreateHealthDiaryShare records a grant that gives a facility or caregiver access to part of a client's health diary
+func (d *MyCareHubDb) CreateHealthDiaryShare(ctx context.Context, share *domain.HealthDiaryShare) (*domain.HealthDiaryShare, error) {
+	record := &gorm.HealthDiaryShare{
+		Active:         share.Active,
+		ClientID:       share.ClientID,
+		FacilityID:     share.FacilityID,
+		CaregiverID:    share.CaregiverID,
+		Scope:          share.Scope.String(),
+		From:           share.From,
+		To:             share.To,
+		EntryIDs:       share.EntryIDs,
+		ExpiresAt:      share.ExpiresAt,
+		ProgramID:      share.ProgramID,
+		OrganisationID: share.OrganisationID,
+	}
+
+	err := d.create.CreateHealthDiaryShare(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapHealthDiaryShare(record), nil
+}
+
+// CreateSurveyCampaign saves a survey campaign that sends a survey to clients at a scheduled time
+func (d *MyCareHubDb) CreateSurveyCampaign(ctx context.Context, campaign *domain.SurveyCampaign) (*domain.SurveyCampaign, error) {
+	clientTypes := pq.StringArray{}
+	for _, t := range campaign.ClientTypes {
+		clientTypes = append(clientTypes, t.String())
+	}
+	genders := pq.StringArray{}
+	for _, g := range campaign.Genders {
+		genders = append(genders, g.String())
+	}
+
+	record := &gorm.SurveyCampaign{
+		Active:               campaign.Active,
+		Title:                campaign.Title,
+		ProjectID:            campaign.ProjectID,
+		FormID:               campaign.FormID,
+		FacilityID:           campaign.FacilityID,
+		ClientTypes:          clientTypes,
+		Genders:              genders,
+		Recurrence:           campaign.Recurrence.String(),
+		Status:               campaign.Status.String(),
+		NextSendAt:           campaign.NextSendAt,
+		EndsAt:               campaign.EndsAt,
+		LinkExpiryDays:       campaign.LinkExpiryDays,
+		ReminderIntervalDays: campaign.ReminderIntervalDays,
+		ProgramID:            campaign.ProgramID,
+		OrganisationID:       campaign.OrganisationID,
+	}
+	if campaign.AgeRange != nil {
+		record.MinimumAge = &campaign.AgeRange.LowerBound
+		record.MaximumAge = &campaign.AgeRange.UpperBound
+	}
+
+	err := d.create.CreateSurveyCampaign(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapSurveyCampaign(record), nil
+}
+
+// CreateSurveyRedFlagRule saves a rule used to flag submissions to a survey form
+func (d *MyCareHubDb) CreateSurveyRedFlagRule(ctx context.Context, rule *domain.SurveyRedFlagRule) (*domain.SurveyRedFlagRule, error) {
+	conditions, err := json.Marshal(rule.Conditions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal survey red flag rule conditions: %w", err)
+	}
+
+	record := &gorm.SurveyRedFlagRule{
+		Active:         rule.Active,
+		Name:           rule.Name,
+		ProjectID:      rule.ProjectID,
+		FormID:         rule.FormID,
+		Combinator:     rule.Combinator.String(),
+		Conditions:     string(conditions),
+		ProgramID:      rule.ProgramID,
+		OrganisationID: rule.OrganisationID,
+	}
+
+	err = d.create.CreateSurveyRedFlagRule(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapSurveyRedFlagRule(record)
+}
+
+// CreateCommunityCrisisKeyword saves a keyword used to flag community messages that may indicate a crisis
+func (d *MyCareHubDb) CreateCommunityCrisisKeyword(ctx context.Context, keyword *domain.CommunityCrisisKeyword) (*domain.CommunityCrisisKeyword, error) {
+	record := &gorm.CommunityCrisisKeyword{
+		Active:         keyword.Active,
+		Keyword:        keyword.Keyword,
+		IsRegex:        keyword.IsRegex,
+		Category:       keyword.Category.String(),
+		ProgramID:      keyword.ProgramID,
+		OrganisationID: keyword.OrganisationID,
+	}
+
+	err := d.create.CreateCommunityCrisisKeyword(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapCommunityCrisisKeyword(record), nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/gorm"
 	gormMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/gorm/mock"
+	"github.com/savannahghi/scalarutils"
 )
 
 func TestMyCareHubDb_SaveTemporaryUserPin(t *testing.T) {
@@ -1416,6 +1417,7 @@ func TestMyCareHubDb_CreateScreeningTool(t *testing.T) {
 	d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
 
 	UID := uuid.New().String()
+	priority := enums.ServiceRequestPriorityHigh
 
 	screeningTool := &domain.ScreeningTool{
 		ID:              UID,
@@ -1455,6 +1457,51 @@ func TestMyCareHubDb_CreateScreeningTool(t *testing.T) {
 						},
 					},
 				},
+				{
+					Active:            true,
+					Text:              gofakeit.Sentence(50),
+					QuestionType:      enums.QuestionTypeOpenEnded,
+					ResponseValueType: enums.QuestionResponseValueTypeString,
+					Sequence:          2,
+					Conditions: []domain.QuestionCondition{
+						{
+							Active:            true,
+							DependsOnSequence: 1,
+							Operator:          enums.QuestionConditionOperatorEquals,
+							Target:            enums.QuestionConditionTargetChoice,
+							Values:            []string{"YES"},
+						},
+					},
+				},
+			},
+		},
+		ScoreBands: []domain.ScreeningToolScoreBand{
+			{
+				Active:   true,
+				Label:    "Severe",
+				MinScore: 1,
+				Action:   enums.ScreeningToolBandActionCreateRedFlag,
+				Priority: &priority,
+			},
+		},
+	}
+
+	danglingScreeningTool := *screeningTool
+	danglingScreeningTool.Questionnaire.Questions = []domain.Question{
+		{
+			Active:            true,
+			Text:              gofakeit.Sentence(50),
+			QuestionType:      enums.QuestionTypeOpenEnded,
+			ResponseValueType: enums.QuestionResponseValueTypeString,
+			Sequence:          1,
+			Conditions: []domain.QuestionCondition{
+				{
+					Active:            true,
+					DependsOnSequence: 5,
+					Operator:          enums.QuestionConditionOperatorEquals,
+					Target:            enums.QuestionConditionTargetChoice,
+					Values:            []string{"YES"},
+				},
 			},
 		},
 	}
@@ -1508,6 +1555,38 @@ func TestMyCareHubDb_CreateScreeningTool(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Sad Case: Unable to create score band",
+			args: args{
+				ctx:   context.Background(),
+				input: screeningTool,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case: Unable to create question condition",
+			args: args{
+				ctx:   context.Background(),
+				input: screeningTool,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case: Condition depends on an unknown question",
+			args: args{
+				ctx:   context.Background(),
+				input: &danglingScreeningTool,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case: Unable to create screening tool version",
+			args: args{
+				ctx:   context.Background(),
+				input: screeningTool,
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1549,6 +1628,32 @@ func TestMyCareHubDb_CreateScreeningTool(t *testing.T) {
 					return fmt.Errorf("cannot create question input choice")
 				}
 			}
+			if tt.name == "Sad Case: Unable to create score band" {
+				fakeGorm.MockCreateQuestionChoiceFn = func(ctx context.Context, input *gorm.QuestionInputChoice) error {
+					return nil
+				}
+				fakeGorm.MockCreateScreeningToolScoreBandFn = func(ctx context.Context, input *gorm.ScreeningToolScoreBand) error {
+					return fmt.Errorf("cannot create score band")
+				}
+			}
+			if tt.name == "Sad Case: Unable to create question condition" {
+				fakeGorm.MockCreateScreeningToolScoreBandFn = func(ctx context.Context, input *gorm.ScreeningToolScoreBand) error {
+					return nil
+				}
+				fakeGorm.MockCreateQuestionConditionFn = func(ctx context.Context, input *gorm.QuestionCondition) error {
+					return fmt.Errorf("cannot create question condition")
+				}
+			}
+			if tt.name == "Sad Case: Condition depends on an unknown question" {
+				fakeGorm.MockCreateQuestionConditionFn = func(ctx context.Context, input *gorm.QuestionCondition) error {
+					return nil
+				}
+			}
+			if tt.name == "Sad Case: Unable to create screening tool version" {
+				fakeGorm.MockCreateScreeningToolVersionFn = func(ctx context.Context, version *gorm.ScreeningToolVersion) error {
+					return fmt.Errorf("cannot create screening tool version")
+				}
+			}
 			if err := d.CreateScreeningTool(tt.args.ctx, tt.args.input); (err != nil) != tt.wantErr {
 				t.Errorf("MyCareHubDb.CreateScreeningTool() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -2483,3 +2588,1002 @@ func TestMyCareHubDb_CreateTermsOfService(t *testing.T) {
 		})
 	}
 }
+
+func TestMyCareHubDb_CreateAuditLog(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		auditLog *domain.AuditLog
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create an audit log",
+			args: args{
+				ctx: context.Background(),
+				auditLog: &domain.AuditLog{
+					RecordType:     enums.AuditLogRecordTypeClientFacilityTransfer,
+					ActorID:        gofakeit.UUID(),
+					TargetID:       gofakeit.UUID(),
+					TargetType:     "CLIENT",
+					OldValue:       map[string]interface{}{"facilityID": gofakeit.UUID()},
+					NewValue:       map[string]interface{}{"facilityID": gofakeit.UUID()},
+					OrganisationID: gofakeit.UUID(),
+					ProgramID:      gofakeit.UUID(),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to marshal old value",
+			args: args{
+				ctx: context.Background(),
+				auditLog: &domain.AuditLog{
+					RecordType: enums.AuditLogRecordTypeClientFacilityTransfer,
+					TargetID:   gofakeit.UUID(),
+					OldValue:   map[string]interface{}{"invalid": make(chan int)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to marshal new value",
+			args: args{
+				ctx: context.Background(),
+				auditLog: &domain.AuditLog{
+					RecordType: enums.AuditLogRecordTypeClientFacilityTransfer,
+					TargetID:   gofakeit.UUID(),
+					NewValue:   map[string]interface{}{"invalid": make(chan int)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to create audit log",
+			args: args{
+				ctx: context.Background(),
+				auditLog: &domain.AuditLog{
+					RecordType: enums.AuditLogRecordTypeClientFacilityTransfer,
+					TargetID:   gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to create audit log" {
+				fakeGorm.MockCreateAuditLogFn = func(ctx context.Context, auditLog *gorm.AuditLog) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			if err := d.CreateAuditLog(tt.args.ctx, tt.args.auditLog); (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.CreateAuditLog() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_CreateAuthorityRole(t *testing.T) {
+	permissions := []domain.AuthorityPermission{
+		{
+			Name:        "Read facility",
+			Description: "Can read facility",
+			Category:    "Facility",
+			Scope:       "facility.read",
+		},
+	}
+
+	type args struct {
+		ctx  context.Context
+		role *domain.AuthorityRole
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create a program role",
+			args: args{
+				ctx: context.Background(),
+				role: &domain.AuthorityRole{
+					Name:           "Facility Manager",
+					Active:         true,
+					OrganisationID: gofakeit.UUID(),
+					ProgramID:      gofakeit.UUID(),
+					Permissions:    permissions,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: create an organisation role",
+			args: args{
+				ctx: context.Background(),
+				role: &domain.AuthorityRole{
+					Name:           "Organisation Auditor",
+					Active:         true,
+					OrganisationID: gofakeit.UUID(),
+					Permissions:    permissions,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to create role",
+			args: args{
+				ctx: context.Background(),
+				role: &domain.AuthorityRole{
+					Name:           "Facility Manager",
+					OrganisationID: gofakeit.UUID(),
+					Permissions:    permissions,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			fakeGorm.MockCreateAuthorityRoleFn = func(ctx context.Context, role *gorm.AuthorityRole, permissions []*gorm.AuthorityPermission) error {
+				id := gofakeit.UUID()
+				role.AuthorityRoleID = &id
+				return nil
+			}
+			if tt.name == "Sad case: unable to create role" {
+				fakeGorm.MockCreateAuthorityRoleFn = func(ctx context.Context, role *gorm.AuthorityRole, permissions []*gorm.AuthorityPermission) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.CreateAuthorityRole(tt.args.ctx, tt.args.role)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.CreateAuthorityRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.ProgramID != tt.args.role.ProgramID {
+				t.Errorf("MyCareHubDb.CreateAuthorityRole() programID = %v, want %v", got.ProgramID, tt.args.role.ProgramID)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_AssignStaffRole(t *testing.T) {
+	type args struct {
+		ctx     context.Context
+		staffID string
+		roleID  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: assign staff role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to assign staff role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to assign staff role" {
+				fakeGorm.MockAssignStaffRoleFn = func(ctx context.Context, staffID string, roleID string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			if err := d.AssignStaffRole(tt.args.ctx, tt.args.staffID, tt.args.roleID); (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.AssignStaffRole() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_CreateSMSDelivery(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		delivery *domain.SMSDelivery
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create sms delivery",
+			args: args{
+				ctx: context.Background(),
+				delivery: &domain.SMSDelivery{
+					PhoneNumber: gofakeit.Phone(),
+					Status:      enums.SMSDeliveryStatusPending,
+				},
+			},
+		},
+		{
+			name: "Sad case: unable to create sms delivery",
+			args: args{
+				ctx: context.Background(),
+				delivery: &domain.SMSDelivery{
+					PhoneNumber: gofakeit.Phone(),
+					Status:      enums.SMSDeliveryStatusPending,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to create sms delivery" {
+				fakeGorm.MockCreateSMSDeliveryFn = func(ctx context.Context, delivery *gorm.SMSDelivery) (*gorm.SMSDelivery, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.CreateSMSDelivery(tt.args.ctx, tt.args.delivery)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.CreateSMSDelivery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.Status != tt.args.delivery.Status {
+				t.Errorf("MyCareHubDb.CreateSMSDelivery() status = %v, want %v", got.Status, tt.args.delivery.Status)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_CreateAppointmentReminder(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		reminder *domain.AppointmentReminder
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create appointment reminder",
+			args: args{
+				ctx: context.Background(),
+				reminder: &domain.AppointmentReminder{
+					AppointmentID: uuid.New().String(),
+					OffsetDays:    1,
+					PushSent:      true,
+					SentAt:        time.Now(),
+				},
+			},
+		},
+		{
+			name: "Sad case: unable to create appointment reminder",
+			args: args{
+				ctx: context.Background(),
+				reminder: &domain.AppointmentReminder{
+					AppointmentID: uuid.New().String(),
+					OffsetDays:    1,
+					SentAt:        time.Now(),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to create appointment reminder" {
+				fakeGorm.MockCreateAppointmentReminderFn = func(ctx context.Context, reminder *gorm.AppointmentReminder) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			if err := d.CreateAppointmentReminder(tt.args.ctx, tt.args.reminder); (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.CreateAppointmentReminder() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_SaveNotificationPreference(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		preference *domain.NotificationPreference
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: save notification preference",
+			args: args{
+				ctx: context.Background(),
+				preference: &domain.NotificationPreference{
+					UserID:           uuid.New().String(),
+					NotificationType: enums.NotificationTypeAppointment,
+					PushEnabled:      true,
+				},
+			},
+		},
+		{
+			name: "Sad case: unable to save notification preference",
+			args: args{
+				ctx: context.Background(),
+				preference: &domain.NotificationPreference{
+					UserID:           uuid.New().String(),
+					NotificationType: enums.NotificationTypeAppointment,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to save notification preference" {
+				fakeGorm.MockSaveNotificationPreferenceFn = func(ctx context.Context, preference *gorm.NotificationPreference) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			if err := d.SaveNotificationPreference(tt.args.ctx, tt.args.preference); (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.SaveNotificationPreference() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_CreateAppointmentSlot(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		slot *domain.AppointmentSlot
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create appointment slot",
+			args: args{
+				ctx: context.Background(),
+				slot: &domain.AppointmentSlot{
+					FacilityID: uuid.New().String(),
+					DayOfWeek:  int(time.Monday),
+					StartTime:  "08:00",
+					EndTime:    "12:00",
+					Capacity:   10,
+					Reasons:    []string{"Pharmacy Visit"},
+				},
+			},
+		},
+		{
+			name: "Sad case: unable to create appointment slot",
+			args: args{
+				ctx: context.Background(),
+				slot: &domain.AppointmentSlot{
+					FacilityID: uuid.New().String(),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to create appointment slot" {
+				fakeGorm.MockCreateAppointmentSlotFn = func(ctx context.Context, slot *gorm.AppointmentSlot) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.CreateAppointmentSlot(tt.args.ctx, tt.args.slot)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.CreateAppointmentSlot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got == nil || got.ID == "" || !got.Active) {
+				t.Errorf("expected an active appointment slot to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_BookAppointmentSlot(t *testing.T) {
+	slotID := uuid.New().String()
+
+	type args struct {
+		ctx                 context.Context
+		appointment         *domain.Appointment
+		serviceRequestInput *dto.ServiceRequestInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: book appointment slot",
+			args: args{
+				ctx: context.Background(),
+				appointment: &domain.Appointment{
+					Reason:     "Pharmacy Visit",
+					Date:       scalarutils.Date{Year: 2030, Month: 1, Day: 1},
+					ClientID:   uuid.New().String(),
+					FacilityID: uuid.New().String(),
+					SlotID:     &slotID,
+				},
+				serviceRequestInput: &dto.ServiceRequestInput{
+					Active:      true,
+					RequestType: enums.ServiceRequestTypeAppointments.String(),
+					Request:     gofakeit.Sentence(5),
+					Status:      enums.ServiceRequestStatusPending.String(),
+					Meta: map[string]interface{}{
+						"action": enums.AppointmentServiceRequestActionBook.String(),
+					},
+				},
+			},
+		},
+		{
+			name: "Sad case: unable to book appointment slot",
+			args: args{
+				ctx: context.Background(),
+				appointment: &domain.Appointment{
+					SlotID: &slotID,
+				},
+				serviceRequestInput: &dto.ServiceRequestInput{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to book appointment slot" {
+				fakeGorm.MockBookAppointmentSlotFn = func(ctx context.Context, appointment *gorm.Appointment, serviceRequest *gorm.ClientServiceRequest) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.BookAppointmentSlot(tt.args.ctx, tt.args.appointment, tt.args.serviceRequestInput)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.BookAppointmentSlot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got == nil || got.ID == "" || got.SlotID == nil) {
+				t.Errorf("expected a booked appointment to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_CreateServiceRequestComment(t *testing.T) {
+	parentID := uuid.New().String()
+
+	type args struct {
+		ctx     context.Context
+		comment *domain.ServiceRequestComment
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create service request comment",
+			args: args{
+				ctx: context.Background(),
+				comment: &domain.ServiceRequestComment{
+					ServiceRequestID: uuid.New().String(),
+					ParentID:         &parentID,
+					StaffID:          uuid.New().String(),
+					Comment:          gofakeit.Sentence(5),
+				},
+			},
+		},
+		{
+			name: "Sad case: unable to create service request comment",
+			args: args{
+				ctx:     context.Background(),
+				comment: &domain.ServiceRequestComment{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to create service request comment" {
+				fakeGorm.MockCreateServiceRequestCommentFn = func(ctx context.Context, comment *gorm.ServiceRequestComment) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.CreateServiceRequestComment(tt.args.ctx, tt.args.comment)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.CreateServiceRequestComment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got == nil || got.ID == "" || got.ParentID == nil) {
+				t.Errorf("expected the created comment to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_CreateServiceRequestEvent(t *testing.T) {
+	staffID := uuid.New().String()
+
+	type args struct {
+		ctx   context.Context
+		event *domain.ServiceRequestEvent
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create service request event",
+			args: args{
+				ctx: context.Background(),
+				event: &domain.ServiceRequestEvent{
+					ServiceRequestID: uuid.New().String(),
+					EventType:        enums.ServiceRequestEventTypeAssigned,
+					StaffID:          &staffID,
+					Status:           enums.ServiceRequestStatusPending.String(),
+					AssignedToID:     &staffID,
+				},
+			},
+		},
+		{
+			name: "Sad case: unable to create service request event",
+			args: args{
+				ctx:   context.Background(),
+				event: &domain.ServiceRequestEvent{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to create service request event" {
+				fakeGorm.MockCreateServiceRequestEventFn = func(ctx context.Context, event *gorm.ServiceRequestEvent) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			if err := d.CreateServiceRequestEvent(tt.args.ctx, tt.args.event); (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.CreateServiceRequestEvent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_SaveScreeningToolDraft(t *testing.T) {
+	questionnaire := &domain.Questionnaire{
+		Active: true,
+		Name:   gofakeit.BeerName(),
+		Questions: []domain.Question{
+			{
+				Active:            true,
+				Text:              gofakeit.Sentence(10),
+				QuestionType:      enums.QuestionTypeOpenEnded,
+				ResponseValueType: enums.QuestionResponseValueTypeString,
+				Sequence:          1,
+			},
+		},
+	}
+	draftQuestionnaireID := uuid.NewString()
+
+	tests := []struct {
+		name        string
+		wantVersion int
+		wantErr     bool
+	}{
+		{
+			name:        "Happy case: create a new draft",
+			wantVersion: 2,
+			wantErr:     false,
+		},
+		{
+			name:        "Happy case: replace an existing draft",
+			wantVersion: 2,
+			wantErr:     false,
+		},
+		{
+			name:    "Sad case: unable to get screening tool",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to list screening tool versions",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to create questionnaire",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to create draft version",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to update existing draft",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to delete the replaced questionnaire",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			versions := []*gorm.ScreeningToolVersion{
+				{
+					ID:              uuid.NewString(),
+					QuestionnaireID: uuid.NewString(),
+					Version:         1,
+					Status:          enums.ScreeningToolVersionStatusPublished.String(),
+				},
+			}
+			replacesDraft := tt.name == "Happy case: replace an existing draft" ||
+				tt.name == "Sad case: unable to update existing draft" ||
+				tt.name == "Sad case: unable to delete the replaced questionnaire"
+			if replacesDraft {
+				versions = append(versions, &gorm.ScreeningToolVersion{
+					ID:              uuid.NewString(),
+					QuestionnaireID: draftQuestionnaireID,
+					Version:         2,
+					Status:          enums.ScreeningToolVersionStatusDraft.String(),
+				})
+			}
+			fakeGorm.MockListScreeningToolVersionsFn = func(ctx context.Context, screeningToolID string) ([]*gorm.ScreeningToolVersion, error) {
+				return versions, nil
+			}
+
+			created := false
+			fakeGorm.MockCreateScreeningToolVersionFn = func(ctx context.Context, version *gorm.ScreeningToolVersion) error {
+				created = true
+				return nil
+			}
+			var deletedQuestionnaireID string
+			fakeGorm.MockDeleteQuestionnaireFn = func(ctx context.Context, questionnaireID string) error {
+				deletedQuestionnaireID = questionnaireID
+				return nil
+			}
+
+			switch tt.name {
+			case "Sad case: unable to get screening tool":
+				fakeGorm.MockGetScreeningToolByIDFn = func(ctx context.Context, toolID string) (*gorm.ScreeningTool, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			case "Sad case: unable to list screening tool versions":
+				fakeGorm.MockListScreeningToolVersionsFn = func(ctx context.Context, screeningToolID string) ([]*gorm.ScreeningToolVersion, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			case "Sad case: unable to create questionnaire":
+				fakeGorm.MockCreateQuestionnaireFn = func(ctx context.Context, input *gorm.Questionnaire) error {
+					return fmt.Errorf("an error occurred")
+				}
+			case "Sad case: unable to create draft version":
+				fakeGorm.MockCreateScreeningToolVersionFn = func(ctx context.Context, version *gorm.ScreeningToolVersion) error {
+					return fmt.Errorf("an error occurred")
+				}
+			case "Sad case: unable to update existing draft":
+				fakeGorm.MockUpdateScreeningToolVersionFn = func(ctx context.Context, version *gorm.ScreeningToolVersion, updateData map[string]interface{}) error {
+					return fmt.Errorf("an error occurred")
+				}
+			case "Sad case: unable to delete the replaced questionnaire":
+				fakeGorm.MockDeleteQuestionnaireFn = func(ctx context.Context, questionnaireID string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.SaveScreeningToolDraft(context.Background(), uuid.NewString(), questionnaire)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.SaveScreeningToolDraft() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Version != tt.wantVersion || got.Status != enums.ScreeningToolVersionStatusDraft || got.Questionnaire == nil {
+				t.Errorf("unexpected draft %+v", got)
+			}
+			if created == replacesDraft {
+				t.Errorf("expected a new draft version to be created: %v", !replacesDraft)
+			}
+			if replacesDraft && deletedQuestionnaireID != draftQuestionnaireID {
+				t.Errorf("expected the replaced draft questionnaire to be deleted")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_CreateScreeningToolAssignment(t *testing.T) {
+	assignment := &domain.ScreeningToolAssignment{
+		Active:          true,
+		ScreeningToolID: uuid.NewString(),
+		ClientID:        uuid.NewString(),
+		StaffID:         uuid.NewString(),
+		DueDate:         time.Now().AddDate(0, 0, 7),
+		Status:          enums.ScreeningToolAssignmentStatusPending,
+		ProgramID:       uuid.NewString(),
+		OrganisationID:  uuid.NewString(),
+	}
+
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: create screening tool assignment",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to create screening tool assignment",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to create screening tool assignment" {
+				fakeGorm.MockCreateScreeningToolAssignmentFn = func(ctx context.Context, assignment *gorm.ScreeningToolAssignment) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.CreateScreeningToolAssignment(context.Background(), assignment)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.CreateScreeningToolAssignment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got == nil || got.ClientID != assignment.ClientID || got.Status != enums.ScreeningToolAssignmentStatusPending) {
+				t.Errorf("unexpected screening tool assignment %v", got)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_CreateHealthDiaryShare(t *testing.T) {
+	facilityID := uuid.NewString()
+	share := &domain.HealthDiaryShare{
+		Active:         true,
+		ClientID:       uuid.NewString(),
+		FacilityID:     &facilityID,
+		Scope:          enums.HealthDiaryShareScopeEntireDiary,
+		ProgramID:      uuid.NewString(),
+		OrganisationID: uuid.NewString(),
+	}
+
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: create health diary share",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to create health diary share",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to create health diary share" {
+				fakeGorm.MockCreateHealthDiaryShareFn = func(ctx context.Context, share *gorm.HealthDiaryShare) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.CreateHealthDiaryShare(context.Background(), share)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.CreateHealthDiaryShare() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got == nil || got.ClientID != share.ClientID || got.Scope != enums.HealthDiaryShareScopeEntireDiary) {
+				t.Errorf("unexpected health diary share %v", got)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_CreateSurveyCampaign(t *testing.T) {
+	nextSendAt := time.Now().Add(time.Hour)
+	campaign := &domain.SurveyCampaign{
+		Active:         true,
+		Title:          "Monthly check in",
+		ProjectID:      1,
+		FormID:         uuid.NewString(),
+		FacilityID:     uuid.NewString(),
+		ClientTypes:    []enums.ClientType{enums.ClientTypePmtct},
+		Genders:        []enumutils.Gender{enumutils.GenderFemale},
+		AgeRange:       &domain.AgeRange{LowerBound: 18, UpperBound: 30},
+		Recurrence:     enums.SurveyCampaignRecurrenceMonthly,
+		Status:         enums.SurveyCampaignStatusScheduled,
+		NextSendAt:     &nextSendAt,
+		LinkExpiryDays: 7,
+		ProgramID:      uuid.NewString(),
+		OrganisationID: uuid.NewString(),
+	}
+
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: create survey campaign",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to create survey campaign",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to create survey campaign" {
+				fakeGorm.MockCreateSurveyCampaignFn = func(ctx context.Context, campaign *gorm.SurveyCampaign) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.CreateSurveyCampaign(context.Background(), campaign)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.CreateSurveyCampaign() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got == nil || got.AgeRange == nil || got.AgeRange.UpperBound != 30 || got.Recurrence != enums.SurveyCampaignRecurrenceMonthly) {
+				t.Errorf("unexpected survey campaign %v", got)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_CreateSurveyRedFlagRule(t *testing.T) {
+	rule := &domain.SurveyRedFlagRule{
+		Active:     true,
+		Name:       "High PHQ-9 score",
+		ProjectID:  1,
+		FormID:     uuid.NewString(),
+		Combinator: enums.SurveyRuleCombinatorAnd,
+		Conditions: []domain.SurveyRuleCondition{
+			{Field: "phq_score", Operator: enums.SurveyRuleOperatorGreaterThanOrEqualTo, Value: "20"},
+		},
+		ProgramID:      uuid.NewString(),
+		OrganisationID: uuid.NewString(),
+	}
+
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: create survey red flag rule",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to create survey red flag rule",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to create survey red flag rule" {
+				fakeGorm.MockCreateSurveyRedFlagRuleFn = func(ctx context.Context, rule *gorm.SurveyRedFlagRule) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.CreateSurveyRedFlagRule(context.Background(), rule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.CreateSurveyRedFlagRule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got == nil || len(got.Conditions) != 1 || got.Conditions[0].Operator != enums.SurveyRuleOperatorGreaterThanOrEqualTo) {
+				t.Errorf("unexpected survey red flag rule %v", got)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_CreateCommunityCrisisKeyword(t *testing.T) {
+	keyword := &domain.CommunityCrisisKeyword{
+		Active:         true,
+		Keyword:        "end my life",
+		Category:       enums.CommunityCrisisCategorySelfHarm,
+		ProgramID:      uuid.NewString(),
+		OrganisationID: uuid.NewString(),
+	}
+
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: create community crisis keyword",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to create community crisis keyword",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to create community crisis keyword" {
+				fakeGorm.MockCreateCommunityCrisisKeywordFn = func(ctx context.Context, keyword *gorm.CommunityCrisisKeyword) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.CreateCommunityCrisisKeyword(context.Background(), keyword)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.CreateCommunityCrisisKeyword() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got == nil || got.Keyword != keyword.Keyword || got.Category != enums.CommunityCrisisCategorySelfHarm) {
+				t.Errorf("unexpected community crisis keyword %v", got)
+			}
+		})
+	}
+}
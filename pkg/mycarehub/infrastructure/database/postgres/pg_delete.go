@@ -49,3 +49,13 @@ func (d *MyCareHubDb) DeleteOrganisation(ctx context.Context, organisation *doma
 	}
 	return d.delete.DeleteOrganisation(ctx, org)
 }
+
+// DeleteAuthorityRole deletes a role
+func (d *MyCareHubDb) DeleteAuthorityRole(ctx context.Context, roleID string) error {
+	return d.delete.DeleteAuthorityRole(ctx, roleID)
+}
+
+// RevokeStaffRole removes a role that had been assigned to a staff
+func (d *MyCareHubDb) RevokeStaffRole(ctx context.Context, staffID string, roleID string) error {
+	return d.delete.RevokeStaffRole(ctx, staffID, roleID)
+}
@@ -259,3 +259,96 @@ func TestMyCareHubDb_DeleteOrganisation(t *testing.T) {
 		})
 	}
 }
+
+func TestMyCareHubDb_DeleteAuthorityRole(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		roleID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: delete role",
+			args: args{
+				ctx:    context.Background(),
+				roleID: uuid.New().String(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to delete role",
+			args: args{
+				ctx:    context.Background(),
+				roleID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to delete role" {
+				fakeGorm.MockDeleteAuthorityRoleFn = func(ctx context.Context, roleID string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			if err := d.DeleteAuthorityRole(tt.args.ctx, tt.args.roleID); (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.DeleteAuthorityRole() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_RevokeStaffRole(t *testing.T) {
+	type args struct {
+		ctx     context.Context
+		staffID string
+		roleID  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: revoke staff role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: uuid.New().String(),
+				roleID:  uuid.New().String(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to revoke staff role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: uuid.New().String(),
+				roleID:  uuid.New().String(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to revoke staff role" {
+				fakeGorm.MockRevokeStaffRoleFn = func(ctx context.Context, staffID string, roleID string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			if err := d.RevokeStaffRole(tt.args.ctx, tt.args.staffID, tt.args.roleID); (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.RevokeStaffRole() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
@@ -682,7 +683,8 @@ func (d *MyCareHubDb) GetPendingServiceRequestsCount(ctx context.Context, facili
 
 }
 
-// GetClientHealthDiaryEntries queries the database to return a clients all health diary records
+// GetClientHealthDiaryEntries queries the database to return a clients all health diary records.
+// The shared filter uses the client's active grants to facilities
 func (d *MyCareHubDb) GetClientHealthDiaryEntries(ctx context.Context, clientID string, moodType *enums.Mood, shared *bool) ([]*domain.ClientHealthDiaryEntry, error) {
 	var healthDiaryEntries []*domain.ClientHealthDiaryEntry
 
@@ -692,11 +694,8 @@ func (d *MyCareHubDb) GetClientHealthDiaryEntries(ctx context.Context, clientID
 	if moodType != nil {
 		queryParams["mood"] = moodType.String()
 	}
-	if shared != nil {
-		queryParams["share_with_health_worker"] = shared
-	}
 
-	clientHealthDiaryEntry, err := d.query.GetClientHealthDiaryEntries(ctx, queryParams)
+	clientHealthDiaryEntry, err := d.query.GetClientHealthDiaryEntries(ctx, queryParams, shared)
 	if err != nil {
 		return nil, err
 	}
@@ -824,23 +823,36 @@ func (d *MyCareHubDb) ReturnClientsServiceRequests(ctx context.Context, clientSe
 			}
 			resolvedByName = resolvedBy.Name
 		}
+		var assignedToName *string
+		if serviceRequest.AssignedToID != nil {
+			assignedTo, err := d.query.GetUserProfileByStaffID(ctx, *serviceRequest.AssignedToID)
+			if err != nil {
+				return nil, err
+			}
+			assignedToName = &assignedTo.Name
+		}
 
 		serviceRequest := &domain.ServiceRequest{
-			ID:             *serviceRequest.ID,
-			RequestType:    serviceRequest.RequestType,
-			Request:        serviceRequest.Request,
-			Status:         serviceRequest.Status,
-			ClientID:       serviceRequest.ClientID,
-			CreatedAt:      serviceRequest.Base.CreatedAt,
-			InProgressAt:   serviceRequest.InProgressAt,
-			InProgressBy:   serviceRequest.InProgressByID,
-			ResolvedAt:     serviceRequest.ResolvedAt,
-			ResolvedBy:     serviceRequest.ResolvedByID,
-			ResolvedByName: &resolvedByName,
-			FacilityID:     serviceRequest.FacilityID,
-			ClientName:     &clientProfile.User.Name,
-			ClientContact:  &clientProfile.User.Contacts.Value,
-			Meta:           meta,
+			ID:                   *serviceRequest.ID,
+			RequestType:          serviceRequest.RequestType,
+			Request:              serviceRequest.Request,
+			Status:               serviceRequest.Status,
+			ClientID:             serviceRequest.ClientID,
+			CreatedAt:            serviceRequest.Base.CreatedAt,
+			InProgressAt:         serviceRequest.InProgressAt,
+			InProgressBy:         serviceRequest.InProgressByID,
+			ResolvedAt:           serviceRequest.ResolvedAt,
+			ResolvedBy:           serviceRequest.ResolvedByID,
+			ResolvedByName:       &resolvedByName,
+			EscalatedAt:          serviceRequest.EscalatedAt,
+			EscalationBackfilled: serviceRequest.EscalationBackfilled,
+			AssignedTo:           serviceRequest.AssignedToID,
+			AssignedToName:       assignedToName,
+			AssignedAt:           serviceRequest.AssignedAt,
+			FacilityID:           serviceRequest.FacilityID,
+			ClientName:           &clientProfile.User.Name,
+			ClientContact:        &clientProfile.User.Contacts.Value,
+			Meta:                 meta,
 		}
 		serviceRequests = append(serviceRequests, serviceRequest)
 	}
@@ -934,11 +946,7 @@ func (d *MyCareHubDb) GetCommunityByID(ctx context.Context, communityID string)
 		return nil, err
 	}
 
-	return &domain.Community{
-		ID:          community.ID,
-		Name:        community.Name,
-		Description: community.Description,
-	}, nil
+	return mapCommunity(community), nil
 }
 
 // CheckIdentifierExists checks whether an identifier of a certain type and value exists
@@ -992,14 +1000,15 @@ func (d *MyCareHubDb) GetClientsInAFacility(ctx context.Context, facilityID stri
 }
 
 // GetRecentHealthDiaryEntries queries the database for health diary entries that were
-// recorded after the last time the entries were synced to KenyaEMR.
+// recorded after the last time the entries were synced to KenyaEMR and that the client shares with the facility.
 func (d *MyCareHubDb) GetRecentHealthDiaryEntries(
 	ctx context.Context,
 	lastSyncTime time.Time,
 	client *domain.ClientProfile,
+	facilityID string,
 ) ([]*domain.ClientHealthDiaryEntry, error) {
 	var healthDiaryEntries []*domain.ClientHealthDiaryEntry
-	clientHealthDiaryEntry, err := d.query.GetRecentHealthDiaryEntries(ctx, lastSyncTime, *client.ID)
+	clientHealthDiaryEntry, err := d.query.GetRecentHealthDiaryEntries(ctx, lastSyncTime, *client.ID, facilityID)
 	if err != nil {
 		return nil, err
 	}
@@ -1200,6 +1209,8 @@ func (d *MyCareHubDb) GetServiceRequestsForKenyaEMR(ctx context.Context, payload
 			InProgressBy:       serviceReq.InProgressByID,
 			ResolvedAt:         serviceReq.ResolvedAt,
 			ResolvedBy:         serviceReq.ResolvedByID,
+			AssignedTo:         serviceReq.AssignedToID,
+			AssignedAt:         serviceReq.AssignedAt,
 			FacilityID:         serviceReq.FacilityID,
 			ClientName:         &userProfile.Name,
 			ClientContact:      &userProfile.Contacts.Value,
@@ -1241,6 +1252,8 @@ func (d *MyCareHubDb) ListAppointments(ctx context.Context, params *domain.Appoi
 				Day:   a.Date.Day(),
 			},
 			HasRescheduledAppointment: a.HasRescheduledAppointment,
+			Missed:                    a.Missed,
+			SlotID:                    a.SlotID,
 		}
 
 		mapped = append(mapped, m)
@@ -1482,7 +1495,10 @@ func (d *MyCareHubDb) GetClientServiceRequestByID(ctx context.Context, serviceRe
 		InProgressBy: serviceRequest.InProgressByID,
 		ResolvedAt:   serviceRequest.ResolvedAt,
 		ResolvedBy:   serviceRequest.ResolvedByID,
+		AssignedTo:   serviceRequest.AssignedToID,
+		AssignedAt:   serviceRequest.AssignedAt,
 		FacilityID:   serviceRequest.FacilityID,
+		ProgramID:    serviceRequest.ProgramID,
 		Meta:         metadata,
 	}, nil
 }
@@ -1507,6 +1523,8 @@ func (d *MyCareHubDb) GetStaffProfileByStaffID(ctx context.Context, staffID stri
 		Active:          staffProfile.Active,
 		StaffNumber:     staffProfile.StaffNumber,
 		DefaultFacility: facility,
+		OrganisationID:  staffProfile.OrganisationID,
+		ProgramID:       staffProfile.ProgramID,
 	}, nil
 }
 
@@ -1562,6 +1580,11 @@ func (d *MyCareHubDb) GetAppointmentServiceRequests(ctx context.Context, lastSyn
 			return nil, err
 		}
 
+		action := enums.AppointmentServiceRequestActionReschedule
+		if valueAction, exists := metaMap["action"]; exists {
+			action = enums.AppointmentServiceRequestAction(valueAction.(string))
+		}
+
 		var inProgressByName string
 		if request.InProgressByID != nil {
 			inProgressBy, err := d.GetUserProfileByStaffID(ctx, *request.InProgressByID)
@@ -1610,6 +1633,8 @@ func (d *MyCareHubDb) GetAppointmentServiceRequests(ctx context.Context, lastSyn
 			ClientName:    &clientProfile.User.Name,
 			ClientContact: &clientProfile.User.Contacts.Value,
 			CCCNumber:     identifierValue,
+
+			Action: action,
 		}
 
 		appointmentServiceRequests = append(appointmentServiceRequests, m)
@@ -1668,6 +1693,8 @@ func (d *MyCareHubDb) GetAppointment(ctx context.Context, params domain.Appointm
 		ClientID:   appointment.ClientID,
 		FacilityID: appointment.FacilityID,
 		Provider:   appointment.Provider,
+		Missed:     appointment.Missed,
+		SlotID:     appointment.SlotID,
 	}
 
 	return ap, nil
@@ -1733,6 +1760,9 @@ func (d *MyCareHubDb) GetUserSurveyForms(ctx context.Context, params map[string]
 			LinkID:         s.LinkID,
 			ProgramID:      s.ProgramID,
 			OrganisationID: s.OrganisationID,
+			CampaignID:     s.CampaignID,
+			ExpiresAt:      s.ExpiresAt,
+			LastRemindedAt: s.LastRemindedAt,
 		})
 	}
 
@@ -1886,7 +1916,71 @@ func (d *MyCareHubDb) GetScreeningToolByID(ctx context.Context, toolID string) (
 		return nil, err
 	}
 
-	questionnaire, err := d.query.GetQuestionnaireByID(ctx, tool.QuestionnaireID)
+	questionnaire, err := d.getQuestionnaire(ctx, tool.QuestionnaireID)
+	if err != nil {
+		return nil, err
+	}
+
+	scoreBandsPayload, err := d.query.GetScreeningToolScoreBands(ctx, tool.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	scoreBands := []domain.ScreeningToolScoreBand{}
+	for _, b := range scoreBandsPayload {
+		scoreBands = append(scoreBands, mapScreeningToolScoreBand(b))
+	}
+
+	versions, err := d.query.ListScreeningToolVersions(ctx, tool.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	clientTypes := []enums.ClientType{}
+	for _, k := range tool.ClientTypes {
+		clientTypes = append(clientTypes, enums.ClientType(k))
+	}
+
+	genders := []enumutils.Gender{}
+	for _, k := range tool.Genders {
+		genders = append(genders, enumutils.Gender(k))
+	}
+
+	screeningTool := &domain.ScreeningTool{
+		ID:              tool.ID,
+		Active:          tool.Active,
+		QuestionnaireID: tool.QuestionnaireID,
+		Threshold:       tool.Threshold,
+		ClientTypes:     clientTypes,
+		Genders:         genders,
+		AgeRange: domain.AgeRange{
+			LowerBound: tool.MinimumAge,
+			UpperBound: tool.MaximumAge,
+		},
+		Questionnaire:  *questionnaire,
+		ScoreBands:     scoreBands,
+		ProgramID:      tool.ProgramID,
+		OrganisationID: tool.OrganisationID,
+
+		Recurrence:             enums.ScreeningToolRecurrence(tool.Recurrence),
+		RecurrenceIntervalDays: tool.RecurrenceIntervalDays,
+
+		ScoreWorseningThreshold: tool.ScoreWorseningThreshold,
+	}
+
+	for _, v := range versions {
+		if v.Status == enums.ScreeningToolVersionStatusPublished.String() {
+			screeningTool.VersionID = &v.ID
+			screeningTool.Version = v.Version
+		}
+	}
+
+	return screeningTool, nil
+}
+
+// getQuestionnaire fetches a questionnaire together with its questions, their choices and display conditions
+func (d *MyCareHubDb) getQuestionnaire(ctx context.Context, questionnaireID string) (*domain.Questionnaire, error) {
+	questionnaire, err := d.query.GetQuestionnaireByID(ctx, questionnaireID)
 	if err != nil {
 		return nil, err
 	}
@@ -1915,48 +2009,37 @@ func (d *MyCareHubDb) GetScreeningToolByID(ctx context.Context, toolID string) (
 			})
 		}
 
+		conditions := []domain.QuestionCondition{}
+		conditionsPayload, err := d.query.GetQuestionConditionsByQuestionID(ctx, q.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range conditionsPayload {
+			conditions = append(conditions, mapQuestionCondition(c))
+		}
+
 		questions = append(questions, domain.Question{
-			ID:                q.ID,
-			Active:            q.Active,
-			QuestionnaireID:   q.QuestionnaireID,
-			Text:              q.Text,
-			QuestionType:      enums.QuestionType(q.QuestionType),
-			ResponseValueType: enums.QuestionResponseValueType(q.ResponseValueType),
-			Required:          q.Required,
-			SelectMultiple:    q.SelectMultiple,
-			Sequence:          q.Sequence,
-			Choices:           choices,
+			ID:                    q.ID,
+			Active:                q.Active,
+			QuestionnaireID:       q.QuestionnaireID,
+			Text:                  q.Text,
+			QuestionType:          enums.QuestionType(q.QuestionType),
+			ResponseValueType:     enums.QuestionResponseValueType(q.ResponseValueType),
+			Required:              q.Required,
+			SelectMultiple:        q.SelectMultiple,
+			Sequence:              q.Sequence,
+			Choices:               choices,
+			Conditions:            conditions,
+			CriticalItemThreshold: q.CriticalItemThreshold,
 		})
 	}
 
-	clientTypes := []enums.ClientType{}
-	for _, k := range tool.ClientTypes {
-		clientTypes = append(clientTypes, enums.ClientType(k))
-	}
-
-	genders := []enumutils.Gender{}
-	for _, k := range tool.Genders {
-		genders = append(genders, enumutils.Gender(k))
-	}
-
-	return &domain.ScreeningTool{
-		ID:              tool.ID,
-		Active:          tool.Active,
-		QuestionnaireID: tool.QuestionnaireID,
-		Threshold:       tool.Threshold,
-		ClientTypes:     clientTypes,
-		Genders:         genders,
-		AgeRange: domain.AgeRange{
-			LowerBound: tool.MinimumAge,
-			UpperBound: tool.MaximumAge,
-		},
-		Questionnaire: domain.Questionnaire{
-			ID:          questionnaire.ID,
-			Active:      questionnaire.Active,
-			Name:        questionnaire.Name,
-			Description: questionnaire.Description,
-			Questions:   questions,
-		},
+	return &domain.Questionnaire{
+		ID:          questionnaire.ID,
+		Active:      questionnaire.Active,
+		Name:        questionnaire.Name,
+		Description: questionnaire.Description,
+		Questions:   questions,
 	}, nil
 }
 
@@ -2018,14 +2101,18 @@ func (d *MyCareHubDb) GetAvailableScreeningTools(ctx context.Context, clientID s
 				Name:        questionnaire.Name,
 				Description: questionnaire.Description,
 			},
+			Recurrence:             enums.ScreeningToolRecurrence(s.Recurrence),
+			RecurrenceIntervalDays: s.RecurrenceIntervalDays,
+
+			ScoreWorseningThreshold: s.ScoreWorseningThreshold,
 		})
 	}
 	return screeningToolList, nil
 }
 
-// GetScreeningToolResponsesWithin24Hours gets the user screening response that are within 24 hours
-func (d *MyCareHubDb) GetScreeningToolResponsesWithin24Hours(ctx context.Context, clientID, programID string) ([]*domain.QuestionnaireScreeningToolResponse, error) {
-	screeningToolResponsesList, err := d.query.GetScreeningToolResponsesWithin24Hours(ctx, clientID, programID)
+// GetLatestScreeningToolResponses gets a client's most recent response to each of the screening tools they have responded to
+func (d *MyCareHubDb) GetLatestScreeningToolResponses(ctx context.Context, clientID, programID string) ([]*domain.QuestionnaireScreeningToolResponse, error) {
+	screeningToolResponsesList, err := d.query.GetLatestScreeningToolResponses(ctx, clientID, programID)
 	if err != nil {
 		return nil, err
 	}
@@ -2040,8 +2127,11 @@ func (d *MyCareHubDb) GetScreeningToolResponsesWithin24Hours(ctx context.Context
 			ClientID:        screeningToolResponse.ClientID,
 			DateOfResponse:  screeningToolResponse.CreatedAt,
 			AggregateScore:  screeningToolResponse.AggregateScore,
+			Severity:        screeningToolResponse.Severity,
 			ProgramID:       screeningToolResponse.ProgramID,
 			OrganisationID:  screeningToolResponse.OrganisationID,
+
+			ScreeningToolVersionID: screeningToolResponse.ScreeningToolVersionID,
 		})
 	}
 	return screeningToolResponses, nil
@@ -2064,8 +2154,11 @@ func (d *MyCareHubDb) GetScreeningToolResponsesWithPendingServiceRequests(ctx co
 			ClientID:        screeningToolResponse.ClientID,
 			DateOfResponse:  screeningToolResponse.CreatedAt,
 			AggregateScore:  screeningToolResponse.AggregateScore,
+			Severity:        screeningToolResponse.Severity,
 			ProgramID:       screeningToolResponse.ProgramID,
 			OrganisationID:  screeningToolResponse.OrganisationID,
+
+			ScreeningToolVersionID: screeningToolResponse.ScreeningToolVersionID,
 		})
 	}
 	return screeningToolResponses, nil
@@ -2159,6 +2252,14 @@ func (d *MyCareHubDb) GetScreeningToolResponseByID(ctx context.Context, id strin
 	if err != nil {
 		return nil, err
 	}
+	// the response is rendered against the version of the questionnaire it was answered against
+	if response.ScreeningToolVersionID != nil && (screeningTool.VersionID == nil || *screeningTool.VersionID != *response.ScreeningToolVersionID) {
+		questionnaire, err := d.getScreeningToolVersionQuestionnaire(ctx, response.ScreeningToolID, *response.ScreeningToolVersionID)
+		if err != nil {
+			return nil, err
+		}
+		screeningTool.Questionnaire = *questionnaire
+	}
 	questionResponsesPayload := []*domain.QuestionnaireScreeningToolQuestionResponse{}
 	for _, s := range screeningToolResponses {
 		question := screeningTool.GetQuestion(s.QuestionID)
@@ -2185,10 +2286,27 @@ func (d *MyCareHubDb) GetScreeningToolResponseByID(ctx context.Context, id strin
 		ClientID:          response.ClientID,
 		DateOfResponse:    response.CreatedAt,
 		AggregateScore:    response.AggregateScore,
+		Severity:          response.Severity,
 		QuestionResponses: questionResponsesPayload,
+
+		ScreeningToolVersionID: response.ScreeningToolVersionID,
 	}, nil
 }
 
+// getScreeningToolVersionQuestionnaire fetches the questionnaire of a specific version of a screening tool
+func (d *MyCareHubDb) getScreeningToolVersionQuestionnaire(ctx context.Context, screeningToolID, versionID string) (*domain.Questionnaire, error) {
+	versions, err := d.query.ListScreeningToolVersions(ctx, screeningToolID)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range versions {
+		if v.ID == versionID {
+			return d.getQuestionnaire(ctx, v.QuestionnaireID)
+		}
+	}
+	return nil, fmt.Errorf("screening tool version %s not found", versionID)
+}
+
 // GetSurveysWithServiceRequests fetches all the surveys with a service request for a given facility
 func (d *MyCareHubDb) GetSurveysWithServiceRequests(ctx context.Context, facilityID string) ([]*dto.SurveysWithServiceRequest, error) {
 	surveys, err := d.query.GetSurveysWithServiceRequests(ctx, facilityID)
@@ -2917,30 +3035,7 @@ func (d *MyCareHubDb) ListCommunities(ctx context.Context, programID string, org
 
 	var communities []*domain.Community
 	for _, record := range records {
-		clientTypes := []enums.ClientType{}
-		for _, k := range record.ClientTypes {
-			clientTypes = append(clientTypes, enums.ClientType(k))
-		}
-
-		genders := []enumutils.Gender{}
-		for _, k := range record.Gender {
-			genders = append(genders, enumutils.Gender(k))
-		}
-
-		communities = append(communities, &domain.Community{
-			ID:          record.ID,
-			RoomID:      record.RoomID,
-			Name:        record.Name,
-			Description: record.Description,
-			AgeRange: &domain.AgeRange{
-				LowerBound: record.MinimumAge,
-				UpperBound: record.MaximumAge,
-			},
-			Gender:         genders,
-			ClientType:     clientTypes,
-			OrganisationID: record.OrganisationID,
-			ProgramID:      record.ProgramID,
-		})
+		communities = append(communities, mapCommunity(record))
 	}
 
 	return communities, nil
@@ -2973,3 +3068,716 @@ func (d *MyCareHubDb) GetStaffServiceRequestByID(ctx context.Context, serviceReq
 		Meta:        metadata,
 	}, nil
 }
+
+// GetUserPermissions retrieves the permissions that have been granted to a user through the roles assigned to them in a program
+func (d *MyCareHubDb) GetUserPermissions(ctx context.Context, userID string, programID string) ([]*domain.AuthorityPermission, error) {
+	records, err := d.query.GetUserPermissions(ctx, userID, programID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := []*domain.AuthorityPermission{}
+	for _, record := range records {
+		permissions = append(permissions, &domain.AuthorityPermission{
+			PermissionID: *record.AuthorityPermissionID,
+			Active:       record.Active,
+			Name:         enums.PermissionType(record.Name),
+			Description:  record.Description,
+			Category:     record.Category,
+			Scope:        record.Scope,
+		})
+	}
+
+	return permissions, nil
+}
+
+// ListAuditLogs returns the audit logs recorded in an organisation
+func (d *MyCareHubDb) ListAuditLogs(ctx context.Context, organisationID string, filter *dto.AuditLogFilterInput, pagination *domain.Pagination) ([]*domain.AuditLog, *domain.Pagination, error) {
+	records, pageInfo, err := d.query.ListAuditLogs(ctx, organisationID, filter, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	auditLogs := []*domain.AuditLog{}
+	for _, record := range records {
+		auditLog := &domain.AuditLog{
+			ID:             *record.ID,
+			Timestamp:      record.Timestamp,
+			RecordType:     enums.AuditLogRecordType(record.RecordType),
+			Notes:          record.Notes,
+			TargetID:       record.TargetID,
+			TargetType:     record.TargetType,
+			OrganisationID: record.OrganisationID,
+		}
+
+		if record.ActorID != nil {
+			auditLog.ActorID = *record.ActorID
+		}
+
+		if record.ProgramID != nil {
+			auditLog.ProgramID = *record.ProgramID
+		}
+
+		if record.OldValue != "" {
+			if err := json.Unmarshal([]byte(record.OldValue), &auditLog.OldValue); err != nil {
+				return nil, nil, fmt.Errorf("failed to unmarshal audit log old value: %w", err)
+			}
+		}
+
+		if record.NewValue != "" {
+			if err := json.Unmarshal([]byte(record.NewValue), &auditLog.NewValue); err != nil {
+				return nil, nil, fmt.Errorf("failed to unmarshal audit log new value: %w", err)
+			}
+		}
+
+		auditLogs = append(auditLogs, auditLog)
+	}
+
+	return auditLogs, pageInfo, nil
+}
+
+// GetAuthorityRoleByID retrieves a role and the permissions that it has been granted
+func (d *MyCareHubDb) GetAuthorityRoleByID(ctx context.Context, roleID string) (*domain.AuthorityRole, error) {
+	role, err := d.query.GetAuthorityRoleByID(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := d.query.GetAuthorityRolePermissions(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAuthorityRole(role, permissions, nil), nil
+}
+
+// ListAuthorityRoles retrieves the roles in an organisation together with the permissions that they have been granted
+func (d *MyCareHubDb) ListAuthorityRoles(ctx context.Context, organisationID string, programID string) ([]*domain.AuthorityRole, error) {
+	records, err := d.query.ListAuthorityRoles(ctx, organisationID, programID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := []*domain.AuthorityRole{}
+	for _, record := range records {
+		permissions, err := d.query.GetAuthorityRolePermissions(ctx, *record.AuthorityRoleID)
+		if err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, mapAuthorityRole(record, permissions, nil))
+	}
+
+	return roles, nil
+}
+
+// ListAuthorityRoleStaff retrieves the staff that have been assigned a role
+func (d *MyCareHubDb) ListAuthorityRoleStaff(ctx context.Context, roleID string, pagination *domain.Pagination) ([]*domain.StaffProfile, *domain.Pagination, error) {
+	records, pageInfo, err := d.query.ListAuthorityRoleStaff(ctx, roleID, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	staff := []*domain.StaffProfile{}
+	for _, record := range records {
+		facility, err := d.RetrieveFacility(ctx, &record.DefaultFacilityID, true)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		staff = append(staff, &domain.StaffProfile{
+			ID:              record.ID,
+			User:            createMapUser(&record.UserProfile),
+			UserID:          record.UserID,
+			Active:          record.Active,
+			StaffNumber:     record.StaffNumber,
+			DefaultFacility: facility,
+			OrganisationID:  record.OrganisationID,
+			ProgramID:       record.ProgramID,
+		})
+	}
+
+	return staff, pageInfo, nil
+}
+
+// CheckStaffHasRole checks whether a staff has been assigned a role
+func (d *MyCareHubDb) CheckStaffHasRole(ctx context.Context, staffID string, roleID string) (bool, error) {
+	return d.query.CheckStaffHasRole(ctx, staffID, roleID)
+}
+
+// GetRecentOTPsCount returns the number of OTPs that have been generated for a phone number since the provided time
+func (d *MyCareHubDb) GetRecentOTPsCount(ctx context.Context, phoneNumber string, since time.Time) (int, error) {
+	if phoneNumber == "" {
+		return 0, fmt.Errorf("phone number should be provided")
+	}
+	return d.query.GetRecentOTPsCount(ctx, phoneNumber, since)
+}
+
+// ListAppointmentsPendingReminder retrieves the appointments scheduled on the provided date that are yet to receive
+// a reminder at the given offset
+func (d *MyCareHubDb) ListAppointmentsPendingReminder(ctx context.Context, appointmentDate time.Time, offsetDays int) ([]*domain.Appointment, error) {
+	records, err := d.query.ListAppointmentsPendingReminder(ctx, appointmentDate, offsetDays)
+	if err != nil {
+		return nil, err
+	}
+
+	appointments := []*domain.Appointment{}
+	for _, record := range records {
+		appointments = append(appointments, mapAppointment(record))
+	}
+
+	return appointments, nil
+}
+
+// GetNotificationPreferences retrieves the notification preferences that a user has set
+func (d *MyCareHubDb) GetNotificationPreferences(ctx context.Context, userID string) ([]*domain.NotificationPreference, error) {
+	records, err := d.query.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences := []*domain.NotificationPreference{}
+	for _, record := range records {
+		preferences = append(preferences, &domain.NotificationPreference{
+			ID:               record.ID,
+			UserID:           record.UserID,
+			NotificationType: enums.NotificationType(record.NotificationType),
+			PushEnabled:      record.PushEnabled,
+			SMSEnabled:       record.SMSEnabled,
+		})
+	}
+
+	return preferences, nil
+}
+
+// ListMissedAppointments retrieves the appointments due before the provided date that were not attended
+func (d *MyCareHubDb) ListMissedAppointments(ctx context.Context, before time.Time) ([]*domain.Appointment, error) {
+	records, err := d.query.ListMissedAppointments(ctx, before)
+	if err != nil {
+		return nil, err
+	}
+
+	appointments := []*domain.Appointment{}
+	for _, record := range records {
+		appointments = append(appointments, mapAppointment(record))
+	}
+
+	return appointments, nil
+}
+
+// GetAppointmentSlot retrieves an appointment slot using its ID
+func (d *MyCareHubDb) GetAppointmentSlot(ctx context.Context, slotID string) (*domain.AppointmentSlot, error) {
+	slot, err := d.query.GetAppointmentSlot(ctx, slotID)
+	if err != nil {
+		return nil, err
+	}
+	return mapAppointmentSlot(slot), nil
+}
+
+// ListAppointmentSlots retrieves the active appointment slots of a facility
+func (d *MyCareHubDb) ListAppointmentSlots(ctx context.Context, facilityID string) ([]*domain.AppointmentSlot, error) {
+	records, err := d.query.ListAppointmentSlots(ctx, facilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := []*domain.AppointmentSlot{}
+	for _, record := range records {
+		slots = append(slots, mapAppointmentSlot(record))
+	}
+
+	return slots, nil
+}
+
+// GetAppointmentSlotBookingCounts retrieves the number of active bookings made against each of a facility's
+// appointment slots on every date between the start and end dates
+func (d *MyCareHubDb) GetAppointmentSlotBookingCounts(ctx context.Context, facilityID string, startDate, endDate time.Time) ([]*domain.AppointmentSlotBookingCount, error) {
+	records, err := d.query.GetAppointmentSlotBookingCounts(ctx, facilityID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := []*domain.AppointmentSlotBookingCount{}
+	for _, record := range records {
+		counts = append(counts, &domain.AppointmentSlotBookingCount{
+			SlotID: record.SlotID,
+			Date:   record.Date,
+			Count:  record.Count,
+		})
+	}
+
+	return counts, nil
+}
+
+// ListOverdueServiceRequests retrieves the pending service requests of the given type created before the provided time
+// that have not been escalated yet
+func (d *MyCareHubDb) ListOverdueServiceRequests(ctx context.Context, requestType string, createdBefore time.Time) ([]*domain.ServiceRequest, error) {
+	records, err := d.query.ListOverdueServiceRequests(ctx, requestType, createdBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceRequests := []*domain.ServiceRequest{}
+	for _, record := range records {
+		serviceRequests = append(serviceRequests, &domain.ServiceRequest{
+			ID:                   *record.ID,
+			RequestType:          record.RequestType,
+			Request:              record.Request,
+			Status:               record.Status,
+			Active:               record.Active,
+			ClientID:             record.ClientID,
+			CreatedAt:            record.Base.CreatedAt,
+			InProgressAt:         record.InProgressAt,
+			ResolvedAt:           record.ResolvedAt,
+			EscalatedAt:          record.EscalatedAt,
+			EscalationBackfilled: record.EscalationBackfilled,
+			FacilityID:           record.FacilityID,
+		})
+	}
+
+	return serviceRequests, nil
+}
+
+// getStaffName returns the name of a staff member, caching the names that have already been looked up
+func (d *MyCareHubDb) getStaffName(ctx context.Context, staffID string, staffNames map[string]string) (string, error) {
+	if staffName, ok := staffNames[staffID]; ok {
+		return staffName, nil
+	}
+
+	user, err := d.query.GetUserProfileByStaffID(ctx, staffID)
+	if err != nil {
+		return "", err
+	}
+	staffNames[staffID] = user.Name
+
+	return user.Name, nil
+}
+
+// ListServiceRequestComments retrieves the comments on a service request, oldest first
+func (d *MyCareHubDb) ListServiceRequestComments(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestComment, error) {
+	records, err := d.query.ListServiceRequestComments(ctx, serviceRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	staffNames := map[string]string{}
+	comments := []*domain.ServiceRequestComment{}
+	for _, record := range records {
+		comment := mapServiceRequestComment(record)
+
+		comment.StaffName, err = d.getStaffName(ctx, record.StaffID, staffNames)
+		if err != nil {
+			return nil, err
+		}
+
+		comments = append(comments, comment)
+	}
+
+	return comments, nil
+}
+
+// ListServiceRequestEvents retrieves the history of a service request in the order in which it happened
+func (d *MyCareHubDb) ListServiceRequestEvents(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestEvent, error) {
+	records, err := d.query.ListServiceRequestEvents(ctx, serviceRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	staffNames := map[string]string{}
+	events := []*domain.ServiceRequestEvent{}
+	for _, record := range records {
+		event := mapServiceRequestEvent(record)
+
+		if record.StaffID != nil {
+			staffName, err := d.getStaffName(ctx, *record.StaffID, staffNames)
+			if err != nil {
+				return nil, err
+			}
+			event.StaffName = &staffName
+		}
+		if record.AssignedToID != nil {
+			assignedToName, err := d.getStaffName(ctx, *record.AssignedToID, staffNames)
+			if err != nil {
+				return nil, err
+			}
+			event.AssignedToName = &assignedToName
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// ListScreeningToolVersions fetches the versions of a screening tool ordered from the oldest to the newest
+func (d *MyCareHubDb) ListScreeningToolVersions(ctx context.Context, screeningToolID string) ([]*domain.ScreeningToolVersion, error) {
+	records, err := d.query.ListScreeningToolVersions(ctx, screeningToolID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []*domain.ScreeningToolVersion{}
+	for _, v := range records {
+		versions = append(versions, mapScreeningToolVersion(v))
+	}
+
+	return versions, nil
+}
+
+// GetScreeningToolVersion fetches a version of a screening tool together with its questionnaire
+func (d *MyCareHubDb) GetScreeningToolVersion(ctx context.Context, screeningToolID string, version int) (*domain.ScreeningToolVersion, error) {
+	records, err := d.query.ListScreeningToolVersions(ctx, screeningToolID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range records {
+		if v.Version != version {
+			continue
+		}
+
+		questionnaire, err := d.getQuestionnaire(ctx, v.QuestionnaireID)
+		if err != nil {
+			return nil, err
+		}
+
+		screeningToolVersion := mapScreeningToolVersion(v)
+		screeningToolVersion.Questionnaire = questionnaire
+		return screeningToolVersion, nil
+	}
+
+	return nil, fmt.Errorf("version %d of screening tool %s not found", version, screeningToolID)
+}
+
+// ListScreeningToolAssignments is used to get the screening tool assignments matching the provided parameters ordered by their due date
+func (d *MyCareHubDb) ListScreeningToolAssignments(ctx context.Context, params *domain.ScreeningToolAssignment) ([]*domain.ScreeningToolAssignment, error) {
+	records, err := d.query.ListScreeningToolAssignments(ctx, &gorm.ScreeningToolAssignment{
+		ID:              params.ID,
+		ScreeningToolID: params.ScreeningToolID,
+		ClientID:        params.ClientID,
+		Status:          params.Status.String(),
+		ProgramID:       params.ProgramID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := []*domain.ScreeningToolAssignment{}
+	for _, record := range records {
+		assignments = append(assignments, mapScreeningToolAssignment(record))
+	}
+
+	return assignments, nil
+}
+
+// ListPendingScreeningToolAssignmentsDueBefore is used to get the screening tool assignments that clients have not responded to
+// and are due before the provided time
+func (d *MyCareHubDb) ListPendingScreeningToolAssignmentsDueBefore(ctx context.Context, dueBefore time.Time) ([]*domain.ScreeningToolAssignment, error) {
+	records, err := d.query.ListPendingScreeningToolAssignmentsDueBefore(ctx, dueBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := []*domain.ScreeningToolAssignment{}
+	for _, record := range records {
+		assignments = append(assignments, mapScreeningToolAssignment(record))
+	}
+
+	return assignments, nil
+}
+
+// ListClientScreeningToolResponses gets all of a client's screening tool responses ordered from the oldest to the most recent.
+// The responses can optionally be limited to a single screening tool
+func (d *MyCareHubDb) ListClientScreeningToolResponses(ctx context.Context, clientID, programID string, screeningToolID *string) ([]*domain.QuestionnaireScreeningToolResponse, error) {
+	screeningToolResponsesList, err := d.query.ListClientScreeningToolResponses(ctx, clientID, programID, screeningToolID)
+	if err != nil {
+		return nil, err
+	}
+
+	screeningToolResponses := []*domain.QuestionnaireScreeningToolResponse{}
+	for _, screeningToolResponse := range screeningToolResponsesList {
+		screeningToolResponses = append(screeningToolResponses, &domain.QuestionnaireScreeningToolResponse{
+			ID:              screeningToolResponse.ID,
+			Active:          screeningToolResponse.Active,
+			ScreeningToolID: screeningToolResponse.ScreeningToolID,
+			FacilityID:      screeningToolResponse.FacilityID,
+			ClientID:        screeningToolResponse.ClientID,
+			DateOfResponse:  screeningToolResponse.CreatedAt,
+			AggregateScore:  screeningToolResponse.AggregateScore,
+			Severity:        screeningToolResponse.Severity,
+			ProgramID:       screeningToolResponse.ProgramID,
+			OrganisationID:  screeningToolResponse.OrganisationID,
+
+			ScreeningToolVersionID: screeningToolResponse.ScreeningToolVersionID,
+		})
+	}
+	return screeningToolResponses, nil
+}
+
+// ListClientHealthDiaryEntriesSince gets the health diary entries a client recorded after the provided time ordered from the oldest to the most recent
+func (d *MyCareHubDb) ListClientHealthDiaryEntriesSince(ctx context.Context, clientID string, since time.Time) ([]*domain.ClientHealthDiaryEntry, error) {
+	records, err := d.query.ListClientHealthDiaryEntriesSince(ctx, clientID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	healthDiaryEntries := []*domain.ClientHealthDiaryEntry{}
+	for _, record := range records {
+		healthDiaryEntries = append(healthDiaryEntries, &domain.ClientHealthDiaryEntry{
+			ID:                    record.ClientHealthDiaryEntryID,
+			Active:                record.Active,
+			Mood:                  record.Mood,
+			Note:                  record.Note,
+			EntryType:             record.EntryType,
+			ShareWithHealthWorker: record.ShareWithHealthWorker,
+			SharedAt:              record.SharedAt,
+			ClientID:              record.ClientID,
+			CreatedAt:             record.CreatedAt,
+			ProgramID:             record.ProgramID,
+			OrganisationID:        record.OrganisationID,
+		})
+	}
+	return healthDiaryEntries, nil
+}
+
+// ListClientIDsWithHealthDiaryEntriesSince gets the IDs of the clients who recorded a health diary entry after the provided time
+func (d *MyCareHubDb) ListClientIDsWithHealthDiaryEntriesSince(ctx context.Context, since time.Time) ([]string, error) {
+	return d.query.ListClientIDsWithHealthDiaryEntriesSince(ctx, since)
+}
+
+// GetHealthDiaryShareByID gets a health diary share using its ID
+func (d *MyCareHubDb) GetHealthDiaryShareByID(ctx context.Context, shareID string) (*domain.HealthDiaryShare, error) {
+	record, err := d.query.GetHealthDiaryShareByID(ctx, shareID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapHealthDiaryShare(record), nil
+}
+
+// ListClientHealthDiaryShares gets the grants a client made to their health diary, including the revoked and expired ones, from the most recent
+func (d *MyCareHubDb) ListClientHealthDiaryShares(ctx context.Context, clientID string) ([]*domain.HealthDiaryShare, error) {
+	records, err := d.query.ListClientHealthDiaryShares(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	shares := []*domain.HealthDiaryShare{}
+	for _, record := range records {
+		shares = append(shares, mapHealthDiaryShare(record))
+	}
+
+	return shares, nil
+}
+
+// GetCaregiverSharedHealthDiaryEntries gets the health diary entries that a client currently shares with one of their caregivers
+func (d *MyCareHubDb) GetCaregiverSharedHealthDiaryEntries(ctx context.Context, clientID string, caregiverID string) ([]*domain.ClientHealthDiaryEntry, error) {
+	records, err := d.query.GetCaregiverSharedHealthDiaryEntries(ctx, clientID, caregiverID)
+	if err != nil {
+		return nil, err
+	}
+
+	healthDiaryEntries := []*domain.ClientHealthDiaryEntry{}
+	for _, record := range records {
+		healthDiaryEntries = append(healthDiaryEntries, &domain.ClientHealthDiaryEntry{
+			ID:                    record.ClientHealthDiaryEntryID,
+			Active:                record.Active,
+			Mood:                  record.Mood,
+			Note:                  record.Note,
+			EntryType:             record.EntryType,
+			ShareWithHealthWorker: record.ShareWithHealthWorker,
+			SharedAt:              record.SharedAt,
+			ClientID:              record.ClientID,
+			CreatedAt:             record.CreatedAt,
+			ProgramID:             record.ProgramID,
+			OrganisationID:        record.OrganisationID,
+		})
+	}
+	return healthDiaryEntries, nil
+}
+
+// GetSurveyCampaignByID gets a survey campaign using its ID
+func (d *MyCareHubDb) GetSurveyCampaignByID(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error) {
+	record, err := d.query.GetSurveyCampaignByID(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapSurveyCampaign(record), nil
+}
+
+// ListSurveyCampaigns gets the survey campaigns that a program runs in a facility, from the most recent
+func (d *MyCareHubDb) ListSurveyCampaigns(ctx context.Context, facilityID, programID string) ([]*domain.SurveyCampaign, error) {
+	records, err := d.query.ListSurveyCampaigns(ctx, facilityID, programID)
+	if err != nil {
+		return nil, err
+	}
+
+	campaigns := []*domain.SurveyCampaign{}
+	for _, record := range records {
+		campaigns = append(campaigns, mapSurveyCampaign(record))
+	}
+
+	return campaigns, nil
+}
+
+// ListOngoingSurveyCampaigns gets the scheduled and active survey campaigns
+func (d *MyCareHubDb) ListOngoingSurveyCampaigns(ctx context.Context) ([]*domain.SurveyCampaign, error) {
+	records, err := d.query.ListOngoingSurveyCampaigns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	campaigns := []*domain.SurveyCampaign{}
+	for _, record := range records {
+		campaigns = append(campaigns, mapSurveyCampaign(record))
+	}
+
+	return campaigns, nil
+}
+
+// ListSurveyRedFlagRules gets the active red flag rules that a program defined for a survey form
+func (d *MyCareHubDb) ListSurveyRedFlagRules(ctx context.Context, projectID int, formID, programID string) ([]*domain.SurveyRedFlagRule, error) {
+	records, err := d.query.ListSurveyRedFlagRules(ctx, projectID, formID, programID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := []*domain.SurveyRedFlagRule{}
+	for _, record := range records {
+		rule, err := mapSurveyRedFlagRule(record)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// ListAllCommunities gets all the active communities across every program
+func (d *MyCareHubDb) ListAllCommunities(ctx context.Context) ([]*domain.Community, error) {
+	records, err := d.query.ListAllCommunities(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	communities := []*domain.Community{}
+	for _, record := range records {
+		communities = append(communities, mapCommunity(record))
+	}
+
+	return communities, nil
+}
+
+// ListProgramClients gets all the clients of a program, active or not, together with their user profiles
+func (d *MyCareHubDb) ListProgramClients(ctx context.Context, programID string) ([]*domain.ClientProfile, error) {
+	records, err := d.query.ListProgramClients(ctx, programID)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := []*domain.ClientProfile{}
+	for _, record := range records {
+		clientTypes := []enums.ClientType{}
+		for _, k := range record.ClientTypes {
+			clientTypes = append(clientTypes, enums.ClientType(k))
+		}
+
+		facilityID := record.FacilityID
+		client := &domain.ClientProfile{
+			ID:              record.ID,
+			User:            createMapUser(&record.User),
+			Active:          record.Active,
+			ClientTypes:     clientTypes,
+			OrganisationID:  record.OrganisationID,
+			ProgramID:       record.ProgramID,
+			DefaultFacility: &domain.Facility{ID: &facilityID},
+		}
+		if record.UserID != nil {
+			client.UserID = *record.UserID
+		}
+
+		clients = append(clients, client)
+	}
+
+	return clients, nil
+}
+
+// GetUserMatrixPassword gets the encrypted Matrix password of a user. An empty password is returned when the user's
+// Matrix password is not managed yet
+func (d *MyCareHubDb) GetUserMatrixPassword(ctx context.Context, userID string) (string, error) {
+	password, err := d.query.GetUserMatrixPassword(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if password == nil {
+		return "", nil
+	}
+
+	return *password, nil
+}
+
+// ListUsersWithoutMatrixPassword gets the users whose Matrix password is not managed yet
+func (d *MyCareHubDb) ListUsersWithoutMatrixPassword(ctx context.Context) ([]*domain.User, error) {
+	records, err := d.query.ListUsersWithoutMatrixPassword(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	users := []*domain.User{}
+	for _, record := range records {
+		users = append(users, createMapUser(record))
+	}
+
+	return users, nil
+}
+
+// ListCommunityCrisisKeywords gets the active crisis keywords of a program
+func (d *MyCareHubDb) ListCommunityCrisisKeywords(ctx context.Context, programID string) ([]*domain.CommunityCrisisKeyword, error) {
+	records, err := d.query.ListCommunityCrisisKeywords(ctx, programID)
+	if err != nil {
+		return nil, err
+	}
+
+	keywords := []*domain.CommunityCrisisKeyword{}
+	for _, record := range records {
+		keywords = append(keywords, mapCommunityCrisisKeyword(record))
+	}
+
+	return keywords, nil
+}
+
+// GetCommunityByRoomID fetches the active community backed by a Matrix room
+func (d *MyCareHubDb) GetCommunityByRoomID(ctx context.Context, roomID string) (*domain.Community, error) {
+	if roomID == "" {
+		return nil, fmt.Errorf("roomID cannot be empty")
+	}
+
+	community, err := d.query.GetCommunityByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapCommunity(community), nil
+}
+
+// CheckCrisisMessageFlagged checks whether a red flag service request has already been raised for a community message
+func (d *MyCareHubDb) CheckCrisisMessageFlagged(ctx context.Context, eventID string) (bool, error) {
+	if eventID == "" {
+		return false, fmt.Errorf("eventID cannot be empty")
+	}
+
+	return d.query.CheckCrisisMessageFlagged(ctx, eventID)
+}
+
+// GetSurveyRedFlagRuleByID gets a survey red flag rule by its ID
+func (d *MyCareHubDb) GetSurveyRedFlagRuleByID(ctx context.Context, ruleID string) (*domain.SurveyRedFlagRule, error) {
+	record, err := d.query.GetSurveyRedFlagRuleByID(ctx, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapSurveyRedFlagRule(record)
+}
@@ -1926,7 +1926,7 @@ func TestMyCareHubDb_GetClientHealthDiaryEntries(t *testing.T) {
 			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
 
 			if tt.name == "Sad Case - Fail to get client health diary entries" {
-				fakeGorm.MockGetClientHealthDiaryEntriesFn = func(ctx context.Context, params map[string]interface{}) ([]*gorm.ClientHealthDiaryEntry, error) {
+				fakeGorm.MockGetClientHealthDiaryEntriesFn = func(ctx context.Context, params map[string]interface{}, shared *bool) ([]*gorm.ClientHealthDiaryEntry, error) {
 					return nil, fmt.Errorf("an error occurred")
 				}
 			}
@@ -2519,12 +2519,12 @@ func TestMyCareHubDb_GetRecentHealthDiaryEntries(t *testing.T) {
 			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
 
 			if tt.name == "Sad Case - Fail to get recent entries" {
-				fakeGorm.MockGetRecentHealthDiaryEntriesFn = func(ctx context.Context, lastSyncTime time.Time, clientID string) ([]*gorm.ClientHealthDiaryEntry, error) {
+				fakeGorm.MockGetRecentHealthDiaryEntriesFn = func(ctx context.Context, lastSyncTime time.Time, clientID string, facilityID string) ([]*gorm.ClientHealthDiaryEntry, error) {
 					return nil, fmt.Errorf("failed to get recent health diary entries")
 				}
 			}
 
-			got, err := d.GetRecentHealthDiaryEntries(tt.args.ctx, tt.args.lastSyncTime, tt.args.client)
+			got, err := d.GetRecentHealthDiaryEntries(tt.args.ctx, tt.args.lastSyncTime, tt.args.client, uuid.New().String())
 			if (err != nil) != tt.wantErr {
 				t.Errorf("MyCareHubDb.GetRecentHealthDiaryEntries() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -4994,6 +4994,136 @@ func TestMyCareHubDb_GetScreeningToolByID(t *testing.T) {
 	}
 }
 
+func TestMyCareHubDb_GetScreeningToolByID_ScoreBands(t *testing.T) {
+	maxScore := 4
+	priority := enums.ServiceRequestPriorityHigh.String()
+
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: get screening tool with score bands",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: failed to get score bands",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			fakeGorm.MockGetScreeningToolScoreBandsFn = func(ctx context.Context, screeningToolID string) ([]*gorm.ScreeningToolScoreBand, error) {
+				return []*gorm.ScreeningToolScoreBand{
+					{
+						ID:              uuid.NewString(),
+						Active:          true,
+						ScreeningToolID: screeningToolID,
+						Label:           "Mild",
+						MinScore:        0,
+						MaxScore:        &maxScore,
+						Action:          enums.ScreeningToolBandActionNone.String(),
+					},
+					{
+						ID:              uuid.NewString(),
+						Active:          true,
+						ScreeningToolID: screeningToolID,
+						Label:           "Severe",
+						MinScore:        5,
+						Action:          enums.ScreeningToolBandActionCreateRedFlag.String(),
+						Priority:        &priority,
+					},
+				}, nil
+			}
+			if tt.name == "Sad case: failed to get score bands" {
+				fakeGorm.MockGetScreeningToolScoreBandsFn = func(ctx context.Context, screeningToolID string) ([]*gorm.ScreeningToolScoreBand, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.GetScreeningToolByID(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.GetScreeningToolByID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got.ScoreBands) != 2 {
+				t.Errorf("expected 2 score bands, got %v", len(got.ScoreBands))
+				return
+			}
+			if severe := got.ScoreBands[1]; severe.Priority == nil || *severe.Priority != enums.ServiceRequestPriorityHigh {
+				t.Errorf("expected the severe band to have a high priority, got %v", severe.Priority)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_GetScreeningToolByID_Conditions(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: get screening tool with question conditions",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: failed to get question conditions",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			dependsOnQuestionID := uuid.NewString()
+			fakeGorm.MockGetQuestionConditionsByQuestionIDFn = func(ctx context.Context, questionID string) ([]*gorm.QuestionCondition, error) {
+				return []*gorm.QuestionCondition{
+					{
+						ID:                  uuid.NewString(),
+						Active:              true,
+						QuestionID:          questionID,
+						DependsOnQuestionID: dependsOnQuestionID,
+						Operator:            enums.QuestionConditionOperatorIn.String(),
+						Target:              enums.QuestionConditionTargetChoice.String(),
+						Values:              []string{"1", "2"},
+					},
+				}, nil
+			}
+			if tt.name == "Sad case: failed to get question conditions" {
+				fakeGorm.MockGetQuestionConditionsByQuestionIDFn = func(ctx context.Context, questionID string) ([]*gorm.QuestionCondition, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.GetScreeningToolByID(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.GetScreeningToolByID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			for _, q := range got.Questionnaire.Questions {
+				if len(q.Conditions) != 1 {
+					t.Errorf("expected 1 condition, got %v", len(q.Conditions))
+					return
+				}
+				condition := q.Conditions[0]
+				if condition.DependsOnQuestionID != dependsOnQuestionID || condition.Operator != enums.QuestionConditionOperatorIn || len(condition.Values) != 2 {
+					t.Errorf("unexpected condition %+v", condition)
+				}
+			}
+		})
+	}
+}
+
 func TestMyCareHubDb_GetFacilityRespondedScreeningTools(t *testing.T) {
 	ctx := context.Background()
 
@@ -7172,7 +7302,7 @@ func TestMyCareHubDb_GetAvailableScreeningTools(t *testing.T) {
 	}
 }
 
-func TestMyCareHubDb_GetScreeningToolResponsesWithin24Hours(t *testing.T) {
+func TestMyCareHubDb_GetLatestScreeningToolResponses(t *testing.T) {
 	type args struct {
 		ctx       context.Context
 		clientID  string
@@ -7184,7 +7314,7 @@ func TestMyCareHubDb_GetScreeningToolResponsesWithin24Hours(t *testing.T) {
 		wantErr bool
 	}{
 		{
-			name: "Happy case: get sscreening tool responses within 24 hours",
+			name: "Happy case: get latest screening tool responses",
 			args: args{
 				ctx:       context.Background(),
 				clientID:  gofakeit.UUID(),
@@ -7193,7 +7323,7 @@ func TestMyCareHubDb_GetScreeningToolResponsesWithin24Hours(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "Sad case: failed to get sscreening tool responses within 24 hours",
+			name: "Sad case: failed to get latest screening tool responses",
 			args: args{
 				ctx:       context.Background(),
 				clientID:  gofakeit.UUID(),
@@ -7207,15 +7337,15 @@ func TestMyCareHubDb_GetScreeningToolResponsesWithin24Hours(t *testing.T) {
 			fakeGorm := gormMock.NewGormMock()
 			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
 
-			if tt.name == "Sad case: failed to get sscreening tool responses within 24 hours" {
-				fakeGorm.MockGetScreeningToolResponsesWithin24HoursFn = func(ctx context.Context, clientID, programID string) ([]*gorm.ScreeningToolResponse, error) {
+			if tt.name == "Sad case: failed to get latest screening tool responses" {
+				fakeGorm.MockGetLatestScreeningToolResponsesFn = func(ctx context.Context, clientID, programID string) ([]*gorm.ScreeningToolResponse, error) {
 					return nil, fmt.Errorf("an error occurred")
 				}
 			}
 
-			_, err := d.GetScreeningToolResponsesWithin24Hours(tt.args.ctx, tt.args.clientID, tt.args.programID)
+			_, err := d.GetLatestScreeningToolResponses(tt.args.ctx, tt.args.clientID, tt.args.programID)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("MyCareHubDb.GetScreeningToolResponsesWithin24Hours() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("MyCareHubDb.GetLatestScreeningToolResponses() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 		})
@@ -7320,3 +7450,1926 @@ func TestMyCareHubDb_GetStaffServiceRequestByID(t *testing.T) {
 		})
 	}
 }
+
+func TestMyCareHubDb_GetUserPermissions(t *testing.T) {
+	type args struct {
+		ctx       context.Context
+		userID    string
+		programID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get user permissions",
+			args: args{
+				ctx:       context.Background(),
+				userID:    gofakeit.UUID(),
+				programID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get user permissions",
+			args: args{
+				ctx:       context.Background(),
+				userID:    gofakeit.UUID(),
+				programID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeGorm := gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to get user permissions" {
+				fakeGorm.MockGetUserPermissionsFn = func(ctx context.Context, userID string, programID string) ([]*gorm.AuthorityPermission, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			got, err := d.GetUserPermissions(tt.args.ctx, tt.args.userID, tt.args.programID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.GetUserPermissions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected permissions to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListAuditLogs(t *testing.T) {
+	recordType := enums.AuditLogRecordTypeClientFacilityTransfer
+
+	type args struct {
+		ctx            context.Context
+		organisationID string
+		filter         *dto.AuditLogFilterInput
+		pagination     *domain.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list audit logs",
+			args: args{
+				ctx:            context.Background(),
+				organisationID: gofakeit.UUID(),
+				filter: &dto.AuditLogFilterInput{
+					RecordType: &recordType,
+				},
+				pagination: &domain.Pagination{
+					Limit:       10,
+					CurrentPage: 1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to list audit logs",
+			args: args{
+				ctx:            context.Background(),
+				organisationID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid audit log value",
+			args: args{
+				ctx:            context.Background(),
+				organisationID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeGorm := gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list audit logs" {
+				fakeGorm.MockListAuditLogsFn = func(ctx context.Context, organisationID string, filter *dto.AuditLogFilterInput, pagination *domain.Pagination) ([]*gorm.AuditLog, *domain.Pagination, error) {
+					return nil, nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: invalid audit log value" {
+				fakeGorm.MockListAuditLogsFn = func(ctx context.Context, organisationID string, filter *dto.AuditLogFilterInput, pagination *domain.Pagination) ([]*gorm.AuditLog, *domain.Pagination, error) {
+					id := gofakeit.UUID()
+					return []*gorm.AuditLog{
+						{
+							ID:       &id,
+							OldValue: "invalid",
+						},
+					}, nil, nil
+				}
+			}
+
+			got, _, err := d.ListAuditLogs(tt.args.ctx, tt.args.organisationID, tt.args.filter, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListAuditLogs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected audit logs to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_GetAuthorityRoleByID(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		roleID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get role by id",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get role",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get role permissions",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeGorm := gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to get role" {
+				fakeGorm.MockGetAuthorityRoleByIDFn = func(ctx context.Context, roleID string) (*gorm.AuthorityRole, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get role permissions" {
+				fakeGorm.MockGetAuthorityRolePermissionsFn = func(ctx context.Context, roleID string) ([]*gorm.AuthorityPermission, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := d.GetAuthorityRoleByID(tt.args.ctx, tt.args.roleID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.GetAuthorityRoleByID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Permissions) == 0 {
+				t.Errorf("expected role permissions to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListAuthorityRoles(t *testing.T) {
+	type args struct {
+		ctx            context.Context
+		organisationID string
+		programID      string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list roles",
+			args: args{
+				ctx:            context.Background(),
+				organisationID: gofakeit.UUID(),
+				programID:      gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to list roles",
+			args: args{
+				ctx:            context.Background(),
+				organisationID: gofakeit.UUID(),
+				programID:      gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get role permissions",
+			args: args{
+				ctx:            context.Background(),
+				organisationID: gofakeit.UUID(),
+				programID:      gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeGorm := gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list roles" {
+				fakeGorm.MockListAuthorityRolesFn = func(ctx context.Context, organisationID string, programID string) ([]*gorm.AuthorityRole, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get role permissions" {
+				fakeGorm.MockGetAuthorityRolePermissionsFn = func(ctx context.Context, roleID string) ([]*gorm.AuthorityPermission, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := d.ListAuthorityRoles(tt.args.ctx, tt.args.organisationID, tt.args.programID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListAuthorityRoles() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected roles to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListAuthorityRoleStaff(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		roleID     string
+		pagination *domain.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list role staff",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+				pagination: &domain.Pagination{
+					Limit:       10,
+					CurrentPage: 1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to list role staff",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to retrieve staff default facility",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeGorm := gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list role staff" {
+				fakeGorm.MockListAuthorityRoleStaffFn = func(ctx context.Context, roleID string, pagination *domain.Pagination) ([]*gorm.StaffProfile, *domain.Pagination, error) {
+					return nil, nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to retrieve staff default facility" {
+				fakeGorm.MockRetrieveFacilityFn = func(ctx context.Context, id *string, isActive bool) (*gorm.Facility, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, _, err := d.ListAuthorityRoleStaff(tt.args.ctx, tt.args.roleID, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListAuthorityRoleStaff() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected role staff to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_CheckStaffHasRole(t *testing.T) {
+	type args struct {
+		ctx     context.Context
+		staffID string
+		roleID  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "Happy case: staff has role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to check staff role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeGorm := gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to check staff role" {
+				fakeGorm.MockCheckStaffHasRoleFn = func(ctx context.Context, staffID string, roleID string) (bool, error) {
+					return false, errors.New("an error occurred")
+				}
+			}
+
+			got, err := d.CheckStaffHasRole(tt.args.ctx, tt.args.staffID, tt.args.roleID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.CheckStaffHasRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MyCareHubDb.CheckStaffHasRole() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_GetRecentOTPsCount(t *testing.T) {
+	ctx := context.Background()
+	type args struct {
+		ctx         context.Context
+		phoneNumber string
+		since       time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Happy case: count recent otps",
+			args: args{
+				ctx:         ctx,
+				phoneNumber: gofakeit.Phone(),
+				since:       time.Now().Add(-time.Hour),
+			},
+			want: 2,
+		},
+		{
+			name: "Sad case: missing phone number",
+			args: args{
+				ctx:   ctx,
+				since: time.Now().Add(-time.Hour),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to count recent otps",
+			args: args{
+				ctx:         ctx,
+				phoneNumber: gofakeit.Phone(),
+				since:       time.Now().Add(-time.Hour),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Happy case: count recent otps" {
+				fakeGorm.MockGetRecentOTPsCountFn = func(ctx context.Context, phoneNumber string, since time.Time) (int, error) {
+					return 2, nil
+				}
+			}
+			if tt.name == "Sad case: failed to count recent otps" {
+				fakeGorm.MockGetRecentOTPsCountFn = func(ctx context.Context, phoneNumber string, since time.Time) (int, error) {
+					return 0, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.GetRecentOTPsCount(tt.args.ctx, tt.args.phoneNumber, tt.args.since)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.GetRecentOTPsCount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MyCareHubDb.GetRecentOTPsCount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListAppointmentsPendingReminder(t *testing.T) {
+	type args struct {
+		ctx             context.Context
+		appointmentDate time.Time
+		offsetDays      int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list appointments pending reminder",
+			args: args{
+				ctx:             context.Background(),
+				appointmentDate: time.Now().AddDate(0, 0, 1),
+				offsetDays:      1,
+			},
+		},
+		{
+			name: "Sad case: unable to list appointments pending reminder",
+			args: args{
+				ctx:             context.Background(),
+				appointmentDate: time.Now().AddDate(0, 0, 1),
+				offsetDays:      1,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list appointments pending reminder" {
+				fakeGorm.MockListAppointmentsPendingReminderFn = func(ctx context.Context, appointmentDate time.Time, offsetDays int) ([]*gorm.Appointment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.ListAppointmentsPendingReminder(tt.args.ctx, tt.args.appointmentDate, tt.args.offsetDays)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListAppointmentsPendingReminder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected appointments to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_GetNotificationPreferences(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		userID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get notification preferences",
+			args: args{
+				ctx:    context.Background(),
+				userID: uuid.New().String(),
+			},
+		},
+		{
+			name: "Sad case: unable to get notification preferences",
+			args: args{
+				ctx:    context.Background(),
+				userID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to get notification preferences" {
+				fakeGorm.MockGetNotificationPreferencesFn = func(ctx context.Context, userID string) ([]*gorm.NotificationPreference, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.GetNotificationPreferences(tt.args.ctx, tt.args.userID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.GetNotificationPreferences() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got[0].NotificationType != enums.NotificationTypeAppointment {
+				t.Errorf("expected notification type %v, got %v", enums.NotificationTypeAppointment, got[0].NotificationType)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListMissedAppointments(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		before time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list missed appointments",
+			args: args{
+				ctx:    context.Background(),
+				before: time.Now(),
+			},
+		},
+		{
+			name: "Sad case: unable to list missed appointments",
+			args: args{
+				ctx:    context.Background(),
+				before: time.Now(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list missed appointments" {
+				fakeGorm.MockListMissedAppointmentsFn = func(ctx context.Context, before time.Time) ([]*gorm.Appointment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.ListMissedAppointments(tt.args.ctx, tt.args.before)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListMissedAppointments() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected appointments to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_GetAppointmentSlot(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		slotID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get appointment slot",
+			args: args{
+				ctx:    context.Background(),
+				slotID: uuid.New().String(),
+			},
+		},
+		{
+			name: "Sad case: unable to get appointment slot",
+			args: args{
+				ctx:    context.Background(),
+				slotID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to get appointment slot" {
+				fakeGorm.MockGetAppointmentSlotFn = func(ctx context.Context, slotID string) (*gorm.AppointmentSlot, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.GetAppointmentSlot(tt.args.ctx, tt.args.slotID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.GetAppointmentSlot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.ID != tt.args.slotID {
+				t.Errorf("MyCareHubDb.GetAppointmentSlot() returned slot %s, want %s", got.ID, tt.args.slotID)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListAppointmentSlots(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		facilityID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list appointment slots",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: uuid.New().String(),
+			},
+		},
+		{
+			name: "Sad case: unable to list appointment slots",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list appointment slots" {
+				fakeGorm.MockListAppointmentSlotsFn = func(ctx context.Context, facilityID string) ([]*gorm.AppointmentSlot, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.ListAppointmentSlots(tt.args.ctx, tt.args.facilityID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListAppointmentSlots() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected appointment slots to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_GetAppointmentSlotBookingCounts(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		facilityID string
+		startDate  time.Time
+		endDate    time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get appointment slot booking counts",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: uuid.New().String(),
+				startDate:  time.Now(),
+				endDate:    time.Now().AddDate(0, 0, 7),
+			},
+		},
+		{
+			name: "Sad case: unable to get appointment slot booking counts",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: uuid.New().String(),
+				startDate:  time.Now(),
+				endDate:    time.Now().AddDate(0, 0, 7),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to get appointment slot booking counts" {
+				fakeGorm.MockGetAppointmentSlotBookingCountsFn = func(ctx context.Context, facilityID string, startDate, endDate time.Time) ([]*gorm.AppointmentSlotBookingCount, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.GetAppointmentSlotBookingCounts(tt.args.ctx, tt.args.facilityID, tt.args.startDate, tt.args.endDate)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.GetAppointmentSlotBookingCounts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected appointment slot booking counts to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListOverdueServiceRequests(t *testing.T) {
+	type args struct {
+		ctx           context.Context
+		requestType   string
+		createdBefore time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list overdue service requests",
+			args: args{
+				ctx:           context.Background(),
+				requestType:   enums.ServiceRequestTypeRedFlag.String(),
+				createdBefore: time.Now().Add(-time.Hour),
+			},
+		},
+		{
+			name: "Sad case: unable to list overdue service requests",
+			args: args{
+				ctx:           context.Background(),
+				requestType:   enums.ServiceRequestTypeRedFlag.String(),
+				createdBefore: time.Now().Add(-time.Hour),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list overdue service requests" {
+				fakeGorm.MockListOverdueServiceRequestsFn = func(ctx context.Context, requestType string, createdBefore time.Time) ([]*gorm.ClientServiceRequest, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.ListOverdueServiceRequests(tt.args.ctx, tt.args.requestType, tt.args.createdBefore)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListOverdueServiceRequests() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected service requests to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListServiceRequestComments(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list service request comments",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to list service request comments",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to get commenter's name",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list service request comments" {
+				fakeGorm.MockListServiceRequestCommentsFn = func(ctx context.Context, serviceRequestID string) ([]*gorm.ServiceRequestComment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get commenter's name" {
+				fakeGorm.MockGetUserProfileByStaffIDFn = func(ctx context.Context, staffID string) (*gorm.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.ListServiceRequestComments(context.Background(), uuid.New().String())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListServiceRequestComments() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (len(got) == 0 || got[0].StaffName == "") {
+				t.Errorf("expected comments with the staff name to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListServiceRequestEvents(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list service request events",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to list service request events",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to get staff name",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list service request events" {
+				fakeGorm.MockListServiceRequestEventsFn = func(ctx context.Context, serviceRequestID string) ([]*gorm.ServiceRequestEvent, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get staff name" {
+				fakeGorm.MockGetUserProfileByStaffIDFn = func(ctx context.Context, staffID string) (*gorm.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.ListServiceRequestEvents(context.Background(), uuid.New().String())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListServiceRequestEvents() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (len(got) == 0 || got[0].StaffName == nil || got[0].AssignedToName == nil) {
+				t.Errorf("expected events with staff names to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListScreeningToolVersions(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list screening tool versions",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to list screening tool versions",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list screening tool versions" {
+				fakeGorm.MockListScreeningToolVersionsFn = func(ctx context.Context, screeningToolID string) ([]*gorm.ScreeningToolVersion, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.ListScreeningToolVersions(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListScreeningToolVersions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (len(got) != 1 || got[0].Status != enums.ScreeningToolVersionStatusPublished) {
+				t.Errorf("unexpected screening tool versions %v", got)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_GetScreeningToolVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: get screening tool version",
+			version: 1,
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: version not found",
+			version: 5,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to list screening tool versions",
+			version: 1,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to get questionnaire",
+			version: 1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list screening tool versions" {
+				fakeGorm.MockListScreeningToolVersionsFn = func(ctx context.Context, screeningToolID string) ([]*gorm.ScreeningToolVersion, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get questionnaire" {
+				fakeGorm.MockGetQuestionnaireByIDFn = func(ctx context.Context, questionnaireID string) (*gorm.Questionnaire, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.GetScreeningToolVersion(context.Background(), uuid.NewString(), tt.version)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.GetScreeningToolVersion() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got.Version != tt.version || got.Questionnaire == nil) {
+				t.Errorf("unexpected screening tool version %+v", got)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_GetScreeningToolResponseByID_PinnedVersion(t *testing.T) {
+	var fakeGorm = gormMock.NewGormMock()
+	d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+	currentQuestionnaireID := uuid.NewString()
+	previousQuestionnaireID := uuid.NewString()
+	previousVersionID := uuid.NewString()
+	previousQuestionID := uuid.NewString()
+
+	fakeGorm.MockGetScreeningToolResponseByIDFn = func(ctx context.Context, id string) (*gorm.ScreeningToolResponse, error) {
+		return &gorm.ScreeningToolResponse{
+			ID:                     id,
+			ScreeningToolID:        uuid.NewString(),
+			ScreeningToolVersionID: &previousVersionID,
+		}, nil
+	}
+	fakeGorm.MockGetScreeningToolQuestionResponsesByResponseIDFn = func(ctx context.Context, responseID string) ([]*gorm.ScreeningToolQuestionResponse, error) {
+		return []*gorm.ScreeningToolQuestionResponse{
+			{ID: uuid.NewString(), QuestionID: previousQuestionID, Response: "0"},
+		}, nil
+	}
+	fakeGorm.MockGetScreeningToolByIDFn = func(ctx context.Context, toolID string) (*gorm.ScreeningTool, error) {
+		return &gorm.ScreeningTool{ID: toolID, QuestionnaireID: currentQuestionnaireID}, nil
+	}
+	fakeGorm.MockListScreeningToolVersionsFn = func(ctx context.Context, screeningToolID string) ([]*gorm.ScreeningToolVersion, error) {
+		return []*gorm.ScreeningToolVersion{
+			{ID: previousVersionID, QuestionnaireID: previousQuestionnaireID, Version: 1, Status: enums.ScreeningToolVersionStatusRetired.String()},
+			{ID: uuid.NewString(), QuestionnaireID: currentQuestionnaireID, Version: 2, Status: enums.ScreeningToolVersionStatusPublished.String()},
+		}, nil
+	}
+	fakeGorm.MockGetQuestionnaireByIDFn = func(ctx context.Context, questionnaireID string) (*gorm.Questionnaire, error) {
+		return &gorm.Questionnaire{ID: questionnaireID}, nil
+	}
+	fakeGorm.MockGetQuestionsByQuestionnaireIDFn = func(ctx context.Context, questionnaireID string) ([]*gorm.Question, error) {
+		question := &gorm.Question{
+			ID:                uuid.NewString(),
+			QuestionnaireID:   questionnaireID,
+			Text:              "Current question",
+			QuestionType:      enums.QuestionTypeCloseEnded.String(),
+			ResponseValueType: enums.QuestionResponseValueTypeNumber.String(),
+			Sequence:          1,
+		}
+		if questionnaireID == previousQuestionnaireID {
+			question.ID = previousQuestionID
+			question.Text = "Previous question"
+		}
+		return []*gorm.Question{question}, nil
+	}
+	fakeGorm.MockGetQuestionInputChoicesByQuestionIDFn = func(ctx context.Context, questionID string) ([]*gorm.QuestionInputChoice, error) {
+		return []*gorm.QuestionInputChoice{
+			{ID: uuid.NewString(), QuestionID: questionID, Choice: "0", Value: "Not at all", Score: 0},
+		}, nil
+	}
+
+	got, err := d.GetScreeningToolResponseByID(context.Background(), uuid.NewString())
+	if err != nil {
+		t.Errorf("MyCareHubDb.GetScreeningToolResponseByID() error = %v", err)
+		return
+	}
+	if got.ScreeningToolVersionID == nil || *got.ScreeningToolVersionID != previousVersionID {
+		t.Errorf("expected the response to be pinned to version %s, got %v", previousVersionID, got.ScreeningToolVersionID)
+		return
+	}
+	if len(got.QuestionResponses) != 1 || got.QuestionResponses[0].QuestionText != "Previous question" {
+		t.Errorf("expected the response to be rendered against the version it was answered against, got %+v", got.QuestionResponses)
+	}
+	if got.QuestionResponses[0].NormalizedResponse["0"] != "Not at all" {
+		t.Errorf("expected the normalized response to use the pinned version's choices, got %v", got.QuestionResponses[0].NormalizedResponse)
+	}
+
+	fakeGorm.MockListScreeningToolVersionsFn = func(ctx context.Context, screeningToolID string) ([]*gorm.ScreeningToolVersion, error) {
+		return []*gorm.ScreeningToolVersion{}, nil
+	}
+	if _, err := d.GetScreeningToolResponseByID(context.Background(), uuid.NewString()); err == nil {
+		t.Errorf("expected an error when the pinned version does not exist")
+	}
+}
+
+func TestMyCareHubDb_ListScreeningToolAssignments(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list screening tool assignments",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to list screening tool assignments",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list screening tool assignments" {
+				fakeGorm.MockListScreeningToolAssignmentsFn = func(ctx context.Context, params *gorm.ScreeningToolAssignment) ([]*gorm.ScreeningToolAssignment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.ListScreeningToolAssignments(context.Background(), &domain.ScreeningToolAssignment{
+				ClientID: uuid.NewString(),
+				Status:   enums.ScreeningToolAssignmentStatusPending,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListScreeningToolAssignments() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (len(got) != 1 || got[0].Status != enums.ScreeningToolAssignmentStatusPending) {
+				t.Errorf("unexpected screening tool assignments %v", got)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListPendingScreeningToolAssignmentsDueBefore(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list pending screening tool assignments",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to list pending screening tool assignments",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list pending screening tool assignments" {
+				fakeGorm.MockListPendingScreeningToolAssignmentsDueBeforeFn = func(ctx context.Context, dueBefore time.Time) ([]*gorm.ScreeningToolAssignment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.ListPendingScreeningToolAssignmentsDueBefore(context.Background(), time.Now())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListPendingScreeningToolAssignmentsDueBefore() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) != 1 {
+				t.Errorf("expected one pending screening tool assignment, got %d", len(got))
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListClientScreeningToolResponses(t *testing.T) {
+	screeningToolID := gofakeit.UUID()
+
+	type args struct {
+		ctx             context.Context
+		clientID        string
+		programID       string
+		screeningToolID *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list client screening tool responses",
+			args: args{
+				ctx:       context.Background(),
+				clientID:  gofakeit.UUID(),
+				programID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: list client responses to a screening tool",
+			args: args{
+				ctx:             context.Background(),
+				clientID:        gofakeit.UUID(),
+				programID:       gofakeit.UUID(),
+				screeningToolID: &screeningToolID,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: failed to list client screening tool responses",
+			args: args{
+				ctx:       context.Background(),
+				clientID:  gofakeit.UUID(),
+				programID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeGorm := gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: failed to list client screening tool responses" {
+				fakeGorm.MockListClientScreeningToolResponsesFn = func(ctx context.Context, clientID, programID string, screeningToolID *string) ([]*gorm.ScreeningToolResponse, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			_, err := d.ListClientScreeningToolResponses(tt.args.ctx, tt.args.clientID, tt.args.programID, tt.args.screeningToolID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListClientScreeningToolResponses() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListClientHealthDiaryEntriesSince(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		clientID string
+		since    time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list client health diary entries",
+			args: args{
+				ctx:      context.Background(),
+				clientID: gofakeit.UUID(),
+				since:    time.Now().AddDate(0, 0, -30),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: failed to list client health diary entries",
+			args: args{
+				ctx:      context.Background(),
+				clientID: gofakeit.UUID(),
+				since:    time.Now().AddDate(0, 0, -30),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeGorm := gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: failed to list client health diary entries" {
+				fakeGorm.MockListClientHealthDiaryEntriesSinceFn = func(ctx context.Context, clientID string, since time.Time) ([]*gorm.ClientHealthDiaryEntry, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			_, err := d.ListClientHealthDiaryEntriesSince(tt.args.ctx, tt.args.clientID, tt.args.since)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListClientHealthDiaryEntriesSince() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListClientIDsWithHealthDiaryEntriesSince(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		since time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list clients with health diary entries",
+			args: args{
+				ctx:   context.Background(),
+				since: time.Now().AddDate(0, 0, -30),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: failed to list clients with health diary entries",
+			args: args{
+				ctx:   context.Background(),
+				since: time.Now().AddDate(0, 0, -30),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeGorm := gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: failed to list clients with health diary entries" {
+				fakeGorm.MockListClientIDsWithHealthDiaryEntriesSinceFn = func(ctx context.Context, since time.Time) ([]string, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			_, err := d.ListClientIDsWithHealthDiaryEntriesSince(tt.args.ctx, tt.args.since)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListClientIDsWithHealthDiaryEntriesSince() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_GetHealthDiaryShareByID(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: get health diary share",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to get health diary share",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to get health diary share" {
+				fakeGorm.MockGetHealthDiaryShareByIDFn = func(ctx context.Context, shareID string) (*gorm.HealthDiaryShare, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.GetHealthDiaryShareByID(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.GetHealthDiaryShareByID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected a health diary share to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListClientHealthDiaryShares(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list client health diary shares",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to list client health diary shares",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list client health diary shares" {
+				fakeGorm.MockListClientHealthDiarySharesFn = func(ctx context.Context, clientID string) ([]*gorm.HealthDiaryShare, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.ListClientHealthDiaryShares(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListClientHealthDiaryShares() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected health diary shares to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_GetCaregiverSharedHealthDiaryEntries(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: get entries shared with caregiver",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to get entries shared with caregiver",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to get entries shared with caregiver" {
+				fakeGorm.MockGetCaregiverSharedHealthDiaryEntriesFn = func(ctx context.Context, clientID string, caregiverID string) ([]*gorm.ClientHealthDiaryEntry, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.GetCaregiverSharedHealthDiaryEntries(context.Background(), uuid.NewString(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.GetCaregiverSharedHealthDiaryEntries() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected shared health diary entries to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_GetSurveyCampaignByID(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: get survey campaign",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to get survey campaign",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to get survey campaign" {
+				fakeGorm.MockGetSurveyCampaignByIDFn = func(ctx context.Context, campaignID string) (*gorm.SurveyCampaign, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.GetSurveyCampaignByID(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.GetSurveyCampaignByID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected a survey campaign to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListSurveyCampaigns(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list survey campaigns",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to list survey campaigns",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list survey campaigns" {
+				fakeGorm.MockListSurveyCampaignsFn = func(ctx context.Context, facilityID, programID string) ([]*gorm.SurveyCampaign, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.ListSurveyCampaigns(context.Background(), uuid.NewString(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListSurveyCampaigns() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected survey campaigns to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListOngoingSurveyCampaigns(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list ongoing survey campaigns",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to list ongoing survey campaigns",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list ongoing survey campaigns" {
+				fakeGorm.MockListOngoingSurveyCampaignsFn = func(ctx context.Context) ([]*gorm.SurveyCampaign, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.ListOngoingSurveyCampaigns(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListOngoingSurveyCampaigns() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected survey campaigns to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListSurveyRedFlagRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list survey red flag rules",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to list survey red flag rules",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: invalid survey red flag rule conditions",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list survey red flag rules" {
+				fakeGorm.MockListSurveyRedFlagRulesFn = func(ctx context.Context, projectID int, formID, programID string) ([]*gorm.SurveyRedFlagRule, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: invalid survey red flag rule conditions" {
+				fakeGorm.MockListSurveyRedFlagRulesFn = func(ctx context.Context, projectID int, formID, programID string) ([]*gorm.SurveyRedFlagRule, error) {
+					return []*gorm.SurveyRedFlagRule{{ID: uuid.NewString(), Conditions: "invalid"}}, nil
+				}
+			}
+
+			got, err := d.ListSurveyRedFlagRules(context.Background(), 1, uuid.NewString(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListSurveyRedFlagRules() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (len(got) != 1 || len(got[0].Conditions) != 1) {
+				t.Errorf("unexpected survey red flag rules %v", got)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_GetSurveyRedFlagRuleByID(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: get survey red flag rule",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to get survey red flag rule",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: invalid survey red flag rule conditions",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to get survey red flag rule" {
+				fakeGorm.MockGetSurveyRedFlagRuleByIDFn = func(ctx context.Context, ruleID string) (*gorm.SurveyRedFlagRule, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: invalid survey red flag rule conditions" {
+				fakeGorm.MockGetSurveyRedFlagRuleByIDFn = func(ctx context.Context, ruleID string) (*gorm.SurveyRedFlagRule, error) {
+					return &gorm.SurveyRedFlagRule{ID: ruleID, Conditions: "invalid"}, nil
+				}
+			}
+
+			got, err := d.GetSurveyRedFlagRuleByID(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.GetSurveyRedFlagRuleByID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Conditions) == 0 {
+				t.Errorf("expected the rule conditions to be mapped")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListAllCommunities(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list all communities",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to list all communities",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			facilityID := uuid.NewString()
+			fakeGorm.MockListAllCommunitiesFn = func(ctx context.Context) ([]*gorm.Community, error) {
+				return []*gorm.Community{{ID: uuid.NewString(), RoomID: uuid.NewString(), FacilityID: &facilityID}}, nil
+			}
+			if tt.name == "Sad case: unable to list all communities" {
+				fakeGorm.MockListAllCommunitiesFn = func(ctx context.Context) ([]*gorm.Community, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.ListAllCommunities(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListAllCommunities() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (len(got) != 1 || got[0].FacilityID != facilityID) {
+				t.Errorf("unexpected communities %v", got)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListProgramClients(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list program clients",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to list program clients",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list program clients" {
+				fakeGorm.MockListProgramClientsFn = func(ctx context.Context, programID string) ([]*gorm.Client, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.ListProgramClients(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListProgramClients() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (len(got) != 1 || got[0].User == nil || got[0].DefaultFacility == nil) {
+				t.Errorf("unexpected program clients %v", got)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_GetUserMatrixPassword(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: get user matrix password",
+			want:    "encrypted",
+			wantErr: false,
+		},
+		{
+			name:    "Happy case: matrix password not managed yet",
+			want:    "",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to get user matrix password",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			fakeGorm.MockGetUserMatrixPasswordFn = func(ctx context.Context, userID string) (*string, error) {
+				password := "encrypted"
+				return &password, nil
+			}
+			if tt.name == "Happy case: matrix password not managed yet" {
+				fakeGorm.MockGetUserMatrixPasswordFn = func(ctx context.Context, userID string) (*string, error) {
+					return nil, nil
+				}
+			}
+			if tt.name == "Sad case: unable to get user matrix password" {
+				fakeGorm.MockGetUserMatrixPasswordFn = func(ctx context.Context, userID string) (*string, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.GetUserMatrixPassword(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.GetUserMatrixPassword() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MyCareHubDb.GetUserMatrixPassword() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListUsersWithoutMatrixPassword(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list users without a matrix password",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to list users without a matrix password",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list users without a matrix password" {
+				fakeGorm.MockListUsersWithoutMatrixPasswordFn = func(ctx context.Context) ([]*gorm.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.ListUsersWithoutMatrixPassword(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListUsersWithoutMatrixPassword() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("MyCareHubDb.ListUsersWithoutMatrixPassword() expected users")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ListCommunityCrisisKeywords(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list community crisis keywords",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to list community crisis keywords",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to list community crisis keywords" {
+				fakeGorm.MockListCommunityCrisisKeywordsFn = func(ctx context.Context, programID string) ([]*gorm.CommunityCrisisKeyword, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.ListCommunityCrisisKeywords(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ListCommunityCrisisKeywords() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected community crisis keywords to be returned")
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_GetCommunityByRoomID(t *testing.T) {
+	tests := []struct {
+		name    string
+		roomID  string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: get community by room ID",
+			roomID:  "!room:example.com",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: missing room ID",
+			roomID:  "",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to get community by room ID",
+			roomID:  "!room:example.com",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to get community by room ID" {
+				fakeGorm.MockGetCommunityByRoomIDFn = func(ctx context.Context, roomID string) (*gorm.Community, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.GetCommunityByRoomID(context.Background(), tt.roomID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.GetCommunityByRoomID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got == nil || got.RoomID != tt.roomID) {
+				t.Errorf("unexpected community %v", got)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_CheckCrisisMessageFlagged(t *testing.T) {
+	tests := []struct {
+		name    string
+		eventID string
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: check whether a message has been flagged",
+			eventID: "$event",
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: missing event ID",
+			eventID: "",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to check whether a message has been flagged",
+			eventID: "$event",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Happy case: check whether a message has been flagged" {
+				fakeGorm.MockCheckCrisisMessageFlaggedFn = func(ctx context.Context, eventID string) (bool, error) {
+					return true, nil
+				}
+			}
+			if tt.name == "Sad case: unable to check whether a message has been flagged" {
+				fakeGorm.MockCheckCrisisMessageFlaggedFn = func(ctx context.Context, eventID string) (bool, error) {
+					return false, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.CheckCrisisMessageFlagged(context.Background(), tt.eventID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.CheckCrisisMessageFlagged() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MyCareHubDb.CheckCrisisMessageFlagged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
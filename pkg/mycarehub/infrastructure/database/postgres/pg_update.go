@@ -338,3 +338,154 @@ func (d *MyCareHubDb) UpdateProgram(ctx context.Context, program *domain.Program
 
 	return d.update.UpdateProgram(ctx, gormProgram, updateData)
 }
+
+// UpdateAuthorityRole updates a custom role. A nil list of permissions leaves the role's permissions unchanged
+func (d *MyCareHubDb) UpdateAuthorityRole(ctx context.Context, roleID string, updateData map[string]interface{}, permissions []domain.AuthorityPermission) error {
+	var rolePermissions []*gorm.AuthorityPermission
+	if permissions != nil {
+		rolePermissions = mapRolePermissionsToGorm(permissions)
+	}
+
+	return d.update.UpdateAuthorityRole(ctx, roleID, updateData, rolePermissions)
+}
+
+// UpdateSMSDelivery updates the delivery details of an SMS
+func (d *MyCareHubDb) UpdateSMSDelivery(ctx context.Context, delivery *domain.SMSDelivery, updateData map[string]interface{}) error {
+	return d.update.UpdateSMSDelivery(ctx, &gorm.SMSDelivery{ID: delivery.ID}, updateData)
+}
+
+// MarkAppointmentMissed marks an appointment as missed and creates a service request to follow up with the client
+func (d *MyCareHubDb) MarkAppointmentMissed(ctx context.Context, appointmentID string, serviceRequestInput *dto.ServiceRequestInput) error {
+	meta, err := json.Marshal(serviceRequestInput.Meta)
+	if err != nil {
+		return fmt.Errorf("failed to marshal meta data: %w", err)
+	}
+
+	serviceRequest := &gorm.ClientServiceRequest{
+		Active:         serviceRequestInput.Active,
+		RequestType:    serviceRequestInput.RequestType,
+		Request:        serviceRequestInput.Request,
+		Status:         serviceRequestInput.Status,
+		ClientID:       serviceRequestInput.ClientID,
+		FacilityID:     serviceRequestInput.FacilityID,
+		ProgramID:      serviceRequestInput.ProgramID,
+		Meta:           string(meta),
+		OrganisationID: serviceRequestInput.OrganisationID,
+	}
+
+	return d.update.MarkAppointmentMissed(ctx, appointmentID, serviceRequest)
+}
+
+// DeactivateAppointmentSlot stops a facility from accepting further bookings against an appointment slot
+func (d *MyCareHubDb) DeactivateAppointmentSlot(ctx context.Context, slotID string) error {
+	return d.update.DeactivateAppointmentSlot(ctx, slotID)
+}
+
+// CancelAppointmentBooking cancels an appointment booked against a facility slot and creates the service request used to
+// share the cancellation with KenyaEMR
+func (d *MyCareHubDb) CancelAppointmentBooking(ctx context.Context, appointmentID string, serviceRequestInput *dto.ServiceRequestInput) error {
+	meta, err := json.Marshal(serviceRequestInput.Meta)
+	if err != nil {
+		return fmt.Errorf("failed to marshal meta data: %w", err)
+	}
+
+	serviceRequest := &gorm.ClientServiceRequest{
+		Active:         serviceRequestInput.Active,
+		RequestType:    serviceRequestInput.RequestType,
+		Request:        serviceRequestInput.Request,
+		Status:         serviceRequestInput.Status,
+		ClientID:       serviceRequestInput.ClientID,
+		FacilityID:     serviceRequestInput.FacilityID,
+		ProgramID:      serviceRequestInput.ProgramID,
+		Meta:           string(meta),
+		OrganisationID: serviceRequestInput.OrganisationID,
+	}
+
+	return d.update.CancelAppointmentBooking(ctx, appointmentID, serviceRequest)
+}
+
+// MarkServiceRequestEscalated records when a service request was escalated
+func (d *MyCareHubDb) MarkServiceRequestEscalated(ctx context.Context, serviceRequestID string, escalatedAt time.Time) (bool, error) {
+	return d.update.MarkServiceRequestEscalated(ctx, serviceRequestID, escalatedAt)
+}
+
+// PublishScreeningToolDraft publishes the draft version of a screening tool making it the version that clients respond to
+func (d *MyCareHubDb) PublishScreeningToolDraft(ctx context.Context, screeningToolID string) (*domain.ScreeningToolVersion, error) {
+	versions, err := d.query.ListScreeningToolVersions(ctx, screeningToolID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range versions {
+		if v.Status != enums.ScreeningToolVersionStatusDraft.String() {
+			continue
+		}
+
+		err := d.update.PublishScreeningToolVersion(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+
+		publishedAt := time.Now()
+		v.Status = enums.ScreeningToolVersionStatusPublished.String()
+		v.PublishedAt = &publishedAt
+		return mapScreeningToolVersion(v), nil
+	}
+
+	return nil, fmt.Errorf("screening tool %s does not have a draft to publish", screeningToolID)
+}
+
+// UpdateScreeningToolAssignment updates a screening tool assignment with the new data
+func (d *MyCareHubDb) UpdateScreeningToolAssignment(ctx context.Context, assignment *domain.ScreeningToolAssignment, updateData map[string]interface{}) error {
+	return d.update.UpdateScreeningToolAssignment(ctx, &gorm.ScreeningToolAssignment{ID: assignment.ID}, updateData)
+}
+
+// CompleteScreeningToolAssignments marks a client's pending assignments of a screening tool as completed by the provided response
+func (d *MyCareHubDb) CompleteScreeningToolAssignments(ctx context.Context, clientID, screeningToolID, responseID string) error {
+	return d.update.CompleteScreeningToolAssignments(ctx, clientID, screeningToolID, responseID)
+}
+
+// UpdateScreeningTool updates a screening tool with the new data
+func (d *MyCareHubDb) UpdateScreeningTool(ctx context.Context, screeningTool *domain.ScreeningTool, updateData map[string]interface{}) error {
+	return d.update.UpdateScreeningTool(ctx, &gorm.ScreeningTool{ID: screeningTool.ID}, updateData)
+}
+
+// UpdateHealthDiaryShare updates a health diary share with the new data
+func (d *MyCareHubDb) UpdateHealthDiaryShare(ctx context.Context, share *domain.HealthDiaryShare, updateData map[string]interface{}) error {
+	if share.ID == nil {
+		return fmt.Errorf("a health diary share ID is required")
+	}
+
+	return d.update.UpdateHealthDiaryShare(ctx, &gorm.HealthDiaryShare{ID: *share.ID}, updateData)
+}
+
+// UpdateSurveyCampaign updates a survey campaign with the new data
+func (d *MyCareHubDb) UpdateSurveyCampaign(ctx context.Context, campaign *domain.SurveyCampaign, updateData map[string]interface{}) error {
+	return d.update.UpdateSurveyCampaign(ctx, &gorm.SurveyCampaign{ID: campaign.ID}, updateData)
+}
+
+// UpdateSurveyRedFlagRule updates a survey red flag rule with the new data
+func (d *MyCareHubDb) UpdateSurveyRedFlagRule(ctx context.Context, rule *domain.SurveyRedFlagRule, updateData map[string]interface{}) error {
+	return d.update.UpdateSurveyRedFlagRule(ctx, &gorm.SurveyRedFlagRule{ID: rule.ID}, updateData)
+}
+
+// UpdateCommunityCrisisKeyword updates a community crisis keyword with the new data
+func (d *MyCareHubDb) UpdateCommunityCrisisKeyword(ctx context.Context, keyword *domain.CommunityCrisisKeyword, updateData map[string]interface{}) error {
+	return d.update.UpdateCommunityCrisisKeyword(ctx, &gorm.CommunityCrisisKeyword{ID: keyword.ID}, updateData)
+}
+
+// SetUserMatrixPasswordIfUnset saves the encrypted Matrix password of a user who does not have one yet.
+// It reports whether the password was saved so that only one of several concurrent rotations of a user goes ahead
+func (d *MyCareHubDb) SetUserMatrixPasswordIfUnset(ctx context.Context, userID string, encryptedPassword string) (bool, error) {
+	if userID == "" || encryptedPassword == "" {
+		return false, fmt.Errorf("userID and encryptedPassword cannot be empty")
+	}
+
+	return d.update.SetUserMatrixPasswordIfUnset(ctx, userID, encryptedPassword)
+}
+
+// ClaimSurveyCampaignRound updates a survey campaign only if its next round is still due at the time it was read with.
+// It reports whether the campaign was updated so that a round is only sent by one of several concurrent processors
+func (d *MyCareHubDb) ClaimSurveyCampaignRound(ctx context.Context, campaign *domain.SurveyCampaign, updateData map[string]interface{}) (bool, error) {
+	return d.update.ClaimSurveyCampaignRound(ctx, &gorm.SurveyCampaign{ID: campaign.ID, NextSendAt: campaign.NextSendAt}, updateData)
+}
@@ -1891,3 +1891,771 @@ func TestMyCareHubDb_UpdateProgram(t *testing.T) {
 		})
 	}
 }
+
+func TestMyCareHubDb_UpdateAuthorityRole(t *testing.T) {
+	type args struct {
+		ctx         context.Context
+		roleID      string
+		updateData  map[string]interface{}
+		permissions []domain.AuthorityPermission
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update role and its permissions",
+			args: args{
+				ctx:        context.Background(),
+				roleID:     gofakeit.UUID(),
+				updateData: map[string]interface{}{"name": "Facility Manager"},
+				permissions: []domain.AuthorityPermission{
+					{
+						Name:     "Read facility",
+						Category: "Facility",
+						Scope:    "facility.read",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: update role without changing its permissions",
+			args: args{
+				ctx:        context.Background(),
+				roleID:     gofakeit.UUID(),
+				updateData: map[string]interface{}{"name": "Facility Manager"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to update role",
+			args: args{
+				ctx:        context.Background(),
+				roleID:     gofakeit.UUID(),
+				updateData: map[string]interface{}{"name": "Facility Manager"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Happy case: update role without changing its permissions" {
+				fakeGorm.MockUpdateAuthorityRoleFn = func(ctx context.Context, roleID string, updateData map[string]interface{}, permissions []*gorm.AuthorityPermission) error {
+					if permissions != nil {
+						return fmt.Errorf("expected permissions to be unchanged")
+					}
+					return nil
+				}
+			}
+			if tt.name == "Sad case: unable to update role" {
+				fakeGorm.MockUpdateAuthorityRoleFn = func(ctx context.Context, roleID string, updateData map[string]interface{}, permissions []*gorm.AuthorityPermission) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			if err := d.UpdateAuthorityRole(tt.args.ctx, tt.args.roleID, tt.args.updateData, tt.args.permissions); (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.UpdateAuthorityRole() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_UpdateSMSDelivery(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		delivery   *domain.SMSDelivery
+		updateData map[string]interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update sms delivery",
+			args: args{
+				ctx:        context.Background(),
+				delivery:   &domain.SMSDelivery{ID: uuid.New().String()},
+				updateData: map[string]interface{}{"status": enums.SMSDeliveryStatusSent.String()},
+			},
+		},
+		{
+			name: "Sad case: unable to update sms delivery",
+			args: args{
+				ctx:        context.Background(),
+				delivery:   &domain.SMSDelivery{ID: uuid.New().String()},
+				updateData: map[string]interface{}{"status": enums.SMSDeliveryStatusSent.String()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to update sms delivery" {
+				fakeGorm.MockUpdateSMSDeliveryFn = func(ctx context.Context, delivery *gorm.SMSDelivery, updateData map[string]interface{}) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			if err := d.UpdateSMSDelivery(tt.args.ctx, tt.args.delivery, tt.args.updateData); (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.UpdateSMSDelivery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_MarkAppointmentMissed(t *testing.T) {
+	type args struct {
+		ctx                 context.Context
+		appointmentID       string
+		serviceRequestInput *dto.ServiceRequestInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: mark appointment as missed",
+			args: args{
+				ctx:           context.Background(),
+				appointmentID: uuid.New().String(),
+				serviceRequestInput: &dto.ServiceRequestInput{
+					Active:      true,
+					RequestType: enums.ServiceRequestTypeMissedAppointment.String(),
+					Request:     gofakeit.Sentence(5),
+					Status:      enums.ServiceRequestStatusPending.String(),
+					ClientID:    uuid.New().String(),
+					FacilityID:  uuid.New().String(),
+					Meta: map[string]interface{}{
+						"appointmentID": uuid.New().String(),
+					},
+				},
+			},
+		},
+		{
+			name: "Sad case: unable to mark appointment as missed",
+			args: args{
+				ctx:           context.Background(),
+				appointmentID: uuid.New().String(),
+				serviceRequestInput: &dto.ServiceRequestInput{
+					RequestType: enums.ServiceRequestTypeMissedAppointment.String(),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to mark appointment as missed" {
+				fakeGorm.MockMarkAppointmentMissedFn = func(ctx context.Context, appointmentID string, serviceRequest *gorm.ClientServiceRequest) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			if err := d.MarkAppointmentMissed(tt.args.ctx, tt.args.appointmentID, tt.args.serviceRequestInput); (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.MarkAppointmentMissed() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_DeactivateAppointmentSlot(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		slotID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: deactivate appointment slot",
+			args: args{
+				ctx:    context.Background(),
+				slotID: uuid.New().String(),
+			},
+		},
+		{
+			name: "Sad case: unable to deactivate appointment slot",
+			args: args{
+				ctx:    context.Background(),
+				slotID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to deactivate appointment slot" {
+				fakeGorm.MockDeactivateAppointmentSlotFn = func(ctx context.Context, slotID string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			if err := d.DeactivateAppointmentSlot(tt.args.ctx, tt.args.slotID); (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.DeactivateAppointmentSlot() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_CancelAppointmentBooking(t *testing.T) {
+	type args struct {
+		ctx                 context.Context
+		appointmentID       string
+		serviceRequestInput *dto.ServiceRequestInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: cancel appointment booking",
+			args: args{
+				ctx:           context.Background(),
+				appointmentID: uuid.New().String(),
+				serviceRequestInput: &dto.ServiceRequestInput{
+					Active:      true,
+					RequestType: enums.ServiceRequestTypeAppointments.String(),
+					Request:     gofakeit.Sentence(5),
+					Status:      enums.ServiceRequestStatusPending.String(),
+					Meta: map[string]interface{}{
+						"action": enums.AppointmentServiceRequestActionCancel.String(),
+					},
+				},
+			},
+		},
+		{
+			name: "Sad case: unable to cancel appointment booking",
+			args: args{
+				ctx:                 context.Background(),
+				appointmentID:       uuid.New().String(),
+				serviceRequestInput: &dto.ServiceRequestInput{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to cancel appointment booking" {
+				fakeGorm.MockCancelAppointmentBookingFn = func(ctx context.Context, appointmentID string, serviceRequest *gorm.ClientServiceRequest) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			if err := d.CancelAppointmentBooking(tt.args.ctx, tt.args.appointmentID, tt.args.serviceRequestInput); (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.CancelAppointmentBooking() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_MarkServiceRequestEscalated(t *testing.T) {
+	type args struct {
+		ctx              context.Context
+		serviceRequestID string
+		escalatedAt      time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "Happy case: mark service request as escalated",
+			args: args{
+				ctx:              context.Background(),
+				serviceRequestID: uuid.New().String(),
+				escalatedAt:      time.Now(),
+			},
+			want: true,
+		},
+		{
+			name: "Sad case: unable to mark service request as escalated",
+			args: args{
+				ctx:              context.Background(),
+				serviceRequestID: uuid.New().String(),
+				escalatedAt:      time.Now(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to mark service request as escalated" {
+				fakeGorm.MockMarkServiceRequestEscalatedFn = func(ctx context.Context, serviceRequestID string, escalatedAt time.Time) (bool, error) {
+					return false, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.MarkServiceRequestEscalated(tt.args.ctx, tt.args.serviceRequestID, tt.args.escalatedAt)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.MarkServiceRequestEscalated() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MyCareHubDb.MarkServiceRequestEscalated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_PublishScreeningToolDraft(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: publish screening tool draft",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to list screening tool versions",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: screening tool has no draft",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to publish draft",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			draftID := uuid.NewString()
+			fakeGorm.MockListScreeningToolVersionsFn = func(ctx context.Context, screeningToolID string) ([]*gorm.ScreeningToolVersion, error) {
+				return []*gorm.ScreeningToolVersion{
+					{ID: uuid.NewString(), Version: 1, Status: enums.ScreeningToolVersionStatusPublished.String()},
+					{ID: draftID, Version: 2, Status: enums.ScreeningToolVersionStatusDraft.String()},
+				}, nil
+			}
+
+			switch tt.name {
+			case "Sad case: unable to list screening tool versions":
+				fakeGorm.MockListScreeningToolVersionsFn = func(ctx context.Context, screeningToolID string) ([]*gorm.ScreeningToolVersion, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			case "Sad case: screening tool has no draft":
+				fakeGorm.MockListScreeningToolVersionsFn = func(ctx context.Context, screeningToolID string) ([]*gorm.ScreeningToolVersion, error) {
+					return []*gorm.ScreeningToolVersion{
+						{ID: uuid.NewString(), Version: 1, Status: enums.ScreeningToolVersionStatusPublished.String()},
+					}, nil
+				}
+			case "Sad case: unable to publish draft":
+				fakeGorm.MockPublishScreeningToolVersionFn = func(ctx context.Context, version *gorm.ScreeningToolVersion) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.PublishScreeningToolDraft(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.PublishScreeningToolDraft() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.ID != draftID || got.Status != enums.ScreeningToolVersionStatusPublished || got.PublishedAt == nil {
+				t.Errorf("expected the draft to be published, got %+v", got)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_UpdateScreeningToolAssignment(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: update screening tool assignment",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to update screening tool assignment",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to update screening tool assignment" {
+				fakeGorm.MockUpdateScreeningToolAssignmentFn = func(ctx context.Context, assignment *gorm.ScreeningToolAssignment, updateData map[string]interface{}) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			err := d.UpdateScreeningToolAssignment(context.Background(), &domain.ScreeningToolAssignment{ID: uuid.NewString()}, map[string]interface{}{
+				"status": enums.ScreeningToolAssignmentStatusCancelled.String(),
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.UpdateScreeningToolAssignment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_CompleteScreeningToolAssignments(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: complete screening tool assignments",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to complete screening tool assignments",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to complete screening tool assignments" {
+				fakeGorm.MockCompleteScreeningToolAssignmentsFn = func(ctx context.Context, clientID, screeningToolID, responseID string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			err := d.CompleteScreeningToolAssignments(context.Background(), uuid.NewString(), uuid.NewString(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.CompleteScreeningToolAssignments() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_UpdateScreeningTool(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: update screening tool",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to update screening tool",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to update screening tool" {
+				fakeGorm.MockUpdateScreeningToolFn = func(ctx context.Context, screeningTool *gorm.ScreeningTool, updateData map[string]interface{}) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			err := d.UpdateScreeningTool(context.Background(), &domain.ScreeningTool{ID: uuid.NewString()}, map[string]interface{}{
+				"recurrence": enums.ScreeningToolRecurrenceOnce.String(),
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.UpdateScreeningTool() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_UpdateHealthDiaryShare(t *testing.T) {
+	shareID := uuid.NewString()
+
+	tests := []struct {
+		name    string
+		share   *domain.HealthDiaryShare
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: update health diary share",
+			share:   &domain.HealthDiaryShare{ID: &shareID},
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: missing health diary share ID",
+			share:   &domain.HealthDiaryShare{},
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to update health diary share",
+			share:   &domain.HealthDiaryShare{ID: &shareID},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to update health diary share" {
+				fakeGorm.MockUpdateHealthDiaryShareFn = func(ctx context.Context, share *gorm.HealthDiaryShare, updateData map[string]interface{}) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			err := d.UpdateHealthDiaryShare(context.Background(), tt.share, map[string]interface{}{
+				"active":     false,
+				"revoked_at": time.Now(),
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.UpdateHealthDiaryShare() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_UpdateSurveyCampaign(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: update survey campaign",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to update survey campaign",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to update survey campaign" {
+				fakeGorm.MockUpdateSurveyCampaignFn = func(ctx context.Context, campaign *gorm.SurveyCampaign, updateData map[string]interface{}) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			err := d.UpdateSurveyCampaign(context.Background(), &domain.SurveyCampaign{ID: uuid.NewString()}, map[string]interface{}{
+				"status":       enums.SurveyCampaignStatusCancelled.String(),
+				"next_send_at": nil,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.UpdateSurveyCampaign() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_ClaimSurveyCampaignRound(t *testing.T) {
+	nextSendAt := time.Now()
+
+	tests := []struct {
+		name    string
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: claim survey campaign round",
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name:    "Happy case: survey campaign round already claimed",
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to claim survey campaign round",
+			want:    false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Happy case: survey campaign round already claimed" {
+				fakeGorm.MockClaimSurveyCampaignRoundFn = func(ctx context.Context, campaign *gorm.SurveyCampaign, updateData map[string]interface{}) (bool, error) {
+					return false, nil
+				}
+			}
+			if tt.name == "Sad case: unable to claim survey campaign round" {
+				fakeGorm.MockClaimSurveyCampaignRoundFn = func(ctx context.Context, campaign *gorm.SurveyCampaign, updateData map[string]interface{}) (bool, error) {
+					return false, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.ClaimSurveyCampaignRound(context.Background(), &domain.SurveyCampaign{ID: uuid.NewString(), NextSendAt: &nextSendAt}, map[string]interface{}{
+				"status":       enums.SurveyCampaignStatusActive.String(),
+				"next_send_at": nil,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.ClaimSurveyCampaignRound() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MyCareHubDb.ClaimSurveyCampaignRound() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_UpdateSurveyRedFlagRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: update survey red flag rule",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to update survey red flag rule",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to update survey red flag rule" {
+				fakeGorm.MockUpdateSurveyRedFlagRuleFn = func(ctx context.Context, rule *gorm.SurveyRedFlagRule, updateData map[string]interface{}) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			err := d.UpdateSurveyRedFlagRule(context.Background(), &domain.SurveyRedFlagRule{ID: uuid.NewString()}, map[string]interface{}{"active": false})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.UpdateSurveyRedFlagRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_UpdateCommunityCrisisKeyword(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: update community crisis keyword",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to update community crisis keyword",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Sad case: unable to update community crisis keyword" {
+				fakeGorm.MockUpdateCommunityCrisisKeywordFn = func(ctx context.Context, keyword *gorm.CommunityCrisisKeyword, updateData map[string]interface{}) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			err := d.UpdateCommunityCrisisKeyword(context.Background(), &domain.CommunityCrisisKeyword{ID: uuid.NewString()}, map[string]interface{}{"active": false})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.UpdateCommunityCrisisKeyword() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMyCareHubDb_SetUserMatrixPasswordIfUnset(t *testing.T) {
+	tests := []struct {
+		name              string
+		userID            string
+		encryptedPassword string
+		want              bool
+		wantErr           bool
+	}{
+		{
+			name:              "Happy case: save matrix password",
+			userID:            uuid.NewString(),
+			encryptedPassword: "encrypted",
+			want:              true,
+			wantErr:           false,
+		},
+		{
+			name:              "Happy case: matrix password already set",
+			userID:            uuid.NewString(),
+			encryptedPassword: "encrypted",
+			want:              false,
+			wantErr:           false,
+		},
+		{
+			name:              "Sad case: missing user ID",
+			userID:            "",
+			encryptedPassword: "encrypted",
+			wantErr:           true,
+		},
+		{
+			name:              "Sad case: unable to save matrix password",
+			userID:            uuid.NewString(),
+			encryptedPassword: "encrypted",
+			wantErr:           true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fakeGorm = gormMock.NewGormMock()
+			d := NewMyCareHubDb(fakeGorm, fakeGorm, fakeGorm, fakeGorm)
+
+			if tt.name == "Happy case: matrix password already set" {
+				fakeGorm.MockSetUserMatrixPasswordIfUnsetFn = func(ctx context.Context, userID string, encryptedPassword string) (bool, error) {
+					return false, nil
+				}
+			}
+			if tt.name == "Sad case: unable to save matrix password" {
+				fakeGorm.MockSetUserMatrixPasswordIfUnsetFn = func(ctx context.Context, userID string, encryptedPassword string) (bool, error) {
+					return false, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := d.SetUserMatrixPasswordIfUnset(context.Background(), tt.userID, tt.encryptedPassword)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyCareHubDb.SetUserMatrixPasswordIfUnset() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MyCareHubDb.SetUserMatrixPasswordIfUnset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
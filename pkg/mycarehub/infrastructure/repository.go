@@ -50,6 +50,22 @@ type Create interface {
 	CreateFacilities(ctx context.Context, facilities []*domain.Facility) ([]*domain.Facility, error)
 	CreateSecurityQuestions(ctx context.Context, securityQuestions []*domain.SecurityQuestion) ([]*domain.SecurityQuestion, error)
 	CreateTermsOfService(ctx context.Context, termsOfService *domain.TermsOfService) (*domain.TermsOfService, error)
+	CreateAuditLog(ctx context.Context, auditLog *domain.AuditLog) error
+	CreateAuthorityRole(ctx context.Context, role *domain.AuthorityRole) (*domain.AuthorityRole, error)
+	AssignStaffRole(ctx context.Context, staffID string, roleID string) error
+	CreateSMSDelivery(ctx context.Context, delivery *domain.SMSDelivery) (*domain.SMSDelivery, error)
+	CreateAppointmentReminder(ctx context.Context, reminder *domain.AppointmentReminder) error
+	SaveNotificationPreference(ctx context.Context, preference *domain.NotificationPreference) error
+	CreateAppointmentSlot(ctx context.Context, slot *domain.AppointmentSlot) (*domain.AppointmentSlot, error)
+	BookAppointmentSlot(ctx context.Context, appointment *domain.Appointment, serviceRequestInput *dto.ServiceRequestInput) (*domain.Appointment, error)
+	CreateServiceRequestComment(ctx context.Context, comment *domain.ServiceRequestComment) (*domain.ServiceRequestComment, error)
+	CreateServiceRequestEvent(ctx context.Context, event *domain.ServiceRequestEvent) error
+	SaveScreeningToolDraft(ctx context.Context, screeningToolID string, questionnaire *domain.Questionnaire) (*domain.ScreeningToolVersion, error)
+	CreateScreeningToolAssignment(ctx context.Context, assignment *domain.ScreeningToolAssignment) (*domain.ScreeningToolAssignment, error)
+	CreateHealthDiaryShare(ctx context.Context, share *domain.HealthDiaryShare) (*domain.HealthDiaryShare, error)
+	CreateSurveyCampaign(ctx context.Context, campaign *domain.SurveyCampaign) (*domain.SurveyCampaign, error)
+	CreateSurveyRedFlagRule(ctx context.Context, rule *domain.SurveyRedFlagRule) (*domain.SurveyRedFlagRule, error)
+	CreateCommunityCrisisKeyword(ctx context.Context, keyword *domain.CommunityCrisisKeyword) (*domain.CommunityCrisisKeyword, error)
 }
 
 // Delete represents all the deletion action interfaces
@@ -61,6 +77,8 @@ type Delete interface {
 	RemoveFacilitiesFromClientProfile(ctx context.Context, clientID string, facilities []string) error
 	RemoveFacilitiesFromStaffProfile(ctx context.Context, staffID string, facilities []string) error
 	DeleteOrganisation(ctx context.Context, organisation *domain.Organisation) error
+	DeleteAuthorityRole(ctx context.Context, roleID string) error
+	RevokeStaffRole(ctx context.Context, staffID string, roleID string) error
 }
 
 // Query contains all query methods
@@ -103,7 +121,7 @@ type Query interface {
 	CheckIdentifierExists(ctx context.Context, identifierType enums.UserIdentifierType, identifierValue string) (bool, error)
 	CheckFacilityExistsByIdentifier(ctx context.Context, identifier *dto.FacilityIdentifierInput) (bool, error)
 	GetClientsInAFacility(ctx context.Context, facilityID string) ([]*domain.ClientProfile, error)
-	GetRecentHealthDiaryEntries(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile) ([]*domain.ClientHealthDiaryEntry, error)
+	GetRecentHealthDiaryEntries(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile, facilityID string) ([]*domain.ClientHealthDiaryEntry, error)
 	GetClientsByParams(ctx context.Context, params gorm.Client, lastSyncTime *time.Time) ([]*domain.ClientProfile, error)
 	GetClientIdentifiers(ctx context.Context, clientID string) ([]*domain.Identifier, error)
 	GetServiceRequestsForKenyaEMR(ctx context.Context, payload *dto.ServiceRequestPayload) ([]*domain.ServiceRequest, error)
@@ -132,7 +150,7 @@ type Query interface {
 	SearchStaffServiceRequests(ctx context.Context, searchParameter string, requestType string, facilityID string) ([]*domain.ServiceRequest, error)
 	GetScreeningToolByID(ctx context.Context, screeningToolID string) (*domain.ScreeningTool, error)
 	GetAvailableScreeningTools(ctx context.Context, clientID string, screeningTool domain.ScreeningTool, screeningToolIDs []string) ([]*domain.ScreeningTool, error)
-	GetScreeningToolResponsesWithin24Hours(ctx context.Context, clientID, programID string) ([]*domain.QuestionnaireScreeningToolResponse, error)
+	GetLatestScreeningToolResponses(ctx context.Context, clientID, programID string) ([]*domain.QuestionnaireScreeningToolResponse, error)
 	GetScreeningToolResponsesWithPendingServiceRequests(ctx context.Context, clientID, programID string) ([]*domain.QuestionnaireScreeningToolResponse, error)
 	GetFacilityRespondedScreeningTools(ctx context.Context, facilityID, programID string, pagination *domain.Pagination) ([]*domain.ScreeningTool, *domain.Pagination, error)
 	ListSurveyRespondents(ctx context.Context, params *domain.UserSurvey, facilityID string, pagination *domain.Pagination) ([]*domain.SurveyRespondent, *domain.Pagination, error)
@@ -162,6 +180,44 @@ type Query interface {
 	ListCommunities(ctx context.Context, programID string, organisationID string) ([]*domain.Community, error)
 	CheckPhoneExists(ctx context.Context, phone string) (bool, error)
 	GetStaffServiceRequestByID(ctx context.Context, serviceRequestID string) (*domain.ServiceRequest, error)
+	GetUserPermissions(ctx context.Context, userID string, programID string) ([]*domain.AuthorityPermission, error)
+	ListAuditLogs(ctx context.Context, organisationID string, filter *dto.AuditLogFilterInput, pagination *domain.Pagination) ([]*domain.AuditLog, *domain.Pagination, error)
+	GetAuthorityRoleByID(ctx context.Context, roleID string) (*domain.AuthorityRole, error)
+	ListAuthorityRoles(ctx context.Context, organisationID string, programID string) ([]*domain.AuthorityRole, error)
+	ListAuthorityRoleStaff(ctx context.Context, roleID string, pagination *domain.Pagination) ([]*domain.StaffProfile, *domain.Pagination, error)
+	CheckStaffHasRole(ctx context.Context, staffID string, roleID string) (bool, error)
+	GetRecentOTPsCount(ctx context.Context, phoneNumber string, since time.Time) (int, error)
+	ListAppointmentsPendingReminder(ctx context.Context, appointmentDate time.Time, offsetDays int) ([]*domain.Appointment, error)
+	GetNotificationPreferences(ctx context.Context, userID string) ([]*domain.NotificationPreference, error)
+	ListMissedAppointments(ctx context.Context, before time.Time) ([]*domain.Appointment, error)
+	GetAppointmentSlot(ctx context.Context, slotID string) (*domain.AppointmentSlot, error)
+	ListAppointmentSlots(ctx context.Context, facilityID string) ([]*domain.AppointmentSlot, error)
+	GetAppointmentSlotBookingCounts(ctx context.Context, facilityID string, startDate, endDate time.Time) ([]*domain.AppointmentSlotBookingCount, error)
+	ListOverdueServiceRequests(ctx context.Context, requestType string, createdBefore time.Time) ([]*domain.ServiceRequest, error)
+	ListServiceRequestComments(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestComment, error)
+	ListServiceRequestEvents(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestEvent, error)
+	ListScreeningToolVersions(ctx context.Context, screeningToolID string) ([]*domain.ScreeningToolVersion, error)
+	GetScreeningToolVersion(ctx context.Context, screeningToolID string, version int) (*domain.ScreeningToolVersion, error)
+	ListScreeningToolAssignments(ctx context.Context, params *domain.ScreeningToolAssignment) ([]*domain.ScreeningToolAssignment, error)
+	ListPendingScreeningToolAssignmentsDueBefore(ctx context.Context, dueBefore time.Time) ([]*domain.ScreeningToolAssignment, error)
+	ListClientScreeningToolResponses(ctx context.Context, clientID, programID string, screeningToolID *string) ([]*domain.QuestionnaireScreeningToolResponse, error)
+	ListClientHealthDiaryEntriesSince(ctx context.Context, clientID string, since time.Time) ([]*domain.ClientHealthDiaryEntry, error)
+	ListClientIDsWithHealthDiaryEntriesSince(ctx context.Context, since time.Time) ([]string, error)
+	GetHealthDiaryShareByID(ctx context.Context, shareID string) (*domain.HealthDiaryShare, error)
+	ListClientHealthDiaryShares(ctx context.Context, clientID string) ([]*domain.HealthDiaryShare, error)
+	GetCaregiverSharedHealthDiaryEntries(ctx context.Context, clientID string, caregiverID string) ([]*domain.ClientHealthDiaryEntry, error)
+	GetSurveyCampaignByID(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error)
+	ListSurveyCampaigns(ctx context.Context, facilityID, programID string) ([]*domain.SurveyCampaign, error)
+	ListOngoingSurveyCampaigns(ctx context.Context) ([]*domain.SurveyCampaign, error)
+	ListSurveyRedFlagRules(ctx context.Context, projectID int, formID, programID string) ([]*domain.SurveyRedFlagRule, error)
+	ListAllCommunities(ctx context.Context) ([]*domain.Community, error)
+	ListProgramClients(ctx context.Context, programID string) ([]*domain.ClientProfile, error)
+	GetUserMatrixPassword(ctx context.Context, userID string) (string, error)
+	ListUsersWithoutMatrixPassword(ctx context.Context) ([]*domain.User, error)
+	ListCommunityCrisisKeywords(ctx context.Context, programID string) ([]*domain.CommunityCrisisKeyword, error)
+	GetCommunityByRoomID(ctx context.Context, roomID string) (*domain.Community, error)
+	CheckCrisisMessageFlagged(ctx context.Context, eventID string) (bool, error)
+	GetSurveyRedFlagRuleByID(ctx context.Context, ruleID string) (*domain.SurveyRedFlagRule, error)
 }
 
 // Update represents all the update action interfaces
@@ -196,4 +252,20 @@ type Update interface {
 	UpdateClientIdentifier(ctx context.Context, clientID string, identifierType string, identifierValue string, programID string) error
 	UpdateUserContact(ctx context.Context, contact *domain.Contact, updateData map[string]interface{}) error
 	UpdateProgram(ctx context.Context, program *domain.Program, updateData map[string]interface{}) error
+	UpdateAuthorityRole(ctx context.Context, roleID string, updateData map[string]interface{}, permissions []domain.AuthorityPermission) error
+	UpdateSMSDelivery(ctx context.Context, delivery *domain.SMSDelivery, updateData map[string]interface{}) error
+	MarkAppointmentMissed(ctx context.Context, appointmentID string, serviceRequestInput *dto.ServiceRequestInput) error
+	DeactivateAppointmentSlot(ctx context.Context, slotID string) error
+	CancelAppointmentBooking(ctx context.Context, appointmentID string, serviceRequestInput *dto.ServiceRequestInput) error
+	MarkServiceRequestEscalated(ctx context.Context, serviceRequestID string, escalatedAt time.Time) (bool, error)
+	PublishScreeningToolDraft(ctx context.Context, screeningToolID string) (*domain.ScreeningToolVersion, error)
+	UpdateScreeningToolAssignment(ctx context.Context, assignment *domain.ScreeningToolAssignment, updateData map[string]interface{}) error
+	CompleteScreeningToolAssignments(ctx context.Context, clientID, screeningToolID, responseID string) error
+	UpdateScreeningTool(ctx context.Context, screeningTool *domain.ScreeningTool, updateData map[string]interface{}) error
+	UpdateHealthDiaryShare(ctx context.Context, share *domain.HealthDiaryShare, updateData map[string]interface{}) error
+	UpdateSurveyCampaign(ctx context.Context, campaign *domain.SurveyCampaign, updateData map[string]interface{}) error
+	UpdateSurveyRedFlagRule(ctx context.Context, rule *domain.SurveyRedFlagRule, updateData map[string]interface{}) error
+	UpdateCommunityCrisisKeyword(ctx context.Context, keyword *domain.CommunityCrisisKeyword, updateData map[string]interface{}) error
+	SetUserMatrixPasswordIfUnset(ctx context.Context, userID string, encryptedPassword string) (bool, error)
+	ClaimSurveyCampaignRound(ctx context.Context, campaign *domain.SurveyCampaign, updateData map[string]interface{}) (bool, error)
 }
@@ -7,7 +7,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"sort"
+	"strings"
 
+	"github.com/google/uuid"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"github.com/savannahghi/serverutils"
@@ -17,12 +21,36 @@ var (
 	matrixLocalPart = serverutils.MustGetEnvVar("MATRIX_DOMAIN")
 )
 
+// The power levels given to users in a room. A user whose power level is below the room's
+// `events_default` (0 by default) can not send messages
+const (
+	// ModeratorPowerLevel is the power level of a room's moderators
+	ModeratorPowerLevel = 50
+	// MemberPowerLevel is the power level of a regular member of a room
+	MemberPowerLevel = 0
+	// MutedPowerLevel is the power level of a member who has been muted in a room
+	MutedPowerLevel = -1
+)
+
 // Matrix defines the methods to be used in making various matrix requests
 type Matrix interface {
 	CreateCommunity(ctx context.Context, auth *domain.MatrixAuth, room *dto.CommunityInput) (string, error)
 	RegisterUser(ctx context.Context, auth *domain.MatrixAuth, registrationPayload *domain.MatrixUserRegistration) (*dto.MatrixUserRegistrationOutput, error)
+	SetUserPassword(ctx context.Context, auth *domain.MatrixAuth, username string, password string, logoutDevices bool) error
 	Login(ctx context.Context, username string, password string) (*domain.CommunityProfile, error)
 	CheckIfUserIsAdmin(ctx context.Context, auth *domain.MatrixAuth, userID string) (bool, error)
+	MakeRoomAdmin(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error
+	ListRoomMembers(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]*domain.MatrixRoomMember, error)
+	ListRoomModerators(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]string, error)
+	ListJoinedRooms(ctx context.Context, auth *domain.MatrixAuth) (map[string]int, error)
+	JoinRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string) error
+	LeaveRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string) error
+	InviteUserToRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error
+	RemoveUserFromRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, reason string) error
+	SetUserPowerLevel(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, powerLevel int) error
+	BanUserFromRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, reason string) error
+	UnbanUserFromRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error
+	RedactRoomEvent(ctx context.Context, auth *domain.MatrixAuth, roomID string, eventID string, reason string) error
 }
 
 // RequestHelperPayload is the payload that is used to make requests to matrix client
@@ -270,3 +298,590 @@ func (m *ServiceImpl) CheckIfUserIsAdmin(ctx context.Context, auth *domain.Matri
 
 	return true, nil
 }
+
+// matrixUserID converts a username to its fully qualified Matrix user ID
+func matrixUserID(username string) string {
+	return fmt.Sprintf("@%s:%s", username, matrixLocalPart)
+}
+
+// MakeRoomAdmin gives a user the highest power level in a room and joins them to it.
+// It is used to let the service account manage the members of rooms created by staff
+func (m *ServiceImpl) MakeRoomAdmin(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error {
+	body := struct {
+		UserID string `json:"user_id"`
+	}{
+		UserID: matrixUserID(username),
+	}
+
+	paths := []string{
+		fmt.Sprintf("%s/_synapse/admin/v1/rooms/%s/make_room_admin", m.BaseURL, url.PathEscape(roomID)),
+		fmt.Sprintf("%s/_synapse/admin/v1/join/%s", m.BaseURL, url.PathEscape(roomID)),
+	}
+
+	for _, path := range paths {
+		requestPayload := RequestHelperPayload{
+			Method: http.MethodPost,
+			Path:   path,
+			Body:   body,
+		}
+
+		resp, err := m.MakeRequest(ctx, auth, requestPayload)
+		if err != nil {
+			return err
+		}
+
+		respBytes, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			var errResponse map[string]string
+
+			err = json.Unmarshal(respBytes, &errResponse)
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("unable to make user a room admin with status code %v. Reason: %v", resp.StatusCode, errResponse["error"])
+		}
+	}
+
+	return nil
+}
+
+// ListRoomMembers returns the membership of every user who has joined, been invited to, left or been banned from a room
+func (m *ServiceImpl) ListRoomMembers(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]*domain.MatrixRoomMember, error) {
+	membersURL := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/members", m.BaseURL, url.PathEscape(roomID))
+
+	requestPayload := RequestHelperPayload{
+		Method: http.MethodGet,
+		Path:   membersURL,
+	}
+
+	resp, err := m.MakeRequest(ctx, auth, requestPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResponse map[string]string
+
+		err = json.Unmarshal(respBytes, &errResponse)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("unable to list room members with status code %v. Reason: %v", resp.StatusCode, errResponse["error"])
+	}
+
+	data := struct {
+		Chunk []struct {
+			StateKey string `json:"state_key"`
+			Sender   string `json:"sender"`
+			Content  struct {
+				Membership string `json:"membership"`
+			} `json:"content"`
+		} `json:"chunk"`
+	}{}
+	if err := json.Unmarshal(respBytes, &data); err != nil {
+		return nil, err
+	}
+
+	members := []*domain.MatrixRoomMember{}
+	for _, event := range data.Chunk {
+		username := strings.TrimPrefix(event.StateKey, "@")
+		username = strings.TrimSuffix(username, ":"+matrixLocalPart)
+		members = append(members, &domain.MatrixRoomMember{
+			Username:    username,
+			Membership:  event.Content.Membership,
+			SelfChanged: event.Sender == event.StateKey,
+		})
+	}
+
+	return members, nil
+}
+
+// ListRoomModerators returns the usernames of the users whose power level in a room is at least the moderator power level
+func (m *ServiceImpl) ListRoomModerators(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]string, error) {
+	powerLevelsURL := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/state/m.room.power_levels", m.BaseURL, url.PathEscape(roomID))
+
+	requestPayload := RequestHelperPayload{
+		Method: http.MethodGet,
+		Path:   powerLevelsURL,
+	}
+
+	resp, err := m.MakeRequest(ctx, auth, requestPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResponse map[string]string
+
+		err = json.Unmarshal(respBytes, &errResponse)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("unable to get room power levels with status code %v. Reason: %v", resp.StatusCode, errResponse["error"])
+	}
+
+	powerLevels := struct {
+		Users map[string]int `json:"users"`
+	}{}
+	if err := json.Unmarshal(respBytes, &powerLevels); err != nil {
+		return nil, err
+	}
+
+	moderators := []string{}
+	for userID, powerLevel := range powerLevels.Users {
+		if powerLevel < ModeratorPowerLevel {
+			continue
+		}
+		username := strings.TrimPrefix(userID, "@")
+		username = strings.TrimSuffix(username, ":"+matrixLocalPart)
+		moderators = append(moderators, username)
+	}
+	sort.Strings(moderators)
+
+	return moderators, nil
+}
+
+// InviteUserToRoom invites a user to a room
+func (m *ServiceImpl) InviteUserToRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error {
+	inviteURL := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/invite", m.BaseURL, url.PathEscape(roomID))
+
+	requestPayload := RequestHelperPayload{
+		Method: http.MethodPost,
+		Path:   inviteURL,
+		Body: struct {
+			UserID string `json:"user_id"`
+		}{
+			UserID: matrixUserID(username),
+		},
+	}
+
+	resp, err := m.MakeRequest(ctx, auth, requestPayload)
+	if err != nil {
+		return err
+	}
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResponse map[string]string
+
+		err = json.Unmarshal(respBytes, &errResponse)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("unable to invite user to room with status code %v. Reason: %v", resp.StatusCode, errResponse["error"])
+	}
+
+	return nil
+}
+
+// RemoveUserFromRoom kicks a user out of a room or withdraws their pending invite
+func (m *ServiceImpl) RemoveUserFromRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, reason string) error {
+	kickURL := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/kick", m.BaseURL, url.PathEscape(roomID))
+
+	requestPayload := RequestHelperPayload{
+		Method: http.MethodPost,
+		Path:   kickURL,
+		Body: struct {
+			UserID string `json:"user_id"`
+			Reason string `json:"reason,omitempty"`
+		}{
+			UserID: matrixUserID(username),
+			Reason: reason,
+		},
+	}
+
+	resp, err := m.MakeRequest(ctx, auth, requestPayload)
+	if err != nil {
+		return err
+	}
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResponse map[string]string
+
+		err = json.Unmarshal(respBytes, &errResponse)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("unable to remove user from room with status code %v. Reason: %v", resp.StatusCode, errResponse["error"])
+	}
+
+	return nil
+}
+
+// SetUserPowerLevel changes the power level of a user in a room. The room's other power levels are left as they are
+func (m *ServiceImpl) SetUserPowerLevel(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, powerLevel int) error {
+	powerLevelsURL := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/state/m.room.power_levels", m.BaseURL, url.PathEscape(roomID))
+
+	resp, err := m.MakeRequest(ctx, auth, RequestHelperPayload{
+		Method: http.MethodGet,
+		Path:   powerLevelsURL,
+	})
+	if err != nil {
+		return err
+	}
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResponse map[string]string
+
+		err = json.Unmarshal(respBytes, &errResponse)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("unable to get room power levels with status code %v. Reason: %v", resp.StatusCode, errResponse["error"])
+	}
+
+	powerLevels := map[string]interface{}{}
+	if err := json.Unmarshal(respBytes, &powerLevels); err != nil {
+		return err
+	}
+
+	users, ok := powerLevels["users"].(map[string]interface{})
+	if !ok {
+		users = map[string]interface{}{}
+	}
+	users[matrixUserID(username)] = powerLevel
+	powerLevels["users"] = users
+
+	resp, err = m.MakeRequest(ctx, auth, RequestHelperPayload{
+		Method: http.MethodPut,
+		Path:   powerLevelsURL,
+		Body:   powerLevels,
+	})
+	if err != nil {
+		return err
+	}
+
+	respBytes, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResponse map[string]string
+
+		err = json.Unmarshal(respBytes, &errResponse)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("unable to set user power level with status code %v. Reason: %v", resp.StatusCode, errResponse["error"])
+	}
+
+	return nil
+}
+
+// BanUserFromRoom removes a user from a room and prevents them from joining it again
+func (m *ServiceImpl) BanUserFromRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, reason string) error {
+	banURL := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/ban", m.BaseURL, url.PathEscape(roomID))
+
+	requestPayload := RequestHelperPayload{
+		Method: http.MethodPost,
+		Path:   banURL,
+		Body: struct {
+			UserID string `json:"user_id"`
+			Reason string `json:"reason,omitempty"`
+		}{
+			UserID: matrixUserID(username),
+			Reason: reason,
+		},
+	}
+
+	resp, err := m.MakeRequest(ctx, auth, requestPayload)
+	if err != nil {
+		return err
+	}
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResponse map[string]string
+
+		err = json.Unmarshal(respBytes, &errResponse)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("unable to ban user from room with status code %v. Reason: %v", resp.StatusCode, errResponse["error"])
+	}
+
+	return nil
+}
+
+// UnbanUserFromRoom lifts a user's ban from a room. The user has to be invited again to rejoin the room
+func (m *ServiceImpl) UnbanUserFromRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error {
+	unbanURL := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/unban", m.BaseURL, url.PathEscape(roomID))
+
+	requestPayload := RequestHelperPayload{
+		Method: http.MethodPost,
+		Path:   unbanURL,
+		Body: struct {
+			UserID string `json:"user_id"`
+		}{
+			UserID: matrixUserID(username),
+		},
+	}
+
+	resp, err := m.MakeRequest(ctx, auth, requestPayload)
+	if err != nil {
+		return err
+	}
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResponse map[string]string
+
+		err = json.Unmarshal(respBytes, &errResponse)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("unable to unban user from room with status code %v. Reason: %v", resp.StatusCode, errResponse["error"])
+	}
+
+	return nil
+}
+
+// RedactRoomEvent strips the content of an event, such as a message, in a room
+func (m *ServiceImpl) RedactRoomEvent(ctx context.Context, auth *domain.MatrixAuth, roomID string, eventID string, reason string) error {
+	redactURL := fmt.Sprintf(
+		"%s/_matrix/client/v3/rooms/%s/redact/%s/%s",
+		m.BaseURL, url.PathEscape(roomID), url.PathEscape(eventID), uuid.NewString(),
+	)
+
+	requestPayload := RequestHelperPayload{
+		Method: http.MethodPut,
+		Path:   redactURL,
+		Body: struct {
+			Reason string `json:"reason,omitempty"`
+		}{
+			Reason: reason,
+		},
+	}
+
+	resp, err := m.MakeRequest(ctx, auth, requestPayload)
+	if err != nil {
+		return err
+	}
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResponse map[string]string
+
+		err = json.Unmarshal(respBytes, &errResponse)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("unable to redact room event with status code %v. Reason: %v", resp.StatusCode, errResponse["error"])
+	}
+
+	return nil
+}
+
+// SetUserPassword changes the password of a user in our Matrix homeserver. The supplied credentials must belong to a server admin.
+// When logoutDevices is set the user's existing sessions are ended so that the access tokens already handed out stop working
+func (m *ServiceImpl) SetUserPassword(ctx context.Context, auth *domain.MatrixAuth, username string, password string, logoutDevices bool) error {
+	resetPasswordURL := fmt.Sprintf("%s/_synapse/admin/v1/reset_password/%s", m.BaseURL, url.PathEscape(matrixUserID(username)))
+
+	requestPayload := RequestHelperPayload{
+		Method: http.MethodPost,
+		Path:   resetPasswordURL,
+		Body: struct {
+			NewPassword   string `json:"new_password"`
+			LogoutDevices bool   `json:"logout_devices"`
+		}{
+			NewPassword:   password,
+			LogoutDevices: logoutDevices,
+		},
+	}
+
+	resp, err := m.MakeRequest(ctx, auth, requestPayload)
+	if err != nil {
+		return err
+	}
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResponse map[string]string
+
+		err = json.Unmarshal(respBytes, &errResponse)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("unable to set user password with status code %v. Reason: %v", resp.StatusCode, errResponse["error"])
+	}
+
+	return nil
+}
+
+// joinedRoomsFilter limits a sync to the joined rooms' unread notification counts
+const joinedRoomsFilter = `{"presence":{"not_types":["*"]},"account_data":{"not_types":["*"]},"room":{"timeline":{"limit":1},"state":{"lazy_load_members":true},"ephemeral":{"not_types":["*"]},"account_data":{"not_types":["*"]}}}`
+
+// ListJoinedRooms returns the rooms a user has joined mapped to the number of unread notifications the user has in each room
+func (m *ServiceImpl) ListJoinedRooms(ctx context.Context, auth *domain.MatrixAuth) (map[string]int, error) {
+	syncURL := fmt.Sprintf("%s/_matrix/client/v3/sync?timeout=0&filter=%s", m.BaseURL, url.QueryEscape(joinedRoomsFilter))
+
+	requestPayload := RequestHelperPayload{
+		Method: http.MethodGet,
+		Path:   syncURL,
+	}
+
+	resp, err := m.MakeRequest(ctx, auth, requestPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResponse map[string]string
+
+		err = json.Unmarshal(respBytes, &errResponse)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("unable to list joined rooms with status code %v. Reason: %v", resp.StatusCode, errResponse["error"])
+	}
+
+	data := struct {
+		Rooms struct {
+			Join map[string]struct {
+				UnreadNotifications struct {
+					NotificationCount int `json:"notification_count"`
+				} `json:"unread_notifications"`
+			} `json:"join"`
+		} `json:"rooms"`
+	}{}
+	if err := json.Unmarshal(respBytes, &data); err != nil {
+		return nil, err
+	}
+
+	rooms := map[string]int{}
+	for roomID, room := range data.Rooms.Join {
+		rooms[roomID] = room.UnreadNotifications.NotificationCount
+	}
+
+	return rooms, nil
+}
+
+// JoinRoom makes a user join a room. The user must have been invited to the room unless the room is public
+func (m *ServiceImpl) JoinRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string) error {
+	joinURL := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/join", m.BaseURL, url.PathEscape(roomID))
+
+	requestPayload := RequestHelperPayload{
+		Method: http.MethodPost,
+		Path:   joinURL,
+		Body:   struct{}{},
+	}
+
+	resp, err := m.MakeRequest(ctx, auth, requestPayload)
+	if err != nil {
+		return err
+	}
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResponse map[string]string
+
+		err = json.Unmarshal(respBytes, &errResponse)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("unable to join room with status code %v. Reason: %v", resp.StatusCode, errResponse["error"])
+	}
+
+	return nil
+}
+
+// LeaveRoom makes a user leave a room, or decline an invite to it
+func (m *ServiceImpl) LeaveRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string) error {
+	leaveURL := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/leave", m.BaseURL, url.PathEscape(roomID))
+
+	requestPayload := RequestHelperPayload{
+		Method: http.MethodPost,
+		Path:   leaveURL,
+		Body:   struct{}{},
+	}
+
+	resp, err := m.MakeRequest(ctx, auth, requestPayload)
+	if err != nil {
+		return err
+	}
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResponse map[string]string
+
+		err = json.Unmarshal(respBytes, &errResponse)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("unable to leave room with status code %v. Reason: %v", resp.StatusCode, errResponse["error"])
+	}
+
+	return nil
+}
@@ -2,8 +2,12 @@ package matrix_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/brianvoe/gofakeit"
@@ -414,3 +418,712 @@ func TestServiceImpl_CheckIfUserIsAdmin(t *testing.T) {
 		})
 	}
 }
+
+func registerLoginResponder() {
+	httpmock.RegisterResponder(http.MethodPost, "/_matrix/client/v3/login",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"user_id":      "@test:prohealth360.org",
+				"access_token": "syt_test",
+			})
+		},
+	)
+}
+
+func TestServiceImpl_MakeRoomAdmin(t *testing.T) {
+	auth := &domain.MatrixAuth{
+		Username: gofakeit.Name(),
+		Password: gofakeit.BeerName(),
+	}
+
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "happy case: make a user a room admin",
+			wantErr: false,
+		},
+		{
+			name:    "sad case: unable to make a user a room admin",
+			wantErr: true,
+		},
+		{
+			name:    "sad case: unable to join the user to the room",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			m := matrix.NewMatrixImpl("https://example.com")
+			registerLoginResponder()
+
+			adminStatus, joinStatus := 200, 200
+			if tt.name == "sad case: unable to make a user a room admin" {
+				adminStatus = 403
+			}
+			if tt.name == "sad case: unable to join the user to the room" {
+				joinStatus = 404
+			}
+
+			httpmock.RegisterResponder(http.MethodPost, "/_synapse/admin/v1/rooms/room1/make_room_admin",
+				func(req *http.Request) (*http.Response, error) {
+					return httpmock.NewJsonResponse(adminStatus, map[string]string{"error": "forbidden"})
+				},
+			)
+			httpmock.RegisterResponder(http.MethodPost, "/_synapse/admin/v1/join/room1",
+				func(req *http.Request) (*http.Response, error) {
+					return httpmock.NewJsonResponse(joinStatus, map[string]string{"error": "not found"})
+				},
+			)
+
+			err := m.MakeRoomAdmin(context.Background(), auth, "room1", "test")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceImpl.MakeRoomAdmin() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestServiceImpl_ListRoomMembers(t *testing.T) {
+	auth := &domain.MatrixAuth{
+		Username: gofakeit.Name(),
+		Password: gofakeit.BeerName(),
+	}
+
+	tests := []struct {
+		name    string
+		want    []*domain.MatrixRoomMember
+		wantErr bool
+	}{
+		{
+			name: "happy case: list room members",
+			want: []*domain.MatrixRoomMember{
+				{Username: "joined", Membership: "join", SelfChanged: true},
+				{Username: "invited", Membership: "invite", SelfChanged: false},
+				{Username: "left", Membership: "leave", SelfChanged: true},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "sad case: unable to list room members",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			m := matrix.NewMatrixImpl("https://example.com")
+			registerLoginResponder()
+
+			if tt.name == "happy case: list room members" {
+				matrixDomain := os.Getenv("MATRIX_DOMAIN")
+				httpmock.RegisterResponder(http.MethodGet, "/_matrix/client/v3/rooms/room1/members",
+					func(req *http.Request) (*http.Response, error) {
+						return httpmock.NewJsonResponse(200, map[string]interface{}{
+							"chunk": []map[string]interface{}{
+								{"state_key": "@joined:" + matrixDomain, "sender": "@joined:" + matrixDomain, "content": map[string]string{"membership": "join"}},
+								{"state_key": "@invited:" + matrixDomain, "sender": "@admin:" + matrixDomain, "content": map[string]string{"membership": "invite"}},
+								{"state_key": "@left:" + matrixDomain, "sender": "@left:" + matrixDomain, "content": map[string]string{"membership": "leave"}},
+							},
+						})
+					},
+				)
+			}
+			if tt.name == "sad case: unable to list room members" {
+				httpmock.RegisterResponder(http.MethodGet, "/_matrix/client/v3/rooms/room1/members",
+					func(req *http.Request) (*http.Response, error) {
+						return httpmock.NewJsonResponse(403, map[string]string{"error": "forbidden"})
+					},
+				)
+			}
+
+			got, err := m.ListRoomMembers(context.Background(), auth, "room1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceImpl.ListRoomMembers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ServiceImpl.ListRoomMembers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServiceImpl_ListRoomModerators(t *testing.T) {
+	auth := &domain.MatrixAuth{
+		Username: gofakeit.Name(),
+		Password: gofakeit.BeerName(),
+	}
+
+	tests := []struct {
+		name    string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "happy case: list room moderators",
+			want:    []string{"admin", "moderator"},
+			wantErr: false,
+		},
+		{
+			name:    "sad case: unable to list room moderators",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			m := matrix.NewMatrixImpl("https://example.com")
+			registerLoginResponder()
+
+			if tt.name == "happy case: list room moderators" {
+				matrixDomain := os.Getenv("MATRIX_DOMAIN")
+				httpmock.RegisterResponder(http.MethodGet, "/_matrix/client/v3/rooms/room1/state/m.room.power_levels",
+					func(req *http.Request) (*http.Response, error) {
+						return httpmock.NewJsonResponse(200, map[string]interface{}{
+							"users": map[string]int{
+								"@admin:" + matrixDomain:     100,
+								"@moderator:" + matrixDomain: 50,
+								"@member:" + matrixDomain:    0,
+								"@muted:" + matrixDomain:     -1,
+							},
+						})
+					},
+				)
+			}
+			if tt.name == "sad case: unable to list room moderators" {
+				httpmock.RegisterResponder(http.MethodGet, "/_matrix/client/v3/rooms/room1/state/m.room.power_levels",
+					func(req *http.Request) (*http.Response, error) {
+						return httpmock.NewJsonResponse(403, map[string]string{"error": "forbidden"})
+					},
+				)
+			}
+
+			got, err := m.ListRoomModerators(context.Background(), auth, "room1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceImpl.ListRoomModerators() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ServiceImpl.ListRoomModerators() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServiceImpl_InviteUserToRoom(t *testing.T) {
+	auth := &domain.MatrixAuth{
+		Username: gofakeit.Name(),
+		Password: gofakeit.BeerName(),
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:    "happy case: invite a user to a room",
+			status:  200,
+			wantErr: false,
+		},
+		{
+			name:    "sad case: unable to invite a user to a room",
+			status:  403,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			m := matrix.NewMatrixImpl("https://example.com")
+			registerLoginResponder()
+
+			httpmock.RegisterResponder(http.MethodPost, "/_matrix/client/v3/rooms/room1/invite",
+				func(req *http.Request) (*http.Response, error) {
+					return httpmock.NewJsonResponse(tt.status, map[string]string{"error": "forbidden"})
+				},
+			)
+
+			err := m.InviteUserToRoom(context.Background(), auth, "room1", "test")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceImpl.InviteUserToRoom() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestServiceImpl_RemoveUserFromRoom(t *testing.T) {
+	auth := &domain.MatrixAuth{
+		Username: gofakeit.Name(),
+		Password: gofakeit.BeerName(),
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:    "happy case: remove a user from a room",
+			status:  200,
+			wantErr: false,
+		},
+		{
+			name:    "sad case: unable to remove a user from a room",
+			status:  403,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			m := matrix.NewMatrixImpl("https://example.com")
+			registerLoginResponder()
+
+			httpmock.RegisterResponder(http.MethodPost, "/_matrix/client/v3/rooms/room1/kick",
+				func(req *http.Request) (*http.Response, error) {
+					return httpmock.NewJsonResponse(tt.status, map[string]string{"error": "forbidden"})
+				},
+			)
+
+			err := m.RemoveUserFromRoom(context.Background(), auth, "room1", "test", "no longer eligible")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceImpl.RemoveUserFromRoom() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestServiceImpl_SetUserPowerLevel(t *testing.T) {
+	auth := &domain.MatrixAuth{
+		Username: gofakeit.Name(),
+		Password: gofakeit.BeerName(),
+	}
+
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "happy case: set a user's power level",
+			wantErr: false,
+		},
+		{
+			name:    "sad case: unable to get the room's power levels",
+			wantErr: true,
+		},
+		{
+			name:    "sad case: unable to update the room's power levels",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			m := matrix.NewMatrixImpl("https://example.com")
+			registerLoginResponder()
+
+			getStatus, putStatus := 200, 200
+			if tt.name == "sad case: unable to get the room's power levels" {
+				getStatus = 403
+			}
+			if tt.name == "sad case: unable to update the room's power levels" {
+				putStatus = 403
+			}
+
+			var updated map[string]interface{}
+			httpmock.RegisterResponder(http.MethodGet, "/_matrix/client/v3/rooms/room1/state/m.room.power_levels",
+				func(req *http.Request) (*http.Response, error) {
+					if getStatus != 200 {
+						return httpmock.NewJsonResponse(getStatus, map[string]string{"error": "forbidden"})
+					}
+					return httpmock.NewJsonResponse(200, map[string]interface{}{
+						"events_default": 0,
+						"users":          map[string]interface{}{"@admin:example.com": 100},
+					})
+				},
+			)
+			httpmock.RegisterResponder(http.MethodPut, "/_matrix/client/v3/rooms/room1/state/m.room.power_levels",
+				func(req *http.Request) (*http.Response, error) {
+					if err := json.NewDecoder(req.Body).Decode(&updated); err != nil {
+						return nil, err
+					}
+					return httpmock.NewJsonResponse(putStatus, map[string]string{"error": "forbidden"})
+				},
+			)
+
+			err := m.SetUserPowerLevel(context.Background(), auth, "room1", "test", matrix.ModeratorPowerLevel)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceImpl.SetUserPowerLevel() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				users, _ := updated["users"].(map[string]interface{})
+				if len(users) != 2 || updated["events_default"] == nil {
+					t.Errorf("expected the other power levels to be kept, got %v", updated)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceImpl_BanUserFromRoom(t *testing.T) {
+	auth := &domain.MatrixAuth{
+		Username: gofakeit.Name(),
+		Password: gofakeit.BeerName(),
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:    "happy case: ban a user from a room",
+			status:  200,
+			wantErr: false,
+		},
+		{
+			name:    "sad case: unable to ban a user from a room",
+			status:  403,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			m := matrix.NewMatrixImpl("https://example.com")
+			registerLoginResponder()
+
+			httpmock.RegisterResponder(http.MethodPost, "/_matrix/client/v3/rooms/room1/ban",
+				func(req *http.Request) (*http.Response, error) {
+					return httpmock.NewJsonResponse(tt.status, map[string]string{"error": "forbidden"})
+				},
+			)
+
+			err := m.BanUserFromRoom(context.Background(), auth, "room1", "test", "spam")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceImpl.BanUserFromRoom() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestServiceImpl_UnbanUserFromRoom(t *testing.T) {
+	auth := &domain.MatrixAuth{
+		Username: gofakeit.Name(),
+		Password: gofakeit.BeerName(),
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:    "happy case: unban a user from a room",
+			status:  200,
+			wantErr: false,
+		},
+		{
+			name:    "sad case: unable to unban a user from a room",
+			status:  403,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			m := matrix.NewMatrixImpl("https://example.com")
+			registerLoginResponder()
+
+			httpmock.RegisterResponder(http.MethodPost, "/_matrix/client/v3/rooms/room1/unban",
+				func(req *http.Request) (*http.Response, error) {
+					return httpmock.NewJsonResponse(tt.status, map[string]string{"error": "forbidden"})
+				},
+			)
+
+			err := m.UnbanUserFromRoom(context.Background(), auth, "room1", "test")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceImpl.UnbanUserFromRoom() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestServiceImpl_RedactRoomEvent(t *testing.T) {
+	auth := &domain.MatrixAuth{
+		Username: gofakeit.Name(),
+		Password: gofakeit.BeerName(),
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:    "happy case: redact a room event",
+			status:  200,
+			wantErr: false,
+		},
+		{
+			name:    "sad case: unable to redact a room event",
+			status:  403,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			m := matrix.NewMatrixImpl("https://example.com")
+			registerLoginResponder()
+
+			httpmock.RegisterRegexpResponder(http.MethodPut, regexp.MustCompile(`/_matrix/client/v3/rooms/room1/redact/event1/.+`),
+				func(req *http.Request) (*http.Response, error) {
+					return httpmock.NewJsonResponse(tt.status, map[string]string{"error": "forbidden"})
+				},
+			)
+
+			err := m.RedactRoomEvent(context.Background(), auth, "room1", "event1", "abusive")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceImpl.RedactRoomEvent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestServiceImpl_SetUserPassword(t *testing.T) {
+	auth := &domain.MatrixAuth{
+		Username: gofakeit.Name(),
+		Password: gofakeit.BeerName(),
+	}
+
+	tests := []struct {
+		name          string
+		status        int
+		logoutDevices bool
+		wantErr       bool
+	}{
+		{
+			name:          "happy case: set a user's password",
+			status:        200,
+			logoutDevices: false,
+			wantErr:       false,
+		},
+		{
+			name:          "happy case: set a user's password and log out their devices",
+			status:        200,
+			logoutDevices: true,
+			wantErr:       false,
+		},
+		{
+			name:          "sad case: unable to set a user's password",
+			status:        403,
+			logoutDevices: true,
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			m := matrix.NewMatrixImpl("https://example.com")
+			registerLoginResponder()
+
+			httpmock.RegisterRegexpResponder(http.MethodPost, regexp.MustCompile(`/_synapse/admin/v1/reset_password/.+`),
+				func(req *http.Request) (*http.Response, error) {
+					var body map[string]interface{}
+					if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+						return nil, err
+					}
+					if body["new_password"] != "secret" || body["logout_devices"] != tt.logoutDevices {
+						return httpmock.NewJsonResponse(400, map[string]string{"error": "unexpected body"})
+					}
+					return httpmock.NewJsonResponse(tt.status, map[string]string{"error": "forbidden"})
+				},
+			)
+
+			err := m.SetUserPassword(context.Background(), auth, "test", "secret", tt.logoutDevices)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceImpl.SetUserPassword() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestServiceImpl_ListJoinedRooms(t *testing.T) {
+	auth := &domain.MatrixAuth{
+		Username: gofakeit.Name(),
+		Password: gofakeit.BeerName(),
+	}
+
+	tests := []struct {
+		name    string
+		want    map[string]int
+		wantErr bool
+	}{
+		{
+			name:    "happy case: list joined rooms",
+			want:    map[string]int{"room1": 3, "room2": 0},
+			wantErr: false,
+		},
+		{
+			name:    "sad case: unable to list joined rooms",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			m := matrix.NewMatrixImpl("https://example.com")
+			registerLoginResponder()
+
+			httpmock.RegisterResponder(http.MethodGet, "/_matrix/client/v3/sync",
+				func(req *http.Request) (*http.Response, error) {
+					if tt.wantErr {
+						return httpmock.NewJsonResponse(403, map[string]string{"error": "forbidden"})
+					}
+					return httpmock.NewJsonResponse(200, map[string]interface{}{
+						"rooms": map[string]interface{}{
+							"join": map[string]interface{}{
+								"room1": map[string]interface{}{"unread_notifications": map[string]int{"notification_count": 3}},
+								"room2": map[string]interface{}{},
+							},
+						},
+					})
+				},
+			)
+
+			got, err := m.ListJoinedRooms(context.Background(), auth)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceImpl.ListJoinedRooms() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ServiceImpl.ListJoinedRooms() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServiceImpl_JoinRoom(t *testing.T) {
+	auth := &domain.MatrixAuth{
+		Username: gofakeit.Name(),
+		Password: gofakeit.BeerName(),
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:    "happy case: join a room",
+			status:  200,
+			wantErr: false,
+		},
+		{
+			name:    "sad case: unable to join a room",
+			status:  403,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			m := matrix.NewMatrixImpl("https://example.com")
+			registerLoginResponder()
+
+			httpmock.RegisterResponder(http.MethodPost, "/_matrix/client/v3/rooms/room1/join",
+				func(req *http.Request) (*http.Response, error) {
+					return httpmock.NewJsonResponse(tt.status, map[string]string{"error": "forbidden"})
+				},
+			)
+
+			err := m.JoinRoom(context.Background(), auth, "room1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceImpl.JoinRoom() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestServiceImpl_LeaveRoom(t *testing.T) {
+	auth := &domain.MatrixAuth{
+		Username: gofakeit.Name(),
+		Password: gofakeit.BeerName(),
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:    "happy case: leave a room",
+			status:  200,
+			wantErr: false,
+		},
+		{
+			name:    "sad case: unable to leave a room",
+			status:  403,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			m := matrix.NewMatrixImpl("https://example.com")
+			registerLoginResponder()
+
+			httpmock.RegisterResponder(http.MethodPost, "/_matrix/client/v3/rooms/room1/leave",
+				func(req *http.Request) (*http.Response, error) {
+					return httpmock.NewJsonResponse(tt.status, map[string]string{"error": "forbidden"})
+				},
+			)
+
+			err := m.LeaveRoom(context.Background(), auth, "room1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceImpl.LeaveRoom() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
@@ -15,8 +15,21 @@ type MatrixMock struct {
 	MockMakeRequestFn        func(ctx context.Context, payload matrix.RequestHelperPayload) (*http.Response, error)
 	MockCreateCommunity      func(ctx context.Context, auth *domain.MatrixAuth, room *dto.CommunityInput) (string, error)
 	MockRegisterUserFn       func(ctx context.Context, auth *domain.MatrixAuth, registrationPayload *domain.MatrixUserRegistration) (*dto.MatrixUserRegistrationOutput, error)
+	MockSetUserPasswordFn    func(ctx context.Context, auth *domain.MatrixAuth, username string, password string, logoutDevices bool) error
 	MockLoginFn              func(ctx context.Context, username string, password string) (*domain.CommunityProfile, error)
 	MockCheckIfUserIsAdminFn func(ctx context.Context, auth *domain.MatrixAuth, userID string) (bool, error)
+	MockMakeRoomAdminFn      func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error
+	MockListRoomMembersFn    func(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]*domain.MatrixRoomMember, error)
+	MockListRoomModeratorsFn func(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]string, error)
+	MockListJoinedRoomsFn    func(ctx context.Context, auth *domain.MatrixAuth) (map[string]int, error)
+	MockJoinRoomFn           func(ctx context.Context, auth *domain.MatrixAuth, roomID string) error
+	MockLeaveRoomFn          func(ctx context.Context, auth *domain.MatrixAuth, roomID string) error
+	MockInviteUserToRoomFn   func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error
+	MockRemoveUserFromRoomFn func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, reason string) error
+	MockSetUserPowerLevelFn  func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, powerLevel int) error
+	MockBanUserFromRoomFn    func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, reason string) error
+	MockUnbanUserFromRoomFn  func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error
+	MockRedactRoomEventFn    func(ctx context.Context, auth *domain.MatrixAuth, roomID string, eventID string, reason string) error
 }
 
 // NewSurveysMock initializes the surveys mock service
@@ -36,6 +49,9 @@ func NewMatrixMock() *MatrixMock {
 				UserID: gofakeit.BeerName(),
 			}, nil
 		},
+		MockSetUserPasswordFn: func(ctx context.Context, auth *domain.MatrixAuth, username string, password string, logoutDevices bool) error {
+			return nil
+		},
 		MockLoginFn: func(ctx context.Context, username, password string) (*domain.CommunityProfile, error) {
 			return &domain.CommunityProfile{
 				UserID:      "@test:prohealth360.org",
@@ -52,6 +68,49 @@ func NewMatrixMock() *MatrixMock {
 		MockCheckIfUserIsAdminFn: func(ctx context.Context, auth *domain.MatrixAuth, userID string) (bool, error) {
 			return true, nil
 		},
+		MockMakeRoomAdminFn: func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error {
+			return nil
+		},
+		MockListRoomMembersFn: func(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]*domain.MatrixRoomMember, error) {
+			return []*domain.MatrixRoomMember{
+				{
+					Username:   gofakeit.Username(),
+					Membership: "join",
+				},
+			}, nil
+		},
+		MockListRoomModeratorsFn: func(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]string, error) {
+			return []string{gofakeit.Username()}, nil
+		},
+		MockListJoinedRoomsFn: func(ctx context.Context, auth *domain.MatrixAuth) (map[string]int, error) {
+			return map[string]int{
+				gofakeit.UUID(): 1,
+			}, nil
+		},
+		MockJoinRoomFn: func(ctx context.Context, auth *domain.MatrixAuth, roomID string) error {
+			return nil
+		},
+		MockLeaveRoomFn: func(ctx context.Context, auth *domain.MatrixAuth, roomID string) error {
+			return nil
+		},
+		MockInviteUserToRoomFn: func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error {
+			return nil
+		},
+		MockRemoveUserFromRoomFn: func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, reason string) error {
+			return nil
+		},
+		MockSetUserPowerLevelFn: func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, powerLevel int) error {
+			return nil
+		},
+		MockBanUserFromRoomFn: func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, reason string) error {
+			return nil
+		},
+		MockUnbanUserFromRoomFn: func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error {
+			return nil
+		},
+		MockRedactRoomEventFn: func(ctx context.Context, auth *domain.MatrixAuth, roomID string, eventID string, reason string) error {
+			return nil
+		},
 	}
 }
 
@@ -70,6 +129,11 @@ func (m *MatrixMock) RegisterUser(ctx context.Context, auth *domain.MatrixAuth,
 	return m.MockRegisterUserFn(ctx, auth, registrationPayload)
 }
 
+// SetUserPassword mocks the implementation of changing a Matrix user's password
+func (m *MatrixMock) SetUserPassword(ctx context.Context, auth *domain.MatrixAuth, username string, password string, logoutDevices bool) error {
+	return m.MockSetUserPasswordFn(ctx, auth, username, password, logoutDevices)
+}
+
 // Login mocks authentication if a matrix user
 func (m *MatrixMock) Login(ctx context.Context, username, password string) (*domain.CommunityProfile, error) {
 	return m.MockLoginFn(ctx, username, password)
@@ -79,3 +143,63 @@ func (m *MatrixMock) Login(ctx context.Context, username, password string) (*dom
 func (m *MatrixMock) CheckIfUserIsAdmin(ctx context.Context, auth *domain.MatrixAuth, userID string) (bool, error) {
 	return m.MockCheckIfUserIsAdminFn(ctx, auth, userID)
 }
+
+// MakeRoomAdmin mocks making a user the admin of a room
+func (m *MatrixMock) MakeRoomAdmin(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error {
+	return m.MockMakeRoomAdminFn(ctx, auth, roomID, username)
+}
+
+// ListRoomMembers mocks listing the members of a room
+func (m *MatrixMock) ListRoomMembers(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]*domain.MatrixRoomMember, error) {
+	return m.MockListRoomMembersFn(ctx, auth, roomID)
+}
+
+// ListRoomModerators mocks listing the moderators of a room
+func (m *MatrixMock) ListRoomModerators(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]string, error) {
+	return m.MockListRoomModeratorsFn(ctx, auth, roomID)
+}
+
+// ListJoinedRooms mocks the implementation of listing the rooms a user has joined and their unread notification counts
+func (m *MatrixMock) ListJoinedRooms(ctx context.Context, auth *domain.MatrixAuth) (map[string]int, error) {
+	return m.MockListJoinedRoomsFn(ctx, auth)
+}
+
+// JoinRoom mocks the implementation of a user joining a room
+func (m *MatrixMock) JoinRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string) error {
+	return m.MockJoinRoomFn(ctx, auth, roomID)
+}
+
+// LeaveRoom mocks the implementation of a user leaving a room
+func (m *MatrixMock) LeaveRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string) error {
+	return m.MockLeaveRoomFn(ctx, auth, roomID)
+}
+
+// InviteUserToRoom mocks inviting a user to a room
+func (m *MatrixMock) InviteUserToRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error {
+	return m.MockInviteUserToRoomFn(ctx, auth, roomID, username)
+}
+
+// RemoveUserFromRoom mocks removing a user from a room
+func (m *MatrixMock) RemoveUserFromRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, reason string) error {
+	return m.MockRemoveUserFromRoomFn(ctx, auth, roomID, username, reason)
+}
+
+// SetUserPowerLevel mocks changing the power level of a user in a room
+func (m *MatrixMock) SetUserPowerLevel(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, powerLevel int) error {
+	return m.MockSetUserPowerLevelFn(ctx, auth, roomID, username, powerLevel)
+}
+
+// BanUserFromRoom mocks banning a user from a room
+func (m *MatrixMock) BanUserFromRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, reason string) error {
+	return m.MockBanUserFromRoomFn(ctx, auth, roomID, username, reason)
+}
+
+// UnbanUserFromRoom mocks lifting a user's ban from a room
+func (m *MatrixMock) UnbanUserFromRoom(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error {
+	return m.MockUnbanUserFromRoomFn(ctx, auth, roomID, username)
+}
+
+// RedactRoomEvent mocks redacting an event in a room
+func (m *MatrixMock) RedactRoomEvent(ctx context.Context, auth *domain.MatrixAuth, roomID string, eventID string, reason string) error {
+	return m.MockRedactRoomEventFn(ctx, auth, roomID, eventID, reason)
+}
@@ -0,0 +1,176 @@
+package smsgateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/savannahghi/converterandformatter"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure"
+)
+
+// SMSGateway sends SMS messages through an ordered chain of providers and records the delivery status of each message
+type SMSGateway interface {
+	SendSMS(ctx context.Context, phoneNumber string, message string) (*domain.SMSDelivery, error)
+}
+
+// SMSProvider is implemented by the services that are able to deliver an SMS message e.g SILComms and Twilio
+type SMSProvider interface {
+	// Name returns the name of the provider that is recorded against a delivery
+	Name() string
+	// Send delivers a message to a phone number and returns the provider's reference of the message, if any
+	Send(ctx context.Context, phoneNumber string, message string) (string, error)
+}
+
+// ServiceImpl is the implementation of the SMS gateway. Messages are sent through the provider chain
+// registered for the country code of the recipient, falling back to the next provider when a provider
+// returns an error or does not respond within the configured timeout
+type ServiceImpl struct {
+	Create infrastructure.Create
+	Update infrastructure.Update
+
+	defaultChain []SMSProvider
+	chains       map[string][]SMSProvider
+	timeout      time.Duration
+}
+
+// NewSMSGateway initializes the SMS gateway. The default chain is used for recipients whose country code
+// does not have a registered provider chain
+func NewSMSGateway(
+	create infrastructure.Create,
+	update infrastructure.Update,
+	timeout time.Duration,
+	defaultChain ...SMSProvider,
+) *ServiceImpl {
+	return &ServiceImpl{
+		Create:       create,
+		Update:       update,
+		defaultChain: defaultChain,
+		chains:       map[string][]SMSProvider{},
+		timeout:      timeout,
+	}
+}
+
+// RegisterChain sets the ordered list of providers to be used for recipients with the given country code e.g +254
+func (g *ServiceImpl) RegisterChain(countryCode string, providers ...SMSProvider) {
+	g.chains[countryCode] = providers
+}
+
+// providerChain returns the providers registered for the longest country code matching the phone number
+func (g *ServiceImpl) providerChain(phoneNumber string) []SMSProvider {
+	countryCodes := make([]string, 0, len(g.chains))
+	for countryCode := range g.chains {
+		countryCodes = append(countryCodes, countryCode)
+	}
+	sort.Slice(countryCodes, func(i, j int) bool {
+		return len(countryCodes[i]) > len(countryCodes[j])
+	})
+
+	for _, countryCode := range countryCodes {
+		if strings.HasPrefix(phoneNumber, countryCode) {
+			return g.chains[countryCode]
+		}
+	}
+
+	return g.defaultChain
+}
+
+// SendSMS sends a message to the phone number through the provider chain for the phone number's country code.
+// An error is returned only when every provider in the chain fails to send the message
+func (g *ServiceImpl) SendSMS(ctx context.Context, phoneNumber string, message string) (*domain.SMSDelivery, error) {
+	if normalized, err := converterandformatter.NormalizeMSISDN(phoneNumber); err == nil {
+		phoneNumber = *normalized
+	}
+
+	providers := g.providerChain(phoneNumber)
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("no sms provider configured for %s", phoneNumber)
+	}
+
+	delivery, err := g.Create.CreateSMSDelivery(ctx, &domain.SMSDelivery{
+		PhoneNumber: phoneNumber,
+		Status:      enums.SMSDeliveryStatusPending,
+	})
+	if err != nil {
+		// failing to track a delivery should not prevent the message from being sent
+		helpers.ReportErrorToSentry(err)
+		delivery = &domain.SMSDelivery{
+			PhoneNumber: phoneNumber,
+			Status:      enums.SMSDeliveryStatusPending,
+		}
+	}
+
+	var failures []string
+	for _, provider := range providers {
+		delivery.Attempts++
+		delivery.Provider = provider.Name()
+
+		reference, err := g.send(ctx, provider, phoneNumber, message)
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			failures = append(failures, fmt.Sprintf("%s: %v", provider.Name(), err))
+			continue
+		}
+
+		delivery.ProviderMessageID = reference
+		delivery.Status = enums.SMSDeliveryStatusSent
+		delivery.FailureReason = strings.Join(failures, "; ")
+		g.recordDelivery(ctx, delivery)
+
+		return delivery, nil
+	}
+
+	delivery.Status = enums.SMSDeliveryStatusFailed
+	delivery.FailureReason = strings.Join(failures, "; ")
+	g.recordDelivery(ctx, delivery)
+
+	return delivery, fmt.Errorf("failed to send sms via all providers: %s", delivery.FailureReason)
+}
+
+// send delivers the message using a single provider, giving up once the provider timeout elapses
+func (g *ServiceImpl) send(ctx context.Context, provider SMSProvider, phoneNumber string, message string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	type result struct {
+		reference string
+		err       error
+	}
+
+	// buffered so that a provider that returns after the timeout does not block forever
+	results := make(chan result, 1)
+	go func() {
+		reference, err := provider.Send(ctx, phoneNumber, message)
+		results <- result{reference: reference, err: err}
+	}()
+
+	select {
+	case res := <-results:
+		return res.reference, res.err
+	case <-ctx.Done():
+		return "", fmt.Errorf("timed out after %v: %w", g.timeout, ctx.Err())
+	}
+}
+
+// recordDelivery persists the outcome of a delivery. Deliveries that could not be created are not recorded
+func (g *ServiceImpl) recordDelivery(ctx context.Context, delivery *domain.SMSDelivery) {
+	if delivery.ID == "" {
+		return
+	}
+
+	err := g.Update.UpdateSMSDelivery(ctx, delivery, map[string]interface{}{
+		"provider":            delivery.Provider,
+		"provider_message_id": delivery.ProviderMessageID,
+		"status":              delivery.Status.String(),
+		"attempts":            delivery.Attempts,
+		"failure_reason":      delivery.FailureReason,
+	})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+	}
+}
@@ -0,0 +1,202 @@
+package smsgateway_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+	pgMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/mock"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/smsgateway"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/smsgateway/mock"
+)
+
+func TestServiceImpl_SendSMS(t *testing.T) {
+	kenyanNumber := "+254711223344"
+	foreignNumber := "+12025550123"
+
+	type args struct {
+		ctx         context.Context
+		phoneNumber string
+		message     string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantProvider string
+		wantAttempts int
+		wantStatus   enums.SMSDeliveryStatus
+		wantErr      bool
+	}{
+		{
+			name: "Happy case: send via the first provider in the country chain",
+			args: args{
+				ctx:         context.Background(),
+				phoneNumber: kenyanNumber,
+				message:     "hello",
+			},
+			wantProvider: "primary",
+			wantAttempts: 1,
+			wantStatus:   enums.SMSDeliveryStatusSent,
+		},
+		{
+			name: "Happy case: send local number via the country chain",
+			args: args{
+				ctx:         context.Background(),
+				phoneNumber: "0711223344",
+				message:     "hello",
+			},
+			wantProvider: "primary",
+			wantAttempts: 1,
+			wantStatus:   enums.SMSDeliveryStatusSent,
+		},
+		{
+			name: "Happy case: send via the default chain",
+			args: args{
+				ctx:         context.Background(),
+				phoneNumber: foreignNumber,
+				message:     "hello",
+			},
+			wantProvider: "default",
+			wantAttempts: 1,
+			wantStatus:   enums.SMSDeliveryStatusSent,
+		},
+		{
+			name: "Happy case: fall back when the first provider fails",
+			args: args{
+				ctx:         context.Background(),
+				phoneNumber: kenyanNumber,
+				message:     "hello",
+			},
+			wantProvider: "secondary",
+			wantAttempts: 2,
+			wantStatus:   enums.SMSDeliveryStatusSent,
+		},
+		{
+			name: "Happy case: fall back when the first provider times out",
+			args: args{
+				ctx:         context.Background(),
+				phoneNumber: kenyanNumber,
+				message:     "hello",
+			},
+			wantProvider: "secondary",
+			wantAttempts: 2,
+			wantStatus:   enums.SMSDeliveryStatusSent,
+		},
+		{
+			name: "Happy case: send when the delivery cannot be recorded",
+			args: args{
+				ctx:         context.Background(),
+				phoneNumber: kenyanNumber,
+				message:     "hello",
+			},
+			wantProvider: "primary",
+			wantAttempts: 1,
+			wantStatus:   enums.SMSDeliveryStatusSent,
+		},
+		{
+			name: "Sad case: all providers fail",
+			args: args{
+				ctx:         context.Background(),
+				phoneNumber: kenyanNumber,
+				message:     "hello",
+			},
+			wantProvider: "secondary",
+			wantAttempts: 2,
+			wantStatus:   enums.SMSDeliveryStatusFailed,
+			wantErr:      true,
+		},
+		{
+			name: "Sad case: no providers configured",
+			args: args{
+				ctx:         context.Background(),
+				phoneNumber: foreignNumber,
+				message:     "hello",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+
+			primary := mock.NewFakeSMSProvider("primary")
+			secondary := mock.NewFakeSMSProvider("secondary")
+			defaultProvider := mock.NewFakeSMSProvider("default")
+
+			gateway := smsgateway.NewSMSGateway(fakeDB, fakeDB, 50*time.Millisecond, defaultProvider)
+			gateway.RegisterChain("+254", primary, secondary)
+
+			var recorded map[string]interface{}
+			fakeDB.MockUpdateSMSDeliveryFn = func(ctx context.Context, delivery *domain.SMSDelivery, updateData map[string]interface{}) error {
+				recorded = updateData
+				return nil
+			}
+
+			switch tt.name {
+			case "Happy case: fall back when the first provider fails":
+				primary.Err = fmt.Errorf("an error occurred")
+			case "Happy case: fall back when the first provider times out":
+				primary.Delay = time.Second
+			case "Happy case: send when the delivery cannot be recorded":
+				fakeDB.MockCreateSMSDeliveryFn = func(ctx context.Context, delivery *domain.SMSDelivery) (*domain.SMSDelivery, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			case "Sad case: all providers fail":
+				primary.Err = fmt.Errorf("an error occurred")
+				secondary.Err = fmt.Errorf("an error occurred")
+			case "Sad case: no providers configured":
+				gateway = smsgateway.NewSMSGateway(fakeDB, fakeDB, 50*time.Millisecond)
+			}
+
+			got, err := gateway.SendSMS(tt.args.ctx, tt.args.phoneNumber, tt.args.message)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceImpl.SendSMS() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got == nil {
+				return
+			}
+
+			if got.Provider != tt.wantProvider {
+				t.Errorf("ServiceImpl.SendSMS() provider = %v, want %v", got.Provider, tt.wantProvider)
+			}
+			if got.Attempts != tt.wantAttempts {
+				t.Errorf("ServiceImpl.SendSMS() attempts = %v, want %v", got.Attempts, tt.wantAttempts)
+			}
+			if got.Status != tt.wantStatus {
+				t.Errorf("ServiceImpl.SendSMS() status = %v, want %v", got.Status, tt.wantStatus)
+			}
+
+			if tt.name == "Happy case: send when the delivery cannot be recorded" {
+				if recorded != nil {
+					t.Errorf("expected the delivery not to be recorded, got %v", recorded)
+				}
+				return
+			}
+			if recorded["status"] != tt.wantStatus.String() {
+				t.Errorf("expected recorded status %v, got %v", tt.wantStatus, recorded["status"])
+			}
+
+			if tt.wantStatus == enums.SMSDeliveryStatusSent {
+				var sent []mock.SentSMS
+				switch tt.wantProvider {
+				case "primary":
+					sent = primary.Sent()
+				case "secondary":
+					sent = secondary.Sent()
+				case "default":
+					sent = defaultProvider.Sent()
+				}
+				if len(sent) != 1 || sent[0].Message != tt.args.message {
+					t.Errorf("expected the message to be sent once by %s, got %v", tt.wantProvider, sent)
+				}
+				if got.ProviderMessageID != sent[0].Reference {
+					t.Errorf("expected provider message ID %v, got %v", sent[0].Reference, got.ProviderMessageID)
+				}
+			}
+		})
+	}
+}
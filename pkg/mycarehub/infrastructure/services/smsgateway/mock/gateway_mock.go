@@ -0,0 +1,37 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+)
+
+// SMSGatewayMock mocks the SMS gateway
+type SMSGatewayMock struct {
+	MockSendSMSFn func(ctx context.Context, phoneNumber string, message string) (*domain.SMSDelivery, error)
+}
+
+// NewSMSGatewayMock initializes the SMS gateway mock
+func NewSMSGatewayMock() *SMSGatewayMock {
+	return &SMSGatewayMock{
+		MockSendSMSFn: func(ctx context.Context, phoneNumber string, message string) (*domain.SMSDelivery, error) {
+			return &domain.SMSDelivery{
+				ID:                uuid.New().String(),
+				PhoneNumber:       phoneNumber,
+				Provider:          "FAKE",
+				ProviderMessageID: uuid.New().String(),
+				Status:            enums.SMSDeliveryStatusSent,
+				Attempts:          1,
+				CreatedAt:         time.Now(),
+			}, nil
+		},
+	}
+}
+
+// SendSMS mocks the implementation of sending an SMS through the gateway
+func (m *SMSGatewayMock) SendSMS(ctx context.Context, phoneNumber string, message string) (*domain.SMSDelivery, error) {
+	return m.MockSendSMSFn(ctx, phoneNumber, message)
+}
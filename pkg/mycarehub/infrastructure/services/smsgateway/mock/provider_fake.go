@@ -0,0 +1,76 @@
+package mock
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SentSMS is a message that has been accepted by the fake SMS provider
+type SentSMS struct {
+	PhoneNumber string
+	Message     string
+	Reference   string
+}
+
+// FakeSMSProvider is an in-memory SMS provider that records the messages it sends.
+// It can be configured to fail or to respond slowly in order to exercise the gateway's failover
+type FakeSMSProvider struct {
+	ProviderName string
+	// Err is returned for every message sent when set
+	Err error
+	// Delay is how long the provider takes before responding
+	Delay time.Duration
+
+	mu   sync.Mutex
+	sent []SentSMS
+}
+
+// NewFakeSMSProvider initializes an in-memory SMS provider with the given name
+func NewFakeSMSProvider(name string) *FakeSMSProvider {
+	return &FakeSMSProvider{
+		ProviderName: name,
+	}
+}
+
+// Name returns the name of the fake provider
+func (f *FakeSMSProvider) Name() string {
+	return f.ProviderName
+}
+
+// Send records the message unless the provider has been configured to fail
+func (f *FakeSMSProvider) Send(ctx context.Context, phoneNumber string, message string) (string, error) {
+	if f.Delay > 0 {
+		select {
+		case <-time.After(f.Delay):
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+
+	if f.Err != nil {
+		return "", f.Err
+	}
+
+	reference := uuid.New().String()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sent = append(f.sent, SentSMS{
+		PhoneNumber: phoneNumber,
+		Message:     message,
+		Reference:   reference,
+	})
+
+	return reference, nil
+}
+
+// Sent returns the messages that have been sent by the fake provider
+func (f *FakeSMSProvider) Sent() []SentSMS {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]SentSMS{}, f.sent...)
+}
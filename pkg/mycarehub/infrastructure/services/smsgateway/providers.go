@@ -0,0 +1,72 @@
+package smsgateway
+
+import (
+	"context"
+
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/sms"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/twilio"
+)
+
+const (
+	// SILCommsProviderName is the name recorded against messages sent via SILComms
+	SILCommsProviderName = "SILCOMMS"
+
+	// TwilioProviderName is the name recorded against messages sent via Twilio
+	TwilioProviderName = "TWILIO"
+)
+
+// SILCommsProvider sends SMS messages using the SILComms service
+type SILCommsProvider struct {
+	service sms.IServiceSMS
+}
+
+// NewSILCommsProvider initializes an SMS provider backed by SILComms
+func NewSILCommsProvider(service sms.IServiceSMS) *SILCommsProvider {
+	return &SILCommsProvider{
+		service: service,
+	}
+}
+
+// Name returns the name of the provider
+func (p *SILCommsProvider) Name() string {
+	return SILCommsProviderName
+}
+
+// Send sends an SMS message via SILComms
+func (p *SILCommsProvider) Send(ctx context.Context, phoneNumber string, message string) (string, error) {
+	resp, err := p.service.SendSMS(ctx, message, []string{phoneNumber})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil {
+		return "", nil
+	}
+
+	return resp.GUID, nil
+}
+
+// TwilioProvider sends SMS messages using the Twilio service
+type TwilioProvider struct {
+	service twilio.ITwilioService
+}
+
+// NewTwilioProvider initializes an SMS provider backed by Twilio
+func NewTwilioProvider(service twilio.ITwilioService) *TwilioProvider {
+	return &TwilioProvider{
+		service: service,
+	}
+}
+
+// Name returns the name of the provider
+func (p *TwilioProvider) Name() string {
+	return TwilioProviderName
+}
+
+// Send sends an SMS message via Twilio
+func (p *TwilioProvider) Send(ctx context.Context, phoneNumber string, message string) (string, error) {
+	if err := p.service.SendSMSViaTwilio(ctx, phoneNumber, message); err != nil {
+		return "", err
+	}
+
+	return "", nil
+}
@@ -0,0 +1,90 @@
+package smsgateway_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/savannahghi/interserviceclient"
+	smsMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/sms/mock"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/smsgateway"
+	twilioMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/twilio/mock"
+	"github.com/savannahghi/silcomms"
+)
+
+func TestSILCommsProvider_Send(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: send sms",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to send sms",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSMS := smsMock.NewSMSServiceMock()
+			provider := smsgateway.NewSILCommsProvider(fakeSMS)
+
+			if tt.name == "Sad case: unable to send sms" {
+				fakeSMS.MockSendSMSFn = func(ctx context.Context, message string, recipients []string) (*silcomms.BulkSMSResponse, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := provider.Send(context.Background(), interserviceclient.TestUserPhoneNumber, "hello")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SILCommsProvider.Send() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == "" {
+				t.Errorf("expected a message reference")
+			}
+			if provider.Name() != smsgateway.SILCommsProviderName {
+				t.Errorf("SILCommsProvider.Name() = %v, want %v", provider.Name(), smsgateway.SILCommsProviderName)
+			}
+		})
+	}
+}
+
+func TestTwilioProvider_Send(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: send sms",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to send sms",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeTwilio := twilioMock.NewTwilioServiceMock()
+			provider := smsgateway.NewTwilioProvider(fakeTwilio)
+
+			if tt.name == "Sad case: unable to send sms" {
+				fakeTwilio.MockSendSMSViaTwilioFn = func(ctx context.Context, phonenumber, message string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			_, err := provider.Send(context.Background(), "+12025550123", "hello")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TwilioProvider.Send() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if provider.Name() != smsgateway.TwilioProviderName {
+				t.Errorf("TwilioProvider.Name() = %v, want %v", provider.Name(), smsgateway.TwilioProviderName)
+			}
+		})
+	}
+}
@@ -101,6 +101,112 @@ func mycarehubCommands() []*cobra.Command {
 		},
 	}
 
+	var sendAppointmentRemindersCmd = &cobra.Command{
+		Use:   "sendappointmentreminders",
+		Short: "Sends reminders to clients with upcoming appointments",
+		Long: `Reminders are sent at the offsets configured in APPOINTMENT_REMINDER_OFFSET_DAYS.
+			Reminders that have already been sent are skipped, so the command can be run periodically e.g as a cron job`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := mycarehubService.SendAppointmentReminders(cmd.Context()); err != nil {
+				log.Fatal(err)
+			}
+			os.Exit(0)
+		},
+	}
+
+	var detectMissedAppointmentsCmd = &cobra.Command{
+		Use:   "detectmissedappointments",
+		Short: "Marks appointments that were not attended as missed",
+		Long: `Appointments whose date passed without an update from KenyaEMR are marked as missed
+			and a service request is created for the facility staff to follow up with the client`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := mycarehubService.DetectMissedAppointments(cmd.Context()); err != nil {
+				log.Fatal(err)
+			}
+			os.Exit(0)
+		},
+	}
+
+	var escalateServiceRequestsCmd = &cobra.Command{
+		Use:   "escalateservicerequests",
+		Short: "Notifies facility staff about service requests that breached their SLA",
+		Long: `Pending service requests that have not been picked up within the SLA configured in SERVICE_REQUEST_SLAS
+			are escalated to the staff at their facility. Each service request is escalated only once`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := mycarehubService.EscalateServiceRequests(cmd.Context()); err != nil {
+				log.Fatal(err)
+			}
+			os.Exit(0)
+		},
+	}
+
+	var sendScreeningToolRemindersCmd = &cobra.Command{
+		Use:   "sendscreeningtoolreminders",
+		Short: "Reminds clients to fill screening tools assigned to them",
+		Long: `Clients with pending screening tool assignments that are due within the next day, or are already overdue,
+			receive a reminder notification. A client is reminded at most once a day for each assignment`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := mycarehubService.SendScreeningToolAssignmentReminders(cmd.Context()); err != nil {
+				log.Fatal(err)
+			}
+			os.Exit(0)
+		},
+	}
+
+	var detectMoodDeteriorationCmd = &cobra.Command{
+		Use:   "detectmooddeterioration",
+		Short: "Checks clients' health diaries for signs of a deteriorating mood",
+		Long: `Clients whose recent health diary entries show sustained sadness, a downward mood trend or who have
+			stopped recording their mood get a mood deterioration service request raised for follow up`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := mycarehubService.DetectMoodDeterioration(cmd.Context()); err != nil {
+				log.Fatal(err)
+			}
+			os.Exit(0)
+		},
+	}
+
+	var processSurveyCampaignsCmd = &cobra.Command{
+		Use:   "processsurveycampaigns",
+		Short: "Sends out scheduled survey campaigns and follows up on the surveys they sent",
+		Long: `Survey campaigns that are due are sent to the clients who match their filters. Survey links that have expired
+			are deleted and clients who have not responded are reminded, so the command can be run periodically e.g as a cron job`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := mycarehubService.ProcessSurveyCampaigns(cmd.Context()); err != nil {
+				log.Fatal(err)
+			}
+			os.Exit(0)
+		},
+	}
+
+	var reconcileCommunitiesCmd = &cobra.Command{
+		Use:   "reconcilecommunities",
+		Short: "Syncs the members of every community with the community's membership criteria",
+		Long: `Eligible clients who are not members of a community are invited to it and members who no longer meet its
+			criteria are removed. Staff members are never removed, so the command can be run periodically e.g as a cron job`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := mycarehubService.ReconcileCommunities(cmd.Context()); err != nil {
+				log.Fatal(err)
+			}
+			os.Exit(0)
+		},
+	}
+
+	var rotateMatrixPasswordsCmd = &cobra.Command{
+		Use:   "rotatematrixpasswords",
+		Short: "Replaces the legacy Matrix passwords of existing users with generated ones",
+		Long: `Users whose Matrix password is still their user ID get a randomly generated password that is stored encrypted.
+			Users that fail to be rotated are skipped and retried the next time the command is run.
+			The command must be run as part of every deployment after the migrations are applied, since migrating only adds
+			the column that holds the generated passwords and the legacy passwords keep working until they are rotated`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := mycarehubService.RotateMatrixPasswords(cmd.Context()); err != nil {
+				log.Fatal(err)
+			}
+			os.Exit(0)
+		},
+	}
+
 	return []*cobra.Command{
 		loadOrganisationCmd,
 		loadProgramCmd,
@@ -109,6 +215,14 @@ func mycarehubCommands() []*cobra.Command {
 		loadTermsOfServiceCmd,
 		loadSecurityQuestionsCmd,
 		createsuperuserCmd,
+		sendAppointmentRemindersCmd,
+		detectMissedAppointmentsCmd,
+		escalateServiceRequestsCmd,
+		sendScreeningToolRemindersCmd,
+		detectMoodDeteriorationCmd,
+		processSurveyCampaignsCmd,
+		reconcileCommunitiesCmd,
+		rotateMatrixPasswordsCmd,
 	}
 
 }
@@ -24,6 +24,14 @@ type MyCareHubCmdInterfaces interface {
 	LinkFacilityToProgram(ctx context.Context, stdin io.Reader) error
 	LoadSecurityQuestions(ctx context.Context, absoluteFilePath string) error
 	LoadTermsOfService(ctx context.Context, stdin io.Reader) error
+	SendAppointmentReminders(ctx context.Context) error
+	DetectMissedAppointments(ctx context.Context) error
+	EscalateServiceRequests(ctx context.Context) error
+	SendScreeningToolAssignmentReminders(ctx context.Context) error
+	DetectMoodDeterioration(ctx context.Context) error
+	ProcessSurveyCampaigns(ctx context.Context) error
+	ReconcileCommunities(ctx context.Context) error
+	RotateMatrixPasswords(ctx context.Context) error
 }
 
 // MyCareHubCmdInterfacesImpl represents the usecase implementation object
@@ -476,3 +484,107 @@ func (m *MyCareHubCmdInterfacesImpl) LoadTermsOfService(ctx context.Context, std
 
 	return nil
 }
+
+// SendAppointmentReminders sends the reminders that are due for upcoming appointments
+func (m *MyCareHubCmdInterfacesImpl) SendAppointmentReminders(ctx context.Context) error {
+	fmt.Println("Sending appointment reminders...")
+
+	err := m.usecase.Appointment.SendAppointmentReminders(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Successfully sent appointment reminders")
+	return nil
+}
+
+// DetectMissedAppointments marks appointments that were not attended as missed and creates follow up service requests
+func (m *MyCareHubCmdInterfacesImpl) DetectMissedAppointments(ctx context.Context) error {
+	fmt.Println("Detecting missed appointments...")
+
+	err := m.usecase.Appointment.DetectMissedAppointments(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Successfully detected missed appointments")
+	return nil
+}
+
+// EscalateServiceRequests notifies facility staff about pending service requests that breached their SLA
+func (m *MyCareHubCmdInterfacesImpl) EscalateServiceRequests(ctx context.Context) error {
+	fmt.Println("Escalating overdue service requests...")
+
+	err := m.usecase.ServiceRequest.EscalateOverdueServiceRequests(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Successfully escalated overdue service requests")
+	return nil
+}
+
+// SendScreeningToolAssignmentReminders reminds clients to fill the screening tools assigned to them
+func (m *MyCareHubCmdInterfacesImpl) SendScreeningToolAssignmentReminders(ctx context.Context) error {
+	fmt.Println("Sending screening tool assignment reminders...")
+
+	err := m.usecase.Questionnaires.SendScreeningToolAssignmentReminders(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Successfully sent screening tool assignment reminders")
+	return nil
+}
+
+// DetectMoodDeterioration checks clients' health diaries for signs of a deteriorating mood
+func (m *MyCareHubCmdInterfacesImpl) DetectMoodDeterioration(ctx context.Context) error {
+	fmt.Println("Detecting mood deterioration...")
+
+	err := m.usecase.HealthDiary.DetectMoodDeterioration(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Successfully detected mood deterioration")
+	return nil
+}
+
+// ProcessSurveyCampaigns sends out the survey campaigns that are due and follows up on the surveys they sent
+func (m *MyCareHubCmdInterfacesImpl) ProcessSurveyCampaigns(ctx context.Context) error {
+	fmt.Println("Processing survey campaigns...")
+
+	err := m.usecase.Surveys.ProcessSurveyCampaigns(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Successfully processed survey campaigns")
+	return nil
+}
+
+// ReconcileCommunities re-syncs the members of every community with the community's membership criteria
+func (m *MyCareHubCmdInterfacesImpl) ReconcileCommunities(ctx context.Context) error {
+	fmt.Println("Reconciling communities...")
+
+	err := m.usecase.Community.ReconcileCommunities(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Successfully reconciled communities")
+	return nil
+}
+
+// RotateMatrixPasswords replaces the legacy Matrix passwords of existing users with generated ones
+func (m *MyCareHubCmdInterfacesImpl) RotateMatrixPasswords(ctx context.Context) error {
+	fmt.Println("Rotating Matrix passwords...")
+
+	err := m.usecase.Community.RotateMatrixPasswords(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Successfully rotated Matrix passwords")
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/presentation/graph"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/presentation/graph/generated"
 	internalRest "github.com/savannahghi/mycarehub/pkg/mycarehub/presentation/rest"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/presentation/scheduler"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases"
 	"github.com/savannahghi/serverutils"
 	log "github.com/sirupsen/logrus"
@@ -61,6 +62,10 @@ func Router(ctx context.Context) (*mux.Router, error) {
 		return nil, err
 	}
 
+	if scheduler.IsEnabled() {
+		scheduler.NewScheduler(scheduler.Jobs(*useCases)...).Start(ctx)
+	}
+
 	internalHandlers := internalRest.NewMyCareHubHandlersInterfaces(*useCases)
 
 	r := mux.NewRouter() // gorilla mux
@@ -158,6 +163,11 @@ func Router(ctx context.Context) (*mux.Router, error) {
 
 	r.Path("/pubsub").Methods(http.MethodPost).HandlerFunc(useCases.Pubsub.ReceivePubSubPushMessages)
 
+	// The Matrix homeserver pushes room events to this endpoint. It is authenticated with the application service's token
+	r.Path("/_matrix/app/v1/transactions/{txnID}").Methods(
+		http.MethodPut,
+	).HandlerFunc(internalHandlers.MatrixTransactions())
+
 	// This endpoint will be used by external services to get a token that will be used to
 	// authenticate against our APIs
 	r.Path("/login").Methods(
@@ -288,6 +298,9 @@ func GQLHandler(ctx context.Context,
 		generated.NewExecutableSchema(
 			generated.Config{
 				Resolvers: resolver,
+				Directives: generated.DirectiveRoot{
+					HasPermission: resolver.HasPermission,
+				},
 			},
 		),
 	)
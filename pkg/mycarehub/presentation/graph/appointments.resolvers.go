@@ -19,6 +19,26 @@ func (r *mutationResolver) RescheduleAppointment(ctx context.Context, appointmen
 	return r.mycarehub.Appointment.RescheduleClientAppointment(ctx, appointmentID, date)
 }
 
+// CreateAppointmentSlot is the resolver for the createAppointmentSlot field.
+func (r *mutationResolver) CreateAppointmentSlot(ctx context.Context, input dto.AppointmentSlotInput) (*domain.AppointmentSlot, error) {
+	return r.mycarehub.Appointment.CreateAppointmentSlot(ctx, input)
+}
+
+// DeleteAppointmentSlot is the resolver for the deleteAppointmentSlot field.
+func (r *mutationResolver) DeleteAppointmentSlot(ctx context.Context, slotID string) (bool, error) {
+	return r.mycarehub.Appointment.DeleteAppointmentSlot(ctx, slotID)
+}
+
+// BookAppointment is the resolver for the bookAppointment field.
+func (r *mutationResolver) BookAppointment(ctx context.Context, clientID string, slotID string, date scalarutils.Date, reason string) (*domain.Appointment, error) {
+	return r.mycarehub.Appointment.BookAppointment(ctx, clientID, slotID, date, reason)
+}
+
+// CancelAppointment is the resolver for the cancelAppointment field.
+func (r *mutationResolver) CancelAppointment(ctx context.Context, appointmentID string) (bool, error) {
+	return r.mycarehub.Appointment.CancelAppointment(ctx, appointmentID)
+}
+
 // FetchClientAppointments is the resolver for the fetchClientAppointments field.
 func (r *queryResolver) FetchClientAppointments(ctx context.Context, clientID string, paginationInput dto.PaginationsInput, filters []*firebasetools.FilterParam) (*domain.AppointmentsPage, error) {
 	return r.mycarehub.Appointment.FetchClientAppointments(ctx, clientID, paginationInput, filters)
@@ -29,6 +49,16 @@ func (r *queryResolver) NextRefill(ctx context.Context, clientID string) (*scala
 	return r.mycarehub.Appointment.NextRefill(ctx, clientID)
 }
 
+// ListFacilityAppointmentSlots is the resolver for the listFacilityAppointmentSlots field.
+func (r *queryResolver) ListFacilityAppointmentSlots(ctx context.Context, facilityID string) ([]*domain.AppointmentSlot, error) {
+	return r.mycarehub.Appointment.ListFacilityAppointmentSlots(ctx, facilityID)
+}
+
+// ListAvailableAppointmentSlots is the resolver for the listAvailableAppointmentSlots field.
+func (r *queryResolver) ListAvailableAppointmentSlots(ctx context.Context, facilityID string, startDate scalarutils.Date, endDate scalarutils.Date, reason *string) ([]*domain.AvailableAppointmentSlot, error) {
+	return r.mycarehub.Appointment.ListAvailableAppointmentSlots(ctx, facilityID, startDate, endDate, reason)
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
@@ -1 +1,68 @@
 package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.26
+
+import (
+	"context"
+
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+)
+
+// CreateRole is the resolver for the createRole field.
+func (r *mutationResolver) CreateRole(ctx context.Context, input dto.AuthorityRoleInput) (*domain.AuthorityRole, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Authority.CreateRole(ctx, input)
+}
+
+// UpdateRole is the resolver for the updateRole field.
+func (r *mutationResolver) UpdateRole(ctx context.Context, roleID string, input dto.AuthorityRoleInput) (*domain.AuthorityRole, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Authority.UpdateRole(ctx, roleID, input)
+}
+
+// DeleteRole is the resolver for the deleteRole field.
+func (r *mutationResolver) DeleteRole(ctx context.Context, roleID string) (bool, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Authority.DeleteRole(ctx, roleID)
+}
+
+// AssignRole is the resolver for the assignRole field.
+func (r *mutationResolver) AssignRole(ctx context.Context, staffID string, roleID string) (bool, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Authority.AssignRole(ctx, staffID, roleID)
+}
+
+// RevokeRole is the resolver for the revokeRole field.
+func (r *mutationResolver) RevokeRole(ctx context.Context, staffID string, roleID string) (bool, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Authority.RevokeRole(ctx, staffID, roleID)
+}
+
+// AuditLogs is the resolver for the auditLogs field.
+func (r *queryResolver) AuditLogs(ctx context.Context, filter *dto.AuditLogFilterInput, paginationInput dto.PaginationsInput) (*domain.AuditLogPage, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Authority.ListAuditLogs(ctx, filter, &paginationInput)
+}
+
+// ListRoles is the resolver for the listRoles field.
+func (r *queryResolver) ListRoles(ctx context.Context) ([]*domain.AuthorityRole, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Authority.ListRoles(ctx)
+}
+
+// ListRoleMembers is the resolver for the listRoleMembers field.
+func (r *queryResolver) ListRoleMembers(ctx context.Context, roleID string, paginationInput dto.PaginationsInput) (*domain.AuthorityRoleMembersPage, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Authority.ListRoleMembers(ctx, roleID, &paginationInput)
+}
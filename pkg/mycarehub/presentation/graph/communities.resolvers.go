@@ -18,9 +18,114 @@ func (r *mutationResolver) CreateCommunity(ctx context.Context, input *dto.Commu
 	return r.mycarehub.Community.CreateCommunity(ctx, input)
 }
 
+// PromoteToModerator is the resolver for the promoteToModerator field.
+func (r *mutationResolver) PromoteToModerator(ctx context.Context, communityID string, userID string) (bool, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Community.PromoteToModerator(ctx, communityID, userID)
+}
+
+// DemoteModerator is the resolver for the demoteModerator field.
+func (r *mutationResolver) DemoteModerator(ctx context.Context, communityID string, userID string) (bool, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Community.DemoteModerator(ctx, communityID, userID)
+}
+
+// RemoveCommunityMember is the resolver for the removeCommunityMember field.
+func (r *mutationResolver) RemoveCommunityMember(ctx context.Context, communityID string, userID string, reason *string) (bool, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Community.RemoveCommunityMember(ctx, communityID, userID, reason)
+}
+
+// BanCommunityMember is the resolver for the banCommunityMember field.
+func (r *mutationResolver) BanCommunityMember(ctx context.Context, communityID string, userID string, reason *string) (bool, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Community.BanCommunityMember(ctx, communityID, userID, reason)
+}
+
+// UnbanCommunityMember is the resolver for the unbanCommunityMember field.
+func (r *mutationResolver) UnbanCommunityMember(ctx context.Context, communityID string, userID string) (bool, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Community.UnbanCommunityMember(ctx, communityID, userID)
+}
+
+// MuteCommunityMember is the resolver for the muteCommunityMember field.
+func (r *mutationResolver) MuteCommunityMember(ctx context.Context, communityID string, userID string) (bool, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Community.MuteCommunityMember(ctx, communityID, userID)
+}
+
+// UnmuteCommunityMember is the resolver for the unmuteCommunityMember field.
+func (r *mutationResolver) UnmuteCommunityMember(ctx context.Context, communityID string, userID string) (bool, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Community.UnmuteCommunityMember(ctx, communityID, userID)
+}
+
+// RedactCommunityMessage is the resolver for the redactCommunityMessage field.
+func (r *mutationResolver) RedactCommunityMessage(ctx context.Context, communityID string, eventID string, reason *string) (bool, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Community.RedactCommunityMessage(ctx, communityID, eventID, reason)
+}
+
+// JoinCommunity is the resolver for the joinCommunity field.
+func (r *mutationResolver) JoinCommunity(ctx context.Context, communityID string) (bool, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Community.JoinCommunity(ctx, communityID)
+}
+
+// LeaveCommunity is the resolver for the leaveCommunity field.
+func (r *mutationResolver) LeaveCommunity(ctx context.Context, communityID string) (bool, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Community.LeaveCommunity(ctx, communityID)
+}
+
+// CreateCommunityCrisisKeyword is the resolver for the createCommunityCrisisKeyword field.
+func (r *mutationResolver) CreateCommunityCrisisKeyword(ctx context.Context, input dto.CommunityCrisisKeywordInput) (*domain.CommunityCrisisKeyword, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Community.CreateCommunityCrisisKeyword(ctx, input)
+}
+
+// DeleteCommunityCrisisKeyword is the resolver for the deleteCommunityCrisisKeyword field.
+func (r *mutationResolver) DeleteCommunityCrisisKeyword(ctx context.Context, keywordID string) (bool, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Community.DeleteCommunityCrisisKeyword(ctx, keywordID)
+}
+
 // ListRooms is the resolver for the listRooms field.
 func (r *queryResolver) ListRooms(ctx context.Context) ([]string, error) {
 	r.checkPreconditions()
 
 	return r.mycarehub.Community.ListCommunities(ctx)
 }
+
+// ListJoinedCommunities is the resolver for the listJoinedCommunities field.
+func (r *queryResolver) ListJoinedCommunities(ctx context.Context, searchTerm *string, paginationInput dto.PaginationsInput) (*domain.CommunityPage, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Community.ListJoinedCommunities(ctx, searchTerm, paginationInput)
+}
+
+// DiscoverCommunities is the resolver for the discoverCommunities field.
+func (r *queryResolver) DiscoverCommunities(ctx context.Context, searchTerm *string, paginationInput dto.PaginationsInput) (*domain.CommunityPage, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Community.DiscoverCommunities(ctx, searchTerm, paginationInput)
+}
+
+// ListCommunityCrisisKeywords is the resolver for the listCommunityCrisisKeywords field.
+func (r *queryResolver) ListCommunityCrisisKeywords(ctx context.Context) ([]*domain.CommunityCrisisKeyword, error) {
+	r.checkPreconditions()
+
+	return r.mycarehub.Community.ListCommunityCrisisKeywords(ctx)
+}
@@ -0,0 +1,25 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/exceptions"
+)
+
+// HasPermission implements the `@hasPermission` directive. It ensures that the logged in user has been granted
+// the provided permission before the field is resolved
+func (r *Resolver) HasPermission(ctx context.Context, obj interface{}, next graphql.Resolver, permission string) (interface{}, error) {
+	r.checkPreconditions()
+
+	allowed, err := r.mycarehub.Authority.CheckUserPermission(ctx, permission)
+	if err != nil {
+		return nil, err
+	}
+
+	if !allowed {
+		return nil, exceptions.PermissionDeniedErr(permission)
+	}
+
+	return next(ctx)
+}
@@ -6,7 +6,9 @@ package graph
 
 import (
 	"context"
+	"time"
 
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 )
@@ -22,6 +24,18 @@ func (r *mutationResolver) ShareHealthDiaryEntry(ctx context.Context, healthDiar
 	return r.mycarehub.HealthDiary.ShareHealthDiaryEntry(ctx, healthDiaryEntryID, shareEntireHealthDiary)
 }
 
+// ShareHealthDiary is the resolver for the shareHealthDiary field.
+func (r *mutationResolver) ShareHealthDiary(ctx context.Context, input dto.HealthDiaryShareInput) (*domain.HealthDiaryShare, error) {
+	r.checkPreconditions()
+	return r.mycarehub.HealthDiary.ShareHealthDiary(ctx, input)
+}
+
+// RevokeHealthDiaryShare is the resolver for the revokeHealthDiaryShare field.
+func (r *mutationResolver) RevokeHealthDiaryShare(ctx context.Context, shareID string) (bool, error) {
+	r.checkPreconditions()
+	return r.mycarehub.HealthDiary.RevokeHealthDiaryShare(ctx, shareID)
+}
+
 // CanRecordMood is the resolver for the canRecordMood field.
 func (r *queryResolver) CanRecordMood(ctx context.Context, clientID string) (bool, error) {
 	return r.mycarehub.HealthDiary.CanRecordHeathDiary(ctx, clientID)
@@ -40,6 +54,33 @@ func (r *queryResolver) GetClientHealthDiaryEntries(ctx context.Context, clientI
 }
 
 // GetSharedHealthDiaryEntries is the resolver for the getSharedHealthDiaryEntries field.
-func (r *queryResolver) GetSharedHealthDiaryEntries(ctx context.Context, clientID string, facilityID string) ([]*domain.ClientHealthDiaryEntry, error) {
-	return r.mycarehub.HealthDiary.GetSharedHealthDiaryEntries(ctx, clientID, facilityID)
+func (r *queryResolver) GetSharedHealthDiaryEntries(ctx context.Context, clientID string) ([]*domain.ClientHealthDiaryEntry, error) {
+	return r.mycarehub.HealthDiary.GetSharedHealthDiaryEntries(ctx, clientID)
+}
+
+// GetClientMoodSummary is the resolver for the getClientMoodSummary field.
+func (r *queryResolver) GetClientMoodSummary(ctx context.Context, clientID string, days *int) (*domain.ClientMoodSummary, error) {
+	return r.mycarehub.HealthDiary.GetClientMoodSummary(ctx, clientID, days)
+}
+
+// ExportHealthDiary is the resolver for the exportHealthDiary field.
+func (r *queryResolver) ExportHealthDiary(ctx context.Context, clientID string, from time.Time, to time.Time, format enums.HealthDiaryExportFormat) (*dto.HealthDiaryExportOutput, error) {
+	return r.mycarehub.HealthDiary.ExportHealthDiary(ctx, clientID, from, to, format)
+}
+
+// ExportSharedHealthDiary is the resolver for the exportSharedHealthDiary field.
+func (r *queryResolver) ExportSharedHealthDiary(ctx context.Context, clientID string, from time.Time, to time.Time, format enums.HealthDiaryExportFormat) (*dto.HealthDiaryExportOutput, error) {
+	return r.mycarehub.HealthDiary.ExportSharedHealthDiary(ctx, clientID, from, to, format)
+}
+
+// ListHealthDiaryShares is the resolver for the listHealthDiaryShares field.
+func (r *queryResolver) ListHealthDiaryShares(ctx context.Context, clientID string) ([]*domain.HealthDiaryShare, error) {
+	r.checkPreconditions()
+	return r.mycarehub.HealthDiary.ListHealthDiaryShares(ctx, clientID)
+}
+
+// GetCaregiverSharedHealthDiaryEntries is the resolver for the getCaregiverSharedHealthDiaryEntries field.
+func (r *queryResolver) GetCaregiverSharedHealthDiaryEntries(ctx context.Context, clientID string) ([]*domain.ClientHealthDiaryEntry, error) {
+	r.checkPreconditions()
+	return r.mycarehub.HealthDiary.GetCaregiverSharedHealthDiaryEntries(ctx, clientID)
 }
@@ -23,6 +23,11 @@ func (r *mutationResolver) ReadNotifications(ctx context.Context, ids []string)
 	return r.mycarehub.Notification.ReadNotifications(ctx, ids)
 }
 
+// SetNotificationPreference is the resolver for the setNotificationPreference field.
+func (r *mutationResolver) SetNotificationPreference(ctx context.Context, input dto.NotificationPreferenceInput) (bool, error) {
+	return r.mycarehub.Notification.SetNotificationPreference(ctx, input)
+}
+
 // FetchNotifications is the resolver for the fetchNotifications field.
 func (r *queryResolver) FetchNotifications(ctx context.Context, userID string, flavour feedlib.Flavour, paginationInput dto.PaginationsInput, filters *domain.NotificationFilters) (*domain.NotificationsPage, error) {
 	return r.mycarehub.Notification.FetchNotifications(ctx, userID, flavour, paginationInput, filters)
@@ -32,3 +37,8 @@ func (r *queryResolver) FetchNotifications(ctx context.Context, userID string, f
 func (r *queryResolver) FetchNotificationTypeFilters(ctx context.Context, flavour feedlib.Flavour) ([]*domain.NotificationTypeFilter, error) {
 	return r.mycarehub.Notification.FetchNotificationTypeFilters(ctx, flavour)
 }
+
+// FetchNotificationPreferences is the resolver for the fetchNotificationPreferences field.
+func (r *queryResolver) FetchNotificationPreferences(ctx context.Context) ([]*domain.NotificationPreference, error) {
+	return r.mycarehub.Notification.FetchNotificationPreferences(ctx)
+}
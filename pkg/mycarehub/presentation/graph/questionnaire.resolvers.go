@@ -21,6 +21,36 @@ func (r *mutationResolver) RespondToScreeningTool(ctx context.Context, input dto
 	return r.mycarehub.Questionnaires.RespondToScreeningTool(ctx, input)
 }
 
+// SaveScreeningToolDraft is the resolver for the saveScreeningToolDraft field.
+func (r *mutationResolver) SaveScreeningToolDraft(ctx context.Context, screeningToolID string, input dto.QuestionnaireInput) (*domain.ScreeningToolVersion, error) {
+	return r.mycarehub.Questionnaires.SaveScreeningToolDraft(ctx, screeningToolID, input)
+}
+
+// PublishScreeningToolDraft is the resolver for the publishScreeningToolDraft field.
+func (r *mutationResolver) PublishScreeningToolDraft(ctx context.Context, screeningToolID string) (*domain.ScreeningToolVersion, error) {
+	return r.mycarehub.Questionnaires.PublishScreeningToolDraft(ctx, screeningToolID)
+}
+
+// UpdateScreeningToolRecurrence is the resolver for the updateScreeningToolRecurrence field.
+func (r *mutationResolver) UpdateScreeningToolRecurrence(ctx context.Context, screeningToolID string, input dto.ScreeningToolRecurrenceInput) (bool, error) {
+	return r.mycarehub.Questionnaires.UpdateScreeningToolRecurrence(ctx, screeningToolID, input)
+}
+
+// UpdateScreeningToolScoreWorseningThreshold is the resolver for the updateScreeningToolScoreWorseningThreshold field.
+func (r *mutationResolver) UpdateScreeningToolScoreWorseningThreshold(ctx context.Context, screeningToolID string, threshold *int) (bool, error) {
+	return r.mycarehub.Questionnaires.UpdateScreeningToolScoreWorseningThreshold(ctx, screeningToolID, threshold)
+}
+
+// AssignScreeningTool is the resolver for the assignScreeningTool field.
+func (r *mutationResolver) AssignScreeningTool(ctx context.Context, input dto.ScreeningToolAssignmentInput) (*domain.ScreeningToolAssignment, error) {
+	return r.mycarehub.Questionnaires.AssignScreeningTool(ctx, input)
+}
+
+// CancelScreeningToolAssignment is the resolver for the cancelScreeningToolAssignment field.
+func (r *mutationResolver) CancelScreeningToolAssignment(ctx context.Context, assignmentID string) (bool, error) {
+	return r.mycarehub.Questionnaires.CancelScreeningToolAssignment(ctx, assignmentID)
+}
+
 // GetAvailableScreeningTools is the resolver for the getAvailableScreeningTools field.
 func (r *queryResolver) GetAvailableScreeningTools(ctx context.Context) ([]*domain.ScreeningTool, error) {
 	return r.mycarehub.Questionnaires.GetAvailableScreeningTools(ctx)
@@ -45,3 +75,28 @@ func (r *queryResolver) GetScreeningToolRespondents(ctx context.Context, facilit
 func (r *queryResolver) GetScreeningToolResponse(ctx context.Context, id string) (*domain.QuestionnaireScreeningToolResponse, error) {
 	return r.mycarehub.Questionnaires.GetScreeningToolResponse(ctx, id)
 }
+
+// GetClientScreeningToolScoreTrends is the resolver for the getClientScreeningToolScoreTrends field.
+func (r *queryResolver) GetClientScreeningToolScoreTrends(ctx context.Context, clientID string, screeningToolID *string) ([]*domain.ScreeningToolScoreTrend, error) {
+	return r.mycarehub.Questionnaires.GetClientScreeningToolScoreTrends(ctx, clientID, screeningToolID)
+}
+
+// ListScreeningToolVersions is the resolver for the listScreeningToolVersions field.
+func (r *queryResolver) ListScreeningToolVersions(ctx context.Context, screeningToolID string) ([]*domain.ScreeningToolVersion, error) {
+	return r.mycarehub.Questionnaires.ListScreeningToolVersions(ctx, screeningToolID)
+}
+
+// GetScreeningToolVersion is the resolver for the getScreeningToolVersion field.
+func (r *queryResolver) GetScreeningToolVersion(ctx context.Context, screeningToolID string, version int) (*domain.ScreeningToolVersion, error) {
+	return r.mycarehub.Questionnaires.GetScreeningToolVersion(ctx, screeningToolID, version)
+}
+
+// CompareScreeningToolVersions is the resolver for the compareScreeningToolVersions field.
+func (r *queryResolver) CompareScreeningToolVersions(ctx context.Context, screeningToolID string, fromVersion int, toVersion int) (*domain.ScreeningToolVersionDiff, error) {
+	return r.mycarehub.Questionnaires.CompareScreeningToolVersions(ctx, screeningToolID, fromVersion, toVersion)
+}
+
+// ListClientScreeningToolAssignments is the resolver for the listClientScreeningToolAssignments field.
+func (r *queryResolver) ListClientScreeningToolAssignments(ctx context.Context, clientID string) ([]*domain.ScreeningToolAssignment, error) {
+	return r.mycarehub.Questionnaires.ListClientScreeningToolAssignments(ctx, clientID)
+}
@@ -40,6 +40,16 @@ func (r *mutationResolver) VerifyStaffPinResetServiceRequest(ctx context.Context
 	return r.mycarehub.ServiceRequest.VerifyStaffPinResetServiceRequest(ctx, serviceRequestID, status)
 }
 
+// AssignServiceRequest is the resolver for the assignServiceRequest field.
+func (r *mutationResolver) AssignServiceRequest(ctx context.Context, serviceRequestID string, staffID string) (bool, error) {
+	return r.mycarehub.ServiceRequest.AssignServiceRequest(ctx, serviceRequestID, staffID)
+}
+
+// AddServiceRequestComment is the resolver for the addServiceRequestComment field.
+func (r *mutationResolver) AddServiceRequestComment(ctx context.Context, input dto.ServiceRequestCommentInput) (*domain.ServiceRequestComment, error) {
+	return r.mycarehub.ServiceRequest.AddServiceRequestComment(ctx, &input)
+}
+
 // GetServiceRequests is the resolver for the getServiceRequests field.
 func (r *queryResolver) GetServiceRequests(ctx context.Context, requestType *string, requestStatus *string, facilityID string, flavour feedlib.Flavour) ([]*domain.ServiceRequest, error) {
 	return r.mycarehub.ServiceRequest.GetServiceRequests(ctx, requestType, requestStatus, facilityID, flavour)
@@ -54,3 +64,18 @@ func (r *queryResolver) GetPendingServiceRequestsCount(ctx context.Context, faci
 func (r *queryResolver) SearchServiceRequests(ctx context.Context, searchTerm string, flavour feedlib.Flavour, requestType string, facilityID string) ([]*domain.ServiceRequest, error) {
 	return r.mycarehub.ServiceRequest.SearchServiceRequests(ctx, searchTerm, flavour, requestType, facilityID)
 }
+
+// GetServiceRequestSLAMetrics is the resolver for the getServiceRequestSLAMetrics field.
+func (r *queryResolver) GetServiceRequestSLAMetrics(ctx context.Context, facilityID string, requestType *string) ([]*domain.ServiceRequestSLAMetric, error) {
+	return r.mycarehub.ServiceRequest.GetServiceRequestSLAMetrics(ctx, facilityID, requestType)
+}
+
+// ListServiceRequestComments is the resolver for the listServiceRequestComments field.
+func (r *queryResolver) ListServiceRequestComments(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestComment, error) {
+	return r.mycarehub.ServiceRequest.ListServiceRequestComments(ctx, serviceRequestID)
+}
+
+// GetServiceRequestHistory is the resolver for the getServiceRequestHistory field.
+func (r *queryResolver) GetServiceRequestHistory(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestEvent, error) {
+	return r.mycarehub.ServiceRequest.GetServiceRequestHistory(ctx, serviceRequestID)
+}
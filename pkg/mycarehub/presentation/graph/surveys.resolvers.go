@@ -21,6 +21,26 @@ func (r *mutationResolver) VerifySurveySubmission(ctx context.Context, input dto
 	return r.mycarehub.Surveys.VerifySurveySubmission(ctx, input)
 }
 
+// CreateSurveyCampaign is the resolver for the createSurveyCampaign field.
+func (r *mutationResolver) CreateSurveyCampaign(ctx context.Context, input dto.SurveyCampaignInput) (*domain.SurveyCampaign, error) {
+	return r.mycarehub.Surveys.CreateSurveyCampaign(ctx, input)
+}
+
+// CancelSurveyCampaign is the resolver for the cancelSurveyCampaign field.
+func (r *mutationResolver) CancelSurveyCampaign(ctx context.Context, campaignID string) (bool, error) {
+	return r.mycarehub.Surveys.CancelSurveyCampaign(ctx, campaignID)
+}
+
+// CreateSurveyRedFlagRule is the resolver for the createSurveyRedFlagRule field.
+func (r *mutationResolver) CreateSurveyRedFlagRule(ctx context.Context, input dto.SurveyRedFlagRuleInput) (*domain.SurveyRedFlagRule, error) {
+	return r.mycarehub.Surveys.CreateSurveyRedFlagRule(ctx, input)
+}
+
+// DeleteSurveyRedFlagRule is the resolver for the deleteSurveyRedFlagRule field.
+func (r *mutationResolver) DeleteSurveyRedFlagRule(ctx context.Context, ruleID string) (bool, error) {
+	return r.mycarehub.Surveys.DeleteSurveyRedFlagRule(ctx, ruleID)
+}
+
 // ListSurveys is the resolver for the listSurveys field.
 func (r *queryResolver) ListSurveys(ctx context.Context, projectID int) ([]*domain.SurveyForm, error) {
 	return r.mycarehub.Surveys.ListSurveys(ctx, &projectID)
@@ -50,3 +70,18 @@ func (r *queryResolver) GetSurveyResponse(ctx context.Context, input dto.SurveyR
 func (r *queryResolver) GetSurveyWithServiceRequest(ctx context.Context, facilityID string) ([]*dto.SurveysWithServiceRequest, error) {
 	return r.mycarehub.Surveys.GetSurveysWithServiceRequests(ctx, facilityID)
 }
+
+// ListSurveyCampaigns is the resolver for the listSurveyCampaigns field.
+func (r *queryResolver) ListSurveyCampaigns(ctx context.Context, facilityID string) ([]*domain.SurveyCampaign, error) {
+	return r.mycarehub.Surveys.ListSurveyCampaigns(ctx, facilityID)
+}
+
+// GetSurveyCampaign is the resolver for the getSurveyCampaign field.
+func (r *queryResolver) GetSurveyCampaign(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error) {
+	return r.mycarehub.Surveys.GetSurveyCampaign(ctx, campaignID)
+}
+
+// ListSurveyRedFlagRules is the resolver for the listSurveyRedFlagRules field.
+func (r *queryResolver) ListSurveyRedFlagRules(ctx context.Context, projectID int, formID string) ([]*domain.SurveyRedFlagRule, error) {
+	return r.mycarehub.Surveys.ListSurveyRedFlagRules(ctx, projectID, formID)
+}
@@ -2,9 +2,13 @@ package rest
 
 import (
 	"context"
+	"crypto/subtle"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -45,6 +49,7 @@ type MyCareHubHandlersInterfaces interface {
 	FetchContactOrganisations() http.HandlerFunc
 	Organisations() http.HandlerFunc
 	UpdateProgramTenantID() http.HandlerFunc
+	MatrixTransactions() http.HandlerFunc
 }
 
 type okResp struct {
@@ -346,6 +351,7 @@ func (h *MyCareHubHandlersInterfacesImpl) SendOTP() http.HandlerFunc {
 			helpers.ReportErrorToSentry(err)
 			serverutils.WriteJSONResponse(w, errorcodeutil.CustomError{
 				Message: err.Error(),
+				Code:    exceptions.GetErrorCode(err),
 			}, http.StatusBadRequest)
 			return
 		}
@@ -388,6 +394,7 @@ func (h *MyCareHubHandlersInterfacesImpl) RequestPINReset() http.HandlerFunc {
 			helpers.ReportErrorToSentry(err)
 			serverutils.WriteJSONResponse(w, errorcodeutil.CustomError{
 				Message: err.Error(),
+				Code:    exceptions.GetErrorCode(err),
 			}, http.StatusBadRequest)
 			return
 		}
@@ -1066,3 +1073,45 @@ func (h *MyCareHubHandlersInterfacesImpl) Organisations() http.HandlerFunc {
 		serverutils.WriteJSONResponse(w, response, http.StatusOK)
 	}
 }
+
+// MatrixTransactions receives the events the Matrix homeserver pushes to myCareHub as an application service.
+// The homeserver authenticates with the application service's `hs_token`. The messages sent in communities are
+// scanned for crisis keywords. The homeserver retries a transaction until it is acknowledged so a transaction is
+// only rejected when it can not be authenticated or decoded
+func (h *MyCareHubHandlersInterfacesImpl) MatrixTransactions() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "" {
+			token = r.URL.Query().Get("access_token")
+		}
+
+		hsToken := os.Getenv("MCH_MATRIX_APPSERVICE_HS_TOKEN")
+		if hsToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(hsToken)) != 1 {
+			serverutils.WriteJSONResponse(w, map[string]string{
+				"errcode": "M_FORBIDDEN",
+				"error":   "invalid application service token",
+			}, http.StatusForbidden)
+			return
+		}
+
+		payload := &dto.MatrixTransaction{}
+		if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+			serverutils.WriteJSONResponse(w, map[string]string{
+				"errcode": "M_NOT_JSON",
+				"error":   err.Error(),
+			}, http.StatusBadRequest)
+			return
+		}
+
+		err := h.usecase.Community.ProcessMatrixEvents(ctx, payload.Events)
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			serverutils.WriteJSONResponse(w, serverutils.ErrorMap(err), http.StatusInternalServerError)
+			return
+		}
+
+		serverutils.WriteJSONResponse(w, map[string]string{}, http.StatusOK)
+	}
+}
@@ -0,0 +1,71 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases"
+)
+
+const (
+	// appointmentRemindersInterval is how often upcoming appointments are checked for reminders that are due
+	appointmentRemindersInterval = time.Hour
+
+	// missedAppointmentsInterval is how often past appointments are checked for ones that were missed
+	missedAppointmentsInterval = 6 * time.Hour
+
+	// serviceRequestEscalationInterval is how often pending service requests are checked for ones that breached their SLA
+	serviceRequestEscalationInterval = 15 * time.Minute
+
+	// screeningToolRemindersInterval is how often pending screening tool assignments are checked for reminders that are due
+	screeningToolRemindersInterval = time.Hour
+
+	// moodDeteriorationInterval is how often clients' health diaries are checked for signs of a deteriorating mood
+	moodDeteriorationInterval = 24 * time.Hour
+
+	// surveyCampaignsInterval is how often survey campaigns are checked for rounds that are due, expired links and reminders
+	surveyCampaignsInterval = 15 * time.Minute
+
+	// communityReconciliationInterval is how often the members of every community are synced with its membership criteria
+	communityReconciliationInterval = 24 * time.Hour
+)
+
+// Jobs returns the jobs that are run periodically by the scheduler
+func Jobs(usecase usecases.MyCareHub) []Job {
+	return []Job{
+		{
+			Name:     "appointment-reminders",
+			Interval: appointmentRemindersInterval,
+			Run:      usecase.Appointment.SendAppointmentReminders,
+		},
+		{
+			Name:     "missed-appointments",
+			Interval: missedAppointmentsInterval,
+			Run:      usecase.Appointment.DetectMissedAppointments,
+		},
+		{
+			Name:     "service-request-escalation",
+			Interval: serviceRequestEscalationInterval,
+			Run:      usecase.ServiceRequest.EscalateOverdueServiceRequests,
+		},
+		{
+			Name:     "screening-tool-assignment-reminders",
+			Interval: screeningToolRemindersInterval,
+			Run:      usecase.Questionnaires.SendScreeningToolAssignmentReminders,
+		},
+		{
+			Name:     "mood-deterioration-detection",
+			Interval: moodDeteriorationInterval,
+			Run:      usecase.HealthDiary.DetectMoodDeterioration,
+		},
+		{
+			Name:     "survey-campaigns",
+			Interval: surveyCampaignsInterval,
+			Run:      usecase.Surveys.ProcessSurveyCampaigns,
+		},
+		{
+			Name:     "community-reconciliation",
+			Interval: communityReconciliationInterval,
+			Run:      usecase.Community.ReconcileCommunities,
+		},
+	}
+}
@@ -0,0 +1,90 @@
+package scheduler
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
+	log "github.com/sirupsen/logrus"
+)
+
+// EnableBackgroundJobs is the environment variable used to run the scheduled jobs within the server process.
+// It should only be enabled on a single instance of the service. Alternatively, the jobs can be run periodically
+// using their respective `mycarehub` CLI subcommands
+const EnableBackgroundJobs = "ENABLE_BACKGROUND_JOBS"
+
+// Job represents a task that is run periodically by the scheduler
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler runs jobs periodically in the background
+type Scheduler struct {
+	jobs []Job
+	wg   sync.WaitGroup
+}
+
+// NewScheduler initializes a new scheduler with the jobs it should run
+func NewScheduler(jobs ...Job) *Scheduler {
+	return &Scheduler{
+		jobs: jobs,
+	}
+}
+
+// IsEnabled checks whether the scheduled jobs should be run within the server process
+func IsEnabled() bool {
+	enabled, err := strconv.ParseBool(os.Getenv(EnableBackgroundJobs))
+	if err != nil {
+		return false
+	}
+	return enabled
+}
+
+// Start runs each job immediately and then at its interval until the context is cancelled
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.schedule(ctx, job)
+	}
+}
+
+// Wait blocks until all the jobs have stopped after the scheduler's context is cancelled
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Scheduler) schedule(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		runJob(ctx, job)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runJob runs a single iteration of a job. A failing or panicking job does not stop subsequent runs
+func runJob(ctx context.Context, job Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("scheduled job %s panicked: %v", job.Name, r)
+		}
+	}()
+
+	if err := job.Run(ctx); err != nil {
+		helpers.ReportErrorToSentry(err)
+		log.Errorf("scheduled job %s failed: %v", job.Name, err)
+	}
+}
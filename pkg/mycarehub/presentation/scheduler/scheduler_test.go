@@ -0,0 +1,103 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIsEnabled(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     bool
+	}{
+		{
+			name:     "Happy case: background jobs enabled",
+			envValue: "true",
+			want:     true,
+		},
+		{
+			name:     "Happy case: background jobs disabled",
+			envValue: "false",
+			want:     false,
+		},
+		{
+			name:     "Sad case: invalid value",
+			envValue: "invalid",
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnableBackgroundJobs, tt.envValue)
+
+			if got := IsEnabled(); got != tt.want {
+				t.Errorf("IsEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduler_Start(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(ctx context.Context) error
+	}{
+		{
+			name: "Happy case: job runs periodically",
+			run: func(ctx context.Context) error {
+				return nil
+			},
+		},
+		{
+			name: "Sad case: failing job keeps running",
+			run: func(ctx context.Context) error {
+				return fmt.Errorf("an error occurred")
+			},
+		},
+		{
+			name: "Sad case: panicking job keeps running",
+			run: func(ctx context.Context) error {
+				panic("an error occurred")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var runs int32
+			ctx, cancel := context.WithCancel(context.Background())
+
+			s := NewScheduler(Job{
+				Name:     "test",
+				Interval: time.Millisecond,
+				Run: func(ctx context.Context) error {
+					if atomic.AddInt32(&runs, 1) == 3 {
+						cancel()
+					}
+					return tt.run(ctx)
+				},
+			})
+			s.Start(ctx)
+
+			done := make(chan struct{})
+			go func() {
+				s.Wait()
+				close(done)
+			}()
+
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				cancel()
+				t.Fatalf("expected the scheduler to stop after its context is cancelled")
+			}
+
+			if got := atomic.LoadInt32(&runs); got < 3 {
+				t.Errorf("expected the job to run at least 3 times, ran %v times", got)
+			}
+		})
+	}
+}
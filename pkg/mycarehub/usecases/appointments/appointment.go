@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -13,10 +14,12 @@ import (
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/exceptions"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure"
 	pubsubmessaging "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/pubsub"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/smsgateway"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/notification"
 	"github.com/savannahghi/scalarutils"
 	"gorm.io/gorm"
@@ -24,6 +27,9 @@ import (
 
 const (
 	refillReasonText = "Pharmacy Visit"
+
+	// appointmentBookingWindowDays is how far ahead of the current date a client can book an appointment
+	appointmentBookingWindowDays = 90
 )
 
 // These are concept IDs of the various appointments types on KenyaEMR
@@ -52,6 +58,7 @@ type ICreateHealthRecords interface {
 type IUpdateAppointments interface {
 	UpdateKenyaEMRAppointments(ctx context.Context, facility *domain.Facility, payload dto.AppointmentPayload) (*dto.AppointmentPayload, error)
 	RescheduleClientAppointment(ctx context.Context, appointmentID string, date scalarutils.Date) (bool, error)
+	DetectMissedAppointments(ctx context.Context) error
 }
 
 // IListAppointments defines method signatures for listing appointments
@@ -61,12 +68,29 @@ type IListAppointments interface {
 	NextRefill(ctx context.Context, clientID string) (*scalarutils.Date, error)
 }
 
+// IRemindAppointments defines method signatures for reminding clients of upcoming appointments
+type IRemindAppointments interface {
+	SendAppointmentReminders(ctx context.Context) error
+}
+
+// IBookAppointments defines method signatures for publishing facility appointment slots and booking appointments against them
+type IBookAppointments interface {
+	CreateAppointmentSlot(ctx context.Context, input dto.AppointmentSlotInput) (*domain.AppointmentSlot, error)
+	ListFacilityAppointmentSlots(ctx context.Context, facilityID string) ([]*domain.AppointmentSlot, error)
+	DeleteAppointmentSlot(ctx context.Context, slotID string) (bool, error)
+	ListAvailableAppointmentSlots(ctx context.Context, facilityID string, startDate, endDate scalarutils.Date, reason *string) ([]*domain.AvailableAppointmentSlot, error)
+	BookAppointment(ctx context.Context, clientID, slotID string, date scalarutils.Date, reason string) (*domain.Appointment, error)
+	CancelAppointment(ctx context.Context, appointmentID string) (bool, error)
+}
+
 // UseCasesAppointments holds all interfaces required to implement the appointments features
 type UseCasesAppointments interface {
 	ICreateHealthRecords
 	ICreateAppointments
 	IUpdateAppointments
 	IListAppointments
+	IRemindAppointments
+	IBookAppointments
 }
 
 // UseCasesAppointmentsImpl represents appointments implementation
@@ -77,6 +101,7 @@ type UseCasesAppointmentsImpl struct {
 	Update       infrastructure.Update
 	Pubsub       pubsubmessaging.ServicePubsub
 	Notification notification.UseCaseNotification
+	SMSGateway   smsgateway.SMSGateway
 }
 
 // NewUseCaseAppointmentsImpl initializes a new appointments usecase
@@ -87,6 +112,7 @@ func NewUseCaseAppointmentsImpl(
 	update infrastructure.Update,
 	pubsub pubsubmessaging.ServicePubsub,
 	notification notification.UseCaseNotification,
+	smsGateway smsgateway.SMSGateway,
 ) *UseCasesAppointmentsImpl {
 	return &UseCasesAppointmentsImpl{
 		Create:       create,
@@ -95,6 +121,7 @@ func NewUseCaseAppointmentsImpl(
 		Update:       update,
 		Pubsub:       pubsub,
 		Notification: notification,
+		SMSGateway:   smsGateway,
 	}
 }
 
@@ -213,9 +240,10 @@ func (a *UseCasesAppointmentsImpl) UpdateKenyaEMRAppointments(ctx context.Contex
 	}
 
 	updates := map[string]interface{}{
-		"date":        input.AppointmentDate.AsTime(),
-		"reason":      input.AppointmentReason,
-		"facility_id": *facility.ID,
+		"date":           input.AppointmentDate.AsTime(),
+		"reason":         input.AppointmentReason,
+		"facility_id":    *facility.ID,
+		"last_synced_at": time.Now(),
 	}
 
 	_, err = a.Update.UpdateAppointment(ctx, appointment, updates)
@@ -504,6 +532,79 @@ func (a *UseCasesAppointmentsImpl) RescheduleClientAppointment(ctx context.Conte
 	return true, nil
 }
 
+// DetectMissedAppointments marks the appointments whose date passed without an update from KenyaEMR as missed
+// and creates a service request for the facility staff to follow up with each client
+func (a *UseCasesAppointmentsImpl) DetectMissedAppointments(ctx context.Context) error {
+	before := appointmentsToday().AddDate(0, 0, -helpers.GetMissedAppointmentGraceDays())
+
+	appointments, err := a.Query.ListMissedAppointments(ctx, before)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("failed to list missed appointments: %w", err)
+	}
+
+	var errs error
+	for _, appointment := range appointments {
+		err := a.markAppointmentMissed(ctx, appointment)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("failed to mark appointment %s as missed: %w", appointment.ID, err))
+		}
+	}
+
+	if errs != nil {
+		helpers.ReportErrorToSentry(errs)
+	}
+
+	return errs
+}
+
+// markAppointmentMissed marks a single appointment as missed and notifies the facility staff of the follow up
+func (a *UseCasesAppointmentsImpl) markAppointmentMissed(ctx context.Context, appointment *domain.Appointment) error {
+	client, err := a.Query.GetClientProfileByClientID(ctx, appointment.ClientID)
+	if err != nil {
+		return fmt.Errorf("failed to get client profile: %w", err)
+	}
+
+	appointmentDate := appointment.Date.AsTime().Format("02-Jan-2006")
+
+	serviceRequest := &dto.ServiceRequestInput{
+		Active:      true,
+		RequestType: enums.ServiceRequestTypeMissedAppointment.String(),
+		Request:     fmt.Sprintf("%s missed their %s appointment on %s", client.User.Name, appointment.Reason, appointmentDate),
+		Status:      enums.ServiceRequestStatusPending.String(),
+		ClientID:    appointment.ClientID,
+		FacilityID:  appointment.FacilityID,
+		Meta: map[string]interface{}{
+			"appointmentID":     appointment.ID,
+			"externalID":        appointment.ExternalID,
+			"appointmentReason": appointment.Reason,
+			"appointmentDate":   appointment.Date.AsTime().Format(time.RFC3339),
+		},
+		ProgramID:      client.User.CurrentProgramID,
+		OrganisationID: client.User.CurrentOrganizationID,
+	}
+
+	err = a.Update.MarkAppointmentMissed(ctx, appointment.ID, serviceRequest)
+	if err != nil {
+		return err
+	}
+
+	requestType := enums.ServiceRequestTypeMissedAppointment
+	message := notification.ComposeStaffNotification(
+		enums.NotificationTypeServiceRequest,
+		notification.StaffNotificationArgs{
+			Subject:            client.User,
+			ServiceRequestType: &requestType,
+		},
+	)
+	err = a.Notification.NotifyFacilityStaffs(ctx, &domain.Facility{ID: &appointment.FacilityID}, message)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+	}
+
+	return nil
+}
+
 // NextRefill indicates the next time a user is supposed to visit the pharmacy to refill drugs
 // It is stored as an appointment with reason "Pharmacy Visit" as obtained from Kenya EMR
 func (a *UseCasesAppointmentsImpl) NextRefill(ctx context.Context, clientID string) (*scalarutils.Date, error) {
@@ -525,3 +626,458 @@ func (a *UseCasesAppointmentsImpl) NextRefill(ctx context.Context, clientID stri
 
 	return &appointment.Date, nil
 }
+
+// SendAppointmentReminders reminds clients of their upcoming appointments at each of the configured offsets.
+// Reminders are only sent after the configured hour of the day and each sent reminder is recorded so that a client
+// is not reminded more than once at the same offset
+func (a *UseCasesAppointmentsImpl) SendAppointmentReminders(ctx context.Context) error {
+	reminderHour, location := helpers.GetAppointmentReminderSchedule()
+	now := time.Now().In(location)
+	if now.Hour() < reminderHour {
+		return nil
+	}
+
+	var errs error
+	for _, offset := range helpers.GetAppointmentReminderOffsets() {
+		appointments, err := a.Query.ListAppointmentsPendingReminder(ctx, now.AddDate(0, 0, offset), offset)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("failed to list appointments due for a reminder: %w", err))
+			continue
+		}
+
+		for _, appointment := range appointments {
+			err := a.sendAppointmentReminder(ctx, appointment, offset)
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("failed to send reminder for appointment %s: %w", appointment.ID, err))
+			}
+		}
+	}
+
+	if errs != nil {
+		helpers.ReportErrorToSentry(errs)
+	}
+
+	return errs
+}
+
+// sendAppointmentReminder sends a reminder to a client through the channels they have not opted out of
+// and records the reminder
+func (a *UseCasesAppointmentsImpl) sendAppointmentReminder(ctx context.Context, appointment *domain.Appointment, offsetDays int) error {
+	client, err := a.Query.GetClientProfileByClientID(ctx, appointment.ClientID)
+	if err != nil {
+		return fmt.Errorf("failed to get client profile: %w", err)
+	}
+
+	preferences, err := a.Query.GetNotificationPreferences(ctx, *client.User.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	// clients receive reminders on all channels unless they have opted out
+	pushEnabled, smsEnabled := true, true
+	for _, preference := range preferences {
+		if preference.NotificationType == enums.NotificationTypeAppointment {
+			pushEnabled, smsEnabled = preference.PushEnabled, preference.SMSEnabled
+		}
+	}
+
+	message := notification.ComposeClientNotification(
+		enums.NotificationTypeAppointment,
+		notification.ClientNotificationInput{
+			Appointment:       appointment,
+			DaysToAppointment: &offsetDays,
+		},
+	)
+
+	reminder := &domain.AppointmentReminder{
+		AppointmentID: appointment.ID,
+		OffsetDays:    offsetDays,
+	}
+
+	if pushEnabled {
+		err = a.Notification.NotifyUser(ctx, client.User, message)
+		if err != nil {
+			return err
+		}
+		reminder.PushSent = true
+	}
+
+	if smsEnabled {
+		contact, err := a.Query.GetContactByUserID(ctx, client.User.ID, "PHONE")
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+		}
+
+		if contact != nil && contact.OptedIn {
+			_, err = a.SMSGateway.SendSMS(ctx, contact.ContactValue, message.Body)
+			if err != nil {
+				helpers.ReportErrorToSentry(err)
+			} else {
+				reminder.SMSSent = true
+			}
+		}
+	}
+
+	reminder.SentAt = time.Now()
+
+	return a.Create.CreateAppointmentReminder(ctx, reminder)
+}
+
+// appointmentsToday returns the current date in the time zone of the appointments. Like appointment dates, it is
+// represented as midnight UTC
+func appointmentsToday() time.Time {
+	now := time.Now().In(helpers.GetAppointmentsLocation())
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// CreateAppointmentSlot publishes a weekly period during which a facility accepts appointment bookings.
+// Only staff assigned to the facility in their current program can publish its slots
+func (a *UseCasesAppointmentsImpl) CreateAppointmentSlot(ctx context.Context, input dto.AppointmentSlotInput) (*domain.AppointmentSlot, error) {
+	if err := input.Validate(); err != nil {
+		return nil, exceptions.InputValidationErr(err)
+	}
+
+	_, err := a.Query.RetrieveFacility(ctx, &input.FacilityID, true)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.ItemNotFoundErr(err)
+	}
+
+	err = a.checkLoggedInStaffFacility(ctx, input.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	slot, err := a.Create.CreateAppointmentSlot(ctx, &domain.AppointmentSlot{
+		FacilityID: input.FacilityID,
+		DayOfWeek:  input.DayOfWeek,
+		StartTime:  input.StartTime,
+		EndTime:    input.EndTime,
+		Capacity:   input.Capacity,
+		Reasons:    input.Reasons,
+	})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to create appointment slot: %w", err)
+	}
+
+	return slot, nil
+}
+
+// ListFacilityAppointmentSlots returns the appointment slots that a facility has published
+func (a *UseCasesAppointmentsImpl) ListFacilityAppointmentSlots(ctx context.Context, facilityID string) ([]*domain.AppointmentSlot, error) {
+	slots, err := a.Query.ListAppointmentSlots(ctx, facilityID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to list appointment slots: %w", err)
+	}
+
+	return slots, nil
+}
+
+// DeleteAppointmentSlot stops a facility from accepting further bookings against an appointment slot.
+// Appointments that have already been booked against the slot are kept. Only staff assigned to the slot's facility
+// in their current program can delete it
+func (a *UseCasesAppointmentsImpl) DeleteAppointmentSlot(ctx context.Context, slotID string) (bool, error) {
+	slot, err := a.Query.GetAppointmentSlot(ctx, slotID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to get appointment slot: %w", err)
+	}
+
+	err = a.checkLoggedInStaffFacility(ctx, slot.FacilityID)
+	if err != nil {
+		return false, err
+	}
+
+	err = a.Update.DeactivateAppointmentSlot(ctx, slotID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to delete appointment slot: %w", err)
+	}
+
+	return true, nil
+}
+
+// ListAvailableAppointmentSlots returns the appointment slots of a facility that can still be booked on each date
+// between the start and end dates. Dates that have already passed are skipped and the slots can optionally be
+// filtered by the reason of the appointment
+func (a *UseCasesAppointmentsImpl) ListAvailableAppointmentSlots(ctx context.Context, facilityID string, startDate, endDate scalarutils.Date, reason *string) ([]*domain.AvailableAppointmentSlot, error) {
+	start, end := startDate.AsTime(), endDate.AsTime()
+	if end.Before(start) {
+		return nil, exceptions.InputValidationErr(fmt.Errorf("the end date should not be before the start date"))
+	}
+
+	today := appointmentsToday()
+	if start.Before(today) {
+		start = today
+	}
+	lastBookableDate := today.AddDate(0, 0, appointmentBookingWindowDays)
+	if end.After(lastBookableDate) {
+		end = lastBookableDate
+	}
+
+	available := []*domain.AvailableAppointmentSlot{}
+	if end.Before(start) {
+		return available, nil
+	}
+
+	slots, err := a.Query.ListAppointmentSlots(ctx, facilityID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to list appointment slots: %w", err)
+	}
+
+	counts, err := a.Query.GetAppointmentSlotBookingCounts(ctx, facilityID, start, end)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get appointment slot bookings: %w", err)
+	}
+
+	booked := map[string]int{}
+	for _, count := range counts {
+		booked[slotBookingKey(count.SlotID, count.Date)] = count.Count
+	}
+
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		for _, slot := range slots {
+			if slot.DayOfWeek != int(date.Weekday()) {
+				continue
+			}
+			if reason != nil && !slotAcceptsReason(slot, *reason) {
+				continue
+			}
+
+			remaining := slot.Capacity - booked[slotBookingKey(slot.ID, date)]
+			if remaining <= 0 {
+				continue
+			}
+
+			available = append(available, &domain.AvailableAppointmentSlot{
+				SlotID: slot.ID,
+				Date: scalarutils.Date{
+					Year:  date.Year(),
+					Month: int(date.Month()),
+					Day:   date.Day(),
+				},
+				StartTime: slot.StartTime,
+				EndTime:   slot.EndTime,
+				Reasons:   slot.Reasons,
+				Capacity:  slot.Capacity,
+				Available: remaining,
+			})
+		}
+	}
+
+	return available, nil
+}
+
+// BookAppointment books an appointment for the logged in client against one of their facility's appointment slots.
+// A service request is created with the booking so that it is shared with KenyaEMR
+func (a *UseCasesAppointmentsImpl) BookAppointment(ctx context.Context, clientID, slotID string, date scalarutils.Date, reason string) (*domain.Appointment, error) {
+	client, err := a.Query.GetClientProfileByClientID(ctx, clientID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.ClientProfileNotFoundErr(err)
+	}
+
+	if err := a.checkLoggedInClient(ctx, client); err != nil {
+		return nil, err
+	}
+
+	slot, err := a.Query.GetAppointmentSlot(ctx, slotID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get appointment slot: %w", err)
+	}
+
+	if err := checkSlotBookable(slot, client, date.AsTime(), reason); err != nil {
+		return nil, exceptions.AppointmentSlotUnavailableErr(err)
+	}
+
+	appointment := &domain.Appointment{
+		Reason:     reason,
+		Date:       date,
+		ClientID:   *client.ID,
+		FacilityID: slot.FacilityID,
+		ProgramID:  client.User.CurrentProgramID,
+		SlotID:     &slot.ID,
+	}
+
+	serviceRequest := &dto.ServiceRequestInput{
+		Active:      true,
+		RequestType: enums.ServiceRequestTypeAppointments.String(),
+		Request: fmt.Sprintf(
+			"%s has booked a %s appointment on %s between %s and %s",
+			client.User.Name, reason, date.AsTime().Format("02-Jan-2006"), slot.StartTime, slot.EndTime,
+		),
+		Status:     enums.ServiceRequestStatusPending.String(),
+		ClientID:   *client.ID,
+		FacilityID: slot.FacilityID,
+		Meta: map[string]interface{}{
+			"slotID":         slot.ID,
+			"externalID":     "",
+			"rescheduleTime": date.AsTime().Format(time.RFC3339),
+			"action":         enums.AppointmentServiceRequestActionBook.String(),
+		},
+		ProgramID:      client.User.CurrentProgramID,
+		OrganisationID: client.User.CurrentOrganizationID,
+	}
+
+	booking, err := a.Create.BookAppointmentSlot(ctx, appointment, serviceRequest)
+	if err != nil {
+		if errors.Is(err, domain.ErrAppointmentSlotFullyBooked) {
+			return nil, exceptions.AppointmentSlotFullyBookedErr(err)
+		}
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to book appointment: %w", err)
+	}
+
+	return booking, nil
+}
+
+// CancelAppointment cancels an appointment that the logged in client booked against a facility slot, freeing up its place in the slot.
+// A service request is created with the cancellation so that it is shared with KenyaEMR
+func (a *UseCasesAppointmentsImpl) CancelAppointment(ctx context.Context, appointmentID string) (bool, error) {
+	appointment, err := a.Query.GetAppointment(ctx, domain.Appointment{ID: appointmentID})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("error getting client appointment: %w", err)
+	}
+
+	if appointment.SlotID == nil {
+		return false, fmt.Errorf("only appointments booked against a facility slot can be cancelled")
+	}
+
+	client, err := a.Query.GetClientProfileByClientID(ctx, appointment.ClientID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.ClientProfileNotFoundErr(err)
+	}
+
+	if err := a.checkLoggedInClient(ctx, client); err != nil {
+		return false, err
+	}
+
+	serviceRequest := &dto.ServiceRequestInput{
+		Active:      true,
+		RequestType: enums.ServiceRequestTypeAppointments.String(),
+		Request: fmt.Sprintf(
+			"%s has cancelled their %s appointment on %s",
+			client.User.Name, appointment.Reason, appointment.Date.AsTime().Format("02-Jan-2006"),
+		),
+		Status:     enums.ServiceRequestStatusPending.String(),
+		ClientID:   appointment.ClientID,
+		FacilityID: appointment.FacilityID,
+		Meta: map[string]interface{}{
+			"appointmentID":  appointment.ID,
+			"slotID":         *appointment.SlotID,
+			"externalID":     appointment.ExternalID,
+			"rescheduleTime": appointment.Date.AsTime().Format(time.RFC3339),
+			"action":         enums.AppointmentServiceRequestActionCancel.String(),
+		},
+		ProgramID:      client.User.CurrentProgramID,
+		OrganisationID: client.User.CurrentOrganizationID,
+	}
+
+	err = a.Update.CancelAppointmentBooking(ctx, appointment.ID, serviceRequest)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to cancel appointment: %w", err)
+	}
+
+	return true, nil
+}
+
+// checkLoggedInClient checks that a client profile belongs to the logged in user
+func (a *UseCasesAppointmentsImpl) checkLoggedInClient(ctx context.Context, client *domain.ClientProfile) error {
+	loggedInUserID, err := a.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return exceptions.GetLoggedInUserUIDErr(err)
+	}
+
+	if client.UserID != loggedInUserID {
+		err := fmt.Errorf("client %s does not belong to the logged in user", *client.ID)
+		helpers.ReportErrorToSentry(err)
+		return exceptions.UserNotAuthorizedErr(err)
+	}
+
+	return nil
+}
+
+// checkLoggedInStaffFacility checks that the logged in user is a staff assigned to a facility in their current program
+func (a *UseCasesAppointmentsImpl) checkLoggedInStaffFacility(ctx context.Context, facilityID string) error {
+	loggedInUserID, err := a.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return exceptions.GetLoggedInUserUIDErr(err)
+	}
+
+	userProfile, err := a.Query.GetUserProfileByUserID(ctx, loggedInUserID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return exceptions.UserNotFoundError(err)
+	}
+
+	staff, err := a.Query.GetStaffProfile(ctx, loggedInUserID, userProfile.CurrentProgramID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return exceptions.StaffProfileNotFoundErr(err)
+	}
+
+	facilities, _, err := a.Query.GetStaffFacilities(ctx, dto.StaffFacilityInput{StaffID: staff.ID, FacilityID: &facilityID}, nil)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("failed to get staff facilities: %w", err)
+	}
+
+	if len(facilities) == 0 {
+		err := fmt.Errorf("staff %s is not assigned to facility %s", *staff.ID, facilityID)
+		helpers.ReportErrorToSentry(err)
+		return exceptions.UserNotAuthorizedErr(err)
+	}
+
+	return nil
+}
+
+// checkSlotBookable checks that a client can book an appointment for the provided reason against a slot on a date
+func checkSlotBookable(slot *domain.AppointmentSlot, client *domain.ClientProfile, date time.Time, reason string) error {
+	if !slot.Active {
+		return fmt.Errorf("appointment slot %s is no longer accepting bookings", slot.ID)
+	}
+
+	if client.DefaultFacility == nil || client.DefaultFacility.ID == nil || *client.DefaultFacility.ID != slot.FacilityID {
+		return fmt.Errorf("appointments can only be booked at the client's default facility")
+	}
+
+	if slot.DayOfWeek != int(date.Weekday()) {
+		return fmt.Errorf("appointment slot %s is only available on %s", slot.ID, time.Weekday(slot.DayOfWeek))
+	}
+
+	today := appointmentsToday()
+	if date.Before(today) || date.After(today.AddDate(0, 0, appointmentBookingWindowDays)) {
+		return fmt.Errorf("appointments can only be booked up to %d days in advance", appointmentBookingWindowDays)
+	}
+
+	if !slotAcceptsReason(slot, reason) {
+		return fmt.Errorf("appointment slot %s does not accept %s appointments", slot.ID, reason)
+	}
+
+	return nil
+}
+
+// slotAcceptsReason checks whether appointments for the provided reason can be booked against a slot
+func slotAcceptsReason(slot *domain.AppointmentSlot, reason string) bool {
+	for _, slotReason := range slot.Reasons {
+		if strings.EqualFold(slotReason, reason) {
+			return true
+		}
+	}
+	return false
+}
+
+// slotBookingKey identifies the bookings made against a slot on a specific date
+func slotBookingKey(slotID string, date time.Time) string {
+	return fmt.Sprintf("%s/%s", slotID, date.Format("2006-01-02"))
+}
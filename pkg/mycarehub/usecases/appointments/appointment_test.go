@@ -3,17 +3,21 @@ package appointment
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/brianvoe/gofakeit"
 	"github.com/google/uuid"
 	"github.com/savannahghi/firebasetools"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 	extensionMock "github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension/mock"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	pgMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/mock"
 	pubsubMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/pubsub/mock"
+	smsGatewayMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/smsgateway/mock"
 	notificationMock "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/notification/mock"
 	"github.com/savannahghi/scalarutils"
 	"gorm.io/gorm"
@@ -193,8 +197,9 @@ func TestUseCasesAppointmentsImpl_CreateKenyaEMRAppointments(t *testing.T) {
 			fakeExtension := extensionMock.NewFakeExtension()
 			fakePubsub := pubsubMock.NewPubsubServiceMock()
 			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMSGateway := smsGatewayMock.NewSMSGatewayMock()
 
-			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification)
+			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification, fakeSMSGateway)
 
 			if tt.name == "sad case: error checking facility" {
 				fakeDB.MockCheckFacilityExistsByIdentifier = func(ctx context.Context, identifier *dto.FacilityIdentifierInput) (bool, error) {
@@ -340,8 +345,9 @@ func TestUseCasesAppointmentsImpl_UpdateKenyaEMRAppointments(t *testing.T) {
 	fakeExtension := extensionMock.NewFakeExtension()
 	fakePubsub := pubsubMock.NewPubsubServiceMock()
 	fakeNotification := notificationMock.NewServiceNotificationMock()
+	fakeSMSGateway := smsGatewayMock.NewSMSGatewayMock()
 
-	a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification)
+	a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification, fakeSMSGateway)
 
 	type args struct {
 		ctx   context.Context
@@ -730,8 +736,9 @@ func TestUseCasesAppointmentsImpl_FetchClientAppointments(t *testing.T) {
 			fakePubsub := pubsubMock.NewPubsubServiceMock()
 
 			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMSGateway := smsGatewayMock.NewSMSGatewayMock()
 
-			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification)
+			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification, fakeSMSGateway)
 
 			if tt.name == "sad case: error listing appointments" {
 				fakeDB.MockListAppointments = func(ctx context.Context, params *domain.Appointment, filters []*firebasetools.FilterParam, pagination *domain.Pagination) ([]*domain.Appointment, *domain.Pagination, error) {
@@ -886,8 +893,9 @@ func TestUseCasesAppointmentsImpl_AddPatientsRecords(t *testing.T) {
 			fakePubsub := pubsubMock.NewPubsubServiceMock()
 
 			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMSGateway := smsGatewayMock.NewSMSGatewayMock()
 
-			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification)
+			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification, fakeSMSGateway)
 
 			if tt.name == "sad case: error checking facility exist" {
 				fakeDB.MockCheckFacilityExistsByIdentifier = func(ctx context.Context, identifier *dto.FacilityIdentifierInput) (bool, error) {
@@ -1176,8 +1184,9 @@ func TestUseCasesAppointmentsImpl_AddPatientRecord(t *testing.T) {
 			fakePubsub := pubsubMock.NewPubsubServiceMock()
 
 			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMSGateway := smsGatewayMock.NewSMSGatewayMock()
 
-			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification)
+			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification, fakeSMSGateway)
 
 			if tt.name == "sad case: error retrieving mfl code" {
 				fakeDB.MockRetrieveFacilityByIdentifierFn = func(ctx context.Context, identifier *dto.FacilityIdentifierInput, isActive bool) (*domain.Facility, error) {
@@ -1297,8 +1306,9 @@ func TestUseCasesAppointmentsImpl_GetAppointmentServiceRequests(t *testing.T) {
 			fakePubsub := pubsubMock.NewPubsubServiceMock()
 
 			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMSGateway := smsGatewayMock.NewSMSGatewayMock()
 
-			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification)
+			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification, fakeSMSGateway)
 
 			if tt.name == "sad case: error facility with provided mfl code not found" {
 				fakeDB.MockCheckFacilityExistsByIdentifier = func(ctx context.Context, identifier *dto.FacilityIdentifierInput) (bool, error) {
@@ -1415,8 +1425,9 @@ func TestUseCasesAppointmentsImpl_RescheduleClientAppointment(t *testing.T) {
 			fakeExtension := extensionMock.NewFakeExtension()
 			fakePubsub := pubsubMock.NewPubsubServiceMock()
 			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMSGateway := smsGatewayMock.NewSMSGatewayMock()
 
-			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification)
+			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification, fakeSMSGateway)
 
 			if tt.name == "sad case: failed to get client by id" {
 				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
@@ -1527,8 +1538,9 @@ func TestUseCasesAppointmentsImpl_NextRefill(t *testing.T) {
 			}
 
 			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMSGateway := smsGatewayMock.NewSMSGatewayMock()
 
-			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification)
+			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification, fakeSMSGateway)
 			got, err := a.NextRefill(tt.args.ctx, tt.args.clientID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UseCasesAppointmentsImpl.NextRefill() error = %v, wantErr %v", err, tt.wantErr)
@@ -1545,3 +1557,1225 @@ func TestUseCasesAppointmentsImpl_NextRefill(t *testing.T) {
 		})
 	}
 }
+
+func TestUseCasesAppointmentsImpl_SendAppointmentReminders(t *testing.T) {
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: send appointment reminders",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: reminders are not sent before the reminder hour",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: client opted out of appointment notifications",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: phone number opted out of SMS",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: failed to send SMS reminder",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: failed to list appointments pending reminder",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get client profile",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get notification preferences",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to notify client",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to record reminder",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakePubsub := pubsubMock.NewPubsubServiceMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMSGateway := smsGatewayMock.NewSMSGatewayMock()
+
+			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification, fakeSMSGateway)
+
+			t.Setenv(helpers.AppointmentReminderHour, "0")
+
+			if tt.name == "Happy case: reminders are not sent before the reminder hour" {
+				// shift the reminder time zone so that it is currently midnight and the reminder hour is yet to come
+				utcHour := time.Now().UTC().Hour()
+				utcOffset := -utcHour
+				if utcHour > 12 {
+					utcOffset = 24 - utcHour
+				}
+				t.Setenv(helpers.AppointmentReminderHour, "1")
+				t.Setenv(helpers.AppointmentsUTCOffsetHours, strconv.Itoa(utcOffset))
+
+				fakeDB.MockListAppointmentsPendingReminderFn = func(ctx context.Context, appointmentDate time.Time, offsetDays int) ([]*domain.Appointment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Happy case: client opted out of appointment notifications" {
+				fakeDB.MockGetNotificationPreferencesFn = func(ctx context.Context, userID string) ([]*domain.NotificationPreference, error) {
+					return []*domain.NotificationPreference{
+						{
+							UserID:           userID,
+							NotificationType: enums.NotificationTypeAppointment,
+							PushEnabled:      false,
+							SMSEnabled:       false,
+						},
+					}, nil
+				}
+				fakeNotification.MockNotifyUserFn = func(ctx context.Context, userProfile *domain.User, notificationPayload *domain.Notification) error {
+					return fmt.Errorf("an error occurred")
+				}
+				fakeSMSGateway.MockSendSMSFn = func(ctx context.Context, phoneNumber, message string) (*domain.SMSDelivery, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Happy case: phone number opted out of SMS" {
+				fakeDB.MockGetContactByUserIDFn = func(ctx context.Context, userID *string, contactType string) (*domain.Contact, error) {
+					return &domain.Contact{
+						ContactType:  "PHONE",
+						ContactValue: gofakeit.Phone(),
+						OptedIn:      false,
+					}, nil
+				}
+				fakeSMSGateway.MockSendSMSFn = func(ctx context.Context, phoneNumber, message string) (*domain.SMSDelivery, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Happy case: failed to send SMS reminder" {
+				fakeSMSGateway.MockSendSMSFn = func(ctx context.Context, phoneNumber, message string) (*domain.SMSDelivery, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to list appointments pending reminder" {
+				fakeDB.MockListAppointmentsPendingReminderFn = func(ctx context.Context, appointmentDate time.Time, offsetDays int) ([]*domain.Appointment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get client profile" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get notification preferences" {
+				fakeDB.MockGetNotificationPreferencesFn = func(ctx context.Context, userID string) ([]*domain.NotificationPreference, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to notify client" {
+				fakeNotification.MockNotifyUserFn = func(ctx context.Context, userProfile *domain.User, notificationPayload *domain.Notification) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to record reminder" {
+				fakeDB.MockCreateAppointmentReminderFn = func(ctx context.Context, reminder *domain.AppointmentReminder) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			if err := a.SendAppointmentReminders(tt.args.ctx); (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesAppointmentsImpl.SendAppointmentReminders() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUseCasesAppointmentsImpl_DetectMissedAppointments(t *testing.T) {
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: detect missed appointments",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: no missed appointments",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: failed to notify facility staff",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: failed to list missed appointments",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get client profile",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to mark appointment as missed",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakePubsub := pubsubMock.NewPubsubServiceMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMSGateway := smsGatewayMock.NewSMSGatewayMock()
+
+			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification, fakeSMSGateway)
+
+			if tt.name == "Happy case: no missed appointments" {
+				fakeDB.MockListMissedAppointmentsFn = func(ctx context.Context, before time.Time) ([]*domain.Appointment, error) {
+					return []*domain.Appointment{}, nil
+				}
+				fakeDB.MockMarkAppointmentMissedFn = func(ctx context.Context, appointmentID string, serviceRequestInput *dto.ServiceRequestInput) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Happy case: failed to notify facility staff" {
+				fakeNotification.MockNotifyFacilityStaffsFn = func(ctx context.Context, facility *domain.Facility, notificationPayload *domain.Notification) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to list missed appointments" {
+				fakeDB.MockListMissedAppointmentsFn = func(ctx context.Context, before time.Time) ([]*domain.Appointment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get client profile" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to mark appointment as missed" {
+				fakeDB.MockMarkAppointmentMissedFn = func(ctx context.Context, appointmentID string, serviceRequestInput *dto.ServiceRequestInput) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			if err := a.DetectMissedAppointments(tt.args.ctx); (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesAppointmentsImpl.DetectMissedAppointments() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUseCasesAppointmentsImpl_CreateAppointmentSlot(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input dto.AppointmentSlotInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create appointment slot",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentSlotInput{
+					FacilityID: uuid.New().String(),
+					DayOfWeek:  int(time.Monday),
+					StartTime:  "08:00",
+					EndTime:    "12:00",
+					Capacity:   10,
+					Reasons:    []string{"Pharmacy Visit"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid input",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentSlotInput{
+					FacilityID: uuid.New().String(),
+					DayOfWeek:  int(time.Monday),
+					StartTime:  "12:00",
+					EndTime:    "08:00",
+					Capacity:   10,
+					Reasons:    []string{"Pharmacy Visit"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: facility not found",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentSlotInput{
+					FacilityID: uuid.New().String(),
+					DayOfWeek:  int(time.Monday),
+					StartTime:  "08:00",
+					EndTime:    "12:00",
+					Capacity:   10,
+					Reasons:    []string{"Pharmacy Visit"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get logged in user",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentSlotInput{
+					FacilityID: uuid.New().String(),
+					DayOfWeek:  int(time.Monday),
+					StartTime:  "08:00",
+					EndTime:    "12:00",
+					Capacity:   10,
+					Reasons:    []string{"Pharmacy Visit"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get user profile",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentSlotInput{
+					FacilityID: uuid.New().String(),
+					DayOfWeek:  int(time.Monday),
+					StartTime:  "08:00",
+					EndTime:    "12:00",
+					Capacity:   10,
+					Reasons:    []string{"Pharmacy Visit"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get staff profile",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentSlotInput{
+					FacilityID: uuid.New().String(),
+					DayOfWeek:  int(time.Monday),
+					StartTime:  "08:00",
+					EndTime:    "12:00",
+					Capacity:   10,
+					Reasons:    []string{"Pharmacy Visit"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get staff facilities",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentSlotInput{
+					FacilityID: uuid.New().String(),
+					DayOfWeek:  int(time.Monday),
+					StartTime:  "08:00",
+					EndTime:    "12:00",
+					Capacity:   10,
+					Reasons:    []string{"Pharmacy Visit"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: staff not assigned to the facility",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentSlotInput{
+					FacilityID: uuid.New().String(),
+					DayOfWeek:  int(time.Monday),
+					StartTime:  "08:00",
+					EndTime:    "12:00",
+					Capacity:   10,
+					Reasons:    []string{"Pharmacy Visit"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create appointment slot",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentSlotInput{
+					FacilityID: uuid.New().String(),
+					DayOfWeek:  int(time.Monday),
+					StartTime:  "08:00",
+					EndTime:    "12:00",
+					Capacity:   10,
+					Reasons:    []string{"Pharmacy Visit"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakePubsub := pubsubMock.NewPubsubServiceMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMSGateway := smsGatewayMock.NewSMSGatewayMock()
+
+			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification, fakeSMSGateway)
+
+			if tt.name == "Sad case: failed to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get user profile" {
+				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get staff profile" {
+				fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID string, programID string) (*domain.StaffProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get staff facilities" {
+				fakeDB.MockGetStaffFacilitiesFn = func(ctx context.Context, input dto.StaffFacilityInput, pagination *domain.Pagination) ([]*domain.Facility, *domain.Pagination, error) {
+					return nil, nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: staff not assigned to the facility" {
+				fakeDB.MockGetStaffFacilitiesFn = func(ctx context.Context, input dto.StaffFacilityInput, pagination *domain.Pagination) ([]*domain.Facility, *domain.Pagination, error) {
+					return []*domain.Facility{}, nil, nil
+				}
+			}
+
+			if tt.name == "Sad case: facility not found" {
+				fakeDB.MockRetrieveFacilityFn = func(ctx context.Context, id *string, isActive bool) (*domain.Facility, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to create appointment slot" {
+				fakeDB.MockCreateAppointmentSlotFn = func(ctx context.Context, slot *domain.AppointmentSlot) (*domain.AppointmentSlot, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := a.CreateAppointmentSlot(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesAppointmentsImpl.CreateAppointmentSlot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected an appointment slot to be returned")
+			}
+		})
+	}
+}
+
+func TestUseCasesAppointmentsImpl_ListFacilityAppointmentSlots(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		facilityID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list facility appointment slots",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: uuid.New().String(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: failed to list facility appointment slots",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakePubsub := pubsubMock.NewPubsubServiceMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMSGateway := smsGatewayMock.NewSMSGatewayMock()
+
+			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification, fakeSMSGateway)
+
+			if tt.name == "Sad case: failed to list facility appointment slots" {
+				fakeDB.MockListAppointmentSlotsFn = func(ctx context.Context, facilityID string) ([]*domain.AppointmentSlot, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			_, err := a.ListFacilityAppointmentSlots(tt.args.ctx, tt.args.facilityID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesAppointmentsImpl.ListFacilityAppointmentSlots() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUseCasesAppointmentsImpl_DeleteAppointmentSlot(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		slotID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "Happy case: delete appointment slot",
+			args: args{
+				ctx:    context.Background(),
+				slotID: uuid.New().String(),
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Sad case: appointment slot not found",
+			args: args{
+				ctx:    context.Background(),
+				slotID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get logged in user",
+			args: args{
+				ctx:    context.Background(),
+				slotID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get user profile",
+			args: args{
+				ctx:    context.Background(),
+				slotID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get staff profile",
+			args: args{
+				ctx:    context.Background(),
+				slotID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get staff facilities",
+			args: args{
+				ctx:    context.Background(),
+				slotID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: staff not assigned to the facility",
+			args: args{
+				ctx:    context.Background(),
+				slotID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to deactivate appointment slot",
+			args: args{
+				ctx:    context.Background(),
+				slotID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakePubsub := pubsubMock.NewPubsubServiceMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMSGateway := smsGatewayMock.NewSMSGatewayMock()
+
+			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification, fakeSMSGateway)
+
+			if tt.name == "Sad case: failed to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get user profile" {
+				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get staff profile" {
+				fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID string, programID string) (*domain.StaffProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get staff facilities" {
+				fakeDB.MockGetStaffFacilitiesFn = func(ctx context.Context, input dto.StaffFacilityInput, pagination *domain.Pagination) ([]*domain.Facility, *domain.Pagination, error) {
+					return nil, nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: staff not assigned to the facility" {
+				fakeDB.MockGetStaffFacilitiesFn = func(ctx context.Context, input dto.StaffFacilityInput, pagination *domain.Pagination) ([]*domain.Facility, *domain.Pagination, error) {
+					return []*domain.Facility{}, nil, nil
+				}
+			}
+
+			if tt.name == "Sad case: appointment slot not found" {
+				fakeDB.MockGetAppointmentSlotFn = func(ctx context.Context, slotID string) (*domain.AppointmentSlot, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to deactivate appointment slot" {
+				fakeDB.MockDeactivateAppointmentSlotFn = func(ctx context.Context, slotID string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := a.DeleteAppointmentSlot(tt.args.ctx, tt.args.slotID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesAppointmentsImpl.DeleteAppointmentSlot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("UseCasesAppointmentsImpl.DeleteAppointmentSlot() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUseCasesAppointmentsImpl_ListAvailableAppointmentSlots(t *testing.T) {
+	today := appointmentsToday()
+	toDate := func(date time.Time) scalarutils.Date {
+		return scalarutils.Date{Year: date.Year(), Month: int(date.Month()), Day: date.Day()}
+	}
+	otherReason := "Counselling"
+	pharmacyReason := "pharmacy visit"
+
+	type args struct {
+		ctx        context.Context
+		facilityID string
+		startDate  scalarutils.Date
+		endDate    scalarutils.Date
+		reason     *string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name: "Happy case: list available appointment slots",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: uuid.New().String(),
+				startDate:  toDate(today),
+				endDate:    toDate(today.AddDate(0, 0, 6)),
+			},
+			wantCount: 7,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: filter available appointment slots by reason",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: uuid.New().String(),
+				startDate:  toDate(today),
+				endDate:    toDate(today.AddDate(0, 0, 6)),
+				reason:     &pharmacyReason,
+			},
+			wantCount: 7,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: no slots accept the reason",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: uuid.New().String(),
+				startDate:  toDate(today),
+				endDate:    toDate(today.AddDate(0, 0, 6)),
+				reason:     &otherReason,
+			},
+			wantCount: 0,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: fully booked slots are skipped",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: uuid.New().String(),
+				startDate:  toDate(today),
+				endDate:    toDate(today.AddDate(0, 0, 6)),
+			},
+			wantCount: 6,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: past dates are skipped",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: uuid.New().String(),
+				startDate:  toDate(today.AddDate(0, 0, -10)),
+				endDate:    toDate(today.AddDate(0, 0, -4)),
+			},
+			wantCount: 0,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: dates beyond the booking window are skipped",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: uuid.New().String(),
+				startDate:  toDate(today.AddDate(0, 0, appointmentBookingWindowDays-1)),
+				endDate:    toDate(today.AddDate(0, 0, appointmentBookingWindowDays+10)),
+			},
+			wantCount: 2,
+			wantErr:   false,
+		},
+		{
+			name: "Sad case: end date before start date",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: uuid.New().String(),
+				startDate:  toDate(today.AddDate(0, 0, 6)),
+				endDate:    toDate(today),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to list appointment slots",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: uuid.New().String(),
+				startDate:  toDate(today),
+				endDate:    toDate(today.AddDate(0, 0, 6)),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get appointment slot booking counts",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: uuid.New().String(),
+				startDate:  toDate(today),
+				endDate:    toDate(today.AddDate(0, 0, 6)),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakePubsub := pubsubMock.NewPubsubServiceMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMSGateway := smsGatewayMock.NewSMSGatewayMock()
+
+			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification, fakeSMSGateway)
+
+			if tt.name == "Happy case: fully booked slots are skipped" {
+				slots, _ := fakeDB.MockListAppointmentSlotsFn(tt.args.ctx, tt.args.facilityID)
+				fakeDB.MockGetAppointmentSlotBookingCountsFn = func(ctx context.Context, facilityID string, startDate, endDate time.Time) ([]*domain.AppointmentSlotBookingCount, error) {
+					return []*domain.AppointmentSlotBookingCount{
+						{
+							SlotID: slots[0].ID,
+							Date:   startDate,
+							Count:  10,
+						},
+					}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to list appointment slots" {
+				fakeDB.MockListAppointmentSlotsFn = func(ctx context.Context, facilityID string) ([]*domain.AppointmentSlot, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get appointment slot booking counts" {
+				fakeDB.MockGetAppointmentSlotBookingCountsFn = func(ctx context.Context, facilityID string, startDate, endDate time.Time) ([]*domain.AppointmentSlotBookingCount, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := a.ListAvailableAppointmentSlots(tt.args.ctx, tt.args.facilityID, tt.args.startDate, tt.args.endDate, tt.args.reason)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesAppointmentsImpl.ListAvailableAppointmentSlots() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) != tt.wantCount {
+				t.Errorf("UseCasesAppointmentsImpl.ListAvailableAppointmentSlots() returned %v slots, want %v", len(got), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestUseCasesAppointmentsImpl_BookAppointment(t *testing.T) {
+	bookingDate := appointmentsToday().AddDate(0, 0, 1)
+	date := scalarutils.Date{Year: bookingDate.Year(), Month: int(bookingDate.Month()), Day: bookingDate.Day()}
+	pastDate := scalarutils.Date{Year: bookingDate.Year() - 1, Month: int(bookingDate.Month()), Day: bookingDate.Day()}
+	slotID := uuid.New().String()
+
+	type args struct {
+		ctx      context.Context
+		clientID string
+		slotID   string
+		date     scalarutils.Date
+		reason   string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: book appointment",
+			args: args{
+				ctx:      context.Background(),
+				clientID: uuid.New().String(),
+				slotID:   slotID,
+				date:     date,
+				reason:   "Pharmacy Visit",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: failed to get client profile",
+			args: args{
+				ctx:      context.Background(),
+				clientID: uuid.New().String(),
+				slotID:   slotID,
+				date:     date,
+				reason:   "Pharmacy Visit",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get appointment slot",
+			args: args{
+				ctx:      context.Background(),
+				clientID: uuid.New().String(),
+				slotID:   slotID,
+				date:     date,
+				reason:   "Pharmacy Visit",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: appointment slot is inactive",
+			args: args{
+				ctx:      context.Background(),
+				clientID: uuid.New().String(),
+				slotID:   slotID,
+				date:     date,
+				reason:   "Pharmacy Visit",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: appointment slot is not at the client's facility",
+			args: args{
+				ctx:      context.Background(),
+				clientID: uuid.New().String(),
+				slotID:   slotID,
+				date:     date,
+				reason:   "Pharmacy Visit",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: appointment slot is not available on the day",
+			args: args{
+				ctx:      context.Background(),
+				clientID: uuid.New().String(),
+				slotID:   slotID,
+				date:     date,
+				reason:   "Pharmacy Visit",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: date has passed",
+			args: args{
+				ctx:      context.Background(),
+				clientID: uuid.New().String(),
+				slotID:   slotID,
+				date:     pastDate,
+				reason:   "Pharmacy Visit",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: appointment slot does not accept the reason",
+			args: args{
+				ctx:      context.Background(),
+				clientID: uuid.New().String(),
+				slotID:   slotID,
+				date:     date,
+				reason:   "Counselling",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: appointment slot is fully booked",
+			args: args{
+				ctx:      context.Background(),
+				clientID: uuid.New().String(),
+				slotID:   slotID,
+				date:     date,
+				reason:   "Pharmacy Visit",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to book appointment slot",
+			args: args{
+				ctx:      context.Background(),
+				clientID: uuid.New().String(),
+				slotID:   slotID,
+				date:     date,
+				reason:   "Pharmacy Visit",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get logged in user",
+			args: args{
+				ctx:      context.Background(),
+				clientID: uuid.New().String(),
+				slotID:   slotID,
+				date:     date,
+				reason:   "Pharmacy Visit",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: book an appointment for another client",
+			args: args{
+				ctx:      context.Background(),
+				clientID: uuid.New().String(),
+				slotID:   slotID,
+				date:     date,
+				reason:   "Pharmacy Visit",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakePubsub := pubsubMock.NewPubsubServiceMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMSGateway := smsGatewayMock.NewSMSGatewayMock()
+
+			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification, fakeSMSGateway)
+
+			userID := uuid.New().String()
+			fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+				return userID, nil
+			}
+
+			facilityID := uuid.New().String()
+			fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+				return &domain.ClientProfile{
+					ID:     &clientID,
+					UserID: userID,
+					User:   &domain.User{ID: &userID, Name: gofakeit.Name()},
+					DefaultFacility: &domain.Facility{
+						ID: &facilityID,
+					},
+				}, nil
+			}
+
+			slot := &domain.AppointmentSlot{
+				ID:         slotID,
+				Active:     true,
+				FacilityID: facilityID,
+				DayOfWeek:  int(bookingDate.Weekday()),
+				StartTime:  "08:00",
+				EndTime:    "12:00",
+				Capacity:   10,
+				Reasons:    []string{"Pharmacy Visit"},
+			}
+			fakeDB.MockGetAppointmentSlotFn = func(ctx context.Context, slotID string) (*domain.AppointmentSlot, error) {
+				return slot, nil
+			}
+
+			if tt.name == "Sad case: failed to get client profile" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get appointment slot" {
+				fakeDB.MockGetAppointmentSlotFn = func(ctx context.Context, slotID string) (*domain.AppointmentSlot, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: appointment slot is inactive" {
+				slot.Active = false
+			}
+			if tt.name == "Sad case: appointment slot is not at the client's facility" {
+				slot.FacilityID = uuid.New().String()
+			}
+			if tt.name == "Sad case: appointment slot is not available on the day" {
+				slot.DayOfWeek = int(bookingDate.AddDate(0, 0, 1).Weekday())
+			}
+			if tt.name == "Sad case: appointment slot is fully booked" {
+				fakeDB.MockBookAppointmentSlotFn = func(ctx context.Context, appointment *domain.Appointment, serviceRequestInput *dto.ServiceRequestInput) (*domain.Appointment, error) {
+					return nil, fmt.Errorf("failed to book: %w", domain.ErrAppointmentSlotFullyBooked)
+				}
+			}
+			if tt.name == "Sad case: failed to book appointment slot" {
+				fakeDB.MockBookAppointmentSlotFn = func(ctx context.Context, appointment *domain.Appointment, serviceRequestInput *dto.ServiceRequestInput) (*domain.Appointment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: book an appointment for another client" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return uuid.New().String(), nil
+				}
+			}
+
+			got, err := a.BookAppointment(tt.args.ctx, tt.args.clientID, tt.args.slotID, tt.args.date, tt.args.reason)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesAppointmentsImpl.BookAppointment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got == nil || got.SlotID == nil || *got.SlotID != slotID) {
+				t.Errorf("expected an appointment booked against slot %s to be returned", slotID)
+			}
+		})
+	}
+}
+
+func TestUseCasesAppointmentsImpl_CancelAppointment(t *testing.T) {
+	slotID := uuid.New().String()
+
+	type args struct {
+		ctx           context.Context
+		appointmentID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "Happy case: cancel appointment",
+			args: args{
+				ctx:           context.Background(),
+				appointmentID: uuid.New().String(),
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Sad case: failed to get appointment",
+			args: args{
+				ctx:           context.Background(),
+				appointmentID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: appointment was not booked against a slot",
+			args: args{
+				ctx:           context.Background(),
+				appointmentID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get client profile",
+			args: args{
+				ctx:           context.Background(),
+				appointmentID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to cancel appointment booking",
+			args: args{
+				ctx:           context.Background(),
+				appointmentID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get logged in user",
+			args: args{
+				ctx:           context.Background(),
+				appointmentID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: cancel another client's appointment",
+			args: args{
+				ctx:           context.Background(),
+				appointmentID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakePubsub := pubsubMock.NewPubsubServiceMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMSGateway := smsGatewayMock.NewSMSGatewayMock()
+
+			a := NewUseCaseAppointmentsImpl(fakeExtension, fakeDB, fakeDB, fakeDB, fakePubsub, fakeNotification, fakeSMSGateway)
+
+			userID := uuid.New().String()
+			fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+				return userID, nil
+			}
+			fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+				return &domain.ClientProfile{
+					ID:     &clientID,
+					UserID: userID,
+					User:   &domain.User{ID: &userID, Name: gofakeit.Name()},
+				}, nil
+			}
+
+			fakeDB.MockGetAppointmentFn = func(ctx context.Context, params domain.Appointment) (*domain.Appointment, error) {
+				return &domain.Appointment{
+					ID:         params.ID,
+					Reason:     "Pharmacy Visit",
+					Date:       scalarutils.Date{Year: 2030, Month: 1, Day: 1},
+					ClientID:   uuid.New().String(),
+					FacilityID: uuid.New().String(),
+					SlotID:     &slotID,
+				}, nil
+			}
+
+			if tt.name == "Sad case: failed to get appointment" {
+				fakeDB.MockGetAppointmentFn = func(ctx context.Context, params domain.Appointment) (*domain.Appointment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: appointment was not booked against a slot" {
+				fakeDB.MockGetAppointmentFn = func(ctx context.Context, params domain.Appointment) (*domain.Appointment, error) {
+					return &domain.Appointment{ID: params.ID}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to get client profile" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to cancel appointment booking" {
+				fakeDB.MockCancelAppointmentBookingFn = func(ctx context.Context, appointmentID string, serviceRequestInput *dto.ServiceRequestInput) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: cancel another client's appointment" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return uuid.New().String(), nil
+				}
+			}
+
+			got, err := a.CancelAppointment(tt.args.ctx, tt.args.appointmentID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesAppointmentsImpl.CancelAppointment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("UseCasesAppointmentsImpl.CancelAppointment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -24,6 +24,14 @@ type AppointmentsUseCaseMock struct {
 	MockFetchClientAppointmentsFn            func(ctx context.Context, clientID string, paginationInput dto.PaginationsInput, filters []*firebasetools.FilterParam) (*domain.AppointmentsPage, error)
 	MockGetAppointmentServiceRequestsFn      func(ctx context.Context, payload dto.AppointmentServiceRequestInput) (*dto.AppointmentServiceRequestsOutput, error)
 	MockNextRefillFn                         func(ctx context.Context, clientID string) (*scalarutils.Date, error)
+	MockSendAppointmentRemindersFn           func(ctx context.Context) error
+	MockDetectMissedAppointmentsFn           func(ctx context.Context) error
+	MockCreateAppointmentSlotFn              func(ctx context.Context, input dto.AppointmentSlotInput) (*domain.AppointmentSlot, error)
+	MockListFacilityAppointmentSlotsFn       func(ctx context.Context, facilityID string) ([]*domain.AppointmentSlot, error)
+	MockDeleteAppointmentSlotFn              func(ctx context.Context, slotID string) (bool, error)
+	MockListAvailableAppointmentSlotsFn      func(ctx context.Context, facilityID string, startDate, endDate scalarutils.Date, reason *string) ([]*domain.AvailableAppointmentSlot, error)
+	MockBookAppointmentFn                    func(ctx context.Context, clientID, slotID string, date scalarutils.Date, reason string) (*domain.Appointment, error)
+	MockCancelAppointmentFn                  func(ctx context.Context, appointmentID string) (bool, error)
 }
 
 // NewAppointmentsUseCaseMock creates in itializes create type mocks
@@ -31,6 +39,16 @@ func NewAppointmentsUseCaseMock() *AppointmentsUseCaseMock {
 	now := time.Now()
 	UUID := gofakeit.UUID()
 	contact := "0999999999"
+	appointmentSlot := domain.AppointmentSlot{
+		ID:         UUID,
+		Active:     true,
+		FacilityID: UUID,
+		DayOfWeek:  int(now.Weekday()),
+		StartTime:  "08:00",
+		EndTime:    "12:00",
+		Capacity:   10,
+		Reasons:    []string{"Pharmacy Visit"},
+	}
 	appointmentsPayload := dto.AppointmentPayload{
 		CCCNumber:  "2121221",
 		ExternalID: uuid.NewString(),
@@ -136,6 +154,47 @@ func NewAppointmentsUseCaseMock() *AppointmentsUseCaseMock {
 		MockNextRefillFn: func(ctx context.Context, clientID string) (*scalarutils.Date, error) {
 			return &date, nil
 		},
+		MockSendAppointmentRemindersFn: func(ctx context.Context) error {
+			return nil
+		},
+		MockDetectMissedAppointmentsFn: func(ctx context.Context) error {
+			return nil
+		},
+		MockCreateAppointmentSlotFn: func(ctx context.Context, input dto.AppointmentSlotInput) (*domain.AppointmentSlot, error) {
+			return &appointmentSlot, nil
+		},
+		MockListFacilityAppointmentSlotsFn: func(ctx context.Context, facilityID string) ([]*domain.AppointmentSlot, error) {
+			return []*domain.AppointmentSlot{&appointmentSlot}, nil
+		},
+		MockDeleteAppointmentSlotFn: func(ctx context.Context, slotID string) (bool, error) {
+			return true, nil
+		},
+		MockListAvailableAppointmentSlotsFn: func(ctx context.Context, facilityID string, startDate, endDate scalarutils.Date, reason *string) ([]*domain.AvailableAppointmentSlot, error) {
+			return []*domain.AvailableAppointmentSlot{
+				{
+					SlotID:    appointmentSlot.ID,
+					Date:      startDate,
+					StartTime: appointmentSlot.StartTime,
+					EndTime:   appointmentSlot.EndTime,
+					Reasons:   appointmentSlot.Reasons,
+					Capacity:  appointmentSlot.Capacity,
+					Available: appointmentSlot.Capacity,
+				},
+			}, nil
+		},
+		MockBookAppointmentFn: func(ctx context.Context, clientID, slotID string, date scalarutils.Date, reason string) (*domain.Appointment, error) {
+			return &domain.Appointment{
+				ID:         UUID,
+				Reason:     reason,
+				Date:       date,
+				ClientID:   clientID,
+				FacilityID: appointmentSlot.FacilityID,
+				SlotID:     &slotID,
+			}, nil
+		},
+		MockCancelAppointmentFn: func(ctx context.Context, appointmentID string) (bool, error) {
+			return true, nil
+		},
 	}
 }
 
@@ -183,3 +242,43 @@ func (gm *AppointmentsUseCaseMock) GetAppointmentServiceRequests(ctx context.Con
 func (gm *AppointmentsUseCaseMock) NextRefill(ctx context.Context, clientID string) (*scalarutils.Date, error) {
 	return gm.MockNextRefillFn(ctx, clientID)
 }
+
+// SendAppointmentReminders mocks the implementation of sending appointment reminders
+func (gm *AppointmentsUseCaseMock) SendAppointmentReminders(ctx context.Context) error {
+	return gm.MockSendAppointmentRemindersFn(ctx)
+}
+
+// DetectMissedAppointments mocks the implementation of detecting missed appointments
+func (gm *AppointmentsUseCaseMock) DetectMissedAppointments(ctx context.Context) error {
+	return gm.MockDetectMissedAppointmentsFn(ctx)
+}
+
+// CreateAppointmentSlot mocks the implementation of creating an appointment slot
+func (gm *AppointmentsUseCaseMock) CreateAppointmentSlot(ctx context.Context, input dto.AppointmentSlotInput) (*domain.AppointmentSlot, error) {
+	return gm.MockCreateAppointmentSlotFn(ctx, input)
+}
+
+// ListFacilityAppointmentSlots mocks the implementation of listing the appointment slots of a facility
+func (gm *AppointmentsUseCaseMock) ListFacilityAppointmentSlots(ctx context.Context, facilityID string) ([]*domain.AppointmentSlot, error) {
+	return gm.MockListFacilityAppointmentSlotsFn(ctx, facilityID)
+}
+
+// DeleteAppointmentSlot mocks the implementation of deleting an appointment slot
+func (gm *AppointmentsUseCaseMock) DeleteAppointmentSlot(ctx context.Context, slotID string) (bool, error) {
+	return gm.MockDeleteAppointmentSlotFn(ctx, slotID)
+}
+
+// ListAvailableAppointmentSlots mocks the implementation of listing the appointment slots that can be booked
+func (gm *AppointmentsUseCaseMock) ListAvailableAppointmentSlots(ctx context.Context, facilityID string, startDate, endDate scalarutils.Date, reason *string) ([]*domain.AvailableAppointmentSlot, error) {
+	return gm.MockListAvailableAppointmentSlotsFn(ctx, facilityID, startDate, endDate, reason)
+}
+
+// BookAppointment mocks the implementation of booking an appointment against a slot
+func (gm *AppointmentsUseCaseMock) BookAppointment(ctx context.Context, clientID, slotID string, date scalarutils.Date, reason string) (*domain.Appointment, error) {
+	return gm.MockBookAppointmentFn(ctx, clientID, slotID, date, reason)
+}
+
+// CancelAppointment mocks the implementation of cancelling a booked appointment
+func (gm *AppointmentsUseCaseMock) CancelAppointment(ctx context.Context, appointmentID string) (bool, error) {
+	return gm.MockCancelAppointmentFn(ctx, appointmentID)
+}
@@ -1,34 +1,588 @@
 package authority
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/exceptions"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/authorization"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/notification"
 )
 
+// ICheckPermission checks whether the logged in user has been granted a permission
+type ICheckPermission interface {
+	CheckUserPermission(ctx context.Context, permission string) (bool, error)
+}
+
+// IAuditLog contains the methods used to view the audit log of sensitive changes
+type IAuditLog interface {
+	ListAuditLogs(ctx context.Context, filter *dto.AuditLogFilterInput, paginationInput *dto.PaginationsInput) (*domain.AuditLogPage, error)
+}
+
+// IRoleManagement contains the methods used to manage the custom roles of an organisation or program and their members
+type IRoleManagement interface {
+	CreateRole(ctx context.Context, input dto.AuthorityRoleInput) (*domain.AuthorityRole, error)
+	UpdateRole(ctx context.Context, roleID string, input dto.AuthorityRoleInput) (*domain.AuthorityRole, error)
+	DeleteRole(ctx context.Context, roleID string) (bool, error)
+	ListRoles(ctx context.Context) ([]*domain.AuthorityRole, error)
+	AssignRole(ctx context.Context, staffID string, roleID string) (bool, error)
+	RevokeRole(ctx context.Context, staffID string, roleID string) (bool, error)
+	ListRoleMembers(ctx context.Context, roleID string, paginationInput *dto.PaginationsInput) (*domain.AuthorityRoleMembersPage, error)
+	AssignSystemRole(ctx context.Context, staffID string, organisationID string, systemRole authorization.DefaultRole) error
+}
+
 // UsecaseAuthority groups al the interfaces for the Authority usecase
 type UsecaseAuthority interface {
+	ICheckPermission
+	IAuditLog
+	IRoleManagement
 }
 
 // UsecaseAuthorityImpl represents the Authority implementation
 type UsecaseAuthorityImpl struct {
+	Create       infrastructure.Create
 	Query        infrastructure.Query
 	Update       infrastructure.Update
+	Delete       infrastructure.Delete
 	ExternalExt  extension.ExternalMethodsExtension
 	Notification notification.UseCaseNotification
 }
 
 // NewUsecaseAuthority is the controller function for the Authority usecase
 func NewUsecaseAuthority(
+	create infrastructure.Create,
 	query infrastructure.Query,
 	update infrastructure.Update,
+	delete infrastructure.Delete,
 	externalExt extension.ExternalMethodsExtension,
 	notification notification.UseCaseNotification,
 ) *UsecaseAuthorityImpl {
 	return &UsecaseAuthorityImpl{
+		Create:       create,
 		Query:        query,
 		Update:       update,
+		Delete:       delete,
 		ExternalExt:  externalExt,
 		Notification: notification,
 	}
 }
+
+// CheckUserPermission checks whether the logged in user has the provided permission scope in their current program.
+// Clients and caregivers are granted the permissions of the default role for their user type as well as the permissions
+// of any role they have been assigned in the program. Staff are only granted the permissions of the roles they have been assigned
+func (u *UsecaseAuthorityImpl) CheckUserPermission(ctx context.Context, permission string) (bool, error) {
+	uid, err := u.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.GetLoggedInUserUIDErr(err)
+	}
+
+	user, err := u.Query.GetUserProfileByUserID(ctx, uid)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.UserNotFoundError(err)
+	}
+
+	if role, ok := authorization.DefaultUserTypeRole(enums.UsersType(user.CurrentUserType)); ok {
+		for _, rolePermission := range role.Permissions {
+			if rolePermission.Scope == permission {
+				return true, nil
+			}
+		}
+	}
+
+	if user.CurrentProgramID == "" {
+		return false, nil
+	}
+
+	permissions, err := u.Query.GetUserPermissions(ctx, uid, user.CurrentProgramID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.InternalErr(err)
+	}
+
+	for _, userPermission := range permissions {
+		if userPermission.Scope == permission {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ListAuditLogs returns a paginated list of the sensitive changes recorded in the logged in user's current organisation
+func (u *UsecaseAuthorityImpl) ListAuditLogs(ctx context.Context, filter *dto.AuditLogFilterInput, paginationInput *dto.PaginationsInput) (*domain.AuditLogPage, error) {
+	if err := paginationInput.Validate(); err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.InputValidationErr(err)
+	}
+
+	page := &domain.Pagination{
+		Limit:       paginationInput.Limit,
+		CurrentPage: paginationInput.CurrentPage,
+	}
+
+	uid, err := u.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.GetLoggedInUserUIDErr(err)
+	}
+
+	user, err := u.Query.GetUserProfileByUserID(ctx, uid)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.UserNotFoundError(err)
+	}
+
+	auditLogs, pageInfo, err := u.Query.ListAuditLogs(ctx, user.CurrentOrganizationID, filter, page)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.InternalErr(err)
+	}
+
+	return &domain.AuditLogPage{
+		Pagination: *pageInfo,
+		AuditLogs:  auditLogs,
+	}, nil
+}
+
+// CreateRole creates a custom staff role in the logged in user's current organisation. When a program is provided, the role
+// only applies to the staff of that program otherwise it applies to all the programs in the organisation
+func (u *UsecaseAuthorityImpl) CreateRole(ctx context.Context, input dto.AuthorityRoleInput) (*domain.AuthorityRole, error) {
+	if err := input.Validate(); err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.InputValidationErr(err)
+	}
+
+	permissions, err := rolePermissions(ctx, input.Permissions)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.InputValidationErr(err)
+	}
+
+	user, err := u.getLoggedInUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role := &domain.AuthorityRole{
+		Name:           input.Name,
+		Description:    input.Description,
+		Active:         true,
+		OrganisationID: user.CurrentOrganizationID,
+		Permissions:    permissions,
+	}
+
+	if input.ProgramID != nil && *input.ProgramID != "" {
+		program, err := u.Query.GetProgramByID(ctx, *input.ProgramID)
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			return nil, exceptions.InputValidationErr(err)
+		}
+		if program.Organisation.ID != user.CurrentOrganizationID {
+			err := fmt.Errorf("program %s does not belong to the organisation", *input.ProgramID)
+			helpers.ReportErrorToSentry(err)
+			return nil, exceptions.InputValidationErr(err)
+		}
+
+		role.ProgramID = program.ID
+	}
+
+	createdRole, err := u.Create.CreateAuthorityRole(ctx, role)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.InternalErr(err)
+	}
+
+	return createdRole, nil
+}
+
+// UpdateRole updates the name, description and permissions of a custom role. The organisation or program that a role
+// applies to cannot be changed
+func (u *UsecaseAuthorityImpl) UpdateRole(ctx context.Context, roleID string, input dto.AuthorityRoleInput) (*domain.AuthorityRole, error) {
+	if err := input.Validate(); err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.InputValidationErr(err)
+	}
+
+	permissions, err := rolePermissions(ctx, input.Permissions)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.InputValidationErr(err)
+	}
+
+	user, err := u.getLoggedInUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := u.getOrganisationRole(ctx, user, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role.IsSystemRole {
+		err := fmt.Errorf("role %s is a system role", role.Name)
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.SystemRoleModificationErr(err)
+	}
+
+	if input.ProgramID != nil && *input.ProgramID != role.ProgramID {
+		err := fmt.Errorf("the program of role %s cannot be changed", role.Name)
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.InputValidationErr(err)
+	}
+
+	updateData := map[string]interface{}{
+		"name":        input.Name,
+		"description": input.Description,
+	}
+
+	if err := u.Update.UpdateAuthorityRole(ctx, roleID, updateData, permissions); err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.InternalErr(err)
+	}
+
+	role.Name = input.Name
+	role.Description = input.Description
+	role.Permissions = permissions
+
+	return role, nil
+}
+
+// DeleteRole deletes a custom role. The role is revoked from all the staff that had been assigned the role
+func (u *UsecaseAuthorityImpl) DeleteRole(ctx context.Context, roleID string) (bool, error) {
+	user, err := u.getLoggedInUser(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	role, err := u.getOrganisationRole(ctx, user, roleID)
+	if err != nil {
+		return false, err
+	}
+
+	if role.IsSystemRole {
+		err := fmt.Errorf("role %s is a system role", role.Name)
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.SystemRoleModificationErr(err)
+	}
+
+	if err := u.Delete.DeleteAuthorityRole(ctx, roleID); err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.InternalErr(err)
+	}
+
+	return true, nil
+}
+
+// ListRoles returns the roles of the logged in user's current program together with the roles that apply to all the
+// programs in their organisation
+func (u *UsecaseAuthorityImpl) ListRoles(ctx context.Context) ([]*domain.AuthorityRole, error) {
+	user, err := u.getLoggedInUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := u.Query.ListAuthorityRoles(ctx, user.CurrentOrganizationID, user.CurrentProgramID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.InternalErr(err)
+	}
+
+	return roles, nil
+}
+
+// AssignRole assigns a role to a staff in the role's organisation or program and notifies the staff of the new role
+func (u *UsecaseAuthorityImpl) AssignRole(ctx context.Context, staffID string, roleID string) (bool, error) {
+	user, role, staff, err := u.getRoleAssignment(ctx, staffID, roleID)
+	if err != nil {
+		return false, err
+	}
+
+	assigned, err := u.Query.CheckStaffHasRole(ctx, staffID, roleID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.InternalErr(err)
+	}
+	if assigned {
+		err := fmt.Errorf("staff has already been assigned the %s role", role.Name)
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.InvalidRoleAssignmentErr(err)
+	}
+
+	if err := u.Create.AssignStaffRole(ctx, staffID, roleID); err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.InternalErr(err)
+	}
+
+	u.recordRoleAssignment(ctx, user, role, staff, true)
+
+	return true, nil
+}
+
+// AssignSystemRole assigns one of the organisation wide system roles to a staff. The role is created with its default
+// permissions the first time it is assigned in an organisation
+func (u *UsecaseAuthorityImpl) AssignSystemRole(ctx context.Context, staffID string, organisationID string, systemRole authorization.DefaultRole) error {
+	definition, ok := authorization.SystemStaffRole(systemRole)
+	if !ok {
+		return fmt.Errorf("%s is not a staff system role", systemRole)
+	}
+
+	roles, err := u.Query.ListAuthorityRoles(ctx, organisationID, "")
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("failed to list organisation roles: %w", err)
+	}
+
+	var role *domain.AuthorityRole
+	for _, r := range roles {
+		if r.IsSystemRole && r.Name == definition.Name {
+			role = r
+			break
+		}
+	}
+
+	if role == nil {
+		role, err = u.Create.CreateAuthorityRole(ctx, &domain.AuthorityRole{
+			Name:           definition.Name,
+			Description:    definition.Description,
+			Active:         true,
+			IsSystemRole:   true,
+			OrganisationID: organisationID,
+			Permissions:    definition.Permissions,
+		})
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			return fmt.Errorf("failed to create the %s role: %w", definition.Name, err)
+		}
+	}
+
+	assigned, err := u.Query.CheckStaffHasRole(ctx, staffID, role.AuthorityRoleID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("failed to check the staff's roles: %w", err)
+	}
+	if assigned {
+		return nil
+	}
+
+	if err := u.Create.AssignStaffRole(ctx, staffID, role.AuthorityRoleID); err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("failed to assign the %s role: %w", definition.Name, err)
+	}
+
+	return nil
+}
+
+// RevokeRole revokes a role that had been assigned to a staff and notifies the staff that the role has been revoked
+func (u *UsecaseAuthorityImpl) RevokeRole(ctx context.Context, staffID string, roleID string) (bool, error) {
+	user, role, staff, err := u.getRoleAssignment(ctx, staffID, roleID)
+	if err != nil {
+		return false, err
+	}
+
+	assigned, err := u.Query.CheckStaffHasRole(ctx, staffID, roleID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.InternalErr(err)
+	}
+	if !assigned {
+		err := fmt.Errorf("staff has not been assigned the %s role", role.Name)
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.InvalidRoleAssignmentErr(err)
+	}
+
+	if err := u.Delete.RevokeStaffRole(ctx, staffID, roleID); err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.InternalErr(err)
+	}
+
+	u.recordRoleAssignment(ctx, user, role, staff, false)
+
+	return true, nil
+}
+
+// ListRoleMembers returns a paginated list of the staff that have been assigned a role
+func (u *UsecaseAuthorityImpl) ListRoleMembers(ctx context.Context, roleID string, paginationInput *dto.PaginationsInput) (*domain.AuthorityRoleMembersPage, error) {
+	if err := paginationInput.Validate(); err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.InputValidationErr(err)
+	}
+
+	page := &domain.Pagination{
+		Limit:       paginationInput.Limit,
+		CurrentPage: paginationInput.CurrentPage,
+	}
+
+	user, err := u.getLoggedInUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.getOrganisationRole(ctx, user, roleID); err != nil {
+		return nil, err
+	}
+
+	staff, pageInfo, err := u.Query.ListAuthorityRoleStaff(ctx, roleID, page)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.InternalErr(err)
+	}
+
+	return &domain.AuthorityRoleMembersPage{
+		Pagination: *pageInfo,
+		Staff:      staff,
+	}, nil
+}
+
+// getLoggedInUser retrieves the profile of the logged in user
+func (u *UsecaseAuthorityImpl) getLoggedInUser(ctx context.Context) (*domain.User, error) {
+	uid, err := u.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.GetLoggedInUserUIDErr(err)
+	}
+
+	user, err := u.Query.GetUserProfileByUserID(ctx, uid)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.UserNotFoundError(err)
+	}
+
+	return user, nil
+}
+
+// getOrganisationRole retrieves a role that belongs to the user's current organisation
+func (u *UsecaseAuthorityImpl) getOrganisationRole(ctx context.Context, user *domain.User, roleID string) (*domain.AuthorityRole, error) {
+	role, err := u.Query.GetAuthorityRoleByID(ctx, roleID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.RoleNotFoundErr(err)
+	}
+
+	if role.OrganisationID != user.CurrentOrganizationID {
+		err := fmt.Errorf("role %s does not belong to organisation %s", roleID, user.CurrentOrganizationID)
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.RoleNotFoundErr(err)
+	}
+
+	return role, nil
+}
+
+// getRoleAssignment retrieves the logged in user, the role and the staff whose role is being changed. The staff must
+// belong to the role's organisation and, for program roles, to the role's program. The superuser's role cannot be
+// assigned or revoked
+func (u *UsecaseAuthorityImpl) getRoleAssignment(ctx context.Context, staffID string, roleID string) (*domain.User, *domain.AuthorityRole, *domain.StaffProfile, error) {
+	user, err := u.getLoggedInUser(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	role, err := u.getOrganisationRole(ctx, user, roleID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if role.IsSystemRole && role.Name == authorization.DefaultRoleSuperUser.String() {
+		err := fmt.Errorf("the %s role can only be held by the platform superuser", role.Name)
+		helpers.ReportErrorToSentry(err)
+		return nil, nil, nil, exceptions.InvalidRoleAssignmentErr(err)
+	}
+
+	staff, err := u.Query.GetStaffProfileByStaffID(ctx, staffID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, nil, nil, exceptions.StaffProfileNotFoundErr(err)
+	}
+
+	if staff.OrganisationID != role.OrganisationID {
+		err := fmt.Errorf("staff does not belong to the organisation of the %s role", role.Name)
+		helpers.ReportErrorToSentry(err)
+		return nil, nil, nil, exceptions.InvalidRoleAssignmentErr(err)
+	}
+
+	if role.ProgramID != "" && staff.ProgramID != role.ProgramID {
+		err := fmt.Errorf("staff does not belong to the program of the %s role", role.Name)
+		helpers.ReportErrorToSentry(err)
+		return nil, nil, nil, exceptions.InvalidRoleAssignmentErr(err)
+	}
+
+	return user, role, staff, nil
+}
+
+// recordRoleAssignment records a change in a staff's roles in the audit log and notifies the staff of the change
+func (u *UsecaseAuthorityImpl) recordRoleAssignment(ctx context.Context, user *domain.User, role *domain.AuthorityRole, staff *domain.StaffProfile, assigned bool) {
+	notificationType := enums.NotificationTypeRoleRevocation
+	notes := "role revoked from staff"
+	if assigned {
+		notificationType = enums.NotificationTypeRoleAssignment
+		notes = "role assigned to staff"
+	}
+
+	auditLog := &domain.AuditLog{
+		RecordType: enums.AuditLogRecordTypeRoleAssignment,
+		Notes:      notes,
+		ActorID:    *user.ID,
+		TargetID:   *staff.ID,
+		TargetType: "STAFF",
+		OldValue: map[string]interface{}{
+			"roleID":   role.AuthorityRoleID,
+			"role":     role.Name,
+			"assigned": !assigned,
+		},
+		NewValue: map[string]interface{}{
+			"roleID":   role.AuthorityRoleID,
+			"role":     role.Name,
+			"assigned": assigned,
+		},
+		OrganisationID: role.OrganisationID,
+		ProgramID:      role.ProgramID,
+	}
+	if err := u.Create.CreateAuditLog(ctx, auditLog); err != nil {
+		helpers.ReportErrorToSentry(err)
+	}
+
+	if staff.User == nil {
+		return
+	}
+
+	notificationArgs := notification.StaffNotificationArgs{
+		Subject: user,
+		Role:    role,
+	}
+	message := notification.ComposeStaffNotification(notificationType, notificationArgs)
+	if err := u.Notification.NotifyUser(ctx, staff.User, message); err != nil {
+		helpers.ReportErrorToSentry(err)
+	}
+}
+
+// rolePermissions maps the provided permission scopes to the defined permissions
+func rolePermissions(ctx context.Context, scopes []string) ([]domain.AuthorityPermission, error) {
+	permissions := []domain.AuthorityPermission{}
+	seen := map[string]bool{}
+
+	for _, scope := range scopes {
+		if seen[scope] {
+			continue
+		}
+		seen[scope] = true
+
+		permission, ok := authorization.GetPermissionByScope(ctx, scope)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a valid permission", scope)
+		}
+		if authorization.IsPlatformPermission(scope) {
+			return nil, fmt.Errorf("%s can only be granted to the superuser", scope)
+		}
+		permissions = append(permissions, *permission)
+	}
+
+	return permissions, nil
+}
@@ -0,0 +1,1405 @@
+package authority_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	extensionMock "github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension/mock"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/authorization"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+	pgMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/mock"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/authority"
+	notificationMock "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/notification/mock"
+)
+
+func TestUsecaseAuthorityImpl_CheckUserPermission(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		permission string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "Happy case: permission granted by default user type role",
+			args: args{
+				ctx:        context.Background(),
+				permission: "healthdiary.create",
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Happy case: permission granted by assigned role",
+			args: args{
+				ctx:        context.Background(),
+				permission: "organisation.delete",
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Happy case: permission not granted",
+			args: args{
+				ctx:        context.Background(),
+				permission: "organisation.delete",
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "Happy case: staff without the permission is denied",
+			args: args{
+				ctx:        context.Background(),
+				permission: "organisation.delete",
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "Happy case: user without a current program",
+			args: args{
+				ctx:        context.Background(),
+				permission: "organisation.delete",
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get logged in user",
+			args: args{
+				ctx:        context.Background(),
+				permission: "organisation.delete",
+			},
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get user profile",
+			args: args{
+				ctx:        context.Background(),
+				permission: "organisation.delete",
+			},
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get user permissions",
+			args: args{
+				ctx:        context.Background(),
+				permission: "organisation.delete",
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			u := authority.NewUsecaseAuthority(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+				return &domain.User{
+					ID:               &userID,
+					CurrentUserType:  enums.ClientUser.String(),
+					CurrentProgramID: gofakeit.UUID(),
+				}, nil
+			}
+
+			if tt.name == "Happy case: permission granted by assigned role" {
+				fakeDB.MockGetUserPermissionsFn = func(ctx context.Context, userID string, programID string) ([]*domain.AuthorityPermission, error) {
+					return []*domain.AuthorityPermission{
+						{
+							PermissionID: gofakeit.UUID(),
+							Active:       true,
+							Scope:        "organisation.delete",
+						},
+					}, nil
+				}
+			}
+			if tt.name == "Happy case: permission not granted" {
+				fakeDB.MockGetUserPermissionsFn = func(ctx context.Context, userID string, programID string) ([]*domain.AuthorityPermission, error) {
+					return []*domain.AuthorityPermission{}, nil
+				}
+			}
+			if tt.name == "Happy case: staff without the permission is denied" {
+				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+					return &domain.User{
+						ID:               &userID,
+						CurrentUserType:  enums.StaffUser.String(),
+						CurrentProgramID: gofakeit.UUID(),
+					}, nil
+				}
+				fakeDB.MockGetUserPermissionsFn = func(ctx context.Context, userID string, programID string) ([]*domain.AuthorityPermission, error) {
+					return []*domain.AuthorityPermission{
+						{
+							PermissionID: gofakeit.UUID(),
+							Active:       true,
+							Scope:        "client.appointment.read",
+						},
+					}, nil
+				}
+			}
+			if tt.name == "Happy case: user without a current program" {
+				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+					return &domain.User{ID: &userID}, nil
+				}
+			}
+			if tt.name == "Sad case: unable to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get user profile" {
+				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get user permissions" {
+				fakeDB.MockGetUserPermissionsFn = func(ctx context.Context, userID string, programID string) ([]*domain.AuthorityPermission, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.CheckUserPermission(tt.args.ctx, tt.args.permission)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseAuthorityImpl.CheckUserPermission() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("UsecaseAuthorityImpl.CheckUserPermission() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUsecaseAuthorityImpl_ListAuditLogs(t *testing.T) {
+	recordType := enums.AuditLogRecordTypeClientFacilityTransfer
+
+	type args struct {
+		ctx             context.Context
+		filter          *dto.AuditLogFilterInput
+		paginationInput *dto.PaginationsInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list audit logs",
+			args: args{
+				ctx: context.Background(),
+				filter: &dto.AuditLogFilterInput{
+					RecordType: &recordType,
+				},
+				paginationInput: &dto.PaginationsInput{
+					Limit:       10,
+					CurrentPage: 1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid pagination input",
+			args: args{
+				ctx:             context.Background(),
+				paginationInput: &dto.PaginationsInput{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get logged in user",
+			args: args{
+				ctx: context.Background(),
+				paginationInput: &dto.PaginationsInput{
+					Limit:       10,
+					CurrentPage: 1,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get user profile",
+			args: args{
+				ctx: context.Background(),
+				paginationInput: &dto.PaginationsInput{
+					Limit:       10,
+					CurrentPage: 1,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to list audit logs",
+			args: args{
+				ctx: context.Background(),
+				paginationInput: &dto.PaginationsInput{
+					Limit:       10,
+					CurrentPage: 1,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			u := authority.NewUsecaseAuthority(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			if tt.name == "Sad case: unable to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get user profile" {
+				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to list audit logs" {
+				fakeDB.MockListAuditLogsFn = func(ctx context.Context, organisationID string, filter *dto.AuditLogFilterInput, pagination *domain.Pagination) ([]*domain.AuditLog, *domain.Pagination, error) {
+					return nil, nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.ListAuditLogs(tt.args.ctx, tt.args.filter, tt.args.paginationInput)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseAuthorityImpl.ListAuditLogs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected audit logs to be returned")
+			}
+		})
+	}
+}
+
+func TestUsecaseAuthorityImpl_CreateRole(t *testing.T) {
+	programID := gofakeit.UUID()
+
+	type args struct {
+		ctx   context.Context
+		input dto.AuthorityRoleInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create an organisation role",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AuthorityRoleInput{
+					Name:        "Organisation Auditor",
+					Permissions: []string{"organisation.auditlog.read", "organisation.read"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: create a program role",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AuthorityRoleInput{
+					Name:        "Facility Manager",
+					ProgramID:   &programID,
+					Permissions: []string{"facility.read", "facility.update", "facility.read"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid input",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AuthorityRoleInput{
+					Name: "Facility Manager",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid permission",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AuthorityRoleInput{
+					Name:        "Facility Manager",
+					Permissions: []string{"facility.invalid"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: platform permission",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AuthorityRoleInput{
+					Name:        "Organisation Manager",
+					Permissions: []string{"organisation.delete"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get logged in user",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AuthorityRoleInput{
+					Name:        "Facility Manager",
+					Permissions: []string{"facility.read"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get program",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AuthorityRoleInput{
+					Name:        "Facility Manager",
+					ProgramID:   &programID,
+					Permissions: []string{"facility.read"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: program belongs to another organisation",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AuthorityRoleInput{
+					Name:        "Facility Manager",
+					ProgramID:   &programID,
+					Permissions: []string{"facility.read"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to create role",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AuthorityRoleInput{
+					Name:        "Facility Manager",
+					Permissions: []string{"facility.read"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			u := authority.NewUsecaseAuthority(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			role, _ := fakeDB.GetAuthorityRoleByID(context.Background(), gofakeit.UUID())
+			fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+				return &domain.User{
+					ID:                    &userID,
+					Name:                  gofakeit.Name(),
+					CurrentOrganizationID: role.OrganisationID,
+					CurrentProgramID:      role.ProgramID,
+				}, nil
+			}
+
+			fakeDB.MockGetProgramByIDFn = func(ctx context.Context, programID string) (*domain.Program, error) {
+				return &domain.Program{
+					ID:           programID,
+					Active:       true,
+					Organisation: domain.Organisation{ID: role.OrganisationID},
+				}, nil
+			}
+
+			if tt.name == "Sad case: unable to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get program" {
+				fakeDB.MockGetProgramByIDFn = func(ctx context.Context, programID string) (*domain.Program, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: program belongs to another organisation" {
+				fakeDB.MockGetProgramByIDFn = func(ctx context.Context, programID string) (*domain.Program, error) {
+					return &domain.Program{
+						ID:           programID,
+						Organisation: domain.Organisation{ID: gofakeit.UUID()},
+					}, nil
+				}
+			}
+			if tt.name == "Sad case: unable to create role" {
+				fakeDB.MockCreateAuthorityRoleFn = func(ctx context.Context, role *domain.AuthorityRole) (*domain.AuthorityRole, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.CreateRole(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseAuthorityImpl.CreateRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.name == "Happy case: create a program role" {
+				if got.ProgramID != programID {
+					t.Errorf("UsecaseAuthorityImpl.CreateRole() programID = %v, want %v", got.ProgramID, programID)
+				}
+				if len(got.Permissions) != 2 {
+					t.Errorf("UsecaseAuthorityImpl.CreateRole() got %v permissions, want 2", len(got.Permissions))
+				}
+			}
+		})
+	}
+}
+
+func TestUsecaseAuthorityImpl_UpdateRole(t *testing.T) {
+	otherProgramID := gofakeit.UUID()
+
+	type args struct {
+		ctx    context.Context
+		roleID string
+		input  dto.AuthorityRoleInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update role",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+				input: dto.AuthorityRoleInput{
+					Name:        "Facility Manager",
+					Description: "Manages the facilities in a program",
+					Permissions: []string{"facility.read"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid input",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+				input: dto.AuthorityRoleInput{
+					Permissions: []string{"facility.read"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid permission",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+				input: dto.AuthorityRoleInput{
+					Name:        "Facility Manager",
+					Permissions: []string{"facility.invalid"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get user profile",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+				input: dto.AuthorityRoleInput{
+					Name:        "Facility Manager",
+					Permissions: []string{"facility.read"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get role",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+				input: dto.AuthorityRoleInput{
+					Name:        "Facility Manager",
+					Permissions: []string{"facility.read"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: role belongs to another organisation",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+				input: dto.AuthorityRoleInput{
+					Name:        "Facility Manager",
+					Permissions: []string{"facility.read"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: update a system role",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+				input: dto.AuthorityRoleInput{
+					Name:        "Facility Manager",
+					Permissions: []string{"facility.read"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: change role program",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+				input: dto.AuthorityRoleInput{
+					Name:        "Facility Manager",
+					ProgramID:   &otherProgramID,
+					Permissions: []string{"facility.read"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to update role",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+				input: dto.AuthorityRoleInput{
+					Name:        "Facility Manager",
+					Permissions: []string{"facility.read"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			u := authority.NewUsecaseAuthority(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			role, _ := fakeDB.GetAuthorityRoleByID(context.Background(), gofakeit.UUID())
+			fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+				return &domain.User{
+					ID:                    &userID,
+					Name:                  gofakeit.Name(),
+					CurrentOrganizationID: role.OrganisationID,
+					CurrentProgramID:      role.ProgramID,
+				}, nil
+			}
+
+			if tt.name == "Sad case: unable to get user profile" {
+				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get role" {
+				fakeDB.MockGetAuthorityRoleByIDFn = func(ctx context.Context, roleID string) (*domain.AuthorityRole, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: role belongs to another organisation" {
+				fakeDB.MockGetAuthorityRoleByIDFn = func(ctx context.Context, roleID string) (*domain.AuthorityRole, error) {
+					return &domain.AuthorityRole{
+						AuthorityRoleID: roleID,
+						OrganisationID:  gofakeit.UUID(),
+					}, nil
+				}
+			}
+			if tt.name == "Sad case: update a system role" {
+				fakeDB.MockGetAuthorityRoleByIDFn = func(ctx context.Context, roleID string) (*domain.AuthorityRole, error) {
+					return &domain.AuthorityRole{
+						AuthorityRoleID: roleID,
+						IsSystemRole:    true,
+						OrganisationID:  role.OrganisationID,
+					}, nil
+				}
+			}
+			if tt.name == "Sad case: unable to update role" {
+				fakeDB.MockUpdateAuthorityRoleFn = func(ctx context.Context, roleID string, updateData map[string]interface{}, permissions []domain.AuthorityPermission) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.UpdateRole(tt.args.ctx, tt.args.roleID, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseAuthorityImpl.UpdateRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.Name != tt.args.input.Name {
+				t.Errorf("UsecaseAuthorityImpl.UpdateRole() name = %v, want %v", got.Name, tt.args.input.Name)
+			}
+		})
+	}
+}
+
+func TestUsecaseAuthorityImpl_DeleteRole(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		roleID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "Happy case: delete role",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get logged in user",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get role",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: delete a system role",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to delete role",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			u := authority.NewUsecaseAuthority(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			role, _ := fakeDB.GetAuthorityRoleByID(context.Background(), gofakeit.UUID())
+			fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+				return &domain.User{
+					ID:                    &userID,
+					Name:                  gofakeit.Name(),
+					CurrentOrganizationID: role.OrganisationID,
+					CurrentProgramID:      role.ProgramID,
+				}, nil
+			}
+
+			if tt.name == "Sad case: unable to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get role" {
+				fakeDB.MockGetAuthorityRoleByIDFn = func(ctx context.Context, roleID string) (*domain.AuthorityRole, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: delete a system role" {
+				fakeDB.MockGetAuthorityRoleByIDFn = func(ctx context.Context, roleID string) (*domain.AuthorityRole, error) {
+					return &domain.AuthorityRole{
+						AuthorityRoleID: roleID,
+						IsSystemRole:    true,
+						OrganisationID:  role.OrganisationID,
+					}, nil
+				}
+			}
+			if tt.name == "Sad case: unable to delete role" {
+				fakeDB.MockDeleteAuthorityRoleFn = func(ctx context.Context, roleID string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.DeleteRole(tt.args.ctx, tt.args.roleID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseAuthorityImpl.DeleteRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("UsecaseAuthorityImpl.DeleteRole() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUsecaseAuthorityImpl_ListRoles(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list roles",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to get logged in user",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to list roles",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			u := authority.NewUsecaseAuthority(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			if tt.name == "Sad case: unable to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to list roles" {
+				fakeDB.MockListAuthorityRolesFn = func(ctx context.Context, organisationID string, programID string) ([]*domain.AuthorityRole, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.ListRoles(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseAuthorityImpl.ListRoles() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected roles to be returned")
+			}
+		})
+	}
+}
+
+func TestUsecaseAuthorityImpl_AssignRole(t *testing.T) {
+	type args struct {
+		ctx     context.Context
+		staffID string
+		roleID  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "Happy case: assign role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Happy case: unable to record role assignment",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get logged in user",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: assigning the super user role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get staff profile",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: staff belongs to another organisation",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: staff belongs to another program",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to check staff role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: role already assigned",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to assign role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			u := authority.NewUsecaseAuthority(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			role, _ := fakeDB.GetAuthorityRoleByID(context.Background(), gofakeit.UUID())
+			fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+				return &domain.User{
+					ID:                    &userID,
+					Name:                  gofakeit.Name(),
+					CurrentOrganizationID: role.OrganisationID,
+					CurrentProgramID:      role.ProgramID,
+				}, nil
+			}
+
+			if tt.name == "Happy case: unable to record role assignment" {
+				fakeDB.MockCreateAuditLogFn = func(ctx context.Context, auditLog *domain.AuditLog) error {
+					return fmt.Errorf("an error occurred")
+				}
+				fakeNotification.MockNotifyUserFn = func(ctx context.Context, userProfile *domain.User, notificationPayload *domain.Notification) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get role" {
+				fakeDB.MockGetAuthorityRoleByIDFn = func(ctx context.Context, roleID string) (*domain.AuthorityRole, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: assigning the super user role" {
+				fakeDB.MockGetAuthorityRoleByIDFn = func(ctx context.Context, roleID string) (*domain.AuthorityRole, error) {
+					return &domain.AuthorityRole{
+						AuthorityRoleID: roleID,
+						Name:            authorization.DefaultRoleSuperUser.String(),
+						IsSystemRole:    true,
+						OrganisationID:  role.OrganisationID,
+					}, nil
+				}
+			}
+			if tt.name == "Sad case: unable to get staff profile" {
+				fakeDB.MockGetStaffProfileByStaffIDFn = func(ctx context.Context, staffID string) (*domain.StaffProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: staff belongs to another organisation" {
+				fakeDB.MockGetStaffProfileByStaffIDFn = func(ctx context.Context, staffID string) (*domain.StaffProfile, error) {
+					return &domain.StaffProfile{
+						ID:             &staffID,
+						OrganisationID: gofakeit.UUID(),
+					}, nil
+				}
+			}
+			if tt.name == "Sad case: staff belongs to another program" {
+				fakeDB.MockGetStaffProfileByStaffIDFn = func(ctx context.Context, staffID string) (*domain.StaffProfile, error) {
+					return &domain.StaffProfile{
+						ID:             &staffID,
+						OrganisationID: role.OrganisationID,
+						ProgramID:      gofakeit.UUID(),
+					}, nil
+				}
+			}
+			if tt.name == "Sad case: unable to check staff role" {
+				fakeDB.MockCheckStaffHasRoleFn = func(ctx context.Context, staffID string, roleID string) (bool, error) {
+					return false, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: role already assigned" {
+				fakeDB.MockCheckStaffHasRoleFn = func(ctx context.Context, staffID string, roleID string) (bool, error) {
+					return true, nil
+				}
+			}
+			if tt.name == "Sad case: unable to assign role" {
+				fakeDB.MockAssignStaffRoleFn = func(ctx context.Context, staffID string, roleID string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.AssignRole(tt.args.ctx, tt.args.staffID, tt.args.roleID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseAuthorityImpl.AssignRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("UsecaseAuthorityImpl.AssignRole() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUsecaseAuthorityImpl_AssignSystemRole(t *testing.T) {
+	type args struct {
+		ctx            context.Context
+		staffID        string
+		organisationID string
+		systemRole     authorization.DefaultRole
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: assign an existing system role",
+			args: args{
+				ctx:            context.Background(),
+				staffID:        gofakeit.UUID(),
+				organisationID: gofakeit.UUID(),
+				systemRole:     authorization.DefaultRoleStaff,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: create and assign a system role",
+			args: args{
+				ctx:            context.Background(),
+				staffID:        gofakeit.UUID(),
+				organisationID: gofakeit.UUID(),
+				systemRole:     authorization.DefaultRoleAdmin,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: system role already assigned",
+			args: args{
+				ctx:            context.Background(),
+				staffID:        gofakeit.UUID(),
+				organisationID: gofakeit.UUID(),
+				systemRole:     authorization.DefaultRoleStaff,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: not a staff system role",
+			args: args{
+				ctx:            context.Background(),
+				staffID:        gofakeit.UUID(),
+				organisationID: gofakeit.UUID(),
+				systemRole:     authorization.DefaultRoleClient,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to list organisation roles",
+			args: args{
+				ctx:            context.Background(),
+				staffID:        gofakeit.UUID(),
+				organisationID: gofakeit.UUID(),
+				systemRole:     authorization.DefaultRoleStaff,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to create system role",
+			args: args{
+				ctx:            context.Background(),
+				staffID:        gofakeit.UUID(),
+				organisationID: gofakeit.UUID(),
+				systemRole:     authorization.DefaultRoleAdmin,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to check staff role",
+			args: args{
+				ctx:            context.Background(),
+				staffID:        gofakeit.UUID(),
+				organisationID: gofakeit.UUID(),
+				systemRole:     authorization.DefaultRoleStaff,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to assign system role",
+			args: args{
+				ctx:            context.Background(),
+				staffID:        gofakeit.UUID(),
+				organisationID: gofakeit.UUID(),
+				systemRole:     authorization.DefaultRoleStaff,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			u := authority.NewUsecaseAuthority(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			createdRole := false
+			fakeDB.MockListAuthorityRolesFn = func(ctx context.Context, organisationID string, programID string) ([]*domain.AuthorityRole, error) {
+				return []*domain.AuthorityRole{
+					{
+						AuthorityRoleID: gofakeit.UUID(),
+						Name:            authorization.DefaultRoleStaff.String(),
+						Active:          true,
+						IsSystemRole:    true,
+						OrganisationID:  organisationID,
+					},
+				}, nil
+			}
+			fakeDB.MockCreateAuthorityRoleFn = func(ctx context.Context, role *domain.AuthorityRole) (*domain.AuthorityRole, error) {
+				createdRole = true
+				role.AuthorityRoleID = gofakeit.UUID()
+				return role, nil
+			}
+
+			if tt.name == "Happy case: system role already assigned" {
+				fakeDB.MockCheckStaffHasRoleFn = func(ctx context.Context, staffID string, roleID string) (bool, error) {
+					return true, nil
+				}
+				fakeDB.MockAssignStaffRoleFn = func(ctx context.Context, staffID string, roleID string) error {
+					return fmt.Errorf("role should not be assigned twice")
+				}
+			}
+			if tt.name == "Sad case: unable to list organisation roles" {
+				fakeDB.MockListAuthorityRolesFn = func(ctx context.Context, organisationID string, programID string) ([]*domain.AuthorityRole, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to create system role" {
+				fakeDB.MockCreateAuthorityRoleFn = func(ctx context.Context, role *domain.AuthorityRole) (*domain.AuthorityRole, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to check staff role" {
+				fakeDB.MockCheckStaffHasRoleFn = func(ctx context.Context, staffID string, roleID string) (bool, error) {
+					return false, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to assign system role" {
+				fakeDB.MockAssignStaffRoleFn = func(ctx context.Context, staffID string, roleID string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			err := u.AssignSystemRole(tt.args.ctx, tt.args.staffID, tt.args.organisationID, tt.args.systemRole)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseAuthorityImpl.AssignSystemRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.name == "Happy case: create and assign a system role" && !createdRole {
+				t.Errorf("UsecaseAuthorityImpl.AssignSystemRole() expected the %s role to be created", tt.args.systemRole)
+			}
+			if tt.name == "Happy case: assign an existing system role" && createdRole {
+				t.Errorf("UsecaseAuthorityImpl.AssignSystemRole() expected the existing %s role to be assigned", tt.args.systemRole)
+			}
+		})
+	}
+}
+
+func TestUsecaseAuthorityImpl_RevokeRole(t *testing.T) {
+	type args struct {
+		ctx     context.Context
+		staffID string
+		roleID  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "Happy case: revoke role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get staff profile",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to check staff role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: role not assigned",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to revoke role",
+			args: args{
+				ctx:     context.Background(),
+				staffID: gofakeit.UUID(),
+				roleID:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			u := authority.NewUsecaseAuthority(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			role, _ := fakeDB.GetAuthorityRoleByID(context.Background(), gofakeit.UUID())
+			fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+				return &domain.User{
+					ID:                    &userID,
+					Name:                  gofakeit.Name(),
+					CurrentOrganizationID: role.OrganisationID,
+					CurrentProgramID:      role.ProgramID,
+				}, nil
+			}
+
+			fakeDB.MockCheckStaffHasRoleFn = func(ctx context.Context, staffID string, roleID string) (bool, error) {
+				return true, nil
+			}
+
+			if tt.name == "Sad case: unable to get staff profile" {
+				fakeDB.MockGetStaffProfileByStaffIDFn = func(ctx context.Context, staffID string) (*domain.StaffProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to check staff role" {
+				fakeDB.MockCheckStaffHasRoleFn = func(ctx context.Context, staffID string, roleID string) (bool, error) {
+					return false, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: role not assigned" {
+				fakeDB.MockCheckStaffHasRoleFn = func(ctx context.Context, staffID string, roleID string) (bool, error) {
+					return false, nil
+				}
+			}
+			if tt.name == "Sad case: unable to revoke role" {
+				fakeDB.MockRevokeStaffRoleFn = func(ctx context.Context, staffID string, roleID string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.RevokeRole(tt.args.ctx, tt.args.staffID, tt.args.roleID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseAuthorityImpl.RevokeRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("UsecaseAuthorityImpl.RevokeRole() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUsecaseAuthorityImpl_ListRoleMembers(t *testing.T) {
+	type args struct {
+		ctx             context.Context
+		roleID          string
+		paginationInput *dto.PaginationsInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list role members",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+				paginationInput: &dto.PaginationsInput{
+					Limit:       10,
+					CurrentPage: 1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid pagination input",
+			args: args{
+				ctx:             context.Background(),
+				roleID:          gofakeit.UUID(),
+				paginationInput: &dto.PaginationsInput{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get logged in user",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+				paginationInput: &dto.PaginationsInput{
+					Limit:       10,
+					CurrentPage: 1,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get role",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+				paginationInput: &dto.PaginationsInput{
+					Limit:       10,
+					CurrentPage: 1,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to list role members",
+			args: args{
+				ctx:    context.Background(),
+				roleID: gofakeit.UUID(),
+				paginationInput: &dto.PaginationsInput{
+					Limit:       10,
+					CurrentPage: 1,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			u := authority.NewUsecaseAuthority(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			role, _ := fakeDB.GetAuthorityRoleByID(context.Background(), gofakeit.UUID())
+			fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+				return &domain.User{
+					ID:                    &userID,
+					Name:                  gofakeit.Name(),
+					CurrentOrganizationID: role.OrganisationID,
+					CurrentProgramID:      role.ProgramID,
+				}, nil
+			}
+
+			if tt.name == "Sad case: unable to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get role" {
+				fakeDB.MockGetAuthorityRoleByIDFn = func(ctx context.Context, roleID string) (*domain.AuthorityRole, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to list role members" {
+				fakeDB.MockListAuthorityRoleStaffFn = func(ctx context.Context, roleID string, pagination *domain.Pagination) ([]*domain.StaffProfile, *domain.Pagination, error) {
+					return nil, nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.ListRoleMembers(tt.args.ctx, tt.args.roleID, tt.args.paginationInput)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseAuthorityImpl.ListRoleMembers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Staff) == 0 {
+				t.Errorf("expected role members to be returned")
+			}
+		})
+	}
+}
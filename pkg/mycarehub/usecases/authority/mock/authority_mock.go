@@ -1,11 +1,168 @@
 package mock
 
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/authorization"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+)
+
 // AuthorityUseCaseMock mocks the implementation of usecase methods.
 type AuthorityUseCaseMock struct {
+	MockCheckUserPermissionFn func(ctx context.Context, permission string) (bool, error)
+	MockListAuditLogsFn       func(ctx context.Context, filter *dto.AuditLogFilterInput, paginationInput *dto.PaginationsInput) (*domain.AuditLogPage, error)
+	MockCreateRoleFn          func(ctx context.Context, input dto.AuthorityRoleInput) (*domain.AuthorityRole, error)
+	MockUpdateRoleFn          func(ctx context.Context, roleID string, input dto.AuthorityRoleInput) (*domain.AuthorityRole, error)
+	MockDeleteRoleFn          func(ctx context.Context, roleID string) (bool, error)
+	MockListRolesFn           func(ctx context.Context) ([]*domain.AuthorityRole, error)
+	MockAssignRoleFn          func(ctx context.Context, staffID string, roleID string) (bool, error)
+	MockRevokeRoleFn          func(ctx context.Context, staffID string, roleID string) (bool, error)
+	MockListRoleMembersFn     func(ctx context.Context, roleID string, paginationInput *dto.PaginationsInput) (*domain.AuthorityRoleMembersPage, error)
+	MockAssignSystemRoleFn    func(ctx context.Context, staffID string, organisationID string, systemRole authorization.DefaultRole) error
 }
 
 // NewAuthorityUseCaseMock creates in initializes create type mocks
 func NewAuthorityUseCaseMock() *AuthorityUseCaseMock {
 
-	return &AuthorityUseCaseMock{}
+	ID := uuid.New().String()
+
+	role := &domain.AuthorityRole{
+		AuthorityRoleID: ID,
+		Name:            "Facility Manager",
+		Description:     "Manages the facilities in a program",
+		Active:          true,
+		OrganisationID:  ID,
+		ProgramID:       ID,
+		Permissions: []domain.AuthorityPermission{
+			{
+				PermissionID: ID,
+				Active:       true,
+				Name:         "Read facility",
+				Description:  "Can read facility",
+				Category:     "Facility",
+				Scope:        "facility.read",
+			},
+		},
+	}
+
+	return &AuthorityUseCaseMock{
+		MockCheckUserPermissionFn: func(ctx context.Context, permission string) (bool, error) {
+			return true, nil
+		},
+		MockListAuditLogsFn: func(ctx context.Context, filter *dto.AuditLogFilterInput, paginationInput *dto.PaginationsInput) (*domain.AuditLogPage, error) {
+			return &domain.AuditLogPage{
+				Pagination: domain.Pagination{
+					Limit:       10,
+					CurrentPage: 1,
+				},
+				AuditLogs: []*domain.AuditLog{
+					{
+						ID:             ID,
+						Timestamp:      time.Now(),
+						RecordType:     enums.AuditLogRecordTypeClientFacilityTransfer,
+						ActorID:        ID,
+						TargetID:       ID,
+						TargetType:     "CLIENT",
+						OldValue:       map[string]interface{}{"facilityID": ID},
+						NewValue:       map[string]interface{}{"facilityID": ID},
+						OrganisationID: ID,
+						ProgramID:      ID,
+					},
+				},
+			}, nil
+		},
+		MockCreateRoleFn: func(ctx context.Context, input dto.AuthorityRoleInput) (*domain.AuthorityRole, error) {
+			return role, nil
+		},
+		MockUpdateRoleFn: func(ctx context.Context, roleID string, input dto.AuthorityRoleInput) (*domain.AuthorityRole, error) {
+			return role, nil
+		},
+		MockDeleteRoleFn: func(ctx context.Context, roleID string) (bool, error) {
+			return true, nil
+		},
+		MockListRolesFn: func(ctx context.Context) ([]*domain.AuthorityRole, error) {
+			return []*domain.AuthorityRole{role}, nil
+		},
+		MockAssignRoleFn: func(ctx context.Context, staffID string, roleID string) (bool, error) {
+			return true, nil
+		},
+		MockRevokeRoleFn: func(ctx context.Context, staffID string, roleID string) (bool, error) {
+			return true, nil
+		},
+		MockListRoleMembersFn: func(ctx context.Context, roleID string, paginationInput *dto.PaginationsInput) (*domain.AuthorityRoleMembersPage, error) {
+			return &domain.AuthorityRoleMembersPage{
+				Pagination: domain.Pagination{
+					Limit:       10,
+					CurrentPage: 1,
+				},
+				Staff: []*domain.StaffProfile{
+					{
+						ID:             &ID,
+						UserID:         ID,
+						Active:         true,
+						StaffNumber:    "TEST-00",
+						OrganisationID: ID,
+						ProgramID:      ID,
+					},
+				},
+			}, nil
+		},
+		MockAssignSystemRoleFn: func(ctx context.Context, staffID string, organisationID string, systemRole authorization.DefaultRole) error {
+			return nil
+		},
+	}
+}
+
+// CheckUserPermission mocks the implementation of checking whether the logged in user has a permission
+func (m *AuthorityUseCaseMock) CheckUserPermission(ctx context.Context, permission string) (bool, error) {
+	return m.MockCheckUserPermissionFn(ctx, permission)
+}
+
+// ListAuditLogs mocks the implementation of listing the audit logs of an organisation
+func (m *AuthorityUseCaseMock) ListAuditLogs(ctx context.Context, filter *dto.AuditLogFilterInput, paginationInput *dto.PaginationsInput) (*domain.AuditLogPage, error) {
+	return m.MockListAuditLogsFn(ctx, filter, paginationInput)
+}
+
+// CreateRole mocks the implementation of creating a custom role
+func (m *AuthorityUseCaseMock) CreateRole(ctx context.Context, input dto.AuthorityRoleInput) (*domain.AuthorityRole, error) {
+	return m.MockCreateRoleFn(ctx, input)
+}
+
+// UpdateRole mocks the implementation of updating a custom role
+func (m *AuthorityUseCaseMock) UpdateRole(ctx context.Context, roleID string, input dto.AuthorityRoleInput) (*domain.AuthorityRole, error) {
+	return m.MockUpdateRoleFn(ctx, roleID, input)
+}
+
+// DeleteRole mocks the implementation of deleting a custom role
+func (m *AuthorityUseCaseMock) DeleteRole(ctx context.Context, roleID string) (bool, error) {
+	return m.MockDeleteRoleFn(ctx, roleID)
+}
+
+// ListRoles mocks the implementation of listing the roles in an organisation
+func (m *AuthorityUseCaseMock) ListRoles(ctx context.Context) ([]*domain.AuthorityRole, error) {
+	return m.MockListRolesFn(ctx)
+}
+
+// AssignRole mocks the implementation of assigning a role to a staff
+func (m *AuthorityUseCaseMock) AssignRole(ctx context.Context, staffID string, roleID string) (bool, error) {
+	return m.MockAssignRoleFn(ctx, staffID, roleID)
+}
+
+// RevokeRole mocks the implementation of revoking a staff role
+func (m *AuthorityUseCaseMock) RevokeRole(ctx context.Context, staffID string, roleID string) (bool, error) {
+	return m.MockRevokeRoleFn(ctx, staffID, roleID)
+}
+
+// ListRoleMembers mocks the implementation of listing the staff that have been assigned a role
+func (m *AuthorityUseCaseMock) ListRoleMembers(ctx context.Context, roleID string, paginationInput *dto.PaginationsInput) (*domain.AuthorityRoleMembersPage, error) {
+	return m.MockListRoleMembersFn(ctx, roleID, paginationInput)
+}
+
+// AssignSystemRole mocks the implementation of assigning an organisation wide system role to a staff
+func (m *AuthorityUseCaseMock) AssignSystemRole(ctx context.Context, staffID string, organisationID string, systemRole authorization.DefaultRole) error {
+	return m.MockAssignSystemRoleFn(ctx, staffID, organisationID, systemRole)
 }
@@ -2,43 +2,64 @@ package communities
 
 import (
 	"context"
+	"fmt"
+	"log"
 	"strings"
+	"time"
 
 	"github.com/savannahghi/enumutils"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/matrix"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/notification"
+	"github.com/savannahghi/serverutils"
 )
 
+// ineligibleMemberReason is the reason shown to clients removed from a community they no longer qualify for
+const ineligibleMemberReason = "You no longer meet the membership criteria of this community"
+
 // UseCasesCommunities holds all interfaces required to implement the communities feature
 type UseCasesCommunities interface {
 	CreateCommunity(ctx context.Context, communityInput *dto.CommunityInput) (*domain.Community, error)
 	ListCommunities(ctx context.Context) ([]string, error)
+	SyncClientCommunities(ctx context.Context, clientID string) error
+	ReconcileCommunities(ctx context.Context) error
+	ICommunityModeration
+	ICommunityCredentials
+	ICommunityMembership
+	ICommunitySafety
 }
 
 // UseCasesCommunitiesImpl represents communities implementation
 type UseCasesCommunitiesImpl struct {
-	Create      infrastructure.Create
-	Query       infrastructure.Query
-	ExternalExt extension.ExternalMethodsExtension
-	Matrix      matrix.Matrix
+	Create       infrastructure.Create
+	Query        infrastructure.Query
+	Update       infrastructure.Update
+	ExternalExt  extension.ExternalMethodsExtension
+	Matrix       matrix.Matrix
+	Notification notification.UseCaseNotification
 }
 
 // NewUseCaseCommunitiesImpl initializes a new communities service
 func NewUseCaseCommunitiesImpl(
 	create infrastructure.Create,
 	query infrastructure.Query,
+	update infrastructure.Update,
 	externalExtension extension.ExternalMethodsExtension,
 	matrix matrix.Matrix,
+	notification notification.UseCaseNotification,
 ) UseCasesCommunities {
 	return &UseCasesCommunitiesImpl{
-		Create:      create,
-		Query:       query,
-		ExternalExt: externalExtension,
-		Matrix:      matrix,
+		Create:       create,
+		Query:        query,
+		Update:       update,
+		ExternalExt:  externalExtension,
+		Matrix:       matrix,
+		Notification: notification,
 	}
 }
 
@@ -67,9 +88,9 @@ func (uc *UseCasesCommunitiesImpl) CreateCommunity(ctx context.Context, communit
 		clientTypes = append(clientTypes, enums.ClientType(strings.ToUpper(k.String())))
 	}
 
-	auth := &domain.MatrixAuth{
-		Username: userProfile.Username,
-		Password: *userProfile.ID,
+	auth, err := uc.GetMatrixAuth(ctx, userProfile)
+	if err != nil {
+		return nil, err
 	}
 
 	roomID, err := uc.Matrix.CreateCommunity(ctx, auth, communityInput)
@@ -89,6 +110,7 @@ func (uc *UseCasesCommunitiesImpl) CreateCommunity(ctx context.Context, communit
 		ClientType:     clientTypes,
 		OrganisationID: userProfile.CurrentOrganizationID,
 		ProgramID:      userProfile.CurrentProgramID,
+		FacilityID:     communityInput.FacilityID,
 	}
 
 	community, err := uc.Create.CreateCommunity(ctx, &communityPayload)
@@ -96,6 +118,29 @@ func (uc *UseCasesCommunitiesImpl) CreateCommunity(ctx context.Context, communit
 		return nil, err
 	}
 
+	// the room has been created at this point so failing to populate it should not fail the request.
+	// Any clients left out are picked up by the next reconciliation
+	serviceAccount := matrixServiceAccount()
+	err = uc.Matrix.MakeRoomAdmin(ctx, serviceAccount, community.RoomID, serviceAccount.Username)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		log.Printf("failed to make the service account an admin of community %s: %v", community.ID, err)
+		return community, nil
+	}
+
+	clients, err := uc.Query.ListProgramClients(ctx, community.ProgramID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		log.Printf("failed to list the clients of community %s: %v", community.ID, err)
+		return community, nil
+	}
+
+	err = uc.syncCommunityMembers(ctx, serviceAccount, community, clients)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		log.Printf("failed to add eligible clients to community %s: %v", community.ID, err)
+	}
+
 	return community, nil
 }
 
@@ -123,3 +168,118 @@ func (uc *UseCasesCommunitiesImpl) ListCommunities(ctx context.Context) ([]strin
 
 	return communityIDs, nil
 }
+
+// matrixServiceAccount returns the credentials of the Matrix account used to manage community members
+func matrixServiceAccount() *domain.MatrixAuth {
+	return &domain.MatrixAuth{
+		Username: serverutils.MustGetEnvVar("MCH_MATRIX_USER"),
+		Password: serverutils.MustGetEnvVar("MCH_MATRIX_PASSWORD"),
+	}
+}
+
+// syncCommunityMembers invites the eligible clients who are not members of a community and removes the
+// members who are no longer eligible. Only the supplied clients are considered so staff members are never removed.
+// Clients who left the community themselves or were banned from it are not invited again.
+// A failure to invite or remove one client is reported and does not stop the rest of the clients from being synced
+func (uc *UseCasesCommunitiesImpl) syncCommunityMembers(ctx context.Context, auth *domain.MatrixAuth, community *domain.Community, clients []*domain.ClientProfile) error {
+	members, err := uc.Matrix.ListRoomMembers(ctx, auth, community.RoomID)
+	if err != nil {
+		return fmt.Errorf("failed to list the members of community %s: %w", community.ID, err)
+	}
+
+	memberships := map[string]*domain.MatrixRoomMember{}
+	for _, member := range members {
+		memberships[strings.ToLower(member.Username)] = member
+	}
+
+	now := time.Now()
+	for _, client := range clients {
+		if client.User == nil || client.User.Username == "" {
+			continue
+		}
+
+		username := client.User.Username
+		membership, ok := memberships[strings.ToLower(username)]
+		isMember := ok && membership.IsMember()
+		hasOptedOut := ok && membership.HasOptedOut()
+		isEligible := community.IsEligible(client, now)
+
+		switch {
+		case isEligible && !isMember && !hasOptedOut:
+			err = uc.Matrix.InviteUserToRoom(ctx, auth, community.RoomID, username)
+
+		case !isEligible && isMember:
+			err = uc.Matrix.RemoveUserFromRoom(ctx, auth, community.RoomID, username, ineligibleMemberReason)
+
+		default:
+			continue
+		}
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			log.Printf("failed to sync user %s with community %s: %v", client.UserID, community.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// SyncClientCommunities adds a client to the communities in their program that they are eligible for and removes
+// them from the ones they no longer qualify for. It is called whenever a client's details that are used as
+// community membership criteria change
+func (uc *UseCasesCommunitiesImpl) SyncClientCommunities(ctx context.Context, clientID string) error {
+	client, err := uc.Query.GetClientProfileByClientID(ctx, clientID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("failed to get client profile: %w", err)
+	}
+
+	communities, err := uc.Query.ListCommunities(ctx, client.ProgramID, client.OrganisationID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("failed to list communities: %w", err)
+	}
+
+	serviceAccount := matrixServiceAccount()
+	for _, community := range communities {
+		err := uc.syncCommunityMembers(ctx, serviceAccount, community, []*domain.ClientProfile{client})
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			log.Printf("failed to sync client %s with community %s: %v", clientID, community.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// ReconcileCommunities re-syncs the members of every community with its membership criteria.
+// It picks up the changes that are not tied to a specific action such as clients growing out of a community's age range
+func (uc *UseCasesCommunitiesImpl) ReconcileCommunities(ctx context.Context) error {
+	communities, err := uc.Query.ListAllCommunities(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("failed to list communities: %w", err)
+	}
+
+	serviceAccount := matrixServiceAccount()
+	programClients := map[string][]*domain.ClientProfile{}
+	for _, community := range communities {
+		clients, ok := programClients[community.ProgramID]
+		if !ok {
+			clients, err = uc.Query.ListProgramClients(ctx, community.ProgramID)
+			if err != nil {
+				helpers.ReportErrorToSentry(err)
+				log.Printf("failed to list the clients of program %s: %v", community.ProgramID, err)
+				continue
+			}
+			programClients[community.ProgramID] = clients
+		}
+
+		err = uc.syncCommunityMembers(ctx, serviceAccount, community, clients)
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			log.Printf("failed to reconcile community %s: %v", community.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/savannahghi/enumutils"
@@ -15,6 +17,7 @@ import (
 	pgMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/mock"
 	mockMatrix "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/matrix/mock"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/communities"
+	notificationMock "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/notification/mock"
 )
 
 func TestUseCasesCommunitiesImpl_CreateCommunity(t *testing.T) {
@@ -111,6 +114,45 @@ func TestUseCasesCommunitiesImpl_CreateCommunity(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Happy case: unable to make the service account a room admin",
+			args: args{
+				ctx: context.Background(),
+				communityInput: &dto.CommunityInput{
+					Name:       "Test",
+					Topic:      "Test",
+					AgeRange:   &dto.AgeRangeInput{},
+					FacilityID: uuid.NewString(),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: unable to list the program's clients",
+			args: args{
+				ctx: context.Background(),
+				communityInput: &dto.CommunityInput{
+					Name:       "Test",
+					Topic:      "Test",
+					AgeRange:   &dto.AgeRangeInput{},
+					FacilityID: uuid.NewString(),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: unable to list the room's members",
+			args: args{
+				ctx: context.Background(),
+				communityInput: &dto.CommunityInput{
+					Name:       "Test",
+					Topic:      "Test",
+					AgeRange:   &dto.AgeRangeInput{},
+					FacilityID: uuid.NewString(),
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "Sad case: unable to create room in db",
 			args: args{
@@ -131,14 +173,33 @@ func TestUseCasesCommunitiesImpl_CreateCommunity(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Sad case: unable to get matrix credentials",
+			args: args{
+				ctx: context.Background(),
+				communityInput: &dto.CommunityInput{
+					Name:       "Test",
+					Topic:      "Test",
+					AgeRange:   &dto.AgeRangeInput{},
+					FacilityID: uuid.NewString(),
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			fakeDB := pgMock.NewPostgresMock()
 			fakeExt := extensionMock.NewFakeExtension()
 			fakeMatrix := mockMatrix.NewMatrixMock()
-			uc := communities.NewUseCaseCommunitiesImpl(fakeDB, fakeDB, fakeExt, fakeMatrix)
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			uc := communities.NewUseCaseCommunitiesImpl(fakeDB, fakeDB, fakeDB, fakeExt, fakeMatrix, fakeNotification)
 
+			if tt.name == "Sad case: unable to get matrix credentials" {
+				fakeDB.MockGetUserMatrixPasswordFn = func(ctx context.Context, userID string) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
 			if tt.name == "Sad case: unable to get logged in user" {
 				fakeExt.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
 					return "", fmt.Errorf("an error occurred")
@@ -163,6 +224,21 @@ func TestUseCasesCommunitiesImpl_CreateCommunity(t *testing.T) {
 					return nil, fmt.Errorf("an error occurred")
 				}
 			}
+			if tt.name == "Happy case: unable to make the service account a room admin" {
+				fakeMatrix.MockMakeRoomAdminFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Happy case: unable to list the program's clients" {
+				fakeDB.MockListProgramClientsFn = func(ctx context.Context, programID string) ([]*domain.ClientProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Happy case: unable to list the room's members" {
+				fakeMatrix.MockListRoomMembersFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]*domain.MatrixRoomMember, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
 
 			_, err := uc.CreateCommunity(tt.args.ctx, tt.args.communityInput)
 			if (err != nil) != tt.wantErr {
@@ -216,7 +292,8 @@ func TestUseCasesCommunitiesImpl_ListCommunities(t *testing.T) {
 			fakeDB := pgMock.NewPostgresMock()
 			fakeExt := extensionMock.NewFakeExtension()
 			fakeMatrix := mockMatrix.NewMatrixMock()
-			uc := communities.NewUseCaseCommunitiesImpl(fakeDB, fakeDB, fakeExt, fakeMatrix)
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			uc := communities.NewUseCaseCommunitiesImpl(fakeDB, fakeDB, fakeDB, fakeExt, fakeMatrix, fakeNotification)
 
 			if tt.name == "Sad case: unable to get logged in user" {
 				fakeExt.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
@@ -241,3 +318,257 @@ func TestUseCasesCommunitiesImpl_ListCommunities(t *testing.T) {
 		})
 	}
 }
+
+func communityClient(username string, programID string, active bool) *domain.ClientProfile {
+	id := uuid.NewString()
+	dob := time.Now().AddDate(-20, 0, 0)
+	return &domain.ClientProfile{
+		ID:        &id,
+		Active:    active,
+		ProgramID: programID,
+		User: &domain.User{
+			Username:    username,
+			Gender:      enumutils.GenderFemale,
+			DateOfBirth: &dob,
+		},
+	}
+}
+
+// roomMember returns a Matrix room member with the supplied membership
+func roomMember(username string, membership string, selfChanged bool) *domain.MatrixRoomMember {
+	return &domain.MatrixRoomMember{
+		Username:    username,
+		Membership:  membership,
+		SelfChanged: selfChanged,
+	}
+}
+
+func TestUseCasesCommunitiesImpl_SyncClientCommunities(t *testing.T) {
+	programID := uuid.NewString()
+
+	tests := []struct {
+		name        string
+		client      *domain.ClientProfile
+		members     []*domain.MatrixRoomMember
+		wantInvited []string
+		wantRemoved []string
+		wantErr     bool
+	}{
+		{
+			name:        "Happy case: invite an eligible client",
+			client:      communityClient("jane", programID, true),
+			members:     []*domain.MatrixRoomMember{roomMember("staff", "join", true)},
+			wantInvited: []string{"jane"},
+			wantErr:     false,
+		},
+		{
+			name:        "Happy case: invite an eligible client removed by a moderator",
+			client:      communityClient("jane", programID, true),
+			members:     []*domain.MatrixRoomMember{roomMember("jane", "leave", false)},
+			wantInvited: []string{"jane"},
+			wantErr:     false,
+		},
+		{
+			name:    "Happy case: do not invite an eligible client who left the community",
+			client:  communityClient("jane", programID, true),
+			members: []*domain.MatrixRoomMember{roomMember("jane", "leave", true)},
+			wantErr: false,
+		},
+		{
+			name:    "Happy case: do not invite an eligible client who was banned",
+			client:  communityClient("jane", programID, true),
+			members: []*domain.MatrixRoomMember{roomMember("jane", "ban", false)},
+			wantErr: false,
+		},
+		{
+			name:        "Happy case: remove an ineligible client",
+			client:      communityClient("jane", programID, false),
+			members:     []*domain.MatrixRoomMember{roomMember("staff", "join", true), roomMember("Jane", "join", true)},
+			wantRemoved: []string{"jane"},
+			wantErr:     false,
+		},
+		{
+			name:    "Happy case: eligible client who is already a member",
+			client:  communityClient("jane", programID, true),
+			members: []*domain.MatrixRoomMember{roomMember("jane", "invite", false)},
+			wantErr: false,
+		},
+		{
+			name:    "Happy case: unable to list room members",
+			client:  communityClient("jane", programID, true),
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to get client profile",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to list communities",
+			client:  communityClient("jane", programID, true),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExt := extensionMock.NewFakeExtension()
+			fakeMatrix := mockMatrix.NewMatrixMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			uc := communities.NewUseCaseCommunitiesImpl(fakeDB, fakeDB, fakeDB, fakeExt, fakeMatrix, fakeNotification)
+
+			fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+				return tt.client, nil
+			}
+			fakeDB.MockListCommunitiesFn = func(ctx context.Context, programID, organisationID string) ([]*domain.Community, error) {
+				return []*domain.Community{{ID: uuid.NewString(), RoomID: "room1", ProgramID: programID}}, nil
+			}
+			fakeMatrix.MockListRoomMembersFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]*domain.MatrixRoomMember, error) {
+				return tt.members, nil
+			}
+
+			var invited, removed []string
+			fakeMatrix.MockInviteUserToRoomFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error {
+				invited = append(invited, username)
+				return nil
+			}
+			fakeMatrix.MockRemoveUserFromRoomFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, reason string) error {
+				removed = append(removed, username)
+				return nil
+			}
+
+			if tt.name == "Happy case: unable to list room members" {
+				fakeMatrix.MockListRoomMembersFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]*domain.MatrixRoomMember, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get client profile" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to list communities" {
+				fakeDB.MockListCommunitiesFn = func(ctx context.Context, programID, organisationID string) ([]*domain.Community, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			err := uc.SyncClientCommunities(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.SyncClientCommunities() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(invited, tt.wantInvited) {
+				t.Errorf("invited %v, want %v", invited, tt.wantInvited)
+			}
+			if !reflect.DeepEqual(removed, tt.wantRemoved) {
+				t.Errorf("removed %v, want %v", removed, tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestUseCasesCommunitiesImpl_ReconcileCommunities(t *testing.T) {
+	programID := uuid.NewString()
+
+	tests := []struct {
+		name        string
+		wantInvited []string
+		wantRemoved []string
+		wantErr     bool
+	}{
+		{
+			name:        "Happy case: reconcile communities",
+			wantInvited: []string{"eligible", "eligible"},
+			wantRemoved: []string{"ineligible", "ineligible"},
+			wantErr:     false,
+		},
+		{
+			name:        "Happy case: unable to invite a client",
+			wantInvited: []string{"eligible", "eligible"},
+			wantRemoved: []string{"ineligible", "ineligible"},
+			wantErr:     false,
+		},
+		{
+			name:    "Happy case: unable to list program clients",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to list communities",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExt := extensionMock.NewFakeExtension()
+			fakeMatrix := mockMatrix.NewMatrixMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			uc := communities.NewUseCaseCommunitiesImpl(fakeDB, fakeDB, fakeDB, fakeExt, fakeMatrix, fakeNotification)
+
+			fakeDB.MockListAllCommunitiesFn = func(ctx context.Context) ([]*domain.Community, error) {
+				return []*domain.Community{
+					{ID: uuid.NewString(), RoomID: "room1", ProgramID: programID},
+					{ID: uuid.NewString(), RoomID: "room2", ProgramID: programID},
+				}, nil
+			}
+			listedPrograms := 0
+			fakeDB.MockListProgramClientsFn = func(ctx context.Context, programID string) ([]*domain.ClientProfile, error) {
+				listedPrograms++
+				return []*domain.ClientProfile{
+					communityClient("eligible", programID, true),
+					communityClient("ineligible", programID, false),
+					communityClient("member", programID, true),
+				}, nil
+			}
+			fakeMatrix.MockListRoomMembersFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]*domain.MatrixRoomMember, error) {
+				return []*domain.MatrixRoomMember{
+					roomMember("staff", "join", true),
+					roomMember("ineligible", "join", true),
+					roomMember("member", "join", true),
+				}, nil
+			}
+
+			var invited, removed []string
+			fakeMatrix.MockInviteUserToRoomFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error {
+				invited = append(invited, username)
+				return nil
+			}
+			fakeMatrix.MockRemoveUserFromRoomFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, reason string) error {
+				removed = append(removed, username)
+				return nil
+			}
+
+			if tt.name == "Happy case: unable to invite a client" {
+				fakeMatrix.MockInviteUserToRoomFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error {
+					invited = append(invited, username)
+					return fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Happy case: unable to list program clients" {
+				fakeDB.MockListProgramClientsFn = func(ctx context.Context, programID string) ([]*domain.ClientProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to list communities" {
+				fakeDB.MockListAllCommunitiesFn = func(ctx context.Context) ([]*domain.Community, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			err := uc.ReconcileCommunities(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.ReconcileCommunities() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(invited, tt.wantInvited) {
+				t.Errorf("invited %v, want %v", invited, tt.wantInvited)
+			}
+			if !reflect.DeepEqual(removed, tt.wantRemoved) {
+				t.Errorf("removed %v, want %v", removed, tt.wantRemoved)
+			}
+			if tt.name == "Happy case: reconcile communities" && listedPrograms != 1 {
+				t.Errorf("expected the program's clients to be listed once, listed %d times", listedPrograms)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package communities
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"log"
+
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/exceptions"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+	"github.com/savannahghi/serverutils"
+)
+
+// matrixPasswordLength is the number of random bytes used to generate a user's Matrix password
+const matrixPasswordLength = 32
+
+// matrixPasswordPassphrase is the secret key used when encrypting and decrypting the users' Matrix passwords
+var matrixPasswordPassphrase = serverutils.MustGetEnvVar("SENSITIVE_CONTENT_SECRET_KEY")
+
+// ICommunityCredentials manages the credentials used to authenticate users to Matrix.
+// Every user gets a randomly generated password that is stored encrypted and never leaves the backend;
+// the clients only ever receive the access tokens obtained with it
+type ICommunityCredentials interface {
+	RegisterMatrixUser(ctx context.Context, user *domain.User, admin bool) error
+	GetMatrixAuth(ctx context.Context, user *domain.User) (*domain.MatrixAuth, error)
+	RotateMatrixPasswords(ctx context.Context) error
+}
+
+// generateMatrixPassword creates a random password for a Matrix account
+func generateMatrixPassword() (string, error) {
+	b := make([]byte, matrixPasswordLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// saveMatrixPassword stores a user's Matrix password encrypted
+func (uc *UseCasesCommunitiesImpl) saveMatrixPassword(ctx context.Context, user *domain.User, password string) error {
+	encryptedPassword, err := helpers.EncryptSensitiveData(password, matrixPasswordPassphrase)
+	if err != nil {
+		return exceptions.EncryptionErr(fmt.Errorf("failed to encrypt matrix password: %w", err))
+	}
+
+	return uc.Update.UpdateUser(ctx, user, map[string]interface{}{
+		"matrix_password": encryptedPassword,
+	})
+}
+
+// RegisterMatrixUser creates a Matrix account with a generated password for a user
+func (uc *UseCasesCommunitiesImpl) RegisterMatrixUser(ctx context.Context, user *domain.User, admin bool) error {
+	if user == nil || user.ID == nil {
+		return fmt.Errorf("a user with an ID is required")
+	}
+
+	password, err := generateMatrixPassword()
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("failed to generate matrix password: %w", err)
+	}
+
+	_, err = uc.Matrix.RegisterUser(ctx, matrixServiceAccount(), &domain.MatrixUserRegistration{
+		Username: user.Username,
+		Password: password,
+		Admin:    admin,
+	})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return err
+	}
+
+	err = uc.saveMatrixPassword(ctx, user, password)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("failed to save matrix password: %w", err)
+	}
+
+	return nil
+}
+
+// GetMatrixAuth returns the credentials used to authenticate a user to Matrix.
+// A user whose password is not managed yet has it rotated first
+func (uc *UseCasesCommunitiesImpl) GetMatrixAuth(ctx context.Context, user *domain.User) (*domain.MatrixAuth, error) {
+	if user == nil || user.ID == nil {
+		return nil, fmt.Errorf("a user with an ID is required")
+	}
+
+	encryptedPassword, err := uc.Query.GetUserMatrixPassword(ctx, *user.ID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get matrix password: %w", err)
+	}
+
+	if encryptedPassword == "" {
+		return uc.rotateMatrixPassword(ctx, user)
+	}
+
+	return decryptMatrixAuth(user, encryptedPassword)
+}
+
+// decryptMatrixAuth builds a user's Matrix credentials from their stored password
+func decryptMatrixAuth(user *domain.User, encryptedPassword string) (*domain.MatrixAuth, error) {
+	password, err := helpers.DecryptSensitiveData(encryptedPassword, matrixPasswordPassphrase)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to decrypt matrix password: %w", err)
+	}
+
+	return &domain.MatrixAuth{
+		Username: user.Username,
+		Password: password,
+	}, nil
+}
+
+// rotateMatrixPassword replaces a user's legacy Matrix password with a generated one.
+// The user's existing Matrix sessions are logged out since they may have been started with the legacy password.
+// The generated password is saved only if the user still does not have one, so that when several requests rotate the
+// same user at once only the one that saved its password updates Matrix and the rest use the saved password.
+// A failure to update Matrix clears the saved password so that the user is rotated again the next time it is needed
+func (uc *UseCasesCommunitiesImpl) rotateMatrixPassword(ctx context.Context, user *domain.User) (*domain.MatrixAuth, error) {
+	password, err := generateMatrixPassword()
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to generate matrix password: %w", err)
+	}
+
+	encryptedPassword, err := helpers.EncryptSensitiveData(password, matrixPasswordPassphrase)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.EncryptionErr(fmt.Errorf("failed to encrypt matrix password: %w", err))
+	}
+
+	saved, err := uc.Update.SetUserMatrixPasswordIfUnset(ctx, *user.ID, encryptedPassword)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to save matrix password: %w", err)
+	}
+
+	if !saved {
+		encryptedPassword, err = uc.Query.GetUserMatrixPassword(ctx, *user.ID)
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			return nil, fmt.Errorf("failed to get matrix password: %w", err)
+		}
+
+		if encryptedPassword == "" {
+			return nil, fmt.Errorf("the matrix password of user %s is being rotated", *user.ID)
+		}
+
+		return decryptMatrixAuth(user, encryptedPassword)
+	}
+
+	err = uc.Matrix.SetUserPassword(ctx, matrixServiceAccount(), user.Username, password, true)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+
+		if clearErr := uc.Update.UpdateUser(ctx, user, map[string]interface{}{"matrix_password": nil}); clearErr != nil {
+			helpers.ReportErrorToSentry(clearErr)
+			log.Printf("failed to clear the matrix password of user %s: %v", *user.ID, clearErr)
+		}
+
+		return nil, fmt.Errorf("failed to set matrix password: %w", err)
+	}
+
+	return &domain.MatrixAuth{
+		Username: user.Username,
+		Password: password,
+	}, nil
+}
+
+// RotateMatrixPasswords replaces the legacy Matrix passwords of all the users whose password is not managed yet.
+// A user that fails to be rotated is skipped and picked up the next time the passwords are rotated
+func (uc *UseCasesCommunitiesImpl) RotateMatrixPasswords(ctx context.Context) error {
+	users, err := uc.Query.ListUsersWithoutMatrixPassword(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("failed to list users without a matrix password: %w", err)
+	}
+
+	for _, user := range users {
+		if user.ID == nil {
+			continue
+		}
+
+		_, err := uc.rotateMatrixPassword(ctx, user)
+		if err != nil {
+			log.Printf("failed to rotate the matrix password of user %s: %v", *user.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,327 @@
+package communities_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	extensionMock "github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension/mock"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+	pgMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/mock"
+	mockMatrix "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/matrix/mock"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/communities"
+	notificationMock "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/notification/mock"
+	"github.com/savannahghi/serverutils"
+)
+
+func TestUseCasesCommunitiesImpl_RegisterMatrixUser(t *testing.T) {
+	userID := uuid.NewString()
+
+	tests := []struct {
+		name    string
+		user    *domain.User
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: register a matrix user",
+			user:    &domain.User{ID: &userID, Username: "test"},
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: missing user ID",
+			user:    &domain.User{Username: "test"},
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to register matrix user",
+			user:    &domain.User{ID: &userID, Username: "test"},
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to save matrix password",
+			user:    &domain.User{ID: &userID, Username: "test"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExt := extensionMock.NewFakeExtension()
+			fakeMatrix := mockMatrix.NewMatrixMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			uc := communities.NewUseCaseCommunitiesImpl(fakeDB, fakeDB, fakeDB, fakeExt, fakeMatrix, fakeNotification)
+
+			var registeredPassword, savedPassword string
+			fakeMatrix.MockRegisterUserFn = func(ctx context.Context, auth *domain.MatrixAuth, registrationPayload *domain.MatrixUserRegistration) (*dto.MatrixUserRegistrationOutput, error) {
+				registeredPassword = registrationPayload.Password
+				return &dto.MatrixUserRegistrationOutput{}, nil
+			}
+			fakeDB.MockUpdateUserFn = func(ctx context.Context, user *domain.User, updateData map[string]interface{}) error {
+				savedPassword, _ = updateData["matrix_password"].(string)
+				return nil
+			}
+
+			if tt.name == "Sad case: unable to register matrix user" {
+				fakeMatrix.MockRegisterUserFn = func(ctx context.Context, auth *domain.MatrixAuth, registrationPayload *domain.MatrixUserRegistration) (*dto.MatrixUserRegistrationOutput, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to save matrix password" {
+				fakeDB.MockUpdateUserFn = func(ctx context.Context, user *domain.User, updateData map[string]interface{}) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			err := uc.RegisterMatrixUser(context.Background(), tt.user, false)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.RegisterMatrixUser() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				if registeredPassword == "" || registeredPassword == userID {
+					t.Errorf("expected a generated matrix password, got %q", registeredPassword)
+				}
+				decrypted, err := helpers.DecryptSensitiveData(savedPassword, serverutils.MustGetEnvVar("SENSITIVE_CONTENT_SECRET_KEY"))
+				if err != nil || decrypted != registeredPassword || savedPassword == registeredPassword {
+					t.Errorf("expected the matrix password to be saved encrypted")
+				}
+			}
+		})
+	}
+}
+
+func TestUseCasesCommunitiesImpl_GetMatrixAuth(t *testing.T) {
+	userID := uuid.NewString()
+	encryptedPassword, err := helpers.EncryptSensitiveData("secret", serverutils.MustGetEnvVar("SENSITIVE_CONTENT_SECRET_KEY"))
+	if err != nil {
+		t.Fatalf("failed to encrypt password: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		user         *domain.User
+		wantPassword string
+		wantErr      bool
+	}{
+		{
+			name:         "Happy case: get managed matrix credentials",
+			user:         &domain.User{ID: &userID, Username: "test"},
+			wantPassword: "secret",
+			wantErr:      false,
+		},
+		{
+			name:    "Happy case: rotate a legacy matrix password",
+			user:    &domain.User{ID: &userID, Username: "test"},
+			wantErr: false,
+		},
+		{
+			name:         "Happy case: matrix password rotated by another request",
+			user:         &domain.User{ID: &userID, Username: "test"},
+			wantPassword: "secret",
+			wantErr:      false,
+		},
+		{
+			name:    "Sad case: missing user ID",
+			user:    &domain.User{Username: "test"},
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to get matrix password",
+			user:    &domain.User{ID: &userID, Username: "test"},
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to set matrix password",
+			user:    &domain.User{ID: &userID, Username: "test"},
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to save rotated matrix password",
+			user:    &domain.User{ID: &userID, Username: "test"},
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to get matrix password rotated by another request",
+			user:    &domain.User{ID: &userID, Username: "test"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExt := extensionMock.NewFakeExtension()
+			fakeMatrix := mockMatrix.NewMatrixMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			uc := communities.NewUseCaseCommunitiesImpl(fakeDB, fakeDB, fakeDB, fakeExt, fakeMatrix, fakeNotification)
+
+			fakeDB.MockGetUserMatrixPasswordFn = func(ctx context.Context, userID string) (string, error) {
+				return encryptedPassword, nil
+			}
+			var rotatedPassword, savedPassword string
+			var loggedOutDevices, clearedPassword bool
+			fakeMatrix.MockSetUserPasswordFn = func(ctx context.Context, auth *domain.MatrixAuth, username string, password string, logoutDevices bool) error {
+				rotatedPassword = password
+				loggedOutDevices = logoutDevices
+				return nil
+			}
+			fakeDB.MockSetUserMatrixPasswordIfUnsetFn = func(ctx context.Context, userID string, encryptedPassword string) (bool, error) {
+				savedPassword = encryptedPassword
+				return true, nil
+			}
+			fakeDB.MockUpdateUserFn = func(ctx context.Context, user *domain.User, updateData map[string]interface{}) error {
+				if v, ok := updateData["matrix_password"]; ok && v == nil {
+					clearedPassword = true
+				}
+				return nil
+			}
+
+			if tt.name != "Happy case: get managed matrix credentials" {
+				fakeDB.MockGetUserMatrixPasswordFn = func(ctx context.Context, userID string) (string, error) {
+					return "", nil
+				}
+			}
+			if tt.name == "Happy case: matrix password rotated by another request" {
+				calls := 0
+				fakeDB.MockGetUserMatrixPasswordFn = func(ctx context.Context, userID string) (string, error) {
+					calls++
+					if calls == 1 {
+						return "", nil
+					}
+					return encryptedPassword, nil
+				}
+			}
+			if tt.name == "Happy case: matrix password rotated by another request" || tt.name == "Sad case: unable to get matrix password rotated by another request" {
+				fakeDB.MockSetUserMatrixPasswordIfUnsetFn = func(ctx context.Context, userID string, encryptedPassword string) (bool, error) {
+					return false, nil
+				}
+				fakeMatrix.MockSetUserPasswordFn = func(ctx context.Context, auth *domain.MatrixAuth, username string, password string, logoutDevices bool) error {
+					return fmt.Errorf("the matrix password should only be set by the request that saved it")
+				}
+			}
+			if tt.name == "Sad case: unable to get matrix password" {
+				fakeDB.MockGetUserMatrixPasswordFn = func(ctx context.Context, userID string) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to set matrix password" {
+				fakeMatrix.MockSetUserPasswordFn = func(ctx context.Context, auth *domain.MatrixAuth, username string, password string, logoutDevices bool) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to save rotated matrix password" {
+				fakeDB.MockSetUserMatrixPasswordIfUnsetFn = func(ctx context.Context, userID string, encryptedPassword string) (bool, error) {
+					return false, fmt.Errorf("an error occurred")
+				}
+				fakeMatrix.MockSetUserPasswordFn = func(ctx context.Context, auth *domain.MatrixAuth, username string, password string, logoutDevices bool) error {
+					return fmt.Errorf("the matrix password should only be set after it is saved")
+				}
+			}
+
+			got, err := uc.GetMatrixAuth(context.Background(), tt.user)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.GetMatrixAuth() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.name == "Sad case: unable to set matrix password" && !clearedPassword {
+				t.Errorf("expected the saved matrix password to be cleared when it fails to be set")
+			}
+			if tt.wantErr {
+				return
+			}
+
+			wantPassword := tt.wantPassword
+			if tt.name == "Happy case: rotate a legacy matrix password" {
+				wantPassword = rotatedPassword
+				if rotatedPassword == "" || rotatedPassword == userID {
+					t.Errorf("expected the legacy matrix password to be rotated")
+				}
+				decrypted, err := helpers.DecryptSensitiveData(savedPassword, serverutils.MustGetEnvVar("SENSITIVE_CONTENT_SECRET_KEY"))
+				if err != nil || decrypted != rotatedPassword {
+					t.Errorf("expected the rotated matrix password to be saved encrypted")
+				}
+				if !loggedOutDevices {
+					t.Errorf("expected the sessions started with the legacy matrix password to be logged out")
+				}
+			}
+			if got.Username != tt.user.Username || got.Password != wantPassword {
+				t.Errorf("UseCasesCommunitiesImpl.GetMatrixAuth() = %v, want password %v", got, wantPassword)
+			}
+		})
+	}
+}
+
+func TestUseCasesCommunitiesImpl_RotateMatrixPasswords(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: rotate matrix passwords",
+			wantErr: false,
+		},
+		{
+			name:    "Happy case: skip users that fail to be rotated",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to list users without a matrix password",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExt := extensionMock.NewFakeExtension()
+			fakeMatrix := mockMatrix.NewMatrixMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			uc := communities.NewUseCaseCommunitiesImpl(fakeDB, fakeDB, fakeDB, fakeExt, fakeMatrix, fakeNotification)
+
+			firstID, secondID := uuid.NewString(), uuid.NewString()
+			fakeDB.MockListUsersWithoutMatrixPasswordFn = func(ctx context.Context) ([]*domain.User, error) {
+				return []*domain.User{
+					{ID: &firstID, Username: "first"},
+					{ID: &secondID, Username: "second"},
+				}, nil
+			}
+			rotated := 0
+			fakeMatrix.MockSetUserPasswordFn = func(ctx context.Context, auth *domain.MatrixAuth, username string, password string, logoutDevices bool) error {
+				if !logoutDevices {
+					return fmt.Errorf("expected the sessions started with the legacy matrix password to be logged out")
+				}
+				rotated++
+				return nil
+			}
+
+			if tt.name == "Happy case: skip users that fail to be rotated" {
+				fakeMatrix.MockSetUserPasswordFn = func(ctx context.Context, auth *domain.MatrixAuth, username string, password string, logoutDevices bool) error {
+					if username == "first" {
+						return fmt.Errorf("an error occurred")
+					}
+					rotated++
+					return nil
+				}
+			}
+			if tt.name == "Sad case: unable to list users without a matrix password" {
+				fakeDB.MockListUsersWithoutMatrixPasswordFn = func(ctx context.Context) ([]*domain.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			err := uc.RotateMatrixPasswords(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.RotateMatrixPasswords() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.name == "Happy case: rotate matrix passwords" && rotated != 2 {
+				t.Errorf("expected 2 matrix passwords to be rotated, got %d", rotated)
+			}
+			if tt.name == "Happy case: skip users that fail to be rotated" && rotated != 1 {
+				t.Errorf("expected 1 matrix password to be rotated, got %d", rotated)
+			}
+		})
+	}
+}
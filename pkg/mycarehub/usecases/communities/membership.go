@@ -0,0 +1,301 @@
+package communities
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/exceptions"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+)
+
+// ICommunityMembership contains the methods used by users to find the communities in their program and to join and leave them
+type ICommunityMembership interface {
+	ListJoinedCommunities(ctx context.Context, searchTerm *string, paginationInput dto.PaginationsInput) (*domain.CommunityPage, error)
+	DiscoverCommunities(ctx context.Context, searchTerm *string, paginationInput dto.PaginationsInput) (*domain.CommunityPage, error)
+	JoinCommunity(ctx context.Context, communityID string) (bool, error)
+	LeaveCommunity(ctx context.Context, communityID string) (bool, error)
+}
+
+// communityUser holds the logged in user's details that are needed to work out their communities
+type communityUser struct {
+	user *domain.User
+	auth *domain.MatrixAuth
+	// client is the user's client profile in their current program. It is not set for staff members
+	client *domain.ClientProfile
+}
+
+// getCommunityUser gets the logged in user, their Matrix credentials and, for clients, their client profile
+func (uc *UseCasesCommunitiesImpl) getCommunityUser(ctx context.Context) (*communityUser, error) {
+	loggedInUserID, err := uc.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.GetLoggedInUserUIDErr(err)
+	}
+
+	user, err := uc.Query.GetUserProfileByUserID(ctx, loggedInUserID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.UserNotFoundError(err)
+	}
+
+	auth, err := uc.GetMatrixAuth(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	loggedInUser := &communityUser{
+		user: user,
+		auth: auth,
+	}
+
+	if user.CurrentUserType == enums.ClientUser.String() {
+		client, err := uc.Query.GetClientProfile(ctx, loggedInUserID, user.CurrentProgramID)
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			return nil, exceptions.ClientProfileNotFoundErr(err)
+		}
+		loggedInUser.client = client
+	}
+
+	return loggedInUser, nil
+}
+
+// isEligible returns true if the user can be a member of a community.
+// Clients must meet the community's membership criteria while staff members can be members of any community in their program
+func (u *communityUser) isEligible(community *domain.Community, now time.Time) bool {
+	if u.client == nil {
+		return community.ProgramID == u.user.CurrentProgramID
+	}
+
+	return community.IsEligible(u.client, now)
+}
+
+// matchesSearchTerm returns true if a community's name or description contains the search term
+func matchesSearchTerm(community *domain.Community, searchTerm *string) bool {
+	term := strings.ToLower(strings.TrimSpace(stringValue(searchTerm)))
+	if term == "" {
+		return true
+	}
+
+	return strings.Contains(strings.ToLower(community.Name), term) ||
+		strings.Contains(strings.ToLower(community.Description), term)
+}
+
+// paginateCommunities returns the page of communities described by the pagination and fills in its page information
+func paginateCommunities(communities []*domain.Community, pagination *domain.Pagination) []*domain.Community {
+	pagination.Count = int64(len(communities))
+	pagination.TotalPages = int(math.Ceil(float64(pagination.Count) / float64(pagination.GetLimit())))
+
+	currentPage := pagination.GetPage()
+
+	nextPage := currentPage + 1
+	if nextPage <= pagination.TotalPages {
+		pagination.NextPage = &nextPage
+	}
+
+	previousPage := currentPage - 1
+	if previousPage > 0 {
+		pagination.PreviousPage = &previousPage
+	}
+
+	start := pagination.GetOffset()
+	if start > len(communities) {
+		start = len(communities)
+	}
+	end := start + pagination.GetLimit()
+	if end > len(communities) {
+		end = len(communities)
+	}
+
+	return communities[start:end]
+}
+
+// listCommunityPage lists the communities in the user's program that pass the filter as a page of communities
+// with their metadata filled in. The joined rooms are the rooms the user has joined mapped to their unread notifications
+func (uc *UseCasesCommunitiesImpl) listCommunityPage(
+	ctx context.Context,
+	loggedInUser *communityUser,
+	joinedRooms map[string]int,
+	searchTerm *string,
+	paginationInput dto.PaginationsInput,
+	filter func(community *domain.Community) bool,
+) (*domain.CommunityPage, error) {
+	communities, err := uc.Query.ListCommunities(ctx, loggedInUser.user.CurrentProgramID, loggedInUser.user.CurrentOrganizationID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to list communities: %w", err)
+	}
+
+	results := []*domain.Community{}
+	for _, community := range communities {
+		if matchesSearchTerm(community, searchTerm) && filter(community) {
+			results = append(results, community)
+		}
+	}
+
+	page := &domain.Pagination{
+		Limit:       paginationInput.Limit,
+		CurrentPage: paginationInput.CurrentPage,
+	}
+	results = paginateCommunities(results, page)
+
+	now := time.Now()
+	serviceAccount := matrixServiceAccount()
+	for _, community := range results {
+		community.UnreadCount = joinedRooms[community.RoomID]
+		community.Eligible = loggedInUser.isEligible(community, now)
+
+		members, err := uc.Matrix.ListRoomMembers(ctx, serviceAccount, community.RoomID)
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			log.Printf("failed to list the members of community %s: %v", community.ID, err)
+			continue
+		}
+		for _, member := range members {
+			if member.IsJoined() {
+				community.MemberCount++
+			}
+		}
+	}
+
+	return &domain.CommunityPage{
+		Pagination:  *page,
+		Communities: results,
+	}, nil
+}
+
+// ListJoinedCommunities lists the communities in the logged in user's program that they have joined.
+// The communities can be searched by their name or description
+func (uc *UseCasesCommunitiesImpl) ListJoinedCommunities(ctx context.Context, searchTerm *string, paginationInput dto.PaginationsInput) (*domain.CommunityPage, error) {
+	if err := paginationInput.Validate(); err != nil {
+		return nil, fmt.Errorf("pagination input validation failed: %v", err)
+	}
+
+	loggedInUser, err := uc.getCommunityUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	joinedRooms, err := uc.Matrix.ListJoinedRooms(ctx, loggedInUser.auth)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to list joined communities: %w", err)
+	}
+
+	return uc.listCommunityPage(ctx, loggedInUser, joinedRooms, searchTerm, paginationInput, func(community *domain.Community) bool {
+		_, joined := joinedRooms[community.RoomID]
+		return joined
+	})
+}
+
+// DiscoverCommunities lists the communities in the logged in user's program that they have not joined but are eligible to join.
+// The communities can be searched by their name or description
+func (uc *UseCasesCommunitiesImpl) DiscoverCommunities(ctx context.Context, searchTerm *string, paginationInput dto.PaginationsInput) (*domain.CommunityPage, error) {
+	if err := paginationInput.Validate(); err != nil {
+		return nil, fmt.Errorf("pagination input validation failed: %v", err)
+	}
+
+	loggedInUser, err := uc.getCommunityUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	joinedRooms, err := uc.Matrix.ListJoinedRooms(ctx, loggedInUser.auth)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to list joined communities: %w", err)
+	}
+
+	now := time.Now()
+	return uc.listCommunityPage(ctx, loggedInUser, joinedRooms, searchTerm, paginationInput, func(community *domain.Community) bool {
+		_, joined := joinedRooms[community.RoomID]
+		return !joined && loggedInUser.isEligible(community, now)
+	})
+}
+
+// JoinCommunity adds the logged in user to a community they are eligible for.
+// The user is invited with the service account, when they have not been invited already, and then joins with their own account
+func (uc *UseCasesCommunitiesImpl) JoinCommunity(ctx context.Context, communityID string) (bool, error) {
+	loggedInUser, err := uc.getCommunityUser(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	community, err := uc.Query.GetCommunityByID(ctx, communityID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to get community: %w", err)
+	}
+
+	if !loggedInUser.isEligible(community, time.Now()) {
+		return false, fmt.Errorf("user is not eligible to join community %s", communityID)
+	}
+
+	serviceAccount := matrixServiceAccount()
+	members, err := uc.Matrix.ListRoomMembers(ctx, serviceAccount, community.RoomID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to list the members of community %s: %w", communityID, err)
+	}
+
+	var membership *domain.MatrixRoomMember
+	for _, member := range members {
+		if strings.EqualFold(member.Username, loggedInUser.user.Username) {
+			membership = member
+			break
+		}
+	}
+
+	switch {
+	case membership != nil && membership.IsJoined():
+		return true, nil
+
+	case membership != nil && membership.Membership == "ban":
+		return false, fmt.Errorf("user has been banned from community %s", communityID)
+
+	case membership == nil || !membership.IsMember():
+		err = uc.Matrix.InviteUserToRoom(ctx, serviceAccount, community.RoomID, loggedInUser.user.Username)
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			return false, fmt.Errorf("failed to invite user to community: %w", err)
+		}
+	}
+
+	err = uc.Matrix.JoinRoom(ctx, loggedInUser.auth, community.RoomID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to join community: %w", err)
+	}
+
+	return true, nil
+}
+
+// LeaveCommunity removes the logged in user from a community. A client who leaves a community is not added back to it
+// when the community's members are synced, but they can join it again themselves
+func (uc *UseCasesCommunitiesImpl) LeaveCommunity(ctx context.Context, communityID string) (bool, error) {
+	loggedInUser, err := uc.getCommunityUser(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	community, err := uc.Query.GetCommunityByID(ctx, communityID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to get community: %w", err)
+	}
+
+	err = uc.Matrix.LeaveRoom(ctx, loggedInUser.auth, community.RoomID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to leave community: %w", err)
+	}
+
+	return true, nil
+}
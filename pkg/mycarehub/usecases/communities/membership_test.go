@@ -0,0 +1,512 @@
+package communities_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	extensionMock "github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension/mock"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+	pgMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/mock"
+	mockMatrix "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/matrix/mock"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/communities"
+	notificationMock "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/notification/mock"
+)
+
+// setupMembershipTest sets up a logged in client who has joined the "joined" community and is eligible for the "eligible" community.
+// The client is not eligible for the "ineligible" community
+func setupMembershipTest(t *testing.T) (communities.UseCasesCommunities, *pgMock.PostgresMock, *mockMatrix.MatrixMock, *extensionMock.FakeExtensionImpl) {
+	t.Helper()
+
+	fakeDB := pgMock.NewPostgresMock()
+	fakeExt := extensionMock.NewFakeExtension()
+	fakeMatrix := mockMatrix.NewMatrixMock()
+	fakeNotification := notificationMock.NewServiceNotificationMock()
+	uc := communities.NewUseCaseCommunitiesImpl(fakeDB, fakeDB, fakeDB, fakeExt, fakeMatrix, fakeNotification)
+
+	userID := uuid.NewString()
+	programID := uuid.NewString()
+	fakeExt.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+		return userID, nil
+	}
+	fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, id string) (*domain.User, error) {
+		return &domain.User{
+			ID:               &id,
+			Username:         "jane",
+			CurrentProgramID: programID,
+			CurrentUserType:  enums.ClientUser.String(),
+		}, nil
+	}
+	fakeDB.MockGetClientProfileFn = func(ctx context.Context, id string, programID string) (*domain.ClientProfile, error) {
+		return communityClient("jane", programID, true), nil
+	}
+
+	communitiesByID := map[string]*domain.Community{
+		"joined": {
+			ID:          "joined",
+			RoomID:      "!joined:example.com",
+			Name:        "Teen mothers",
+			Description: "A community for young mothers",
+			ProgramID:   programID,
+		},
+		"eligible": {
+			ID:          "eligible",
+			RoomID:      "!eligible:example.com",
+			Name:        "Adherence club",
+			Description: "Tips on taking medication",
+			ProgramID:   programID,
+		},
+		"ineligible": {
+			ID:          "ineligible",
+			RoomID:      "!ineligible:example.com",
+			Name:        "Fathers",
+			Description: "A community for fathers",
+			ProgramID:   uuid.NewString(),
+		},
+	}
+	fakeDB.MockListCommunitiesFn = func(ctx context.Context, programID, organisationID string) ([]*domain.Community, error) {
+		return []*domain.Community{communitiesByID["joined"], communitiesByID["eligible"], communitiesByID["ineligible"]}, nil
+	}
+	fakeDB.MockGetCommunityByIDFn = func(ctx context.Context, communityID string) (*domain.Community, error) {
+		community, ok := communitiesByID[communityID]
+		if !ok {
+			return nil, fmt.Errorf("community not found")
+		}
+		return community, nil
+	}
+
+	fakeMatrix.MockListJoinedRoomsFn = func(ctx context.Context, auth *domain.MatrixAuth) (map[string]int, error) {
+		return map[string]int{"!joined:example.com": 3}, nil
+	}
+	fakeMatrix.MockListRoomMembersFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]*domain.MatrixRoomMember, error) {
+		return []*domain.MatrixRoomMember{
+			roomMember("staff", "join", true),
+			roomMember("john", "join", true),
+			roomMember("mary", "invite", false),
+		}, nil
+	}
+
+	return uc, fakeDB, fakeMatrix, fakeExt
+}
+
+func TestUseCasesCommunitiesImpl_ListJoinedCommunities(t *testing.T) {
+	searchTerm := "MOTHERS"
+	otherSearchTerm := "adherence"
+
+	type args struct {
+		searchTerm      *string
+		paginationInput dto.PaginationsInput
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name: "Happy case: list joined communities",
+			args: args{
+				paginationInput: dto.PaginationsInput{Limit: 10, CurrentPage: 1},
+			},
+			wantCount: 1,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: search joined communities",
+			args: args{
+				searchTerm:      &searchTerm,
+				paginationInput: dto.PaginationsInput{Limit: 10, CurrentPage: 1},
+			},
+			wantCount: 1,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: search term does not match any joined community",
+			args: args{
+				searchTerm:      &otherSearchTerm,
+				paginationInput: dto.PaginationsInput{Limit: 10, CurrentPage: 1},
+			},
+			wantCount: 0,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: unable to count community members",
+			args: args{
+				paginationInput: dto.PaginationsInput{Limit: 10, CurrentPage: 1},
+			},
+			wantCount: 1,
+			wantErr:   false,
+		},
+		{
+			name: "Sad case: invalid pagination input",
+			args: args{
+				paginationInput: dto.PaginationsInput{Limit: 10},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get logged in user",
+			args: args{
+				paginationInput: dto.PaginationsInput{Limit: 10, CurrentPage: 1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get client profile",
+			args: args{
+				paginationInput: dto.PaginationsInput{Limit: 10, CurrentPage: 1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to list joined rooms",
+			args: args{
+				paginationInput: dto.PaginationsInput{Limit: 10, CurrentPage: 1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to list communities",
+			args: args{
+				paginationInput: dto.PaginationsInput{Limit: 10, CurrentPage: 1},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, fakeDB, fakeMatrix, fakeExt := setupMembershipTest(t)
+
+			switch tt.name {
+			case "Happy case: unable to count community members":
+				fakeMatrix.MockListRoomMembersFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]*domain.MatrixRoomMember, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			case "Sad case: unable to get logged in user":
+				fakeExt.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			case "Sad case: unable to get client profile":
+				fakeDB.MockGetClientProfileFn = func(ctx context.Context, userID string, programID string) (*domain.ClientProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			case "Sad case: unable to list joined rooms":
+				fakeMatrix.MockListJoinedRoomsFn = func(ctx context.Context, auth *domain.MatrixAuth) (map[string]int, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			case "Sad case: unable to list communities":
+				fakeDB.MockListCommunitiesFn = func(ctx context.Context, programID, organisationID string) ([]*domain.Community, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := uc.ListJoinedCommunities(context.Background(), tt.args.searchTerm, tt.args.paginationInput)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.ListJoinedCommunities() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got.Communities) != tt.wantCount || got.Pagination.Count != int64(tt.wantCount) {
+				t.Errorf("expected %d communities, got %d with a count of %d", tt.wantCount, len(got.Communities), got.Pagination.Count)
+				return
+			}
+			if tt.name == "Happy case: list joined communities" {
+				community := got.Communities[0]
+				if community.ID != "joined" || community.UnreadCount != 3 || community.MemberCount != 2 || !community.Eligible {
+					t.Errorf("unexpected community metadata: %+v", community)
+				}
+			}
+		})
+	}
+}
+
+func TestUseCasesCommunitiesImpl_DiscoverCommunities(t *testing.T) {
+	searchTerm := "mothers"
+
+	type args struct {
+		searchTerm      *string
+		paginationInput dto.PaginationsInput
+	}
+	tests := []struct {
+		name      string
+		args      args
+		userType  enums.UsersType
+		wantIDs   []string
+		wantPages int
+		wantErr   bool
+	}{
+		{
+			name: "Happy case: discover communities a client is eligible to join",
+			args: args{
+				paginationInput: dto.PaginationsInput{Limit: 10, CurrentPage: 1},
+			},
+			userType:  enums.ClientUser,
+			wantIDs:   []string{"eligible"},
+			wantPages: 1,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: staff can discover any community in their program",
+			args: args{
+				paginationInput: dto.PaginationsInput{Limit: 10, CurrentPage: 1},
+			},
+			userType:  enums.StaffUser,
+			wantIDs:   []string{"eligible"},
+			wantPages: 1,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: joined communities are not discovered",
+			args: args{
+				searchTerm:      &searchTerm,
+				paginationInput: dto.PaginationsInput{Limit: 10, CurrentPage: 1},
+			},
+			userType:  enums.ClientUser,
+			wantIDs:   []string{},
+			wantPages: 0,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: page past the last community",
+			args: args{
+				paginationInput: dto.PaginationsInput{Limit: 10, CurrentPage: 3},
+			},
+			userType:  enums.ClientUser,
+			wantIDs:   []string{},
+			wantPages: 1,
+			wantErr:   false,
+		},
+		{
+			name: "Sad case: invalid pagination input",
+			args: args{
+				paginationInput: dto.PaginationsInput{Limit: 10},
+			},
+			userType: enums.ClientUser,
+			wantErr:  true,
+		},
+		{
+			name: "Sad case: unable to list joined rooms",
+			args: args{
+				paginationInput: dto.PaginationsInput{Limit: 10, CurrentPage: 1},
+			},
+			userType: enums.ClientUser,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, fakeDB, fakeMatrix, _ := setupMembershipTest(t)
+
+			profile, _ := fakeDB.MockGetUserProfileByUserIDFn(context.Background(), uuid.NewString())
+			fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+				user := *profile
+				user.ID = &userID
+				user.CurrentUserType = tt.userType.String()
+				return &user, nil
+			}
+			if tt.name == "Sad case: unable to list joined rooms" {
+				fakeMatrix.MockListJoinedRoomsFn = func(ctx context.Context, auth *domain.MatrixAuth) (map[string]int, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := uc.DiscoverCommunities(context.Background(), tt.args.searchTerm, tt.args.paginationInput)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.DiscoverCommunities() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			gotIDs := []string{}
+			for _, community := range got.Communities {
+				gotIDs = append(gotIDs, community.ID)
+				if !community.Eligible || community.UnreadCount != 0 {
+					t.Errorf("unexpected community metadata: %+v", community)
+				}
+			}
+			if fmt.Sprint(gotIDs) != fmt.Sprint(tt.wantIDs) {
+				t.Errorf("expected communities %v, got %v", tt.wantIDs, gotIDs)
+			}
+			if got.Pagination.TotalPages != tt.wantPages {
+				t.Errorf("expected %d pages, got %d", tt.wantPages, got.Pagination.TotalPages)
+			}
+		})
+	}
+}
+
+func TestUseCasesCommunitiesImpl_JoinCommunity(t *testing.T) {
+	tests := []struct {
+		name        string
+		communityID string
+		members     []*domain.MatrixRoomMember
+		wantInvited bool
+		wantJoined  bool
+		wantErr     bool
+	}{
+		{
+			name:        "Happy case: join a community",
+			communityID: "eligible",
+			wantInvited: true,
+			wantJoined:  true,
+			wantErr:     false,
+		},
+		{
+			name:        "Happy case: join a community the user was invited to",
+			communityID: "eligible",
+			members:     []*domain.MatrixRoomMember{roomMember("jane", "invite", false)},
+			wantJoined:  true,
+			wantErr:     false,
+		},
+		{
+			name:        "Happy case: join a community the user left",
+			communityID: "eligible",
+			members:     []*domain.MatrixRoomMember{roomMember("jane", "leave", true)},
+			wantInvited: true,
+			wantJoined:  true,
+			wantErr:     false,
+		},
+		{
+			name:        "Happy case: user has already joined the community",
+			communityID: "joined",
+			members:     []*domain.MatrixRoomMember{roomMember("jane", "join", true)},
+			wantErr:     false,
+		},
+		{
+			name:        "Sad case: user is not eligible",
+			communityID: "ineligible",
+			wantErr:     true,
+		},
+		{
+			name:        "Sad case: user was banned from the community",
+			communityID: "eligible",
+			members:     []*domain.MatrixRoomMember{roomMember("jane", "ban", false)},
+			wantErr:     true,
+		},
+		{
+			name:        "Sad case: unable to get community",
+			communityID: "unknown",
+			wantErr:     true,
+		},
+		{
+			name:        "Sad case: unable to list community members",
+			communityID: "eligible",
+			wantErr:     true,
+		},
+		{
+			name:        "Sad case: unable to invite user",
+			communityID: "eligible",
+			wantErr:     true,
+		},
+		{
+			name:        "Sad case: unable to join room",
+			communityID: "eligible",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _, fakeMatrix, _ := setupMembershipTest(t)
+
+			fakeMatrix.MockListRoomMembersFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]*domain.MatrixRoomMember, error) {
+				return tt.members, nil
+			}
+			invited, joined := false, false
+			fakeMatrix.MockInviteUserToRoomFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error {
+				invited = true
+				return nil
+			}
+			fakeMatrix.MockJoinRoomFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string) error {
+				joined = auth.Username == "jane"
+				return nil
+			}
+
+			switch tt.name {
+			case "Sad case: unable to list community members":
+				fakeMatrix.MockListRoomMembersFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]*domain.MatrixRoomMember, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			case "Sad case: unable to invite user":
+				fakeMatrix.MockInviteUserToRoomFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			case "Sad case: unable to join room":
+				fakeMatrix.MockJoinRoomFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := uc.JoinCommunity(context.Background(), tt.communityID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.JoinCommunity() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got == tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.JoinCommunity() = %v", got)
+			}
+			if !tt.wantErr && (invited != tt.wantInvited || joined != tt.wantJoined) {
+				t.Errorf("expected invited %v and joined %v, got invited %v and joined %v", tt.wantInvited, tt.wantJoined, invited, joined)
+			}
+		})
+	}
+}
+
+func TestUseCasesCommunitiesImpl_LeaveCommunity(t *testing.T) {
+	tests := []struct {
+		name        string
+		communityID string
+		wantErr     bool
+	}{
+		{
+			name:        "Happy case: leave a community",
+			communityID: "joined",
+			wantErr:     false,
+		},
+		{
+			name:        "Sad case: unable to get logged in user",
+			communityID: "joined",
+			wantErr:     true,
+		},
+		{
+			name:        "Sad case: unable to get community",
+			communityID: "unknown",
+			wantErr:     true,
+		},
+		{
+			name:        "Sad case: unable to leave room",
+			communityID: "joined",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _, fakeMatrix, fakeExt := setupMembershipTest(t)
+
+			switch tt.name {
+			case "Sad case: unable to get logged in user":
+				fakeExt.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			case "Sad case: unable to leave room":
+				fakeMatrix.MockLeaveRoomFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := uc.LeaveCommunity(context.Background(), tt.communityID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.LeaveCommunity() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got == tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.LeaveCommunity() = %v", got)
+			}
+		})
+	}
+}
@@ -6,17 +6,56 @@ import (
 	"github.com/brianvoe/gofakeit"
 	"github.com/google/uuid"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 )
 
 // CommunityUsecaseMock is used to mock community methods
 type CommunityUsecaseMock struct {
-	MockCreateCommunityFn func(ctx context.Context, communityInput *dto.CommunityInput) (*domain.Community, error)
-	MockListCommunitiesFn func(ctx context.Context) ([]string, error)
+	MockCreateCommunityFn              func(ctx context.Context, communityInput *dto.CommunityInput) (*domain.Community, error)
+	MockListCommunitiesFn              func(ctx context.Context) ([]string, error)
+	MockSyncClientCommunitiesFn        func(ctx context.Context, clientID string) error
+	MockReconcileCommunitiesFn         func(ctx context.Context) error
+	MockPromoteToModeratorFn           func(ctx context.Context, communityID string, userID string) (bool, error)
+	MockDemoteModeratorFn              func(ctx context.Context, communityID string, userID string) (bool, error)
+	MockRemoveCommunityMemberFn        func(ctx context.Context, communityID string, userID string, reason *string) (bool, error)
+	MockBanCommunityMemberFn           func(ctx context.Context, communityID string, userID string, reason *string) (bool, error)
+	MockUnbanCommunityMemberFn         func(ctx context.Context, communityID string, userID string) (bool, error)
+	MockMuteCommunityMemberFn          func(ctx context.Context, communityID string, userID string) (bool, error)
+	MockUnmuteCommunityMemberFn        func(ctx context.Context, communityID string, userID string) (bool, error)
+	MockRedactCommunityMessageFn       func(ctx context.Context, communityID string, eventID string, reason *string) (bool, error)
+	MockRegisterMatrixUserFn           func(ctx context.Context, user *domain.User, admin bool) error
+	MockGetMatrixAuthFn                func(ctx context.Context, user *domain.User) (*domain.MatrixAuth, error)
+	MockRotateMatrixPasswordsFn        func(ctx context.Context) error
+	MockListJoinedCommunitiesFn        func(ctx context.Context, searchTerm *string, paginationInput dto.PaginationsInput) (*domain.CommunityPage, error)
+	MockDiscoverCommunitiesFn          func(ctx context.Context, searchTerm *string, paginationInput dto.PaginationsInput) (*domain.CommunityPage, error)
+	MockJoinCommunityFn                func(ctx context.Context, communityID string) (bool, error)
+	MockLeaveCommunityFn               func(ctx context.Context, communityID string) (bool, error)
+	MockCreateCommunityCrisisKeywordFn func(ctx context.Context, input dto.CommunityCrisisKeywordInput) (*domain.CommunityCrisisKeyword, error)
+	MockListCommunityCrisisKeywordsFn  func(ctx context.Context) ([]*domain.CommunityCrisisKeyword, error)
+	MockDeleteCommunityCrisisKeywordFn func(ctx context.Context, keywordID string) (bool, error)
+	MockProcessMatrixEventsFn          func(ctx context.Context, events []*dto.MatrixEvent) error
 }
 
 // NewCommunityUsecaseMock instantiates all the community usecase mock methods
 func NewCommunityUsecaseMock() *CommunityUsecaseMock {
+	community := &domain.Community{
+		ID:          uuid.NewString(),
+		RoomID:      gofakeit.BeerName(),
+		Name:        gofakeit.BeerName(),
+		Description: gofakeit.BeerAlcohol(),
+		MemberCount: 10,
+		UnreadCount: 2,
+		Eligible:    true,
+	}
+
+	crisisKeyword := &domain.CommunityCrisisKeyword{
+		ID:       uuid.NewString(),
+		Active:   true,
+		Keyword:  "end my life",
+		Category: enums.CommunityCrisisCategorySelfHarm,
+	}
+
 	return &CommunityUsecaseMock{
 		MockCreateCommunityFn: func(ctx context.Context, communityInput *dto.CommunityInput) (*domain.Community, error) {
 			return &domain.Community{
@@ -36,6 +75,88 @@ func NewCommunityUsecaseMock() *CommunityUsecaseMock {
 		MockListCommunitiesFn: func(ctx context.Context) ([]string, error) {
 			return []string{"test"}, nil
 		},
+		MockSyncClientCommunitiesFn: func(ctx context.Context, clientID string) error {
+			return nil
+		},
+		MockReconcileCommunitiesFn: func(ctx context.Context) error {
+			return nil
+		},
+		MockPromoteToModeratorFn: func(ctx context.Context, communityID string, userID string) (bool, error) {
+			return true, nil
+		},
+		MockDemoteModeratorFn: func(ctx context.Context, communityID string, userID string) (bool, error) {
+			return true, nil
+		},
+		MockRemoveCommunityMemberFn: func(ctx context.Context, communityID string, userID string, reason *string) (bool, error) {
+			return true, nil
+		},
+		MockBanCommunityMemberFn: func(ctx context.Context, communityID string, userID string, reason *string) (bool, error) {
+			return true, nil
+		},
+		MockUnbanCommunityMemberFn: func(ctx context.Context, communityID string, userID string) (bool, error) {
+			return true, nil
+		},
+		MockMuteCommunityMemberFn: func(ctx context.Context, communityID string, userID string) (bool, error) {
+			return true, nil
+		},
+		MockUnmuteCommunityMemberFn: func(ctx context.Context, communityID string, userID string) (bool, error) {
+			return true, nil
+		},
+		MockRedactCommunityMessageFn: func(ctx context.Context, communityID string, eventID string, reason *string) (bool, error) {
+			return true, nil
+		},
+		MockRegisterMatrixUserFn: func(ctx context.Context, user *domain.User, admin bool) error {
+			return nil
+		},
+		MockGetMatrixAuthFn: func(ctx context.Context, user *domain.User) (*domain.MatrixAuth, error) {
+			return &domain.MatrixAuth{
+				Username: user.Username,
+				Password: gofakeit.Password(true, true, true, false, false, 32),
+			}, nil
+		},
+		MockRotateMatrixPasswordsFn: func(ctx context.Context) error {
+			return nil
+		},
+		MockListJoinedCommunitiesFn: func(ctx context.Context, searchTerm *string, paginationInput dto.PaginationsInput) (*domain.CommunityPage, error) {
+			return &domain.CommunityPage{
+				Pagination: domain.Pagination{
+					Limit:       10,
+					CurrentPage: 1,
+					Count:       1,
+					TotalPages:  1,
+				},
+				Communities: []*domain.Community{community},
+			}, nil
+		},
+		MockDiscoverCommunitiesFn: func(ctx context.Context, searchTerm *string, paginationInput dto.PaginationsInput) (*domain.CommunityPage, error) {
+			return &domain.CommunityPage{
+				Pagination: domain.Pagination{
+					Limit:       10,
+					CurrentPage: 1,
+					Count:       1,
+					TotalPages:  1,
+				},
+				Communities: []*domain.Community{community},
+			}, nil
+		},
+		MockJoinCommunityFn: func(ctx context.Context, communityID string) (bool, error) {
+			return true, nil
+		},
+		MockLeaveCommunityFn: func(ctx context.Context, communityID string) (bool, error) {
+			return true, nil
+		},
+		MockCreateCommunityCrisisKeywordFn: func(ctx context.Context, input dto.CommunityCrisisKeywordInput) (*domain.CommunityCrisisKeyword, error) {
+			return crisisKeyword, nil
+		},
+		MockListCommunityCrisisKeywordsFn: func(ctx context.Context) ([]*domain.CommunityCrisisKeyword, error) {
+			return []*domain.CommunityCrisisKeyword{crisisKeyword}, nil
+		},
+		MockDeleteCommunityCrisisKeywordFn: func(ctx context.Context, keywordID string) (bool, error) {
+			return true, nil
+		},
+		MockProcessMatrixEventsFn: func(ctx context.Context, events []*dto.MatrixEvent) error {
+			return nil
+		},
 	}
 }
 
@@ -48,3 +169,108 @@ func (c *CommunityUsecaseMock) CreateCommunity(ctx context.Context, communityInp
 func (c *CommunityUsecaseMock) ListCommunities(ctx context.Context) ([]string, error) {
 	return c.MockListCommunitiesFn(ctx)
 }
+
+// SyncClientCommunities mocks syncing a client's membership of the communities in their program
+func (c *CommunityUsecaseMock) SyncClientCommunities(ctx context.Context, clientID string) error {
+	return c.MockSyncClientCommunitiesFn(ctx, clientID)
+}
+
+// ReconcileCommunities mocks re-syncing the members of every community
+func (c *CommunityUsecaseMock) ReconcileCommunities(ctx context.Context) error {
+	return c.MockReconcileCommunitiesFn(ctx)
+}
+
+// PromoteToModerator mocks promoting a member to a moderator of a community
+func (c *CommunityUsecaseMock) PromoteToModerator(ctx context.Context, communityID string, userID string) (bool, error) {
+	return c.MockPromoteToModeratorFn(ctx, communityID, userID)
+}
+
+// DemoteModerator mocks demoting a moderator of a community
+func (c *CommunityUsecaseMock) DemoteModerator(ctx context.Context, communityID string, userID string) (bool, error) {
+	return c.MockDemoteModeratorFn(ctx, communityID, userID)
+}
+
+// RemoveCommunityMember mocks removing a member from a community
+func (c *CommunityUsecaseMock) RemoveCommunityMember(ctx context.Context, communityID string, userID string, reason *string) (bool, error) {
+	return c.MockRemoveCommunityMemberFn(ctx, communityID, userID, reason)
+}
+
+// BanCommunityMember mocks banning a member from a community
+func (c *CommunityUsecaseMock) BanCommunityMember(ctx context.Context, communityID string, userID string, reason *string) (bool, error) {
+	return c.MockBanCommunityMemberFn(ctx, communityID, userID, reason)
+}
+
+// UnbanCommunityMember mocks unbanning a member from a community
+func (c *CommunityUsecaseMock) UnbanCommunityMember(ctx context.Context, communityID string, userID string) (bool, error) {
+	return c.MockUnbanCommunityMemberFn(ctx, communityID, userID)
+}
+
+// MuteCommunityMember mocks muting a member of a community
+func (c *CommunityUsecaseMock) MuteCommunityMember(ctx context.Context, communityID string, userID string) (bool, error) {
+	return c.MockMuteCommunityMemberFn(ctx, communityID, userID)
+}
+
+// UnmuteCommunityMember mocks unmuting a member of a community
+func (c *CommunityUsecaseMock) UnmuteCommunityMember(ctx context.Context, communityID string, userID string) (bool, error) {
+	return c.MockUnmuteCommunityMemberFn(ctx, communityID, userID)
+}
+
+// RedactCommunityMessage mocks redacting a message in a community
+func (c *CommunityUsecaseMock) RedactCommunityMessage(ctx context.Context, communityID string, eventID string, reason *string) (bool, error) {
+	return c.MockRedactCommunityMessageFn(ctx, communityID, eventID, reason)
+}
+
+// RegisterMatrixUser mocks creating a Matrix account with a generated password
+func (c *CommunityUsecaseMock) RegisterMatrixUser(ctx context.Context, user *domain.User, admin bool) error {
+	return c.MockRegisterMatrixUserFn(ctx, user, admin)
+}
+
+// GetMatrixAuth mocks getting the credentials used to authenticate a user to Matrix
+func (c *CommunityUsecaseMock) GetMatrixAuth(ctx context.Context, user *domain.User) (*domain.MatrixAuth, error) {
+	return c.MockGetMatrixAuthFn(ctx, user)
+}
+
+// RotateMatrixPasswords mocks replacing the legacy Matrix passwords
+func (c *CommunityUsecaseMock) RotateMatrixPasswords(ctx context.Context) error {
+	return c.MockRotateMatrixPasswordsFn(ctx)
+}
+
+// ListJoinedCommunities mocks listing the communities the logged in user has joined
+func (c *CommunityUsecaseMock) ListJoinedCommunities(ctx context.Context, searchTerm *string, paginationInput dto.PaginationsInput) (*domain.CommunityPage, error) {
+	return c.MockListJoinedCommunitiesFn(ctx, searchTerm, paginationInput)
+}
+
+// DiscoverCommunities mocks listing the communities the logged in user is eligible to join
+func (c *CommunityUsecaseMock) DiscoverCommunities(ctx context.Context, searchTerm *string, paginationInput dto.PaginationsInput) (*domain.CommunityPage, error) {
+	return c.MockDiscoverCommunitiesFn(ctx, searchTerm, paginationInput)
+}
+
+// JoinCommunity mocks the logged in user joining a community
+func (c *CommunityUsecaseMock) JoinCommunity(ctx context.Context, communityID string) (bool, error) {
+	return c.MockJoinCommunityFn(ctx, communityID)
+}
+
+// LeaveCommunity mocks the logged in user leaving a community
+func (c *CommunityUsecaseMock) LeaveCommunity(ctx context.Context, communityID string) (bool, error) {
+	return c.MockLeaveCommunityFn(ctx, communityID)
+}
+
+// CreateCommunityCrisisKeyword mocks adding a crisis keyword to the logged in user's program
+func (c *CommunityUsecaseMock) CreateCommunityCrisisKeyword(ctx context.Context, input dto.CommunityCrisisKeywordInput) (*domain.CommunityCrisisKeyword, error) {
+	return c.MockCreateCommunityCrisisKeywordFn(ctx, input)
+}
+
+// ListCommunityCrisisKeywords mocks listing the crisis keywords of the logged in user's program
+func (c *CommunityUsecaseMock) ListCommunityCrisisKeywords(ctx context.Context) ([]*domain.CommunityCrisisKeyword, error) {
+	return c.MockListCommunityCrisisKeywordsFn(ctx)
+}
+
+// DeleteCommunityCrisisKeyword mocks deactivating a crisis keyword
+func (c *CommunityUsecaseMock) DeleteCommunityCrisisKeyword(ctx context.Context, keywordID string) (bool, error) {
+	return c.MockDeleteCommunityCrisisKeywordFn(ctx, keywordID)
+}
+
+// ProcessMatrixEvents mocks scanning community messages for crisis keywords
+func (c *CommunityUsecaseMock) ProcessMatrixEvents(ctx context.Context, events []*dto.MatrixEvent) error {
+	return c.MockProcessMatrixEventsFn(ctx, events)
+}
@@ -0,0 +1,238 @@
+package communities
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/exceptions"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/matrix"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/notification"
+)
+
+// ICommunityModeration contains the methods used by moderators to manage the members and messages of a community.
+// The actions are performed in Matrix with the logged in user's own account so Matrix's power levels decide
+// whether the user is allowed to perform them in the community
+type ICommunityModeration interface {
+	PromoteToModerator(ctx context.Context, communityID string, userID string) (bool, error)
+	DemoteModerator(ctx context.Context, communityID string, userID string) (bool, error)
+	RemoveCommunityMember(ctx context.Context, communityID string, userID string, reason *string) (bool, error)
+	BanCommunityMember(ctx context.Context, communityID string, userID string, reason *string) (bool, error)
+	UnbanCommunityMember(ctx context.Context, communityID string, userID string) (bool, error)
+	MuteCommunityMember(ctx context.Context, communityID string, userID string) (bool, error)
+	UnmuteCommunityMember(ctx context.Context, communityID string, userID string) (bool, error)
+	RedactCommunityMessage(ctx context.Context, communityID string, eventID string, reason *string) (bool, error)
+}
+
+// moderationAction holds what is needed to perform a moderation action in a community
+type moderationAction struct {
+	moderator *domain.User
+	auth      *domain.MatrixAuth
+	community *domain.Community
+	member    *domain.User
+}
+
+// getModerationAction gets the logged in moderator, the community being moderated and, when a user ID is supplied,
+// the member the action is performed on
+func (uc *UseCasesCommunitiesImpl) getModerationAction(ctx context.Context, communityID string, userID *string) (*moderationAction, error) {
+	loggedInUserID, err := uc.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.GetLoggedInUserUIDErr(err)
+	}
+
+	moderator, err := uc.Query.GetUserProfileByUserID(ctx, loggedInUserID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.UserNotFoundError(err)
+	}
+
+	auth, err := uc.GetMatrixAuth(ctx, moderator)
+	if err != nil {
+		return nil, err
+	}
+
+	community, err := uc.Query.GetCommunityByID(ctx, communityID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get community: %w", err)
+	}
+
+	action := &moderationAction{
+		moderator: moderator,
+		auth:      auth,
+		community: community,
+	}
+
+	if userID == nil {
+		return action, nil
+	}
+
+	if *userID == loggedInUserID {
+		return nil, fmt.Errorf("a moderator can not perform moderation actions on themselves")
+	}
+
+	member, err := uc.Query.GetUserProfileByUserID(ctx, *userID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.UserNotFoundError(err)
+	}
+	action.member = member
+
+	return action, nil
+}
+
+// setMemberPowerLevel changes a member's power level in a community
+func (uc *UseCasesCommunitiesImpl) setMemberPowerLevel(ctx context.Context, communityID string, userID string, powerLevel int) (*moderationAction, error) {
+	action, err := uc.getModerationAction(ctx, communityID, &userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.Matrix.SetUserPowerLevel(ctx, action.auth, action.community.RoomID, action.member.Username, powerLevel)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to change the member's power level: %w", err)
+	}
+
+	return action, nil
+}
+
+// notifyMember sends a moderation notification to the member it was performed on.
+// The moderation action has already taken effect so a failure to notify the member is only reported
+func (uc *UseCasesCommunitiesImpl) notifyMember(ctx context.Context, action *moderationAction, notificationType enums.NotificationType) {
+	message := notification.ComposeClientNotification(notificationType, notification.ClientNotificationInput{
+		Community: action.community,
+		Promoter:  action.moderator,
+		Demoter:   action.moderator,
+	})
+
+	err := uc.Notification.NotifyUser(ctx, action.member, message)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		log.Printf("failed to notify user of moderation action in community %s: %v", action.community.ID, err)
+	}
+}
+
+// PromoteToModerator makes a member a moderator of a community and notifies them
+func (uc *UseCasesCommunitiesImpl) PromoteToModerator(ctx context.Context, communityID string, userID string) (bool, error) {
+	action, err := uc.setMemberPowerLevel(ctx, communityID, userID, matrix.ModeratorPowerLevel)
+	if err != nil {
+		return false, err
+	}
+
+	uc.notifyMember(ctx, action, enums.NotificationTypePromoteToModerator)
+
+	return true, nil
+}
+
+// DemoteModerator makes a moderator of a community a regular member and notifies them
+func (uc *UseCasesCommunitiesImpl) DemoteModerator(ctx context.Context, communityID string, userID string) (bool, error) {
+	action, err := uc.setMemberPowerLevel(ctx, communityID, userID, matrix.MemberPowerLevel)
+	if err != nil {
+		return false, err
+	}
+
+	uc.notifyMember(ctx, action, enums.NotificationTypeDemoteModerator)
+
+	return true, nil
+}
+
+// RemoveCommunityMember removes a member from a community. They can join the community again if they are invited
+func (uc *UseCasesCommunitiesImpl) RemoveCommunityMember(ctx context.Context, communityID string, userID string, reason *string) (bool, error) {
+	action, err := uc.getModerationAction(ctx, communityID, &userID)
+	if err != nil {
+		return false, err
+	}
+
+	err = uc.Matrix.RemoveUserFromRoom(ctx, action.auth, action.community.RoomID, action.member.Username, stringValue(reason))
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to remove member from community: %w", err)
+	}
+
+	return true, nil
+}
+
+// BanCommunityMember removes a member from a community and prevents them from joining it again until they are unbanned
+func (uc *UseCasesCommunitiesImpl) BanCommunityMember(ctx context.Context, communityID string, userID string, reason *string) (bool, error) {
+	action, err := uc.getModerationAction(ctx, communityID, &userID)
+	if err != nil {
+		return false, err
+	}
+
+	err = uc.Matrix.BanUserFromRoom(ctx, action.auth, action.community.RoomID, action.member.Username, stringValue(reason))
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to ban member from community: %w", err)
+	}
+
+	return true, nil
+}
+
+// UnbanCommunityMember lifts a user's ban from a community
+func (uc *UseCasesCommunitiesImpl) UnbanCommunityMember(ctx context.Context, communityID string, userID string) (bool, error) {
+	action, err := uc.getModerationAction(ctx, communityID, &userID)
+	if err != nil {
+		return false, err
+	}
+
+	err = uc.Matrix.UnbanUserFromRoom(ctx, action.auth, action.community.RoomID, action.member.Username)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to unban member from community: %w", err)
+	}
+
+	return true, nil
+}
+
+// MuteCommunityMember prevents a member from sending messages in a community
+func (uc *UseCasesCommunitiesImpl) MuteCommunityMember(ctx context.Context, communityID string, userID string) (bool, error) {
+	_, err := uc.setMemberPowerLevel(ctx, communityID, userID, matrix.MutedPowerLevel)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// UnmuteCommunityMember allows a muted member to send messages in a community again
+func (uc *UseCasesCommunitiesImpl) UnmuteCommunityMember(ctx context.Context, communityID string, userID string) (bool, error) {
+	_, err := uc.setMemberPowerLevel(ctx, communityID, userID, matrix.MemberPowerLevel)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RedactCommunityMessage removes the content of a message sent in a community
+func (uc *UseCasesCommunitiesImpl) RedactCommunityMessage(ctx context.Context, communityID string, eventID string, reason *string) (bool, error) {
+	if eventID == "" {
+		return false, fmt.Errorf("a message's event ID is required")
+	}
+
+	action, err := uc.getModerationAction(ctx, communityID, nil)
+	if err != nil {
+		return false, err
+	}
+
+	err = uc.Matrix.RedactRoomEvent(ctx, action.auth, action.community.RoomID, eventID, stringValue(reason))
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to redact community message: %w", err)
+	}
+
+	return true, nil
+}
+
+// stringValue returns the value of an optional string or an empty string when it is not set
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
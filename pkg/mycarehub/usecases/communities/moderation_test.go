@@ -0,0 +1,362 @@
+package communities_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	extensionMock "github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension/mock"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+	pgMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/mock"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/matrix"
+	mockMatrix "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/matrix/mock"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/communities"
+	notificationMock "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/notification/mock"
+)
+
+// moderationTestCases are the cases shared by the moderation actions that are performed on a community member
+var moderationTestCases = []struct {
+	name    string
+	wantErr bool
+}{
+	{
+		name:    "Happy case: moderate a community member",
+		wantErr: false,
+	},
+	{
+		name:    "Sad case: unable to get logged in user",
+		wantErr: true,
+	},
+	{
+		name:    "Sad case: unable to get moderator profile",
+		wantErr: true,
+	},
+	{
+		name:    "Sad case: unable to get community",
+		wantErr: true,
+	},
+	{
+		name:    "Sad case: moderator acting on themselves",
+		wantErr: true,
+	},
+	{
+		name:    "Sad case: unable to get member profile",
+		wantErr: true,
+	},
+	{
+		name:    "Sad case: matrix rejects the action",
+		wantErr: true,
+	},
+}
+
+// setupModerationTest sets up the mocks of a moderation test case. The matrix mock is returned so that the
+// caller can make the action's matrix call fail
+func setupModerationTest(t *testing.T, caseName string) (communities.UseCasesCommunities, *mockMatrix.MatrixMock, *notificationMock.NotificationUseCaseMock, string) {
+	t.Helper()
+
+	fakeDB := pgMock.NewPostgresMock()
+	fakeExt := extensionMock.NewFakeExtension()
+	fakeMatrix := mockMatrix.NewMatrixMock()
+	fakeNotification := notificationMock.NewServiceNotificationMock()
+	uc := communities.NewUseCaseCommunitiesImpl(fakeDB, fakeDB, fakeDB, fakeExt, fakeMatrix, fakeNotification)
+
+	moderatorID := uuid.NewString()
+	memberID := uuid.NewString()
+	fakeExt.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+		return moderatorID, nil
+	}
+
+	switch caseName {
+	case "Sad case: unable to get logged in user":
+		fakeExt.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+			return "", fmt.Errorf("an error occurred")
+		}
+	case "Sad case: unable to get moderator profile":
+		fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+			return nil, fmt.Errorf("an error occurred")
+		}
+	case "Sad case: unable to get community":
+		fakeDB.MockGetCommunityByIDFn = func(ctx context.Context, communityID string) (*domain.Community, error) {
+			return nil, fmt.Errorf("an error occurred")
+		}
+	case "Sad case: moderator acting on themselves":
+		memberID = moderatorID
+	case "Sad case: unable to get member profile":
+		fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+			if userID == memberID {
+				return nil, fmt.Errorf("an error occurred")
+			}
+			return &domain.User{ID: &userID, Username: "moderator"}, nil
+		}
+	}
+
+	return uc, fakeMatrix, fakeNotification, memberID
+}
+
+func TestUseCasesCommunitiesImpl_PromoteToModerator(t *testing.T) {
+	tests := append(moderationTestCases, struct {
+		name    string
+		wantErr bool
+	}{
+		name:    "Happy case: unable to notify the member",
+		wantErr: false,
+	})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, fakeMatrix, fakeNotification, memberID := setupModerationTest(t, tt.name)
+
+			powerLevel := 0
+			fakeMatrix.MockSetUserPowerLevelFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, level int) error {
+				powerLevel = level
+				return nil
+			}
+			notified := false
+			fakeNotification.MockNotifyUserFn = func(ctx context.Context, userProfile *domain.User, notificationPayload *domain.Notification) error {
+				notified = true
+				return nil
+			}
+
+			if tt.name == "Sad case: matrix rejects the action" {
+				fakeMatrix.MockSetUserPowerLevelFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, level int) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Happy case: unable to notify the member" {
+				fakeNotification.MockNotifyUserFn = func(ctx context.Context, userProfile *domain.User, notificationPayload *domain.Notification) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := uc.PromoteToModerator(context.Background(), uuid.NewString(), memberID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.PromoteToModerator() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got == tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.PromoteToModerator() = %v", got)
+			}
+			if tt.name == "Happy case: moderate a community member" && (powerLevel != matrix.ModeratorPowerLevel || !notified) {
+				t.Errorf("expected the member to be made a moderator and notified, got power level %d, notified %v", powerLevel, notified)
+			}
+		})
+	}
+}
+
+func TestUseCasesCommunitiesImpl_DemoteModerator(t *testing.T) {
+	for _, tt := range moderationTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, fakeMatrix, fakeNotification, memberID := setupModerationTest(t, tt.name)
+
+			powerLevel := matrix.ModeratorPowerLevel
+			fakeMatrix.MockSetUserPowerLevelFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, level int) error {
+				powerLevel = level
+				return nil
+			}
+			notified := false
+			fakeNotification.MockNotifyUserFn = func(ctx context.Context, userProfile *domain.User, notificationPayload *domain.Notification) error {
+				notified = true
+				return nil
+			}
+
+			if tt.name == "Sad case: matrix rejects the action" {
+				fakeMatrix.MockSetUserPowerLevelFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, level int) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := uc.DemoteModerator(context.Background(), uuid.NewString(), memberID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.DemoteModerator() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got == tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.DemoteModerator() = %v", got)
+			}
+			if !tt.wantErr && (powerLevel != matrix.MemberPowerLevel || !notified) {
+				t.Errorf("expected the moderator to be demoted and notified, got power level %d, notified %v", powerLevel, notified)
+			}
+		})
+	}
+}
+
+func TestUseCasesCommunitiesImpl_RemoveCommunityMember(t *testing.T) {
+	reason := "spam"
+	for _, tt := range moderationTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, fakeMatrix, _, memberID := setupModerationTest(t, tt.name)
+
+			if tt.name == "Sad case: matrix rejects the action" {
+				fakeMatrix.MockRemoveUserFromRoomFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, reason string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := uc.RemoveCommunityMember(context.Background(), uuid.NewString(), memberID, &reason)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.RemoveCommunityMember() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got == tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.RemoveCommunityMember() = %v", got)
+			}
+		})
+	}
+}
+
+func TestUseCasesCommunitiesImpl_BanCommunityMember(t *testing.T) {
+	for _, tt := range moderationTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, fakeMatrix, _, memberID := setupModerationTest(t, tt.name)
+
+			if tt.name == "Sad case: matrix rejects the action" {
+				fakeMatrix.MockBanUserFromRoomFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, reason string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := uc.BanCommunityMember(context.Background(), uuid.NewString(), memberID, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.BanCommunityMember() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got == tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.BanCommunityMember() = %v", got)
+			}
+		})
+	}
+}
+
+func TestUseCasesCommunitiesImpl_UnbanCommunityMember(t *testing.T) {
+	for _, tt := range moderationTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, fakeMatrix, _, memberID := setupModerationTest(t, tt.name)
+
+			if tt.name == "Sad case: matrix rejects the action" {
+				fakeMatrix.MockUnbanUserFromRoomFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := uc.UnbanCommunityMember(context.Background(), uuid.NewString(), memberID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.UnbanCommunityMember() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got == tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.UnbanCommunityMember() = %v", got)
+			}
+		})
+	}
+}
+
+func TestUseCasesCommunitiesImpl_MuteCommunityMember(t *testing.T) {
+	for _, tt := range moderationTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, fakeMatrix, _, memberID := setupModerationTest(t, tt.name)
+
+			powerLevel := matrix.MemberPowerLevel
+			fakeMatrix.MockSetUserPowerLevelFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, level int) error {
+				powerLevel = level
+				return nil
+			}
+			if tt.name == "Sad case: matrix rejects the action" {
+				fakeMatrix.MockSetUserPowerLevelFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, level int) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := uc.MuteCommunityMember(context.Background(), uuid.NewString(), memberID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.MuteCommunityMember() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got == tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.MuteCommunityMember() = %v", got)
+			}
+			if !tt.wantErr && powerLevel != matrix.MutedPowerLevel {
+				t.Errorf("expected the member to be muted, got power level %d", powerLevel)
+			}
+		})
+	}
+}
+
+func TestUseCasesCommunitiesImpl_UnmuteCommunityMember(t *testing.T) {
+	for _, tt := range moderationTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, fakeMatrix, _, memberID := setupModerationTest(t, tt.name)
+
+			powerLevel := matrix.MutedPowerLevel
+			fakeMatrix.MockSetUserPowerLevelFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, level int) error {
+				powerLevel = level
+				return nil
+			}
+			if tt.name == "Sad case: matrix rejects the action" {
+				fakeMatrix.MockSetUserPowerLevelFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, username string, level int) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := uc.UnmuteCommunityMember(context.Background(), uuid.NewString(), memberID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.UnmuteCommunityMember() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got == tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.UnmuteCommunityMember() = %v", got)
+			}
+			if !tt.wantErr && powerLevel != matrix.MemberPowerLevel {
+				t.Errorf("expected the member to be unmuted, got power level %d", powerLevel)
+			}
+		})
+	}
+}
+
+func TestUseCasesCommunitiesImpl_RedactCommunityMessage(t *testing.T) {
+	reason := "abusive"
+	tests := []struct {
+		name    string
+		eventID string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: redact a community message",
+			eventID: "$event",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: missing event ID",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to get community",
+			eventID: "$event",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: matrix rejects the action",
+			eventID: "$event",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, fakeMatrix, _, _ := setupModerationTest(t, tt.name)
+
+			if tt.name == "Sad case: matrix rejects the action" {
+				fakeMatrix.MockRedactRoomEventFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string, eventID string, reason string) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := uc.RedactCommunityMessage(context.Background(), uuid.NewString(), tt.eventID, &reason)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.RedactCommunityMessage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got == tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.RedactCommunityMessage() = %v", got)
+			}
+		})
+	}
+}
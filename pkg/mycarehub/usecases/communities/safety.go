@@ -0,0 +1,310 @@
+package communities
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/savannahghi/feedlib"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/exceptions"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/notification"
+)
+
+// messageExcerptLength is the maximum number of characters of a flagged message that are kept on its service request
+const messageExcerptLength = 500
+
+// ICommunitySafety contains the methods used to manage a program's crisis keywords and to flag community messages that match them
+type ICommunitySafety interface {
+	CreateCommunityCrisisKeyword(ctx context.Context, input dto.CommunityCrisisKeywordInput) (*domain.CommunityCrisisKeyword, error)
+	ListCommunityCrisisKeywords(ctx context.Context) ([]*domain.CommunityCrisisKeyword, error)
+	DeleteCommunityCrisisKeyword(ctx context.Context, keywordID string) (bool, error)
+	ProcessMatrixEvents(ctx context.Context, events []*dto.MatrixEvent) error
+}
+
+// crisisPattern is a crisis keyword together with its compiled pattern
+type crisisPattern struct {
+	keyword *domain.CommunityCrisisKeyword
+	pattern *regexp.Regexp
+}
+
+// getLoggedInUser gets the profile of the logged in user
+func (uc *UseCasesCommunitiesImpl) getLoggedInUser(ctx context.Context) (*domain.User, error) {
+	loggedInUserID, err := uc.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.GetLoggedInUserUIDErr(err)
+	}
+
+	user, err := uc.Query.GetUserProfileByUserID(ctx, loggedInUserID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.UserNotFoundError(err)
+	}
+
+	return user, nil
+}
+
+// CreateCommunityCrisisKeyword adds a keyword, phrase or regular expression that flags the messages sent in the communities of the logged in user's program
+func (uc *UseCasesCommunitiesImpl) CreateCommunityCrisisKeyword(ctx context.Context, input dto.CommunityCrisisKeywordInput) (*domain.CommunityCrisisKeyword, error) {
+	if err := input.Validate(); err != nil {
+		return nil, exceptions.InputValidationErr(err)
+	}
+
+	user, err := uc.getLoggedInUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keyword, err := uc.Create.CreateCommunityCrisisKeyword(ctx, &domain.CommunityCrisisKeyword{
+		Active:         true,
+		Keyword:        strings.TrimSpace(input.Keyword),
+		IsRegex:        input.IsRegex,
+		Category:       input.Category,
+		ProgramID:      user.CurrentProgramID,
+		OrganisationID: user.CurrentOrganizationID,
+	})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to create community crisis keyword: %w", err)
+	}
+
+	return keyword, nil
+}
+
+// ListCommunityCrisisKeywords lists the active crisis keywords of the logged in user's program
+func (uc *UseCasesCommunitiesImpl) ListCommunityCrisisKeywords(ctx context.Context) ([]*domain.CommunityCrisisKeyword, error) {
+	user, err := uc.getLoggedInUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keywords, err := uc.Query.ListCommunityCrisisKeywords(ctx, user.CurrentProgramID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to list community crisis keywords: %w", err)
+	}
+
+	return keywords, nil
+}
+
+// DeleteCommunityCrisisKeyword deactivates one of the crisis keywords of the logged in user's program
+func (uc *UseCasesCommunitiesImpl) DeleteCommunityCrisisKeyword(ctx context.Context, keywordID string) (bool, error) {
+	keywords, err := uc.ListCommunityCrisisKeywords(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var keyword *domain.CommunityCrisisKeyword
+	for _, k := range keywords {
+		if k.ID == keywordID {
+			keyword = k
+			break
+		}
+	}
+	if keyword == nil {
+		return false, fmt.Errorf("community crisis keyword %s not found in the current program", keywordID)
+	}
+
+	err = uc.Update.UpdateCommunityCrisisKeyword(ctx, keyword, map[string]interface{}{"active": false})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to delete community crisis keyword: %w", err)
+	}
+
+	return true, nil
+}
+
+// ProcessMatrixEvents scans the text messages sent in communities for their program's crisis keywords.
+// A message that matches a keyword creates a red flag service request for its sender and alerts the community's moderators.
+// A failure to process one event is reported without stopping the rest of the events from being processed
+func (uc *UseCasesCommunitiesImpl) ProcessMatrixEvents(ctx context.Context, events []*dto.MatrixEvent) error {
+	serviceAccount := matrixServiceAccount()
+	programPatterns := map[string][]*crisisPattern{}
+
+	for _, event := range events {
+		if event == nil || !event.IsTextMessage() {
+			continue
+		}
+
+		sender := event.SenderUsername()
+		if strings.EqualFold(sender, serviceAccount.Username) {
+			continue
+		}
+
+		community, err := uc.Query.GetCommunityByRoomID(ctx, event.RoomID)
+		if err != nil {
+			// messages sent in rooms that are not program communities, such as direct messages, are not scanned
+			continue
+		}
+
+		patterns, ok := programPatterns[community.ProgramID]
+		if !ok {
+			patterns, err = uc.listCrisisPatterns(ctx, community.ProgramID)
+			if err != nil {
+				helpers.ReportErrorToSentry(err)
+				log.Printf("failed to list the crisis keywords of program %s: %v", community.ProgramID, err)
+				continue
+			}
+			programPatterns[community.ProgramID] = patterns
+		}
+
+		var match *crisisPattern
+		for _, p := range patterns {
+			if p.pattern.MatchString(event.Content.Body) {
+				match = p
+				break
+			}
+		}
+		if match == nil {
+			continue
+		}
+
+		err = uc.flagCrisisMessage(ctx, serviceAccount, community, event, sender, match.keyword)
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			log.Printf("failed to flag message %s in community %s: %v", event.EventID, community.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// listCrisisPatterns compiles the active crisis keywords of a program. A keyword that does not compile is reported and left out
+func (uc *UseCasesCommunitiesImpl) listCrisisPatterns(ctx context.Context, programID string) ([]*crisisPattern, error) {
+	keywords, err := uc.Query.ListCommunityCrisisKeywords(ctx, programID)
+	if err != nil {
+		return nil, err
+	}
+
+	patterns := []*crisisPattern{}
+	for _, keyword := range keywords {
+		pattern, err := keyword.Pattern()
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			log.Printf("failed to compile community crisis keyword %s: %v", keyword.ID, err)
+			continue
+		}
+		patterns = append(patterns, &crisisPattern{
+			keyword: keyword,
+			pattern: pattern,
+		})
+	}
+
+	return patterns, nil
+}
+
+// flagCrisisMessage creates a red flag service request at the sender's default facility and alerts the community's moderators.
+// Only messages sent by the program's clients are flagged since a service request is raised on behalf of a client
+func (uc *UseCasesCommunitiesImpl) flagCrisisMessage(
+	ctx context.Context,
+	serviceAccount *domain.MatrixAuth,
+	community *domain.Community,
+	event *dto.MatrixEvent,
+	sender string,
+	keyword *domain.CommunityCrisisKeyword,
+) error {
+	// Matrix retries a transaction until it is acknowledged so the same message can be delivered more than once
+	flagged, err := uc.Query.CheckCrisisMessageFlagged(ctx, event.EventID)
+	if err != nil {
+		return fmt.Errorf("failed to check whether the message has already been flagged: %w", err)
+	}
+	if flagged {
+		return nil
+	}
+
+	user, err := uc.Query.GetUserProfileByUsername(ctx, sender)
+	if err != nil {
+		return fmt.Errorf("failed to get the sender's user profile: %w", err)
+	}
+
+	client, err := uc.Query.GetClientProfile(ctx, *user.ID, community.ProgramID)
+	if err != nil {
+		// staff members are not flagged
+		return nil
+	}
+
+	if client.DefaultFacility == nil || client.DefaultFacility.ID == nil {
+		return fmt.Errorf("client %s does not have a default facility", *client.ID)
+	}
+
+	serviceRequestInput := &dto.ServiceRequestInput{
+		Active:      true,
+		RequestType: enums.ServiceRequestTypeRedFlag.String(),
+		Request:     fmt.Sprintf("A message sent by %s in the %s community matched the crisis keyword %q", user.Name, community.Name, keyword.Keyword),
+		Status:      enums.ServiceRequestStatusPending.String(),
+		ClientID:    *client.ID,
+		FacilityID:  *client.DefaultFacility.ID,
+		Meta: map[string]interface{}{
+			"communityID": community.ID,
+			"roomID":      event.RoomID,
+			"eventID":     event.EventID,
+			"keyword":     keyword.Keyword,
+			"category":    keyword.Category.String(),
+			"excerpt":     messageExcerpt(event.Content.Body),
+		},
+		ProgramID:      community.ProgramID,
+		OrganisationID: community.OrganisationID,
+	}
+	err = uc.Create.CreateServiceRequest(ctx, serviceRequestInput)
+	if err != nil {
+		return fmt.Errorf("failed to create red flag service request: %w", err)
+	}
+
+	uc.notifyModerators(ctx, serviceAccount, community, user)
+
+	return nil
+}
+
+// notifyModerators alerts the moderators of a community about a message that may indicate a crisis.
+// The service request has already been created so a failure to notify the moderators is only reported
+func (uc *UseCasesCommunitiesImpl) notifyModerators(ctx context.Context, serviceAccount *domain.MatrixAuth, community *domain.Community, sender *domain.User) {
+	moderators, err := uc.Matrix.ListRoomModerators(ctx, serviceAccount, community.RoomID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		log.Printf("failed to list the moderators of community %s: %v", community.ID, err)
+		return
+	}
+
+	for _, username := range moderators {
+		if strings.EqualFold(username, serviceAccount.Username) || strings.EqualFold(username, sender.Username) {
+			continue
+		}
+
+		moderator, err := uc.Query.GetUserProfileByUsername(ctx, username)
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			log.Printf("failed to get the user profile of moderator %s: %v", username, err)
+			continue
+		}
+
+		message := notification.ComposeStaffNotification(enums.NotificationTypeCommunityCrisisAlert, notification.StaffNotificationArgs{
+			Subject:   sender,
+			Community: community,
+		})
+		if moderator.CurrentUserType == enums.ClientUser.String() {
+			message.Flavour = feedlib.FlavourConsumer
+		}
+
+		err = uc.Notification.NotifyUser(ctx, moderator, message)
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			log.Printf("failed to notify moderator %s of a crisis message in community %s: %v", username, community.ID, err)
+		}
+	}
+}
+
+// messageExcerpt shortens a message to the length kept on its service request
+func messageExcerpt(body string) string {
+	runes := []rune(strings.TrimSpace(body))
+	if len(runes) <= messageExcerptLength {
+		return string(runes)
+	}
+
+	return string(runes[:messageExcerptLength]) + "..."
+}
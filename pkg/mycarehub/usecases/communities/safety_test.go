@@ -0,0 +1,519 @@
+package communities_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/feedlib"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	extensionMock "github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension/mock"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+	pgMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/mock"
+	mockMatrix "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/matrix/mock"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/communities"
+	notificationMock "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/notification/mock"
+	"github.com/savannahghi/serverutils"
+)
+
+// setupSafetyTest sets up a program with a self harm keyword, a regular expression abuse keyword and a keyword that does not compile.
+// The "!community:example.com" room is the program's community and "jane" is a client in the program
+func setupSafetyTest(t *testing.T) (communities.UseCasesCommunities, *pgMock.PostgresMock, *mockMatrix.MatrixMock, *extensionMock.FakeExtensionImpl, *notificationMock.NotificationUseCaseMock) {
+	t.Helper()
+
+	fakeDB := pgMock.NewPostgresMock()
+	fakeExt := extensionMock.NewFakeExtension()
+	fakeMatrix := mockMatrix.NewMatrixMock()
+	fakeNotification := notificationMock.NewServiceNotificationMock()
+	uc := communities.NewUseCaseCommunitiesImpl(fakeDB, fakeDB, fakeDB, fakeExt, fakeMatrix, fakeNotification)
+
+	programID := uuid.NewString()
+	fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, id string) (*domain.User, error) {
+		return &domain.User{
+			ID:               &id,
+			Username:         "staff",
+			CurrentProgramID: programID,
+			CurrentUserType:  enums.StaffUser.String(),
+		}, nil
+	}
+
+	keywords := []*domain.CommunityCrisisKeyword{
+		{ID: "self-harm", Active: true, Keyword: "end my life", Category: enums.CommunityCrisisCategorySelfHarm, ProgramID: programID},
+		{ID: "abuse", Active: true, Keyword: `(beat|hit)s? me`, IsRegex: true, Category: enums.CommunityCrisisCategoryAbuse, ProgramID: programID},
+		{ID: "invalid", Active: true, Keyword: `(unclosed`, IsRegex: true, Category: enums.CommunityCrisisCategoryOther, ProgramID: programID},
+	}
+	fakeDB.MockListCommunityCrisisKeywordsFn = func(ctx context.Context, programID string) ([]*domain.CommunityCrisisKeyword, error) {
+		return keywords, nil
+	}
+
+	fakeDB.MockGetCommunityByRoomIDFn = func(ctx context.Context, roomID string) (*domain.Community, error) {
+		if roomID != "!community:example.com" {
+			return nil, fmt.Errorf("community not found")
+		}
+		return &domain.Community{
+			ID:        "community",
+			RoomID:    roomID,
+			Name:      "Teen mothers",
+			ProgramID: programID,
+		}, nil
+	}
+
+	fakeDB.MockGetUserProfileByUsernameFn = func(ctx context.Context, username string) (*domain.User, error) {
+		id := "user-" + username
+		userType := enums.StaffUser.String()
+		if username == "jane" {
+			userType = enums.ClientUser.String()
+		}
+		return &domain.User{
+			ID:              &id,
+			Username:        username,
+			Name:            username,
+			CurrentUserType: userType,
+		}, nil
+	}
+	fakeDB.MockGetClientProfileFn = func(ctx context.Context, userID string, programID string) (*domain.ClientProfile, error) {
+		if userID != "user-jane" {
+			return nil, fmt.Errorf("client profile not found")
+		}
+		client := communityClient("jane", programID, true)
+		facilityID := uuid.NewString()
+		client.DefaultFacility = &domain.Facility{ID: &facilityID}
+		return client, nil
+	}
+
+	fakeMatrix.MockListRoomModeratorsFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]string, error) {
+		return []string{serverutils.MustGetEnvVar("MCH_MATRIX_USER"), "staff", "peer"}, nil
+	}
+
+	return uc, fakeDB, fakeMatrix, fakeExt, fakeNotification
+}
+
+// textMessage returns a text message sent by a user in a room
+func textMessage(sender string, roomID string, body string) *dto.MatrixEvent {
+	return &dto.MatrixEvent{
+		EventID: "$" + uuid.NewString(),
+		Type:    "m.room.message",
+		RoomID:  roomID,
+		Sender:  fmt.Sprintf("@%s:example.com", sender),
+		Content: dto.MatrixEventContent{
+			MsgType: "m.text",
+			Body:    body,
+		},
+	}
+}
+
+func TestUseCasesCommunitiesImpl_CreateCommunityCrisisKeyword(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   dto.CommunityCrisisKeywordInput
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create a crisis keyword",
+			input: dto.CommunityCrisisKeywordInput{
+				Keyword:  "  end my life ",
+				Category: enums.CommunityCrisisCategorySelfHarm,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid regular expression",
+			input: dto.CommunityCrisisKeywordInput{
+				Keyword:  "(unclosed",
+				IsRegex:  true,
+				Category: enums.CommunityCrisisCategoryOther,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get logged in user",
+			input: dto.CommunityCrisisKeywordInput{
+				Keyword:  "end my life",
+				Category: enums.CommunityCrisisCategorySelfHarm,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to create crisis keyword",
+			input: dto.CommunityCrisisKeywordInput{
+				Keyword:  "end my life",
+				Category: enums.CommunityCrisisCategorySelfHarm,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, fakeDB, _, fakeExt, _ := setupSafetyTest(t)
+
+			switch tt.name {
+			case "Sad case: unable to get logged in user":
+				fakeExt.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			case "Sad case: unable to create crisis keyword":
+				fakeDB.MockCreateCommunityCrisisKeywordFn = func(ctx context.Context, keyword *domain.CommunityCrisisKeyword) (*domain.CommunityCrisisKeyword, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := uc.CreateCommunityCrisisKeyword(context.Background(), tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.CreateCommunityCrisisKeyword() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got.Keyword != "end my life" || !got.Active || got.ProgramID == "") {
+				t.Errorf("UseCasesCommunitiesImpl.CreateCommunityCrisisKeyword() = %v", got)
+			}
+		})
+	}
+}
+
+func TestUseCasesCommunitiesImpl_ListCommunityCrisisKeywords(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list crisis keywords",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to get logged in user",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to list crisis keywords",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, fakeDB, _, fakeExt, _ := setupSafetyTest(t)
+
+			switch tt.name {
+			case "Sad case: unable to get logged in user":
+				fakeExt.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			case "Sad case: unable to list crisis keywords":
+				fakeDB.MockListCommunityCrisisKeywordsFn = func(ctx context.Context, programID string) ([]*domain.CommunityCrisisKeyword, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := uc.ListCommunityCrisisKeywords(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.ListCommunityCrisisKeywords() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) != 3 {
+				t.Errorf("UseCasesCommunitiesImpl.ListCommunityCrisisKeywords() got %d keywords, want 3", len(got))
+			}
+		})
+	}
+}
+
+func TestUseCasesCommunitiesImpl_DeleteCommunityCrisisKeyword(t *testing.T) {
+	tests := []struct {
+		name      string
+		keywordID string
+		wantErr   bool
+	}{
+		{
+			name:      "Happy case: delete a crisis keyword",
+			keywordID: "self-harm",
+			wantErr:   false,
+		},
+		{
+			name:      "Sad case: keyword is not in the current program",
+			keywordID: uuid.NewString(),
+			wantErr:   true,
+		},
+		{
+			name:      "Sad case: unable to list crisis keywords",
+			keywordID: "self-harm",
+			wantErr:   true,
+		},
+		{
+			name:      "Sad case: unable to update crisis keyword",
+			keywordID: "self-harm",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, fakeDB, _, _, _ := setupSafetyTest(t)
+
+			var updateData map[string]interface{}
+			fakeDB.MockUpdateCommunityCrisisKeywordFn = func(ctx context.Context, keyword *domain.CommunityCrisisKeyword, data map[string]interface{}) error {
+				updateData = data
+				return nil
+			}
+
+			switch tt.name {
+			case "Sad case: unable to list crisis keywords":
+				fakeDB.MockListCommunityCrisisKeywordsFn = func(ctx context.Context, programID string) ([]*domain.CommunityCrisisKeyword, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			case "Sad case: unable to update crisis keyword":
+				fakeDB.MockUpdateCommunityCrisisKeywordFn = func(ctx context.Context, keyword *domain.CommunityCrisisKeyword, data map[string]interface{}) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := uc.DeleteCommunityCrisisKeyword(context.Background(), tt.keywordID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.DeleteCommunityCrisisKeyword() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got == tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.DeleteCommunityCrisisKeyword() = %v", got)
+			}
+			if !tt.wantErr && updateData["active"] != false {
+				t.Errorf("expected the crisis keyword to be deactivated, got %v", updateData)
+			}
+		})
+	}
+}
+
+func TestUseCasesCommunitiesImpl_ProcessMatrixEvents(t *testing.T) {
+	tests := []struct {
+		name                string
+		events              []*dto.MatrixEvent
+		wantServiceRequests int
+		wantCategory        enums.CommunityCrisisCategory
+		wantNotifications   int
+		wantErr             bool
+	}{
+		{
+			name:                "Happy case: flag a message matching a phrase",
+			events:              []*dto.MatrixEvent{textMessage("jane", "!community:example.com", "Some days I just want to END my   life")},
+			wantServiceRequests: 1,
+			wantCategory:        enums.CommunityCrisisCategorySelfHarm,
+			wantNotifications:   2,
+			wantErr:             false,
+		},
+		{
+			name:                "Happy case: flag a message matching a regular expression",
+			events:              []*dto.MatrixEvent{textMessage("jane", "!community:example.com", "My partner hits me when he is drunk")},
+			wantServiceRequests: 1,
+			wantCategory:        enums.CommunityCrisisCategoryAbuse,
+			wantNotifications:   2,
+			wantErr:             false,
+		},
+		{
+			name: "Happy case: messages that are not flagged",
+			events: []*dto.MatrixEvent{
+				nil,
+				textMessage("jane", "!community:example.com", "I am going to the clinic tomorrow"),
+				textMessage("jane", "!community:example.com", "Lifelong friends are the best"),
+				textMessage(serverutils.MustGetEnvVar("MCH_MATRIX_USER"), "!community:example.com", "end my life"),
+				textMessage("jane", "!direct:example.com", "end my life"),
+				{
+					Type:   "m.room.member",
+					RoomID: "!community:example.com",
+					Sender: "@jane:example.com",
+				},
+			},
+			wantServiceRequests: 0,
+			wantNotifications:   0,
+			wantErr:             false,
+		},
+		{
+			name:                "Happy case: messages sent by staff are not flagged",
+			events:              []*dto.MatrixEvent{textMessage("staff", "!community:example.com", "end my life")},
+			wantServiceRequests: 0,
+			wantNotifications:   0,
+			wantErr:             false,
+		},
+		{
+			name: "Happy case: a failure to flag one message does not stop the rest",
+			events: []*dto.MatrixEvent{
+				textMessage("jane", "!community:example.com", "end my life"),
+				textMessage("jane", "!community:example.com", "he beat me"),
+			},
+			wantServiceRequests: 1,
+			wantCategory:        enums.CommunityCrisisCategoryAbuse,
+			wantNotifications:   2,
+			wantErr:             false,
+		},
+		{
+			name:                "Happy case: unable to list crisis keywords",
+			events:              []*dto.MatrixEvent{textMessage("jane", "!community:example.com", "end my life")},
+			wantServiceRequests: 0,
+			wantNotifications:   0,
+			wantErr:             false,
+		},
+		{
+			name:                "Happy case: unable to check whether a message has been flagged",
+			events:              []*dto.MatrixEvent{textMessage("jane", "!community:example.com", "end my life")},
+			wantServiceRequests: 0,
+			wantNotifications:   0,
+			wantErr:             false,
+		},
+		{
+			name:                "Happy case: unable to list moderators",
+			events:              []*dto.MatrixEvent{textMessage("jane", "!community:example.com", "end my life")},
+			wantServiceRequests: 1,
+			wantCategory:        enums.CommunityCrisisCategorySelfHarm,
+			wantNotifications:   0,
+			wantErr:             false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, fakeDB, fakeMatrix, _, fakeNotification := setupSafetyTest(t)
+
+			serviceRequests := []*dto.ServiceRequestInput{}
+			fakeDB.MockCreateServiceRequestFn = func(ctx context.Context, serviceRequestInput *dto.ServiceRequestInput) error {
+				serviceRequests = append(serviceRequests, serviceRequestInput)
+				return nil
+			}
+
+			notifications := []*domain.Notification{}
+			fakeNotification.MockNotifyUserFn = func(ctx context.Context, userProfile *domain.User, notificationPayload *domain.Notification) error {
+				notifications = append(notifications, notificationPayload)
+				return nil
+			}
+
+			switch tt.name {
+			case "Happy case: a failure to flag one message does not stop the rest":
+				failed := false
+				fakeDB.MockCreateServiceRequestFn = func(ctx context.Context, serviceRequestInput *dto.ServiceRequestInput) error {
+					if !failed {
+						failed = true
+						return fmt.Errorf("an error occurred")
+					}
+					serviceRequests = append(serviceRequests, serviceRequestInput)
+					return nil
+				}
+			case "Happy case: unable to list crisis keywords":
+				fakeDB.MockListCommunityCrisisKeywordsFn = func(ctx context.Context, programID string) ([]*domain.CommunityCrisisKeyword, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			case "Happy case: unable to check whether a message has been flagged":
+				fakeDB.MockCheckCrisisMessageFlaggedFn = func(ctx context.Context, eventID string) (bool, error) {
+					return false, fmt.Errorf("an error occurred")
+				}
+			case "Happy case: unable to list moderators":
+				fakeMatrix.MockListRoomModeratorsFn = func(ctx context.Context, auth *domain.MatrixAuth, roomID string) ([]string, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			err := uc.ProcessMatrixEvents(context.Background(), tt.events)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesCommunitiesImpl.ProcessMatrixEvents() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if len(serviceRequests) != tt.wantServiceRequests {
+				t.Errorf("expected %d service requests, got %d", tt.wantServiceRequests, len(serviceRequests))
+				return
+			}
+			for _, serviceRequest := range serviceRequests {
+				if serviceRequest.RequestType != enums.ServiceRequestTypeRedFlag.String() || serviceRequest.FacilityID == "" || serviceRequest.ClientID == "" {
+					t.Errorf("unexpected service request %v", serviceRequest)
+				}
+				if serviceRequest.Meta["category"] != tt.wantCategory.String() || serviceRequest.Meta["communityID"] != "community" {
+					t.Errorf("unexpected service request meta %v", serviceRequest.Meta)
+				}
+			}
+
+			if len(notifications) != tt.wantNotifications {
+				t.Errorf("expected %d moderator notifications, got %d", tt.wantNotifications, len(notifications))
+				return
+			}
+			for _, notification := range notifications {
+				if notification.Type != enums.NotificationTypeCommunityCrisisAlert || !strings.Contains(notification.Body, "Teen mothers") {
+					t.Errorf("unexpected notification %v", notification)
+				}
+			}
+		})
+	}
+}
+
+func TestUseCasesCommunitiesImpl_ProcessMatrixEvents_Replay(t *testing.T) {
+	uc, fakeDB, _, _, fakeNotification := setupSafetyTest(t)
+
+	flaggedEvents := map[string]bool{}
+	fakeDB.MockCheckCrisisMessageFlaggedFn = func(ctx context.Context, eventID string) (bool, error) {
+		return flaggedEvents[eventID], nil
+	}
+	serviceRequests := 0
+	fakeDB.MockCreateServiceRequestFn = func(ctx context.Context, serviceRequestInput *dto.ServiceRequestInput) error {
+		flaggedEvents[serviceRequestInput.Meta["eventID"].(string)] = true
+		serviceRequests++
+		return nil
+	}
+	notifications := 0
+	fakeNotification.MockNotifyUserFn = func(ctx context.Context, userProfile *domain.User, notificationPayload *domain.Notification) error {
+		notifications++
+		return nil
+	}
+
+	events := []*dto.MatrixEvent{textMessage("jane", "!community:example.com", "end my life")}
+	for i := 0; i < 2; i++ {
+		if err := uc.ProcessMatrixEvents(context.Background(), events); err != nil {
+			t.Errorf("UseCasesCommunitiesImpl.ProcessMatrixEvents() error = %v", err)
+			return
+		}
+	}
+
+	if serviceRequests != 1 {
+		t.Errorf("expected a replayed message to create 1 service request, got %d", serviceRequests)
+	}
+	if notifications != 2 {
+		t.Errorf("expected a replayed message to notify the moderators once, got %d notifications", notifications)
+	}
+}
+
+func TestUseCasesCommunitiesImpl_ProcessMatrixEvents_Excerpt(t *testing.T) {
+	uc, fakeDB, _, _, fakeNotification := setupSafetyTest(t)
+
+	var serviceRequest *dto.ServiceRequestInput
+	fakeDB.MockCreateServiceRequestFn = func(ctx context.Context, serviceRequestInput *dto.ServiceRequestInput) error {
+		serviceRequest = serviceRequestInput
+		return nil
+	}
+
+	flavours := []feedlib.Flavour{}
+	fakeNotification.MockNotifyUserFn = func(ctx context.Context, userProfile *domain.User, notificationPayload *domain.Notification) error {
+		flavours = append(flavours, notificationPayload.Flavour)
+		return nil
+	}
+	fakeDB.MockGetUserProfileByUsernameFn = func(ctx context.Context, username string) (*domain.User, error) {
+		id := "user-" + username
+		return &domain.User{
+			ID:              &id,
+			Username:        username,
+			CurrentUserType: enums.ClientUser.String(),
+		}, nil
+	}
+
+	body := "end my life " + strings.Repeat("a", 1000)
+	err := uc.ProcessMatrixEvents(context.Background(), []*dto.MatrixEvent{textMessage("jane", "!community:example.com", body)})
+	if err != nil {
+		t.Errorf("UseCasesCommunitiesImpl.ProcessMatrixEvents() error = %v", err)
+		return
+	}
+
+	if serviceRequest == nil {
+		t.Errorf("expected a service request to be created")
+		return
+	}
+	excerpt, _ := serviceRequest.Meta["excerpt"].(string)
+	if !strings.HasPrefix(excerpt, "end my life") || len(excerpt) >= len(body) {
+		t.Errorf("expected the message to be shortened, got %d characters", len(excerpt))
+	}
+
+	for _, flavour := range flavours {
+		if flavour != feedlib.FlavourConsumer {
+			t.Errorf("expected client moderators to get a consumer notification, got %v", flavour)
+		}
+	}
+}
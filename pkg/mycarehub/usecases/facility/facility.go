@@ -8,6 +8,7 @@ import (
 
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/exceptions"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
@@ -109,7 +110,30 @@ func (f *UseCaseFacilityImpl) DeleteFacility(ctx context.Context, identifier *dt
 // InactivateFacility inactivates the health facility
 // TODO Toggle active boolean
 func (f *UseCaseFacilityImpl) InactivateFacility(ctx context.Context, identifier *dto.FacilityIdentifierInput) (bool, error) {
-	return f.Update.InactivateFacility(ctx, identifier)
+	facility, err := f.Query.RetrieveFacilityByIdentifier(ctx, identifier, true)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, err
+	}
+
+	ok, err := f.Update.InactivateFacility(ctx, identifier)
+	if err != nil {
+		return false, err
+	}
+
+	auditLog := &domain.AuditLog{
+		RecordType: enums.AuditLogRecordTypeFacilityInactivation,
+		Notes:      fmt.Sprintf("facility %s inactivated", facility.Name),
+		TargetID:   *facility.ID,
+		TargetType: "FACILITY",
+		OldValue:   map[string]interface{}{"active": facility.Active},
+		NewValue:   map[string]interface{}{"active": false},
+	}
+	if err := f.Create.CreateAuditLog(ctx, auditLog); err != nil {
+		helpers.ReportErrorToSentry(err)
+	}
+
+	return ok, nil
 }
 
 // ReactivateFacility activates the inactivated health facility
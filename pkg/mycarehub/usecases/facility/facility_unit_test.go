@@ -354,6 +354,30 @@ func TestUseCaseFacilityImpl_Inactivate_Unittest(t *testing.T) {
 			want:    false,
 			wantErr: true,
 		},
+		{
+			name: "Sad Case - unable to retrieve facility",
+			args: args{
+				ctx: ctx,
+				identifier: dto.FacilityIdentifierInput{
+					Type:  enums.FacilityIdentifierTypeMFLCode,
+					Value: "30290320932",
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name: "Happy Case - unable to record audit log",
+			args: args{
+				ctx: ctx,
+				identifier: dto.FacilityIdentifierInput{
+					Type:  enums.FacilityIdentifierTypeMFLCode,
+					Value: "30290320932",
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -381,6 +405,18 @@ func TestUseCaseFacilityImpl_Inactivate_Unittest(t *testing.T) {
 				}
 			}
 
+			if tt.name == "Sad Case - unable to retrieve facility" {
+				fakeDB.MockRetrieveFacilityByIdentifierFn = func(ctx context.Context, identifier *dto.FacilityIdentifierInput, isActive bool) (*domain.Facility, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Happy Case - unable to record audit log" {
+				fakeDB.MockCreateAuditLogFn = func(ctx context.Context, auditLog *domain.AuditLog) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
 			got, err := f.InactivateFacility(tt.args.ctx, &tt.args.identifier)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UseCaseFacilityImpl.Inactivate() error = %v, wantErr %v", err, tt.wantErr)
@@ -0,0 +1,223 @@
+package healthdiary
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/utils"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+)
+
+const (
+	exportDateLayout     = "2006-01-02"
+	exportDateTimeLayout = "02 Jan 2006 15:04"
+
+	pdfMargin      = 40.0
+	pdfLineHeight  = 12.0
+	pdfTextSize    = 9.0
+	pdfChartLeft   = 100.0
+	pdfChartHeight = 160.0
+)
+
+// healthDiaryCSVHeader is the header row of an exported health diary spreadsheet
+var healthDiaryCSVHeader = []string{"date", "mood", "mood_score", "note", "shared_with_health_worker", "shared_at"}
+
+// pdfEntryColumns are the horizontal positions of the date, mood, shared and note columns of the entries table
+var pdfEntryColumns = []float64{pdfMargin, 140, 220, 270}
+
+// exportHealthDiary renders the client's health diary entries in the requested format
+func exportHealthDiary(clientProfile *domain.ClientProfile, entries []*domain.ClientHealthDiaryEntry, from time.Time, to time.Time, format enums.HealthDiaryExportFormat) (*dto.HealthDiaryExportOutput, error) {
+	var (
+		content     []byte
+		contentType string
+		err         error
+	)
+
+	switch format {
+	case enums.HealthDiaryExportFormatCSV:
+		contentType = "text/csv"
+		content, err = renderHealthDiaryCSV(entries)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render health diary csv: %w", err)
+		}
+
+	case enums.HealthDiaryExportFormatPDF:
+		contentType = "application/pdf"
+		clientName := ""
+		if clientProfile.User != nil {
+			clientName = clientProfile.User.Name
+		}
+		content = renderHealthDiaryPDF(clientName, entries, from, to)
+
+	default:
+		return nil, fmt.Errorf("unsupported health diary export format: %s", format)
+	}
+
+	return &dto.HealthDiaryExportOutput{
+		FileName:     fmt.Sprintf("health-diary-%s-to-%s.%s", from.Format(exportDateLayout), to.Format(exportDateLayout), strings.ToLower(format.String())),
+		ContentType:  contentType,
+		Format:       format,
+		Content:      base64.StdEncoding.EncodeToString(content),
+		TotalEntries: len(entries),
+	}, nil
+}
+
+// renderHealthDiaryCSV writes the health diary entries as a spreadsheet with one row per entry
+func renderHealthDiaryCSV(entries []*domain.ClientHealthDiaryEntry) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	err := writer.Write(healthDiaryCSVHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		sharedAt := ""
+		if entry.SharedAt != nil {
+			sharedAt = entry.SharedAt.Format(time.RFC3339)
+		}
+
+		err := writer.Write([]string{
+			entry.CreatedAt.Format(time.RFC3339),
+			entry.Mood,
+			strconv.Itoa(enums.Mood(entry.Mood).Score()),
+			entry.Note,
+			strconv.FormatBool(entry.ShareWithHealthWorker),
+			sharedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// renderHealthDiaryPDF lays out a printable health diary with a chart of the client's moods over the export period
+// followed by a table of the entries
+func renderHealthDiaryPDF(clientName string, entries []*domain.ClientHealthDiaryEntry, from time.Time, to time.Time) []byte {
+	doc := utils.NewPDFDocument()
+
+	y := utils.PDFPageHeight - pdfMargin - 10
+	doc.Text(pdfMargin, y, 18, true, "Health diary")
+	y -= 20
+	if clientName != "" {
+		doc.Text(pdfMargin, y, 11, false, clientName)
+		y -= 14
+	}
+	doc.Text(pdfMargin, y, 10, false, fmt.Sprintf("%s to %s - %d entries", from.Format(exportDateTimeLayout), to.Format(exportDateTimeLayout), len(entries)))
+	y -= 30
+
+	y = drawMoodChart(doc, entries, from, to, y)
+
+	drawEntriesTable(doc, entries, y-30)
+
+	return doc.Bytes()
+}
+
+// drawMoodChart plots the mood score of every entry against the time it was recorded and returns the position below the chart
+func drawMoodChart(doc *utils.PDFDocument, entries []*domain.ClientHealthDiaryEntry, from time.Time, to time.Time, top float64) float64 {
+	bottom := top - pdfChartHeight
+	right := utils.PDFPageWidth - pdfMargin
+	width := right - pdfChartLeft
+	moods := []enums.Mood{enums.MoodVerySad, enums.MoodSad, enums.MoodNeutral, enums.MoodHappy, enums.MoodVeryHappy}
+
+	scoreY := func(score int) float64 {
+		return bottom + float64(score-1)/float64(len(moods)-1)*pdfChartHeight
+	}
+	timeX := func(t time.Time) float64 {
+		return pdfChartLeft + float64(t.Sub(from))/float64(to.Sub(from))*width
+	}
+
+	doc.SetColor(0.85, 0.85, 0.85)
+	for _, mood := range moods {
+		doc.Line(pdfChartLeft, scoreY(mood.Score()), right, scoreY(mood.Score()), 0.5)
+	}
+
+	doc.SetColor(0, 0, 0)
+	for _, mood := range moods {
+		doc.Text(pdfMargin, scoreY(mood.Score())-3, pdfTextSize, false, moodLabel(mood.String()))
+	}
+	doc.Line(pdfChartLeft, bottom, pdfChartLeft, top, 1)
+	doc.Line(pdfChartLeft, bottom, right, bottom, 1)
+	doc.Text(pdfChartLeft, bottom-14, pdfTextSize, false, from.Format(exportDateLayout))
+	doc.Text(right-50, bottom-14, pdfTextSize, false, to.Format(exportDateLayout))
+
+	if len(entries) == 0 {
+		doc.Text(pdfChartLeft+10, bottom+pdfChartHeight/2, 10, false, "No entries were recorded in this period")
+		return bottom - 14
+	}
+
+	doc.SetColor(0.13, 0.4, 0.75)
+	for i, entry := range entries {
+		x, y := timeX(entry.CreatedAt), scoreY(enums.Mood(entry.Mood).Score())
+		if i > 0 {
+			previous := entries[i-1]
+			doc.Line(timeX(previous.CreatedAt), scoreY(enums.Mood(previous.Mood).Score()), x, y, 1.5)
+		}
+		doc.Rect(x-2, y-2, 4, 4, true)
+	}
+	doc.SetColor(0, 0, 0)
+
+	return bottom - 14
+}
+
+// drawEntriesTable lists the entries starting at the given position, continuing on new pages as required
+func drawEntriesTable(doc *utils.PDFDocument, entries []*domain.ClientHealthDiaryEntry, y float64) {
+	noteWidth := utils.PDFPageWidth - pdfMargin - pdfEntryColumns[3]
+
+	drawHeader := func() {
+		for i, heading := range []string{"Date", "Mood", "Shared", "Note"} {
+			doc.Text(pdfEntryColumns[i], y, 10, true, heading)
+		}
+		y -= 4
+		doc.Line(pdfMargin, y, utils.PDFPageWidth-pdfMargin, y, 0.5)
+		y -= pdfLineHeight
+	}
+	drawHeader()
+
+	for _, entry := range entries {
+		note := utils.WrapPDFText(entry.Note, pdfTextSize, noteWidth)
+		if y-float64(len(note)-1)*pdfLineHeight < pdfMargin {
+			doc.AddPage()
+			y = utils.PDFPageHeight - pdfMargin - 10
+			drawHeader()
+		}
+
+		shared := "No"
+		if entry.ShareWithHealthWorker {
+			shared = "Yes"
+		}
+
+		doc.Text(pdfEntryColumns[0], y, pdfTextSize, false, entry.CreatedAt.Format(exportDateTimeLayout))
+		doc.Text(pdfEntryColumns[1], y, pdfTextSize, false, moodLabel(entry.Mood))
+		doc.Text(pdfEntryColumns[2], y, pdfTextSize, false, shared)
+		for _, line := range note {
+			doc.Text(pdfEntryColumns[3], y, pdfTextSize, false, line)
+			y -= pdfLineHeight
+		}
+		y -= 4
+	}
+}
+
+// moodLabel converts a mood such as VERY_HAPPY to a readable label such as Very happy
+func moodLabel(mood string) string {
+	label := strings.ToLower(strings.ReplaceAll(mood, "_", " "))
+	if label == "" {
+		return label
+	}
+	return strings.ToUpper(label[:1]) + label[1:]
+}
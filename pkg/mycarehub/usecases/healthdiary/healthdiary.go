@@ -3,14 +3,17 @@ package healthdiary
 import (
 	"context"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/savannahghi/feedlib"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/exceptions"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/servicerequest"
@@ -45,13 +48,29 @@ type IGetRandomQuote interface {
 type IGetClientHealthDiaryEntry interface {
 	GetClientHealthDiaryEntries(ctx context.Context, clientID string, moodType *enums.Mood, shared *bool) ([]*domain.ClientHealthDiaryEntry, error)
 	GetFacilityHealthDiaryEntries(ctx context.Context, input dto.FetchHealthDiaryEntries) (*dto.HealthDiaryEntriesResponse, error)
-	GetRecentHealthDiaryEntries(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile) ([]*domain.ClientHealthDiaryEntry, error)
-	GetSharedHealthDiaryEntries(ctx context.Context, clientID string, facilityID string) ([]*domain.ClientHealthDiaryEntry, error)
+	GetRecentHealthDiaryEntries(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile, facilityID string) ([]*domain.ClientHealthDiaryEntry, error)
+	GetSharedHealthDiaryEntries(ctx context.Context, clientID string) ([]*domain.ClientHealthDiaryEntry, error)
 }
 
 // IShareHealthDiaryEntry contains the methods to share the health diary with the health care worker
 type IShareHealthDiaryEntry interface {
 	ShareHealthDiaryEntry(ctx context.Context, healthDiaryEntryID string, shareEntireHealthDiary bool) (bool, error)
+	ShareHealthDiary(ctx context.Context, input dto.HealthDiaryShareInput) (*domain.HealthDiaryShare, error)
+	RevokeHealthDiaryShare(ctx context.Context, shareID string) (bool, error)
+	ListHealthDiaryShares(ctx context.Context, clientID string) ([]*domain.HealthDiaryShare, error)
+	GetCaregiverSharedHealthDiaryEntries(ctx context.Context, clientID string) ([]*domain.ClientHealthDiaryEntry, error)
+}
+
+// IMoodAnalytics contains methods that analyse a client's health diary history for sustained changes in their mood
+type IMoodAnalytics interface {
+	GetClientMoodSummary(ctx context.Context, clientID string, days *int) (*domain.ClientMoodSummary, error)
+	DetectMoodDeterioration(ctx context.Context) error
+}
+
+// IExportHealthDiary contains methods that export a client's health diary entries to a file
+type IExportHealthDiary interface {
+	ExportHealthDiary(ctx context.Context, clientID string, from time.Time, to time.Time, format enums.HealthDiaryExportFormat) (*dto.HealthDiaryExportOutput, error)
+	ExportSharedHealthDiary(ctx context.Context, clientID string, from time.Time, to time.Time, format enums.HealthDiaryExportFormat) (*dto.HealthDiaryExportOutput, error)
 }
 
 // UseCasesHealthDiary holds all the interfaces that represents the business logic to implement the health diary
@@ -61,14 +80,28 @@ type UseCasesHealthDiary interface {
 	IGetRandomQuote
 	IGetClientHealthDiaryEntry
 	IShareHealthDiaryEntry
+	IMoodAnalytics
+	IExportHealthDiary
 }
 
+const (
+	// defaultMoodSummaryDays is the number of days covered by a client's mood summary when none is specified
+	defaultMoodSummaryDays = 30
+
+	// maxMoodSummaryDays is the longest period that a client's mood summary can cover
+	maxMoodSummaryDays = 365
+
+	// maxHealthDiaryExportPeriod is the longest period that a health diary export can cover
+	maxHealthDiaryExportPeriod = 366 * 24 * time.Hour
+)
+
 // UseCasesHealthDiaryImpl embeds the healthdiary logic defined on the domain
 type UseCasesHealthDiaryImpl struct {
 	Create         infrastructure.Create
 	Query          infrastructure.Query
 	Update         infrastructure.Update
 	ServiceRequest servicerequest.UseCaseServiceRequest
+	ExternalExt    extension.ExternalMethodsExtension
 }
 
 // NewUseCaseHealthDiaryImpl creates a new instance of health diary
@@ -77,12 +110,14 @@ func NewUseCaseHealthDiaryImpl(
 	query infrastructure.Query,
 	update infrastructure.Update,
 	servicerequest servicerequest.UseCaseServiceRequest,
+	externalExt extension.ExternalMethodsExtension,
 ) *UseCasesHealthDiaryImpl {
 	return &UseCasesHealthDiaryImpl{
 		Create:         create,
 		Query:          query,
 		Update:         update,
 		ServiceRequest: servicerequest,
+		ExternalExt:    externalExt,
 	}
 }
 
@@ -126,6 +161,17 @@ func (h UseCasesHealthDiaryImpl) CreateHealthDiaryEntry(
 			return false, fmt.Errorf("failed to save health diary entry")
 		}
 
+		if reportToStaff {
+			h.shareEntryWithStaff(ctx, clientProfile, *healthDiaryEntry.ID)
+		}
+
+		if clientProfile.DefaultFacility == nil || clientProfile.DefaultFacility.ID == nil {
+			err := fmt.Errorf("client %s does not have a default facility to raise a red flag at", clientID)
+			helpers.ReportErrorToSentry(err)
+			log.Printf("failed to create a red flag for health diary entry %s: %v", *healthDiaryEntry.ID, err)
+			break
+		}
+
 		serviceRequestInput := &dto.ServiceRequestInput{
 			ClientID:    clientID,
 			Flavour:     feedlib.FlavourConsumer,
@@ -164,12 +210,23 @@ func (h UseCasesHealthDiaryImpl) CreateHealthDiaryEntry(
 		if reportToStaff {
 			healthDiaryEntry.SharedAt = &currentTime
 		}
-		_, err := h.Create.CreateHealthDiaryEntry(ctx, healthDiaryEntry)
+		healthDiaryEntry, err := h.Create.CreateHealthDiaryEntry(ctx, healthDiaryEntry)
 		if err != nil {
 			helpers.ReportErrorToSentry(err)
 			return false, fmt.Errorf("failed to save health diary entry")
 		}
+
+		if reportToStaff {
+			h.shareEntryWithStaff(ctx, clientProfile, *healthDiaryEntry.ID)
+		}
 	}
+
+	// the entry has already been saved so failing to analyse the client's mood should not fail the request
+	err = h.checkMoodDeterioration(ctx, clientProfile, time.Now())
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+	}
+
 	return true, nil
 }
 
@@ -230,7 +287,7 @@ func (h UseCasesHealthDiaryImpl) GetFacilityHealthDiaryEntries(ctx context.Conte
 	}
 
 	for _, client := range clients {
-		healthDiaryEntry, err := h.GetRecentHealthDiaryEntries(ctx, *input.LastSyncTime, client)
+		healthDiaryEntry, err := h.GetRecentHealthDiaryEntries(ctx, *input.LastSyncTime, client, *facility.ID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch client health diary entries: %v", err)
 		}
@@ -241,12 +298,14 @@ func (h UseCasesHealthDiaryImpl) GetFacilityHealthDiaryEntries(ctx context.Conte
 }
 
 // GetRecentHealthDiaryEntries fetches the most recent health diary entries. It returns the new entries
-// that were added after the last synced time. This will help KenyEMR module fetch for new health diary entries
-func (h UseCasesHealthDiaryImpl) GetRecentHealthDiaryEntries(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile) ([]*domain.ClientHealthDiaryEntry, error) {
-	return h.Query.GetRecentHealthDiaryEntries(ctx, lastSyncTime, client)
+// that were added after the last synced time and that the client shares with the facility.
+// This will help KenyEMR module fetch for new health diary entries
+func (h UseCasesHealthDiaryImpl) GetRecentHealthDiaryEntries(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile, facilityID string) ([]*domain.ClientHealthDiaryEntry, error) {
+	return h.Query.GetRecentHealthDiaryEntries(ctx, lastSyncTime, client, facilityID)
 }
 
-// ShareHealthDiaryEntry create a service request when the client opts to share their service request
+// ShareHealthDiaryEntry shares a health diary entry, or the client's entire health diary, with the client's default facility.
+// Only the client who recorded the entry can share it. A service request is created when the client opts to share a sad entry
 func (h UseCasesHealthDiaryImpl) ShareHealthDiaryEntry(ctx context.Context, healthDiaryEntryID string, shareEntireHealthDiary bool) (bool, error) {
 	healthDiaryEntry, err := h.Query.GetHealthDiaryEntryByID(ctx, healthDiaryEntryID)
 	if err != nil {
@@ -254,10 +313,17 @@ func (h UseCasesHealthDiaryImpl) ShareHealthDiaryEntry(ctx context.Context, heal
 		return false, err
 	}
 
+	clientProfile, err := h.getLoggedInClientProfile(ctx, healthDiaryEntry.ClientID)
+	if err != nil {
+		return false, err
+	}
+
 	var payload *domain.ClientHealthDiaryEntry
+	scope := enums.HealthDiaryShareScopeEntries
 	// If shareEntireHealthDiary is true, update the health diary records with the client's ID.
 	// Else, update the record whose records match both the client's ID and the health diary entry ID.
 	if shareEntireHealthDiary {
+		scope = enums.HealthDiaryShareScopeEntireDiary
 		payload = &domain.ClientHealthDiaryEntry{
 			ClientID: healthDiaryEntry.ClientID,
 		}
@@ -268,6 +334,17 @@ func (h UseCasesHealthDiaryImpl) ShareHealthDiaryEntry(ctx context.Context, heal
 		}
 	}
 
+	entryIDs := []string{}
+	if scope == enums.HealthDiaryShareScopeEntries {
+		entryIDs = append(entryIDs, healthDiaryEntryID)
+	}
+
+	err = h.shareWithDefaultFacility(ctx, clientProfile, scope, entryIDs)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, err
+	}
+
 	updateData := map[string]interface{}{
 		"share_with_health_worker": true,
 		"shared_at":                time.Now(),
@@ -279,6 +356,26 @@ func (h UseCasesHealthDiaryImpl) ShareHealthDiaryEntry(ctx context.Context, heal
 		return false, err
 	}
 
+	auditLog := &domain.AuditLog{
+		RecordType: enums.AuditLogRecordTypeHealthDiaryShare,
+		Notes:      "client shared their health diary with a health worker",
+		TargetID:   healthDiaryEntry.ClientID,
+		TargetType: "CLIENT",
+		OldValue: map[string]interface{}{
+			"shareWithHealthWorker": healthDiaryEntry.ShareWithHealthWorker,
+		},
+		NewValue: map[string]interface{}{
+			"healthDiaryEntryID":     healthDiaryEntryID,
+			"shareEntireHealthDiary": shareEntireHealthDiary,
+			"shareWithHealthWorker":  true,
+		},
+		OrganisationID: healthDiaryEntry.OrganisationID,
+		ProgramID:      healthDiaryEntry.ProgramID,
+	}
+	if err := h.Create.CreateAuditLog(ctx, auditLog); err != nil {
+		helpers.ReportErrorToSentry(err)
+	}
+
 	if healthDiaryEntry.Mood == enums.MoodVerySad.String() || healthDiaryEntry.Mood == enums.MoodSad.String() {
 		serviceRequestInput := &dto.ServiceRequestInput{
 			RequestType:    healthDiaryEntry.EntryType,
@@ -300,11 +397,532 @@ func (h UseCasesHealthDiaryImpl) ShareHealthDiaryEntry(ctx context.Context, heal
 	return true, nil
 }
 
-// GetSharedHealthDiaryEntries fetches the most recent health diary(ies) shared by the client with the health worker
-func (h UseCasesHealthDiaryImpl) GetSharedHealthDiaryEntries(ctx context.Context, clientID string, facilityID string) ([]*domain.ClientHealthDiaryEntry, error) {
-	if facilityID == "" || clientID == "" {
-		return nil, fmt.Errorf("neither facility id nor client id can be empty")
+// GetSharedHealthDiaryEntries fetches the most recent health diary(ies) shared by the client with the logged in
+// staff member's default facility
+func (h UseCasesHealthDiaryImpl) GetSharedHealthDiaryEntries(ctx context.Context, clientID string) ([]*domain.ClientHealthDiaryEntry, error) {
+	if clientID == "" {
+		return nil, exceptions.EmptyInputErr(fmt.Errorf("empty client ID value passed in input"))
+	}
+
+	clientProfile, err := h.Query.GetClientProfileByClientID(ctx, clientID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.ClientProfileNotFoundErr(err)
+	}
+
+	facilityID, err := h.getLoggedInStaffFacilityID(ctx, clientProfile)
+	if err != nil {
+		return nil, err
 	}
 
 	return h.Query.GetSharedHealthDiaryEntries(ctx, clientID, facilityID)
 }
+
+// GetClientMoodSummary summarises the moods a client recorded in their health diary over the past number of days.
+// The summary covers the past 30 days if the number of days is not specified
+func (h UseCasesHealthDiaryImpl) GetClientMoodSummary(ctx context.Context, clientID string, days *int) (*domain.ClientMoodSummary, error) {
+	if clientID == "" {
+		return nil, exceptions.EmptyInputErr(fmt.Errorf("empty client ID value passed in input"))
+	}
+
+	period := defaultMoodSummaryDays
+	if days != nil {
+		if *days < 1 || *days > maxMoodSummaryDays {
+			return nil, fmt.Errorf("a mood summary should cover between 1 and %d days", maxMoodSummaryDays)
+		}
+		period = *days
+	}
+
+	now := time.Now()
+	from := now.AddDate(0, 0, -period)
+
+	// the entries should go back far enough for the deterioration signals to be detected even for short summaries
+	since := now.Add(-domain.MoodDeteriorationHistory)
+	if from.Before(since) {
+		since = from
+	}
+
+	entries, err := h.Query.ListClientHealthDiaryEntriesSince(ctx, clientID, since)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to list client health diary entries: %w", err)
+	}
+
+	return domain.SummarizeMoods(clientID, entries, from, now), nil
+}
+
+// DetectMoodDeterioration analyses the health diary history of every client who recently recorded an entry and
+// creates a follow up service request for those whose mood is deteriorating.
+// It is run periodically so that clients who stopped recording entries are also followed up
+func (h UseCasesHealthDiaryImpl) DetectMoodDeterioration(ctx context.Context) error {
+	now := time.Now()
+
+	clientIDs, err := h.Query.ListClientIDsWithHealthDiaryEntriesSince(ctx, now.Add(-domain.MoodDeteriorationHistory))
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("failed to list clients with health diary entries: %w", err)
+	}
+
+	var errs error
+	for _, clientID := range clientIDs {
+		clientProfile, err := h.Query.GetClientProfileByClientID(ctx, clientID)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("failed to get client profile for client %s: %w", clientID, err))
+			continue
+		}
+
+		err = h.checkMoodDeterioration(ctx, clientProfile, now)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("failed to check mood deterioration for client %s: %w", clientID, err))
+		}
+	}
+
+	if errs != nil {
+		helpers.ReportErrorToSentry(errs)
+	}
+
+	return errs
+}
+
+// checkMoodDeterioration creates a mood deterioration service request when the client's health diary history shows
+// signs of a deteriorating mood. A client is only followed up once until their pending service request is resolved
+func (h UseCasesHealthDiaryImpl) checkMoodDeterioration(ctx context.Context, clientProfile *domain.ClientProfile, now time.Time) error {
+	entries, err := h.Query.ListClientHealthDiaryEntriesSince(ctx, *clientProfile.ID, now.Add(-domain.MoodDeteriorationHistory))
+	if err != nil {
+		return fmt.Errorf("failed to list client health diary entries: %w", err)
+	}
+
+	signals := domain.DetectMoodDeterioration(entries, now)
+	if len(signals) == 0 {
+		return nil
+	}
+
+	unresolved, err := h.Query.CheckIfClientHasUnresolvedServiceRequests(ctx, *clientProfile.ID, enums.ServiceRequestTypeMoodDeterioration.String())
+	if err != nil {
+		return fmt.Errorf("failed to check for unresolved mood deterioration service requests: %w", err)
+	}
+	if unresolved {
+		return nil
+	}
+
+	signalNames := []string{}
+	for _, signal := range signals {
+		signalNames = append(signalNames, signal.String())
+	}
+
+	_, err = h.ServiceRequest.CreateServiceRequest(ctx, &dto.ServiceRequestInput{
+		Active:      true,
+		ClientID:    *clientProfile.ID,
+		Flavour:     feedlib.FlavourConsumer,
+		RequestType: enums.ServiceRequestTypeMoodDeterioration.String(),
+		Request:     fmt.Sprintf("%s's health diary shows signs of a deteriorating mood. Please reach out and check on them.", clientProfile.User.Name),
+		FacilityID:  *clientProfile.DefaultFacility.ID,
+		ClientName:  &clientProfile.User.Name,
+		Meta: map[string]interface{}{
+			"signals": signalNames,
+		},
+		ProgramID:      clientProfile.User.CurrentProgramID,
+		OrganisationID: clientProfile.User.CurrentOrganizationID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create mood deterioration service request: %w", err)
+	}
+
+	return nil
+}
+
+// ExportHealthDiary exports the health diary entries that the logged in client recorded within a date range.
+// Clients can only export their own health diary
+func (h UseCasesHealthDiaryImpl) ExportHealthDiary(ctx context.Context, clientID string, from time.Time, to time.Time, format enums.HealthDiaryExportFormat) (*dto.HealthDiaryExportOutput, error) {
+	clientProfile, err := h.getHealthDiaryExportClient(ctx, clientID, from, to, format)
+	if err != nil {
+		return nil, err
+	}
+
+	loggedInUserID, err := h.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.GetLoggedInUserUIDErr(err)
+	}
+
+	if clientProfile.UserID != loggedInUserID {
+		err := fmt.Errorf("a client can only export their own health diary")
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.UserNotAuthorizedErr(err)
+	}
+
+	entries, err := h.listHealthDiaryExportEntries(ctx, clientID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return exportHealthDiary(clientProfile, entries, from, to, format)
+}
+
+// ExportSharedHealthDiary exports the health diary entries that a client shares with the staff member's facility within a date range.
+// It is used by staff members from the client's program and only entries covered by the client's active grants are exported
+func (h UseCasesHealthDiaryImpl) ExportSharedHealthDiary(ctx context.Context, clientID string, from time.Time, to time.Time, format enums.HealthDiaryExportFormat) (*dto.HealthDiaryExportOutput, error) {
+	clientProfile, err := h.getHealthDiaryExportClient(ctx, clientID, from, to, format)
+	if err != nil {
+		return nil, err
+	}
+
+	facilityID, err := h.getLoggedInStaffFacilityID(ctx, clientProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedEntries, err := h.Query.GetSharedHealthDiaryEntries(ctx, clientID, facilityID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get shared health diary entries: %w", err)
+	}
+
+	// the shared entries are listed from the most recent while the export lists them in the order they were recorded
+	entries := []*domain.ClientHealthDiaryEntry{}
+	for i := len(sharedEntries) - 1; i >= 0; i-- {
+		entry := sharedEntries[i]
+		if entry.CreatedAt.Before(from) || entry.CreatedAt.After(to) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	return exportHealthDiary(clientProfile, entries, from, to, format)
+}
+
+// getHealthDiaryExportClient validates the export input and returns the profile of the client whose health diary is exported
+func (h UseCasesHealthDiaryImpl) getHealthDiaryExportClient(ctx context.Context, clientID string, from time.Time, to time.Time, format enums.HealthDiaryExportFormat) (*domain.ClientProfile, error) {
+	if clientID == "" {
+		return nil, exceptions.EmptyInputErr(fmt.Errorf("empty client ID value passed in input"))
+	}
+	if !format.IsValid() {
+		return nil, exceptions.InputValidationErr(fmt.Errorf("invalid health diary export format: %s", format))
+	}
+	if !from.Before(to) {
+		return nil, exceptions.InputValidationErr(fmt.Errorf("the start of the export period should be before its end"))
+	}
+	if to.Sub(from) > maxHealthDiaryExportPeriod {
+		return nil, exceptions.InputValidationErr(fmt.Errorf("a health diary export should cover at most %d days", int(maxHealthDiaryExportPeriod.Hours()/24)))
+	}
+
+	clientProfile, err := h.Query.GetClientProfileByClientID(ctx, clientID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.ClientProfileNotFoundErr(err)
+	}
+
+	return clientProfile, nil
+}
+
+// listHealthDiaryExportEntries lists the client's health diary entries recorded within the export period
+func (h UseCasesHealthDiaryImpl) listHealthDiaryExportEntries(ctx context.Context, clientID string, from time.Time, to time.Time) ([]*domain.ClientHealthDiaryEntry, error) {
+	entries, err := h.Query.ListClientHealthDiaryEntriesSince(ctx, clientID, from)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to list client health diary entries: %w", err)
+	}
+
+	exported := []*domain.ClientHealthDiaryEntry{}
+	for _, entry := range entries {
+		if entry.CreatedAt.After(to) {
+			continue
+		}
+		exported = append(exported, entry)
+	}
+
+	return exported, nil
+}
+
+// ShareHealthDiary gives a facility or one of the client's caregivers access to part of the client's health diary.
+// Only the client can share their health diary. The grant lasts until it expires or the client revokes it
+func (h UseCasesHealthDiaryImpl) ShareHealthDiary(ctx context.Context, input dto.HealthDiaryShareInput) (*domain.HealthDiaryShare, error) {
+	if err := input.Validate(); err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.InputValidationErr(err)
+	}
+
+	clientProfile, err := h.getLoggedInClientProfile(ctx, input.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entryID := range input.EntryIDs {
+		entry, err := h.Query.GetHealthDiaryEntryByID(ctx, entryID)
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			return nil, fmt.Errorf("failed to get health diary entry %s: %w", entryID, err)
+		}
+		if entry.ClientID != input.ClientID {
+			err := fmt.Errorf("health diary entry %s does not belong to the client", entryID)
+			helpers.ReportErrorToSentry(err)
+			return nil, exceptions.InputValidationErr(err)
+		}
+	}
+
+	if input.FacilityID != nil {
+		facilities, _, err := h.Query.GetClientFacilities(ctx, dto.ClientFacilityInput{ClientID: &input.ClientID, FacilityID: input.FacilityID}, nil)
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			return nil, fmt.Errorf("failed to get client facilities: %w", err)
+		}
+		if len(facilities) == 0 {
+			err := fmt.Errorf("a client can only share their health diary with a facility they are assigned to")
+			helpers.ReportErrorToSentry(err)
+			return nil, exceptions.InputValidationErr(err)
+		}
+	}
+
+	if input.CaregiverID != nil {
+		caregivers, err := h.Query.GetCaregiversClient(ctx, domain.CaregiverClient{ClientID: input.ClientID, CaregiverID: *input.CaregiverID})
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			return nil, fmt.Errorf("failed to get client caregivers: %w", err)
+		}
+		if len(caregivers) == 0 {
+			err := fmt.Errorf("a client can only share their health diary with their own caregivers")
+			helpers.ReportErrorToSentry(err)
+			return nil, exceptions.InputValidationErr(err)
+		}
+	}
+
+	share, err := h.createHealthDiaryShare(ctx, &domain.HealthDiaryShare{
+		Active:         true,
+		ClientID:       input.ClientID,
+		FacilityID:     input.FacilityID,
+		CaregiverID:    input.CaregiverID,
+		Scope:          input.Scope,
+		From:           input.From,
+		To:             input.To,
+		EntryIDs:       input.EntryIDs,
+		ExpiresAt:      input.ExpiresAt,
+		ProgramID:      clientProfile.ProgramID,
+		OrganisationID: clientProfile.OrganisationID,
+	})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, err
+	}
+
+	return share, nil
+}
+
+// RevokeHealthDiaryShare withdraws a grant to the client's health diary. The grantee immediately loses access to the shared entries
+func (h UseCasesHealthDiaryImpl) RevokeHealthDiaryShare(ctx context.Context, shareID string) (bool, error) {
+	if shareID == "" {
+		return false, exceptions.EmptyInputErr(fmt.Errorf("empty health diary share ID value passed in input"))
+	}
+
+	share, err := h.Query.GetHealthDiaryShareByID(ctx, shareID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to get health diary share: %w", err)
+	}
+
+	_, err = h.getLoggedInClientProfile(ctx, share.ClientID)
+	if err != nil {
+		return false, err
+	}
+
+	if share.RevokedAt != nil {
+		return true, nil
+	}
+
+	err = h.Update.UpdateHealthDiaryShare(ctx, share, map[string]interface{}{
+		"active":     false,
+		"revoked_at": time.Now(),
+	})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to revoke health diary share: %w", err)
+	}
+
+	auditLog := &domain.AuditLog{
+		RecordType: enums.AuditLogRecordTypeHealthDiaryShareRevocation,
+		Notes:      "client revoked access to their health diary",
+		TargetID:   share.ClientID,
+		TargetType: "CLIENT",
+		OldValue: map[string]interface{}{
+			"active": share.Active,
+		},
+		NewValue: map[string]interface{}{
+			"healthDiaryShareID": shareID,
+			"active":             false,
+		},
+		OrganisationID: share.OrganisationID,
+		ProgramID:      share.ProgramID,
+	}
+	if err := h.Create.CreateAuditLog(ctx, auditLog); err != nil {
+		helpers.ReportErrorToSentry(err)
+	}
+
+	return true, nil
+}
+
+// ListHealthDiaryShares lists the grants the logged in client made to their health diary, including the expired and revoked ones
+func (h UseCasesHealthDiaryImpl) ListHealthDiaryShares(ctx context.Context, clientID string) ([]*domain.HealthDiaryShare, error) {
+	if clientID == "" {
+		return nil, exceptions.EmptyInputErr(fmt.Errorf("empty client ID value passed in input"))
+	}
+
+	_, err := h.getLoggedInClientProfile(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := h.Query.ListClientHealthDiaryShares(ctx, clientID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to list health diary shares: %w", err)
+	}
+
+	return shares, nil
+}
+
+// GetCaregiverSharedHealthDiaryEntries fetches the health diary entries that a client currently shares with the logged in caregiver
+func (h UseCasesHealthDiaryImpl) GetCaregiverSharedHealthDiaryEntries(ctx context.Context, clientID string) ([]*domain.ClientHealthDiaryEntry, error) {
+	if clientID == "" {
+		return nil, exceptions.EmptyInputErr(fmt.Errorf("empty client ID value passed in input"))
+	}
+
+	loggedInUserID, err := h.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.GetLoggedInUserUIDErr(err)
+	}
+
+	caregiver, err := h.Query.GetCaregiverByUserID(ctx, loggedInUserID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.UserNotAuthorizedErr(fmt.Errorf("only caregivers can view health diaries shared with them: %w", err))
+	}
+
+	caregivers, err := h.Query.GetCaregiversClient(ctx, domain.CaregiverClient{ClientID: clientID, CaregiverID: caregiver.ID})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get client caregivers: %w", err)
+	}
+	if len(caregivers) == 0 {
+		err := fmt.Errorf("the caregiver does not manage the client")
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.UserNotAuthorizedErr(err)
+	}
+
+	return h.Query.GetCaregiverSharedHealthDiaryEntries(ctx, clientID, caregiver.ID)
+}
+
+// getLoggedInClientProfile returns the profile of the client if they are the logged in user.
+// Clients can only share and manage access to their own health diary
+func (h UseCasesHealthDiaryImpl) getLoggedInClientProfile(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+	clientProfile, err := h.Query.GetClientProfileByClientID(ctx, clientID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.ClientProfileNotFoundErr(err)
+	}
+
+	loggedInUserID, err := h.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.GetLoggedInUserUIDErr(err)
+	}
+
+	if clientProfile.UserID != loggedInUserID {
+		err := fmt.Errorf("a client can only manage access to their own health diary")
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.UserNotAuthorizedErr(err)
+	}
+
+	return clientProfile, nil
+}
+
+// getLoggedInStaffFacilityID returns the default facility of the logged in staff member. Only staff in the client's
+// program can read the health diary entries the client shares with their facility
+func (h UseCasesHealthDiaryImpl) getLoggedInStaffFacilityID(ctx context.Context, clientProfile *domain.ClientProfile) (string, error) {
+	loggedInUserID, err := h.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return "", exceptions.GetLoggedInUserUIDErr(err)
+	}
+
+	staffProfile, err := h.Query.GetStaffProfile(ctx, loggedInUserID, clientProfile.ProgramID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return "", exceptions.UserNotAuthorizedErr(fmt.Errorf("only staff in the client's program can read their shared health diary: %w", err))
+	}
+	if staffProfile.DefaultFacility == nil || staffProfile.DefaultFacility.ID == nil {
+		err := fmt.Errorf("the staff member does not have a default facility")
+		helpers.ReportErrorToSentry(err)
+		return "", exceptions.UserNotAuthorizedErr(err)
+	}
+
+	return *staffProfile.DefaultFacility.ID, nil
+}
+
+// shareEntryWithStaff shares a newly recorded health diary entry with the client's default facility.
+// The entry has already been saved so a failure to share it, e.g. when the client has no default facility, is only reported
+func (h UseCasesHealthDiaryImpl) shareEntryWithStaff(ctx context.Context, clientProfile *domain.ClientProfile, entryID string) {
+	err := h.shareWithDefaultFacility(ctx, clientProfile, enums.HealthDiaryShareScopeEntries, []string{entryID})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		log.Printf("failed to share health diary entry %s with the client's default facility: %v", entryID, err)
+	}
+}
+
+// shareWithDefaultFacility grants the client's default facility access to their health diary.
+// It backs the legacy sharing flows where a client shares their diary with the health workers who attend to them
+func (h UseCasesHealthDiaryImpl) shareWithDefaultFacility(ctx context.Context, clientProfile *domain.ClientProfile, scope enums.HealthDiaryShareScope, entryIDs []string) error {
+	if clientProfile.DefaultFacility == nil || clientProfile.DefaultFacility.ID == nil {
+		return fmt.Errorf("the client does not have a default facility to share their health diary with")
+	}
+
+	_, err := h.createHealthDiaryShare(ctx, &domain.HealthDiaryShare{
+		Active:         true,
+		ClientID:       *clientProfile.ID,
+		FacilityID:     clientProfile.DefaultFacility.ID,
+		Scope:          scope,
+		EntryIDs:       entryIDs,
+		ProgramID:      clientProfile.ProgramID,
+		OrganisationID: clientProfile.OrganisationID,
+	})
+	return err
+}
+
+// createHealthDiaryShare saves a health diary grant and records it in the audit log
+func (h UseCasesHealthDiaryImpl) createHealthDiaryShare(ctx context.Context, input *domain.HealthDiaryShare) (*domain.HealthDiaryShare, error) {
+	share, err := h.Create.CreateHealthDiaryShare(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create health diary share: %w", err)
+	}
+
+	newValue := map[string]interface{}{
+		"scope":    share.Scope,
+		"entryIDs": share.EntryIDs,
+	}
+	if share.ID != nil {
+		newValue["healthDiaryShareID"] = *share.ID
+	}
+	if share.FacilityID != nil {
+		newValue["facilityID"] = *share.FacilityID
+	}
+	if share.CaregiverID != nil {
+		newValue["caregiverID"] = *share.CaregiverID
+	}
+	if share.ExpiresAt != nil {
+		newValue["expiresAt"] = *share.ExpiresAt
+	}
+
+	auditLog := &domain.AuditLog{
+		RecordType:     enums.AuditLogRecordTypeHealthDiaryShare,
+		Notes:          "client granted access to their health diary",
+		TargetID:       share.ClientID,
+		TargetType:     "CLIENT",
+		NewValue:       newValue,
+		OrganisationID: share.OrganisationID,
+		ProgramID:      share.ProgramID,
+	}
+	if err := h.Create.CreateAuditLog(ctx, auditLog); err != nil {
+		helpers.ReportErrorToSentry(err)
+	}
+
+	return share, nil
+}
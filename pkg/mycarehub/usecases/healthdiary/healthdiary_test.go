@@ -1,7 +1,10 @@
 package healthdiary_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/csv"
 	"fmt"
 	"reflect"
 	"testing"
@@ -11,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	extensionMock "github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension/mock"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	pgMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/mock"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/healthdiary"
@@ -94,6 +98,54 @@ func TestUseCasesHealthDiaryImpl_CreateHealthDiaryEntry(t *testing.T) {
 			want:    false,
 			wantErr: true,
 		},
+		{
+			name: "Happy Case - Report entry to staff",
+			args: args{
+				ctx:           ctx,
+				clientID:      uuid.New().String(),
+				note:          &note,
+				mood:          enums.MoodSad.String(),
+				reportToStaff: true,
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Happy Case - Report entry to staff without a default facility",
+			args: args{
+				ctx:           ctx,
+				clientID:      uuid.New().String(),
+				note:          &note,
+				mood:          enums.MoodSad.String(),
+				reportToStaff: true,
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Happy Case - Report very sad entry to staff without a default facility",
+			args: args{
+				ctx:           ctx,
+				clientID:      uuid.New().String(),
+				note:          &note,
+				mood:          enums.MoodVerySad.String(),
+				reportToStaff: true,
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Happy Case - Failed to share reported entry with staff",
+			args: args{
+				ctx:           ctx,
+				clientID:      uuid.New().String(),
+				note:          &note,
+				mood:          enums.MoodVerySad.String(),
+				reportToStaff: true,
+			},
+			want:    true,
+			wantErr: false,
+		},
 		{
 			name: "Sad Case - Failed to get client profile by client id",
 			args: args{
@@ -111,6 +163,7 @@ func TestUseCasesHealthDiaryImpl_CreateHealthDiaryEntry(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			fakeDB := pgMock.NewPostgresMock()
 			fakeServiceRequest := serviceRequestMock.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
 			_ = mock.NewHealthDiaryUseCaseMock()
 
 			if tt.name == "Sad Case - Fail to create healthdiary entry for happy mood" {
@@ -131,13 +184,38 @@ func TestUseCasesHealthDiaryImpl_CreateHealthDiaryEntry(t *testing.T) {
 				}
 			}
 
+			shared := false
+			fakeDB.MockCreateHealthDiaryShareFn = func(ctx context.Context, share *domain.HealthDiaryShare) (*domain.HealthDiaryShare, error) {
+				shared = true
+				return share, nil
+			}
+
+			if tt.name == "Happy Case - Report entry to staff without a default facility" || tt.name == "Happy Case - Report very sad entry to staff without a default facility" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+					return &domain.ClientProfile{
+						ID:   &clientID,
+						User: &domain.User{Name: gofakeit.Name()},
+					}, nil
+				}
+				fakeServiceRequest.MockCreateServiceRequestFn = func(ctx context.Context, input *dto.ServiceRequestInput) (bool, error) {
+					t.Errorf("did not expect a red flag to be raised without a default facility")
+					return false, nil
+				}
+			}
+
+			if tt.name == "Happy Case - Failed to share reported entry with staff" {
+				fakeDB.MockCreateHealthDiaryShareFn = func(ctx context.Context, share *domain.HealthDiaryShare) (*domain.HealthDiaryShare, error) {
+					return nil, fmt.Errorf("failed to create health diary share")
+				}
+			}
+
 			if tt.name == "Sad Case - Failed to get client profile by client id" {
 				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
 					return nil, fmt.Errorf("failed to get client profile")
 				}
 			}
 
-			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest)
+			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest, fakeExtension)
 			got, err := h.CreateHealthDiaryEntry(tt.args.ctx, tt.args.clientID, tt.args.note, tt.args.mood, tt.args.reportToStaff)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UseCasesHealthDiaryImpl.CreateHealthDiaryEntry() error = %v, wantErr %v", err, tt.wantErr)
@@ -146,6 +224,9 @@ func TestUseCasesHealthDiaryImpl_CreateHealthDiaryEntry(t *testing.T) {
 			if got != tt.want {
 				t.Errorf("UseCasesHealthDiaryImpl.CreateHealthDiaryEntry() = %v, want %v", got, tt.want)
 			}
+			if tt.name == "Happy Case - Report entry to staff" && !shared {
+				t.Errorf("expected the reported entry to be shared with the client's default facility")
+			}
 		})
 	}
 }
@@ -190,7 +271,8 @@ func TestUseCasesHealthDiaryImpl_GetClientHealthDiaryQuote(t *testing.T) {
 			fakeDB := pgMock.NewPostgresMock()
 			_ = mock.NewHealthDiaryUseCaseMock()
 			fakeServiceRequest := serviceRequestMock.NewServiceRequestUseCaseMock()
-			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest)
+			fakeExtension := extensionMock.NewFakeExtension()
+			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest, fakeExtension)
 
 			if tt.name == "Sad Case - Fail to get quote" {
 				fakeDB.MockGetClientHealthDiaryQuoteFn = func(ctx context.Context, limit int) ([]*domain.ClientHealthDiaryQuote, error) {
@@ -242,7 +324,8 @@ func TestUsecaseHealthDiaryImpl_CanRecordHeathDiary(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			fakeDB := pgMock.NewPostgresMock()
 			fakeServiceRequest := serviceRequestMock.NewServiceRequestUseCaseMock()
-			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest)
+			fakeExtension := extensionMock.NewFakeExtension()
+			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest, fakeExtension)
 
 			got, err := h.CanRecordHeathDiary(tt.args.ctx, tt.args.clientID)
 			if (err != nil) != tt.wantErr {
@@ -292,7 +375,8 @@ func TestUseCasesHealthDiaryImpl_GetClientHealthDiaryEntries(t *testing.T) {
 			fakeDB := pgMock.NewPostgresMock()
 			fakeHealthDiary := mock.NewHealthDiaryUseCaseMock()
 			fakeServiceRequest := serviceRequestMock.NewServiceRequestUseCaseMock()
-			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest)
+			fakeExtension := extensionMock.NewFakeExtension()
+			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest, fakeExtension)
 
 			if tt.name == "Sad Case - Missing user ID" {
 				fakeHealthDiary.MockGetClientHealthDiaryEntriesFn = func(ctx context.Context, clientID string, moodType *enums.Mood, shared *bool) ([]*domain.ClientHealthDiaryEntry, error) {
@@ -391,7 +475,8 @@ func TestUseCasesHealthDiaryImpl_GetFacilityHealthDiaryEntries(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			fakeDB := pgMock.NewPostgresMock()
 			fakeServiceRequest := serviceRequestMock.NewServiceRequestUseCaseMock()
-			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest)
+			fakeExtension := extensionMock.NewFakeExtension()
+			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest, fakeExtension)
 
 			if tt.name == "Sad Case - Failed to check if facility exists" {
 				fakeDB.MockCheckFacilityExistsByIdentifier = func(ctx context.Context, identifier *dto.FacilityIdentifierInput) (bool, error) {
@@ -426,7 +511,7 @@ func TestUseCasesHealthDiaryImpl_GetFacilityHealthDiaryEntries(t *testing.T) {
 			}
 
 			if tt.name == "Sad Case - Fail to get recent health diary entries" {
-				fakeDB.MockGetRecentHealthDiaryEntriesFn = func(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile) ([]*domain.ClientHealthDiaryEntry, error) {
+				fakeDB.MockGetRecentHealthDiaryEntriesFn = func(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile, facilityID string) ([]*domain.ClientHealthDiaryEntry, error) {
 					return nil, fmt.Errorf("failed to get recent health diary entries")
 				}
 			}
@@ -449,7 +534,14 @@ func TestUseCasesHealthDiaryImpl_ShareHealthDiaryEntry(t *testing.T) {
 
 	fakeDB := pgMock.NewPostgresMock()
 	fakeServiceRequest := serviceRequestMock.NewServiceRequestUseCaseMock()
-	h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest)
+	fakeExtension := extensionMock.NewFakeExtension()
+	h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest, fakeExtension)
+
+	clientProfile, _ := fakeDB.MockGetClientProfileByClientIDFn(ctx, uuid.New().String())
+	clientProfile.UserID = uuid.New().String()
+	fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+		return clientProfile, nil
+	}
 
 	type args struct {
 		ctx                    context.Context
@@ -472,6 +564,16 @@ func TestUseCasesHealthDiaryImpl_ShareHealthDiaryEntry(t *testing.T) {
 			want:    true,
 			wantErr: false,
 		},
+		{
+			name: "Sad case - sharing another client's entry",
+			args: args{
+				ctx:                    ctx,
+				healthDiaryEntryID:     uuid.New().String(),
+				shareEntireHealthDiary: true,
+			},
+			want:    false,
+			wantErr: true,
+		},
 		{
 			name: "Sad case - unable to create service request",
 			args: args{
@@ -499,9 +601,31 @@ func TestUseCasesHealthDiaryImpl_ShareHealthDiaryEntry(t *testing.T) {
 			want:    false,
 			wantErr: true,
 		},
+		{
+			name: "Sad case - unable to share with the default facility",
+			args: args{
+				ctx:                ctx,
+				healthDiaryEntryID: uuid.New().String(),
+			},
+			want:    false,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+				return clientProfile.UserID, nil
+			}
+			if tt.name == "Sad case - sharing another client's entry" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return uuid.New().String(), nil
+				}
+			}
+			if tt.name == "Sad case - unable to share with the default facility" {
+				fakeDB.MockCreateHealthDiaryShareFn = func(ctx context.Context, share *domain.HealthDiaryShare) (*domain.HealthDiaryShare, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
 			if tt.name == "Sad case - unable to create service request" {
 				fakeServiceRequest.MockCreateServiceRequestFn = func(ctx context.Context, input *dto.ServiceRequestInput) (bool, error) {
 					return false, fmt.Errorf("an error occurred")
@@ -538,62 +662,94 @@ func TestUseCasesHealthDiaryImpl_ShareHealthDiaryEntry(t *testing.T) {
 func TestUseCasesHealthDiaryImpl_GetSharedHealthDiaryEntry(t *testing.T) {
 	ctx := context.Background()
 
-	fakeDB := pgMock.NewPostgresMock()
-	fakeServiceRequest := serviceRequestMock.NewServiceRequestUseCaseMock()
-	h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest)
-
 	type args struct {
-		ctx        context.Context
-		clientID   string
-		facilityID string
+		ctx      context.Context
+		clientID string
 	}
 	tests := []struct {
 		name    string
 		args    args
-		want    *domain.ClientHealthDiaryEntry
 		wantErr bool
 	}{
 		{
 			name: "Happy case",
 			args: args{
-				ctx:        ctx,
-				clientID:   uuid.New().String(),
-				facilityID: uuid.New().String(),
+				ctx:      ctx,
+				clientID: uuid.New().String(),
 			},
 			wantErr: false,
 		},
 		{
 			name: "Sad case",
 			args: args{
-				ctx:        ctx,
-				clientID:   uuid.New().String(),
-				facilityID: "",
+				ctx:      ctx,
+				clientID: uuid.New().String(),
 			},
 			wantErr: true,
 		},
 		{
 			name: "Sad case - empty client ID",
 			args: args{
-				ctx:        ctx,
-				clientID:   uuid.New().String(),
-				facilityID: "",
+				ctx:      ctx,
+				clientID: "",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case - unable to get client profile",
+			args: args{
+				ctx:      ctx,
+				clientID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case - staff is not in the client's program",
+			args: args{
+				ctx:      ctx,
+				clientID: uuid.New().String(),
 			},
 			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeServiceRequest := serviceRequestMock.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest, fakeExtension)
+
+			facilityID := uuid.New().String()
+			fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID, programID string) (*domain.StaffProfile, error) {
+				return &domain.StaffProfile{
+					UserID:          userID,
+					ProgramID:       programID,
+					DefaultFacility: &domain.Facility{ID: &facilityID},
+				}, nil
+			}
+			fakeDB.MockGetSharedHealthDiaryEntriesFn = func(ctx context.Context, clientID string, sharedFacilityID string) ([]*domain.ClientHealthDiaryEntry, error) {
+				if sharedFacilityID != facilityID {
+					return nil, fmt.Errorf("expected the entries shared with the staff's facility")
+				}
+				return []*domain.ClientHealthDiaryEntry{{ClientID: clientID}}, nil
+			}
+
 			if tt.name == "Sad case" {
 				fakeDB.MockGetSharedHealthDiaryEntriesFn = func(ctx context.Context, clientID string, facilityID string) ([]*domain.ClientHealthDiaryEntry, error) {
 					return nil, fmt.Errorf("an error occurred")
 				}
 			}
-			if tt.name == "Sad case - empty client ID" {
-				fakeDB.MockGetSharedHealthDiaryEntriesFn = func(ctx context.Context, clientID string, facilityID string) ([]*domain.ClientHealthDiaryEntry, error) {
+			if tt.name == "Sad case - unable to get client profile" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
 					return nil, fmt.Errorf("an error occurred")
 				}
 			}
-			got, err := h.GetSharedHealthDiaryEntries(tt.args.ctx, tt.args.clientID, tt.args.facilityID)
+			if tt.name == "Sad case - staff is not in the client's program" {
+				fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID, programID string) (*domain.StaffProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			got, err := h.GetSharedHealthDiaryEntries(tt.args.ctx, tt.args.clientID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UseCasesHealthDiaryImpl.GetSharedHealthDiaryEntries() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -609,3 +765,905 @@ func TestUseCasesHealthDiaryImpl_GetSharedHealthDiaryEntry(t *testing.T) {
 		})
 	}
 }
+
+func TestUseCasesHealthDiaryImpl_CreateHealthDiaryEntry_MoodDeterioration(t *testing.T) {
+	note := gofakeit.HipsterSentence(20)
+
+	sadEntries := func(ctx context.Context, clientID string, since time.Time) ([]*domain.ClientHealthDiaryEntry, error) {
+		now := time.Now()
+		return []*domain.ClientHealthDiaryEntry{
+			{Mood: enums.MoodSad.String(), CreatedAt: now.Add(-72 * time.Hour)},
+			{Mood: enums.MoodSad.String(), CreatedAt: now.Add(-24 * time.Hour)},
+			{Mood: enums.MoodSad.String(), CreatedAt: now.Add(-time.Minute)},
+		}, nil
+	}
+
+	tests := []struct {
+		name               string
+		wantServiceRequest bool
+	}{
+		{
+			name:               "Happy case: sustained sadness creates a service request",
+			wantServiceRequest: true,
+		},
+		{
+			name:               "Happy case: client already has an unresolved service request",
+			wantServiceRequest: false,
+		},
+		{
+			name:               "Happy case: no signs of deterioration",
+			wantServiceRequest: false,
+		},
+		{
+			name:               "Sad case: failed to list client health diary entries",
+			wantServiceRequest: false,
+		},
+		{
+			name:               "Sad case: failed to check for unresolved service requests",
+			wantServiceRequest: false,
+		},
+		{
+			name:               "Sad case: failed to create service request",
+			wantServiceRequest: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeServiceRequest := serviceRequestMock.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest, fakeExtension)
+
+			fakeDB.MockListClientHealthDiaryEntriesSinceFn = sadEntries
+			fakeDB.MockCheckIfClientHasUnresolvedServiceRequestsFn = func(ctx context.Context, clientID string, serviceRequestType string) (bool, error) {
+				return false, nil
+			}
+
+			var serviceRequest *dto.ServiceRequestInput
+			fakeServiceRequest.MockCreateServiceRequestFn = func(ctx context.Context, input *dto.ServiceRequestInput) (bool, error) {
+				serviceRequest = input
+				if tt.name == "Sad case: failed to create service request" {
+					return false, fmt.Errorf("an error occurred")
+				}
+				return true, nil
+			}
+
+			if tt.name == "Happy case: client already has an unresolved service request" {
+				fakeDB.MockCheckIfClientHasUnresolvedServiceRequestsFn = func(ctx context.Context, clientID string, serviceRequestType string) (bool, error) {
+					return true, nil
+				}
+			}
+			if tt.name == "Happy case: no signs of deterioration" {
+				fakeDB.MockListClientHealthDiaryEntriesSinceFn = func(ctx context.Context, clientID string, since time.Time) ([]*domain.ClientHealthDiaryEntry, error) {
+					return []*domain.ClientHealthDiaryEntry{
+						{Mood: enums.MoodHappy.String(), CreatedAt: time.Now().Add(-time.Minute)},
+					}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to list client health diary entries" {
+				fakeDB.MockListClientHealthDiaryEntriesSinceFn = func(ctx context.Context, clientID string, since time.Time) ([]*domain.ClientHealthDiaryEntry, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to check for unresolved service requests" {
+				fakeDB.MockCheckIfClientHasUnresolvedServiceRequestsFn = func(ctx context.Context, clientID string, serviceRequestType string) (bool, error) {
+					return false, fmt.Errorf("an error occurred")
+				}
+			}
+
+			// failing to analyse the client's mood does not fail the entry
+			got, err := h.CreateHealthDiaryEntry(context.Background(), uuid.NewString(), &note, enums.MoodSad.String(), false)
+			if err != nil || !got {
+				t.Errorf("UseCasesHealthDiaryImpl.CreateHealthDiaryEntry() = %v, error = %v", got, err)
+				return
+			}
+
+			if (serviceRequest != nil) != tt.wantServiceRequest {
+				t.Errorf("expected a service request to be created: %v, got %v", tt.wantServiceRequest, serviceRequest)
+				return
+			}
+			if serviceRequest != nil && serviceRequest.RequestType != enums.ServiceRequestTypeMoodDeterioration.String() {
+				t.Errorf("expected a %s service request, got %s", enums.ServiceRequestTypeMoodDeterioration, serviceRequest.RequestType)
+			}
+		})
+	}
+}
+
+func TestUseCasesHealthDiaryImpl_GetClientMoodSummary(t *testing.T) {
+	week := 7
+	tooLong := 366
+
+	tests := []struct {
+		name     string
+		clientID string
+		days     *int
+		wantErr  bool
+	}{
+		{
+			name:     "Happy case: get client mood summary",
+			clientID: uuid.NewString(),
+			wantErr:  false,
+		},
+		{
+			name:     "Happy case: get client mood summary for the past week",
+			clientID: uuid.NewString(),
+			days:     &week,
+			wantErr:  false,
+		},
+		{
+			name:     "Sad case: empty client ID",
+			clientID: "",
+			wantErr:  true,
+		},
+		{
+			name:     "Sad case: summary period too long",
+			clientID: uuid.NewString(),
+			days:     &tooLong,
+			wantErr:  true,
+		},
+		{
+			name:     "Sad case: failed to list client health diary entries",
+			clientID: uuid.NewString(),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeServiceRequest := serviceRequestMock.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest, fakeExtension)
+
+			if tt.name == "Sad case: failed to list client health diary entries" {
+				fakeDB.MockListClientHealthDiaryEntriesSinceFn = func(ctx context.Context, clientID string, since time.Time) ([]*domain.ClientHealthDiaryEntry, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := h.GetClientMoodSummary(context.Background(), tt.clientID, tt.days)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesHealthDiaryImpl.GetClientMoodSummary() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.TotalEntries != 1 {
+				t.Errorf("expected the summary to have 1 entry, got %d", got.TotalEntries)
+			}
+		})
+	}
+}
+
+func TestUseCasesHealthDiaryImpl_DetectMoodDeterioration(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: detect mood deterioration",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: failed to list clients with health diary entries",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get client profile",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to check client mood deterioration",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeServiceRequest := serviceRequestMock.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest, fakeExtension)
+
+			if tt.name == "Sad case: failed to list clients with health diary entries" {
+				fakeDB.MockListClientIDsWithHealthDiaryEntriesSinceFn = func(ctx context.Context, since time.Time) ([]string, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get client profile" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to check client mood deterioration" {
+				fakeDB.MockListClientHealthDiaryEntriesSinceFn = func(ctx context.Context, clientID string, since time.Time) ([]*domain.ClientHealthDiaryEntry, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			err := h.DetectMoodDeterioration(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesHealthDiaryImpl.DetectMoodDeterioration() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUseCasesHealthDiaryImpl_ExportHealthDiary(t *testing.T) {
+	userID := uuid.NewString()
+	to := time.Now()
+	from := to.AddDate(0, 0, -30)
+
+	entries := func(ctx context.Context, clientID string, since time.Time) ([]*domain.ClientHealthDiaryEntry, error) {
+		return []*domain.ClientHealthDiaryEntry{
+			{Mood: enums.MoodSad.String(), Note: "a long, tiring (day)", CreatedAt: to.AddDate(0, 0, -3)},
+			{Mood: enums.MoodHappy.String(), Note: "feeling better", ShareWithHealthWorker: true, SharedAt: &to, CreatedAt: to.AddDate(0, 0, -1)},
+			{Mood: enums.MoodVeryHappy.String(), Note: "recorded after the export period", CreatedAt: to.Add(time.Hour)},
+		}, nil
+	}
+
+	type args struct {
+		clientID string
+		from     time.Time
+		to       time.Time
+		format   enums.HealthDiaryExportFormat
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantEntries int
+		wantErr     bool
+	}{
+		{
+			name: "Happy case: export health diary as csv",
+			args: args{
+				clientID: uuid.NewString(),
+				from:     from,
+				to:       to,
+				format:   enums.HealthDiaryExportFormatCSV,
+			},
+			wantEntries: 2,
+			wantErr:     false,
+		},
+		{
+			name: "Happy case: export health diary as pdf",
+			args: args{
+				clientID: uuid.NewString(),
+				from:     from,
+				to:       to,
+				format:   enums.HealthDiaryExportFormatPDF,
+			},
+			wantEntries: 2,
+			wantErr:     false,
+		},
+		{
+			name: "Sad case: empty client ID",
+			args: args{
+				from:   from,
+				to:     to,
+				format: enums.HealthDiaryExportFormatCSV,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid export format",
+			args: args{
+				clientID: uuid.NewString(),
+				from:     from,
+				to:       to,
+				format:   enums.HealthDiaryExportFormat("DOCX"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: export period ends before it starts",
+			args: args{
+				clientID: uuid.NewString(),
+				from:     to,
+				to:       from,
+				format:   enums.HealthDiaryExportFormatCSV,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: export period is too long",
+			args: args{
+				clientID: uuid.NewString(),
+				from:     to.AddDate(-2, 0, 0),
+				to:       to,
+				format:   enums.HealthDiaryExportFormatCSV,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get client profile",
+			args: args{
+				clientID: uuid.NewString(),
+				from:     from,
+				to:       to,
+				format:   enums.HealthDiaryExportFormatCSV,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get logged in user",
+			args: args{
+				clientID: uuid.NewString(),
+				from:     from,
+				to:       to,
+				format:   enums.HealthDiaryExportFormatCSV,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: client exports another client's health diary",
+			args: args{
+				clientID: uuid.NewString(),
+				from:     from,
+				to:       to,
+				format:   enums.HealthDiaryExportFormatCSV,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to list client health diary entries",
+			args: args{
+				clientID: uuid.NewString(),
+				from:     from,
+				to:       to,
+				format:   enums.HealthDiaryExportFormatCSV,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeServiceRequest := serviceRequestMock.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest, fakeExtension)
+
+			fakeDB.MockListClientHealthDiaryEntriesSinceFn = entries
+			fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+				return userID, nil
+			}
+			fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+				return &domain.ClientProfile{
+					ID:     &clientID,
+					UserID: userID,
+					User:   &domain.User{Name: gofakeit.Name()},
+				}, nil
+			}
+
+			if tt.name == "Sad case: failed to get client profile" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: client exports another client's health diary" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return uuid.NewString(), nil
+				}
+			}
+			if tt.name == "Sad case: failed to list client health diary entries" {
+				fakeDB.MockListClientHealthDiaryEntriesSinceFn = func(ctx context.Context, clientID string, since time.Time) ([]*domain.ClientHealthDiaryEntry, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := h.ExportHealthDiary(context.Background(), tt.args.clientID, tt.args.from, tt.args.to, tt.args.format)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesHealthDiaryImpl.ExportHealthDiary() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got.TotalEntries != tt.wantEntries {
+				t.Errorf("expected %d exported entries, got %d", tt.wantEntries, got.TotalEntries)
+			}
+
+			content, err := base64.StdEncoding.DecodeString(got.Content)
+			if err != nil {
+				t.Errorf("expected the content to be base64 encoded: %v", err)
+				return
+			}
+
+			switch tt.args.format {
+			case enums.HealthDiaryExportFormatCSV:
+				rows, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+				if err != nil {
+					t.Errorf("expected a valid csv: %v", err)
+					return
+				}
+				if len(rows) != tt.wantEntries+1 {
+					t.Errorf("expected %d csv rows, got %d", tt.wantEntries+1, len(rows))
+					return
+				}
+				if rows[1][3] != "a long, tiring (day)" {
+					t.Errorf("expected the note to be exported, got %s", rows[1][3])
+				}
+			case enums.HealthDiaryExportFormatPDF:
+				if !bytes.HasPrefix(content, []byte("%PDF-")) || !bytes.Contains(content, []byte("(a long, tiring \\(day\\)) Tj")) {
+					t.Errorf("expected a pdf listing the entries")
+				}
+			}
+		})
+	}
+}
+
+func TestUseCasesHealthDiaryImpl_ExportSharedHealthDiary(t *testing.T) {
+	to := time.Now()
+	from := to.AddDate(0, 0, -30)
+
+	tests := []struct {
+		name    string
+		format  enums.HealthDiaryExportFormat
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: export shared health diary entries",
+			format:  enums.HealthDiaryExportFormatCSV,
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: invalid export format",
+			format:  enums.HealthDiaryExportFormat("DOCX"),
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get logged in user",
+			format:  enums.HealthDiaryExportFormatCSV,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: staff is not in the client's program",
+			format:  enums.HealthDiaryExportFormatCSV,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: staff does not have a default facility",
+			format:  enums.HealthDiaryExportFormatCSV,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get shared health diary entries",
+			format:  enums.HealthDiaryExportFormatPDF,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeServiceRequest := serviceRequestMock.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest, fakeExtension)
+
+			fakeDB.MockGetSharedHealthDiaryEntriesFn = func(ctx context.Context, clientID string, facilityID string) ([]*domain.ClientHealthDiaryEntry, error) {
+				return []*domain.ClientHealthDiaryEntry{
+					{Mood: enums.MoodVerySad.String(), Note: "shared", ShareWithHealthWorker: true, SharedAt: &to, CreatedAt: to.AddDate(0, 0, -1)},
+					{Mood: enums.MoodSad.String(), Note: "before the export period", CreatedAt: from.AddDate(0, 0, -3)},
+				}, nil
+			}
+
+			if tt.name == "Sad case: failed to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: staff is not in the client's program" {
+				fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID, programID string) (*domain.StaffProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: staff does not have a default facility" {
+				fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID, programID string) (*domain.StaffProfile, error) {
+					return &domain.StaffProfile{UserID: userID, ProgramID: programID}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to get shared health diary entries" {
+				fakeDB.MockGetSharedHealthDiaryEntriesFn = func(ctx context.Context, clientID string, facilityID string) ([]*domain.ClientHealthDiaryEntry, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := h.ExportSharedHealthDiary(context.Background(), uuid.NewString(), from, to, tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesHealthDiaryImpl.ExportSharedHealthDiary() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.TotalEntries != 1 {
+				t.Errorf("expected only the shared entry within the export period to be exported, got %d entries", got.TotalEntries)
+			}
+		})
+	}
+}
+
+func TestUseCasesHealthDiaryImpl_ShareHealthDiary(t *testing.T) {
+	userID := uuid.NewString()
+	clientID := uuid.NewString()
+	facilityID := uuid.NewString()
+	caregiverID := uuid.NewString()
+	expiresAt := time.Now().AddDate(0, 1, 0)
+
+	facilityInput := dto.HealthDiaryShareInput{
+		ClientID:   clientID,
+		FacilityID: &facilityID,
+		Scope:      enums.HealthDiaryShareScopeEntries,
+		EntryIDs:   []string{uuid.NewString()},
+		ExpiresAt:  &expiresAt,
+	}
+	caregiverInput := dto.HealthDiaryShareInput{
+		ClientID:    clientID,
+		CaregiverID: &caregiverID,
+		Scope:       enums.HealthDiaryShareScopeEntireDiary,
+	}
+
+	tests := []struct {
+		name    string
+		input   dto.HealthDiaryShareInput
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: share entries with a facility",
+			input:   facilityInput,
+			wantErr: false,
+		},
+		{
+			name:    "Happy case: share entire diary with a caregiver",
+			input:   caregiverInput,
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid input",
+			input: dto.HealthDiaryShareInput{
+				ClientID: clientID,
+				Scope:    enums.HealthDiaryShareScopeEntireDiary,
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: client shares another client's health diary",
+			input:   facilityInput,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: entry belongs to another client",
+			input:   facilityInput,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get health diary entry",
+			input:   facilityInput,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: client is not assigned to the facility",
+			input:   facilityInput,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get client facilities",
+			input:   facilityInput,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: caregiver does not manage the client",
+			input:   caregiverInput,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get client caregivers",
+			input:   caregiverInput,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to create health diary share",
+			input:   facilityInput,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeServiceRequest := serviceRequestMock.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest, fakeExtension)
+
+			fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+				return userID, nil
+			}
+			fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+				return &domain.ClientProfile{ID: &clientID, UserID: userID}, nil
+			}
+			fakeDB.MockGetHealthDiaryEntryByIDFn = func(ctx context.Context, healthDiaryEntryID string) (*domain.ClientHealthDiaryEntry, error) {
+				return &domain.ClientHealthDiaryEntry{ID: &healthDiaryEntryID, ClientID: clientID}, nil
+			}
+
+			if tt.name == "Sad case: client shares another client's health diary" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return uuid.NewString(), nil
+				}
+			}
+			if tt.name == "Sad case: entry belongs to another client" {
+				fakeDB.MockGetHealthDiaryEntryByIDFn = func(ctx context.Context, healthDiaryEntryID string) (*domain.ClientHealthDiaryEntry, error) {
+					return &domain.ClientHealthDiaryEntry{ID: &healthDiaryEntryID, ClientID: uuid.NewString()}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to get health diary entry" {
+				fakeDB.MockGetHealthDiaryEntryByIDFn = func(ctx context.Context, healthDiaryEntryID string) (*domain.ClientHealthDiaryEntry, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: client is not assigned to the facility" {
+				fakeDB.MockGetClientFacilitiesFn = func(ctx context.Context, input dto.ClientFacilityInput, pagination *domain.Pagination) ([]*domain.Facility, *domain.Pagination, error) {
+					return []*domain.Facility{}, nil, nil
+				}
+			}
+			if tt.name == "Sad case: failed to get client facilities" {
+				fakeDB.MockGetClientFacilitiesFn = func(ctx context.Context, input dto.ClientFacilityInput, pagination *domain.Pagination) ([]*domain.Facility, *domain.Pagination, error) {
+					return nil, nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: caregiver does not manage the client" {
+				fakeDB.MockGetCaregiversClientFn = func(ctx context.Context, caregiverClient domain.CaregiverClient) ([]*domain.CaregiverClient, error) {
+					return []*domain.CaregiverClient{}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to get client caregivers" {
+				fakeDB.MockGetCaregiversClientFn = func(ctx context.Context, caregiverClient domain.CaregiverClient) ([]*domain.CaregiverClient, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to create health diary share" {
+				fakeDB.MockCreateHealthDiaryShareFn = func(ctx context.Context, share *domain.HealthDiaryShare) (*domain.HealthDiaryShare, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := h.ShareHealthDiary(context.Background(), tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesHealthDiaryImpl.ShareHealthDiary() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected a health diary share to be returned")
+			}
+		})
+	}
+}
+
+func TestUseCasesHealthDiaryImpl_RevokeHealthDiaryShare(t *testing.T) {
+	userID := uuid.NewString()
+
+	tests := []struct {
+		name    string
+		shareID string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: revoke health diary share",
+			shareID: uuid.NewString(),
+			wantErr: false,
+		},
+		{
+			name:    "Happy case: share is already revoked",
+			shareID: uuid.NewString(),
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: empty share ID",
+			shareID: "",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get health diary share",
+			shareID: uuid.NewString(),
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: client revokes another client's share",
+			shareID: uuid.NewString(),
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to update health diary share",
+			shareID: uuid.NewString(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeServiceRequest := serviceRequestMock.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest, fakeExtension)
+
+			fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+				return userID, nil
+			}
+			fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+				return &domain.ClientProfile{ID: &clientID, UserID: userID}, nil
+			}
+
+			if tt.name == "Happy case: share is already revoked" {
+				fakeDB.MockGetHealthDiaryShareByIDFn = func(ctx context.Context, shareID string) (*domain.HealthDiaryShare, error) {
+					revokedAt := time.Now()
+					return &domain.HealthDiaryShare{ID: &shareID, ClientID: uuid.NewString(), RevokedAt: &revokedAt}, nil
+				}
+				fakeDB.MockUpdateHealthDiaryShareFn = func(ctx context.Context, share *domain.HealthDiaryShare, updateData map[string]interface{}) error {
+					return fmt.Errorf("a revoked share should not be updated")
+				}
+			}
+			if tt.name == "Sad case: failed to get health diary share" {
+				fakeDB.MockGetHealthDiaryShareByIDFn = func(ctx context.Context, shareID string) (*domain.HealthDiaryShare, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: client revokes another client's share" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return uuid.NewString(), nil
+				}
+			}
+			if tt.name == "Sad case: failed to update health diary share" {
+				fakeDB.MockUpdateHealthDiaryShareFn = func(ctx context.Context, share *domain.HealthDiaryShare, updateData map[string]interface{}) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := h.RevokeHealthDiaryShare(context.Background(), tt.shareID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesHealthDiaryImpl.RevokeHealthDiaryShare() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != !tt.wantErr {
+				t.Errorf("UseCasesHealthDiaryImpl.RevokeHealthDiaryShare() = %v, want %v", got, !tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUseCasesHealthDiaryImpl_ListHealthDiaryShares(t *testing.T) {
+	userID := uuid.NewString()
+
+	tests := []struct {
+		name     string
+		clientID string
+		wantErr  bool
+	}{
+		{
+			name:     "Happy case: list health diary shares",
+			clientID: uuid.NewString(),
+			wantErr:  false,
+		},
+		{
+			name:     "Sad case: empty client ID",
+			clientID: "",
+			wantErr:  true,
+		},
+		{
+			name:     "Sad case: client lists another client's shares",
+			clientID: uuid.NewString(),
+			wantErr:  true,
+		},
+		{
+			name:     "Sad case: failed to list health diary shares",
+			clientID: uuid.NewString(),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeServiceRequest := serviceRequestMock.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest, fakeExtension)
+
+			fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+				return userID, nil
+			}
+			fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+				return &domain.ClientProfile{ID: &clientID, UserID: userID}, nil
+			}
+
+			if tt.name == "Sad case: client lists another client's shares" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return uuid.NewString(), nil
+				}
+			}
+			if tt.name == "Sad case: failed to list health diary shares" {
+				fakeDB.MockListClientHealthDiarySharesFn = func(ctx context.Context, clientID string) ([]*domain.HealthDiaryShare, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := h.ListHealthDiaryShares(context.Background(), tt.clientID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesHealthDiaryImpl.ListHealthDiaryShares() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected health diary shares to be returned")
+			}
+		})
+	}
+}
+
+func TestUseCasesHealthDiaryImpl_GetCaregiverSharedHealthDiaryEntries(t *testing.T) {
+	tests := []struct {
+		name     string
+		clientID string
+		wantErr  bool
+	}{
+		{
+			name:     "Happy case: get entries shared with caregiver",
+			clientID: uuid.NewString(),
+			wantErr:  false,
+		},
+		{
+			name:     "Sad case: empty client ID",
+			clientID: "",
+			wantErr:  true,
+		},
+		{
+			name:     "Sad case: failed to get logged in user",
+			clientID: uuid.NewString(),
+			wantErr:  true,
+		},
+		{
+			name:     "Sad case: logged in user is not a caregiver",
+			clientID: uuid.NewString(),
+			wantErr:  true,
+		},
+		{
+			name:     "Sad case: caregiver does not manage the client",
+			clientID: uuid.NewString(),
+			wantErr:  true,
+		},
+		{
+			name:     "Sad case: failed to get shared entries",
+			clientID: uuid.NewString(),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeServiceRequest := serviceRequestMock.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			h := healthdiary.NewUseCaseHealthDiaryImpl(fakeDB, fakeDB, fakeDB, fakeServiceRequest, fakeExtension)
+
+			if tt.name == "Sad case: failed to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: logged in user is not a caregiver" {
+				fakeDB.MockGetCaregiverByUserIDFn = func(ctx context.Context, userID string) (*domain.Caregiver, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: caregiver does not manage the client" {
+				fakeDB.MockGetCaregiversClientFn = func(ctx context.Context, caregiverClient domain.CaregiverClient) ([]*domain.CaregiverClient, error) {
+					return []*domain.CaregiverClient{}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to get shared entries" {
+				fakeDB.MockGetCaregiverSharedHealthDiaryEntriesFn = func(ctx context.Context, clientID string, caregiverID string) ([]*domain.ClientHealthDiaryEntry, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := h.GetCaregiverSharedHealthDiaryEntries(context.Background(), tt.clientID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesHealthDiaryImpl.GetCaregiverSharedHealthDiaryEntries() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected shared health diary entries to be returned")
+			}
+		})
+	}
+}
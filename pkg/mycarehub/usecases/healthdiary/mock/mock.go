@@ -12,19 +12,39 @@ import (
 
 // HealthDiaryUseCaseMock mocks the implementation of HealthDiary usecase
 type HealthDiaryUseCaseMock struct {
-	MockCreateHealthDiaryEntryFn        func(ctx context.Context, clientID string, note *string, mood string, reportToStaff bool) (bool, error)
-	MockCanRecordHeathDiaryFn           func(ctx context.Context, clientID string) (bool, error)
-	MockGetClientHealthDiaryQuoteFn     func(ctx context.Context, limit int) ([]*domain.ClientHealthDiaryQuote, error)
-	MockGetClientHealthDiaryEntriesFn   func(ctx context.Context, clientID string, moodType *enums.Mood, shared *bool) ([]*domain.ClientHealthDiaryEntry, error)
-	MockGetFacilityHealthDiaryEntriesFn func(ctx context.Context, input dto.FetchHealthDiaryEntries) (*dto.HealthDiaryEntriesResponse, error)
-	MockGetRecentHealthDiaryEntriesFn   func(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile) ([]*domain.ClientHealthDiaryEntry, error)
-	MockShareHealthDiaryEntryFn         func(ctx context.Context, clientID string, shareWithStaff bool) (bool, error)
-	MockGetSharedHealthDiaryEntriesFn   func(ctx context.Context, clientID string, facilityID string) ([]*domain.ClientHealthDiaryEntry, error)
+	MockCreateHealthDiaryEntryFn               func(ctx context.Context, clientID string, note *string, mood string, reportToStaff bool) (bool, error)
+	MockCanRecordHeathDiaryFn                  func(ctx context.Context, clientID string) (bool, error)
+	MockGetClientHealthDiaryQuoteFn            func(ctx context.Context, limit int) ([]*domain.ClientHealthDiaryQuote, error)
+	MockGetClientHealthDiaryEntriesFn          func(ctx context.Context, clientID string, moodType *enums.Mood, shared *bool) ([]*domain.ClientHealthDiaryEntry, error)
+	MockGetFacilityHealthDiaryEntriesFn        func(ctx context.Context, input dto.FetchHealthDiaryEntries) (*dto.HealthDiaryEntriesResponse, error)
+	MockGetRecentHealthDiaryEntriesFn          func(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile, facilityID string) ([]*domain.ClientHealthDiaryEntry, error)
+	MockShareHealthDiaryEntryFn                func(ctx context.Context, clientID string, shareWithStaff bool) (bool, error)
+	MockGetSharedHealthDiaryEntriesFn          func(ctx context.Context, clientID string) ([]*domain.ClientHealthDiaryEntry, error)
+	MockGetClientMoodSummaryFn                 func(ctx context.Context, clientID string, days *int) (*domain.ClientMoodSummary, error)
+	MockDetectMoodDeteriorationFn              func(ctx context.Context) error
+	MockExportHealthDiaryFn                    func(ctx context.Context, clientID string, from time.Time, to time.Time, format enums.HealthDiaryExportFormat) (*dto.HealthDiaryExportOutput, error)
+	MockExportSharedHealthDiaryFn              func(ctx context.Context, clientID string, from time.Time, to time.Time, format enums.HealthDiaryExportFormat) (*dto.HealthDiaryExportOutput, error)
+	MockShareHealthDiaryFn                     func(ctx context.Context, input dto.HealthDiaryShareInput) (*domain.HealthDiaryShare, error)
+	MockRevokeHealthDiaryShareFn               func(ctx context.Context, shareID string) (bool, error)
+	MockListHealthDiarySharesFn                func(ctx context.Context, clientID string) ([]*domain.HealthDiaryShare, error)
+	MockGetCaregiverSharedHealthDiaryEntriesFn func(ctx context.Context, clientID string) ([]*domain.ClientHealthDiaryEntry, error)
 }
 
 // NewHealthDiaryUseCaseMock initializes a new instance mock of the HealthDiary usecase
 func NewHealthDiaryUseCaseMock() *HealthDiaryUseCaseMock {
 	currentTime := time.Now()
+	shareID := uuid.New().String()
+	facilityID := uuid.New().String()
+	healthDiaryShare := &domain.HealthDiaryShare{
+		ID:             &shareID,
+		Active:         true,
+		ClientID:       uuid.New().String(),
+		FacilityID:     &facilityID,
+		Scope:          enums.HealthDiaryShareScopeEntireDiary,
+		CreatedAt:      currentTime,
+		ProgramID:      uuid.New().String(),
+		OrganisationID: uuid.New().String(),
+	}
 	return &HealthDiaryUseCaseMock{
 		MockCreateHealthDiaryEntryFn: func(ctx context.Context, clientID string, note *string, mood string, reportToStaff bool) (bool, error) {
 			return true, nil
@@ -60,10 +80,10 @@ func NewHealthDiaryUseCaseMock() *HealthDiaryUseCaseMock {
 		MockShareHealthDiaryEntryFn: func(ctx context.Context, clientID string, shareWithStaff bool) (bool, error) {
 			return true, nil
 		},
-		MockGetRecentHealthDiaryEntriesFn: func(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile) ([]*domain.ClientHealthDiaryEntry, error) {
+		MockGetRecentHealthDiaryEntriesFn: func(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile, facilityID string) ([]*domain.ClientHealthDiaryEntry, error) {
 			return []*domain.ClientHealthDiaryEntry{}, nil
 		},
-		MockGetSharedHealthDiaryEntriesFn: func(ctx context.Context, clientID string, facilityID string) ([]*domain.ClientHealthDiaryEntry, error) {
+		MockGetSharedHealthDiaryEntriesFn: func(ctx context.Context, clientID string) ([]*domain.ClientHealthDiaryEntry, error) {
 			UUID := uuid.New().String()
 			return []*domain.ClientHealthDiaryEntry{
 				{
@@ -82,6 +102,68 @@ func NewHealthDiaryUseCaseMock() *HealthDiaryUseCaseMock {
 				},
 			}, nil
 		},
+		MockGetClientMoodSummaryFn: func(ctx context.Context, clientID string, days *int) (*domain.ClientMoodSummary, error) {
+			averageScore := 3.5
+			return &domain.ClientMoodSummary{
+				ClientID:     clientID,
+				From:         currentTime.AddDate(0, 0, -7),
+				To:           currentTime,
+				TotalEntries: 2,
+				MoodCounts: []*domain.MoodCount{
+					{Mood: enums.MoodHappy, Count: 1},
+					{Mood: enums.MoodNeutral, Count: 1},
+				},
+				CurrentStreak: 2,
+				LongestStreak: 2,
+				WeeklyAverages: []*domain.WeeklyMoodAverage{
+					{WeekStart: currentTime.AddDate(0, 0, -7), Entries: 2, AverageScore: &averageScore},
+				},
+				Signals: []enums.MoodDeteriorationSignal{},
+			}, nil
+		},
+		MockDetectMoodDeteriorationFn: func(ctx context.Context) error {
+			return nil
+		},
+		MockExportHealthDiaryFn: func(ctx context.Context, clientID string, from time.Time, to time.Time, format enums.HealthDiaryExportFormat) (*dto.HealthDiaryExportOutput, error) {
+			return &dto.HealthDiaryExportOutput{
+				FileName:     "health-diary.csv",
+				ContentType:  "text/csv",
+				Format:       format,
+				Content:      "ZGF0ZSxtb29k",
+				TotalEntries: 1,
+			}, nil
+		},
+		MockExportSharedHealthDiaryFn: func(ctx context.Context, clientID string, from time.Time, to time.Time, format enums.HealthDiaryExportFormat) (*dto.HealthDiaryExportOutput, error) {
+			return &dto.HealthDiaryExportOutput{
+				FileName:     "health-diary.csv",
+				ContentType:  "text/csv",
+				Format:       format,
+				Content:      "ZGF0ZSxtb29k",
+				TotalEntries: 1,
+			}, nil
+		},
+		MockShareHealthDiaryFn: func(ctx context.Context, input dto.HealthDiaryShareInput) (*domain.HealthDiaryShare, error) {
+			return healthDiaryShare, nil
+		},
+		MockRevokeHealthDiaryShareFn: func(ctx context.Context, shareID string) (bool, error) {
+			return true, nil
+		},
+		MockListHealthDiarySharesFn: func(ctx context.Context, clientID string) ([]*domain.HealthDiaryShare, error) {
+			return []*domain.HealthDiaryShare{healthDiaryShare}, nil
+		},
+		MockGetCaregiverSharedHealthDiaryEntriesFn: func(ctx context.Context, clientID string) ([]*domain.ClientHealthDiaryEntry, error) {
+			id := uuid.New().String()
+			return []*domain.ClientHealthDiaryEntry{
+				{
+					ID:        &id,
+					Active:    true,
+					Mood:      enums.MoodHappy.String(),
+					Note:      "test",
+					ClientID:  clientID,
+					CreatedAt: currentTime,
+				},
+			}, nil
+		},
 	}
 }
 
@@ -111,8 +193,8 @@ func (h *HealthDiaryUseCaseMock) GetFacilityHealthDiaryEntries(ctx context.Conte
 }
 
 // GetRecentHealthDiaryEntries mocks getting the most recent health diary entries
-func (h *HealthDiaryUseCaseMock) GetRecentHealthDiaryEntries(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile) ([]*domain.ClientHealthDiaryEntry, error) {
-	return h.MockGetRecentHealthDiaryEntriesFn(ctx, lastSyncTime, client)
+func (h *HealthDiaryUseCaseMock) GetRecentHealthDiaryEntries(ctx context.Context, lastSyncTime time.Time, client *domain.ClientProfile, facilityID string) ([]*domain.ClientHealthDiaryEntry, error) {
+	return h.MockGetRecentHealthDiaryEntriesFn(ctx, lastSyncTime, client, facilityID)
 }
 
 // ShareHealthDiaryEntry mocks the implementation of sharing a health diary entry when the client opts to share it with the health care worker
@@ -121,6 +203,46 @@ func (h *HealthDiaryUseCaseMock) ShareHealthDiaryEntry(ctx context.Context, clie
 }
 
 // GetSharedHealthDiaryEntries mocks the implementation of getting the most recently shared health diary entires by the client to a health care worker
-func (h *HealthDiaryUseCaseMock) GetSharedHealthDiaryEntries(ctx context.Context, clientID string, facilityID string) ([]*domain.ClientHealthDiaryEntry, error) {
-	return h.MockGetSharedHealthDiaryEntriesFn(ctx, clientID, facilityID)
+func (h *HealthDiaryUseCaseMock) GetSharedHealthDiaryEntries(ctx context.Context, clientID string) ([]*domain.ClientHealthDiaryEntry, error) {
+	return h.MockGetSharedHealthDiaryEntriesFn(ctx, clientID)
+}
+
+// GetClientMoodSummary mocks the implementation of summarising the moods a client recorded in their health diary
+func (h *HealthDiaryUseCaseMock) GetClientMoodSummary(ctx context.Context, clientID string, days *int) (*domain.ClientMoodSummary, error) {
+	return h.MockGetClientMoodSummaryFn(ctx, clientID, days)
+}
+
+// DetectMoodDeterioration mocks the implementation of following up clients whose mood is deteriorating
+func (h *HealthDiaryUseCaseMock) DetectMoodDeterioration(ctx context.Context) error {
+	return h.MockDetectMoodDeteriorationFn(ctx)
+}
+
+// ExportHealthDiary mocks the implementation of exporting a client's own health diary
+func (h *HealthDiaryUseCaseMock) ExportHealthDiary(ctx context.Context, clientID string, from time.Time, to time.Time, format enums.HealthDiaryExportFormat) (*dto.HealthDiaryExportOutput, error) {
+	return h.MockExportHealthDiaryFn(ctx, clientID, from, to, format)
+}
+
+// ExportSharedHealthDiary mocks the implementation of exporting the health diary entries a client shared with health workers
+func (h *HealthDiaryUseCaseMock) ExportSharedHealthDiary(ctx context.Context, clientID string, from time.Time, to time.Time, format enums.HealthDiaryExportFormat) (*dto.HealthDiaryExportOutput, error) {
+	return h.MockExportSharedHealthDiaryFn(ctx, clientID, from, to, format)
+}
+
+// ShareHealthDiary mocks the implementation of granting a facility or caregiver access to a client's health diary
+func (h *HealthDiaryUseCaseMock) ShareHealthDiary(ctx context.Context, input dto.HealthDiaryShareInput) (*domain.HealthDiaryShare, error) {
+	return h.MockShareHealthDiaryFn(ctx, input)
+}
+
+// RevokeHealthDiaryShare mocks the implementation of revoking a grant to a client's health diary
+func (h *HealthDiaryUseCaseMock) RevokeHealthDiaryShare(ctx context.Context, shareID string) (bool, error) {
+	return h.MockRevokeHealthDiaryShareFn(ctx, shareID)
+}
+
+// ListHealthDiaryShares mocks the implementation of listing the grants a client made to their health diary
+func (h *HealthDiaryUseCaseMock) ListHealthDiaryShares(ctx context.Context, clientID string) ([]*domain.HealthDiaryShare, error) {
+	return h.MockListHealthDiarySharesFn(ctx, clientID)
+}
+
+// GetCaregiverSharedHealthDiaryEntries mocks the implementation of fetching the health diary entries a client shares with a caregiver
+func (h *HealthDiaryUseCaseMock) GetCaregiverSharedHealthDiaryEntries(ctx context.Context, clientID string) ([]*domain.ClientHealthDiaryEntry, error) {
+	return h.MockGetCaregiverSharedHealthDiaryEntriesFn(ctx, clientID)
 }
@@ -3,6 +3,7 @@ package notification
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/savannahghi/feedlib"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
@@ -17,6 +18,20 @@ type StaffNotificationArgs struct {
 
 	// Arguments for a service request notification
 	ServiceRequestType *enums.ServiceRequestType
+
+	// ServiceRequestSLA is the time within which a service request should have been picked up. Used for escalations
+	ServiceRequestSLA *time.Duration
+
+	// Arguments for a role assignment or revocation notification
+	Role *domain.AuthorityRole
+
+	// Arguments for a screening tool response notification
+	ScreeningToolName     *string
+	ScreeningToolScore    *int
+	ScreeningToolSeverity *string
+
+	// Arguments for a community crisis alert notification
+	Community *domain.Community
 }
 
 // ComposeStaffNotification composes a staff notification which will be sent to the staff at a facility
@@ -39,6 +54,57 @@ func ComposeStaffNotification(notificationType enums.NotificationType, input Sta
 
 		return notification
 
+	case enums.NotificationTypeServiceRequestEscalation:
+		notificationBody := fmt.Sprintf(
+			"%s from %s has not been picked up within %s. Please follow up on it urgently.",
+			ServiceRequestMessage(*input.ServiceRequestType),
+			input.Subject.Name,
+			formatSLA(*input.ServiceRequestSLA),
+		)
+
+		notification.Title = "A service request is overdue"
+		notification.Body = notificationBody
+
+		return notification
+
+	case enums.NotificationTypeScreeningToolResponse:
+		notificationBody := fmt.Sprintf(
+			"%s scored %d (%s) on the %s screening tool. Please follow up with them.",
+			input.Subject.Name,
+			*input.ScreeningToolScore,
+			*input.ScreeningToolSeverity,
+			*input.ScreeningToolName,
+		)
+
+		notification.Title = "A screening tool response needs your attention"
+		notification.Body = notificationBody
+
+		return notification
+
+	case enums.NotificationTypeCommunityCrisisAlert:
+		notificationBody := fmt.Sprintf(
+			"A message sent by %s in the %s community may indicate a crisis. Please reach out to them urgently.",
+			input.Subject.Name,
+			input.Community.Name,
+		)
+
+		notification.Title = "A community message needs urgent attention"
+		notification.Body = notificationBody
+
+		return notification
+
+	case enums.NotificationTypeRoleAssignment:
+		notification.Title = "You have been assigned a new role"
+		notification.Body = fmt.Sprintf("You have been assigned the %s role by %s.", input.Role.Name, input.Subject.Name)
+
+		return notification
+
+	case enums.NotificationTypeRoleRevocation:
+		notification.Title = "A role has been revoked"
+		notification.Body = fmt.Sprintf("Your %s role has been revoked by %s.", input.Role.Name, input.Subject.Name)
+
+		return notification
+
 	default:
 		return nil
 	}
@@ -61,11 +127,28 @@ func ServiceRequestMessage(request enums.ServiceRequestType) string {
 		return "A flagged screening tool response service request"
 	case enums.ServiceRequestTypeSurveyRedFlag:
 		return "A flagged survey response service request"
+	case enums.ServiceRequestTypeMissedAppointment:
+		return "A missed appointment follow up service request"
+	case enums.ServiceRequestTypeMoodDeterioration:
+		return "A mood deterioration follow up service request"
 	default:
 		return ""
 	}
 }
 
+// formatSLA renders a service request SLA in whole hours where possible, falling back to minutes
+func formatSLA(sla time.Duration) string {
+	if sla%time.Hour == 0 {
+		hours := int(sla.Hours())
+		if hours == 1 {
+			return "1 hour"
+		}
+		return fmt.Sprintf("%d hours", hours)
+	}
+
+	return fmt.Sprintf("%d minutes", int(sla.Minutes()))
+}
+
 // ClientNotificationInput is a collection of arguments required to compose a notification and the associated message
 type ClientNotificationInput struct {
 	// Arguments to a community invite notification
@@ -78,8 +161,18 @@ type ClientNotificationInput struct {
 	Appointment   *domain.Appointment
 	IsRescheduled bool
 
+	// DaysToAppointment is set when composing a reminder of an upcoming appointment
+	DaysToAppointment *int
+
 	// Args to a survey notification
 	Survey *domain.UserSurvey
+
+	// Args to a screening tool assignment notification
+	ScreeningToolName       *string
+	ScreeningToolAssignment *domain.ScreeningToolAssignment
+
+	// IsReminder is set when reminding the client of a pending survey or screening tool assignment
+	IsReminder bool
 }
 
 // ComposeClientNotification composes a client notification which will be sent to the client at a facility
@@ -106,6 +199,23 @@ func ComposeClientNotification(notificationType enums.NotificationType, input Cl
 		reason := strings.ToLower(input.Appointment.Reason)
 		date := input.Appointment.Date.AsTime().Format("January 02, 2006")
 
+		if input.DaysToAppointment != nil {
+			var when string
+			switch days := *input.DaysToAppointment; days {
+			case 0:
+				when = fmt.Sprintf("today, %s", date)
+			case 1:
+				when = fmt.Sprintf("tomorrow, %s", date)
+			default:
+				when = fmt.Sprintf("in %d days, on %s", days, date)
+			}
+
+			notification.Title = "Upcoming appointment reminder"
+			notification.Body = fmt.Sprintf("This is a reminder that you have a %s appointment %s.", reason, when)
+
+			return notification
+		}
+
 		if input.IsRescheduled {
 			notificationBody := fmt.Sprintf(
 				"Your %s appointment has been rescheduled to %s.",
@@ -129,8 +239,38 @@ func ComposeClientNotification(notificationType enums.NotificationType, input Cl
 		return notification
 
 	case enums.NotificationTypeSurveys:
-		notification.Title = "You have a new survey"
-		notification.Body = fmt.Sprintf("You have a new %s survey. Please navigate to the homepage and fill it.", input.Survey.Title)
+		if !input.IsReminder {
+			notification.Title = "You have a new survey"
+			notification.Body = fmt.Sprintf("You have a new %s survey. Please navigate to the homepage and fill it.", input.Survey.Title)
+
+			return notification
+		}
+
+		notification.Title = "Survey reminder"
+		if input.Survey.ExpiresAt != nil {
+			notification.Body = fmt.Sprintf("This is a reminder to fill the %s survey before %s.", input.Survey.Title, input.Survey.ExpiresAt.Format("January 02, 2006"))
+		} else {
+			notification.Body = fmt.Sprintf("This is a reminder to fill the %s survey. Please navigate to the homepage and fill it.", input.Survey.Title)
+		}
+
+		return notification
+
+	case enums.NotificationTypeScreeningToolAssignment:
+		dueDate := input.ScreeningToolAssignment.DueDate.Format("January 02, 2006")
+
+		if !input.IsReminder {
+			notification.Title = "You have a new screening tool"
+			notification.Body = fmt.Sprintf("Your health care worker has asked you to fill the %s screening tool by %s.", *input.ScreeningToolName, dueDate)
+
+			return notification
+		}
+
+		notification.Title = "Screening tool reminder"
+		if input.ScreeningToolAssignment.DueDate.Before(time.Now()) {
+			notification.Body = fmt.Sprintf("The %s screening tool was due on %s. Please navigate to the homepage and fill it.", *input.ScreeningToolName, dueDate)
+		} else {
+			notification.Body = fmt.Sprintf("This is a reminder to fill the %s screening tool which is due on %s.", *input.ScreeningToolName, dueDate)
+		}
 
 		return notification
 
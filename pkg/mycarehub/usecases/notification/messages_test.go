@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/savannahghi/feedlib"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
@@ -55,6 +56,10 @@ func TestServiceRequestMessage(t *testing.T) {
 
 func TestComposeStaffNotification(t *testing.T) {
 	redFlag := enums.ServiceRequestTypeRedFlag
+	sla := time.Hour
+	screeningToolName := "PHQ-9"
+	screeningToolScore := 12
+	screeningToolSeverity := "Moderate"
 	type args struct {
 		notificationType enums.NotificationType
 		args             StaffNotificationArgs
@@ -82,6 +87,105 @@ func TestComposeStaffNotification(t *testing.T) {
 				Flavour: feedlib.FlavourPro,
 			},
 		},
+		{
+			name: "service request escalation notification",
+			args: args{
+				notificationType: enums.NotificationTypeServiceRequestEscalation,
+				args: StaffNotificationArgs{
+					Subject: &domain.User{
+						Name: "John Doe",
+					},
+					ServiceRequestType: &redFlag,
+					ServiceRequestSLA:  &sla,
+				},
+			},
+			want: &domain.Notification{
+				Title:   "A service request is overdue",
+				Body:    "A flagged health diary entry service request from John Doe has not been picked up within 1 hour. Please follow up on it urgently.",
+				Type:    enums.NotificationTypeServiceRequestEscalation,
+				Flavour: feedlib.FlavourPro,
+			},
+		},
+		{
+			name: "screening tool response notification",
+			args: args{
+				notificationType: enums.NotificationTypeScreeningToolResponse,
+				args: StaffNotificationArgs{
+					Subject: &domain.User{
+						Name: "John Doe",
+					},
+					ScreeningToolName:     &screeningToolName,
+					ScreeningToolScore:    &screeningToolScore,
+					ScreeningToolSeverity: &screeningToolSeverity,
+				},
+			},
+			want: &domain.Notification{
+				Title:   "A screening tool response needs your attention",
+				Body:    "John Doe scored 12 (Moderate) on the PHQ-9 screening tool. Please follow up with them.",
+				Type:    enums.NotificationTypeScreeningToolResponse,
+				Flavour: feedlib.FlavourPro,
+			},
+		},
+		{
+			name: "community crisis alert notification",
+			args: args{
+				notificationType: enums.NotificationTypeCommunityCrisisAlert,
+				args: StaffNotificationArgs{
+					Subject: &domain.User{
+						Name: "John Doe",
+					},
+					Community: &domain.Community{
+						Name: "Teen mothers",
+					},
+				},
+			},
+			want: &domain.Notification{
+				Title:   "A community message needs urgent attention",
+				Body:    "A message sent by John Doe in the Teen mothers community may indicate a crisis. Please reach out to them urgently.",
+				Type:    enums.NotificationTypeCommunityCrisisAlert,
+				Flavour: feedlib.FlavourPro,
+			},
+		},
+		{
+			name: "role assignment notification",
+			args: args{
+				notificationType: enums.NotificationTypeRoleAssignment,
+				args: StaffNotificationArgs{
+					Subject: &domain.User{
+						Name: "John Doe",
+					},
+					Role: &domain.AuthorityRole{
+						Name: "Facility Manager",
+					},
+				},
+			},
+			want: &domain.Notification{
+				Title:   "You have been assigned a new role",
+				Body:    "You have been assigned the Facility Manager role by John Doe.",
+				Type:    enums.NotificationTypeRoleAssignment,
+				Flavour: feedlib.FlavourPro,
+			},
+		},
+		{
+			name: "role revocation notification",
+			args: args{
+				notificationType: enums.NotificationTypeRoleRevocation,
+				args: StaffNotificationArgs{
+					Subject: &domain.User{
+						Name: "John Doe",
+					},
+					Role: &domain.AuthorityRole{
+						Name: "Facility Manager",
+					},
+				},
+			},
+			want: &domain.Notification{
+				Title:   "A role has been revoked",
+				Body:    "Your Facility Manager role has been revoked by John Doe.",
+				Type:    enums.NotificationTypeRoleRevocation,
+				Flavour: feedlib.FlavourPro,
+			},
+		},
 		{
 			name: "unknown notification type",
 			args: args{
@@ -105,6 +209,12 @@ func TestComposeStaffNotification(t *testing.T) {
 }
 
 func TestComposeClientNotification(t *testing.T) {
+	sameDayReminder, threeDayReminder := 0, 3
+	screeningToolName := "PHQ-9"
+	upcomingAssignment := &domain.ScreeningToolAssignment{DueDate: time.Date(2099, 2, 1, 0, 0, 0, 0, time.UTC)}
+	overdueAssignment := &domain.ScreeningToolAssignment{DueDate: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)}
+	surveyExpiry := time.Date(2099, 2, 1, 0, 0, 0, 0, time.UTC)
+
 	type args struct {
 		notificationType enums.NotificationType
 		args             ClientNotificationInput
@@ -179,6 +289,138 @@ func TestComposeClientNotification(t *testing.T) {
 				Flavour: feedlib.FlavourConsumer,
 			},
 		},
+		{
+			name: "same day appointment reminder notification",
+			args: args{
+				notificationType: enums.NotificationTypeAppointment,
+				args: ClientNotificationInput{
+					Appointment: &domain.Appointment{
+						Reason: "Dental Check",
+						Date: scalarutils.Date{
+							Year:  2022,
+							Month: 2,
+							Day:   1,
+						},
+					},
+					DaysToAppointment: &sameDayReminder,
+				},
+			},
+			want: &domain.Notification{
+				Title:   "Upcoming appointment reminder",
+				Body:    "This is a reminder that you have a dental check appointment today, February 01, 2022.",
+				Type:    enums.NotificationTypeAppointment,
+				Flavour: feedlib.FlavourConsumer,
+			},
+		},
+		{
+			name: "appointment reminder notification",
+			args: args{
+				notificationType: enums.NotificationTypeAppointment,
+				args: ClientNotificationInput{
+					Appointment: &domain.Appointment{
+						Reason: "Dental Check",
+						Date: scalarutils.Date{
+							Year:  2022,
+							Month: 2,
+							Day:   1,
+						},
+					},
+					DaysToAppointment: &threeDayReminder,
+				},
+			},
+			want: &domain.Notification{
+				Title:   "Upcoming appointment reminder",
+				Body:    "This is a reminder that you have a dental check appointment in 3 days, on February 01, 2022.",
+				Type:    enums.NotificationTypeAppointment,
+				Flavour: feedlib.FlavourConsumer,
+			},
+		},
+		{
+			name: "screening tool assignment notification",
+			args: args{
+				notificationType: enums.NotificationTypeScreeningToolAssignment,
+				args: ClientNotificationInput{
+					ScreeningToolName:       &screeningToolName,
+					ScreeningToolAssignment: upcomingAssignment,
+				},
+			},
+			want: &domain.Notification{
+				Title:   "You have a new screening tool",
+				Body:    "Your health care worker has asked you to fill the PHQ-9 screening tool by February 01, 2099.",
+				Type:    enums.NotificationTypeScreeningToolAssignment,
+				Flavour: feedlib.FlavourConsumer,
+			},
+		},
+		{
+			name: "upcoming screening tool assignment reminder",
+			args: args{
+				notificationType: enums.NotificationTypeScreeningToolAssignment,
+				args: ClientNotificationInput{
+					ScreeningToolName:       &screeningToolName,
+					ScreeningToolAssignment: upcomingAssignment,
+					IsReminder:              true,
+				},
+			},
+			want: &domain.Notification{
+				Title:   "Screening tool reminder",
+				Body:    "This is a reminder to fill the PHQ-9 screening tool which is due on February 01, 2099.",
+				Type:    enums.NotificationTypeScreeningToolAssignment,
+				Flavour: feedlib.FlavourConsumer,
+			},
+		},
+		{
+			name: "overdue screening tool assignment reminder",
+			args: args{
+				notificationType: enums.NotificationTypeScreeningToolAssignment,
+				args: ClientNotificationInput{
+					ScreeningToolName:       &screeningToolName,
+					ScreeningToolAssignment: overdueAssignment,
+					IsReminder:              true,
+				},
+			},
+			want: &domain.Notification{
+				Title:   "Screening tool reminder",
+				Body:    "The PHQ-9 screening tool was due on February 01, 2022. Please navigate to the homepage and fill it.",
+				Type:    enums.NotificationTypeScreeningToolAssignment,
+				Flavour: feedlib.FlavourConsumer,
+			},
+		},
+		{
+			name: "survey notification",
+			args: args{
+				notificationType: enums.NotificationTypeSurveys,
+				args: ClientNotificationInput{
+					Survey: &domain.UserSurvey{
+						Title: "Monthly check in",
+					},
+				},
+			},
+			want: &domain.Notification{
+				Title:   "You have a new survey",
+				Body:    "You have a new Monthly check in survey. Please navigate to the homepage and fill it.",
+				Type:    enums.NotificationTypeSurveys,
+				Flavour: feedlib.FlavourConsumer,
+			},
+		},
+		{
+			name: "survey reminder",
+			args: args{
+				notificationType: enums.NotificationTypeSurveys,
+				args: ClientNotificationInput{
+					Survey: &domain.UserSurvey{
+						Title:     "Monthly check in",
+						ExpiresAt: &surveyExpiry,
+					},
+					IsReminder: true,
+				},
+			},
+			want: &domain.Notification{
+				Title:   "Survey reminder",
+				Body:    "This is a reminder to fill the Monthly check in survey before February 01, 2099.",
+				Type:    enums.NotificationTypeSurveys,
+				Flavour: feedlib.FlavourConsumer,
+			},
+		},
 		{
 			name: "unknown notification type",
 			args: args{
@@ -200,3 +442,34 @@ func TestComposeClientNotification(t *testing.T) {
 		})
 	}
 }
+
+func Test_formatSLA(t *testing.T) {
+	tests := []struct {
+		name string
+		sla  time.Duration
+		want string
+	}{
+		{
+			name: "single hour",
+			sla:  time.Hour,
+			want: "1 hour",
+		},
+		{
+			name: "multiple hours",
+			sla:  24 * time.Hour,
+			want: "24 hours",
+		},
+		{
+			name: "minutes",
+			sla:  90 * time.Minute,
+			want: "90 minutes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatSLA(tt.sla); got != tt.want {
+				t.Errorf("formatSLA() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -23,7 +23,9 @@ type NotificationUseCaseMock struct {
 		data map[string]interface{},
 		notification *firebasetools.FirebaseSimpleNotificationInput,
 	) (bool, error)
-	MockReadNotificationsFn func(ctx context.Context, ids []string) (bool, error)
+	MockReadNotificationsFn            func(ctx context.Context, ids []string) (bool, error)
+	MockFetchNotificationPreferencesFn func(ctx context.Context) ([]*domain.NotificationPreference, error)
+	MockSetNotificationPreferenceFn    func(ctx context.Context, input dto.NotificationPreferenceInput) (bool, error)
 }
 
 // NewServiceNotificationMock initializes a new notification mock instance
@@ -38,6 +40,19 @@ func NewServiceNotificationMock() *NotificationUseCaseMock {
 		MockReadNotificationsFn: func(ctx context.Context, ids []string) (bool, error) {
 			return true, nil
 		},
+		MockFetchNotificationPreferencesFn: func(ctx context.Context) ([]*domain.NotificationPreference, error) {
+			return []*domain.NotificationPreference{
+				{
+					UserID:           uuid.New().String(),
+					NotificationType: enums.NotificationTypeAppointment,
+					PushEnabled:      true,
+					SMSEnabled:       true,
+				},
+			}, nil
+		},
+		MockSetNotificationPreferenceFn: func(ctx context.Context, input dto.NotificationPreferenceInput) (bool, error) {
+			return true, nil
+		},
 		MockNotifyFacilityStaffsFn: func(ctx context.Context, facility *domain.Facility, notificationPayload *domain.Notification) error {
 			return nil
 		},
@@ -104,3 +119,13 @@ func (n NotificationUseCaseMock) ReadNotifications(ctx context.Context, ids []st
 func (n NotificationUseCaseMock) FetchNotificationTypeFilters(ctx context.Context, flavour feedlib.Flavour) ([]*domain.NotificationTypeFilter, error) {
 	return n.MockFetchNotificationTypeFilters(ctx, flavour)
 }
+
+// FetchNotificationPreferences mocks the implementation of fetching a user's notification preferences
+func (n NotificationUseCaseMock) FetchNotificationPreferences(ctx context.Context) ([]*domain.NotificationPreference, error) {
+	return n.MockFetchNotificationPreferencesFn(ctx)
+}
+
+// SetNotificationPreference mocks the implementation of setting a user's notification preference
+func (n NotificationUseCaseMock) SetNotificationPreference(ctx context.Context, input dto.NotificationPreferenceInput) (bool, error) {
+	return n.MockSetNotificationPreferenceFn(ctx, input)
+}
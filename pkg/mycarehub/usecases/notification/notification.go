@@ -11,6 +11,7 @@ import (
 	"github.com/savannahghi/firebasetools"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure"
@@ -24,6 +25,8 @@ type IServiceNotify interface {
 	FetchNotifications(ctx context.Context, userID string, flavour feedlib.Flavour, paginationInput dto.PaginationsInput, filters *domain.NotificationFilters) (*domain.NotificationsPage, error)
 	FetchNotificationTypeFilters(ctx context.Context, flavour feedlib.Flavour) ([]*domain.NotificationTypeFilter, error)
 	ReadNotifications(ctx context.Context, ids []string) (bool, error)
+	FetchNotificationPreferences(ctx context.Context) ([]*domain.NotificationPreference, error)
+	SetNotificationPreference(ctx context.Context, input dto.NotificationPreferenceInput) (bool, error)
 
 	SendNotification(
 		ctx context.Context,
@@ -274,3 +277,66 @@ func (n UseCaseNotificationImpl) FetchNotificationTypeFilters(ctx context.Contex
 
 	return filters, nil
 }
+
+// FetchNotificationPreferences retrieves the logged in user's preference for each type of notification.
+// Users receive notifications through all channels for the types they have not set a preference for
+func (n UseCaseNotificationImpl) FetchNotificationPreferences(ctx context.Context) ([]*domain.NotificationPreference, error) {
+	userID, err := n.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, err
+	}
+
+	preferences, err := n.Query.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, err
+	}
+
+	saved := map[enums.NotificationType]*domain.NotificationPreference{}
+	for _, preference := range preferences {
+		saved[preference.NotificationType] = preference
+	}
+
+	result := []*domain.NotificationPreference{}
+	for _, notificationType := range enums.AllNotificationTypes {
+		preference, ok := saved[notificationType]
+		if !ok {
+			preference = &domain.NotificationPreference{
+				UserID:           userID,
+				NotificationType: notificationType,
+				PushEnabled:      true,
+				SMSEnabled:       true,
+			}
+		}
+		result = append(result, preference)
+	}
+
+	return result, nil
+}
+
+// SetNotificationPreference sets the channels through which the logged in user receives a type of notification
+func (n UseCaseNotificationImpl) SetNotificationPreference(ctx context.Context, input dto.NotificationPreferenceInput) (bool, error) {
+	if !input.NotificationType.IsValid() {
+		return false, fmt.Errorf("invalid notification type provided: %s", input.NotificationType)
+	}
+
+	userID, err := n.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, err
+	}
+
+	err = n.Create.SaveNotificationPreference(ctx, &domain.NotificationPreference{
+		UserID:           userID,
+		NotificationType: input.NotificationType,
+		PushEnabled:      input.PushEnabled,
+		SMSEnabled:       input.SMSEnabled,
+	})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, err
+	}
+
+	return true, nil
+}
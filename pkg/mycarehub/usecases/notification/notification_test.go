@@ -535,3 +535,175 @@ func TestUseCaseNotificationImpl_FetchNotificationTypeFilters(t *testing.T) {
 		})
 	}
 }
+
+func TestUseCaseNotificationImpl_FetchNotificationPreferences(t *testing.T) {
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "happy case: fetch notification preferences",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "sad case: fail to get logged in user",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "sad case: fail to get notification preferences",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeFCMService := fakeFCM.NewFCMServiceMock()
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			n := notification.NewNotificationUseCaseImpl(fakeFCMService, fakeDB, fakeDB, fakeDB, fakeExtension)
+
+			if tt.name == "happy case: fetch notification preferences" {
+				fakeDB.MockGetNotificationPreferencesFn = func(ctx context.Context, userID string) ([]*domain.NotificationPreference, error) {
+					return []*domain.NotificationPreference{
+						{
+							UserID:           userID,
+							NotificationType: enums.NotificationTypeAppointment,
+							PushEnabled:      false,
+							SMSEnabled:       true,
+						},
+					}, nil
+				}
+			}
+			if tt.name == "sad case: fail to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("failed to get logged in user")
+				}
+			}
+			if tt.name == "sad case: fail to get notification preferences" {
+				fakeDB.MockGetNotificationPreferencesFn = func(ctx context.Context, userID string) ([]*domain.NotificationPreference, error) {
+					return nil, fmt.Errorf("failed to get notification preferences")
+				}
+			}
+
+			got, err := n.FetchNotificationPreferences(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseNotificationImpl.FetchNotificationPreferences() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if len(got) != len(enums.AllNotificationTypes) {
+				t.Errorf("expected a preference for each notification type, got %v", len(got))
+				return
+			}
+			for _, preference := range got {
+				wantPushEnabled := preference.NotificationType != enums.NotificationTypeAppointment
+				if preference.PushEnabled != wantPushEnabled || !preference.SMSEnabled {
+					t.Errorf("unexpected preference for %v: %+v", preference.NotificationType, preference)
+				}
+			}
+		})
+	}
+}
+
+func TestUseCaseNotificationImpl_SetNotificationPreference(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input dto.NotificationPreferenceInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "happy case: set notification preference",
+			args: args{
+				ctx: context.Background(),
+				input: dto.NotificationPreferenceInput{
+					NotificationType: enums.NotificationTypeAppointment,
+					PushEnabled:      true,
+					SMSEnabled:       false,
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "sad case: invalid notification type",
+			args: args{
+				ctx: context.Background(),
+				input: dto.NotificationPreferenceInput{
+					NotificationType: "INVALID",
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name: "sad case: fail to get logged in user",
+			args: args{
+				ctx: context.Background(),
+				input: dto.NotificationPreferenceInput{
+					NotificationType: enums.NotificationTypeAppointment,
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name: "sad case: fail to save notification preference",
+			args: args{
+				ctx: context.Background(),
+				input: dto.NotificationPreferenceInput{
+					NotificationType: enums.NotificationTypeAppointment,
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeFCMService := fakeFCM.NewFCMServiceMock()
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			n := notification.NewNotificationUseCaseImpl(fakeFCMService, fakeDB, fakeDB, fakeDB, fakeExtension)
+
+			if tt.name == "sad case: fail to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("failed to get logged in user")
+				}
+			}
+			if tt.name == "sad case: fail to save notification preference" {
+				fakeDB.MockSaveNotificationPreferenceFn = func(ctx context.Context, preference *domain.NotificationPreference) error {
+					return fmt.Errorf("failed to save notification preference")
+				}
+			}
+
+			got, err := n.SetNotificationPreference(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseNotificationImpl.SetNotificationPreference() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("UseCaseNotificationImpl.SetNotificationPreference() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
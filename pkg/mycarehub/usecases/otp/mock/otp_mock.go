@@ -17,10 +17,11 @@ type OTPUseCaseMock struct {
 		phoneNumber string,
 		flavour feedlib.Flavour,
 	) (*domain.OTPResponse, error)
-	MockVerifyPhoneNumberFn func(ctx context.Context, phone string, flavour feedlib.Flavour) (*profileutils.OtpResponse, error)
-	MockGenerateRetryOTPFn  func(ctx context.Context, payload *dto.SendRetryOTPPayload) (string, error)
-	MockSendOTPFn           func(ctx context.Context, phoneNumber string, code string, message string) (string, error)
-	MockVerifyOTP           func(ctx context.Context, payload *dto.VerifyOTPInput) (bool, error)
+	MockGeneratePINResetOTPFn func(ctx context.Context, username string, flavour feedlib.Flavour) (*domain.OTPResponse, error)
+	MockVerifyPhoneNumberFn   func(ctx context.Context, phone string, flavour feedlib.Flavour) (*profileutils.OtpResponse, error)
+	MockGenerateRetryOTPFn    func(ctx context.Context, payload *dto.SendRetryOTPPayload) (string, error)
+	MockSendOTPFn             func(ctx context.Context, phoneNumber string, code string, message string) (string, error)
+	MockVerifyOTP             func(ctx context.Context, payload *dto.VerifyOTPInput) (bool, error)
 }
 
 // NewOTPUseCaseMock initializes a new instance mock of the OTP usecase
@@ -32,6 +33,12 @@ func NewOTPUseCaseMock() *OTPUseCaseMock {
 				PhoneNumber: interserviceclient.TestUserPhoneNumber,
 			}, nil
 		},
+		MockGeneratePINResetOTPFn: func(ctx context.Context, username string, flavour feedlib.Flavour) (*domain.OTPResponse, error) {
+			return &domain.OTPResponse{
+				OTP:         "111222",
+				PhoneNumber: interserviceclient.TestUserPhoneNumber,
+			}, nil
+		},
 		MockVerifyPhoneNumberFn: func(ctx context.Context, phone string, flavour feedlib.Flavour) (*profileutils.OtpResponse, error) {
 			return &profileutils.OtpResponse{
 				OTP: "111222",
@@ -58,6 +65,11 @@ func (o *OTPUseCaseMock) GenerateAndSendOTP(
 	return o.MockGenerateAndSendOTPFn(ctx, phoneNumber, flavour)
 }
 
+// GeneratePINResetOTP mocks the implementation of generating and sending a PIN reset OTP
+func (o *OTPUseCaseMock) GeneratePINResetOTP(ctx context.Context, username string, flavour feedlib.Flavour) (*domain.OTPResponse, error) {
+	return o.MockGeneratePINResetOTPFn(ctx, username, flavour)
+}
+
 // VerifyPhoneNumber mock the implementtation of phone verification
 func (o *OTPUseCaseMock) VerifyPhoneNumber(ctx context.Context, phone string, flavour feedlib.Flavour) (*profileutils.OtpResponse, error) {
 	return o.MockVerifyPhoneNumberFn(ctx, phone, flavour)
@@ -7,7 +7,6 @@ import (
 
 	"github.com/savannahghi/converterandformatter"
 	"github.com/savannahghi/feedlib"
-	"github.com/savannahghi/interserviceclient"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/exceptions"
@@ -15,8 +14,7 @@ import (
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/utils"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure"
-	serviceSMS "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/sms"
-	serviceTwilio "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/twilio"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/smsgateway"
 	"github.com/savannahghi/serverutils"
 
 	"github.com/savannahghi/profileutils"
@@ -24,6 +22,12 @@ import (
 
 const (
 	otpMessage = "%s is your %v verification code %v"
+
+	// otpValidity is how long an OTP sent to verify a user's phone number can be used
+	otpValidity = time.Minute * 10
+
+	// pinResetOTPValidity is how long an OTP sent to reset a user's PIN can be used
+	pinResetOTPValidity = time.Hour * 1
 )
 
 var (
@@ -58,6 +62,12 @@ type ISendOTP interface {
 		flavour feedlib.Flavour,
 	) (*domain.OTPResponse, error)
 
+	GeneratePINResetOTP(
+		ctx context.Context,
+		username string,
+		flavour feedlib.Flavour,
+	) (*domain.OTPResponse, error)
+
 	GenerateRetryOTP(
 		ctx context.Context,
 		payload *dto.SendRetryOTPPayload,
@@ -81,8 +91,7 @@ type UseCaseOTPImpl struct {
 	Create      infrastructure.Create
 	Query       infrastructure.Query
 	ExternalExt extension.ExternalMethodsExtension
-	SMS         serviceSMS.IServiceSMS
-	Twilio      serviceTwilio.ITwilioService
+	SMSGateway  smsgateway.SMSGateway
 }
 
 // NewOTPUseCase initializes a new OTP service
@@ -90,15 +99,13 @@ func NewOTPUseCase(
 	create infrastructure.Create,
 	query infrastructure.Query,
 	externalExt extension.ExternalMethodsExtension,
-	sms serviceSMS.IServiceSMS,
-	twilio serviceTwilio.ITwilioService,
+	smsGateway smsgateway.SMSGateway,
 ) *UseCaseOTPImpl {
 	return &UseCaseOTPImpl{
 		Create:      create,
 		Query:       query,
 		ExternalExt: externalExt,
-		SMS:         sms,
-		Twilio:      twilio,
+		SMSGateway:  smsGateway,
 	}
 }
 
@@ -108,6 +115,26 @@ func (o *UseCaseOTPImpl) GenerateAndSendOTP(
 	username string,
 	flavour feedlib.Flavour,
 ) (*domain.OTPResponse, error) {
+	return o.generateAndSendOTP(ctx, username, flavour, otpValidity)
+}
+
+// GeneratePINResetOTP generates and sends the otp a user uses to reset their PIN. It remains valid for longer than
+// other OTPs since the user has to answer their security questions before it is used
+func (o *UseCaseOTPImpl) GeneratePINResetOTP(
+	ctx context.Context,
+	username string,
+	flavour feedlib.Flavour,
+) (*domain.OTPResponse, error) {
+	return o.generateAndSendOTP(ctx, username, flavour, pinResetOTPValidity)
+}
+
+// generateAndSendOTP generates, sends and saves an otp that is valid for the provided duration
+func (o *UseCaseOTPImpl) generateAndSendOTP(
+	ctx context.Context,
+	username string,
+	flavour feedlib.Flavour,
+	validity time.Duration,
+) (*domain.OTPResponse, error) {
 
 	if !flavour.IsValid() {
 		return nil, exceptions.InvalidFlavourDefinedErr(fmt.Errorf("flavour is not valid"))
@@ -131,6 +158,10 @@ func (o *UseCaseOTPImpl) GenerateAndSendOTP(
 		return nil, exceptions.ContactNotFoundErr(err)
 	}
 
+	if err := o.checkOTPSendLimit(ctx, phone.ContactValue); err != nil {
+		return nil, err
+	}
+
 	var message string
 	switch flavour {
 	case feedlib.FlavourConsumer:
@@ -149,7 +180,7 @@ func (o *UseCaseOTPImpl) GenerateAndSendOTP(
 		UserID:      *userProfile.ID,
 		Valid:       true,
 		GeneratedAt: time.Now(),
-		ValidUntil:  time.Now().Add(time.Minute * 10),
+		ValidUntil:  time.Now().Add(validity),
 		Channel:     "SMS",
 		Flavour:     flavour,
 		PhoneNumber: phone.ContactValue,
@@ -196,6 +227,10 @@ func (o *UseCaseOTPImpl) VerifyPhoneNumber(ctx context.Context, phone string, fl
 		return nil, exceptions.UserNotFoundError(err)
 	}
 
+	if err := o.checkOTPSendLimit(ctx, *phoneNumber); err != nil {
+		return nil, err
+	}
+
 	otp, err := utils.GenerateOTP()
 	if err != nil {
 		helpers.ReportErrorToSentry(err)
@@ -210,7 +245,7 @@ func (o *UseCaseOTPImpl) VerifyPhoneNumber(ctx context.Context, phone string, fl
 		message = fmt.Sprintf(otpMessage, otp, proAppName, proAppIdentifier)
 	}
 
-	otp, err = o.SendOTP(ctx, phone, otp, message)
+	otp, err = o.SendOTP(ctx, *phoneNumber, otp, message)
 	if err != nil {
 		helpers.ReportErrorToSentry(err)
 		return nil, err
@@ -223,7 +258,7 @@ func (o *UseCaseOTPImpl) VerifyPhoneNumber(ctx context.Context, phone string, fl
 		ValidUntil:  time.Now().Add(time.Minute * 10),
 		Channel:     "SMS",
 		Flavour:     flavour,
-		PhoneNumber: phone,
+		PhoneNumber: *phoneNumber,
 		OTP:         otp,
 	}
 
@@ -238,7 +273,7 @@ func (o *UseCaseOTPImpl) VerifyPhoneNumber(ctx context.Context, phone string, fl
 	}, nil
 }
 
-// GenerateRetryOTP generates fallback OTPs when Africa is talking sms fails
+// GenerateRetryOTP generates a new OTP when the user did not receive the previous one
 func (o *UseCaseOTPImpl) GenerateRetryOTP(ctx context.Context, payload *dto.SendRetryOTPPayload) (string, error) {
 	retryResponseOTP, err := utils.GenerateOTP()
 	if err != nil {
@@ -258,8 +293,12 @@ func (o *UseCaseOTPImpl) GenerateRetryOTP(ctx context.Context, payload *dto.Send
 		return "", exceptions.ContactNotFoundErr(err)
 	}
 
+	if err := o.checkOTPSendLimit(ctx, phone.ContactValue); err != nil {
+		return "", err
+	}
+
 	// send retry otp
-	_, err = o.SMS.SendSMS(ctx, retryResponseOTP, []string{phone.ContactValue})
+	_, err = o.SMSGateway.SendSMS(ctx, phone.ContactValue, retryResponseOTP)
 	if err != nil {
 		helpers.ReportErrorToSentry(err)
 		return "", fmt.Errorf("failed to send OTP verification code to recipient %w", err)
@@ -285,29 +324,37 @@ func (o *UseCaseOTPImpl) GenerateRetryOTP(ctx context.Context, payload *dto.Send
 	return retryResponseOTP, nil
 }
 
-// SendOTP sends an OTP message to the specified phonenumber. It checks to see whether the provided
-// phone number is Kenyan and if true, it uses AIT else for foreign numbers, it uses twilio to send
-// the otp
+// checkOTPSendLimit ensures that the number of OTPs sent to a phone number within the configured sliding window
+// has not exceeded the configured limit
+func (o *UseCaseOTPImpl) checkOTPSendLimit(ctx context.Context, phoneNumber string) error {
+	limit, window := helpers.GetOTPSendRateLimit()
+
+	count, err := o.Query.GetRecentOTPsCount(ctx, phoneNumber, time.Now().Add(-window))
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("failed to check the number of OTPs sent: %w", err)
+	}
+
+	if count >= limit {
+		return exceptions.OTPRateLimitExceededErr(fmt.Errorf("%d OTPs have already been sent to the phone number in the last %v", count, window))
+	}
+
+	return nil
+}
+
+// SendOTP sends an OTP message to the specified phonenumber through the SMS gateway. The gateway picks
+// the providers to use based on the phone number's country code and falls back to the next provider on failure
 func (o *UseCaseOTPImpl) SendOTP(
 	ctx context.Context,
 	phoneNumber string,
 	code string,
 	message string,
 ) (string, error) {
-	if interserviceclient.IsKenyanNumber(phoneNumber) {
-		_, err := o.SMS.SendSMS(ctx, message, []string{phoneNumber})
-		if err != nil {
-			helpers.ReportErrorToSentry(err)
-			return "", fmt.Errorf("failed to send OTP verification code to recipient: %w", err)
-		}
-
-	} else {
-		// Make the request to twilio
-		err := o.Twilio.SendSMSViaTwilio(ctx, phoneNumber, message)
-		if err != nil {
-			helpers.ReportErrorToSentry(err)
-			return "", fmt.Errorf("sms not sent via twilio: %w", err)
-		}
+	_, err := o.SMSGateway.SendSMS(ctx, phoneNumber, message)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return "", fmt.Errorf("failed to send OTP verification code to recipient: %w", err)
 	}
+
 	return code, nil
 }
@@ -4,21 +4,21 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit"
 	"github.com/google/uuid"
 	"github.com/savannahghi/feedlib"
 	"github.com/savannahghi/interserviceclient"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/exceptions"
 	extensionMock "github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension/mock"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	pgMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/mock"
-	smsMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/sms/mock"
-	twilioMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/twilio/mock"
+	smsGatewayMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/smsgateway/mock"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/otp"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/otp/mock"
 	"github.com/savannahghi/profileutils"
-	"github.com/savannahghi/silcomms"
 	"github.com/segmentio/ksuid"
 )
 
@@ -157,16 +157,51 @@ func TestUseCaseOTPImpl_VerifyPhoneNumber(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Sad Case - OTP send limit exceeded",
+			args: args{
+				ctx:     ctx,
+				phone:   interserviceclient.TestUserPhoneNumber,
+				flavour: feedlib.FlavourPro,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - OTP send limit exceeded for a phone number in local format",
+			args: args{
+				ctx:     ctx,
+				phone:   "0711223344",
+				flavour: feedlib.FlavourPro,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Happy case - phone number in local format is saved normalised",
+			args: args{
+				ctx:     ctx,
+				phone:   "0711223344",
+				flavour: feedlib.FlavourConsumer,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad Case - fail to count recent OTPs",
+			args: args{
+				ctx:     ctx,
+				phone:   interserviceclient.TestUserPhoneNumber,
+				flavour: feedlib.FlavourPro,
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			_ = mock.NewOTPUseCaseMock()
 			fakeDB := pgMock.NewPostgresMock()
 			fakeExtension := extensionMock.NewFakeExtension()
-			fakeSMS := smsMock.NewSMSServiceMock()
-			fakeTwilio := twilioMock.NewTwilioServiceMock()
+			fakeGateway := smsGatewayMock.NewSMSGatewayMock()
 
-			o := otp.NewOTPUseCase(fakeDB, fakeDB, fakeExtension, fakeSMS, fakeTwilio)
+			o := otp.NewOTPUseCase(fakeDB, fakeDB, fakeExtension, fakeGateway)
 
 			if tt.name == "Sad Case - Fail to save otp" {
 				fakeDB.MockSaveOTPFn = func(ctx context.Context, otpInput *domain.OTP) error {
@@ -175,7 +210,7 @@ func TestUseCaseOTPImpl_VerifyPhoneNumber(t *testing.T) {
 			}
 
 			if tt.name == "Sad Case - fail to send SMS" {
-				fakeSMS.MockSendSMSFn = func(ctx context.Context, message string, recipients []string) (*silcomms.BulkSMSResponse, error) {
+				fakeGateway.MockSendSMSFn = func(ctx context.Context, phoneNumber string, message string) (*domain.SMSDelivery, error) {
 					return nil, fmt.Errorf("an error occurred")
 				}
 			}
@@ -231,11 +266,47 @@ func TestUseCaseOTPImpl_VerifyPhoneNumber(t *testing.T) {
 					return nil, fmt.Errorf("an error occurred")
 				}
 			}
+			if tt.name == "Sad Case - OTP send limit exceeded" {
+				fakeDB.MockGetRecentOTPsCountFn = func(ctx context.Context, phoneNumber string, since time.Time) (int, error) {
+					return 100, nil
+				}
+			}
+			if tt.name == "Sad Case - OTP send limit exceeded for a phone number in local format" {
+				fakeDB.MockGetRecentOTPsCountFn = func(ctx context.Context, phoneNumber string, since time.Time) (int, error) {
+					if phoneNumber == "+254711223344" {
+						return 100, nil
+					}
+					return 0, nil
+				}
+			}
+			if tt.name == "Sad Case - fail to count recent OTPs" {
+				fakeDB.MockGetRecentOTPsCountFn = func(ctx context.Context, phoneNumber string, since time.Time) (int, error) {
+					return 0, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Happy case - phone number in local format is saved normalised" {
+				fakeGateway.MockSendSMSFn = func(ctx context.Context, phoneNumber string, message string) (*domain.SMSDelivery, error) {
+					if phoneNumber != "+254711223344" {
+						return nil, fmt.Errorf("expected the OTP to be sent to the normalised phone number, got %s", phoneNumber)
+					}
+					return &domain.SMSDelivery{}, nil
+				}
+				fakeDB.MockSaveOTPFn = func(ctx context.Context, otpInput *domain.OTP) error {
+					if otpInput.PhoneNumber != "+254711223344" {
+						return fmt.Errorf("expected the normalised phone number to be saved, got %s", otpInput.PhoneNumber)
+					}
+					return nil
+				}
+			}
 			_, err := o.VerifyPhoneNumber(tt.args.ctx, tt.args.phone, tt.args.flavour)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UseCaseOTPImpl.VerifyPhoneNumber() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if tt.name == "Sad Case - OTP send limit exceeded" && exceptions.GetErrorCode(err) != exceptions.OTPRateLimitExceededError {
+				t.Errorf("expected error code %v, got %v", exceptions.OTPRateLimitExceededError, exceptions.GetErrorCode(err))
+				return
+			}
 		})
 	}
 }
@@ -346,10 +417,9 @@ func TestMyCareHubDb_VerifyOTP_Unittest(t *testing.T) {
 			_ = mock.NewOTPUseCaseMock()
 			fakeDB := pgMock.NewPostgresMock()
 			fakeExtension := extensionMock.NewFakeExtension()
-			fakeSMS := smsMock.NewSMSServiceMock()
-			fakeTwilio := twilioMock.NewTwilioServiceMock()
+			fakeGateway := smsGatewayMock.NewSMSGatewayMock()
 
-			o := otp.NewOTPUseCase(fakeDB, fakeDB, fakeExtension, fakeSMS, fakeTwilio)
+			o := otp.NewOTPUseCase(fakeDB, fakeDB, fakeExtension, fakeGateway)
 
 			if tt.name == "Sad case - no user ID" {
 				fakeDB.MockVerifyOTPFn = func(ctx context.Context, payload *dto.VerifyOTPInput) (bool, error) {
@@ -394,6 +464,201 @@ func TestMyCareHubDb_VerifyOTP_Unittest(t *testing.T) {
 	}
 }
 
+func TestUseCaseOTPImpl_GenerateAndSendOTP(t *testing.T) {
+	ctx := context.Background()
+
+	type args struct {
+		ctx      context.Context
+		username string
+		flavour  feedlib.Flavour
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: generate and send otp",
+			args: args{
+				ctx:      ctx,
+				username: gofakeit.Username(),
+				flavour:  feedlib.FlavourConsumer,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid flavour",
+			args: args{
+				ctx:      ctx,
+				username: gofakeit.Username(),
+				flavour:  feedlib.Flavour("invalid"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get user profile",
+			args: args{
+				ctx:      ctx,
+				username: gofakeit.Username(),
+				flavour:  feedlib.FlavourConsumer,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get phone",
+			args: args{
+				ctx:      ctx,
+				username: gofakeit.Username(),
+				flavour:  feedlib.FlavourConsumer,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - OTP send limit exceeded",
+			args: args{
+				ctx:      ctx,
+				username: gofakeit.Username(),
+				flavour:  feedlib.FlavourConsumer,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - fail to count recent OTPs",
+			args: args{
+				ctx:      ctx,
+				username: gofakeit.Username(),
+				flavour:  feedlib.FlavourConsumer,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to save otp",
+			args: args{
+				ctx:      ctx,
+				username: gofakeit.Username(),
+				flavour:  feedlib.FlavourConsumer,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeGateway := smsGatewayMock.NewSMSGatewayMock()
+
+			o := otp.NewOTPUseCase(fakeDB, fakeDB, fakeExtension, fakeGateway)
+
+			if tt.name == "Sad case: unable to get user profile" {
+				fakeDB.MockGetUserProfileByUsernameFn = func(ctx context.Context, username string) (*domain.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get phone" {
+				fakeDB.MockGetContactByUserIDFn = func(ctx context.Context, userID *string, contactType string) (*domain.Contact, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad Case - OTP send limit exceeded" {
+				fakeDB.MockGetRecentOTPsCountFn = func(ctx context.Context, phoneNumber string, since time.Time) (int, error) {
+					return 100, nil
+				}
+			}
+			if tt.name == "Sad Case - fail to count recent OTPs" {
+				fakeDB.MockGetRecentOTPsCountFn = func(ctx context.Context, phoneNumber string, since time.Time) (int, error) {
+					return 0, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to save otp" {
+				fakeDB.MockSaveOTPFn = func(ctx context.Context, otpInput *domain.OTP) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			_, err := o.GenerateAndSendOTP(tt.args.ctx, tt.args.username, tt.args.flavour)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseOTPImpl.GenerateAndSendOTP() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.name == "Sad Case - OTP send limit exceeded" && exceptions.GetErrorCode(err) != exceptions.OTPRateLimitExceededError {
+				t.Errorf("expected error code %v, got %v", exceptions.OTPRateLimitExceededError, exceptions.GetErrorCode(err))
+				return
+			}
+		})
+	}
+}
+
+func TestUseCaseOTPImpl_GeneratePINResetOTP(t *testing.T) {
+	ctx := context.Background()
+
+	type args struct {
+		ctx      context.Context
+		username string
+		flavour  feedlib.Flavour
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantValidity time.Duration
+		wantErr      bool
+	}{
+		{
+			name: "Happy case: generate and send pin reset otp",
+			args: args{
+				ctx:      ctx,
+				username: gofakeit.Username(),
+				flavour:  feedlib.FlavourConsumer,
+			},
+			wantValidity: time.Hour,
+			wantErr:      false,
+		},
+		{
+			name: "Sad case: unable to save otp",
+			args: args{
+				ctx:      ctx,
+				username: gofakeit.Username(),
+				flavour:  feedlib.FlavourConsumer,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeGateway := smsGatewayMock.NewSMSGatewayMock()
+
+			o := otp.NewOTPUseCase(fakeDB, fakeDB, fakeExtension, fakeGateway)
+
+			var saved *domain.OTP
+			fakeDB.MockSaveOTPFn = func(ctx context.Context, otpInput *domain.OTP) error {
+				saved = otpInput
+				return nil
+			}
+
+			if tt.name == "Sad case: unable to save otp" {
+				fakeDB.MockSaveOTPFn = func(ctx context.Context, otpInput *domain.OTP) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			_, err := o.GeneratePINResetOTP(tt.args.ctx, tt.args.username, tt.args.flavour)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseOTPImpl.GeneratePINResetOTP() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			validity := saved.ValidUntil.Sub(saved.GeneratedAt)
+			if validity < tt.wantValidity-time.Second || validity > tt.wantValidity+time.Second {
+				t.Errorf("expected the pin reset otp to be valid for %v, got %v", tt.wantValidity, validity)
+			}
+		})
+	}
+}
+
 func TestUseCaseOTPImpl_GenerateRetryOTP(t *testing.T) {
 	ctx := context.Background()
 
@@ -456,16 +721,31 @@ func TestUseCaseOTPImpl_GenerateRetryOTP(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Sad Case - OTP send limit exceeded",
+			args: args{
+				ctx:     ctx,
+				payload: validPayload,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - fail to count recent OTPs",
+			args: args{
+				ctx:     ctx,
+				payload: validPayload,
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			_ = mock.NewOTPUseCaseMock()
 			fakeDB := pgMock.NewPostgresMock()
 			fakeExtension := extensionMock.NewFakeExtension()
-			fakeSMS := smsMock.NewSMSServiceMock()
-			fakeTwilio := twilioMock.NewTwilioServiceMock()
+			fakeGateway := smsGatewayMock.NewSMSGatewayMock()
 
-			o := otp.NewOTPUseCase(fakeDB, fakeDB, fakeExtension, fakeSMS, fakeTwilio)
+			o := otp.NewOTPUseCase(fakeDB, fakeDB, fakeExtension, fakeGateway)
 
 			if tt.name == "Sad case - unable to get phone" {
 				fakeDB.MockGetContactByUserIDFn = func(ctx context.Context, userID *string, contactType string) (*domain.Contact, error) {
@@ -483,11 +763,22 @@ func TestUseCaseOTPImpl_GenerateRetryOTP(t *testing.T) {
 				}
 			}
 			if tt.name == "Sad Case - unable to send SMS" {
-				fakeSMS.MockSendSMSFn = func(ctx context.Context, message string, recipients []string) (*silcomms.BulkSMSResponse, error) {
+				fakeGateway.MockSendSMSFn = func(ctx context.Context, phoneNumber string, message string) (*domain.SMSDelivery, error) {
 					return nil, fmt.Errorf("an error occurred")
 				}
 			}
 
+			if tt.name == "Sad Case - OTP send limit exceeded" {
+				fakeDB.MockGetRecentOTPsCountFn = func(ctx context.Context, phoneNumber string, since time.Time) (int, error) {
+					return 100, nil
+				}
+			}
+			if tt.name == "Sad Case - fail to count recent OTPs" {
+				fakeDB.MockGetRecentOTPsCountFn = func(ctx context.Context, phoneNumber string, since time.Time) (int, error) {
+					return 0, fmt.Errorf("an error occurred")
+				}
+			}
+
 			_, err := o.GenerateRetryOTP(tt.args.ctx, tt.args.payload)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UseCaseOTPImpl.GenerateRetryOTP() error = %v, wantErr %v", err, tt.wantErr)
@@ -559,20 +850,19 @@ func TestUseCaseOTPImpl_SendOTP(t *testing.T) {
 			_ = mock.NewOTPUseCaseMock()
 			fakeDB := pgMock.NewPostgresMock()
 			fakeExtension := extensionMock.NewFakeExtension()
-			fakeSMS := smsMock.NewSMSServiceMock()
-			fakeTwilio := twilioMock.NewTwilioServiceMock()
+			fakeGateway := smsGatewayMock.NewSMSGatewayMock()
 
-			o := otp.NewOTPUseCase(fakeDB, fakeDB, fakeExtension, fakeSMS, fakeTwilio)
+			o := otp.NewOTPUseCase(fakeDB, fakeDB, fakeExtension, fakeGateway)
 
 			if tt.name == "Sad Case - Fail to send an otp to kenyan number" {
-				fakeSMS.MockSendSMSFn = func(ctx context.Context, message string, recipients []string) (*silcomms.BulkSMSResponse, error) {
+				fakeGateway.MockSendSMSFn = func(ctx context.Context, phoneNumber string, message string) (*domain.SMSDelivery, error) {
 					return nil, fmt.Errorf("an error occurred")
 				}
 			}
 
 			if tt.name == "Sad Case - Fail to send an otp to foreign number" {
-				fakeTwilio.MockSendSMSViaTwilioFn = func(ctx context.Context, phonenumber, message string) error {
-					return fmt.Errorf("failed to send sms")
+				fakeGateway.MockSendSMSFn = func(ctx context.Context, phoneNumber string, message string) (*domain.SMSDelivery, error) {
+					return nil, fmt.Errorf("failed to send sms")
 				}
 			}
 
@@ -15,6 +15,7 @@ import (
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/matrix"
 	pubsubmessaging "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/pubsub"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/communities"
 )
 
 // ICreatePrograms creates the programs
@@ -55,6 +56,7 @@ type UsecaseProgramsImpl struct {
 	ExternalExt extension.ExternalMethodsExtension
 	Pubsub      pubsubmessaging.ServicePubsub
 	Matrix      matrix.Matrix
+	Community   communities.UseCasesCommunities
 }
 
 // NewUsecasePrograms is the controller function for the Programs usecase
@@ -65,6 +67,7 @@ func NewUsecasePrograms(
 	ext extension.ExternalMethodsExtension,
 	pubsub pubsubmessaging.ServicePubsub,
 	matrix matrix.Matrix,
+	community communities.UseCasesCommunities,
 ) UsecasePrograms {
 	return &UsecaseProgramsImpl{
 		Query:       query,
@@ -73,6 +76,7 @@ func NewUsecasePrograms(
 		ExternalExt: ext,
 		Pubsub:      pubsub,
 		Matrix:      matrix,
+		Community:   community,
 	}
 }
 
@@ -178,17 +182,46 @@ func (u *UsecaseProgramsImpl) CreateProgram(ctx context.Context, input *dto.Prog
 				})
 			}
 
+			conditions := []domain.QuestionCondition{}
+			for _, c := range q.Conditions {
+				conditions = append(conditions, domain.QuestionCondition{
+					Active:            true,
+					DependsOnSequence: c.QuestionSequence,
+					Operator:          c.Operator,
+					Target:            c.Target,
+					Values:            c.Values,
+					ProgramID:         program.ID,
+					OrganisationID:    program.Organisation.ID,
+				})
+			}
+
 			questions = append(questions, domain.Question{
-				Active:            true,
-				Text:              q.Text,
-				QuestionType:      q.QuestionType,
-				ResponseValueType: q.ResponseValueType,
-				Required:          q.Required,
-				SelectMultiple:    q.SelectMultiple,
-				Sequence:          q.Sequence,
-				Choices:           choices,
-				ProgramID:         program.ID,
-				OrganisationID:    program.Organisation.ID,
+				Active:                true,
+				Text:                  q.Text,
+				QuestionType:          q.QuestionType,
+				ResponseValueType:     q.ResponseValueType,
+				Required:              q.Required,
+				SelectMultiple:        q.SelectMultiple,
+				Sequence:              q.Sequence,
+				Choices:               choices,
+				Conditions:            conditions,
+				CriticalItemThreshold: q.CriticalItemThreshold,
+				ProgramID:             program.ID,
+				OrganisationID:        program.Organisation.ID,
+			})
+		}
+
+		scoreBands := []domain.ScreeningToolScoreBand{}
+		for _, b := range input.ScoreBands {
+			scoreBands = append(scoreBands, domain.ScreeningToolScoreBand{
+				Active:         true,
+				Label:          b.Label,
+				MinScore:       b.MinScore,
+				MaxScore:       b.MaxScore,
+				Action:         b.Action,
+				Priority:       b.Priority,
+				ProgramID:      program.ID,
+				OrganisationID: program.Organisation.ID,
 			})
 		}
 
@@ -211,6 +244,7 @@ func (u *UsecaseProgramsImpl) CreateProgram(ctx context.Context, input *dto.Prog
 				ProgramID:      program.ID,
 				OrganisationID: program.Organisation.ID,
 			},
+			ScoreBands: scoreBands,
 		}
 
 		err = u.Create.CreateScreeningTool(ctx, payload)
@@ -223,9 +257,21 @@ func (u *UsecaseProgramsImpl) CreateProgram(ctx context.Context, input *dto.Prog
 	return true, nil
 }
 
-// ListUserPrograms lists the programs a user is part of in an organisation
+// ListUserPrograms lists the programs a user is part of in an organisation. Users can only list their own programs
 func (u *UsecaseProgramsImpl) ListUserPrograms(ctx context.Context, userID string, flavour feedlib.Flavour) (*dto.ProgramOutput, error) {
-	_, err := u.Query.GetUserProfileByUserID(ctx, userID)
+	uid, err := u.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.GetLoggedInUserUIDErr(err)
+	}
+
+	if uid != userID {
+		err := fmt.Errorf("user %s cannot list the programs of another user", uid)
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.UserNotAuthorizedErr(err)
+	}
+
+	_, err = u.Query.GetUserProfileByUserID(ctx, userID)
 	if err != nil {
 		helpers.ReportErrorToSentry(err)
 		return nil, exceptions.UserNotFoundError(err)
@@ -290,7 +336,8 @@ func (u *UsecaseProgramsImpl) GetProgramFacilities(ctx context.Context, programI
 	return u.Query.GetProgramFacilities(ctx, programID)
 }
 
-// SetStaffProgram sets the program that the staff user has selected from their programs
+// SetStaffProgram sets the program that the staff user has selected from their programs.
+// It only acts on the logged in user's own staff profile in the program so it does not require a permission
 func (u *UsecaseProgramsImpl) SetStaffProgram(ctx context.Context, programID string) (*domain.StaffResponse, error) {
 	uid, err := u.ExternalExt.GetLoggedInUserUID(ctx)
 	if err != nil {
@@ -310,7 +357,13 @@ func (u *UsecaseProgramsImpl) SetStaffProgram(ctx context.Context, programID str
 		return nil, err
 	}
 
-	communityProfile, err := u.Matrix.Login(ctx, programStaffProfile.User.Username, *programStaffProfile.User.ID)
+	matrixAuth, err := u.Community.GetMatrixAuth(ctx, programStaffProfile.User)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, err
+	}
+
+	communityProfile, err := u.Matrix.Login(ctx, matrixAuth.Username, matrixAuth.Password)
 	if err != nil {
 		helpers.ReportErrorToSentry(err)
 		return nil, err
@@ -336,7 +389,8 @@ func (u *UsecaseProgramsImpl) SetStaffProgram(ctx context.Context, programID str
 	}, nil
 }
 
-// SetClientProgram sets the program that the client user has selected from their programs
+// SetClientProgram sets the program that the client user has selected from their programs.
+// It only acts on the logged in user's own client profile in the program so it does not require a permission
 func (u *UsecaseProgramsImpl) SetClientProgram(ctx context.Context, programID string) (*domain.ClientResponse, error) {
 	uid, err := u.ExternalExt.GetLoggedInUserUID(ctx)
 	if err != nil {
@@ -356,7 +410,13 @@ func (u *UsecaseProgramsImpl) SetClientProgram(ctx context.Context, programID st
 		return nil, err
 	}
 
-	communityProfile, err := u.Matrix.Login(ctx, programClientProfile.User.Username, *programClientProfile.User.ID)
+	matrixAuth, err := u.Community.GetMatrixAuth(ctx, programClientProfile.User)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, err
+	}
+
+	communityProfile, err := u.Matrix.Login(ctx, matrixAuth.Username, matrixAuth.Password)
 	if err != nil {
 		helpers.ReportErrorToSentry(err)
 		return nil, err
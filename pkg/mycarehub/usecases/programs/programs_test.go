@@ -14,6 +14,7 @@ import (
 	pgMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/mock"
 	matrixMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/matrix/mock"
 	pubsubMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/pubsub/mock"
+	communityMock "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/communities/mock"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/programs"
 )
 
@@ -188,7 +189,8 @@ func TestUsecaseProgramsImpl_CreateProgram(t *testing.T) {
 			fakeExtension := extensionMock.NewFakeExtension()
 			fakePubsub := pubsubMock.NewPubsubServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad case: failed to check organization exists" {
 				fakeDB.MockCheckOrganisationExistsFn = func(ctx context.Context, organisationID string) (bool, error) {
@@ -306,7 +308,8 @@ func TestUsecaseProgramsImpl_SetCurrentProgram(t *testing.T) {
 			fakeExtension := extensionMock.NewFakeExtension()
 			fakePubsub := pubsubMock.NewPubsubServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix, fakeCommunity)
 
 			if tt.name == "sad case: fail to get logged in user" {
 				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
@@ -350,6 +353,24 @@ func TestUsecaseProgramsImpl_ListUserPrograms(t *testing.T) {
 		args    args
 		wantErr bool
 	}{
+		{
+			name: "sad case: fail to get logged in user",
+			args: args{
+				ctx:     context.Background(),
+				userID:  gofakeit.UUID(),
+				flavour: feedlib.FlavourConsumer,
+			},
+			wantErr: true,
+		},
+		{
+			name: "sad case: list another user's programs",
+			args: args{
+				ctx:     context.Background(),
+				userID:  gofakeit.UUID(),
+				flavour: feedlib.FlavourConsumer,
+			},
+			wantErr: true,
+		},
 		{
 			name: "sad case: fail to get user profile",
 			args: args{
@@ -402,8 +423,23 @@ func TestUsecaseProgramsImpl_ListUserPrograms(t *testing.T) {
 			fakeExtension := extensionMock.NewFakeExtension()
 			fakePubsub := pubsubMock.NewPubsubServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix, fakeCommunity)
 
+			fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+				return tt.args.userID, nil
+			}
+
+			if tt.name == "sad case: fail to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("failed to get logged in user")
+				}
+			}
+			if tt.name == "sad case: list another user's programs" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return gofakeit.UUID(), nil
+				}
+			}
 			if tt.name == "sad case: fail to get user profile" {
 				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
 					return nil, fmt.Errorf("failed to get user profile")
@@ -466,7 +502,8 @@ func TestUsecaseProgramsImpl_GetProgramFacilities(t *testing.T) {
 			fakeExtension := extensionMock.NewFakeExtension()
 			fakePubsub := pubsubMock.NewPubsubServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix, fakeCommunity)
 
 			if tt.name == "sad case: unable to get program facilities" {
 				fakeDB.MockGetProgramFacilitiesFn = func(ctx context.Context, programID string) ([]*domain.Facility, error) {
@@ -544,6 +581,14 @@ func TestUsecaseProgramsImpl_SetStaffProgram(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "sad case: unable to get matrix credentials",
+			args: args{
+				ctx:       context.Background(),
+				programID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -551,7 +596,8 @@ func TestUsecaseProgramsImpl_SetStaffProgram(t *testing.T) {
 			fakeExtension := extensionMock.NewFakeExtension()
 			fakePubsub := pubsubMock.NewPubsubServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix, fakeCommunity)
 
 			if tt.name == "sad case: unable to get logged in user" {
 				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
@@ -595,6 +641,11 @@ func TestUsecaseProgramsImpl_SetStaffProgram(t *testing.T) {
 					return fmt.Errorf("failed to update user")
 				}
 			}
+			if tt.name == "sad case: unable to get matrix credentials" {
+				fakeCommunity.MockGetMatrixAuthFn = func(ctx context.Context, user *domain.User) (*domain.MatrixAuth, error) {
+					return nil, fmt.Errorf("failed to get matrix credentials")
+				}
+			}
 			if tt.name == "sad case: unable to login matrix user and get token" {
 				fakeMatrix.MockLoginFn = func(ctx context.Context, username, password string) (*domain.CommunityProfile, error) {
 					return nil, fmt.Errorf("failed to login matrix user")
@@ -668,6 +719,14 @@ func TestUsecaseProgramsImpl_SetClientProgram(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "sad case: unable to get matrix credentials",
+			args: args{
+				ctx:       context.Background(),
+				programID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -675,7 +734,8 @@ func TestUsecaseProgramsImpl_SetClientProgram(t *testing.T) {
 			fakeExtension := extensionMock.NewFakeExtension()
 			fakePubsub := pubsubMock.NewPubsubServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix, fakeCommunity)
 
 			if tt.name == "sad case: unable to get logged in user" {
 				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
@@ -718,6 +778,11 @@ func TestUsecaseProgramsImpl_SetClientProgram(t *testing.T) {
 					return fmt.Errorf("failed to update user")
 				}
 			}
+			if tt.name == "sad case: unable to get matrix credentials" {
+				fakeCommunity.MockGetMatrixAuthFn = func(ctx context.Context, user *domain.User) (*domain.MatrixAuth, error) {
+					return nil, fmt.Errorf("failed to get matrix credentials")
+				}
+			}
 			if tt.name == "sad case: unable to login matrix user and get token" {
 				fakeMatrix.MockLoginFn = func(ctx context.Context, username, password string) (*domain.CommunityProfile, error) {
 					return nil, fmt.Errorf("failed to login matrix user")
@@ -803,7 +868,8 @@ func TestUsecaseProgramsImpl_ListPrograms(t *testing.T) {
 			fakeExtension := extensionMock.NewFakeExtension()
 			fakePubsub := pubsubMock.NewPubsubServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad case: failed to list programs" {
 				fakeDB.MockListProgramsFn = func(ctx context.Context, organisationID *string, pagination *domain.Pagination) ([]*domain.Program, *domain.Pagination, error) {
@@ -891,7 +957,8 @@ func TestUsecaseProgramsImpl_SearchPrograms(t *testing.T) {
 			fakeExtension := extensionMock.NewFakeExtension()
 			fakePubsub := pubsubMock.NewPubsubServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad Case: unable to get logged in user id" {
 				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
@@ -952,7 +1019,8 @@ func TestUsecaseProgramsImpl_GetProgramByID(t *testing.T) {
 			fakeExtension := extensionMock.NewFakeExtension()
 			fakePubsub := pubsubMock.NewPubsubServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			u := programs.NewUsecasePrograms(fakeDB, fakeDB, fakeDB, fakeExtension, fakePubsub, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad Case: unable to get program by id" {
 				fakeDB.MockGetProgramByIDFn = func(ctx context.Context, programID string) (*domain.Program, error) {
@@ -13,13 +13,25 @@ import (
 
 // QuestionnaireUseCaseMock mocks the questionnaire instance
 type QuestionnaireUseCaseMock struct {
-	MockCreateScreeningToolFn                func(ctx context.Context, input dto.ScreeningToolInput) (bool, error)
-	MockRespondToScreeningToolFn             func(ctx context.Context, input dto.QuestionnaireScreeningToolResponseInput) (bool, error)
-	MockGetAvailableScreeningToolsFn         func(ctx context.Context) ([]*domain.ScreeningTool, error)
-	MockGetScreeningToolByIDFn               func(ctx context.Context, id string) (*domain.ScreeningTool, error)
-	MockGetFacilityRespondedScreeningToolsFn func(ctx context.Context, facilityID string, paginationInput *dto.PaginationsInput) (*domain.ScreeningToolPage, error)
-	MockGetScreeningToolRespondentsFn        func(ctx context.Context, facilityID string, screeningToolID string, searchTerm *string, paginationInput *dto.PaginationsInput) (*domain.ScreeningToolRespondentsPage, error)
-	MockGetScreeningToolResponseFn           func(ctx context.Context, id string) (*domain.QuestionnaireScreeningToolResponse, error)
+	MockCreateScreeningToolFn                        func(ctx context.Context, input dto.ScreeningToolInput) (bool, error)
+	MockRespondToScreeningToolFn                     func(ctx context.Context, input dto.QuestionnaireScreeningToolResponseInput) (bool, error)
+	MockGetAvailableScreeningToolsFn                 func(ctx context.Context) ([]*domain.ScreeningTool, error)
+	MockGetScreeningToolByIDFn                       func(ctx context.Context, id string) (*domain.ScreeningTool, error)
+	MockGetFacilityRespondedScreeningToolsFn         func(ctx context.Context, facilityID string, paginationInput *dto.PaginationsInput) (*domain.ScreeningToolPage, error)
+	MockGetScreeningToolRespondentsFn                func(ctx context.Context, facilityID string, screeningToolID string, searchTerm *string, paginationInput *dto.PaginationsInput) (*domain.ScreeningToolRespondentsPage, error)
+	MockGetScreeningToolResponseFn                   func(ctx context.Context, id string) (*domain.QuestionnaireScreeningToolResponse, error)
+	MockSaveScreeningToolDraftFn                     func(ctx context.Context, screeningToolID string, input dto.QuestionnaireInput) (*domain.ScreeningToolVersion, error)
+	MockPublishScreeningToolDraftFn                  func(ctx context.Context, screeningToolID string) (*domain.ScreeningToolVersion, error)
+	MockListScreeningToolVersionsFn                  func(ctx context.Context, screeningToolID string) ([]*domain.ScreeningToolVersion, error)
+	MockGetScreeningToolVersionFn                    func(ctx context.Context, screeningToolID string, version int) (*domain.ScreeningToolVersion, error)
+	MockCompareScreeningToolVersionsFn               func(ctx context.Context, screeningToolID string, fromVersion, toVersion int) (*domain.ScreeningToolVersionDiff, error)
+	MockUpdateScreeningToolRecurrenceFn              func(ctx context.Context, screeningToolID string, input dto.ScreeningToolRecurrenceInput) (bool, error)
+	MockAssignScreeningToolFn                        func(ctx context.Context, input dto.ScreeningToolAssignmentInput) (*domain.ScreeningToolAssignment, error)
+	MockCancelScreeningToolAssignmentFn              func(ctx context.Context, assignmentID string) (bool, error)
+	MockListClientScreeningToolAssignmentsFn         func(ctx context.Context, clientID string) ([]*domain.ScreeningToolAssignment, error)
+	MockSendScreeningToolAssignmentRemindersFn       func(ctx context.Context) error
+	MockUpdateScreeningToolScoreWorseningThresholdFn func(ctx context.Context, screeningToolID string, threshold *int) (bool, error)
+	MockGetClientScreeningToolScoreTrendsFn          func(ctx context.Context, clientID string, screeningToolID *string) ([]*domain.ScreeningToolScoreTrend, error)
 }
 
 // NewServiceRequestUseCaseMock initializes a new questionnaire instance mock
@@ -27,6 +39,16 @@ func NewServiceRequestUseCaseMock() *QuestionnaireUseCaseMock {
 	UUID := gofakeit.UUID()
 	bs := gofakeit.BS()
 	now := time.Now()
+	screeningToolAssignment := domain.ScreeningToolAssignment{
+		ID:              UUID,
+		Active:          true,
+		ScreeningToolID: UUID,
+		ClientID:        UUID,
+		StaffID:         UUID,
+		DueDate:         now.AddDate(0, 0, 7),
+		Status:          enums.ScreeningToolAssignmentStatusPending,
+		CreatedAt:       now,
+	}
 	questionInputChoice := domain.QuestionInputChoice{
 		ID:         UUID,
 		Active:     true,
@@ -111,6 +133,19 @@ func NewServiceRequestUseCaseMock() *QuestionnaireUseCaseMock {
 		ProgramID:         "",
 	}
 
+	screeningToolVersion := domain.ScreeningToolVersion{
+		ID:              UUID,
+		Active:          true,
+		ScreeningToolID: UUID,
+		QuestionnaireID: UUID,
+		Version:         1,
+		Status:          enums.ScreeningToolVersionStatusPublished,
+		PublishedAt:     &now,
+		CreatedAt:       now,
+		Questionnaire:   &screeningTool.Questionnaire,
+	}
+	questionSequence := 1
+
 	return &QuestionnaireUseCaseMock{
 		MockCreateScreeningToolFn: func(ctx context.Context, input dto.ScreeningToolInput) (bool, error) {
 			return true, nil
@@ -139,6 +174,83 @@ func NewServiceRequestUseCaseMock() *QuestionnaireUseCaseMock {
 		MockGetScreeningToolResponseFn: func(ctx context.Context, id string) (*domain.QuestionnaireScreeningToolResponse, error) {
 			return &questionnaireScreeningToolResponse, nil
 		},
+		MockSaveScreeningToolDraftFn: func(ctx context.Context, screeningToolID string, input dto.QuestionnaireInput) (*domain.ScreeningToolVersion, error) {
+			return &domain.ScreeningToolVersion{
+				ID:              UUID,
+				Active:          true,
+				ScreeningToolID: screeningToolID,
+				QuestionnaireID: UUID,
+				Version:         2,
+				Status:          enums.ScreeningToolVersionStatusDraft,
+				CreatedAt:       now,
+				Questionnaire:   &screeningTool.Questionnaire,
+			}, nil
+		},
+		MockPublishScreeningToolDraftFn: func(ctx context.Context, screeningToolID string) (*domain.ScreeningToolVersion, error) {
+			return &screeningToolVersion, nil
+		},
+		MockListScreeningToolVersionsFn: func(ctx context.Context, screeningToolID string) ([]*domain.ScreeningToolVersion, error) {
+			return []*domain.ScreeningToolVersion{&screeningToolVersion}, nil
+		},
+		MockGetScreeningToolVersionFn: func(ctx context.Context, screeningToolID string, version int) (*domain.ScreeningToolVersion, error) {
+			return &screeningToolVersion, nil
+		},
+		MockCompareScreeningToolVersionsFn: func(ctx context.Context, screeningToolID string, fromVersion, toVersion int) (*domain.ScreeningToolVersionDiff, error) {
+			return &domain.ScreeningToolVersionDiff{
+				ScreeningToolID: screeningToolID,
+				FromVersion:     fromVersion,
+				ToVersion:       toVersion,
+				Changes: []*domain.QuestionnaireChange{
+					{
+						QuestionSequence: &questionSequence,
+						ChangeType:       enums.QuestionnaireChangeTypeModified,
+						Field:            "text",
+						From:             bs,
+						To:               bs,
+					},
+				},
+			}, nil
+		},
+		MockUpdateScreeningToolRecurrenceFn: func(ctx context.Context, screeningToolID string, input dto.ScreeningToolRecurrenceInput) (bool, error) {
+			return true, nil
+		},
+		MockAssignScreeningToolFn: func(ctx context.Context, input dto.ScreeningToolAssignmentInput) (*domain.ScreeningToolAssignment, error) {
+			return &screeningToolAssignment, nil
+		},
+		MockCancelScreeningToolAssignmentFn: func(ctx context.Context, assignmentID string) (bool, error) {
+			return true, nil
+		},
+		MockListClientScreeningToolAssignmentsFn: func(ctx context.Context, clientID string) ([]*domain.ScreeningToolAssignment, error) {
+			return []*domain.ScreeningToolAssignment{&screeningToolAssignment}, nil
+		},
+		MockSendScreeningToolAssignmentRemindersFn: func(ctx context.Context) error {
+			return nil
+		},
+		MockUpdateScreeningToolScoreWorseningThresholdFn: func(ctx context.Context, screeningToolID string, threshold *int) (bool, error) {
+			return true, nil
+		},
+		MockGetClientScreeningToolScoreTrendsFn: func(ctx context.Context, clientID string, screeningToolID *string) ([]*domain.ScreeningToolScoreTrend, error) {
+			delta := 6
+			return []*domain.ScreeningToolScoreTrend{
+				{
+					ScreeningToolID:   UUID,
+					ScreeningToolName: bs,
+					Points: []*domain.ScreeningToolScorePoint{
+						{
+							ResponseID:     UUID,
+							DateOfResponse: now.AddDate(0, -1, 0),
+							AggregateScore: 3,
+						},
+						{
+							ResponseID:     UUID,
+							DateOfResponse: now,
+							AggregateScore: 9,
+							Delta:          &delta,
+						},
+					},
+				},
+			}, nil
+		},
 	}
 }
 
@@ -176,3 +288,63 @@ func (q *QuestionnaireUseCaseMock) GetScreeningToolRespondents(ctx context.Conte
 func (q *QuestionnaireUseCaseMock) GetScreeningToolResponse(ctx context.Context, id string) (*domain.QuestionnaireScreeningToolResponse, error) {
 	return q.MockGetScreeningToolResponseFn(ctx, id)
 }
+
+// SaveScreeningToolDraft mocks the implementation of saving the draft version of a screening tool
+func (q *QuestionnaireUseCaseMock) SaveScreeningToolDraft(ctx context.Context, screeningToolID string, input dto.QuestionnaireInput) (*domain.ScreeningToolVersion, error) {
+	return q.MockSaveScreeningToolDraftFn(ctx, screeningToolID, input)
+}
+
+// PublishScreeningToolDraft mocks the implementation of publishing the draft version of a screening tool
+func (q *QuestionnaireUseCaseMock) PublishScreeningToolDraft(ctx context.Context, screeningToolID string) (*domain.ScreeningToolVersion, error) {
+	return q.MockPublishScreeningToolDraftFn(ctx, screeningToolID)
+}
+
+// ListScreeningToolVersions mocks the implementation of listing the versions of a screening tool
+func (q *QuestionnaireUseCaseMock) ListScreeningToolVersions(ctx context.Context, screeningToolID string) ([]*domain.ScreeningToolVersion, error) {
+	return q.MockListScreeningToolVersionsFn(ctx, screeningToolID)
+}
+
+// GetScreeningToolVersion mocks the implementation of getting a version of a screening tool
+func (q *QuestionnaireUseCaseMock) GetScreeningToolVersion(ctx context.Context, screeningToolID string, version int) (*domain.ScreeningToolVersion, error) {
+	return q.MockGetScreeningToolVersionFn(ctx, screeningToolID, version)
+}
+
+// CompareScreeningToolVersions mocks the implementation of comparing two versions of a screening tool
+func (q *QuestionnaireUseCaseMock) CompareScreeningToolVersions(ctx context.Context, screeningToolID string, fromVersion, toVersion int) (*domain.ScreeningToolVersionDiff, error) {
+	return q.MockCompareScreeningToolVersionsFn(ctx, screeningToolID, fromVersion, toVersion)
+}
+
+// UpdateScreeningToolRecurrence mocks the implementation of changing how often a screening tool is made available
+func (q *QuestionnaireUseCaseMock) UpdateScreeningToolRecurrence(ctx context.Context, screeningToolID string, input dto.ScreeningToolRecurrenceInput) (bool, error) {
+	return q.MockUpdateScreeningToolRecurrenceFn(ctx, screeningToolID, input)
+}
+
+// AssignScreeningTool mocks the implementation of assigning a screening tool to a client
+func (q *QuestionnaireUseCaseMock) AssignScreeningTool(ctx context.Context, input dto.ScreeningToolAssignmentInput) (*domain.ScreeningToolAssignment, error) {
+	return q.MockAssignScreeningToolFn(ctx, input)
+}
+
+// CancelScreeningToolAssignment mocks the implementation of cancelling a screening tool assignment
+func (q *QuestionnaireUseCaseMock) CancelScreeningToolAssignment(ctx context.Context, assignmentID string) (bool, error) {
+	return q.MockCancelScreeningToolAssignmentFn(ctx, assignmentID)
+}
+
+// ListClientScreeningToolAssignments mocks the implementation of listing the screening tools assigned to a client
+func (q *QuestionnaireUseCaseMock) ListClientScreeningToolAssignments(ctx context.Context, clientID string) ([]*domain.ScreeningToolAssignment, error) {
+	return q.MockListClientScreeningToolAssignmentsFn(ctx, clientID)
+}
+
+// SendScreeningToolAssignmentReminders mocks the implementation of reminding clients of their screening tool assignments
+func (q *QuestionnaireUseCaseMock) SendScreeningToolAssignmentReminders(ctx context.Context) error {
+	return q.MockSendScreeningToolAssignmentRemindersFn(ctx)
+}
+
+// UpdateScreeningToolScoreWorseningThreshold mocks the implementation of updating a screening tool's score worsening threshold
+func (q *QuestionnaireUseCaseMock) UpdateScreeningToolScoreWorseningThreshold(ctx context.Context, screeningToolID string, threshold *int) (bool, error) {
+	return q.MockUpdateScreeningToolScoreWorseningThresholdFn(ctx, screeningToolID, threshold)
+}
+
+// GetClientScreeningToolScoreTrends mocks the implementation of getting a client's screening tool score trends
+func (q *QuestionnaireUseCaseMock) GetClientScreeningToolScoreTrends(ctx context.Context, clientID string, screeningToolID *string) ([]*domain.ScreeningToolScoreTrend, error) {
+	return q.MockGetClientScreeningToolScoreTrendsFn(ctx, clientID, screeningToolID)
+}
@@ -3,7 +3,10 @@ package questionnaires
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/savannahghi/enumutils"
 	"github.com/savannahghi/feedlib"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
@@ -13,12 +16,15 @@ import (
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/utils"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/notification"
 )
 
 // ICreateScreeningTools contains methods related to the screening tools
 type ICreateScreeningTools interface {
 	CreateScreeningTool(ctx context.Context, input dto.ScreeningToolInput) (bool, error)
 	RespondToScreeningTool(ctx context.Context, input dto.QuestionnaireScreeningToolResponseInput) (bool, error)
+	UpdateScreeningToolRecurrence(ctx context.Context, screeningToolID string, input dto.ScreeningToolRecurrenceInput) (bool, error)
+	UpdateScreeningToolScoreWorseningThreshold(ctx context.Context, screeningToolID string, threshold *int) (bool, error)
 }
 
 // IGetScreeningTools contains methods related to the screening tools
@@ -28,21 +34,50 @@ type IGetScreeningTools interface {
 	GetFacilityRespondedScreeningTools(ctx context.Context, facilityID string, paginationInput *dto.PaginationsInput) (*domain.ScreeningToolPage, error)
 	GetScreeningToolRespondents(ctx context.Context, facilityID string, screeningToolID string, searchTerm *string, paginationInput *dto.PaginationsInput) (*domain.ScreeningToolRespondentsPage, error)
 	GetScreeningToolResponse(ctx context.Context, id string) (*domain.QuestionnaireScreeningToolResponse, error)
+	GetClientScreeningToolScoreTrends(ctx context.Context, clientID string, screeningToolID *string) ([]*domain.ScreeningToolScoreTrend, error)
+}
+
+// IScreeningToolVersions contains methods related to the versions of a screening tool's questionnaire
+type IScreeningToolVersions interface {
+	SaveScreeningToolDraft(ctx context.Context, screeningToolID string, input dto.QuestionnaireInput) (*domain.ScreeningToolVersion, error)
+	PublishScreeningToolDraft(ctx context.Context, screeningToolID string) (*domain.ScreeningToolVersion, error)
+	ListScreeningToolVersions(ctx context.Context, screeningToolID string) ([]*domain.ScreeningToolVersion, error)
+	GetScreeningToolVersion(ctx context.Context, screeningToolID string, version int) (*domain.ScreeningToolVersion, error)
+	CompareScreeningToolVersions(ctx context.Context, screeningToolID string, fromVersion, toVersion int) (*domain.ScreeningToolVersionDiff, error)
+}
+
+// IScreeningToolAssignments contains methods related to screening tools that staff assign to specific clients
+type IScreeningToolAssignments interface {
+	AssignScreeningTool(ctx context.Context, input dto.ScreeningToolAssignmentInput) (*domain.ScreeningToolAssignment, error)
+	CancelScreeningToolAssignment(ctx context.Context, assignmentID string) (bool, error)
+	ListClientScreeningToolAssignments(ctx context.Context, clientID string) ([]*domain.ScreeningToolAssignment, error)
+	SendScreeningToolAssignmentReminders(ctx context.Context) error
 }
 
 // UseCaseQuestionnaire contains questionnaire interfaces
 type UseCaseQuestionnaire interface {
 	ICreateScreeningTools
 	IGetScreeningTools
+	IScreeningToolVersions
+	IScreeningToolAssignments
 }
 
+const (
+	// assignmentReminderWindow is how long before its due date a client starts being reminded of a screening tool assignment
+	assignmentReminderWindow = 24 * time.Hour
+
+	// assignmentReminderInterval is the minimum time between two reminders of the same screening tool assignment
+	assignmentReminderInterval = 24 * time.Hour
+)
+
 // UseCaseQuestionnaireImpl represents the questionnaire implementations
 type UseCaseQuestionnaireImpl struct {
-	Query       infrastructure.Query
-	Create      infrastructure.Create
-	Update      infrastructure.Update
-	Delete      infrastructure.Delete
-	ExternalExt extension.ExternalMethodsExtension
+	Query        infrastructure.Query
+	Create       infrastructure.Create
+	Update       infrastructure.Update
+	Delete       infrastructure.Delete
+	ExternalExt  extension.ExternalMethodsExtension
+	Notification notification.UseCaseNotification
 }
 
 // NewUseCaseQuestionnaire is the controller function for the questionnaire usecase
@@ -52,19 +87,21 @@ func NewUseCaseQuestionnaire(
 	update infrastructure.Update,
 	delete infrastructure.Delete,
 	externalExt extension.ExternalMethodsExtension,
+	notification notification.UseCaseNotification,
 ) UseCaseQuestionnaire {
 	return &UseCaseQuestionnaireImpl{
-		Query:       query,
-		Create:      create,
-		Update:      update,
-		Delete:      delete,
-		ExternalExt: externalExt,
+		Query:        query,
+		Create:       create,
+		Update:       update,
+		Delete:       delete,
+		ExternalExt:  externalExt,
+		Notification: notification,
 	}
 }
 
 // CreateScreeningTool creates the screening tool questionnaire
 func (q *UseCaseQuestionnaireImpl) CreateScreeningTool(ctx context.Context, input dto.ScreeningToolInput) (bool, error) {
-	err := input.Questionnaire.Validate()
+	err := input.Validate()
 	if err != nil {
 		return false, err
 	}
@@ -81,11 +118,143 @@ func (q *UseCaseQuestionnaireImpl) CreateScreeningTool(ctx context.Context, inpu
 		return false, err
 	}
 
+	questionnaire, err := mapQuestionnaireInput(input.Questionnaire, userProfile.CurrentProgramID, userProfile.CurrentOrganizationID)
+	if err != nil {
+		return false, err
+	}
+
+	scoreBands := []domain.ScreeningToolScoreBand{}
+	for _, b := range input.ScoreBands {
+		scoreBands = append(scoreBands, domain.ScreeningToolScoreBand{
+			Active:         true,
+			Label:          b.Label,
+			MinScore:       b.MinScore,
+			MaxScore:       b.MaxScore,
+			Action:         b.Action,
+			Priority:       b.Priority,
+			ProgramID:      userProfile.CurrentProgramID,
+			OrganisationID: userProfile.CurrentOrganizationID,
+		})
+	}
+
+	// screening tools become available a day after the last response unless configured otherwise
+	defaultIntervalDays := 1
+	recurrence := dto.ScreeningToolRecurrenceInput{Recurrence: enums.ScreeningToolRecurrenceInterval, IntervalDays: &defaultIntervalDays}
+	if input.Recurrence != nil {
+		recurrence = *input.Recurrence
+	}
+
+	payload := &domain.ScreeningTool{
+		Active:         true,
+		Threshold:      input.Threshold,
+		ClientTypes:    input.ClientTypes,
+		Genders:        input.Genders,
+		ProgramID:      userProfile.CurrentProgramID,
+		OrganisationID: userProfile.CurrentOrganizationID,
+		AgeRange: domain.AgeRange{
+			LowerBound: input.AgeRange.LowerBound,
+			UpperBound: input.AgeRange.UpperBound,
+		},
+		Questionnaire: *questionnaire,
+		ScoreBands:    scoreBands,
+
+		Recurrence:             recurrence.Recurrence,
+		RecurrenceIntervalDays: recurrenceIntervalDays(recurrence),
+
+		ScoreWorseningThreshold: input.ScoreWorseningThreshold,
+	}
+
+	err = q.Create.CreateScreeningTool(ctx, payload)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to create screening tool questionnaire: %w", err)
+	}
+	return true, nil
+}
+
+// recurrenceIntervalDays returns the number of days between responses for a recurrence.
+// Screening tools that do not recur after an interval are stored with an interval of zero days
+func recurrenceIntervalDays(recurrence dto.ScreeningToolRecurrenceInput) int {
+	if recurrence.Recurrence != enums.ScreeningToolRecurrenceInterval || recurrence.IntervalDays == nil {
+		return 0
+	}
+	return *recurrence.IntervalDays
+}
+
+// UpdateScreeningToolRecurrence changes how often a screening tool is made available to clients
+func (q *UseCaseQuestionnaireImpl) UpdateScreeningToolRecurrence(ctx context.Context, screeningToolID string, input dto.ScreeningToolRecurrenceInput) (bool, error) {
+	if err := input.Validate(); err != nil {
+		return false, err
+	}
+
+	staff, err := q.getLoggedInStaffProfile(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	screeningTool, err := q.Query.GetScreeningToolByID(ctx, screeningToolID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to get screening tool: %w", err)
+	}
+
+	if screeningTool.ProgramID != staff.ProgramID {
+		return false, fmt.Errorf("the screening tool should belong to the staff's current program")
+	}
+
+	err = q.Update.UpdateScreeningTool(ctx, screeningTool, map[string]interface{}{
+		"recurrence":               input.Recurrence.String(),
+		"recurrence_interval_days": recurrenceIntervalDays(input),
+	})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to update screening tool recurrence: %w", err)
+	}
+
+	return true, nil
+}
+
+// UpdateScreeningToolScoreWorseningThreshold changes the increase in score between two consecutive responses that flags a client as worsening.
+// A nil threshold stops tracking worsening scores for the screening tool
+func (q *UseCaseQuestionnaireImpl) UpdateScreeningToolScoreWorseningThreshold(ctx context.Context, screeningToolID string, threshold *int) (bool, error) {
+	if threshold != nil && *threshold < 1 {
+		return false, fmt.Errorf("the score worsening threshold should be at least one")
+	}
+
+	staff, err := q.getLoggedInStaffProfile(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	screeningTool, err := q.Query.GetScreeningToolByID(ctx, screeningToolID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to get screening tool: %w", err)
+	}
+
+	if screeningTool.ProgramID != staff.ProgramID {
+		return false, fmt.Errorf("the screening tool should belong to the staff's current program")
+	}
+
+	err = q.Update.UpdateScreeningTool(ctx, screeningTool, map[string]interface{}{
+		"score_worsening_threshold": threshold,
+	})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to update screening tool score worsening threshold: %w", err)
+	}
+
+	return true, nil
+}
+
+// mapQuestionnaireInput maps a questionnaire input to its domain representation.
+// The question sequences and the choices of each question should be unique
+func mapQuestionnaireInput(input dto.QuestionnaireInput, programID, organisationID string) (*domain.Questionnaire, error) {
 	questions := []domain.Question{}
 	sequenceMap := make(map[int]int)
-	for _, q := range input.Questionnaire.Questions {
+	for _, q := range input.Questions {
 		if _, ok := sequenceMap[q.Sequence]; ok {
-			return false, fmt.Errorf("duplicate sequence found: %w", err)
+			return nil, fmt.Errorf("duplicate sequence found: %d", q.Sequence)
 		}
 		sequenceMap[q.Sequence] = q.Sequence
 
@@ -93,7 +262,7 @@ func (q *UseCaseQuestionnaireImpl) CreateScreeningTool(ctx context.Context, inpu
 		choiceMap := make(map[string]string)
 		for _, c := range q.Choices {
 			if _, ok := choiceMap[*c.Choice]; ok {
-				return false, fmt.Errorf("duplicate choice found: %w", err)
+				return nil, fmt.Errorf("duplicate choice found: %s", *c.Choice)
 			}
 			choiceMap[*c.Choice] = *c.Choice
 
@@ -102,55 +271,53 @@ func (q *UseCaseQuestionnaireImpl) CreateScreeningTool(ctx context.Context, inpu
 				Choice:         *c.Choice,
 				Value:          c.Value,
 				Score:          c.Score,
-				ProgramID:      userProfile.CurrentProgramID,
-				OrganisationID: userProfile.CurrentOrganizationID,
+				ProgramID:      programID,
+				OrganisationID: organisationID,
+			})
+		}
+
+		conditions := []domain.QuestionCondition{}
+		for _, c := range q.Conditions {
+			conditions = append(conditions, domain.QuestionCondition{
+				Active:            true,
+				DependsOnSequence: c.QuestionSequence,
+				Operator:          c.Operator,
+				Target:            c.Target,
+				Values:            c.Values,
+				ProgramID:         programID,
+				OrganisationID:    organisationID,
 			})
 		}
 
 		questions = append(questions, domain.Question{
-			Active:            true,
-			Text:              q.Text,
-			QuestionType:      q.QuestionType,
-			ResponseValueType: q.ResponseValueType,
-			Required:          q.Required,
-			SelectMultiple:    q.SelectMultiple,
-			Sequence:          q.Sequence,
-			Choices:           choices,
-			ProgramID:         userProfile.CurrentProgramID,
-			OrganisationID:    userProfile.CurrentOrganizationID,
+			Active:                true,
+			Text:                  q.Text,
+			QuestionType:          q.QuestionType,
+			ResponseValueType:     q.ResponseValueType,
+			Required:              q.Required,
+			SelectMultiple:        q.SelectMultiple,
+			Sequence:              q.Sequence,
+			Choices:               choices,
+			Conditions:            conditions,
+			CriticalItemThreshold: q.CriticalItemThreshold,
+			ProgramID:             programID,
+			OrganisationID:        organisationID,
 		})
 	}
 
-	payload := &domain.ScreeningTool{
+	return &domain.Questionnaire{
 		Active:         true,
-		Threshold:      input.Threshold,
-		ClientTypes:    input.ClientTypes,
-		Genders:        input.Genders,
-		ProgramID:      userProfile.CurrentProgramID,
-		OrganisationID: userProfile.CurrentOrganizationID,
-		AgeRange: domain.AgeRange{
-			LowerBound: input.AgeRange.LowerBound,
-			UpperBound: input.AgeRange.UpperBound,
-		},
-		Questionnaire: domain.Questionnaire{
-			Active:         true,
-			Name:           input.Questionnaire.Name,
-			Description:    input.Questionnaire.Description,
-			Questions:      questions,
-			ProgramID:      userProfile.CurrentProgramID,
-			OrganisationID: userProfile.CurrentOrganizationID,
-		},
-	}
-
-	err = q.Create.CreateScreeningTool(ctx, payload)
-	if err != nil {
-		helpers.ReportErrorToSentry(err)
-		return false, fmt.Errorf("failed to create screening tool questionnaire: %w", err)
-	}
-	return true, nil
+		Name:           input.Name,
+		Description:    input.Description,
+		Questions:      questions,
+		ProgramID:      programID,
+		OrganisationID: organisationID,
+	}, nil
 }
 
 // RespondToScreeningTool responds to the screening tool questionnaire
+// The aggregate score is graded against the screening tool's score bands and the action of the matching band is taken.
+// A response to a critical item question always raises a red flag regardless of the aggregate score
 func (q *UseCaseQuestionnaireImpl) RespondToScreeningTool(ctx context.Context, input dto.QuestionnaireScreeningToolResponseInput) (bool, error) {
 	err := input.Validate()
 	if err != nil {
@@ -177,10 +344,14 @@ func (q *UseCaseQuestionnaireImpl) RespondToScreeningTool(ctx context.Context, i
 		ClientID:        input.ClientID,
 		ProgramID:       clientProfile.User.CurrentProgramID,
 		OrganisationID:  clientProfile.User.CurrentOrganizationID,
+
+		ScreeningToolVersionID: screeningTool.VersionID,
 	}
 
 	var aggregateScore int
+	criticalItems := []string{}
 
+	answers := map[string]string{}
 	responses := []*domain.QuestionnaireScreeningToolQuestionResponse{}
 	for _, qr := range input.QuestionResponses {
 		question, err := screeningTool.Questionnaire.GetQuestionByID(qr.QuestionID)
@@ -198,6 +369,12 @@ func (q *UseCaseQuestionnaireImpl) RespondToScreeningTool(ctx context.Context, i
 		score := question.GetScore(qr.Response)
 		aggregateScore += score
 
+		if question.IsCriticalResponse(score) {
+			criticalItems = append(criticalItems, question.Text)
+		}
+
+		answers[qr.QuestionID] = qr.Response
+
 		responses = append(responses, &domain.QuestionnaireScreeningToolQuestionResponse{
 			Active:                  true,
 			ScreeningToolResponseID: screeningTool.ID,
@@ -210,6 +387,33 @@ func (q *UseCaseQuestionnaireImpl) RespondToScreeningTool(ctx context.Context, i
 		})
 	}
 
+	err = screeningTool.Questionnaire.ValidateResponses(answers)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to validate responses: %w", err)
+	}
+
+	scoreBand := screeningTool.GetScoreBand(aggregateScore)
+	if scoreBand != nil {
+		payload.Severity = &scoreBand.Label
+	}
+
+	// the previous response is fetched before the new one is saved so that the response is not compared to itself
+	latestResponses, err := q.Query.GetLatestScreeningToolResponses(ctx, input.ClientID, clientProfile.User.CurrentProgramID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to get latest screening tool responses: %w", err)
+	}
+
+	var previousScore *int
+	for _, response := range latestResponses {
+		if response.ScreeningToolID == screeningTool.ID {
+			score := response.AggregateScore
+			previousScore = &score
+		}
+	}
+	worsened := previousScore != nil && screeningTool.HasWorsened(*previousScore, aggregateScore)
+
 	payload.AggregateScore = aggregateScore
 	payload.QuestionResponses = responses
 
@@ -219,22 +423,65 @@ func (q *UseCaseQuestionnaireImpl) RespondToScreeningTool(ctx context.Context, i
 		return false, fmt.Errorf("failed to create screening tool response: %w", err)
 	}
 
-	if aggregateScore >= screeningTool.Threshold {
+	err = q.Update.CompleteScreeningToolAssignments(ctx, input.ClientID, input.ScreeningToolID, *responseID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to complete screening tool assignments: %w", err)
+	}
+
+	action := enums.ScreeningToolBandActionNone
+	var priority *enums.ServiceRequestPriority
+	switch {
+	case len(criticalItems) > 0:
+		urgent := enums.ServiceRequestPriorityUrgent
+		action, priority = enums.ScreeningToolBandActionCreateRedFlag, &urgent
+
+	case scoreBand != nil:
+		action, priority = scoreBand.Action, scoreBand.Priority
+
+	// screening tools without score bands are graded against their threshold
+	case len(screeningTool.ScoreBands) == 0 && aggregateScore >= screeningTool.Threshold:
+		action = enums.ScreeningToolBandActionCreateRedFlag
+	}
+
+	// a worsening score is followed up even when the band it falls within does not require it
+	if worsened {
+		action = enums.ScreeningToolBandActionCreateRedFlag
+	}
+
+	switch action {
+	case enums.ScreeningToolBandActionCreateRedFlag:
 		serviceRequest := fmt.Sprintf("%s has a score of %d for %s. They require your attention", clientProfile.User.Name, aggregateScore, screeningTool.Questionnaire.Name)
+		meta := map[string]interface{}{
+			"response_id":         *responseID,
+			"screening_tool_name": screeningTool.Questionnaire.Name,
+			"score":               aggregateScore,
+		}
+		if payload.Severity != nil {
+			meta["severity"] = *payload.Severity
+		}
+		if priority != nil {
+			meta["priority"] = priority.String()
+		}
+		if len(criticalItems) > 0 {
+			meta["critical_items"] = criticalItems
+		}
+		if worsened {
+			serviceRequest = fmt.Sprintf("%s's score for %s worsened from %d to %d. They require your attention", clientProfile.User.Name, screeningTool.Questionnaire.Name, *previousScore, aggregateScore)
+			meta["previous_score"] = *previousScore
+			meta["score_change"] = aggregateScore - *previousScore
+		}
+
 		err = q.Create.CreateServiceRequest(ctx, &dto.ServiceRequestInput{
-			Active:      true,
-			RequestType: enums.ServiceRequestTypeScreeningToolsRedFlag.String(),
-			Status:      enums.ServiceRequestStatusPending.String(),
-			Request:     serviceRequest,
-			ClientID:    input.ClientID,
-			FacilityID:  *clientProfile.DefaultFacility.ID,
-			ClientName:  &clientProfile.User.Name,
-			Flavour:     feedlib.FlavourConsumer,
-			Meta: map[string]interface{}{
-				"response_id":         *responseID,
-				"screening_tool_name": screeningTool.Questionnaire.Name,
-				"score":               aggregateScore,
-			},
+			Active:         true,
+			RequestType:    enums.ServiceRequestTypeScreeningToolsRedFlag.String(),
+			Status:         enums.ServiceRequestStatusPending.String(),
+			Request:        serviceRequest,
+			ClientID:       input.ClientID,
+			FacilityID:     *clientProfile.DefaultFacility.ID,
+			ClientName:     &clientProfile.User.Name,
+			Flavour:        feedlib.FlavourConsumer,
+			Meta:           meta,
 			ProgramID:      clientProfile.User.CurrentProgramID,
 			OrganisationID: clientProfile.User.CurrentOrganizationID,
 		})
@@ -242,7 +489,22 @@ func (q *UseCaseQuestionnaireImpl) RespondToScreeningTool(ctx context.Context, i
 			helpers.ReportErrorToSentry(err)
 			return false, fmt.Errorf("failed to create service request: %w", err)
 		}
+
+	case enums.ScreeningToolBandActionNotifyStaff:
+		notificationInput := notification.StaffNotificationArgs{
+			Subject:               clientProfile.User,
+			ScreeningToolName:     &screeningTool.Questionnaire.Name,
+			ScreeningToolScore:    &aggregateScore,
+			ScreeningToolSeverity: payload.Severity,
+		}
+		staffNotification := notification.ComposeStaffNotification(enums.NotificationTypeScreeningToolResponse, notificationInput)
+
+		err = q.Notification.NotifyFacilityStaffs(ctx, clientProfile.DefaultFacility, staffNotification)
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+		}
 	}
+
 	return true, nil
 }
 
@@ -266,18 +528,21 @@ func (q *UseCaseQuestionnaireImpl) GetAvailableScreeningTools(ctx context.Contex
 		return nil, fmt.Errorf("failed to get user profile: %w", err)
 	}
 
-	var screeningToolIDs []string
-
-	screeningToolResponsesWithin24Hours, err := q.Query.GetScreeningToolResponsesWithin24Hours(ctx, *client.ID, client.ProgramID)
+	latestResponses, err := q.Query.GetLatestScreeningToolResponses(ctx, *client.ID, client.ProgramID)
 	if err != nil {
-		helpers.ReportErrorToSentry(fmt.Errorf("failed to get screening tool responses within 24 hours: %w", err))
-		return nil, fmt.Errorf("failed to get screening tool responses within 24 hours: %w", err)
+		helpers.ReportErrorToSentry(fmt.Errorf("failed to get latest screening tool responses: %w", err))
+		return nil, fmt.Errorf("failed to get latest screening tool responses: %w", err)
 	}
 
-	for _, screeningToolResponse := range screeningToolResponsesWithin24Hours {
-		screeningToolIDs = append(screeningToolIDs, screeningToolResponse.ScreeningToolID)
+	lastResponses := map[string]*time.Time{}
+	for _, screeningToolResponse := range latestResponses {
+		lastResponses[screeningToolResponse.ScreeningToolID] = &screeningToolResponse.DateOfResponse
 	}
 
+	// screening tools whose responses are awaiting a follow up by a staff are not available until the follow up is resolved
+	var screeningToolIDs []string
+	pendingFollowUp := map[string]bool{}
+
 	screeningToolResponsesWithPendingServiceRequests, err := q.Query.GetScreeningToolResponsesWithPendingServiceRequests(ctx, *client.ID, client.ProgramID)
 	if err != nil {
 		helpers.ReportErrorToSentry(fmt.Errorf("failed to get screening tool responses with pending service requests: %w", err))
@@ -286,6 +551,25 @@ func (q *UseCaseQuestionnaireImpl) GetAvailableScreeningTools(ctx context.Contex
 
 	for _, screeningToolResponse := range screeningToolResponsesWithPendingServiceRequests {
 		screeningToolIDs = append(screeningToolIDs, screeningToolResponse.ScreeningToolID)
+		pendingFollowUp[screeningToolResponse.ScreeningToolID] = true
+	}
+
+	assignments, err := q.Query.ListScreeningToolAssignments(ctx, &domain.ScreeningToolAssignment{
+		ClientID:  *client.ID,
+		ProgramID: client.ProgramID,
+		Status:    enums.ScreeningToolAssignmentStatusPending,
+	})
+	if err != nil {
+		helpers.ReportErrorToSentry(fmt.Errorf("failed to get screening tool assignments: %w", err))
+		return nil, fmt.Errorf("failed to get screening tool assignments: %w", err)
+	}
+
+	// the assignments are ordered by their due date so the earliest assignment of a screening tool is kept
+	assigned := map[string]*domain.ScreeningToolAssignment{}
+	for _, assignment := range assignments {
+		if _, ok := assigned[assignment.ScreeningToolID]; !ok {
+			assigned[assignment.ScreeningToolID] = assignment
+		}
 	}
 
 	clientTypes := []enums.ClientType{}
@@ -315,7 +599,37 @@ func (q *UseCaseQuestionnaireImpl) GetAvailableScreeningTools(ctx context.Contex
 		return nil, fmt.Errorf("failed to get available screening tools: %w", err)
 	}
 
-	return screeningTools, nil
+	now := time.Now()
+	available := []*domain.ScreeningTool{}
+	for _, tool := range screeningTools {
+		if assignment, ok := assigned[tool.ID]; ok {
+			tool.Assignment = assignment
+			available = append(available, tool)
+			delete(assigned, tool.ID)
+			continue
+		}
+
+		if tool.IsDue(lastResponses[tool.ID], now) {
+			available = append(available, tool)
+		}
+	}
+
+	// a staff can assign a screening tool to a client who is outside the screening tool's target group
+	for _, assignment := range assignments {
+		if assigned[assignment.ScreeningToolID] != assignment || pendingFollowUp[assignment.ScreeningToolID] {
+			continue
+		}
+
+		tool, err := q.Query.GetScreeningToolByID(ctx, assignment.ScreeningToolID)
+		if err != nil {
+			helpers.ReportErrorToSentry(fmt.Errorf("failed to get assigned screening tool: %w", err))
+			return nil, fmt.Errorf("failed to get assigned screening tool: %w", err)
+		}
+		tool.Assignment = assignment
+		available = append(available, tool)
+	}
+
+	return available, nil
 }
 
 // GetScreeningToolByID looks for a screening tool using the provided ID and returns the screening tool
@@ -409,3 +723,413 @@ func (q *UseCaseQuestionnaireImpl) GetScreeningToolResponse(ctx context.Context,
 	}
 	return response, nil
 }
+
+// GetClientScreeningToolScoreTrends returns how a client's aggregate score for each screening tool they responded to changed over time.
+// The trends can optionally be limited to a single screening tool
+func (q *UseCaseQuestionnaireImpl) GetClientScreeningToolScoreTrends(ctx context.Context, clientID string, screeningToolID *string) ([]*domain.ScreeningToolScoreTrend, error) {
+	staff, err := q.getLoggedInStaffProfile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clientProfile, err := q.Query.GetClientProfileByClientID(ctx, clientID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get client profile: %w", err)
+	}
+
+	if clientProfile.ProgramID != staff.ProgramID {
+		return nil, fmt.Errorf("the client should belong to the staff's current program")
+	}
+
+	responses, err := q.Query.ListClientScreeningToolResponses(ctx, clientID, clientProfile.ProgramID, screeningToolID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to list client screening tool responses: %w", err)
+	}
+
+	// the trends are ordered by when the client first responded to each screening tool
+	screeningToolIDs := []string{}
+	responsesByScreeningTool := map[string][]*domain.QuestionnaireScreeningToolResponse{}
+	for _, response := range responses {
+		if _, ok := responsesByScreeningTool[response.ScreeningToolID]; !ok {
+			screeningToolIDs = append(screeningToolIDs, response.ScreeningToolID)
+		}
+		responsesByScreeningTool[response.ScreeningToolID] = append(responsesByScreeningTool[response.ScreeningToolID], response)
+	}
+
+	trends := []*domain.ScreeningToolScoreTrend{}
+	for _, id := range screeningToolIDs {
+		screeningTool, err := q.Query.GetScreeningToolByID(ctx, id)
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			return nil, fmt.Errorf("failed to get screening tool: %w", err)
+		}
+
+		trends = append(trends, screeningTool.ScoreTrend(responsesByScreeningTool[id]))
+	}
+
+	return trends, nil
+}
+
+// SaveScreeningToolDraft saves the questionnaire as the draft version of a screening tool.
+// Published versions are immutable so edits are made on a draft that replaces the published version once published
+func (q *UseCaseQuestionnaireImpl) SaveScreeningToolDraft(ctx context.Context, screeningToolID string, input dto.QuestionnaireInput) (*domain.ScreeningToolVersion, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := q.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, err
+	}
+
+	userProfile, err := q.Query.GetUserProfileByUserID(ctx, userID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, err
+	}
+
+	err = q.checkScreeningToolProgram(ctx, screeningToolID, userProfile.CurrentProgramID)
+	if err != nil {
+		return nil, err
+	}
+
+	questionnaire, err := mapQuestionnaireInput(input, userProfile.CurrentProgramID, userProfile.CurrentOrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := q.Create.SaveScreeningToolDraft(ctx, screeningToolID, questionnaire)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to save screening tool draft: %w", err)
+	}
+
+	return draft, nil
+}
+
+// PublishScreeningToolDraft publishes the draft version of a screening tool. New responses are pinned to the
+// published version while responses to the previous versions continue to be rendered against their own version
+func (q *UseCaseQuestionnaireImpl) PublishScreeningToolDraft(ctx context.Context, screeningToolID string) (*domain.ScreeningToolVersion, error) {
+	userID, err := q.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, err
+	}
+
+	userProfile, err := q.Query.GetUserProfileByUserID(ctx, userID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, err
+	}
+
+	err = q.checkScreeningToolProgram(ctx, screeningToolID, userProfile.CurrentProgramID)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := q.Update.PublishScreeningToolDraft(ctx, screeningToolID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to publish screening tool draft: %w", err)
+	}
+
+	return version, nil
+}
+
+// checkScreeningToolProgram ensures that a screening tool belongs to the given program so that users can only
+// edit the screening tools of the program they are currently in
+func (q *UseCaseQuestionnaireImpl) checkScreeningToolProgram(ctx context.Context, screeningToolID string, programID string) error {
+	screeningTool, err := q.Query.GetScreeningToolByID(ctx, screeningToolID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("failed to get screening tool: %w", err)
+	}
+
+	if screeningTool.ProgramID != programID {
+		return fmt.Errorf("the screening tool should belong to the user's current program")
+	}
+
+	return nil
+}
+
+// ListScreeningToolVersions lists the versions of a screening tool from the oldest to the newest
+func (q *UseCaseQuestionnaireImpl) ListScreeningToolVersions(ctx context.Context, screeningToolID string) ([]*domain.ScreeningToolVersion, error) {
+	versions, err := q.Query.ListScreeningToolVersions(ctx, screeningToolID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to list screening tool versions: %w", err)
+	}
+
+	return versions, nil
+}
+
+// GetScreeningToolVersion gets a version of a screening tool together with its questionnaire
+func (q *UseCaseQuestionnaireImpl) GetScreeningToolVersion(ctx context.Context, screeningToolID string, version int) (*domain.ScreeningToolVersion, error) {
+	screeningToolVersion, err := q.Query.GetScreeningToolVersion(ctx, screeningToolID, version)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get screening tool version: %w", err)
+	}
+
+	return screeningToolVersion, nil
+}
+
+// CompareScreeningToolVersions lists the changes made to a screening tool's questionnaire between two versions
+func (q *UseCaseQuestionnaireImpl) CompareScreeningToolVersions(ctx context.Context, screeningToolID string, fromVersion, toVersion int) (*domain.ScreeningToolVersionDiff, error) {
+	from, err := q.Query.GetScreeningToolVersion(ctx, screeningToolID, fromVersion)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get screening tool version %d: %w", fromVersion, err)
+	}
+
+	to, err := q.Query.GetScreeningToolVersion(ctx, screeningToolID, toVersion)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get screening tool version %d: %w", toVersion, err)
+	}
+
+	return &domain.ScreeningToolVersionDiff{
+		ScreeningToolID: screeningToolID,
+		FromVersion:     fromVersion,
+		ToVersion:       toVersion,
+		Changes:         domain.DiffQuestionnaires(*from.Questionnaire, *to.Questionnaire),
+	}, nil
+}
+
+// AssignScreeningTool asks a client to respond to a screening tool by a due date. The screening tool is made available
+// to the client until they respond to it, regardless of its recurrence, and the client is notified of the assignment
+func (q *UseCaseQuestionnaireImpl) AssignScreeningTool(ctx context.Context, input dto.ScreeningToolAssignmentInput) (*domain.ScreeningToolAssignment, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	staff, err := q.getLoggedInStaffProfile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := q.Query.GetClientProfileByClientID(ctx, input.ClientID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get client profile: %w", err)
+	}
+
+	screeningTool, err := q.Query.GetScreeningToolByID(ctx, input.ScreeningToolID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get screening tool: %w", err)
+	}
+
+	if client.ProgramID != staff.ProgramID || screeningTool.ProgramID != staff.ProgramID {
+		return nil, fmt.Errorf("the client and screening tool should belong to the staff's current program")
+	}
+
+	pending, err := q.Query.ListScreeningToolAssignments(ctx, &domain.ScreeningToolAssignment{
+		ClientID:        input.ClientID,
+		ScreeningToolID: input.ScreeningToolID,
+		Status:          enums.ScreeningToolAssignmentStatusPending,
+	})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get screening tool assignments: %w", err)
+	}
+	if len(pending) > 0 {
+		return nil, fmt.Errorf("the client has a pending assignment of the screening tool due on %s", pending[0].DueDate.Format(time.RFC3339))
+	}
+
+	assignment, err := q.Create.CreateScreeningToolAssignment(ctx, &domain.ScreeningToolAssignment{
+		Active:          true,
+		ScreeningToolID: input.ScreeningToolID,
+		ClientID:        input.ClientID,
+		StaffID:         *staff.ID,
+		DueDate:         input.DueDate,
+		Status:          enums.ScreeningToolAssignmentStatusPending,
+		ProgramID:       staff.ProgramID,
+		OrganisationID:  staff.OrganisationID,
+	})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to assign screening tool: %w", err)
+	}
+
+	message := notification.ComposeClientNotification(
+		enums.NotificationTypeScreeningToolAssignment,
+		notification.ClientNotificationInput{
+			ScreeningToolName:       &screeningTool.Questionnaire.Name,
+			ScreeningToolAssignment: assignment,
+		},
+	)
+	err = q.Notification.NotifyUser(ctx, client.User, message)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+	}
+
+	return assignment, nil
+}
+
+// CancelScreeningToolAssignment withdraws a screening tool assignment that the client has not responded to
+func (q *UseCaseQuestionnaireImpl) CancelScreeningToolAssignment(ctx context.Context, assignmentID string) (bool, error) {
+	if assignmentID == "" {
+		return false, fmt.Errorf("a screening tool assignment ID is required")
+	}
+
+	staff, err := q.getLoggedInStaffProfile(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	assignments, err := q.Query.ListScreeningToolAssignments(ctx, &domain.ScreeningToolAssignment{ID: assignmentID})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to get screening tool assignment: %w", err)
+	}
+	if len(assignments) == 0 {
+		return false, fmt.Errorf("screening tool assignment %s not found", assignmentID)
+	}
+
+	assignment := assignments[0]
+	if assignment.ProgramID != staff.ProgramID {
+		return false, fmt.Errorf("the screening tool assignment should belong to the staff's current program")
+	}
+
+	if assignment.Status != enums.ScreeningToolAssignmentStatusPending {
+		return false, fmt.Errorf("a %s screening tool assignment cannot be cancelled", strings.ToLower(assignment.Status.String()))
+	}
+
+	err = q.Update.UpdateScreeningToolAssignment(ctx, assignment, map[string]interface{}{
+		"status": enums.ScreeningToolAssignmentStatusCancelled.String(),
+	})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to cancel screening tool assignment: %w", err)
+	}
+
+	return true, nil
+}
+
+// ListClientScreeningToolAssignments returns the screening tools that have been assigned to a client ordered by their due date
+func (q *UseCaseQuestionnaireImpl) ListClientScreeningToolAssignments(ctx context.Context, clientID string) ([]*domain.ScreeningToolAssignment, error) {
+	staff, err := q.getLoggedInStaffProfile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := q.Query.GetClientProfileByClientID(ctx, clientID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get client profile: %w", err)
+	}
+
+	if client.ProgramID != staff.ProgramID {
+		return nil, fmt.Errorf("the client should belong to the staff's current program")
+	}
+
+	assignments, err := q.Query.ListScreeningToolAssignments(ctx, &domain.ScreeningToolAssignment{ClientID: clientID, ProgramID: staff.ProgramID})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to list screening tool assignments: %w", err)
+	}
+	return assignments, nil
+}
+
+// SendScreeningToolAssignmentReminders reminds clients of the screening tools assigned to them that are almost due
+// or overdue. A client is reminded about an assignment at most once within the reminder interval
+func (q *UseCaseQuestionnaireImpl) SendScreeningToolAssignmentReminders(ctx context.Context) error {
+	now := time.Now()
+
+	assignments, err := q.Query.ListPendingScreeningToolAssignmentsDueBefore(ctx, now.Add(assignmentReminderWindow))
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("failed to list screening tool assignments due for a reminder: %w", err)
+	}
+
+	var errs error
+	for _, assignment := range assignments {
+		if !assignment.NeedsReminder(now, assignmentReminderWindow, assignmentReminderInterval) {
+			continue
+		}
+
+		err := q.sendScreeningToolAssignmentReminder(ctx, assignment)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("failed to send reminder for screening tool assignment %s: %w", assignment.ID, err))
+		}
+	}
+
+	if errs != nil {
+		helpers.ReportErrorToSentry(errs)
+	}
+
+	return errs
+}
+
+// sendScreeningToolAssignmentReminder reminds a client of a screening tool assignment unless they opted out of
+// screening tool assignment notifications, and records when they were reminded
+func (q *UseCaseQuestionnaireImpl) sendScreeningToolAssignmentReminder(ctx context.Context, assignment *domain.ScreeningToolAssignment) error {
+	client, err := q.Query.GetClientProfileByClientID(ctx, assignment.ClientID)
+	if err != nil {
+		return fmt.Errorf("failed to get client profile: %w", err)
+	}
+
+	preferences, err := q.Query.GetNotificationPreferences(ctx, *client.User.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	pushEnabled := true
+	for _, preference := range preferences {
+		if preference.NotificationType == enums.NotificationTypeScreeningToolAssignment {
+			pushEnabled = preference.PushEnabled
+		}
+	}
+
+	if pushEnabled {
+		screeningTool, err := q.Query.GetScreeningToolByID(ctx, assignment.ScreeningToolID)
+		if err != nil {
+			return fmt.Errorf("failed to get screening tool: %w", err)
+		}
+
+		message := notification.ComposeClientNotification(
+			enums.NotificationTypeScreeningToolAssignment,
+			notification.ClientNotificationInput{
+				ScreeningToolName:       &screeningTool.Questionnaire.Name,
+				ScreeningToolAssignment: assignment,
+				IsReminder:              true,
+			},
+		)
+		err = q.Notification.NotifyUser(ctx, client.User, message)
+		if err != nil {
+			return err
+		}
+	}
+
+	return q.Update.UpdateScreeningToolAssignment(ctx, assignment, map[string]interface{}{
+		"last_reminded_at": time.Now(),
+	})
+}
+
+// getLoggedInStaffProfile returns the staff profile of the logged in user in their current program
+func (q *UseCaseQuestionnaireImpl) getLoggedInStaffProfile(ctx context.Context) (*domain.StaffProfile, error) {
+	loggedInUserID, err := q.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get logged in user: %w", err)
+	}
+
+	userProfile, err := q.Query.GetUserProfileByUserID(ctx, loggedInUserID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get user profile: %w", err)
+	}
+
+	staffProfile, err := q.Query.GetStaffProfile(ctx, loggedInUserID, userProfile.CurrentProgramID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get staff profile: %w", err)
+	}
+
+	return staffProfile, nil
+}
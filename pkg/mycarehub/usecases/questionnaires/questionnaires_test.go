@@ -3,7 +3,9 @@ package questionnaires_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit"
 	"github.com/google/uuid"
@@ -13,6 +15,7 @@ import (
 	extensionMock "github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension/mock"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	pgMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/mock"
+	notificationMock "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/notification/mock"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/questionnaires"
 )
 
@@ -301,7 +304,8 @@ func TestUseCaseQuestionnaireImpl_CreateScreeningTool(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			fakeDB := pgMock.NewPostgresMock()
 			fakeExtension := extensionMock.NewFakeExtension()
-			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension)
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
 			if tt.name == "Sad case: unable to create screening tool" {
 				fakeDB.MockCreateScreeningToolFn = func(ctx context.Context, input *domain.ScreeningTool) error {
 					return errors.New("unable to create screening tool")
@@ -322,7 +326,8 @@ func TestUseCaseQuestionnaireImpl_CreateScreeningTool(t *testing.T) {
 func TestUseCaseQuestionnaireImpl_RespondToScreeningTool(t *testing.T) {
 	fakeDB := pgMock.NewPostgresMock()
 	fakeExtension := extensionMock.NewFakeExtension()
-	q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension)
+	fakeNotification := notificationMock.NewServiceNotificationMock()
+	q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
 	UUID := "f3f8f8f8-f3f8-f3f8-f3f8-f3f8f8f8f8f8"
 	type args struct {
 		ctx   context.Context
@@ -718,13 +723,55 @@ func TestUseCaseQuestionnaireImpl_GetAvailableScreeningTools(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Sad case: failed to get latest screening tool responses",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get screening tool assignments",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get assigned screening tool",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			fakeDB := pgMock.NewPostgresMock()
 			fakeExtension := extensionMock.NewFakeExtension()
-			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension)
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
 
+			if tt.name == "Sad case: failed to get latest screening tool responses" {
+				fakeDB.MockGetLatestScreeningToolResponsesFn = func(ctx context.Context, clientID, programID string) ([]*domain.QuestionnaireScreeningToolResponse, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get screening tool assignments" {
+				fakeDB.MockListScreeningToolAssignmentsFn = func(ctx context.Context, params *domain.ScreeningToolAssignment) ([]*domain.ScreeningToolAssignment, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get assigned screening tool" {
+				fakeDB.MockListScreeningToolAssignmentsFn = func(ctx context.Context, params *domain.ScreeningToolAssignment) ([]*domain.ScreeningToolAssignment, error) {
+					return []*domain.ScreeningToolAssignment{
+						{ID: uuid.NewString(), ScreeningToolID: uuid.NewString(), Status: enums.ScreeningToolAssignmentStatusPending},
+					}, nil
+				}
+				fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, id string) (*domain.ScreeningTool, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
 			if tt.name == "Sad case: unable to get available screening tools" {
 				fakeDB.MockGetAvailableScreeningToolsFn = func(ctx context.Context, clientID string, screeningTool domain.ScreeningTool, screeningToolIDs []string) ([]*domain.ScreeningTool, error) {
 					return nil, errors.New("unable to get available screening tools")
@@ -752,7 +799,8 @@ func TestUseCaseQuestionnaireImpl_GetAvailableScreeningTools(t *testing.T) {
 func TestUseCaseQuestionnaireImpl_GetScreeningToolByID(t *testing.T) {
 	fakeDB := pgMock.NewPostgresMock()
 	fakeExtension := extensionMock.NewFakeExtension()
-	q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension)
+	fakeNotification := notificationMock.NewServiceNotificationMock()
+	q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
 	UUID := uuid.New().String()
 	type args struct {
 		ctx context.Context
@@ -857,7 +905,8 @@ func TestUseCaseQuestionnaireImpl_GetFacilityRespondedScreeningTools(t *testing.
 		t.Run(tt.name, func(t *testing.T) {
 			fakeDB := pgMock.NewPostgresMock()
 			fakeExtension := extensionMock.NewFakeExtension()
-			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension)
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
 			if tt.name == "Sad case: unable to get facility responded screening tools" {
 				fakeDB.MockGetFacilityRespondedScreeningToolsFn = func(ctx context.Context, facilityID, programID string, pagination *domain.Pagination) ([]*domain.ScreeningTool, *domain.Pagination, error) {
 					return nil, nil, errors.New("unable to get facility responded screening tools")
@@ -961,7 +1010,8 @@ func TestUseCaseQuestionnaireImpl_GetScreeningToolRespondents(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			fakeDB := pgMock.NewPostgresMock()
 			fakeExtension := extensionMock.NewFakeExtension()
-			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension)
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
 			if tt.name == "Sad case: unable to get screening tool respondents" {
 				fakeDB.MockGetScreeningToolRespondentsFn = func(ctx context.Context, facilityID, ProgramID string, screeningToolID string, searchTerm string, paginationInput *dto.PaginationsInput) ([]*domain.ScreeningToolRespondent, *domain.Pagination, error) {
 					return nil, nil, errors.New("failed to get screening tool respondents")
@@ -992,7 +1042,8 @@ func TestUseCaseQuestionnaireImpl_GetScreeningToolRespondents(t *testing.T) {
 func TestUseCaseQuestionnaireImpl_GetScreeningToolResponse(t *testing.T) {
 	fakeDB := pgMock.NewPostgresMock()
 	fakeExtension := extensionMock.NewFakeExtension()
-	q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension)
+	fakeNotification := notificationMock.NewServiceNotificationMock()
+	q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
 	UUID := uuid.New().String()
 	type args struct {
 		ctx context.Context
@@ -1039,3 +1090,1681 @@ func TestUseCaseQuestionnaireImpl_GetScreeningToolResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestUseCaseQuestionnaireImpl_RespondToScreeningTool_ScoreBands(t *testing.T) {
+	scoredQuestionID := uuid.NewString()
+	criticalQuestionID := uuid.NewString()
+	criticalItemThreshold := 1
+	mildMax := 1
+	moderateMax := 2
+	highPriority := enums.ServiceRequestPriorityHigh
+
+	scoreBands := []domain.ScreeningToolScoreBand{
+		{Label: "Mild", MinScore: 0, MaxScore: &mildMax, Action: enums.ScreeningToolBandActionNone},
+		{Label: "Moderate", MinScore: 2, MaxScore: &moderateMax, Action: enums.ScreeningToolBandActionNotifyStaff},
+		{Label: "Severe", MinScore: 3, Action: enums.ScreeningToolBandActionCreateRedFlag, Priority: &highPriority},
+	}
+
+	screeningTool := func(bands []domain.ScreeningToolScoreBand) *domain.ScreeningTool {
+		return &domain.ScreeningTool{
+			ID:        uuid.NewString(),
+			Threshold: 3,
+			Questionnaire: domain.Questionnaire{
+				Name: "PHQ-9",
+				Questions: []domain.Question{
+					{
+						ID:                scoredQuestionID,
+						QuestionType:      enums.QuestionTypeCloseEnded,
+						ResponseValueType: enums.QuestionResponseValueTypeNumber,
+						Choices: []domain.QuestionInputChoice{
+							{Choice: "0", Value: "0", Score: 0},
+							{Choice: "1", Value: "1", Score: 1},
+							{Choice: "2", Value: "2", Score: 2},
+							{Choice: "3", Value: "3", Score: 3},
+						},
+					},
+					{
+						ID:                    criticalQuestionID,
+						QuestionType:          enums.QuestionTypeCloseEnded,
+						ResponseValueType:     enums.QuestionResponseValueTypeNumber,
+						CriticalItemThreshold: &criticalItemThreshold,
+						Choices: []domain.QuestionInputChoice{
+							{Choice: "0", Value: "0", Score: 0},
+							{Choice: "1", Value: "1", Score: 1},
+							{Choice: "2", Value: "2", Score: 2},
+						},
+					},
+				},
+			},
+			ScoreBands: bands,
+		}
+	}
+
+	tests := []struct {
+		name             string
+		scoreBands       []domain.ScreeningToolScoreBand
+		scoredResponse   string
+		criticalResponse string
+		wantSeverity     string
+		wantRedFlag      bool
+		wantPriority     string
+		wantNotification bool
+	}{
+		{
+			name:             "Happy case: mild score takes no action",
+			scoreBands:       scoreBands,
+			scoredResponse:   "1",
+			criticalResponse: "0",
+			wantSeverity:     "Mild",
+		},
+		{
+			name:             "Happy case: moderate score notifies staff",
+			scoreBands:       scoreBands,
+			scoredResponse:   "2",
+			criticalResponse: "0",
+			wantSeverity:     "Moderate",
+			wantNotification: true,
+		},
+		{
+			name:             "Happy case: severe score raises a red flag",
+			scoreBands:       scoreBands,
+			scoredResponse:   "3",
+			criticalResponse: "0",
+			wantSeverity:     "Severe",
+			wantRedFlag:      true,
+			wantPriority:     enums.ServiceRequestPriorityHigh.String(),
+		},
+		{
+			name:             "Happy case: critical item raises an urgent red flag regardless of the score",
+			scoreBands:       scoreBands,
+			scoredResponse:   "0",
+			criticalResponse: "1",
+			wantSeverity:     "Mild",
+			wantRedFlag:      true,
+			wantPriority:     enums.ServiceRequestPriorityUrgent.String(),
+		},
+		{
+			name:             "Happy case: tool without bands below the threshold",
+			scoredResponse:   "2",
+			criticalResponse: "0",
+		},
+		{
+			name:             "Happy case: tool without bands at the threshold",
+			scoredResponse:   "3",
+			criticalResponse: "0",
+			wantRedFlag:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, toolID string) (*domain.ScreeningTool, error) {
+				return screeningTool(tt.scoreBands), nil
+			}
+
+			var severity *string
+			fakeDB.MockCreateScreeningToolResponseFn = func(ctx context.Context, input *domain.QuestionnaireScreeningToolResponse) (*string, error) {
+				severity = input.Severity
+				id := uuid.NewString()
+				return &id, nil
+			}
+
+			var serviceRequest *dto.ServiceRequestInput
+			fakeDB.MockCreateServiceRequestFn = func(ctx context.Context, serviceRequestInput *dto.ServiceRequestInput) error {
+				serviceRequest = serviceRequestInput
+				return nil
+			}
+
+			notified := false
+			fakeNotification.MockNotifyFacilityStaffsFn = func(ctx context.Context, facility *domain.Facility, notificationPayload *domain.Notification) error {
+				notified = notificationPayload.Type == enums.NotificationTypeScreeningToolResponse
+				return nil
+			}
+
+			got, err := q.RespondToScreeningTool(context.Background(), dto.QuestionnaireScreeningToolResponseInput{
+				ScreeningToolID: uuid.NewString(),
+				ClientID:        uuid.NewString(),
+				QuestionResponses: []*dto.QuestionnaireScreeningToolQuestionResponseInput{
+					{QuestionID: scoredQuestionID, Response: tt.scoredResponse},
+					{QuestionID: criticalQuestionID, Response: tt.criticalResponse},
+				},
+			})
+			if err != nil || !got {
+				t.Errorf("UseCaseQuestionnaireImpl.RespondToScreeningTool() = %v, error = %v", got, err)
+				return
+			}
+
+			if tt.wantSeverity == "" && severity != nil {
+				t.Errorf("expected no severity, got %v", *severity)
+			}
+			if tt.wantSeverity != "" && (severity == nil || *severity != tt.wantSeverity) {
+				t.Errorf("expected severity %v, got %v", tt.wantSeverity, severity)
+			}
+
+			if (serviceRequest != nil) != tt.wantRedFlag {
+				t.Errorf("expected red flag to be raised: %v, got %v", tt.wantRedFlag, serviceRequest)
+				return
+			}
+			if serviceRequest != nil && tt.wantPriority != "" && serviceRequest.Meta["priority"] != tt.wantPriority {
+				t.Errorf("expected red flag priority %v, got %v", tt.wantPriority, serviceRequest.Meta["priority"])
+			}
+
+			if notified != tt.wantNotification {
+				t.Errorf("expected staff to be notified: %v, got %v", tt.wantNotification, notified)
+			}
+		})
+	}
+}
+
+func TestUseCaseQuestionnaireImpl_RespondToScreeningTool_Conditions(t *testing.T) {
+	gateQuestionID := uuid.NewString()
+	followUpQuestionID := uuid.NewString()
+
+	screeningTool := &domain.ScreeningTool{
+		ID:        uuid.NewString(),
+		Threshold: 10,
+		Questionnaire: domain.Questionnaire{
+			Name: "Alcohol use",
+			Questions: []domain.Question{
+				{
+					ID:                gateQuestionID,
+					QuestionType:      enums.QuestionTypeCloseEnded,
+					ResponseValueType: enums.QuestionResponseValueTypeNumber,
+					Required:          true,
+					Sequence:          1,
+					Choices: []domain.QuestionInputChoice{
+						{Choice: "0", Value: "No", Score: 0},
+						{Choice: "1", Value: "Yes", Score: 1},
+					},
+				},
+				{
+					ID:                followUpQuestionID,
+					QuestionType:      enums.QuestionTypeCloseEnded,
+					ResponseValueType: enums.QuestionResponseValueTypeNumber,
+					Required:          true,
+					Sequence:          2,
+					Choices: []domain.QuestionInputChoice{
+						{Choice: "0", Value: "Rarely", Score: 0},
+						{Choice: "1", Value: "Often", Score: 1},
+					},
+					Conditions: []domain.QuestionCondition{
+						{
+							QuestionID:          followUpQuestionID,
+							DependsOnQuestionID: gateQuestionID,
+							Operator:            enums.QuestionConditionOperatorEquals,
+							Target:              enums.QuestionConditionTargetChoice,
+							Values:              []string{"1"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		responses []*dto.QuestionnaireScreeningToolQuestionResponseInput
+		wantErr   bool
+	}{
+		{
+			name: "Happy case: follow up question skipped",
+			responses: []*dto.QuestionnaireScreeningToolQuestionResponseInput{
+				{QuestionID: gateQuestionID, Response: "0"},
+			},
+		},
+		{
+			name: "Happy case: follow up question answered",
+			responses: []*dto.QuestionnaireScreeningToolQuestionResponseInput{
+				{QuestionID: gateQuestionID, Response: "1"},
+				{QuestionID: followUpQuestionID, Response: "1"},
+			},
+		},
+		{
+			name: "Sad case: unreachable question answered",
+			responses: []*dto.QuestionnaireScreeningToolQuestionResponseInput{
+				{QuestionID: gateQuestionID, Response: "0"},
+				{QuestionID: followUpQuestionID, Response: "1"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: required reachable question not answered",
+			responses: []*dto.QuestionnaireScreeningToolQuestionResponseInput{
+				{QuestionID: gateQuestionID, Response: "1"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, toolID string) (*domain.ScreeningTool, error) {
+				return screeningTool, nil
+			}
+
+			created := false
+			fakeDB.MockCreateScreeningToolResponseFn = func(ctx context.Context, input *domain.QuestionnaireScreeningToolResponse) (*string, error) {
+				created = true
+				id := uuid.NewString()
+				return &id, nil
+			}
+
+			got, err := q.RespondToScreeningTool(context.Background(), dto.QuestionnaireScreeningToolResponseInput{
+				ScreeningToolID:   screeningTool.ID,
+				ClientID:          uuid.NewString(),
+				QuestionResponses: tt.responses,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseQuestionnaireImpl.RespondToScreeningTool() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got == tt.wantErr {
+				t.Errorf("UseCaseQuestionnaireImpl.RespondToScreeningTool() = %v", got)
+			}
+			if created == tt.wantErr {
+				t.Errorf("expected response to be saved: %v", !tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUseCaseQuestionnaireImpl_RespondToScreeningTool_PinsVersion(t *testing.T) {
+	fakeDB := pgMock.NewPostgresMock()
+	fakeExtension := extensionMock.NewFakeExtension()
+	fakeNotification := notificationMock.NewServiceNotificationMock()
+	q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+	questionID := uuid.NewString()
+	versionID := uuid.NewString()
+	fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, toolID string) (*domain.ScreeningTool, error) {
+		return &domain.ScreeningTool{
+			ID:        toolID,
+			Threshold: 10,
+			Questionnaire: domain.Questionnaire{
+				Questions: []domain.Question{
+					{
+						ID:                questionID,
+						QuestionType:      enums.QuestionTypeOpenEnded,
+						ResponseValueType: enums.QuestionResponseValueTypeString,
+					},
+				},
+			},
+			VersionID: &versionID,
+			Version:   2,
+		}, nil
+	}
+
+	var pinnedVersionID *string
+	fakeDB.MockCreateScreeningToolResponseFn = func(ctx context.Context, input *domain.QuestionnaireScreeningToolResponse) (*string, error) {
+		pinnedVersionID = input.ScreeningToolVersionID
+		id := uuid.NewString()
+		return &id, nil
+	}
+
+	_, err := q.RespondToScreeningTool(context.Background(), dto.QuestionnaireScreeningToolResponseInput{
+		ScreeningToolID: uuid.NewString(),
+		ClientID:        uuid.NewString(),
+		QuestionResponses: []*dto.QuestionnaireScreeningToolQuestionResponseInput{
+			{QuestionID: questionID, Response: gofakeit.Sentence(5)},
+		},
+	})
+	if err != nil {
+		t.Errorf("UseCaseQuestionnaireImpl.RespondToScreeningTool() error = %v", err)
+		return
+	}
+	if pinnedVersionID == nil || *pinnedVersionID != versionID {
+		t.Errorf("expected the response to be pinned to version %s, got %v", versionID, pinnedVersionID)
+	}
+}
+
+func TestUseCaseQuestionnaireImpl_SaveScreeningToolDraft(t *testing.T) {
+	choice := "1"
+	otherChoice := "0"
+	duplicateChoice := "1"
+	input := dto.QuestionnaireInput{
+		Name:        gofakeit.BeerName(),
+		Description: gofakeit.Sentence(20),
+		Questions: []*dto.QuestionInput{
+			{
+				Text:              gofakeit.BeerAlcohol(),
+				QuestionType:      enums.QuestionTypeCloseEnded,
+				ResponseValueType: enums.QuestionResponseValueTypeNumber,
+				Required:          true,
+				Sequence:          1,
+				Choices: []dto.QuestionInputChoiceInput{
+					{Choice: &choice, Value: "1", Score: 1},
+					{Choice: &otherChoice, Value: "0", Score: 0},
+				},
+			},
+		},
+	}
+	duplicateChoiceInput := dto.QuestionnaireInput{
+		Name:        input.Name,
+		Description: input.Description,
+		Questions: []*dto.QuestionInput{
+			{
+				Text:              gofakeit.BeerAlcohol(),
+				QuestionType:      enums.QuestionTypeCloseEnded,
+				ResponseValueType: enums.QuestionResponseValueTypeNumber,
+				Required:          true,
+				Sequence:          1,
+				Choices: []dto.QuestionInputChoiceInput{
+					{Choice: &choice, Value: "1", Score: 1},
+					{Choice: &duplicateChoice, Value: "0", Score: 0},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		input   dto.QuestionnaireInput
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: save screening tool draft",
+			input:   input,
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: invalid questionnaire",
+			input:   dto.QuestionnaireInput{Name: input.Name, Questions: []*dto.QuestionInput{{Text: gofakeit.BeerAlcohol()}}},
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: duplicate choices",
+			input:   duplicateChoiceInput,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to get logged in user",
+			input:   input,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to get user profile",
+			input:   input,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to get screening tool",
+			input:   input,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: screening tool belongs to another program",
+			input:   input,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to save draft",
+			input:   input,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			programID := uuid.NewString()
+			fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+				return &domain.User{ID: &userID, CurrentProgramID: programID}, nil
+			}
+			fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, toolID string) (*domain.ScreeningTool, error) {
+				return &domain.ScreeningTool{ID: toolID, ProgramID: programID}, nil
+			}
+			if tt.name == "Sad case: unable to get screening tool" {
+				fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, toolID string) (*domain.ScreeningTool, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: screening tool belongs to another program" {
+				fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, toolID string) (*domain.ScreeningTool, error) {
+					return &domain.ScreeningTool{ID: toolID, ProgramID: uuid.NewString()}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get user profile" {
+				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to save draft" {
+				fakeDB.MockSaveScreeningToolDraftFn = func(ctx context.Context, screeningToolID string, questionnaire *domain.Questionnaire) (*domain.ScreeningToolVersion, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := q.SaveScreeningToolDraft(context.Background(), uuid.NewString(), tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseQuestionnaireImpl.SaveScreeningToolDraft() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got == nil || got.Status != enums.ScreeningToolVersionStatusDraft) {
+				t.Errorf("expected a draft version, got %v", got)
+			}
+		})
+	}
+}
+
+func TestUseCaseQuestionnaireImpl_PublishScreeningToolDraft(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: publish screening tool draft",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to get logged in user",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to get user profile",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to get screening tool",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: screening tool belongs to another program",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to publish screening tool draft",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			programID := uuid.NewString()
+			fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+				return &domain.User{ID: &userID, CurrentProgramID: programID}, nil
+			}
+			fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, toolID string) (*domain.ScreeningTool, error) {
+				return &domain.ScreeningTool{ID: toolID, ProgramID: programID}, nil
+			}
+			if tt.name == "Sad case: unable to get screening tool" {
+				fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, toolID string) (*domain.ScreeningTool, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: screening tool belongs to another program" {
+				fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, toolID string) (*domain.ScreeningTool, error) {
+					return &domain.ScreeningTool{ID: toolID, ProgramID: uuid.NewString()}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to get user profile" {
+				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: unable to publish screening tool draft" {
+				fakeDB.MockPublishScreeningToolDraftFn = func(ctx context.Context, screeningToolID string) (*domain.ScreeningToolVersion, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := q.PublishScreeningToolDraft(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseQuestionnaireImpl.PublishScreeningToolDraft() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got == nil || got.Status != enums.ScreeningToolVersionStatusPublished) {
+				t.Errorf("expected a published version, got %v", got)
+			}
+		})
+	}
+}
+
+func TestUseCaseQuestionnaireImpl_ListScreeningToolVersions(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list screening tool versions",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to list screening tool versions",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			if tt.name == "Sad case: unable to list screening tool versions" {
+				fakeDB.MockListScreeningToolVersionsFn = func(ctx context.Context, screeningToolID string) ([]*domain.ScreeningToolVersion, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := q.ListScreeningToolVersions(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseQuestionnaireImpl.ListScreeningToolVersions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected screening tool versions to be returned")
+			}
+		})
+	}
+}
+
+func TestUseCaseQuestionnaireImpl_GetScreeningToolVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: get screening tool version",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: unable to get screening tool version",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			if tt.name == "Sad case: unable to get screening tool version" {
+				fakeDB.MockGetScreeningToolVersionFn = func(ctx context.Context, screeningToolID string, version int) (*domain.ScreeningToolVersion, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := q.GetScreeningToolVersion(context.Background(), uuid.NewString(), 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseQuestionnaireImpl.GetScreeningToolVersion() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected a screening tool version to be returned")
+			}
+		})
+	}
+}
+
+func TestUseCaseQuestionnaireImpl_CompareScreeningToolVersions(t *testing.T) {
+	questionnaire := func(text string) *domain.Questionnaire {
+		return &domain.Questionnaire{
+			Name: "GAD-7",
+			Questions: []domain.Question{
+				{
+					Text:              text,
+					QuestionType:      enums.QuestionTypeOpenEnded,
+					ResponseValueType: enums.QuestionResponseValueTypeString,
+					Sequence:          1,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		wantChanges int
+		wantErr     bool
+	}{
+		{
+			name:        "Happy case: compare screening tool versions",
+			wantChanges: 1,
+			wantErr:     false,
+		},
+		{
+			name:    "Sad case: unable to get the older version",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unable to get the newer version",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			fakeDB.MockGetScreeningToolVersionFn = func(ctx context.Context, screeningToolID string, version int) (*domain.ScreeningToolVersion, error) {
+				if tt.name == "Sad case: unable to get the older version" && version == 1 {
+					return nil, errors.New("an error occurred")
+				}
+				if tt.name == "Sad case: unable to get the newer version" && version == 2 {
+					return nil, errors.New("an error occurred")
+				}
+				return &domain.ScreeningToolVersion{
+					Version:       version,
+					Questionnaire: questionnaire(fmt.Sprintf("Question in version %d", version)),
+				}, nil
+			}
+
+			got, err := q.CompareScreeningToolVersions(context.Background(), uuid.NewString(), 1, 2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseQuestionnaireImpl.CompareScreeningToolVersions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got.Changes) != tt.wantChanges {
+				t.Errorf("expected %d changes, got %d", tt.wantChanges, len(got.Changes))
+				return
+			}
+			if change := got.Changes[0]; change.Field != "text" || change.From != "Question in version 1" || change.To != "Question in version 2" {
+				t.Errorf("unexpected change %+v", change)
+			}
+		})
+	}
+}
+
+func TestUseCaseQuestionnaireImpl_GetAvailableScreeningTools_Recurrence(t *testing.T) {
+	fakeDB := pgMock.NewPostgresMock()
+	fakeExtension := extensionMock.NewFakeExtension()
+	fakeNotification := notificationMock.NewServiceNotificationMock()
+	q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+	respondedLastWeek := "responded-last-week"
+	respondedLastMonth := "responded-last-month"
+	onceOnly := "once-only"
+	respondedOnce := "responded-once"
+	assignedOnly := "assigned-only"
+	notAssigned := "not-assigned"
+	outsideTargetGroup := "outside-target-group"
+	pendingFollowUp := "pending-follow-up"
+
+	now := time.Now()
+	fakeDB.MockGetLatestScreeningToolResponsesFn = func(ctx context.Context, clientID, programID string) ([]*domain.QuestionnaireScreeningToolResponse, error) {
+		return []*domain.QuestionnaireScreeningToolResponse{
+			{ScreeningToolID: respondedLastWeek, DateOfResponse: now.AddDate(0, 0, -7)},
+			{ScreeningToolID: respondedLastMonth, DateOfResponse: now.AddDate(0, -1, 0)},
+			{ScreeningToolID: respondedOnce, DateOfResponse: now.AddDate(0, -1, 0)},
+		}, nil
+	}
+	fakeDB.MockGetScreeningToolResponsesWithPendingServiceRequestsFn = func(ctx context.Context, clientID, programID string) ([]*domain.QuestionnaireScreeningToolResponse, error) {
+		return []*domain.QuestionnaireScreeningToolResponse{{ScreeningToolID: pendingFollowUp}}, nil
+	}
+	fakeDB.MockListScreeningToolAssignmentsFn = func(ctx context.Context, params *domain.ScreeningToolAssignment) ([]*domain.ScreeningToolAssignment, error) {
+		return []*domain.ScreeningToolAssignment{
+			{ID: "first", ScreeningToolID: assignedOnly, Status: enums.ScreeningToolAssignmentStatusPending},
+			{ID: "second", ScreeningToolID: assignedOnly, Status: enums.ScreeningToolAssignmentStatusPending},
+			{ID: "third", ScreeningToolID: outsideTargetGroup, Status: enums.ScreeningToolAssignmentStatusPending},
+			{ID: "fourth", ScreeningToolID: pendingFollowUp, Status: enums.ScreeningToolAssignmentStatusPending},
+		}, nil
+	}
+	fakeDB.MockGetAvailableScreeningToolsFn = func(ctx context.Context, clientID string, screeningTool domain.ScreeningTool, screeningToolIDs []string) ([]*domain.ScreeningTool, error) {
+		fortnightly := func(id string) *domain.ScreeningTool {
+			return &domain.ScreeningTool{ID: id, Recurrence: enums.ScreeningToolRecurrenceInterval, RecurrenceIntervalDays: 14}
+		}
+		return []*domain.ScreeningTool{
+			fortnightly(respondedLastWeek),
+			fortnightly(respondedLastMonth),
+			{ID: onceOnly, Recurrence: enums.ScreeningToolRecurrenceOnce},
+			{ID: respondedOnce, Recurrence: enums.ScreeningToolRecurrenceOnce},
+			{ID: assignedOnly, Recurrence: enums.ScreeningToolRecurrenceAssigned},
+			{ID: notAssigned, Recurrence: enums.ScreeningToolRecurrenceAssigned},
+		}, nil
+	}
+	fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, id string) (*domain.ScreeningTool, error) {
+		return &domain.ScreeningTool{ID: id, Recurrence: enums.ScreeningToolRecurrenceAssigned}, nil
+	}
+
+	got, err := q.GetAvailableScreeningTools(context.Background())
+	if err != nil {
+		t.Errorf("UseCaseQuestionnaireImpl.GetAvailableScreeningTools() error = %v", err)
+		return
+	}
+
+	available := map[string]*domain.ScreeningTool{}
+	for _, tool := range got {
+		available[tool.ID] = tool
+	}
+
+	want := []string{respondedLastMonth, onceOnly, assignedOnly, outsideTargetGroup}
+	if len(got) != len(want) {
+		t.Errorf("expected %d available screening tools, got %d", len(want), len(got))
+	}
+	for _, id := range want {
+		if _, ok := available[id]; !ok {
+			t.Errorf("expected screening tool %s to be available", id)
+		}
+	}
+
+	if tool, ok := available[assignedOnly]; !ok || tool.Assignment == nil || tool.Assignment.ID != "first" {
+		t.Errorf("expected the earliest assignment to be attached to the assigned screening tool")
+	}
+	if tool, ok := available[outsideTargetGroup]; !ok || tool.Assignment == nil || tool.Assignment.ID != "third" {
+		t.Errorf("expected the assignment to be attached to the screening tool outside the target group")
+	}
+}
+
+func TestUseCaseQuestionnaireImpl_RespondToScreeningTool_CompletesAssignments(t *testing.T) {
+	UUID := "f3f8f8f8-f3f8-f3f8-f3f8-f3f8f8f8f8f8"
+	input := dto.QuestionnaireScreeningToolResponseInput{
+		ScreeningToolID: UUID,
+		ClientID:        UUID,
+		ProgramID:       uuid.NewString(),
+		QuestionResponses: []*dto.QuestionnaireScreeningToolQuestionResponseInput{
+			{
+				QuestionID: UUID,
+				Response:   "0",
+				ProgramID:  uuid.NewString(),
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: complete assignments",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: failed to complete assignments",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			responseID := uuid.NewString()
+			fakeDB.MockCreateScreeningToolResponseFn = func(ctx context.Context, input *domain.QuestionnaireScreeningToolResponse) (*string, error) {
+				return &responseID, nil
+			}
+
+			var completedBy string
+			fakeDB.MockCompleteScreeningToolAssignmentsFn = func(ctx context.Context, clientID, screeningToolID, responseID string) error {
+				if tt.name == "Sad case: failed to complete assignments" {
+					return errors.New("an error occurred")
+				}
+				completedBy = responseID
+				return nil
+			}
+
+			_, err := q.RespondToScreeningTool(context.Background(), input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseQuestionnaireImpl.RespondToScreeningTool() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && completedBy != responseID {
+				t.Errorf("expected the assignments to be completed by response %s, got %s", responseID, completedBy)
+			}
+		})
+	}
+}
+
+func TestUseCaseQuestionnaireImpl_UpdateScreeningToolRecurrence(t *testing.T) {
+	fortnight := 14
+
+	tests := []struct {
+		name    string
+		input   dto.ScreeningToolRecurrenceInput
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update screening tool recurrence",
+			input: dto.ScreeningToolRecurrenceInput{
+				Recurrence:   enums.ScreeningToolRecurrenceInterval,
+				IntervalDays: &fortnight,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid recurrence",
+			input: dto.ScreeningToolRecurrenceInput{
+				Recurrence: enums.ScreeningToolRecurrenceInterval,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get staff profile",
+			input: dto.ScreeningToolRecurrenceInput{
+				Recurrence: enums.ScreeningToolRecurrenceOnce,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get screening tool",
+			input: dto.ScreeningToolRecurrenceInput{
+				Recurrence: enums.ScreeningToolRecurrenceOnce,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: screening tool in another program",
+			input: dto.ScreeningToolRecurrenceInput{
+				Recurrence: enums.ScreeningToolRecurrenceOnce,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to update screening tool",
+			input: dto.ScreeningToolRecurrenceInput{
+				Recurrence: enums.ScreeningToolRecurrenceOnce,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			var updates map[string]interface{}
+			fakeDB.MockUpdateScreeningToolFn = func(ctx context.Context, screeningTool *domain.ScreeningTool, updateData map[string]interface{}) error {
+				updates = updateData
+				return nil
+			}
+
+			if tt.name == "Sad case: failed to get staff profile" {
+				fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID, programID string) (*domain.StaffProfile, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get screening tool" {
+				fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, id string) (*domain.ScreeningTool, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: screening tool in another program" {
+				fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, id string) (*domain.ScreeningTool, error) {
+					return &domain.ScreeningTool{ID: id, ProgramID: uuid.NewString()}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to update screening tool" {
+				fakeDB.MockUpdateScreeningToolFn = func(ctx context.Context, screeningTool *domain.ScreeningTool, updateData map[string]interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := q.UpdateScreeningToolRecurrence(context.Background(), uuid.NewString(), tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseQuestionnaireImpl.UpdateScreeningToolRecurrence() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && updates["recurrence_interval_days"] != fortnight {
+				t.Errorf("expected the recurrence interval to be updated to %d days, got %v", fortnight, updates["recurrence_interval_days"])
+			}
+		})
+	}
+}
+
+func TestUseCaseQuestionnaireImpl_AssignScreeningTool(t *testing.T) {
+	input := dto.ScreeningToolAssignmentInput{
+		ScreeningToolID: uuid.NewString(),
+		ClientID:        uuid.NewString(),
+		DueDate:         time.Now().AddDate(0, 0, 7),
+	}
+
+	tests := []struct {
+		name    string
+		input   dto.ScreeningToolAssignmentInput
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: assign screening tool",
+			input:   input,
+			wantErr: false,
+		},
+		{
+			name:    "Happy case: failed to notify client",
+			input:   input,
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid input",
+			input: dto.ScreeningToolAssignmentInput{
+				ScreeningToolID: uuid.NewString(),
+				ClientID:        uuid.NewString(),
+				DueDate:         time.Now().AddDate(0, 0, -1),
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get logged in user",
+			input:   input,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get staff profile",
+			input:   input,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get client profile",
+			input:   input,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get screening tool",
+			input:   input,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: client in another program",
+			input:   input,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get pending assignments",
+			input:   input,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: client has a pending assignment",
+			input:   input,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to create assignment",
+			input:   input,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			fakeDB.MockListScreeningToolAssignmentsFn = func(ctx context.Context, params *domain.ScreeningToolAssignment) ([]*domain.ScreeningToolAssignment, error) {
+				return []*domain.ScreeningToolAssignment{}, nil
+			}
+
+			if tt.name == "Happy case: failed to notify client" {
+				fakeNotification.MockNotifyUserFn = func(ctx context.Context, userProfile *domain.User, notificationPayload *domain.Notification) error {
+					return errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get staff profile" {
+				fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID, programID string) (*domain.StaffProfile, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get client profile" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get screening tool" {
+				fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, id string) (*domain.ScreeningTool, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: client in another program" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+					return &domain.ClientProfile{ID: &clientID, ProgramID: uuid.NewString(), User: &domain.User{}}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to get pending assignments" {
+				fakeDB.MockListScreeningToolAssignmentsFn = func(ctx context.Context, params *domain.ScreeningToolAssignment) ([]*domain.ScreeningToolAssignment, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: client has a pending assignment" {
+				fakeDB.MockListScreeningToolAssignmentsFn = func(ctx context.Context, params *domain.ScreeningToolAssignment) ([]*domain.ScreeningToolAssignment, error) {
+					return []*domain.ScreeningToolAssignment{
+						{ID: uuid.NewString(), DueDate: time.Now(), Status: enums.ScreeningToolAssignmentStatusPending},
+					}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to create assignment" {
+				fakeDB.MockCreateScreeningToolAssignmentFn = func(ctx context.Context, assignment *domain.ScreeningToolAssignment) (*domain.ScreeningToolAssignment, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := q.AssignScreeningTool(context.Background(), tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseQuestionnaireImpl.AssignScreeningTool() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got == nil || got.Status != enums.ScreeningToolAssignmentStatusPending) {
+				t.Errorf("expected a pending screening tool assignment, got %v", got)
+			}
+		})
+	}
+}
+
+func TestUseCaseQuestionnaireImpl_CancelScreeningToolAssignment(t *testing.T) {
+	tests := []struct {
+		name         string
+		assignmentID string
+		wantErr      bool
+	}{
+		{
+			name:         "Happy case: cancel screening tool assignment",
+			assignmentID: uuid.NewString(),
+			wantErr:      false,
+		},
+		{
+			name:    "Sad case: missing assignment ID",
+			wantErr: true,
+		},
+		{
+			name:         "Sad case: failed to get staff profile",
+			assignmentID: uuid.NewString(),
+			wantErr:      true,
+		},
+		{
+			name:         "Sad case: failed to get assignment",
+			assignmentID: uuid.NewString(),
+			wantErr:      true,
+		},
+		{
+			name:         "Sad case: assignment not found",
+			assignmentID: uuid.NewString(),
+			wantErr:      true,
+		},
+		{
+			name:         "Sad case: assignment in another program",
+			assignmentID: uuid.NewString(),
+			wantErr:      true,
+		},
+		{
+			name:         "Sad case: assignment already completed",
+			assignmentID: uuid.NewString(),
+			wantErr:      true,
+		},
+		{
+			name:         "Sad case: failed to cancel assignment",
+			assignmentID: uuid.NewString(),
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			if tt.name == "Sad case: failed to get staff profile" {
+				fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID, programID string) (*domain.StaffProfile, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get assignment" {
+				fakeDB.MockListScreeningToolAssignmentsFn = func(ctx context.Context, params *domain.ScreeningToolAssignment) ([]*domain.ScreeningToolAssignment, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: assignment not found" {
+				fakeDB.MockListScreeningToolAssignmentsFn = func(ctx context.Context, params *domain.ScreeningToolAssignment) ([]*domain.ScreeningToolAssignment, error) {
+					return []*domain.ScreeningToolAssignment{}, nil
+				}
+			}
+			if tt.name == "Sad case: assignment in another program" {
+				fakeDB.MockListScreeningToolAssignmentsFn = func(ctx context.Context, params *domain.ScreeningToolAssignment) ([]*domain.ScreeningToolAssignment, error) {
+					return []*domain.ScreeningToolAssignment{
+						{ID: params.ID, Status: enums.ScreeningToolAssignmentStatusPending, ProgramID: uuid.NewString()},
+					}, nil
+				}
+			}
+			if tt.name == "Sad case: assignment already completed" {
+				fakeDB.MockListScreeningToolAssignmentsFn = func(ctx context.Context, params *domain.ScreeningToolAssignment) ([]*domain.ScreeningToolAssignment, error) {
+					return []*domain.ScreeningToolAssignment{
+						{ID: params.ID, Status: enums.ScreeningToolAssignmentStatusCompleted},
+					}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to cancel assignment" {
+				fakeDB.MockUpdateScreeningToolAssignmentFn = func(ctx context.Context, assignment *domain.ScreeningToolAssignment, updateData map[string]interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := q.CancelScreeningToolAssignment(context.Background(), tt.assignmentID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseQuestionnaireImpl.CancelScreeningToolAssignment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUseCaseQuestionnaireImpl_ListClientScreeningToolAssignments(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list client screening tool assignments",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: failed to get staff profile",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get client profile",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: client in another program",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to list client screening tool assignments",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			if tt.name == "Sad case: failed to get staff profile" {
+				fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID, programID string) (*domain.StaffProfile, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get client profile" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: client in another program" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+					return &domain.ClientProfile{ID: &clientID, ProgramID: uuid.NewString(), User: &domain.User{}}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to list client screening tool assignments" {
+				fakeDB.MockListScreeningToolAssignmentsFn = func(ctx context.Context, params *domain.ScreeningToolAssignment) ([]*domain.ScreeningToolAssignment, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			_, err := q.ListClientScreeningToolAssignments(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseQuestionnaireImpl.ListClientScreeningToolAssignments() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUseCaseQuestionnaireImpl_SendScreeningToolAssignmentReminders(t *testing.T) {
+	anHourAgo := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name          string
+		wantReminders int
+		wantErr       bool
+	}{
+		{
+			name:          "Happy case: send screening tool assignment reminders",
+			wantReminders: 1,
+			wantErr:       false,
+		},
+		{
+			name:          "Happy case: client was recently reminded",
+			wantReminders: 0,
+			wantErr:       false,
+		},
+		{
+			name:          "Happy case: client opted out of push notifications",
+			wantReminders: 0,
+			wantErr:       false,
+		},
+		{
+			name:    "Sad case: failed to list assignments due for a reminder",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get client profile",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get notification preferences",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get screening tool",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to notify client",
+			wantErr: true,
+		},
+		{
+			name:          "Sad case: failed to record reminder",
+			wantReminders: 1,
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			reminders := 0
+			fakeNotification.MockNotifyUserFn = func(ctx context.Context, userProfile *domain.User, notificationPayload *domain.Notification) error {
+				reminders++
+				return nil
+			}
+
+			if tt.name == "Happy case: client was recently reminded" {
+				fakeDB.MockListPendingScreeningToolAssignmentsDueBeforeFn = func(ctx context.Context, dueBefore time.Time) ([]*domain.ScreeningToolAssignment, error) {
+					return []*domain.ScreeningToolAssignment{
+						{ID: uuid.NewString(), DueDate: time.Now(), Status: enums.ScreeningToolAssignmentStatusPending, LastRemindedAt: &anHourAgo},
+					}, nil
+				}
+			}
+			if tt.name == "Happy case: client opted out of push notifications" {
+				fakeDB.MockGetNotificationPreferencesFn = func(ctx context.Context, userID string) ([]*domain.NotificationPreference, error) {
+					return []*domain.NotificationPreference{
+						{NotificationType: enums.NotificationTypeScreeningToolAssignment, PushEnabled: false},
+					}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to list assignments due for a reminder" {
+				fakeDB.MockListPendingScreeningToolAssignmentsDueBeforeFn = func(ctx context.Context, dueBefore time.Time) ([]*domain.ScreeningToolAssignment, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get client profile" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get notification preferences" {
+				fakeDB.MockGetNotificationPreferencesFn = func(ctx context.Context, userID string) ([]*domain.NotificationPreference, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get screening tool" {
+				fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, id string) (*domain.ScreeningTool, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to notify client" {
+				fakeNotification.MockNotifyUserFn = func(ctx context.Context, userProfile *domain.User, notificationPayload *domain.Notification) error {
+					return errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to record reminder" {
+				fakeDB.MockUpdateScreeningToolAssignmentFn = func(ctx context.Context, assignment *domain.ScreeningToolAssignment, updateData map[string]interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			err := q.SendScreeningToolAssignmentReminders(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseQuestionnaireImpl.SendScreeningToolAssignmentReminders() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantReminders != reminders {
+				t.Errorf("expected %d reminders to be sent, got %d", tt.wantReminders, reminders)
+			}
+		})
+	}
+}
+
+func TestUseCaseQuestionnaireImpl_RespondToScreeningTool_WorseningScore(t *testing.T) {
+	screeningToolID := uuid.NewString()
+	questionID := uuid.NewString()
+	worseningThreshold := 3
+	lowScore, mildScore := 0, 1
+
+	screeningTool := &domain.ScreeningTool{
+		ID: screeningToolID,
+		// the threshold is out of reach so that only a worsening score raises a red flag
+		Threshold:               100,
+		ScoreWorseningThreshold: &worseningThreshold,
+		Questionnaire: domain.Questionnaire{
+			Name: "PHQ-9",
+			Questions: []domain.Question{
+				{
+					ID:                questionID,
+					QuestionType:      enums.QuestionTypeCloseEnded,
+					ResponseValueType: enums.QuestionResponseValueTypeNumber,
+					Choices: []domain.QuestionInputChoice{
+						{Choice: "0", Value: "0", Score: 0},
+						{Choice: "1", Value: "1", Score: 1},
+						{Choice: "2", Value: "2", Score: 2},
+						{Choice: "3", Value: "3", Score: 3},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		previousScore *int
+		response      string
+		wantRedFlag   bool
+		wantErr       bool
+	}{
+		{
+			name:          "Happy case: worsened score raises a red flag",
+			previousScore: &lowScore,
+			response:      "3",
+			wantRedFlag:   true,
+		},
+		{
+			name:          "Happy case: score did not worsen by the threshold",
+			previousScore: &mildScore,
+			response:      "3",
+			wantRedFlag:   false,
+		},
+		{
+			name:        "Happy case: first response to the screening tool",
+			response:    "3",
+			wantRedFlag: false,
+		},
+		{
+			name:     "Sad case: failed to get latest screening tool responses",
+			response: "3",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, toolID string) (*domain.ScreeningTool, error) {
+				return screeningTool, nil
+			}
+			fakeDB.MockGetLatestScreeningToolResponsesFn = func(ctx context.Context, clientID, programID string) ([]*domain.QuestionnaireScreeningToolResponse, error) {
+				if tt.name == "Sad case: failed to get latest screening tool responses" {
+					return nil, errors.New("an error occurred")
+				}
+				if tt.previousScore == nil {
+					return []*domain.QuestionnaireScreeningToolResponse{}, nil
+				}
+				return []*domain.QuestionnaireScreeningToolResponse{
+					{ID: uuid.NewString(), ScreeningToolID: uuid.NewString(), AggregateScore: 0},
+					{ID: uuid.NewString(), ScreeningToolID: screeningToolID, AggregateScore: *tt.previousScore},
+				}, nil
+			}
+
+			var serviceRequest *dto.ServiceRequestInput
+			fakeDB.MockCreateServiceRequestFn = func(ctx context.Context, serviceRequestInput *dto.ServiceRequestInput) error {
+				serviceRequest = serviceRequestInput
+				return nil
+			}
+
+			_, err := q.RespondToScreeningTool(context.Background(), dto.QuestionnaireScreeningToolResponseInput{
+				ScreeningToolID: screeningToolID,
+				ClientID:        uuid.NewString(),
+				QuestionResponses: []*dto.QuestionnaireScreeningToolQuestionResponseInput{
+					{QuestionID: questionID, Response: tt.response},
+				},
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseQuestionnaireImpl.RespondToScreeningTool() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if (serviceRequest != nil) != tt.wantRedFlag {
+				t.Errorf("expected red flag to be raised: %v, got %v", tt.wantRedFlag, serviceRequest)
+				return
+			}
+			if serviceRequest != nil {
+				if serviceRequest.Meta["previous_score"] != *tt.previousScore {
+					t.Errorf("expected previous score %d, got %v", *tt.previousScore, serviceRequest.Meta["previous_score"])
+				}
+				if serviceRequest.Meta["score_change"] != 3-*tt.previousScore {
+					t.Errorf("expected score change %d, got %v", 3-*tt.previousScore, serviceRequest.Meta["score_change"])
+				}
+			}
+		})
+	}
+}
+
+func TestUseCaseQuestionnaireImpl_UpdateScreeningToolScoreWorseningThreshold(t *testing.T) {
+	threshold := 5
+	invalidThreshold := 0
+
+	tests := []struct {
+		name      string
+		threshold *int
+		wantErr   bool
+	}{
+		{
+			name:      "Happy case: update screening tool score worsening threshold",
+			threshold: &threshold,
+			wantErr:   false,
+		},
+		{
+			name:      "Happy case: stop tracking worsening scores",
+			threshold: nil,
+			wantErr:   false,
+		},
+		{
+			name:      "Sad case: threshold less than one",
+			threshold: &invalidThreshold,
+			wantErr:   true,
+		},
+		{
+			name:      "Sad case: failed to get staff profile",
+			threshold: &threshold,
+			wantErr:   true,
+		},
+		{
+			name:      "Sad case: failed to get screening tool",
+			threshold: &threshold,
+			wantErr:   true,
+		},
+		{
+			name:      "Sad case: screening tool in another program",
+			threshold: &threshold,
+			wantErr:   true,
+		},
+		{
+			name:      "Sad case: failed to update screening tool",
+			threshold: &threshold,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			var updates map[string]interface{}
+			fakeDB.MockUpdateScreeningToolFn = func(ctx context.Context, screeningTool *domain.ScreeningTool, updateData map[string]interface{}) error {
+				updates = updateData
+				return nil
+			}
+
+			if tt.name == "Sad case: failed to get staff profile" {
+				fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID, programID string) (*domain.StaffProfile, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get screening tool" {
+				fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, id string) (*domain.ScreeningTool, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: screening tool in another program" {
+				fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, id string) (*domain.ScreeningTool, error) {
+					return &domain.ScreeningTool{ID: id, ProgramID: uuid.NewString()}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to update screening tool" {
+				fakeDB.MockUpdateScreeningToolFn = func(ctx context.Context, screeningTool *domain.ScreeningTool, updateData map[string]interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := q.UpdateScreeningToolScoreWorseningThreshold(context.Background(), uuid.NewString(), tt.threshold)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseQuestionnaireImpl.UpdateScreeningToolScoreWorseningThreshold() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && updates["score_worsening_threshold"] != tt.threshold {
+				t.Errorf("expected the score worsening threshold to be updated to %v, got %v", tt.threshold, updates["score_worsening_threshold"])
+			}
+		})
+	}
+}
+
+func TestUseCaseQuestionnaireImpl_GetClientScreeningToolScoreTrends(t *testing.T) {
+	screeningToolID := uuid.NewString()
+
+	tests := []struct {
+		name            string
+		screeningToolID *string
+		wantCount       int
+		wantErr         bool
+	}{
+		{
+			name:      "Happy case: get client screening tool score trends",
+			wantCount: 1,
+			wantErr:   false,
+		},
+		{
+			name:            "Happy case: get client score trend for a screening tool",
+			screeningToolID: &screeningToolID,
+			wantCount:       1,
+			wantErr:         false,
+		},
+		{
+			name:      "Happy case: client has not responded to any screening tool",
+			wantCount: 0,
+			wantErr:   false,
+		},
+		{
+			name:    "Sad case: failed to get staff profile",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get client profile",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: client in another program",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to list client screening tool responses",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get screening tool",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			q := questionnaires.NewUseCaseQuestionnaire(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeNotification)
+
+			if tt.name == "Happy case: client has not responded to any screening tool" {
+				fakeDB.MockListClientScreeningToolResponsesFn = func(ctx context.Context, clientID, programID string, screeningToolID *string) ([]*domain.QuestionnaireScreeningToolResponse, error) {
+					return []*domain.QuestionnaireScreeningToolResponse{}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to get staff profile" {
+				fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID, programID string) (*domain.StaffProfile, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get client profile" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: client in another program" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+					return &domain.ClientProfile{ID: &clientID, ProgramID: uuid.NewString(), User: &domain.User{}}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to list client screening tool responses" {
+				fakeDB.MockListClientScreeningToolResponsesFn = func(ctx context.Context, clientID, programID string, screeningToolID *string) ([]*domain.QuestionnaireScreeningToolResponse, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get screening tool" {
+				fakeDB.MockGetScreeningToolByIDFn = func(ctx context.Context, id string) (*domain.ScreeningTool, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := q.GetClientScreeningToolScoreTrends(context.Background(), uuid.NewString(), tt.screeningToolID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCaseQuestionnaireImpl.GetClientScreeningToolScoreTrends() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != tt.wantCount {
+				t.Errorf("expected %d trends, got %d", tt.wantCount, len(got))
+				return
+			}
+			if tt.wantCount > 0 && len(got[0].Points) != 2 {
+				t.Errorf("expected the trend to have 2 points, got %d", len(got[0].Points))
+			}
+		})
+	}
+}
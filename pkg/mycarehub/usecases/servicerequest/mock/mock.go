@@ -24,6 +24,12 @@ type ServiceRequestUseCaseMock struct {
 	MockCreatePinResetServiceRequestFn       func(ctx context.Context, username string, cccNumber string, flavour feedlib.Flavour) (bool, error)
 	MockVerifyStaffPinResetServiceRequestFn  func(ctx context.Context, serviceRequestID string, status enums.PINResetVerificationStatus) (bool, error)
 	MockSearchServiceRequestsFn              func(ctx context.Context, searchTerm string, flavour feedlib.Flavour, requestType string, facilityID string) ([]*domain.ServiceRequest, error)
+	MockEscalateOverdueServiceRequestsFn     func(ctx context.Context) error
+	MockGetServiceRequestSLAMetricsFn        func(ctx context.Context, facilityID string, requestType *string) ([]*domain.ServiceRequestSLAMetric, error)
+	MockAssignServiceRequestFn               func(ctx context.Context, serviceRequestID string, staffID string) (bool, error)
+	MockAddServiceRequestCommentFn           func(ctx context.Context, input *dto.ServiceRequestCommentInput) (*domain.ServiceRequestComment, error)
+	MockListServiceRequestCommentsFn         func(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestComment, error)
+	MockGetServiceRequestHistoryFn           func(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestEvent, error)
 }
 
 // NewServiceRequestUseCaseMock initializes a new service request instance mock
@@ -120,6 +126,65 @@ func NewServiceRequestUseCaseMock() *ServiceRequestUseCaseMock {
 				},
 			}, nil
 		},
+		MockEscalateOverdueServiceRequestsFn: func(ctx context.Context) error {
+			return nil
+		},
+		MockGetServiceRequestSLAMetricsFn: func(ctx context.Context, facilityID string, requestType *string) ([]*domain.ServiceRequestSLAMetric, error) {
+			return []*domain.ServiceRequestSLAMetric{
+				{
+					RequestType:    enums.ServiceRequestTypeRedFlag,
+					SLAMinutes:     60,
+					Total:          4,
+					Breached:       1,
+					Escalated:      1,
+					ComplianceRate: 0.75,
+				},
+			}, nil
+		},
+		MockAssignServiceRequestFn: func(ctx context.Context, serviceRequestID string, staffID string) (bool, error) {
+			return true, nil
+		},
+		MockAddServiceRequestCommentFn: func(ctx context.Context, input *dto.ServiceRequestCommentInput) (*domain.ServiceRequestComment, error) {
+			return &domain.ServiceRequestComment{
+				ID:               uuid.NewString(),
+				ServiceRequestID: input.ServiceRequestID,
+				ParentID:         input.ParentID,
+				StaffID:          uuid.NewString(),
+				StaffName:        "Jane Doe",
+				Comment:          input.Comment,
+				CreatedAt:        time.Now(),
+			}, nil
+		},
+		MockListServiceRequestCommentsFn: func(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestComment, error) {
+			return []*domain.ServiceRequestComment{
+				{
+					ID:               uuid.NewString(),
+					ServiceRequestID: serviceRequestID,
+					StaffID:          uuid.NewString(),
+					StaffName:        "Jane Doe",
+					Comment:          "Called the client",
+					CreatedAt:        time.Now(),
+					Replies:          []*domain.ServiceRequestComment{},
+				},
+			}, nil
+		},
+		MockGetServiceRequestHistoryFn: func(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestEvent, error) {
+			staffID := uuid.NewString()
+			staffName := "Jane Doe"
+			return []*domain.ServiceRequestEvent{
+				{
+					ID:               uuid.NewString(),
+					ServiceRequestID: serviceRequestID,
+					EventType:        enums.ServiceRequestEventTypeAssigned,
+					StaffID:          &staffID,
+					StaffName:        &staffName,
+					Status:           enums.ServiceRequestStatusPending.String(),
+					AssignedToID:     &staffID,
+					AssignedToName:   &staffName,
+					CreatedAt:        time.Now(),
+				},
+			}, nil
+		},
 	}
 }
 
@@ -177,3 +242,33 @@ func (s *ServiceRequestUseCaseMock) VerifyClientPinResetServiceRequest(ctx conte
 func (s *ServiceRequestUseCaseMock) SearchServiceRequests(ctx context.Context, searchTerm string, flavour feedlib.Flavour, requestType string, facilityID string) ([]*domain.ServiceRequest, error) {
 	return s.MockSearchServiceRequestsFn(ctx, searchTerm, flavour, requestType, facilityID)
 }
+
+// EscalateOverdueServiceRequests mocks the implementation of escalating overdue service requests
+func (s *ServiceRequestUseCaseMock) EscalateOverdueServiceRequests(ctx context.Context) error {
+	return s.MockEscalateOverdueServiceRequestsFn(ctx)
+}
+
+// GetServiceRequestSLAMetrics mocks the implementation of getting service request SLA metrics
+func (s *ServiceRequestUseCaseMock) GetServiceRequestSLAMetrics(ctx context.Context, facilityID string, requestType *string) ([]*domain.ServiceRequestSLAMetric, error) {
+	return s.MockGetServiceRequestSLAMetricsFn(ctx, facilityID, requestType)
+}
+
+// AssignServiceRequest mocks the implementation of assigning a service request to a staff member
+func (s *ServiceRequestUseCaseMock) AssignServiceRequest(ctx context.Context, serviceRequestID string, staffID string) (bool, error) {
+	return s.MockAssignServiceRequestFn(ctx, serviceRequestID, staffID)
+}
+
+// AddServiceRequestComment mocks the implementation of commenting on a service request
+func (s *ServiceRequestUseCaseMock) AddServiceRequestComment(ctx context.Context, input *dto.ServiceRequestCommentInput) (*domain.ServiceRequestComment, error) {
+	return s.MockAddServiceRequestCommentFn(ctx, input)
+}
+
+// ListServiceRequestComments mocks the implementation of listing the comments on a service request
+func (s *ServiceRequestUseCaseMock) ListServiceRequestComments(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestComment, error) {
+	return s.MockListServiceRequestCommentsFn(ctx, serviceRequestID)
+}
+
+// GetServiceRequestHistory mocks the implementation of getting the history of a service request
+func (s *ServiceRequestUseCaseMock) GetServiceRequestHistory(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestEvent, error) {
+	return s.MockGetServiceRequestHistoryFn(ctx, serviceRequestID)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/savannahghi/feedlib"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
@@ -67,6 +68,21 @@ type IUpdateServiceRequest interface {
 	UpdateServiceRequestsFromKenyaEMR(ctx context.Context, payload *dto.UpdateServiceRequestsPayload) (bool, error)
 }
 
+// IServiceRequestSLA is the interface holding the method signatures for tracking service requests against their SLAs
+type IServiceRequestSLA interface {
+	EscalateOverdueServiceRequests(ctx context.Context) error
+	GetServiceRequestSLAMetrics(ctx context.Context, facilityID string, requestType *string) ([]*domain.ServiceRequestSLAMetric, error)
+}
+
+// IServiceRequestCollaboration is the interface holding the method signatures used by staff to hand over, discuss
+// and follow the history of service requests
+type IServiceRequestCollaboration interface {
+	AssignServiceRequest(ctx context.Context, serviceRequestID string, staffID string) (bool, error)
+	AddServiceRequestComment(ctx context.Context, input *dto.ServiceRequestCommentInput) (*domain.ServiceRequestComment, error)
+	ListServiceRequestComments(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestComment, error)
+	GetServiceRequestHistory(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestEvent, error)
+}
+
 // UseCaseServiceRequest holds all the interfaces that represent the service request business logic
 type UseCaseServiceRequest interface {
 	ICreateServiceRequest
@@ -74,6 +90,8 @@ type UseCaseServiceRequest interface {
 	ISetInProgresssBy
 	IResolveServiceRequest
 	IUpdateServiceRequest
+	IServiceRequestSLA
+	IServiceRequestCollaboration
 }
 
 // UseCasesServiceRequestImpl embeds the service request logic
@@ -206,7 +224,22 @@ func (u *UseCasesServiceRequestImpl) SetInProgressBy(ctx context.Context, reques
 	if requestID == "" || staffID == "" {
 		return false, fmt.Errorf("request ID or staff ID cannot be empty")
 	}
-	return u.Update.SetInProgressBy(ctx, requestID, staffID)
+	ok, err := u.Update.SetInProgressBy(ctx, requestID, staffID)
+	if err != nil {
+		return false, err
+	}
+
+	err = u.recordServiceRequestEvent(ctx, &domain.ServiceRequestEvent{
+		ServiceRequestID: requestID,
+		EventType:        enums.ServiceRequestEventTypeInProgress,
+		StaffID:          &staffID,
+		Status:           enums.ServiceRequestStatusInProgress.String(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return ok, nil
 }
 
 // GetServiceRequests gets service requests based on the parameters provided
@@ -228,7 +261,28 @@ func (u *UseCasesServiceRequestImpl) GetServiceRequests(
 		}
 	}
 
-	return u.Query.GetServiceRequests(ctx, requestType, requestStatus, facilityID, flavour)
+	serviceRequests, err := u.Query.GetServiceRequests(ctx, requestType, requestStatus, facilityID, flavour)
+	if err != nil {
+		return nil, err
+	}
+
+	applyServiceRequestSLAs(serviceRequests)
+
+	return serviceRequests, nil
+}
+
+// applyServiceRequestSLAs computes the SLA deadline and compliance of service requests whose type has an SLA
+func applyServiceRequestSLAs(serviceRequests []*domain.ServiceRequest) {
+	slas := helpers.GetServiceRequestSLAs()
+	now := time.Now()
+
+	for _, serviceRequest := range serviceRequests {
+		sla, ok := slas[enums.ServiceRequestType(serviceRequest.RequestType)]
+		if !ok {
+			continue
+		}
+		serviceRequest.ApplySLA(sla, now)
+	}
 }
 
 // GetServiceRequestsForKenyaEMR fetches all the most recent service requests  that have not been
@@ -239,6 +293,14 @@ func (u *UseCasesServiceRequestImpl) GetServiceRequestsForKenyaEMR(ctx context.C
 		return nil, err
 	}
 
+	for _, serviceRequest := range serviceRequests {
+		serviceRequest.History, err = u.Query.ListServiceRequestEvents(ctx, serviceRequest.ID)
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			return nil, fmt.Errorf("failed to get service request history: %w", err)
+		}
+	}
+
 	return &dto.RedFlagServiceRequestResponse{
 		RedFlagServiceRequests: serviceRequests,
 	}, nil
@@ -295,6 +357,19 @@ func (u *UseCasesServiceRequestImpl) ResolveServiceRequest(ctx context.Context,
 		return false, fmt.Errorf("failed to update service request: %v", err)
 	}
 
+	event := &domain.ServiceRequestEvent{
+		ServiceRequestID: *serviceRequestID,
+		EventType:        enums.ServiceRequestEventTypeResolved,
+		StaffID:          staffID,
+		Status:           enums.ServiceRequestStatusResolved.String(),
+	}
+	if comment != nil {
+		event.Notes = *comment
+	}
+	if err := u.recordServiceRequestEvent(ctx, event); err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
 
@@ -371,7 +446,24 @@ func (u *UseCasesServiceRequestImpl) UpdateServiceRequestsFromKenyaEMR(ctx conte
 		ServiceRequests: serviceRequests,
 	}
 
-	return u.Update.UpdateServiceRequests(ctx, serviceReq)
+	ok, err := u.Update.UpdateServiceRequests(ctx, serviceReq)
+	if err != nil {
+		return false, err
+	}
+
+	for _, serviceRequest := range serviceRequests {
+		err := u.recordServiceRequestEvent(ctx, &domain.ServiceRequestEvent{
+			ServiceRequestID: serviceRequest.ID,
+			EventType:        enums.ServiceRequestEventTypeSynced,
+			Status:           serviceRequest.Status,
+			Notes:            "Updated from KenyaEMR",
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return ok, nil
 }
 
 // CreatePinResetServiceRequest creates a PIN_RESET service request. This occurs when a user attempts to change
@@ -515,8 +607,14 @@ func (u *UseCasesServiceRequestImpl) VerifyStaffPinResetServiceRequest(ctx conte
 
 	phoneNumber := loggedInUserProfile.Contacts.ContactValue
 
-	return u.VerifyServiceRequestResponse(ctx, status.String(), phoneNumber, serviceRequestID, staffProfile.User, loggedInStaffProfile, feedlib.FlavourPro)
+	ok, err := u.VerifyServiceRequestResponse(ctx, status.String(), phoneNumber, serviceRequestID, staffProfile.User, loggedInStaffProfile, feedlib.FlavourPro)
+	if err != nil {
+		return false, err
+	}
+
+	u.recordPINResetVerification(ctx, serviceRequestID, status, staffProfile.UserID, "STAFF", loggedInUserID, loggedInUserProfile)
 
+	return ok, nil
 }
 
 // VerifyClientPinResetServiceRequest is used to approve/reject a pin reset service request. This is used by the
@@ -574,8 +672,42 @@ func (u *UseCasesServiceRequestImpl) VerifyClientPinResetServiceRequest(ctx cont
 
 	phoneNumber := loggedInUserProfile.Contacts.ContactValue
 
-	return u.VerifyServiceRequestResponse(ctx, status.String(), phoneNumber, serviceRequestID, clientProfile.User, loggedInStaffProfile, feedlib.FlavourConsumer)
+	ok, err := u.VerifyServiceRequestResponse(ctx, status.String(), phoneNumber, serviceRequestID, clientProfile.User, loggedInStaffProfile, feedlib.FlavourConsumer)
+	if err != nil {
+		return false, err
+	}
+
+	u.recordPINResetVerification(ctx, serviceRequestID, status, clientProfile.UserID, "CLIENT", loggedInUserID, loggedInUserProfile)
 
+	return ok, nil
+}
+
+// recordPINResetVerification records the approval or rejection of a PIN reset service request in the audit log
+func (u *UseCasesServiceRequestImpl) recordPINResetVerification(
+	ctx context.Context,
+	serviceRequestID string,
+	status enums.PINResetVerificationStatus,
+	targetUserID, targetType string,
+	actorID string,
+	actor *domain.User,
+) {
+	auditLog := &domain.AuditLog{
+		RecordType: enums.AuditLogRecordTypePINReset,
+		Notes:      fmt.Sprintf("PIN reset service request %s verified", serviceRequestID),
+		ActorID:    actorID,
+		TargetID:   targetUserID,
+		TargetType: targetType,
+		NewValue: map[string]interface{}{
+			"serviceRequestID": serviceRequestID,
+			"status":           status,
+		},
+		OrganisationID: actor.CurrentOrganizationID,
+		ProgramID:      actor.CurrentProgramID,
+	}
+
+	if err := u.Create.CreateAuditLog(ctx, auditLog); err != nil {
+		helpers.ReportErrorToSentry(err)
+	}
 }
 
 // VerifyServiceRequestResponse returns the boolean response indicating whether the processing of a service request is successful or not.
@@ -683,3 +815,382 @@ func (u *UseCasesServiceRequestImpl) SearchServiceRequests(ctx context.Context,
 		return nil, fmt.Errorf("unknown flavour provided")
 	}
 }
+
+// EscalateOverdueServiceRequests notifies the staff at a facility about pending service requests that have not been
+// picked up within the SLA configured for their type. Each service request is escalated only once.
+// There are no facility leads so the escalation goes to every staff member at the service request's facility
+func (u *UseCasesServiceRequestImpl) EscalateOverdueServiceRequests(ctx context.Context) error {
+	now := time.Now()
+
+	var errs error
+	for requestType, sla := range helpers.GetServiceRequestSLAs() {
+		serviceRequests, err := u.Query.ListOverdueServiceRequests(ctx, requestType.String(), now.Add(-sla))
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("failed to list overdue %s service requests: %w", requestType, err))
+			continue
+		}
+
+		for _, serviceRequest := range serviceRequests {
+			err := u.escalateServiceRequest(ctx, serviceRequest, requestType, sla, now)
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("failed to escalate service request %s: %w", serviceRequest.ID, err))
+			}
+		}
+	}
+
+	if errs != nil {
+		helpers.ReportErrorToSentry(errs)
+	}
+
+	return errs
+}
+
+// escalateServiceRequest notifies all the facility staff about a single overdue service request and then marks it as escalated.
+// The facility staff are notified first so that a service request whose notification fails is retried on the next run
+func (u *UseCasesServiceRequestImpl) escalateServiceRequest(
+	ctx context.Context,
+	serviceRequest *domain.ServiceRequest,
+	requestType enums.ServiceRequestType,
+	sla time.Duration,
+	now time.Time,
+) error {
+	client, err := u.Query.GetClientProfileByClientID(ctx, serviceRequest.ClientID)
+	if err != nil {
+		return fmt.Errorf("failed to get client profile: %w", err)
+	}
+
+	message := notification.ComposeStaffNotification(
+		enums.NotificationTypeServiceRequestEscalation,
+		notification.StaffNotificationArgs{
+			Subject:            client.User,
+			ServiceRequestType: &requestType,
+			ServiceRequestSLA:  &sla,
+		},
+	)
+	err = u.Notification.NotifyFacilityStaffs(ctx, &domain.Facility{ID: &serviceRequest.FacilityID}, message)
+	if err != nil {
+		return fmt.Errorf("failed to notify facility staff: %w", err)
+	}
+
+	escalated, err := u.Update.MarkServiceRequestEscalated(ctx, serviceRequest.ID, now)
+	if err != nil {
+		return err
+	}
+	if !escalated {
+		return nil
+	}
+
+	return u.recordServiceRequestEvent(ctx, &domain.ServiceRequestEvent{
+		ServiceRequestID: serviceRequest.ID,
+		EventType:        enums.ServiceRequestEventTypeEscalated,
+		Status:           serviceRequest.Status,
+		Notes:            fmt.Sprintf("Not picked up within %s", sla),
+	})
+}
+
+// GetServiceRequestSLAMetrics summarises, per request type, how many of a facility's client service requests were picked up
+// within their SLA
+func (u *UseCasesServiceRequestImpl) GetServiceRequestSLAMetrics(ctx context.Context, facilityID string, requestType *string) ([]*domain.ServiceRequestSLAMetric, error) {
+	if requestType != nil && !enums.ServiceRequestType(*requestType).IsValid() {
+		return nil, exceptions.InputValidationErr(fmt.Errorf("invalid request type: %v", *requestType))
+	}
+
+	serviceRequests, err := u.Query.GetServiceRequests(ctx, requestType, nil, facilityID, feedlib.FlavourConsumer)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get service requests: %w", err)
+	}
+
+	slas := helpers.GetServiceRequestSLAs()
+	now := time.Now()
+
+	metricsByType := map[enums.ServiceRequestType]*domain.ServiceRequestSLAMetric{}
+	for _, serviceRequest := range serviceRequests {
+		serviceRequestType := enums.ServiceRequestType(serviceRequest.RequestType)
+		sla, ok := slas[serviceRequestType]
+		if !ok {
+			continue
+		}
+		serviceRequest.ApplySLA(sla, now)
+
+		metric, ok := metricsByType[serviceRequestType]
+		if !ok {
+			metric = &domain.ServiceRequestSLAMetric{
+				RequestType: serviceRequestType,
+				SLAMinutes:  int(sla.Minutes()),
+			}
+			metricsByType[serviceRequestType] = metric
+		}
+
+		metric.Total++
+		if serviceRequest.SLABreached {
+			metric.Breached++
+		}
+		// service requests that were marked as escalated when escalation was rolled out were never escalated
+		if serviceRequest.EscalatedAt != nil && !serviceRequest.EscalationBackfilled {
+			metric.Escalated++
+		}
+	}
+
+	metrics := []*domain.ServiceRequestSLAMetric{}
+	for _, serviceRequestType := range enums.AllServiceRequestType {
+		metric, ok := metricsByType[serviceRequestType]
+		if !ok {
+			continue
+		}
+		metric.ComplianceRate = float64(metric.Total-metric.Breached) / float64(metric.Total)
+		metrics = append(metrics, metric)
+	}
+
+	return metrics, nil
+}
+
+// AssignServiceRequest hands a client's service request over to a staff member of the service request's program who
+// works at its facility. Resolved service requests cannot be reassigned
+func (u *UseCasesServiceRequestImpl) AssignServiceRequest(ctx context.Context, serviceRequestID string, staffID string) (bool, error) {
+	loggedInStaff, err := u.getLoggedInStaffProfile(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	serviceRequest, err := u.Query.GetClientServiceRequestByID(ctx, serviceRequestID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to get service request: %w", err)
+	}
+
+	if serviceRequest.Status == enums.ServiceRequestStatusResolved.String() {
+		return false, exceptions.InputValidationErr(fmt.Errorf("a resolved service request cannot be assigned"))
+	}
+
+	if serviceRequest.AssignedTo != nil && *serviceRequest.AssignedTo == staffID {
+		return true, nil
+	}
+
+	assignee, err := u.Query.GetStaffProfileByStaffID(ctx, staffID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.StaffProfileNotFoundErr(err)
+	}
+
+	handles, err := u.staffHandlesServiceRequest(ctx, assignee, serviceRequest)
+	if err != nil {
+		return false, err
+	}
+	if !handles {
+		return false, exceptions.InputValidationErr(fmt.Errorf("staff %s does not belong to the service request's program and facility", staffID))
+	}
+
+	updates := map[string]interface{}{
+		"assigned_to_id": staffID,
+		"assigned_at":    time.Now(),
+	}
+	err = u.Update.UpdateClientServiceRequest(ctx, serviceRequest, updates)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to assign service request: %w", err)
+	}
+
+	eventType := enums.ServiceRequestEventTypeAssigned
+	if serviceRequest.AssignedTo != nil {
+		eventType = enums.ServiceRequestEventTypeReassigned
+	}
+	err = u.recordServiceRequestEvent(ctx, &domain.ServiceRequestEvent{
+		ServiceRequestID: serviceRequestID,
+		EventType:        eventType,
+		StaffID:          loggedInStaff.ID,
+		Status:           serviceRequest.Status,
+		AssignedToID:     &staffID,
+		Notes:            fmt.Sprintf("Assigned to %s", assignee.User.Name),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// AddServiceRequestComment leaves an internal comment on a client's service request. A comment can reply to another
+// comment on the same service request. Only staff of the service request's program and facility can comment on it
+func (u *UseCasesServiceRequestImpl) AddServiceRequestComment(ctx context.Context, input *dto.ServiceRequestCommentInput) (*domain.ServiceRequestComment, error) {
+	if err := input.Validate(); err != nil {
+		return nil, exceptions.InputValidationErr(err)
+	}
+
+	loggedInStaff, serviceRequest, err := u.getStaffServiceRequest(ctx, input.ServiceRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ParentID != nil {
+		comments, err := u.Query.ListServiceRequestComments(ctx, serviceRequest.ID)
+		if err != nil {
+			helpers.ReportErrorToSentry(err)
+			return nil, fmt.Errorf("failed to get service request comments: %w", err)
+		}
+
+		found := false
+		for _, comment := range comments {
+			if comment.ID == *input.ParentID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, exceptions.InputValidationErr(fmt.Errorf("comment %s was not found on service request %s", *input.ParentID, serviceRequest.ID))
+		}
+	}
+
+	comment, err := u.Create.CreateServiceRequestComment(ctx, &domain.ServiceRequestComment{
+		ServiceRequestID: serviceRequest.ID,
+		ParentID:         input.ParentID,
+		StaffID:          *loggedInStaff.ID,
+		Comment:          input.Comment,
+	})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to create service request comment: %w", err)
+	}
+	comment.StaffName = loggedInStaff.User.Name
+
+	notes := "Commented on the service request"
+	if input.ParentID != nil {
+		notes = "Replied to a comment on the service request"
+	}
+	err = u.recordServiceRequestEvent(ctx, &domain.ServiceRequestEvent{
+		ServiceRequestID: serviceRequest.ID,
+		EventType:        enums.ServiceRequestEventTypeCommented,
+		StaffID:          loggedInStaff.ID,
+		Status:           serviceRequest.Status,
+		Notes:            notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// ListServiceRequestComments returns the comment threads on a service request. Replies are nested under the comment
+// they respond to. Only staff of the service request's program and facility can read them
+func (u *UseCasesServiceRequestImpl) ListServiceRequestComments(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestComment, error) {
+	_, serviceRequest, err := u.getStaffServiceRequest(ctx, serviceRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := u.Query.ListServiceRequestComments(ctx, serviceRequest.ID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get service request comments: %w", err)
+	}
+
+	commentsByID := map[string]*domain.ServiceRequestComment{}
+	threads := []*domain.ServiceRequestComment{}
+	for _, comment := range comments {
+		comment.Replies = []*domain.ServiceRequestComment{}
+		commentsByID[comment.ID] = comment
+
+		if comment.ParentID != nil {
+			if parent, ok := commentsByID[*comment.ParentID]; ok {
+				parent.Replies = append(parent.Replies, comment)
+				continue
+			}
+		}
+		threads = append(threads, comment)
+	}
+
+	return threads, nil
+}
+
+// GetServiceRequestHistory returns the actions taken on a service request in the order in which they happened.
+// Only staff of the service request's program and facility can read it
+func (u *UseCasesServiceRequestImpl) GetServiceRequestHistory(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestEvent, error) {
+	_, serviceRequest, err := u.getStaffServiceRequest(ctx, serviceRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := u.Query.ListServiceRequestEvents(ctx, serviceRequest.ID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get service request history: %w", err)
+	}
+
+	return events, nil
+}
+
+// getLoggedInStaffProfile returns the staff profile of the logged in user in their current program
+func (u *UseCasesServiceRequestImpl) getLoggedInStaffProfile(ctx context.Context) (*domain.StaffProfile, error) {
+	loggedInUserID, err := u.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.GetLoggedInUserUIDErr(err)
+	}
+
+	userProfile, err := u.Query.GetUserProfileByUserID(ctx, loggedInUserID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.UserNotFoundError(err)
+	}
+
+	staffProfile, err := u.Query.GetStaffProfile(ctx, loggedInUserID, userProfile.CurrentProgramID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, exceptions.StaffProfileNotFoundErr(err)
+	}
+	staffProfile.User = userProfile
+
+	return staffProfile, nil
+}
+
+// getStaffServiceRequest returns the logged in staff member and the client service request they are working on.
+// The staff member must belong to the service request's program and facility
+func (u *UseCasesServiceRequestImpl) getStaffServiceRequest(ctx context.Context, serviceRequestID string) (*domain.StaffProfile, *domain.ServiceRequest, error) {
+	loggedInStaff, err := u.getLoggedInStaffProfile(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serviceRequest, err := u.Query.GetClientServiceRequestByID(ctx, serviceRequestID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, nil, fmt.Errorf("failed to get service request: %w", err)
+	}
+
+	handles, err := u.staffHandlesServiceRequest(ctx, loggedInStaff, serviceRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !handles {
+		err := fmt.Errorf("staff does not belong to the program and facility of service request %s", serviceRequestID)
+		helpers.ReportErrorToSentry(err)
+		return nil, nil, exceptions.UserNotAuthorizedErr(err)
+	}
+
+	return loggedInStaff, serviceRequest, nil
+}
+
+// staffHandlesServiceRequest checks whether a staff member belongs to the program and facility of a service request
+func (u *UseCasesServiceRequestImpl) staffHandlesServiceRequest(ctx context.Context, staff *domain.StaffProfile, serviceRequest *domain.ServiceRequest) (bool, error) {
+	if staff.ProgramID != serviceRequest.ProgramID {
+		return false, nil
+	}
+
+	facilities, _, err := u.Query.GetStaffFacilities(ctx, dto.StaffFacilityInput{StaffID: staff.ID, FacilityID: &serviceRequest.FacilityID}, nil)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to get staff facilities: %w", err)
+	}
+
+	return len(facilities) > 0, nil
+}
+
+// recordServiceRequestEvent appends an action to the history of a service request
+func (u *UseCasesServiceRequestImpl) recordServiceRequestEvent(ctx context.Context, event *domain.ServiceRequestEvent) error {
+	if err := u.Create.CreateServiceRequestEvent(ctx, event); err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("failed to record service request event: %w", err)
+	}
+	return nil
+}
@@ -3,6 +3,8 @@ package servicerequest_test
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -1770,3 +1772,911 @@ func TestUseCasesServiceRequestImpl_SearchServiceRequests(t *testing.T) {
 		})
 	}
 }
+
+func TestUseCasesServiceRequestImpl_EscalateOverdueServiceRequests(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		wantErr       bool
+		wantNotified  bool
+		wantEscalated bool
+	}{
+		{
+			name:          "Happy case: escalate overdue service requests",
+			wantErr:       false,
+			wantNotified:  true,
+			wantEscalated: true,
+		},
+		{
+			name:          "Happy case: service request already escalated",
+			wantErr:       false,
+			wantNotified:  true,
+			wantEscalated: true,
+		},
+		{
+			name:          "Sad case: failed to list overdue service requests",
+			wantErr:       true,
+			wantNotified:  false,
+			wantEscalated: false,
+		},
+		{
+			name:          "Sad case: failed to get client profile",
+			wantErr:       true,
+			wantNotified:  false,
+			wantEscalated: false,
+		},
+		{
+			name:          "Sad case: failed to mark service request as escalated",
+			wantErr:       true,
+			wantNotified:  true,
+			wantEscalated: true,
+		},
+		{
+			name:          "Sad case: failed to notify facility staff",
+			wantErr:       true,
+			wantNotified:  true,
+			wantEscalated: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeUser := userMock.NewUserUseCaseMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMS := smsMock.NewSMSServiceMock()
+			u := servicerequest.NewUseCaseServiceRequestImpl(fakeDB, fakeDB, fakeDB, fakeExtension, fakeUser, fakeNotification, fakeSMS)
+
+			t.Setenv("SERVICE_REQUEST_SLAS", "")
+
+			escalated := false
+			fakeDB.MockMarkServiceRequestEscalatedFn = func(ctx context.Context, serviceRequestID string, escalatedAt time.Time) (bool, error) {
+				escalated = true
+				return true, nil
+			}
+			notified := false
+			fakeNotification.MockNotifyFacilityStaffsFn = func(ctx context.Context, facility *domain.Facility, notificationPayload *domain.Notification) error {
+				notified = true
+				if notificationPayload.Type != enums.NotificationTypeServiceRequestEscalation {
+					t.Errorf("expected an escalation notification, got %v", notificationPayload.Type)
+				}
+				return nil
+			}
+
+			if tt.name == "Happy case: service request already escalated" {
+				fakeDB.MockMarkServiceRequestEscalatedFn = func(ctx context.Context, serviceRequestID string, escalatedAt time.Time) (bool, error) {
+					escalated = true
+					return false, nil
+				}
+			}
+			if tt.name == "Sad case: failed to list overdue service requests" {
+				fakeDB.MockListOverdueServiceRequestsFn = func(ctx context.Context, requestType string, createdBefore time.Time) ([]*domain.ServiceRequest, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get client profile" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to mark service request as escalated" {
+				fakeDB.MockMarkServiceRequestEscalatedFn = func(ctx context.Context, serviceRequestID string, escalatedAt time.Time) (bool, error) {
+					escalated = true
+					return false, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to notify facility staff" {
+				fakeNotification.MockNotifyFacilityStaffsFn = func(ctx context.Context, facility *domain.Facility, notificationPayload *domain.Notification) error {
+					notified = true
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			err := u.EscalateOverdueServiceRequests(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesServiceRequestImpl.EscalateOverdueServiceRequests() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if notified != tt.wantNotified {
+				t.Errorf("expected notified to be %v, got %v", tt.wantNotified, notified)
+			}
+			if escalated != tt.wantEscalated {
+				t.Errorf("expected escalated to be %v, got %v", tt.wantEscalated, escalated)
+			}
+		})
+	}
+}
+
+func TestUseCasesServiceRequestImpl_GetServiceRequestSLAMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	redFlag := enums.ServiceRequestTypeRedFlag.String()
+	invalidType := "INVALID"
+	now := time.Now()
+	pickedUpInTime := now.Add(-150 * time.Minute)
+	escalatedAt := now.Add(-30 * time.Minute)
+
+	type args struct {
+		ctx         context.Context
+		facilityID  string
+		requestType *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []*domain.ServiceRequestSLAMetric
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get service request SLA metrics",
+			args: args{
+				ctx:         ctx,
+				facilityID:  uuid.NewString(),
+				requestType: &redFlag,
+			},
+			want: []*domain.ServiceRequestSLAMetric{
+				{
+					RequestType:    enums.ServiceRequestTypeRedFlag,
+					SLAMinutes:     60,
+					Total:          3,
+					Breached:       2,
+					Escalated:      1,
+					ComplianceRate: 1.0 / 3,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid request type",
+			args: args{
+				ctx:         ctx,
+				facilityID:  uuid.NewString(),
+				requestType: &invalidType,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get service requests",
+			args: args{
+				ctx:        ctx,
+				facilityID: uuid.NewString(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeUser := userMock.NewUserUseCaseMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMS := smsMock.NewSMSServiceMock()
+			u := servicerequest.NewUseCaseServiceRequestImpl(fakeDB, fakeDB, fakeDB, fakeExtension, fakeUser, fakeNotification, fakeSMS)
+
+			t.Setenv("SERVICE_REQUEST_SLAS", "RED_FLAG:1h")
+
+			fakeDB.MockGetServiceRequestsFn = func(ctx context.Context, requestType, requestStatus *string, facilityID string, flavour feedlib.Flavour) ([]*domain.ServiceRequest, error) {
+				return []*domain.ServiceRequest{
+					{
+						ID:           uuid.NewString(),
+						RequestType:  redFlag,
+						Status:       enums.ServiceRequestStatusInProgress.String(),
+						CreatedAt:    now.Add(-3 * time.Hour),
+						InProgressAt: &pickedUpInTime,
+					},
+					{
+						ID:          uuid.NewString(),
+						RequestType: redFlag,
+						Status:      enums.ServiceRequestStatusPending.String(),
+						CreatedAt:   now.Add(-2 * time.Hour),
+						EscalatedAt: &escalatedAt,
+					},
+					{
+						ID:                   uuid.NewString(),
+						RequestType:          redFlag,
+						Status:               enums.ServiceRequestStatusPending.String(),
+						CreatedAt:            now.Add(-5 * time.Hour),
+						EscalatedAt:          &escalatedAt,
+						EscalationBackfilled: true,
+					},
+				}, nil
+			}
+
+			if tt.name == "Sad case: failed to get service requests" {
+				fakeDB.MockGetServiceRequestsFn = func(ctx context.Context, requestType, requestStatus *string, facilityID string, flavour feedlib.Flavour) ([]*domain.ServiceRequest, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.GetServiceRequestSLAMetrics(tt.args.ctx, tt.args.facilityID, tt.args.requestType)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesServiceRequestImpl.GetServiceRequestSLAMetrics() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) && !tt.wantErr {
+				t.Errorf("UseCasesServiceRequestImpl.GetServiceRequestSLAMetrics() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUseCasesServiceRequestImpl_AssignServiceRequest(t *testing.T) {
+	ctx := context.Background()
+
+	programID := uuid.NewString()
+	facilityID := uuid.NewString()
+
+	type args struct {
+		ctx              context.Context
+		serviceRequestID string
+		staffID          string
+	}
+	tests := []struct {
+		name          string
+		args          args
+		want          bool
+		wantErr       bool
+		wantEventType enums.ServiceRequestEventType
+	}{
+		{
+			name: "Happy case: assign service request",
+			args: args{
+				ctx:              ctx,
+				serviceRequestID: uuid.NewString(),
+				staffID:          uuid.NewString(),
+			},
+			want:          true,
+			wantErr:       false,
+			wantEventType: enums.ServiceRequestEventTypeAssigned,
+		},
+		{
+			name: "Happy case: reassign service request",
+			args: args{
+				ctx:              ctx,
+				serviceRequestID: uuid.NewString(),
+				staffID:          uuid.NewString(),
+			},
+			want:          true,
+			wantErr:       false,
+			wantEventType: enums.ServiceRequestEventTypeReassigned,
+		},
+		{
+			name: "Happy case: service request already assigned to the staff",
+			args: args{
+				ctx:              ctx,
+				serviceRequestID: uuid.NewString(),
+				staffID:          uuid.NewString(),
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Sad case: failed to get logged in user",
+			args: args{
+				ctx:              ctx,
+				serviceRequestID: uuid.NewString(),
+				staffID:          uuid.NewString(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get logged in staff profile",
+			args: args{
+				ctx:              ctx,
+				serviceRequestID: uuid.NewString(),
+				staffID:          uuid.NewString(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get service request",
+			args: args{
+				ctx:              ctx,
+				serviceRequestID: uuid.NewString(),
+				staffID:          uuid.NewString(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: service request is resolved",
+			args: args{
+				ctx:              ctx,
+				serviceRequestID: uuid.NewString(),
+				staffID:          uuid.NewString(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: assignee not found",
+			args: args{
+				ctx:              ctx,
+				serviceRequestID: uuid.NewString(),
+				staffID:          uuid.NewString(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: assignee belongs to another program",
+			args: args{
+				ctx:              ctx,
+				serviceRequestID: uuid.NewString(),
+				staffID:          uuid.NewString(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: assignee does not work at the service request's facility",
+			args: args{
+				ctx:              ctx,
+				serviceRequestID: uuid.NewString(),
+				staffID:          uuid.NewString(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get assignee facilities",
+			args: args{
+				ctx:              ctx,
+				serviceRequestID: uuid.NewString(),
+				staffID:          uuid.NewString(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to update service request",
+			args: args{
+				ctx:              ctx,
+				serviceRequestID: uuid.NewString(),
+				staffID:          uuid.NewString(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeUser := userMock.NewUserUseCaseMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMS := smsMock.NewSMSServiceMock()
+			u := servicerequest.NewUseCaseServiceRequestImpl(fakeDB, fakeDB, fakeDB, fakeExtension, fakeUser, fakeNotification, fakeSMS)
+
+			var recorded *domain.ServiceRequestEvent
+			fakeDB.MockCreateServiceRequestEventFn = func(ctx context.Context, event *domain.ServiceRequestEvent) error {
+				recorded = event
+				return nil
+			}
+			fakeDB.MockGetClientServiceRequestByIDFn = func(ctx context.Context, serviceRequestID string) (*domain.ServiceRequest, error) {
+				return &domain.ServiceRequest{
+					ID:         serviceRequestID,
+					Status:     enums.ServiceRequestStatusPending.String(),
+					FacilityID: facilityID,
+					ProgramID:  programID,
+				}, nil
+			}
+			fakeDB.MockGetStaffProfileByStaffIDFn = func(ctx context.Context, staffID string) (*domain.StaffProfile, error) {
+				return &domain.StaffProfile{
+					ID:        &staffID,
+					User:      &domain.User{Name: gofakeit.Name()},
+					ProgramID: programID,
+				}, nil
+			}
+			fakeDB.MockGetStaffFacilitiesFn = func(ctx context.Context, input dto.StaffFacilityInput, pagination *domain.Pagination) ([]*domain.Facility, *domain.Pagination, error) {
+				if input.StaffID == nil || *input.StaffID != tt.args.staffID || input.FacilityID == nil || *input.FacilityID != facilityID {
+					return []*domain.Facility{}, nil, nil
+				}
+				return []*domain.Facility{{ID: &facilityID}}, nil, nil
+			}
+
+			if tt.name == "Happy case: reassign service request" {
+				fakeDB.MockGetClientServiceRequestByIDFn = func(ctx context.Context, serviceRequestID string) (*domain.ServiceRequest, error) {
+					assignedTo := uuid.NewString()
+					return &domain.ServiceRequest{
+						ID:         serviceRequestID,
+						Status:     enums.ServiceRequestStatusInProgress.String(),
+						AssignedTo: &assignedTo,
+						FacilityID: facilityID,
+						ProgramID:  programID,
+					}, nil
+				}
+			}
+			if tt.name == "Happy case: service request already assigned to the staff" {
+				fakeDB.MockGetClientServiceRequestByIDFn = func(ctx context.Context, serviceRequestID string) (*domain.ServiceRequest, error) {
+					return &domain.ServiceRequest{
+						ID:         serviceRequestID,
+						Status:     enums.ServiceRequestStatusPending.String(),
+						AssignedTo: &tt.args.staffID,
+					}, nil
+				}
+				fakeDB.MockUpdateClientServiceRequestFn = func(ctx context.Context, serviceRequest *domain.ServiceRequest, updateData map[string]interface{}) error {
+					t.Errorf("did not expect the service request to be updated")
+					return nil
+				}
+			}
+			if tt.name == "Sad case: failed to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get logged in staff profile" {
+				fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID string, programID string) (*domain.StaffProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get service request" {
+				fakeDB.MockGetClientServiceRequestByIDFn = func(ctx context.Context, serviceRequestID string) (*domain.ServiceRequest, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: service request is resolved" {
+				fakeDB.MockGetClientServiceRequestByIDFn = func(ctx context.Context, serviceRequestID string) (*domain.ServiceRequest, error) {
+					return &domain.ServiceRequest{
+						ID:     serviceRequestID,
+						Status: enums.ServiceRequestStatusResolved.String(),
+					}, nil
+				}
+			}
+			if tt.name == "Sad case: assignee not found" {
+				fakeDB.MockGetStaffProfileByStaffIDFn = func(ctx context.Context, staffID string) (*domain.StaffProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: assignee belongs to another program" {
+				fakeDB.MockGetStaffProfileByStaffIDFn = func(ctx context.Context, staffID string) (*domain.StaffProfile, error) {
+					return &domain.StaffProfile{
+						ID:        &staffID,
+						User:      &domain.User{Name: gofakeit.Name()},
+						ProgramID: uuid.NewString(),
+					}, nil
+				}
+			}
+			if tt.name == "Sad case: assignee does not work at the service request's facility" {
+				fakeDB.MockGetStaffFacilitiesFn = func(ctx context.Context, input dto.StaffFacilityInput, pagination *domain.Pagination) ([]*domain.Facility, *domain.Pagination, error) {
+					return []*domain.Facility{}, nil, nil
+				}
+			}
+			if tt.name == "Sad case: failed to get assignee facilities" {
+				fakeDB.MockGetStaffFacilitiesFn = func(ctx context.Context, input dto.StaffFacilityInput, pagination *domain.Pagination) ([]*domain.Facility, *domain.Pagination, error) {
+					return nil, nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if strings.HasPrefix(tt.name, "Sad case: assignee") || tt.name == "Sad case: failed to get assignee facilities" {
+				fakeDB.MockUpdateClientServiceRequestFn = func(ctx context.Context, serviceRequest *domain.ServiceRequest, updateData map[string]interface{}) error {
+					t.Errorf("did not expect the service request to be updated")
+					return nil
+				}
+			}
+			if tt.name == "Sad case: failed to update service request" {
+				fakeDB.MockUpdateClientServiceRequestFn = func(ctx context.Context, serviceRequest *domain.ServiceRequest, updateData map[string]interface{}) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.AssignServiceRequest(tt.args.ctx, tt.args.serviceRequestID, tt.args.staffID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesServiceRequestImpl.AssignServiceRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("UseCasesServiceRequestImpl.AssignServiceRequest() = %v, want %v", got, tt.want)
+			}
+			if tt.wantEventType != "" {
+				if recorded == nil || recorded.EventType != tt.wantEventType || *recorded.AssignedToID != tt.args.staffID {
+					t.Errorf("expected a %v event assigning the request to %v, got %+v", tt.wantEventType, tt.args.staffID, recorded)
+				}
+			}
+		})
+	}
+}
+
+func TestUseCasesServiceRequestImpl_AddServiceRequestComment(t *testing.T) {
+	ctx := context.Background()
+
+	parentID := uuid.NewString()
+	missingParentID := uuid.NewString()
+
+	type args struct {
+		ctx   context.Context
+		input *dto.ServiceRequestCommentInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: comment on a service request",
+			args: args{
+				ctx: ctx,
+				input: &dto.ServiceRequestCommentInput{
+					ServiceRequestID: uuid.NewString(),
+					Comment:          "Called the client, they will come in tomorrow",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: staff is not in the service request's facility",
+			args: args{
+				ctx: ctx,
+				input: &dto.ServiceRequestCommentInput{
+					ServiceRequestID: uuid.NewString(),
+					Comment:          "Called the client, they will come in tomorrow",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: staff is not in the service request's program",
+			args: args{
+				ctx: ctx,
+				input: &dto.ServiceRequestCommentInput{
+					ServiceRequestID: uuid.NewString(),
+					Comment:          "Called the client, they will come in tomorrow",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to record the comment in the service request history",
+			args: args{
+				ctx: ctx,
+				input: &dto.ServiceRequestCommentInput{
+					ServiceRequestID: uuid.NewString(),
+					Comment:          "Called the client, they will come in tomorrow",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Happy case: reply to a comment",
+			args: args{
+				ctx: ctx,
+				input: &dto.ServiceRequestCommentInput{
+					ServiceRequestID: uuid.NewString(),
+					ParentID:         &parentID,
+					Comment:          "Thanks, I will follow up",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid input",
+			args: args{
+				ctx: ctx,
+				input: &dto.ServiceRequestCommentInput{
+					ServiceRequestID: uuid.NewString(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get logged in staff profile",
+			args: args{
+				ctx: ctx,
+				input: &dto.ServiceRequestCommentInput{
+					ServiceRequestID: uuid.NewString(),
+					Comment:          "Called the client",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get service request",
+			args: args{
+				ctx: ctx,
+				input: &dto.ServiceRequestCommentInput{
+					ServiceRequestID: uuid.NewString(),
+					Comment:          "Called the client",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to list comments",
+			args: args{
+				ctx: ctx,
+				input: &dto.ServiceRequestCommentInput{
+					ServiceRequestID: uuid.NewString(),
+					ParentID:         &parentID,
+					Comment:          "Thanks, I will follow up",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: parent comment not found",
+			args: args{
+				ctx: ctx,
+				input: &dto.ServiceRequestCommentInput{
+					ServiceRequestID: uuid.NewString(),
+					ParentID:         &missingParentID,
+					Comment:          "Thanks, I will follow up",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create comment",
+			args: args{
+				ctx: ctx,
+				input: &dto.ServiceRequestCommentInput{
+					ServiceRequestID: uuid.NewString(),
+					Comment:          "Called the client",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeUser := userMock.NewUserUseCaseMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMS := smsMock.NewSMSServiceMock()
+			u := servicerequest.NewUseCaseServiceRequestImpl(fakeDB, fakeDB, fakeDB, fakeExtension, fakeUser, fakeNotification, fakeSMS)
+
+			programID := uuid.NewString()
+			facilityID := uuid.NewString()
+			fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID string, currentProgramID string) (*domain.StaffProfile, error) {
+				staffID := uuid.NewString()
+				return &domain.StaffProfile{ID: &staffID, UserID: userID, ProgramID: programID}, nil
+			}
+			fakeDB.MockGetClientServiceRequestByIDFn = func(ctx context.Context, serviceRequestID string) (*domain.ServiceRequest, error) {
+				return &domain.ServiceRequest{
+					ID:         serviceRequestID,
+					Status:     enums.ServiceRequestStatusPending.String(),
+					FacilityID: facilityID,
+					ProgramID:  programID,
+				}, nil
+			}
+			if tt.name == "Sad case: staff is not in the service request's facility" {
+				fakeDB.MockGetStaffFacilitiesFn = func(ctx context.Context, input dto.StaffFacilityInput, pagination *domain.Pagination) ([]*domain.Facility, *domain.Pagination, error) {
+					return []*domain.Facility{}, nil, nil
+				}
+			}
+			if tt.name == "Sad case: staff is not in the service request's program" {
+				fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID string, currentProgramID string) (*domain.StaffProfile, error) {
+					staffID := uuid.NewString()
+					return &domain.StaffProfile{ID: &staffID, UserID: userID, ProgramID: uuid.NewString()}, nil
+				}
+			}
+
+			fakeDB.MockListServiceRequestCommentsFn = func(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestComment, error) {
+				return []*domain.ServiceRequestComment{
+					{
+						ID:               parentID,
+						ServiceRequestID: serviceRequestID,
+						Comment:          "Called the client",
+					},
+				}, nil
+			}
+			var recorded *domain.ServiceRequestEvent
+			fakeDB.MockCreateServiceRequestEventFn = func(ctx context.Context, event *domain.ServiceRequestEvent) error {
+				recorded = event
+				return nil
+			}
+			if tt.name == "Sad case: failed to record the comment in the service request history" {
+				fakeDB.MockCreateServiceRequestEventFn = func(ctx context.Context, event *domain.ServiceRequestEvent) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to get logged in staff profile" {
+				fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID string, programID string) (*domain.StaffProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get service request" {
+				fakeDB.MockGetClientServiceRequestByIDFn = func(ctx context.Context, serviceRequestID string) (*domain.ServiceRequest, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to list comments" {
+				fakeDB.MockListServiceRequestCommentsFn = func(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestComment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to create comment" {
+				fakeDB.MockCreateServiceRequestCommentFn = func(ctx context.Context, comment *domain.ServiceRequestComment) (*domain.ServiceRequestComment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.AddServiceRequestComment(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesServiceRequestImpl.AddServiceRequestComment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got == nil || got.StaffName == "" {
+				t.Errorf("expected a comment with the staff name, got %+v", got)
+			}
+			if recorded == nil || recorded.EventType != enums.ServiceRequestEventTypeCommented {
+				t.Errorf("expected a comment event to be recorded, got %+v", recorded)
+			}
+		})
+	}
+}
+
+func TestUseCasesServiceRequestImpl_ListServiceRequestComments(t *testing.T) {
+	ctx := context.Background()
+
+	serviceRequestID := uuid.NewString()
+	commentID := uuid.NewString()
+	replyID := uuid.NewString()
+	otherCommentID := uuid.NewString()
+
+	tests := []struct {
+		name        string
+		wantThreads int
+		wantReplies int
+		wantErr     bool
+	}{
+		{
+			name:        "Happy case: list comment threads",
+			wantThreads: 2,
+			wantReplies: 1,
+			wantErr:     false,
+		},
+		{
+			name:    "Sad case: failed to list comments",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: staff is not in the service request's facility",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: staff is not in the service request's program",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeUser := userMock.NewUserUseCaseMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMS := smsMock.NewSMSServiceMock()
+			u := servicerequest.NewUseCaseServiceRequestImpl(fakeDB, fakeDB, fakeDB, fakeExtension, fakeUser, fakeNotification, fakeSMS)
+
+			programID := uuid.NewString()
+			facilityID := uuid.NewString()
+			fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID string, currentProgramID string) (*domain.StaffProfile, error) {
+				staffID := uuid.NewString()
+				return &domain.StaffProfile{ID: &staffID, UserID: userID, ProgramID: programID}, nil
+			}
+			fakeDB.MockGetClientServiceRequestByIDFn = func(ctx context.Context, serviceRequestID string) (*domain.ServiceRequest, error) {
+				return &domain.ServiceRequest{
+					ID:         serviceRequestID,
+					Status:     enums.ServiceRequestStatusPending.String(),
+					FacilityID: facilityID,
+					ProgramID:  programID,
+				}, nil
+			}
+			if tt.name == "Sad case: staff is not in the service request's facility" {
+				fakeDB.MockGetStaffFacilitiesFn = func(ctx context.Context, input dto.StaffFacilityInput, pagination *domain.Pagination) ([]*domain.Facility, *domain.Pagination, error) {
+					return []*domain.Facility{}, nil, nil
+				}
+			}
+			if tt.name == "Sad case: staff is not in the service request's program" {
+				fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID string, currentProgramID string) (*domain.StaffProfile, error) {
+					staffID := uuid.NewString()
+					return &domain.StaffProfile{ID: &staffID, UserID: userID, ProgramID: uuid.NewString()}, nil
+				}
+			}
+
+			fakeDB.MockListServiceRequestCommentsFn = func(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestComment, error) {
+				return []*domain.ServiceRequestComment{
+					{ID: commentID, ServiceRequestID: serviceRequestID, Comment: "Called the client"},
+					{ID: replyID, ServiceRequestID: serviceRequestID, ParentID: &commentID, Comment: "Thanks"},
+					{ID: otherCommentID, ServiceRequestID: serviceRequestID, Comment: "Client rescheduled"},
+				}, nil
+			}
+
+			if tt.name == "Sad case: failed to list comments" {
+				fakeDB.MockListServiceRequestCommentsFn = func(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestComment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.ListServiceRequestComments(ctx, serviceRequestID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesServiceRequestImpl.ListServiceRequestComments() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != tt.wantThreads {
+				t.Errorf("expected %v comment threads, got %v", tt.wantThreads, len(got))
+				return
+			}
+			if len(got[0].Replies) != tt.wantReplies || got[0].Replies[0].ID != replyID {
+				t.Errorf("expected the reply to be nested under its comment, got %+v", got[0].Replies)
+			}
+		})
+	}
+}
+
+func TestUseCasesServiceRequestImpl_GetServiceRequestHistory(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: get service request history",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: failed to get service request history",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: staff is not in the service request's facility",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: staff is not in the service request's program",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			fakeUser := userMock.NewUserUseCaseMock()
+			fakeNotification := notificationMock.NewServiceNotificationMock()
+			fakeSMS := smsMock.NewSMSServiceMock()
+			u := servicerequest.NewUseCaseServiceRequestImpl(fakeDB, fakeDB, fakeDB, fakeExtension, fakeUser, fakeNotification, fakeSMS)
+
+			programID := uuid.NewString()
+			facilityID := uuid.NewString()
+			fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID string, currentProgramID string) (*domain.StaffProfile, error) {
+				staffID := uuid.NewString()
+				return &domain.StaffProfile{ID: &staffID, UserID: userID, ProgramID: programID}, nil
+			}
+			fakeDB.MockGetClientServiceRequestByIDFn = func(ctx context.Context, serviceRequestID string) (*domain.ServiceRequest, error) {
+				return &domain.ServiceRequest{
+					ID:         serviceRequestID,
+					Status:     enums.ServiceRequestStatusPending.String(),
+					FacilityID: facilityID,
+					ProgramID:  programID,
+				}, nil
+			}
+			if tt.name == "Sad case: staff is not in the service request's facility" {
+				fakeDB.MockGetStaffFacilitiesFn = func(ctx context.Context, input dto.StaffFacilityInput, pagination *domain.Pagination) ([]*domain.Facility, *domain.Pagination, error) {
+					return []*domain.Facility{}, nil, nil
+				}
+			}
+			if tt.name == "Sad case: staff is not in the service request's program" {
+				fakeDB.MockGetStaffProfileFn = func(ctx context.Context, userID string, currentProgramID string) (*domain.StaffProfile, error) {
+					staffID := uuid.NewString()
+					return &domain.StaffProfile{ID: &staffID, UserID: userID, ProgramID: uuid.NewString()}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get service request history" {
+				fakeDB.MockListServiceRequestEventsFn = func(ctx context.Context, serviceRequestID string) ([]*domain.ServiceRequestEvent, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.GetServiceRequestHistory(ctx, uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesServiceRequestImpl.GetServiceRequestHistory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected service request history to be returned")
+			}
+		})
+	}
+}
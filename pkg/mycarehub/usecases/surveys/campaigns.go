@@ -0,0 +1,377 @@
+package surveys
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/notification"
+)
+
+// CreateSurveyCampaign schedules a survey to be sent to the clients in a facility who match the filter params.
+// The campaign is named after the survey form
+func (u *UsecaseSurveysImpl) CreateSurveyCampaign(ctx context.Context, input dto.SurveyCampaignInput) (*domain.SurveyCampaign, error) {
+	if err := input.Validate(); err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("invalid survey campaign input: %w", err)
+	}
+
+	loggedInUserProfile, err := u.getLoggedInUserProfile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	surveyForm, err := u.Surveys.GetSurveyForm(ctx, input.ProjectID, input.FormID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("error getting survey form: %w", err)
+	}
+
+	campaign := &domain.SurveyCampaign{
+		Active:               true,
+		Title:                surveyForm.Name,
+		ProjectID:            input.ProjectID,
+		FormID:               input.FormID,
+		FacilityID:           input.FacilityID,
+		Recurrence:           input.Recurrence,
+		Status:               enums.SurveyCampaignStatusScheduled,
+		NextSendAt:           &input.ScheduledAt,
+		EndsAt:               input.EndsAt,
+		LinkExpiryDays:       input.LinkExpiryDays,
+		ReminderIntervalDays: input.ReminderIntervalDays,
+		ProgramID:            loggedInUserProfile.CurrentProgramID,
+		OrganisationID:       loggedInUserProfile.CurrentOrganizationID,
+	}
+	if input.FilterParams != nil {
+		campaign.ClientTypes = input.FilterParams.ClientTypes
+		campaign.Genders = input.FilterParams.Gender
+		if input.FilterParams.AgeRange != nil {
+			campaign.AgeRange = &domain.AgeRange{
+				LowerBound: input.FilterParams.AgeRange.LowerBound,
+				UpperBound: input.FilterParams.AgeRange.UpperBound,
+			}
+		}
+	}
+
+	created, err := u.Create.CreateSurveyCampaign(ctx, campaign)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to create survey campaign: %w", err)
+	}
+
+	return created, nil
+}
+
+// CancelSurveyCampaign stops a campaign from sending any more rounds. The links that the clients have not responded to are withdrawn
+func (u *UsecaseSurveysImpl) CancelSurveyCampaign(ctx context.Context, campaignID string) (bool, error) {
+	campaign, err := u.getProgramSurveyCampaign(ctx, campaignID)
+	if err != nil {
+		return false, err
+	}
+
+	if campaign.Status != enums.SurveyCampaignStatusScheduled && campaign.Status != enums.SurveyCampaignStatusActive {
+		return false, fmt.Errorf("a %s survey campaign cannot be cancelled", campaign.Status)
+	}
+
+	err = u.Update.UpdateSurveyCampaign(ctx, campaign, map[string]interface{}{
+		"status":       enums.SurveyCampaignStatusCancelled.String(),
+		"next_send_at": nil,
+	})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to cancel survey campaign: %w", err)
+	}
+
+	pending, err := u.getPendingCampaignSurveys(ctx, campaign.ID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, err
+	}
+
+	for _, survey := range pending {
+		if err := u.withdrawSurveyLink(ctx, survey); err != nil {
+			helpers.ReportErrorToSentry(err)
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// ListSurveyCampaigns lists the survey campaigns that the logged in user's program runs in a facility together with the number
+// of surveys each has sent out
+func (u *UsecaseSurveysImpl) ListSurveyCampaigns(ctx context.Context, facilityID string) ([]*domain.SurveyCampaign, error) {
+	loggedInUserProfile, err := u.getLoggedInUserProfile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	campaigns, err := u.Query.ListSurveyCampaigns(ctx, facilityID, loggedInUserProfile.CurrentProgramID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to list survey campaigns: %w", err)
+	}
+
+	for _, campaign := range campaigns {
+		if err := u.countCampaignSurveys(ctx, campaign); err != nil {
+			helpers.ReportErrorToSentry(err)
+			return nil, err
+		}
+	}
+
+	return campaigns, nil
+}
+
+// GetSurveyCampaign returns the status of a survey campaign, that is how many surveys it has sent out
+// and how many of them have been submitted or expired
+func (u *UsecaseSurveysImpl) GetSurveyCampaign(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error) {
+	campaign, err := u.getProgramSurveyCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.countCampaignSurveys(ctx, campaign); err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, err
+	}
+
+	return campaign, nil
+}
+
+// getProgramSurveyCampaign gets a survey campaign that belongs to the logged in user's current program
+func (u *UsecaseSurveysImpl) getProgramSurveyCampaign(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error) {
+	loggedInUserProfile, err := u.getLoggedInUserProfile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	campaign, err := u.Query.GetSurveyCampaignByID(ctx, campaignID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get survey campaign: %w", err)
+	}
+
+	if campaign.ProgramID != loggedInUserProfile.CurrentProgramID {
+		return nil, fmt.Errorf("the survey campaign should belong to the user's current program")
+	}
+
+	return campaign, nil
+}
+
+// ProcessSurveyCampaigns sends out the survey campaigns that are due, withdraws the survey links that have expired
+// and reminds the clients who have not responded. A campaign is completed once it has no more rounds to send
+// and the links from its last round have expired
+func (u *UsecaseSurveysImpl) ProcessSurveyCampaigns(ctx context.Context) error {
+	now := time.Now()
+
+	campaigns, err := u.Query.ListOngoingSurveyCampaigns(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("failed to list ongoing survey campaigns: %w", err)
+	}
+
+	var errs error
+	for _, campaign := range campaigns {
+		err := u.processSurveyCampaign(ctx, campaign, now)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("failed to process survey campaign %s: %w", campaign.ID, err))
+		}
+	}
+
+	if errs != nil {
+		helpers.ReportErrorToSentry(errs)
+	}
+
+	return errs
+}
+
+// processSurveyCampaign sends a campaign's round if it is due then follows up on the surveys it has sent out.
+// The round is claimed by advancing the campaign to its next round before the links are sent so that overlapping runs
+// do not send the same round twice. A round whose links fail to send is therefore not retried
+func (u *UsecaseSurveysImpl) processSurveyCampaign(ctx context.Context, campaign *domain.SurveyCampaign, now time.Time) error {
+	if campaign.NextSendAt != nil && !now.Before(*campaign.NextSendAt) {
+		nextSendAt := campaign.NextRound(now)
+		claimed, err := u.Update.ClaimSurveyCampaignRound(ctx, campaign, map[string]interface{}{
+			"status":       enums.SurveyCampaignStatusActive.String(),
+			"last_sent_at": now,
+			"next_send_at": nextSendAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update survey campaign: %w", err)
+		}
+		if !claimed {
+			// another run has already sent this round
+			return nil
+		}
+
+		campaign.Status = enums.SurveyCampaignStatusActive
+		campaign.LastSentAt = &now
+		campaign.NextSendAt = nextSendAt
+
+		expiresAt := campaign.LinkExpiry(now)
+		err = u.sendSurveyLinks(ctx, surveyRound{
+			facilityID:     campaign.FacilityID,
+			projectID:      campaign.ProjectID,
+			formID:         campaign.FormID,
+			filterParams:   campaignFilterParams(campaign),
+			programID:      campaign.ProgramID,
+			organisationID: campaign.OrganisationID,
+			campaignID:     &campaign.ID,
+			expiresAt:      &expiresAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	pending, err := u.getPendingCampaignSurveys(ctx, campaign.ID)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for _, survey := range pending {
+		switch {
+		case survey.IsExpired(now):
+			err = u.withdrawSurveyLink(ctx, survey)
+
+		case campaign.ReminderIntervalDays != nil && survey.NeedsReminder(now, time.Duration(*campaign.ReminderIntervalDays)*24*time.Hour):
+			err = u.sendSurveyReminder(ctx, survey)
+
+		default:
+			continue
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if errs != nil {
+		return errs
+	}
+
+	if campaign.IsComplete(now) {
+		err := u.Update.UpdateSurveyCampaign(ctx, campaign, map[string]interface{}{
+			"status": enums.SurveyCampaignStatusCompleted.String(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to complete survey campaign: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// getPendingCampaignSurveys returns the surveys sent out by a campaign that the clients have not responded to and have not been withdrawn
+func (u *UsecaseSurveysImpl) getPendingCampaignSurveys(ctx context.Context, campaignID string) ([]*domain.UserSurvey, error) {
+	surveys, err := u.Query.GetUserSurveyForms(ctx, map[string]interface{}{
+		"campaign_id":   campaignID,
+		"has_submitted": false,
+		"active":        true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get survey campaign surveys: %w", err)
+	}
+	return surveys, nil
+}
+
+// countCampaignSurveys sets the number of surveys a campaign has sent out and how many of them have been submitted or expired
+func (u *UsecaseSurveysImpl) countCampaignSurveys(ctx context.Context, campaign *domain.SurveyCampaign) error {
+	surveys, err := u.Query.GetUserSurveyForms(ctx, map[string]interface{}{
+		"campaign_id": campaign.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get survey campaign surveys: %w", err)
+	}
+
+	campaign.Sent, campaign.Submitted, campaign.Expired = len(surveys), 0, 0
+	for _, survey := range surveys {
+		switch {
+		case survey.HasSubmitted:
+			campaign.Submitted++
+		case !survey.Active:
+			campaign.Expired++
+		}
+	}
+
+	return nil
+}
+
+// withdrawSurveyLink deletes the public access link of a survey so that it can no longer be filled
+func (u *UsecaseSurveysImpl) withdrawSurveyLink(ctx context.Context, survey *domain.UserSurvey) error {
+	err := u.Surveys.DeletePublicAccessLink(ctx, dto.VerifySurveySubmissionInput{
+		ProjectID:   survey.ProjectID,
+		FormID:      survey.FormID,
+		SubmitterID: survey.LinkID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete survey link %d: %w", survey.LinkID, err)
+	}
+
+	err = u.Update.UpdateUserSurveys(ctx, survey, map[string]interface{}{
+		"active": false,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update survey: %w", err)
+	}
+
+	return nil
+}
+
+// sendSurveyReminder reminds a client to fill a survey unless they opted out of survey notifications, and records when they were reminded
+func (u *UsecaseSurveysImpl) sendSurveyReminder(ctx context.Context, survey *domain.UserSurvey) error {
+	client, err := u.Query.GetClientProfile(ctx, survey.UserID, survey.ProgramID)
+	if err != nil {
+		return fmt.Errorf("failed to get client profile: %w", err)
+	}
+
+	preferences, err := u.Query.GetNotificationPreferences(ctx, *client.User.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	pushEnabled := true
+	for _, preference := range preferences {
+		if preference.NotificationType == enums.NotificationTypeSurveys {
+			pushEnabled = preference.PushEnabled
+		}
+	}
+
+	if pushEnabled {
+		message := notification.ComposeClientNotification(
+			enums.NotificationTypeSurveys,
+			notification.ClientNotificationInput{
+				Survey:     survey,
+				IsReminder: true,
+			},
+		)
+		err = u.Notification.NotifyUser(ctx, client.User, message)
+		if err != nil {
+			return err
+		}
+	}
+
+	return u.Update.UpdateUserSurveys(ctx, survey, map[string]interface{}{
+		"last_reminded_at": time.Now(),
+	})
+}
+
+// campaignFilterParams returns the filter params that select the clients a campaign is sent to
+func campaignFilterParams(campaign *domain.SurveyCampaign) *dto.ClientFilterParamsInput {
+	filterParams := &dto.ClientFilterParamsInput{
+		ClientTypes: campaign.ClientTypes,
+		Gender:      campaign.Genders,
+	}
+	if campaign.AgeRange != nil {
+		filterParams.AgeRange = &dto.AgeRangeInput{
+			LowerBound: campaign.AgeRange.LowerBound,
+			UpperBound: campaign.AgeRange.UpperBound,
+		}
+	}
+	return filterParams
+}
@@ -0,0 +1,651 @@
+package surveys
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/enumutils"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	extensionMock "github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension/mock"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+	pgMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/mock"
+	mockSurveys "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/surveys/mock"
+	mockNotification "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/notification/mock"
+	fakeServiceRequest "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/servicerequest/mock"
+)
+
+func TestUsecaseSurveysImpl_CreateSurveyCampaign(t *testing.T) {
+	reminderInterval := 2
+	validInput := dto.SurveyCampaignInput{
+		FacilityID: uuid.NewString(),
+		ProjectID:  1,
+		FormID:     uuid.NewString(),
+		FilterParams: &dto.ClientFilterParamsInput{
+			ClientTypes: []enums.ClientType{enums.ClientTypePmtct},
+			AgeRange: &dto.AgeRangeInput{
+				LowerBound: 20,
+				UpperBound: 25,
+			},
+			Gender: []enumutils.Gender{enumutils.GenderFemale},
+		},
+		ScheduledAt:          time.Now().Add(time.Hour),
+		Recurrence:           enums.SurveyCampaignRecurrenceMonthly,
+		LinkExpiryDays:       7,
+		ReminderIntervalDays: &reminderInterval,
+	}
+
+	tests := []struct {
+		name    string
+		input   dto.SurveyCampaignInput
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: create survey campaign",
+			input:   validInput,
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid input",
+			input: dto.SurveyCampaignInput{
+				FacilityID: uuid.NewString(),
+				ProjectID:  1,
+				FormID:     uuid.NewString(),
+				Recurrence: enums.SurveyCampaignRecurrenceOnce,
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get logged in user",
+			input:   validInput,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get logged in user profile",
+			input:   validInput,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get survey form",
+			input:   validInput,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to create survey campaign",
+			input:   validInput,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSurveys := mockSurveys.NewSurveysMock()
+			fakeDB := pgMock.NewPostgresMock()
+			fakeNotification := mockNotification.NewServiceNotificationMock()
+			fakeServiceRequest := fakeServiceRequest.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			u := NewUsecaseSurveys(fakeSurveys, fakeDB, fakeDB, fakeDB, fakeNotification, fakeServiceRequest, fakeExtension)
+
+			if tt.name == "Sad case: failed to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get logged in user profile" {
+				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get survey form" {
+				fakeSurveys.MockGetSurveyFormFn = func(ctx context.Context, projectID int, formID string) (*domain.SurveyForm, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to create survey campaign" {
+				fakeDB.MockCreateSurveyCampaignFn = func(ctx context.Context, campaign *domain.SurveyCampaign) (*domain.SurveyCampaign, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.CreateSurveyCampaign(context.Background(), tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseSurveysImpl.CreateSurveyCampaign() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Status != enums.SurveyCampaignStatusScheduled || got.AgeRange == nil || len(got.ClientTypes) != 1 || got.Title == "" {
+				t.Errorf("unexpected survey campaign %v", got)
+			}
+		})
+	}
+}
+
+func TestUsecaseSurveysImpl_CancelSurveyCampaign(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: cancel survey campaign",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: failed to get user profile",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: survey campaign belongs to another program",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get survey campaign",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: survey campaign already completed",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to update survey campaign",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get pending surveys",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to delete survey link",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSurveys := mockSurveys.NewSurveysMock()
+			fakeDB := pgMock.NewPostgresMock()
+			fakeNotification := mockNotification.NewServiceNotificationMock()
+			fakeServiceRequest := fakeServiceRequest.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			u := NewUsecaseSurveys(fakeSurveys, fakeDB, fakeDB, fakeDB, fakeNotification, fakeServiceRequest, fakeExtension)
+
+			programID := uuid.NewString()
+			fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+				return &domain.User{ID: &userID, CurrentProgramID: programID}, nil
+			}
+			fakeDB.MockGetSurveyCampaignByIDFn = func(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error) {
+				return &domain.SurveyCampaign{ID: campaignID, Status: enums.SurveyCampaignStatusActive, ProgramID: programID}, nil
+			}
+
+			if tt.name == "Sad case: failed to get user profile" {
+				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: survey campaign belongs to another program" {
+				fakeDB.MockGetSurveyCampaignByIDFn = func(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error) {
+					return &domain.SurveyCampaign{ID: campaignID, Status: enums.SurveyCampaignStatusActive, ProgramID: uuid.NewString()}, nil
+				}
+			}
+
+			withdrawn := 0
+			fakeSurveys.MockDeletePublicAccessLinkFn = func(ctx context.Context, input dto.VerifySurveySubmissionInput) error {
+				withdrawn++
+				return nil
+			}
+
+			if tt.name == "Sad case: failed to get survey campaign" {
+				fakeDB.MockGetSurveyCampaignByIDFn = func(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: survey campaign already completed" {
+				fakeDB.MockGetSurveyCampaignByIDFn = func(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error) {
+					return &domain.SurveyCampaign{ID: campaignID, Status: enums.SurveyCampaignStatusCompleted}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to update survey campaign" {
+				fakeDB.MockUpdateSurveyCampaignFn = func(ctx context.Context, campaign *domain.SurveyCampaign, updateData map[string]interface{}) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get pending surveys" {
+				fakeDB.MockGetUserSurveyFormsFn = func(ctx context.Context, params map[string]interface{}) ([]*domain.UserSurvey, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to delete survey link" {
+				fakeSurveys.MockDeletePublicAccessLinkFn = func(ctx context.Context, input dto.VerifySurveySubmissionInput) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.CancelSurveyCampaign(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseSurveysImpl.CancelSurveyCampaign() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (!got || withdrawn == 0) {
+				t.Errorf("expected the survey campaign to be cancelled and its pending links withdrawn")
+			}
+		})
+	}
+}
+
+func TestUsecaseSurveysImpl_ListSurveyCampaigns(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list survey campaigns",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: failed to get user profile",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to list survey campaigns",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to count campaign surveys",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSurveys := mockSurveys.NewSurveysMock()
+			fakeDB := pgMock.NewPostgresMock()
+			fakeNotification := mockNotification.NewServiceNotificationMock()
+			fakeServiceRequest := fakeServiceRequest.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			u := NewUsecaseSurveys(fakeSurveys, fakeDB, fakeDB, fakeDB, fakeNotification, fakeServiceRequest, fakeExtension)
+
+			programID := uuid.NewString()
+			fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+				return &domain.User{ID: &userID, CurrentProgramID: programID}, nil
+			}
+			fakeDB.MockListSurveyCampaignsFn = func(ctx context.Context, facilityID, campaignProgramID string) ([]*domain.SurveyCampaign, error) {
+				if campaignProgramID != programID {
+					return nil, fmt.Errorf("expected the campaigns of program %s, got %s", programID, campaignProgramID)
+				}
+				return []*domain.SurveyCampaign{{ID: uuid.NewString(), FacilityID: facilityID, ProgramID: programID}}, nil
+			}
+
+			if tt.name == "Sad case: failed to get user profile" {
+				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to list survey campaigns" {
+				fakeDB.MockListSurveyCampaignsFn = func(ctx context.Context, facilityID, programID string) ([]*domain.SurveyCampaign, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to count campaign surveys" {
+				fakeDB.MockGetUserSurveyFormsFn = func(ctx context.Context, params map[string]interface{}) ([]*domain.UserSurvey, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.ListSurveyCampaigns(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseSurveysImpl.ListSurveyCampaigns() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("expected survey campaigns to be returned")
+			}
+		})
+	}
+}
+
+func TestUsecaseSurveysImpl_GetSurveyCampaign(t *testing.T) {
+	tests := []struct {
+		name          string
+		wantSent      int
+		wantSubmitted int
+		wantExpired   int
+		wantErr       bool
+	}{
+		{
+			name:          "Happy case: get survey campaign status",
+			wantSent:      3,
+			wantSubmitted: 1,
+			wantExpired:   1,
+			wantErr:       false,
+		},
+		{
+			name:    "Sad case: failed to get user profile",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: survey campaign belongs to another program",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get survey campaign",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to count campaign surveys",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSurveys := mockSurveys.NewSurveysMock()
+			fakeDB := pgMock.NewPostgresMock()
+			fakeNotification := mockNotification.NewServiceNotificationMock()
+			fakeServiceRequest := fakeServiceRequest.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			u := NewUsecaseSurveys(fakeSurveys, fakeDB, fakeDB, fakeDB, fakeNotification, fakeServiceRequest, fakeExtension)
+
+			programID := uuid.NewString()
+			fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+				return &domain.User{ID: &userID, CurrentProgramID: programID}, nil
+			}
+			fakeDB.MockGetSurveyCampaignByIDFn = func(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error) {
+				return &domain.SurveyCampaign{ID: campaignID, Status: enums.SurveyCampaignStatusActive, ProgramID: programID}, nil
+			}
+
+			if tt.name == "Sad case: failed to get user profile" {
+				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: survey campaign belongs to another program" {
+				fakeDB.MockGetSurveyCampaignByIDFn = func(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error) {
+					return &domain.SurveyCampaign{ID: campaignID, Status: enums.SurveyCampaignStatusActive, ProgramID: uuid.NewString()}, nil
+				}
+			}
+
+			fakeDB.MockGetUserSurveyFormsFn = func(ctx context.Context, params map[string]interface{}) ([]*domain.UserSurvey, error) {
+				return []*domain.UserSurvey{
+					{Active: true, HasSubmitted: true},
+					{Active: true},
+					{Active: false},
+				}, nil
+			}
+
+			if tt.name == "Sad case: failed to get survey campaign" {
+				fakeDB.MockGetSurveyCampaignByIDFn = func(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to count campaign surveys" {
+				fakeDB.MockGetUserSurveyFormsFn = func(ctx context.Context, params map[string]interface{}) ([]*domain.UserSurvey, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.GetSurveyCampaign(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseSurveysImpl.GetSurveyCampaign() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Sent != tt.wantSent || got.Submitted != tt.wantSubmitted || got.Expired != tt.wantExpired {
+				t.Errorf("expected %v sent, %v submitted and %v expired surveys, got %v, %v and %v",
+					tt.wantSent, tt.wantSubmitted, tt.wantExpired, got.Sent, got.Submitted, got.Expired)
+			}
+		})
+	}
+}
+
+func TestUsecaseSurveysImpl_ProcessSurveyCampaigns(t *testing.T) {
+	now := time.Now()
+	due := now.Add(-time.Minute)
+	sentLongAgo := now.AddDate(0, 0, -10)
+	expired := now.Add(-time.Hour)
+	expiresLater := now.AddDate(0, 0, 3)
+	reminderInterval := 2
+
+	tests := []struct {
+		name         string
+		wantStatus   enums.SurveyCampaignStatus
+		wantNotified bool
+		wantErr      bool
+	}{
+		{
+			name:         "Happy case: send due campaign and follow up its surveys",
+			wantStatus:   enums.SurveyCampaignStatusActive,
+			wantNotified: true,
+			wantErr:      false,
+		},
+		{
+			name:       "Happy case: complete campaign",
+			wantStatus: enums.SurveyCampaignStatusCompleted,
+			wantErr:    false,
+		},
+		{
+			name:         "Happy case: round already sent by another run",
+			wantNotified: false,
+			wantErr:      false,
+		},
+		{
+			name:         "Happy case: client opted out of survey notifications",
+			wantStatus:   enums.SurveyCampaignStatusActive,
+			wantNotified: false,
+			wantErr:      false,
+		},
+		{
+			name:    "Sad case: failed to list ongoing survey campaigns",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to send survey links",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to claim survey campaign round",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to complete survey campaign",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get pending surveys",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to delete expired survey link",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get client profile",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get notification preferences",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to notify client",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to update survey",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSurveys := mockSurveys.NewSurveysMock()
+			fakeDB := pgMock.NewPostgresMock()
+			fakeNotification := mockNotification.NewServiceNotificationMock()
+			fakeServiceRequest := fakeServiceRequest.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			u := NewUsecaseSurveys(fakeSurveys, fakeDB, fakeDB, fakeDB, fakeNotification, fakeServiceRequest, fakeExtension)
+
+			fakeDB.MockListOngoingSurveyCampaignsFn = func(ctx context.Context) ([]*domain.SurveyCampaign, error) {
+				return []*domain.SurveyCampaign{
+					{
+						ID:                   uuid.NewString(),
+						Title:                "Monthly check in",
+						ProjectID:            1,
+						FormID:               uuid.NewString(),
+						FacilityID:           uuid.NewString(),
+						Recurrence:           enums.SurveyCampaignRecurrenceMonthly,
+						Status:               enums.SurveyCampaignStatusScheduled,
+						NextSendAt:           &due,
+						LinkExpiryDays:       7,
+						ReminderIntervalDays: &reminderInterval,
+					},
+				}, nil
+			}
+			fakeDB.MockGetUserSurveyFormsFn = func(ctx context.Context, params map[string]interface{}) ([]*domain.UserSurvey, error) {
+				return []*domain.UserSurvey{
+					{Active: true, Created: now.AddDate(0, 0, -8), ExpiresAt: &expired, LinkID: 1},
+					{Active: true, Created: now.AddDate(0, 0, -3), ExpiresAt: &expiresLater, LinkID: 2},
+				}, nil
+			}
+
+			var status enums.SurveyCampaignStatus
+			fakeDB.MockUpdateSurveyCampaignFn = func(ctx context.Context, campaign *domain.SurveyCampaign, updateData map[string]interface{}) error {
+				status = enums.SurveyCampaignStatus(updateData["status"].(string))
+				return nil
+			}
+			claimed := false
+			fakeDB.MockClaimSurveyCampaignRoundFn = func(ctx context.Context, campaign *domain.SurveyCampaign, updateData map[string]interface{}) (bool, error) {
+				claimed = true
+				status = enums.SurveyCampaignStatus(updateData["status"].(string))
+				return true, nil
+			}
+			getClients := fakeDB.MockGetClientsByFilterParamsFn
+			fakeDB.MockGetClientsByFilterParamsFn = func(ctx context.Context, facilityID *string, filterParams *dto.ClientFilterParamsInput) ([]*domain.ClientProfile, error) {
+				if !claimed {
+					return nil, fmt.Errorf("expected the round to be claimed before the survey links are sent")
+				}
+				return getClients(ctx, facilityID, filterParams)
+			}
+			notified := false
+			fakeNotification.MockNotifyUserFn = func(ctx context.Context, userProfile *domain.User, notificationPayload *domain.Notification) error {
+				notified = true
+				return nil
+			}
+
+			if tt.name == "Happy case: complete campaign" {
+				fakeDB.MockListOngoingSurveyCampaignsFn = func(ctx context.Context) ([]*domain.SurveyCampaign, error) {
+					return []*domain.SurveyCampaign{
+						{
+							ID:             uuid.NewString(),
+							Recurrence:     enums.SurveyCampaignRecurrenceOnce,
+							Status:         enums.SurveyCampaignStatusActive,
+							LastSentAt:     &sentLongAgo,
+							LinkExpiryDays: 7,
+						},
+					}, nil
+				}
+				fakeDB.MockGetUserSurveyFormsFn = func(ctx context.Context, params map[string]interface{}) ([]*domain.UserSurvey, error) {
+					return []*domain.UserSurvey{}, nil
+				}
+			}
+			if tt.name == "Happy case: client opted out of survey notifications" {
+				fakeDB.MockGetNotificationPreferencesFn = func(ctx context.Context, userID string) ([]*domain.NotificationPreference, error) {
+					return []*domain.NotificationPreference{
+						{UserID: userID, NotificationType: enums.NotificationTypeSurveys, PushEnabled: false},
+					}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to list ongoing survey campaigns" {
+				fakeDB.MockListOngoingSurveyCampaignsFn = func(ctx context.Context) ([]*domain.SurveyCampaign, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to send survey links" {
+				fakeDB.MockGetClientsByFilterParamsFn = func(ctx context.Context, facilityID *string, filterParams *dto.ClientFilterParamsInput) ([]*domain.ClientProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Happy case: round already sent by another run" {
+				fakeDB.MockClaimSurveyCampaignRoundFn = func(ctx context.Context, campaign *domain.SurveyCampaign, updateData map[string]interface{}) (bool, error) {
+					return false, nil
+				}
+				fakeDB.MockGetClientsByFilterParamsFn = func(ctx context.Context, facilityID *string, filterParams *dto.ClientFilterParamsInput) ([]*domain.ClientProfile, error) {
+					return nil, fmt.Errorf("expected the survey links of a claimed round not to be sent again")
+				}
+			}
+			if tt.name == "Sad case: failed to claim survey campaign round" {
+				fakeDB.MockClaimSurveyCampaignRoundFn = func(ctx context.Context, campaign *domain.SurveyCampaign, updateData map[string]interface{}) (bool, error) {
+					return false, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to complete survey campaign" {
+				fakeDB.MockListOngoingSurveyCampaignsFn = func(ctx context.Context) ([]*domain.SurveyCampaign, error) {
+					return []*domain.SurveyCampaign{
+						{
+							ID:             uuid.NewString(),
+							Recurrence:     enums.SurveyCampaignRecurrenceOnce,
+							Status:         enums.SurveyCampaignStatusActive,
+							LastSentAt:     &sentLongAgo,
+							LinkExpiryDays: 7,
+						},
+					}, nil
+				}
+				fakeDB.MockGetUserSurveyFormsFn = func(ctx context.Context, params map[string]interface{}) ([]*domain.UserSurvey, error) {
+					return []*domain.UserSurvey{}, nil
+				}
+				fakeDB.MockUpdateSurveyCampaignFn = func(ctx context.Context, campaign *domain.SurveyCampaign, updateData map[string]interface{}) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get pending surveys" {
+				fakeDB.MockGetUserSurveyFormsFn = func(ctx context.Context, params map[string]interface{}) ([]*domain.UserSurvey, error) {
+					if _, ok := params["campaign_id"]; ok {
+						return nil, fmt.Errorf("an error occurred")
+					}
+					return []*domain.UserSurvey{}, nil
+				}
+			}
+			if tt.name == "Sad case: failed to delete expired survey link" {
+				fakeSurveys.MockDeletePublicAccessLinkFn = func(ctx context.Context, input dto.VerifySurveySubmissionInput) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get client profile" {
+				fakeDB.MockGetClientProfileFn = func(ctx context.Context, userID string, programID string) (*domain.ClientProfile, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get notification preferences" {
+				fakeDB.MockGetNotificationPreferencesFn = func(ctx context.Context, userID string) ([]*domain.NotificationPreference, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to notify client" {
+				fakeNotification.MockNotifyUserFn = func(ctx context.Context, userProfile *domain.User, notificationPayload *domain.Notification) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to update survey" {
+				fakeDB.MockUpdateUserSurveysFn = func(ctx context.Context, survey *domain.UserSurvey, updateData map[string]interface{}) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			err := u.ProcessSurveyCampaigns(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseSurveysImpl.ProcessSurveyCampaigns() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if status != tt.wantStatus {
+				t.Errorf("expected the survey campaign status to be %v, got %v", tt.wantStatus, status)
+			}
+			if notified != tt.wantNotified {
+				t.Errorf("expected notified to be %v, got %v", tt.wantNotified, notified)
+			}
+		})
+	}
+}
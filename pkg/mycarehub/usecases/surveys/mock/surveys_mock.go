@@ -6,6 +6,7 @@ import (
 
 	"github.com/brianvoe/gofakeit"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 )
 
@@ -19,6 +20,14 @@ type SurveysMock struct {
 	MockGetSurveysWithServiceRequestsFn func(ctx context.Context, facilityID string) ([]*dto.SurveysWithServiceRequest, error)
 	MockVerifySurveySubmissionFn        func(ctx context.Context, input dto.VerifySurveySubmissionInput) (bool, error)
 	MockGetSurveyServiceRequestUserFn   func(ctx context.Context, facilityID string, projectID int, formID string, paginationInput dto.PaginationsInput) (*domain.SurveyServiceRequestUserPage, error)
+	MockCreateSurveyCampaignFn          func(ctx context.Context, input dto.SurveyCampaignInput) (*domain.SurveyCampaign, error)
+	MockCancelSurveyCampaignFn          func(ctx context.Context, campaignID string) (bool, error)
+	MockListSurveyCampaignsFn           func(ctx context.Context, facilityID string) ([]*domain.SurveyCampaign, error)
+	MockGetSurveyCampaignFn             func(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error)
+	MockProcessSurveyCampaignsFn        func(ctx context.Context) error
+	MockCreateSurveyRedFlagRuleFn       func(ctx context.Context, input dto.SurveyRedFlagRuleInput) (*domain.SurveyRedFlagRule, error)
+	MockListSurveyRedFlagRulesFn        func(ctx context.Context, projectID int, formID string) ([]*domain.SurveyRedFlagRule, error)
+	MockDeleteSurveyRedFlagRuleFn       func(ctx context.Context, ruleID string) (bool, error)
 }
 
 // NewSurveysMock initializes a new instance of `Survey Mock` then mocking the case of success.
@@ -26,6 +35,35 @@ func NewSurveysMock() *SurveysMock {
 	UUID := gofakeit.UUID()
 	bs := gofakeit.BS()
 	now := time.Now()
+	campaign := &domain.SurveyCampaign{
+		ID:             UUID,
+		Active:         true,
+		Title:          bs,
+		ProjectID:      1,
+		FormID:         UUID,
+		FacilityID:     UUID,
+		Recurrence:     enums.SurveyCampaignRecurrenceMonthly,
+		Status:         enums.SurveyCampaignStatusActive,
+		NextSendAt:     &now,
+		LastSentAt:     &now,
+		LinkExpiryDays: 7,
+		Sent:           10,
+		Submitted:      6,
+		Expired:        1,
+		ProgramID:      UUID,
+	}
+	rule := &domain.SurveyRedFlagRule{
+		ID:         UUID,
+		Active:     true,
+		Name:       bs,
+		ProjectID:  1,
+		FormID:     UUID,
+		Combinator: enums.SurveyRuleCombinatorAnd,
+		Conditions: []domain.SurveyRuleCondition{
+			{Field: "feeling_unsafe", Operator: enums.SurveyRuleOperatorEquals, Value: "yes"},
+		},
+		ProgramID: UUID,
+	}
 	return &SurveysMock{
 		MockListSurveysFn: func(ctx context.Context, projectID *int) ([]*domain.SurveyForm, error) {
 			return []*domain.SurveyForm{
@@ -112,6 +150,30 @@ func NewSurveysMock() *SurveysMock {
 				},
 			}, nil
 		},
+		MockCreateSurveyCampaignFn: func(ctx context.Context, input dto.SurveyCampaignInput) (*domain.SurveyCampaign, error) {
+			return campaign, nil
+		},
+		MockCancelSurveyCampaignFn: func(ctx context.Context, campaignID string) (bool, error) {
+			return true, nil
+		},
+		MockListSurveyCampaignsFn: func(ctx context.Context, facilityID string) ([]*domain.SurveyCampaign, error) {
+			return []*domain.SurveyCampaign{campaign}, nil
+		},
+		MockGetSurveyCampaignFn: func(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error) {
+			return campaign, nil
+		},
+		MockProcessSurveyCampaignsFn: func(ctx context.Context) error {
+			return nil
+		},
+		MockCreateSurveyRedFlagRuleFn: func(ctx context.Context, input dto.SurveyRedFlagRuleInput) (*domain.SurveyRedFlagRule, error) {
+			return rule, nil
+		},
+		MockListSurveyRedFlagRulesFn: func(ctx context.Context, projectID int, formID string) ([]*domain.SurveyRedFlagRule, error) {
+			return []*domain.SurveyRedFlagRule{rule}, nil
+		},
+		MockDeleteSurveyRedFlagRuleFn: func(ctx context.Context, ruleID string) (bool, error) {
+			return true, nil
+		},
 	}
 }
 
@@ -154,3 +216,43 @@ func (m *SurveysMock) VerifySurveySubmission(ctx context.Context, input dto.Veri
 func (m *SurveysMock) GetSurveyServiceRequestUser(ctx context.Context, facilityID string, projectID int, formID string, paginationInput dto.PaginationsInput) (*domain.SurveyServiceRequestUserPage, error) {
 	return m.MockGetSurveyServiceRequestUserFn(ctx, facilityID, projectID, formID, paginationInput)
 }
+
+// CreateSurveyCampaign mock the implementation of the CreateSurveyCampaign method
+func (m *SurveysMock) CreateSurveyCampaign(ctx context.Context, input dto.SurveyCampaignInput) (*domain.SurveyCampaign, error) {
+	return m.MockCreateSurveyCampaignFn(ctx, input)
+}
+
+// CancelSurveyCampaign mock the implementation of the CancelSurveyCampaign method
+func (m *SurveysMock) CancelSurveyCampaign(ctx context.Context, campaignID string) (bool, error) {
+	return m.MockCancelSurveyCampaignFn(ctx, campaignID)
+}
+
+// ListSurveyCampaigns mock the implementation of the ListSurveyCampaigns method
+func (m *SurveysMock) ListSurveyCampaigns(ctx context.Context, facilityID string) ([]*domain.SurveyCampaign, error) {
+	return m.MockListSurveyCampaignsFn(ctx, facilityID)
+}
+
+// GetSurveyCampaign mock the implementation of the GetSurveyCampaign method
+func (m *SurveysMock) GetSurveyCampaign(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error) {
+	return m.MockGetSurveyCampaignFn(ctx, campaignID)
+}
+
+// ProcessSurveyCampaigns mock the implementation of the ProcessSurveyCampaigns method
+func (m *SurveysMock) ProcessSurveyCampaigns(ctx context.Context) error {
+	return m.MockProcessSurveyCampaignsFn(ctx)
+}
+
+// CreateSurveyRedFlagRule mock the implementation of the CreateSurveyRedFlagRule method
+func (m *SurveysMock) CreateSurveyRedFlagRule(ctx context.Context, input dto.SurveyRedFlagRuleInput) (*domain.SurveyRedFlagRule, error) {
+	return m.MockCreateSurveyRedFlagRuleFn(ctx, input)
+}
+
+// ListSurveyRedFlagRules mock the implementation of the ListSurveyRedFlagRules method
+func (m *SurveysMock) ListSurveyRedFlagRules(ctx context.Context, projectID int, formID string) ([]*domain.SurveyRedFlagRule, error) {
+	return m.MockListSurveyRedFlagRulesFn(ctx, projectID, formID)
+}
+
+// DeleteSurveyRedFlagRule mock the implementation of the DeleteSurveyRedFlagRule method
+func (m *SurveysMock) DeleteSurveyRedFlagRule(ctx context.Context, ruleID string) (bool, error) {
+	return m.MockDeleteSurveyRedFlagRuleFn(ctx, ruleID)
+}
@@ -0,0 +1,102 @@
+package surveys
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+)
+
+// CreateSurveyRedFlagRule defines a rule that raises a survey red flag service request when a verified submission to
+// the survey form matches its conditions. The rule belongs to the program of the logged in staff
+func (u *UsecaseSurveysImpl) CreateSurveyRedFlagRule(ctx context.Context, input dto.SurveyRedFlagRuleInput) (*domain.SurveyRedFlagRule, error) {
+	if err := input.Validate(); err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("invalid survey red flag rule input: %w", err)
+	}
+
+	loggedInUserProfile, err := u.getLoggedInUserProfile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.Surveys.GetSurveyForm(ctx, input.ProjectID, input.FormID); err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("error getting survey form: %w", err)
+	}
+
+	conditions := []domain.SurveyRuleCondition{}
+	for _, condition := range input.Conditions {
+		conditions = append(conditions, domain.SurveyRuleCondition{
+			Field:    strings.Trim(strings.TrimSpace(condition.Field), "/"),
+			Operator: condition.Operator,
+			Value:    strings.TrimSpace(condition.Value),
+		})
+	}
+
+	rule := &domain.SurveyRedFlagRule{
+		Active:         true,
+		Name:           input.Name,
+		ProjectID:      input.ProjectID,
+		FormID:         input.FormID,
+		Combinator:     input.Combinator,
+		Conditions:     conditions,
+		ProgramID:      loggedInUserProfile.CurrentProgramID,
+		OrganisationID: loggedInUserProfile.CurrentOrganizationID,
+	}
+
+	created, err := u.Create.CreateSurveyRedFlagRule(ctx, rule)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to create survey red flag rule: %w", err)
+	}
+
+	return created, nil
+}
+
+// ListSurveyRedFlagRules returns the rules of the logged in user's program that are checked when a submission to a survey form is verified
+func (u *UsecaseSurveysImpl) ListSurveyRedFlagRules(ctx context.Context, projectID int, formID string) ([]*domain.SurveyRedFlagRule, error) {
+	loggedInUserProfile, err := u.getLoggedInUserProfile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := u.Query.ListSurveyRedFlagRules(ctx, projectID, formID, loggedInUserProfile.CurrentProgramID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to list survey red flag rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// DeleteSurveyRedFlagRule stops a rule from being checked against new submissions.
+// The rule is deactivated rather than removed since the service requests it raised refer to it.
+// Only the rules of the logged in user's program can be deleted
+func (u *UsecaseSurveysImpl) DeleteSurveyRedFlagRule(ctx context.Context, ruleID string) (bool, error) {
+	loggedInUserProfile, err := u.getLoggedInUserProfile(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	rule, err := u.Query.GetSurveyRedFlagRuleByID(ctx, ruleID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to get survey red flag rule: %w", err)
+	}
+
+	if rule.ProgramID != loggedInUserProfile.CurrentProgramID {
+		return false, fmt.Errorf("the survey red flag rule should belong to the user's current program")
+	}
+
+	err = u.Update.UpdateSurveyRedFlagRule(ctx, &domain.SurveyRedFlagRule{ID: ruleID}, map[string]interface{}{"active": false})
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to delete survey red flag rule: %w", err)
+	}
+
+	return true, nil
+}
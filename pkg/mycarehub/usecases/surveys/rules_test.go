@@ -0,0 +1,272 @@
+package surveys
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	extensionMock "github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension/mock"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
+	pgMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/mock"
+	mockSurveys "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/surveys/mock"
+	mockNotification "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/notification/mock"
+	fakeServiceRequest "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/servicerequest/mock"
+)
+
+func TestUsecaseSurveysImpl_CreateSurveyRedFlagRule(t *testing.T) {
+	validInput := dto.SurveyRedFlagRuleInput{
+		Name:       "Unsafe with a high score",
+		ProjectID:  1,
+		FormID:     uuid.NewString(),
+		Combinator: enums.SurveyRuleCombinatorAnd,
+		Conditions: []*dto.SurveyRuleConditionInput{
+			{Field: "/feeling_unsafe", Operator: enums.SurveyRuleOperatorEquals, Value: "yes "},
+			{Field: "phq_score", Operator: enums.SurveyRuleOperatorGreaterThanOrEqualTo, Value: "20"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		input   dto.SurveyRedFlagRuleInput
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: create survey red flag rule",
+			input:   validInput,
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid input",
+			input: dto.SurveyRedFlagRuleInput{
+				Name:       "Text score",
+				ProjectID:  1,
+				FormID:     uuid.NewString(),
+				Combinator: enums.SurveyRuleCombinatorAnd,
+				Conditions: []*dto.SurveyRuleConditionInput{
+					{Field: "phq_score", Operator: enums.SurveyRuleOperatorGreaterThan, Value: "high"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get logged in user",
+			input:   validInput,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get logged in user profile",
+			input:   validInput,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get survey form",
+			input:   validInput,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to create survey red flag rule",
+			input:   validInput,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSurveys := mockSurveys.NewSurveysMock()
+			fakeDB := pgMock.NewPostgresMock()
+			fakeNotification := mockNotification.NewServiceNotificationMock()
+			fakeServiceRequest := fakeServiceRequest.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			u := NewUsecaseSurveys(fakeSurveys, fakeDB, fakeDB, fakeDB, fakeNotification, fakeServiceRequest, fakeExtension)
+
+			if tt.name == "Sad case: failed to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get logged in user profile" {
+				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get survey form" {
+				fakeSurveys.MockGetSurveyFormFn = func(ctx context.Context, projectID int, formID string) (*domain.SurveyForm, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to create survey red flag rule" {
+				fakeDB.MockCreateSurveyRedFlagRuleFn = func(ctx context.Context, rule *domain.SurveyRedFlagRule) (*domain.SurveyRedFlagRule, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.CreateSurveyRedFlagRule(context.Background(), tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseSurveysImpl.CreateSurveyRedFlagRule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !got.Active || len(got.Conditions) != 2 || got.Conditions[0].Field != "feeling_unsafe" || got.Conditions[0].Value != "yes" {
+				t.Errorf("unexpected survey red flag rule %v", got)
+			}
+		})
+	}
+}
+
+func TestUsecaseSurveysImpl_ListSurveyRedFlagRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list survey red flag rules",
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: failed to get logged in user",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get user profile",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to list survey red flag rules",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSurveys := mockSurveys.NewSurveysMock()
+			fakeDB := pgMock.NewPostgresMock()
+			fakeNotification := mockNotification.NewServiceNotificationMock()
+			fakeServiceRequest := fakeServiceRequest.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			u := NewUsecaseSurveys(fakeSurveys, fakeDB, fakeDB, fakeDB, fakeNotification, fakeServiceRequest, fakeExtension)
+
+			programID := uuid.NewString()
+			fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+				return &domain.User{ID: &userID, CurrentProgramID: programID}, nil
+			}
+			fakeDB.MockListSurveyRedFlagRulesFn = func(ctx context.Context, projectID int, formID, ruleProgramID string) ([]*domain.SurveyRedFlagRule, error) {
+				if ruleProgramID != programID {
+					return nil, fmt.Errorf("expected the rules of program %s, got %s", programID, ruleProgramID)
+				}
+				return []*domain.SurveyRedFlagRule{{ID: uuid.NewString(), ProgramID: programID}}, nil
+			}
+
+			if tt.name == "Sad case: failed to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get user profile" {
+				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to list survey red flag rules" {
+				fakeDB.MockListSurveyRedFlagRulesFn = func(ctx context.Context, projectID int, formID, programID string) ([]*domain.SurveyRedFlagRule, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.ListSurveyRedFlagRules(context.Background(), 1, uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseSurveysImpl.ListSurveyRedFlagRules() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) != 1 {
+				t.Errorf("expected 1 survey red flag rule, got %d", len(got))
+			}
+		})
+	}
+}
+
+func TestUsecaseSurveysImpl_DeleteSurveyRedFlagRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: delete survey red flag rule",
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: failed to get user profile",
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to get survey red flag rule",
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: survey red flag rule belongs to another program",
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to deactivate survey red flag rule",
+			want:    false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSurveys := mockSurveys.NewSurveysMock()
+			fakeDB := pgMock.NewPostgresMock()
+			fakeNotification := mockNotification.NewServiceNotificationMock()
+			fakeServiceRequest := fakeServiceRequest.NewServiceRequestUseCaseMock()
+			fakeExtension := extensionMock.NewFakeExtension()
+			u := NewUsecaseSurveys(fakeSurveys, fakeDB, fakeDB, fakeDB, fakeNotification, fakeServiceRequest, fakeExtension)
+
+			programID := uuid.NewString()
+			fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+				return &domain.User{ID: &userID, CurrentProgramID: programID}, nil
+			}
+			fakeDB.MockGetSurveyRedFlagRuleByIDFn = func(ctx context.Context, ruleID string) (*domain.SurveyRedFlagRule, error) {
+				return &domain.SurveyRedFlagRule{ID: ruleID, ProgramID: programID}, nil
+			}
+
+			if tt.name == "Sad case: failed to get user profile" {
+				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: failed to get survey red flag rule" {
+				fakeDB.MockGetSurveyRedFlagRuleByIDFn = func(ctx context.Context, ruleID string) (*domain.SurveyRedFlagRule, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad case: survey red flag rule belongs to another program" {
+				fakeDB.MockGetSurveyRedFlagRuleByIDFn = func(ctx context.Context, ruleID string) (*domain.SurveyRedFlagRule, error) {
+					return &domain.SurveyRedFlagRule{ID: ruleID, ProgramID: uuid.NewString()}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to deactivate survey red flag rule" {
+				fakeDB.MockUpdateSurveyRedFlagRuleFn = func(ctx context.Context, rule *domain.SurveyRedFlagRule, updateData map[string]interface{}) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.DeleteSurveyRedFlagRule(context.Background(), uuid.NewString())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UsecaseSurveysImpl.DeleteSurveyRedFlagRule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("UsecaseSurveysImpl.DeleteSurveyRedFlagRule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
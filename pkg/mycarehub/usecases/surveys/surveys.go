@@ -41,11 +41,29 @@ type IListUsersWithSurveyWithServiceRequest interface {
 	GetSurveyServiceRequestUser(ctx context.Context, facilityID string, projectID int, formID string, paginationInput dto.PaginationsInput) (*domain.SurveyServiceRequestUserPage, error)
 }
 
+// ISurveyCampaigns contains the methods used to schedule surveys and send them out
+type ISurveyCampaigns interface {
+	CreateSurveyCampaign(ctx context.Context, input dto.SurveyCampaignInput) (*domain.SurveyCampaign, error)
+	CancelSurveyCampaign(ctx context.Context, campaignID string) (bool, error)
+	ListSurveyCampaigns(ctx context.Context, facilityID string) ([]*domain.SurveyCampaign, error)
+	GetSurveyCampaign(ctx context.Context, campaignID string) (*domain.SurveyCampaign, error)
+	ProcessSurveyCampaigns(ctx context.Context) error
+}
+
+// ISurveyRedFlagRules contains the methods used to manage the rules that flag survey submissions needing a follow up
+type ISurveyRedFlagRules interface {
+	CreateSurveyRedFlagRule(ctx context.Context, input dto.SurveyRedFlagRuleInput) (*domain.SurveyRedFlagRule, error)
+	ListSurveyRedFlagRules(ctx context.Context, projectID int, formID string) ([]*domain.SurveyRedFlagRule, error)
+	DeleteSurveyRedFlagRule(ctx context.Context, ruleID string) (bool, error)
+}
+
 // UsecaseSurveys groups al the interfaces for the Surveys usecase
 type UsecaseSurveys interface {
 	IListSurveys
 	IVerifySurveySubmission
 	IListUsersWithSurveyWithServiceRequest
+	ISurveyCampaigns
+	ISurveyRedFlagRules
 }
 
 // UsecaseSurveysImpl represents the Surveys implementation
@@ -112,6 +130,7 @@ func (u *UsecaseSurveysImpl) GetUserSurveyForms(ctx context.Context, userID stri
 // If the user has filled the survey and submitted their data, the method marks (in the database), that the survey has been  submitted.
 // This method is called when the user goes back from the page that used to fill surveys.
 // It also check from the responses whether the scoring requires creation of a service request. If it does, it creates the service request.
+// A service request is also created when the responses match any of the red flag rules of the survey form.
 func (u *UsecaseSurveysImpl) VerifySurveySubmission(ctx context.Context, input dto.VerifySurveySubmissionInput) (bool, error) {
 	submissions, err := u.Surveys.GetSubmissions(ctx, dto.VerifySurveySubmissionInput{ProjectID: input.ProjectID, FormID: input.FormID})
 	if err != nil {
@@ -161,62 +180,89 @@ func (u *UsecaseSurveysImpl) VerifySurveySubmission(ctx context.Context, input d
 		return false, fmt.Errorf("key 'data' not found in submission")
 	}
 
-	sendAlert, ok := submissonData["send_alert"].(string)
-	if !ok {
-		return true, nil
+	createServiceRequest := false
+	if sendAlert, ok := submissonData["send_alert"].(string); ok {
+		createServiceRequest, err = strconv.ParseBool(sendAlert)
+		if err != nil {
+			return false, err
+		}
 	}
 
-	createServiceRequest, err := strconv.ParseBool(sendAlert)
+	params := map[string]interface{}{
+		"project_id": input.ProjectID,
+		"form_id":    input.FormID,
+		"link_id":    input.SubmitterID,
+	}
+	surveys, err := u.Query.GetUserSurveyForms(ctx, params)
 	if err != nil {
+		helpers.ReportErrorToSentry(err)
 		return false, err
 	}
 
-	if createServiceRequest {
-		params := map[string]interface{}{
-			"project_id": input.ProjectID,
-			"form_id":    input.FormID,
-			"link_id":    input.SubmitterID,
-		}
-		surveys, err := u.Query.GetUserSurveyForms(ctx, params)
-		if err != nil {
-			helpers.ReportErrorToSentry(err)
-			return false, err
-		}
+	// should be only one survey
+	if len(surveys) != 1 {
+		return false, fmt.Errorf("expected 1 survey, got %d", len(surveys))
+	}
 
-		// should be only one survey
-		if len(surveys) != 1 {
-			return false, fmt.Errorf("expected 1 survey, got %d", len(surveys))
-		}
+	// only the rules of the program the survey was sent in are checked against the submission
+	rules, err := u.Query.ListSurveyRedFlagRules(ctx, input.ProjectID, input.FormID, surveys[0].ProgramID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, err
+	}
 
-		client, err := u.Query.GetClientProfile(ctx, surveys[0].UserID, surveys[0].ProgramID)
-		if err != nil {
-			helpers.ReportErrorToSentry(err)
-			return false, err
+	redFlagRules := []string{}
+	flaggedAnswers := map[string]string{}
+	for _, rule := range rules {
+		answers, matched := rule.Match(submissonData)
+		if !matched {
+			continue
 		}
 
-		serviceRequestInput := &dto.ServiceRequestInput{
-			ClientID:    *client.ID,
-			Flavour:     feedlib.FlavourConsumer,
-			RequestType: enums.ServiceRequestTypeSurveyRedFlag.String(),
-			Request:     fmt.Sprintf("%s survey response from %s.", surveys[0].Title, client.User.Name),
-			FacilityID:  *client.DefaultFacility.ID,
-			ClientName:  &client.User.Name,
-			Meta: map[string]interface{}{
-				"projectID":   input.ProjectID,
-				"formID":      input.FormID,
-				"submitterID": input.SubmitterID,
-				"surveyName":  surveys[0].Title,
-			},
-			ProgramID:      client.User.CurrentProgramID,
-			OrganisationID: client.User.CurrentOrganizationID,
+		redFlagRules = append(redFlagRules, rule.Name)
+		for field, answer := range answers {
+			flaggedAnswers[field] = answer
 		}
+	}
 
-		_, err = u.ServiceRequest.CreateServiceRequest(ctx, serviceRequestInput)
-		if err != nil {
-			helpers.ReportErrorToSentry(err)
-			return false, err
-		}
+	if !createServiceRequest && len(redFlagRules) == 0 {
+		return true, nil
+	}
+
+	client, err := u.Query.GetClientProfile(ctx, surveys[0].UserID, surveys[0].ProgramID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, err
+	}
+
+	meta := map[string]interface{}{
+		"projectID":   input.ProjectID,
+		"formID":      input.FormID,
+		"submitterID": input.SubmitterID,
+		"surveyName":  surveys[0].Title,
+	}
+	// the answers that matched the program's red flag rules are shown to the staff following up on the request
+	if len(redFlagRules) > 0 {
+		meta["redFlagRules"] = redFlagRules
+		meta["flaggedAnswers"] = flaggedAnswers
+	}
+
+	serviceRequestInput := &dto.ServiceRequestInput{
+		ClientID:       *client.ID,
+		Flavour:        feedlib.FlavourConsumer,
+		RequestType:    enums.ServiceRequestTypeSurveyRedFlag.String(),
+		Request:        fmt.Sprintf("%s survey response from %s.", surveys[0].Title, client.User.Name),
+		FacilityID:     *client.DefaultFacility.ID,
+		ClientName:     &client.User.Name,
+		Meta:           meta,
+		ProgramID:      client.User.CurrentProgramID,
+		OrganisationID: client.User.CurrentOrganizationID,
+	}
 
+	_, err = u.ServiceRequest.CreateServiceRequest(ctx, serviceRequestInput)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, err
 	}
 
 	return true, nil
@@ -285,20 +331,64 @@ func (u *UsecaseSurveysImpl) ListSurveys(ctx context.Context, projectID *int) ([
 
 // SendClientSurveyLinks sends survey links to clients
 func (u *UsecaseSurveysImpl) SendClientSurveyLinks(ctx context.Context, facilityID *string, formID *string, projectID *int, filterParams *dto.ClientFilterParamsInput) (bool, error) {
-	clients, err := u.Query.GetClientsByFilterParams(ctx, facilityID, filterParams)
+	loggedInUserID, err := u.ExternalExt.GetLoggedInUserUID(ctx)
 	if err != nil {
 		helpers.ReportErrorToSentry(err)
-		return false, fmt.Errorf("error getting clients: %w", err)
+		return false, err
+	}
+
+	loggedInUserProfile, err := u.Query.GetUserProfileByUserID(ctx, loggedInUserID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, err
+	}
+
+	err = u.sendSurveyLinks(ctx, surveyRound{
+		facilityID:     *facilityID,
+		projectID:      *projectID,
+		formID:         *formID,
+		filterParams:   filterParams,
+		programID:      loggedInUserProfile.CurrentProgramID,
+		organisationID: loggedInUserProfile.CurrentOrganizationID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// surveyRound holds the details needed to send a survey to the clients in a facility who match the filter params
+type surveyRound struct {
+	facilityID     string
+	projectID      int
+	formID         string
+	filterParams   *dto.ClientFilterParamsInput
+	programID      string
+	organisationID string
+
+	// campaignID and expiresAt are only set when a survey campaign sends out the survey
+	campaignID *string
+	expiresAt  *time.Time
+}
+
+// sendSurveyLinks generates a survey link for every client in the round who does not have a pending response to the same survey,
+// then notifies the clients of their new survey
+func (u *UsecaseSurveysImpl) sendSurveyLinks(ctx context.Context, round surveyRound) error {
+	clients, err := u.Query.GetClientsByFilterParams(ctx, &round.facilityID, round.filterParams)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return fmt.Errorf("error getting clients: %w", err)
 	}
 
 	if len(clients) == 0 {
-		return true, nil
+		return nil
 	}
 
-	surveyForm, err := u.Surveys.GetSurveyForm(ctx, *projectID, *formID)
+	surveyForm, err := u.Surveys.GetSurveyForm(ctx, round.projectID, round.formID)
 	if err != nil {
 		helpers.ReportErrorToSentry(err)
-		return false, fmt.Errorf("error getting survey form: %w", err)
+		return fmt.Errorf("error getting survey form: %w", err)
 	}
 
 	// alert is a temporary type that holds survey notification input
@@ -311,26 +401,15 @@ func (u *UsecaseSurveysImpl) SendClientSurveyLinks(ctx context.Context, facility
 
 	userSurveyInputs := []*dto.UserSurveyInput{}
 
-	loggedInUserID, err := u.ExternalExt.GetLoggedInUserUID(ctx)
-	if err != nil {
-		helpers.ReportErrorToSentry(err)
-		return false, err
-	}
-
-	loggedInUserProfile, err := u.Query.GetUserProfileByUserID(ctx, loggedInUserID)
-	if err != nil {
-		helpers.ReportErrorToSentry(err)
-		return false, err
-	}
-
 	for _, client := range clients {
 		// validate if they have an existing survey that has been sent
 		// If a survey exists for a client, continue to the next client
 		params := map[string]interface{}{
 			"user_id":       client.UserID,
-			"project_id":    *projectID,
-			"form_id":       *formID,
+			"project_id":    round.projectID,
+			"form_id":       round.formID,
 			"has_submitted": false,
+			"active":        true,
 		}
 		userSurveys, err := u.Query.GetUserSurveyForms(ctx, params)
 		if err != nil {
@@ -342,8 +421,8 @@ func (u *UsecaseSurveysImpl) SendClientSurveyLinks(ctx context.Context, facility
 		}
 
 		accessTokenInput := dto.SurveyLinkInput{
-			ProjectID:   *projectID,
-			FormID:      *formID,
+			ProjectID:   round.projectID,
+			FormID:      round.formID,
 			DisplayName: client.UserID,
 			OnceOnly:    true,
 		}
@@ -351,21 +430,23 @@ func (u *UsecaseSurveysImpl) SendClientSurveyLinks(ctx context.Context, facility
 		publicAccessToken, err := u.Surveys.GeneratePublicAccessLink(ctx, accessTokenInput)
 		if err != nil {
 			helpers.ReportErrorToSentry(err)
-			return false, fmt.Errorf("error generating public access link for user: %w", err)
+			return fmt.Errorf("error generating public access link for user: %w", err)
 		}
 
 		link := fmt.Sprintf("%s/-/single/%s?st=%s", surveyBaseURL, surveyForm.EnketoID, publicAccessToken.Token)
 
 		userSurveyInput := &dto.UserSurveyInput{
 			UserID:         client.UserID,
-			ProjectID:      *projectID,
-			FormID:         *formID,
+			ProjectID:      round.projectID,
+			FormID:         round.formID,
 			Title:          surveyForm.Name,
 			Link:           link,
 			LinkID:         publicAccessToken.ID,
 			Token:          publicAccessToken.Token,
-			ProgramID:      loggedInUserProfile.CurrentProgramID,
-			OrganisationID: loggedInUserProfile.CurrentOrganizationID,
+			ProgramID:      round.programID,
+			OrganisationID: round.organisationID,
+			CampaignID:     round.campaignID,
+			ExpiresAt:      round.expiresAt,
 		}
 		userSurveyInputs = append(userSurveyInputs, userSurveyInput)
 
@@ -376,7 +457,7 @@ func (u *UsecaseSurveysImpl) SendClientSurveyLinks(ctx context.Context, facility
 	err = u.Create.CreateUserSurveys(ctx, userSurveyInputs)
 	if err != nil {
 		helpers.ReportErrorToSentry(err)
-		return false, fmt.Errorf("error creating user survey: %w", err)
+		return fmt.Errorf("error creating user survey: %w", err)
 	}
 
 	for _, alert := range clientsNotifications {
@@ -398,7 +479,7 @@ func (u *UsecaseSurveysImpl) SendClientSurveyLinks(ctx context.Context, facility
 		}
 	}
 
-	return true, nil
+	return nil
 }
 
 // ListSurveyRespondents lists the respondents for a given survey
@@ -482,3 +563,20 @@ func (u *UsecaseSurveysImpl) GetSurveyServiceRequestUser(ctx context.Context, fa
 		Pagination: *pageInfo,
 	}, nil
 }
+
+// getLoggedInUserProfile returns the profile of the logged in user, which holds the program they are currently in
+func (u *UsecaseSurveysImpl) getLoggedInUserProfile(ctx context.Context) (*domain.User, error) {
+	loggedInUserID, err := u.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get logged in user: %w", err)
+	}
+
+	loggedInUserProfile, err := u.Query.GetUserProfileByUserID(ctx, loggedInUserID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return nil, fmt.Errorf("failed to get user profile: %w", err)
+	}
+
+	return loggedInUserProfile, nil
+}
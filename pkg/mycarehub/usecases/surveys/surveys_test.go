@@ -657,6 +657,32 @@ func TestUsecaseSurveysImpl_VerifySurveySubmission(t *testing.T) {
 			want:    true,
 			wantErr: false,
 		},
+		{
+			name: "happy case - red flag rule matched without 'send_alert' key",
+			args: args{
+				ctx: context.Background(),
+				input: dto.VerifySurveySubmissionInput{
+					ProjectID:   10000000000000,
+					FormID:      uuid.New().String(),
+					SubmitterID: 1096,
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Sad case - unable to list survey red flag rules",
+			args: args{
+				ctx: context.Background(),
+				input: dto.VerifySurveySubmissionInput{
+					ProjectID:   10000000000000,
+					FormID:      uuid.New().String(),
+					SubmitterID: 1096,
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
 		{
 			name: "Sad case - unable to update survey",
 			args: args{
@@ -792,6 +818,50 @@ func TestUsecaseSurveysImpl_VerifySurveySubmission(t *testing.T) {
 				}
 			}
 
+			if tt.name == "happy case - red flag rule matched without 'send_alert' key" {
+				fakeSurveys.MockGetSubmissionXMLFn = func(ctx context.Context, projectID int, formID, instanceID string) (map[string]interface{}, error) {
+					return map[string]interface{}{
+						"data": map[string]interface{}{
+							"feeling_unsafe": "Yes",
+						},
+					}, nil
+				}
+				programID := uuid.NewString()
+				fakeDB.MockGetUserSurveyFormsFn = func(ctx context.Context, params map[string]interface{}) ([]*domain.UserSurvey, error) {
+					return []*domain.UserSurvey{
+						{ID: uuid.NewString(), Title: "SurveyTitle", UserID: uuid.NewString(), ProgramID: programID},
+					}, nil
+				}
+				fakeDB.MockListSurveyRedFlagRulesFn = func(ctx context.Context, projectID int, formID, ruleProgramID string) ([]*domain.SurveyRedFlagRule, error) {
+					if ruleProgramID != programID {
+						return nil, fmt.Errorf("expected the rules of the survey's program %s, got %s", programID, ruleProgramID)
+					}
+					return []*domain.SurveyRedFlagRule{
+						{
+							ID:         uuid.NewString(),
+							Name:       "Feels unsafe",
+							Combinator: enums.SurveyRuleCombinatorAnd,
+							Conditions: []domain.SurveyRuleCondition{
+								{Field: "feeling_unsafe", Operator: enums.SurveyRuleOperatorEquals, Value: "yes"},
+							},
+							ProgramID: programID,
+						},
+					}, nil
+				}
+				fakeServiceRequest.MockCreateServiceRequestFn = func(ctx context.Context, input *dto.ServiceRequestInput) (bool, error) {
+					answers, ok := input.Meta["flaggedAnswers"].(map[string]string)
+					if !ok || answers["feeling_unsafe"] != "Yes" {
+						return false, fmt.Errorf("expected the flagged answers in the service request meta, got %v", input.Meta)
+					}
+					return true, nil
+				}
+			}
+			if tt.name == "Sad case - unable to list survey red flag rules" {
+				fakeDB.MockListSurveyRedFlagRulesFn = func(ctx context.Context, projectID int, formID, programID string) ([]*domain.SurveyRedFlagRule, error) {
+					return nil, fmt.Errorf("failed to list survey red flag rules")
+				}
+			}
+
 			if tt.name == "Sad case - fail to retrieve submissions" {
 				fakeSurveys.MockGetSubmissionsFn = func(ctx context.Context, input dto.VerifySurveySubmissionInput) ([]domain.Submission, error) {
 					return nil, fmt.Errorf("failed to get submitters")
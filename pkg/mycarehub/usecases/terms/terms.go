@@ -6,6 +6,7 @@ import (
 
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/common/helpers"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/exceptions"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure"
 )
@@ -34,9 +35,10 @@ type UseCasesTerms interface {
 
 // ServiceTermsImpl represents terms implementation object
 type ServiceTermsImpl struct {
-	Query  infrastructure.Query
-	Update infrastructure.Update
-	Create infrastructure.Create
+	Query       infrastructure.Query
+	Update      infrastructure.Update
+	Create      infrastructure.Create
+	ExternalExt extension.ExternalMethodsExtension
 }
 
 // NewUseCasesTermsOfService is the controler for the terms usecases
@@ -44,11 +46,13 @@ func NewUseCasesTermsOfService(
 	query infrastructure.Query,
 	update infrastructure.Update,
 	create infrastructure.Create,
+	externalExt extension.ExternalMethodsExtension,
 ) *ServiceTermsImpl {
 	return &ServiceTermsImpl{
-		Query:  query,
-		Update: update,
-		Create: create,
+		Query:       query,
+		Update:      update,
+		Create:      create,
+		ExternalExt: externalExt,
 	}
 }
 
@@ -63,8 +67,19 @@ func (t *ServiceTermsImpl) GetCurrentTerms(ctx context.Context) (*domain.TermsOf
 	return termsOfService, nil
 }
 
-// AcceptTerms can be used to accept or review terms of service
+// AcceptTerms can be used to accept or review terms of service. A user can only accept terms on their own behalf
 func (t *ServiceTermsImpl) AcceptTerms(ctx context.Context, userID *string, termsID *int) (bool, error) {
+	loggedInUserID, err := t.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.GetLoggedInUserUIDErr(err)
+	}
+	if userID == nil || *userID != loggedInUserID {
+		err := fmt.Errorf("user %s cannot accept terms on behalf of another user", loggedInUserID)
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.UserNotAuthorizedErr(err)
+	}
+
 	ok, err := t.Update.AcceptTerms(ctx, userID, termsID)
 	if err != nil {
 		helpers.ReportErrorToSentry(err)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/brianvoe/gofakeit"
+	extensionMock "github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension/mock"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	pgMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/mock"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/terms"
@@ -43,9 +44,10 @@ func TestTermsOfServiceImpl_GetCurrentTerms_Unittest(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
 			_ = mock.NewTermsUseCaseMock()
 
-			j := terms.NewUseCasesTermsOfService(fakeDB, fakeDB, fakeDB)
+			j := terms.NewUseCasesTermsOfService(fakeDB, fakeDB, fakeDB, fakeExtension)
 
 			if tt.name == "Sad case - nil context" {
 				fakeDB.MockGetCurrentTermsFn = func(ctx context.Context) (*domain.TermsOfService, error) {
@@ -101,6 +103,26 @@ func TestServiceTermsImpl_AcceptTerms(t *testing.T) {
 			want:    false,
 			wantErr: true,
 		},
+		{
+			name: "Sad case - accepting terms for another user",
+			args: args{
+				ctx:     ctx,
+				userID:  &userID,
+				termsID: &termsID,
+			},
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name: "Sad case - failed to get logged in user",
+			args: args{
+				ctx:     ctx,
+				userID:  &userID,
+				termsID: &termsID,
+			},
+			want:    false,
+			wantErr: true,
+		},
 		{
 			name: "Sad case - no termsID",
 			args: args{
@@ -155,10 +177,24 @@ func TestServiceTermsImpl_AcceptTerms(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			fakeDB := pgMock.NewPostgresMock()
+			fakeExtension := extensionMock.NewFakeExtension()
 			_ = mock.NewTermsUseCaseMock()
 
-			j := terms.NewUseCasesTermsOfService(fakeDB, fakeDB, fakeDB)
+			j := terms.NewUseCasesTermsOfService(fakeDB, fakeDB, fakeDB, fakeExtension)
 
+			fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+				return userID, nil
+			}
+			if tt.name == "Sad case - accepting terms for another user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return ksuid.New().String(), nil
+				}
+			}
+			if tt.name == "Sad case - failed to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
 			if tt.name == "Sad case" {
 				fakeDB.MockAcceptTermsFn = func(ctx context.Context, userID *string, termsID *int) (bool, error) {
 					return false, fmt.Errorf("an error occurred")
@@ -250,7 +286,8 @@ func TestServiceTermsImpl_CreateTermsOfService(t *testing.T) {
 				}
 			}
 
-			tr := terms.NewUseCasesTermsOfService(fakeDB, fakeDB, fakeDB)
+			fakeExtension := extensionMock.NewFakeExtension()
+			tr := terms.NewUseCasesTermsOfService(fakeDB, fakeDB, fakeDB, fakeExtension)
 			_, err := tr.CreateTermsOfService(tt.args.ctx, tt.args.termsOfService)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ServiceTermsImpl.CreateTermsOfService() error = %v, wantErr %v", err, tt.wantErr)
@@ -16,6 +16,7 @@ import (
 	smsMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/sms/mock"
 	twilioMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/twilio/mock"
 	authorityMock "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/authority/mock"
+	communityMock "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/communities/mock"
 	otpMock "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/otp/mock"
 	"gorm.io/gorm"
 )
@@ -88,8 +89,9 @@ func TestUseCasesUserImpl_caregiverProfileCheck(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
 
-			us := NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			us := NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "sad case: fail to check caregiver profile" {
 				fakeDB.MockCheckCaregiverExistsFn = func(ctx context.Context, userID string) (bool, error) {
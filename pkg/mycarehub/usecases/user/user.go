@@ -20,6 +20,7 @@ import (
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/exceptions"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/utils"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/authorization"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/gorm"
@@ -29,6 +30,7 @@ import (
 	serviceSMS "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/sms"
 	serviceTwilio "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/twilio"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/authority"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/communities"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/otp"
 	"github.com/savannahghi/scalarutils"
 	"github.com/savannahghi/serverutils"
@@ -210,6 +212,7 @@ type UseCasesUserImpl struct {
 	SMS         serviceSMS.IServiceSMS
 	Twilio      serviceTwilio.ITwilioService
 	Matrix      serviceMatrix.Matrix
+	Community   communities.UseCasesCommunities
 }
 
 // NewUseCasesUserImpl returns a new user service
@@ -226,6 +229,7 @@ func NewUseCasesUserImpl(
 	sms serviceSMS.IServiceSMS,
 	twilio serviceTwilio.ITwilioService,
 	matrix serviceMatrix.Matrix,
+	community communities.UseCasesCommunities,
 ) *UseCasesUserImpl {
 	return &UseCasesUserImpl{
 		Create:      create,
@@ -240,6 +244,7 @@ func NewUseCasesUserImpl(
 		SMS:         sms,
 		Twilio:      twilio,
 		Matrix:      matrix,
+		Community:   community,
 	}
 }
 
@@ -459,8 +464,29 @@ func (us *UseCasesUserImpl) SetUserPIN(ctx context.Context, input dto.PINInput)
 	return true, nil
 }
 
+// checkLoggedInUser ensures that the logged in user is the user whose account is being changed
+func (us *UseCasesUserImpl) checkLoggedInUser(ctx context.Context, userID string) error {
+	loggedInUserID, err := us.ExternalExt.GetLoggedInUserUID(ctx)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return exceptions.GetLoggedInUserUIDErr(err)
+	}
+
+	if loggedInUserID != userID {
+		err := fmt.Errorf("user %s cannot act on behalf of user %s", loggedInUserID, userID)
+		helpers.ReportErrorToSentry(err)
+		return exceptions.UserNotAuthorizedErr(err)
+	}
+
+	return nil
+}
+
 // SetNickName is used to set the user's nickname. The nickname is also the username
 func (us *UseCasesUserImpl) SetNickName(ctx context.Context, userID string, nickname string) (bool, error) {
+	if err := us.checkLoggedInUser(ctx, userID); err != nil {
+		return false, err
+	}
+
 	exists, err := us.Query.CheckIfUsernameExists(ctx, nickname)
 	if err != nil {
 		helpers.ReportErrorToSentry(err)
@@ -502,33 +528,11 @@ func (us *UseCasesUserImpl) RequestPINReset(ctx context.Context, username string
 		return "", exceptions.ExistingPINError(err)
 	}
 
-	phone, err := us.Query.GetContactByUserID(ctx, userProfile.ID, "PHONE")
-	if err != nil {
-		helpers.ReportErrorToSentry(err)
-		return "", exceptions.ContactNotFoundErr(err)
-	}
-
-	response, err := us.OTP.GenerateAndSendOTP(ctx, username, flavour)
-	if err != nil {
-		helpers.ReportErrorToSentry(err)
-		return "", fmt.Errorf("failed to generate and send OTP: %w", err)
-	}
-
-	otpDataPayload := &domain.OTP{
-		UserID:      *userProfile.ID,
-		Valid:       true,
-		GeneratedAt: time.Now(),
-		ValidUntil:  time.Now().Add(time.Hour * 1),
-		Channel:     "SMS",
-		Flavour:     flavour,
-		PhoneNumber: phone.ContactValue,
-		OTP:         response.OTP,
-	}
-
-	err = us.Create.SaveOTP(ctx, otpDataPayload)
+	// GeneratePINResetOTP persists the OTP and enforces the OTP send limit
+	response, err := us.OTP.GeneratePINResetOTP(ctx, username, flavour)
 	if err != nil {
 		helpers.ReportErrorToSentry(err)
-		return "", fmt.Errorf("failed to save otp")
+		return "", err
 	}
 
 	return response.OTP, nil
@@ -539,6 +543,10 @@ func (us *UseCasesUserImpl) RequestPINReset(ctx context.Context, username string
 // through the process of setting a new pin, accepting terms and setting security questions. After all this is done,
 // the field will be set to false. It will enable the user to be directed to the login page when they log in again.
 func (us *UseCasesUserImpl) CompleteOnboardingTour(ctx context.Context, userID string, flavour feedlib.Flavour) (bool, error) {
+	if err := us.checkLoggedInUser(ctx, userID); err != nil {
+		return false, err
+	}
+
 	return us.Update.CompleteOnboardingTour(ctx, userID, flavour)
 }
 
@@ -631,6 +639,20 @@ func (us *UseCasesUserImpl) ResetPIN(ctx context.Context, input dto.UserResetPin
 		return false, exceptions.ResetPinErr(err)
 	}
 
+	auditLog := &domain.AuditLog{
+		RecordType:     enums.AuditLogRecordTypePINReset,
+		Notes:          "user reset their PIN using an OTP",
+		ActorID:        *userProfile.ID,
+		TargetID:       *userProfile.ID,
+		TargetType:     "USER",
+		NewValue:       map[string]interface{}{"flavour": input.Flavour},
+		OrganisationID: userProfile.CurrentOrganizationID,
+		ProgramID:      userProfile.CurrentProgramID,
+	}
+	if err := us.Create.CreateAuditLog(ctx, auditLog); err != nil {
+		helpers.ReportErrorToSentry(err)
+	}
+
 	return true, nil
 }
 
@@ -709,9 +731,9 @@ func (us *UseCasesUserImpl) RegisterClient(
 	}
 	input.ProgramID = userProfile.CurrentProgramID
 
-	matrixLoginPayload := &domain.MatrixAuth{
-		Username: userProfile.Username,
-		Password: loggedInUserID,
+	matrixLoginPayload, err := us.Community.GetMatrixAuth(ctx, userProfile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register user. Reason(Matrix): %w", err)
 	}
 
 	_, err = us.Matrix.CheckIfUserIsAdmin(ctx, matrixLoginPayload, userProfile.Username)
@@ -861,17 +883,13 @@ func (us *UseCasesUserImpl) RegisterClient(
 		}
 	}
 
-	matrixUserRegistrationPayload := &domain.MatrixUserRegistration{
-		Username: registeredClient.User.Username,
-		Password: registeredClient.UserID,
-		Admin:    false,
-	}
-
-	_, err = us.Matrix.RegisterUser(ctx, matrixLoginPayload, matrixUserRegistrationPayload)
+	err = us.Community.RegisterMatrixUser(ctx, registeredClient.User, false)
 	if err != nil {
 		return nil, err
 	}
 
+	us.syncClientCommunities(ctx, *registeredClient.ID)
+
 	return &dto.ClientRegistrationOutput{
 		ID:                *registeredClient.ID,
 		Active:            registeredClient.Active,
@@ -943,6 +961,8 @@ func (us *UseCasesUserImpl) RegisterExistingUserAsClient(ctx context.Context, in
 		return nil, err
 	}
 
+	us.syncClientCommunities(ctx, *registeredClient.ID)
+
 	return &dto.ClientRegistrationOutput{
 		ID:                *registeredClient.ID,
 		Active:            registeredClient.Active,
@@ -968,9 +988,9 @@ func (us *UseCasesUserImpl) RegisterCaregiver(ctx context.Context, input dto.Car
 		return nil, err
 	}
 
-	matrixLoginPayload := &domain.MatrixAuth{
-		Username: loggedInUser.Username,
-		Password: loggedInUserID,
+	matrixLoginPayload, err := us.Community.GetMatrixAuth(ctx, loggedInUser)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register user. Reason(Matrix): %w", err)
 	}
 
 	_, err = us.Matrix.CheckIfUserIsAdmin(ctx, matrixLoginPayload, loggedInUser.Username)
@@ -1039,13 +1059,7 @@ func (us *UseCasesUserImpl) RegisterCaregiver(ctx context.Context, input dto.Car
 		}
 	}
 
-	matrixUserRegistrationPayload := &domain.MatrixUserRegistration{
-		Username: profile.User.Username,
-		Password: *profile.User.ID,
-		Admin:    false,
-	}
-
-	_, err = us.Matrix.RegisterUser(ctx, matrixLoginPayload, matrixUserRegistrationPayload)
+	err = us.Community.RegisterMatrixUser(ctx, &profile.User, false)
 	if err != nil {
 		return nil, err
 	}
@@ -1464,6 +1478,11 @@ func (us *UseCasesUserImpl) RegisterStaffProfile(ctx context.Context, input dto.
 		return nil, fmt.Errorf("unable to register staff: %w", err)
 	}
 
+	err = us.Authority.AssignSystemRole(ctx, *staff.ID, input.OrganisationID, authorization.DefaultRoleStaff)
+	if err != nil {
+		return nil, fmt.Errorf("unable to assign the default staff role: %w", err)
+	}
+
 	handle := fmt.Sprintf("@%v", input.Username)
 	cmsStaffPayload := &dto.PubsubCreateCMSStaffPayload{
 		UserID: staff.UserID,
@@ -1523,9 +1542,9 @@ func (us *UseCasesUserImpl) RegisterStaff(ctx context.Context, input dto.StaffRe
 	input.ProgramID = userProfile.CurrentProgramID
 	input.OrganisationID = userProfile.CurrentOrganizationID
 
-	matrixLoginPayload := &domain.MatrixAuth{
-		Username: userProfile.Username,
-		Password: loggedInUserID,
+	matrixLoginPayload, err := us.Community.GetMatrixAuth(ctx, userProfile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register user. Reason(Matrix): %w", err)
 	}
 
 	_, err = us.Matrix.CheckIfUserIsAdmin(ctx, matrixLoginPayload, userProfile.Username)
@@ -1538,13 +1557,7 @@ func (us *UseCasesUserImpl) RegisterStaff(ctx context.Context, input dto.StaffRe
 		return nil, err
 	}
 
-	matrixUserRegistrationPayload := &domain.MatrixUserRegistration{
-		Username: staffProfile.UserProfile.Username,
-		Password: staffProfile.UserID,
-		Admin:    true,
-	}
-
-	_, err = us.Matrix.RegisterUser(ctx, matrixLoginPayload, matrixUserRegistrationPayload)
+	err = us.Community.RegisterMatrixUser(ctx, staffProfile.UserProfile, true)
 	if err != nil {
 		return nil, err
 	}
@@ -1561,7 +1574,18 @@ func (us *UseCasesUserImpl) RegisterOrganisationAdmin(ctx context.Context, input
 	}
 	input.ProgramID = program.ID
 	input.OrganisationID = program.Organisation.ID
-	return us.RegisterStaffProfile(ctx, input)
+
+	staff, err := us.RegisterStaffProfile(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	err = us.Authority.AssignSystemRole(ctx, staff.ID, input.OrganisationID, authorization.DefaultRoleAdmin)
+	if err != nil {
+		return nil, fmt.Errorf("unable to assign the default admin role: %w", err)
+	}
+
+	return staff, nil
 }
 
 // RegisterExistingUserAsStaff is used to register an existing user as a staff member
@@ -1615,6 +1639,11 @@ func (us *UseCasesUserImpl) RegisterExistingUserAsStaff(ctx context.Context, inp
 		return nil, fmt.Errorf("unable to register staff: %w", err)
 	}
 
+	err = us.Authority.AssignSystemRole(ctx, *staff.ID, userProfile.CurrentOrganizationID, authorization.DefaultRoleStaff)
+	if err != nil {
+		return nil, fmt.Errorf("unable to assign the default staff role: %w", err)
+	}
+
 	return &dto.StaffRegistrationOutput{
 		ID:              *staff.ID,
 		Active:          staff.Active,
@@ -1655,8 +1684,19 @@ func (us *UseCasesUserImpl) SearchStaffUser(ctx context.Context, searchParameter
 }
 
 // Consent gives the client an option to choose to withdraw from the app by withdrawing their consent.
+// Only the logged in user's own phone number can be used to withdraw
 func (us *UseCasesUserImpl) Consent(ctx context.Context, phoneNumber string, flavour feedlib.Flavour) (bool, error) {
-	_, err := us.DeleteUser(ctx, &dto.PhoneInput{
+	user, err := us.Query.GetUserProfileByPhoneNumber(ctx, phoneNumber)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.UserNotFoundError(err)
+	}
+
+	if err := us.checkLoggedInUser(ctx, *user.ID); err != nil {
+		return false, err
+	}
+
+	_, err = us.DeleteUser(ctx, &dto.PhoneInput{
 		PhoneNumber: phoneNumber,
 		Flavour:     flavour,
 	})
@@ -1783,6 +1823,23 @@ func (us *UseCasesUserImpl) DeleteUser(ctx context.Context, payload *dto.PhoneIn
 		}
 	}
 
+	auditLog := &domain.AuditLog{
+		RecordType: enums.AuditLogRecordTypeUserDeletion,
+		Notes:      "user profile deleted",
+		TargetID:   *user.ID,
+		TargetType: "USER",
+		OldValue: map[string]interface{}{
+			"name":     user.Name,
+			"username": user.Username,
+			"flavour":  payload.Flavour,
+		},
+		OrganisationID: user.CurrentOrganizationID,
+		ProgramID:      user.CurrentProgramID,
+	}
+	if err := us.Create.CreateAuditLog(ctx, auditLog); err != nil {
+		helpers.ReportErrorToSentry(err)
+	}
+
 	return true, nil
 }
 
@@ -1836,6 +1893,23 @@ func (us *UseCasesUserImpl) TransferClientToFacility(ctx context.Context, client
 			return false, exceptions.InternalErr(err)
 		}
 	}
+
+	auditLog := &domain.AuditLog{
+		RecordType:     enums.AuditLogRecordTypeClientFacilityTransfer,
+		Notes:          "client transferred to a different facility",
+		TargetID:       *clientID,
+		TargetType:     "CLIENT",
+		OldValue:       map[string]interface{}{"facilityID": currentClientFacilityID},
+		NewValue:       map[string]interface{}{"facilityID": *facilityID},
+		OrganisationID: clientProfile.OrganisationID,
+		ProgramID:      clientProfile.ProgramID,
+	}
+	if err := us.Create.CreateAuditLog(ctx, auditLog); err != nil {
+		helpers.ReportErrorToSentry(err)
+	}
+
+	us.syncClientCommunities(ctx, *clientID)
+
 	return true, nil
 }
 
@@ -1900,6 +1974,8 @@ func (us *UseCasesUserImpl) SetClientDefaultFacility(ctx context.Context, client
 		return nil, err
 	}
 
+	us.syncClientCommunities(ctx, clientID)
+
 	currentFacility, err := us.Query.RetrieveFacility(ctx, &facilityID, true)
 	if err != nil {
 		helpers.ReportErrorToSentry(err)
@@ -1909,6 +1985,16 @@ func (us *UseCasesUserImpl) SetClientDefaultFacility(ctx context.Context, client
 	return currentFacility, nil
 }
 
+// syncClientCommunities updates a client's community memberships after a change to the details used as membership criteria.
+// Failures are only reported since the next community reconciliation corrects any missed memberships
+func (us *UseCasesUserImpl) syncClientCommunities(ctx context.Context, clientID string) {
+	err := us.Community.SyncClientCommunities(ctx, clientID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		log.Printf("failed to sync the communities of client %s: %v", clientID, err)
+	}
+}
+
 // AddFacilitiesToClientProfile updates the client facility list
 func (us *UseCasesUserImpl) AddFacilitiesToClientProfile(ctx context.Context, clientID string, facilities []string) (bool, error) {
 	if clientID == "" {
@@ -2121,11 +2207,27 @@ func (us *UseCasesUserImpl) ListClientsCaregivers(ctx context.Context, clientID
 
 // ConsentToAClientCaregiver is used to mark whether the client has acknowledged to having a certain caregiver assigned to them
 func (us *UseCasesUserImpl) ConsentToAClientCaregiver(ctx context.Context, clientID string, caregiverID string, consent bool) (bool, error) {
+	client, err := us.Query.GetClientProfileByClientID(ctx, clientID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.ClientProfileNotFoundErr(err)
+	}
+
+	if err := us.checkLoggedInUser(ctx, client.UserID); err != nil {
+		return false, err
+	}
+
 	caregiverClient := &domain.CaregiverClient{
 		ClientID:    clientID,
 		CaregiverID: caregiverID,
 	}
 
+	caregiverClients, err := us.Query.GetCaregiversClient(ctx, *caregiverClient)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to get client caregiver: %w", err)
+	}
+
 	updateData := map[string]interface{}{
 		"client_consent":    consent,
 		"client_consent_at": time.Now(),
@@ -2136,16 +2238,48 @@ func (us *UseCasesUserImpl) ConsentToAClientCaregiver(ctx context.Context, clien
 		return false, fmt.Errorf("failed to update client consent: %w", err)
 	}
 
+	auditLog := &domain.AuditLog{
+		RecordType: enums.AuditLogRecordTypeCaregiverConsent,
+		Notes:      "client consent to a caregiver updated",
+		TargetID:   clientID,
+		TargetType: "CLIENT",
+		NewValue:   map[string]interface{}{"caregiverID": caregiverID, "clientConsent": consent},
+	}
+	if len(caregiverClients) > 0 {
+		auditLog.OldValue = map[string]interface{}{"caregiverID": caregiverID, "clientConsent": caregiverClients[0].ClientConsent}
+		auditLog.OrganisationID = caregiverClients[0].OrganisationID
+		auditLog.ProgramID = caregiverClients[0].ProgramID
+	}
+	if err := us.Create.CreateAuditLog(ctx, auditLog); err != nil {
+		helpers.ReportErrorToSentry(err)
+	}
+
 	return true, nil
 }
 
 // ConsentToManagingClient is used to update caregiver as having consented to offer their service to a caregiver
 func (us *UseCasesUserImpl) ConsentToManagingClient(ctx context.Context, caregiverID string, clientID string, consent bool) (bool, error) {
+	caregiver, err := us.Query.GetCaregiverProfileByCaregiverID(ctx, caregiverID)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, exceptions.ProfileNotFoundErr(err)
+	}
+
+	if err := us.checkLoggedInUser(ctx, caregiver.UserID); err != nil {
+		return false, err
+	}
+
 	caregiverClient := &domain.CaregiverClient{
 		CaregiverID: caregiverID,
 		ClientID:    clientID,
 	}
 
+	caregiverClients, err := us.Query.GetCaregiversClient(ctx, *caregiverClient)
+	if err != nil {
+		helpers.ReportErrorToSentry(err)
+		return false, fmt.Errorf("failed to get caregiver client: %w", err)
+	}
+
 	updateData := map[string]interface{}{
 		"caregiver_consent":    consent,
 		"caregiver_consent_at": time.Now(),
@@ -2156,6 +2290,22 @@ func (us *UseCasesUserImpl) ConsentToManagingClient(ctx context.Context, caregiv
 		return false, err
 	}
 
+	auditLog := &domain.AuditLog{
+		RecordType: enums.AuditLogRecordTypeCaregiverConsent,
+		Notes:      "caregiver consent to managing a client updated",
+		TargetID:   caregiverID,
+		TargetType: "CAREGIVER",
+		NewValue:   map[string]interface{}{"clientID": clientID, "caregiverConsent": consent},
+	}
+	if len(caregiverClients) > 0 {
+		auditLog.OldValue = map[string]interface{}{"clientID": clientID, "caregiverConsent": caregiverClients[0].CaregiverConsent}
+		auditLog.OrganisationID = caregiverClients[0].OrganisationID
+		auditLog.ProgramID = caregiverClients[0].ProgramID
+	}
+	if err := us.Create.CreateAuditLog(ctx, auditLog); err != nil {
+		helpers.ReportErrorToSentry(err)
+	}
+
 	return true, nil
 }
 
@@ -2623,15 +2773,10 @@ func (us *UseCasesUserImpl) CreateSuperUser(ctx context.Context, input dto.Staff
 		return nil, err
 	}
 
-	// UpdateRoles is used to update the roles the superuser to have the system admin role
-	// TODO: update after implementing RBAC
-	// var staffRoles []enums.UserRoleType
-	// staffRoles = append(staffRoles, enums.UserRoleTypeSystemAdministrator)
-	// _, err = us.Update.AssignRoles(ctx, staff.UserID, staffRoles)
-	// if err != nil {
-	// 	helpers.ReportErrorToSentry(fmt.Errorf("%w", err))
-	// 	return nil, fmt.Errorf("unable to assign roles: %w", err)
-	// }
+	err = us.Authority.AssignSystemRole(ctx, *staff.ID, input.OrganisationID, authorization.DefaultRoleSuperUser)
+	if err != nil {
+		return nil, fmt.Errorf("unable to assign the super user role: %w", err)
+	}
 
 	handle := fmt.Sprintf("@%v", input.Username)
 	cmsStaffPayload := &dto.PubsubCreateCMSStaffPayload{
@@ -2667,18 +2812,7 @@ func (us *UseCasesUserImpl) CreateSuperUser(ctx context.Context, input dto.Staff
 		}
 	}
 
-	matrixLoginPayload := &domain.MatrixAuth{
-		Username: serverutils.MustGetEnvVar("MCH_MATRIX_USER"),
-		Password: serverutils.MustGetEnvVar("MCH_MATRIX_PASSWORD"),
-	}
-
-	matrixUserRegistrationPayload := &domain.MatrixUserRegistration{
-		Username: staff.User.Username,
-		Password: staff.UserID,
-		Admin:    true,
-	}
-
-	_, err = us.Matrix.RegisterUser(ctx, matrixLoginPayload, matrixUserRegistrationPayload)
+	err = us.Community.RegisterMatrixUser(ctx, staff.User, true)
 	if err != nil {
 		return nil, err
 	}
@@ -23,8 +23,10 @@ import (
 	"github.com/savannahghi/interserviceclient"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/dto"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/enums"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/exceptions"
 	extensionMock "github.com/savannahghi/mycarehub/pkg/mycarehub/application/extension/mock"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/application/utils"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/authorization"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/domain"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/gorm"
 	pgMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/database/postgres/mock"
@@ -34,6 +36,7 @@ import (
 	smsMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/sms/mock"
 	twilioMock "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/twilio/mock"
 	authorityMock "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/authority/mock"
+	communityMock "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/communities/mock"
 	otpMock "github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/otp/mock"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/user"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases/user/mock"
@@ -269,7 +272,8 @@ func TestUseCasesUserImpl_Login_Unittest(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			u := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			u := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Happy case: consumer login" {
 				currentTime := time.Now()
@@ -644,8 +648,9 @@ func TestUnit_InviteUser(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
 
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "valid: valid phone number" {
 				fakeUserMock.MockInviteUserFn = func(ctx context.Context, userID string, phoneNumber string, flavour feedlib.Flavour, reinvite bool) (bool, error) {
@@ -936,8 +941,9 @@ func TestUseCasesUserImpl_SetUserPIN(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
 
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "invalid: user not found" {
 				fakeDB.MockGetUserProfileByUserIDFn = func(ctx context.Context, userID string) (*domain.User, error) {
@@ -1030,6 +1036,26 @@ func TestUseCasesUserImpl_SetNickName(t *testing.T) {
 			want:    true,
 			wantErr: false,
 		},
+		{
+			name: "Sad Case: setting another user's nickname",
+			args: args{
+				ctx:      ctx,
+				userID:   userID,
+				nickname: gofakeit.Username(),
+			},
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name: "Sad Case: failed to get logged in user",
+			args: args{
+				ctx:      ctx,
+				userID:   userID,
+				nickname: gofakeit.Username(),
+			},
+			want:    false,
+			wantErr: true,
+		},
 		{
 			name: "Sad case - no nickname",
 			args: args{
@@ -1076,8 +1102,23 @@ func TestUseCasesUserImpl_SetNickName(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
 
-			u := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			u := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
+
+			fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+				return tt.args.userID, nil
+			}
+			if tt.name == "Sad Case: setting another user's nickname" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return uuid.New().String(), nil
+				}
+			}
+			if tt.name == "Sad Case: failed to get logged in user" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
 
 			if tt.name == "Happy case: Successfully set nickname" {
 				fakeDB.MockCheckIfUsernameExistsFn = func(ctx context.Context, username string) (bool, error) {
@@ -1176,7 +1217,7 @@ func TestUseCasesUserImpl_RequestPINReset(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "Sad Case - Fail to save otp",
+			name: "Sad Case - OTP send limit exceeded",
 			args: args{
 				ctx:      ctx,
 				username: gofakeit.Name(),
@@ -1198,8 +1239,9 @@ func TestUseCasesUserImpl_RequestPINReset(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
 
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad Case - Invalid username" {
 				fakeUser.MockRequestPINResetFn = func(ctx context.Context, username string, flavour feedlib.Flavour) (string, error) {
@@ -1225,20 +1267,14 @@ func TestUseCasesUserImpl_RequestPINReset(t *testing.T) {
 				}
 			}
 			if tt.name == "Sad Case - Fail to generate and send OTP" {
-				fakeOTP.MockGenerateAndSendOTPFn = func(ctx context.Context, phoneNumber string, flavour feedlib.Flavour) (*domain.OTPResponse, error) {
+				fakeOTP.MockGeneratePINResetOTPFn = func(ctx context.Context, username string, flavour feedlib.Flavour) (*domain.OTPResponse, error) {
 					return nil, fmt.Errorf("failed to generate and send otp")
 				}
 			}
 
-			if tt.name == "Sad Case - Fail to save otp" {
-				fakeOTP.MockGenerateAndSendOTPFn = func(ctx context.Context, phoneNumber string, flavour feedlib.Flavour) (*domain.OTPResponse, error) {
-					return &domain.OTPResponse{
-						OTP:         "123456",
-						PhoneNumber: interserviceclient.TestUserPhoneNumber,
-					}, nil
-				}
-				fakeDB.MockSaveOTPFn = func(ctx context.Context, otpInput *domain.OTP) error {
-					return fmt.Errorf("failed to save otp")
+			if tt.name == "Sad Case - OTP send limit exceeded" {
+				fakeOTP.MockGeneratePINResetOTPFn = func(ctx context.Context, username string, flavour feedlib.Flavour) (*domain.OTPResponse, error) {
+					return nil, exceptions.OTPRateLimitExceededErr(fmt.Errorf("otp send limit exceeded"))
 				}
 			}
 
@@ -1247,6 +1283,10 @@ func TestUseCasesUserImpl_RequestPINReset(t *testing.T) {
 				t.Errorf("UseCasesUserImpl.RequestPINReset() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if tt.name == "Sad Case - OTP send limit exceeded" && exceptions.GetErrorCode(err) != exceptions.OTPRateLimitExceededError {
+				t.Errorf("expected error code %v, got %v", exceptions.OTPRateLimitExceededError, exceptions.GetErrorCode(err))
+				return
+			}
 			if got != tt.want {
 				t.Errorf("UseCasesUserImpl.RequestPINReset() = %v, want %v", got, tt.want)
 			}
@@ -1420,8 +1460,9 @@ func TestUseCasesUserImpl_ResetPIN(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
 
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Happy Case - Successfully reset pin" {
 				fakeDB.MockGetUserSecurityQuestionsResponsesFn = func(ctx context.Context, userID string) ([]*domain.SecurityQuestionResponse, error) {
@@ -1569,7 +1610,8 @@ func TestUseCasesUserImpl_RefreshToken(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad Case - Fail to create firebase custom token" {
 				fakeExtension.MockCreateFirebaseCustomTokenFn = func(ctx context.Context, uid string) (string, error) {
@@ -1685,7 +1727,8 @@ func TestUseCasesUserImpl_VerifyPIN(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Happy Case - Successfully verify pin" {
 				fakeDB.MockGetUserPINByUserIDFn = func(ctx context.Context, userID string) (*domain.UserPIN, error) {
@@ -1745,6 +1788,16 @@ func TestUseCasesUserImpl_CompleteOnboardingTour(t *testing.T) {
 			want:    true,
 			wantErr: false,
 		},
+		{
+			name: "Sad case - completing another user's onboarding tour",
+			args: args{
+				ctx:     ctx,
+				userID:  uuid.New().String(),
+				flavour: feedlib.FlavourConsumer,
+			},
+			want:    false,
+			wantErr: true,
+		},
 		{
 			name: "Sad case - no userID",
 			args: args{
@@ -1774,7 +1827,17 @@ func TestUseCasesUserImpl_CompleteOnboardingTour(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
+
+			fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+				return tt.args.userID, nil
+			}
+			if tt.name == "Sad case - completing another user's onboarding tour" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return uuid.New().String(), nil
+				}
+			}
 
 			if tt.name == "Sad case - no userID" {
 				fakeDB.MockCompleteOnboardingTourFn = func(ctx context.Context, userID string, flavour feedlib.Flavour) (bool, error) {
@@ -1973,7 +2036,8 @@ func TestUseCasesUserImpl_RegisterClient(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad case: unable to register client" {
 				fakeDB.MockRegisterClientFn = func(ctx context.Context, payload *domain.ClientRegistrationPayload) (*domain.ClientProfile, error) {
@@ -2060,8 +2124,8 @@ func TestUseCasesUserImpl_RegisterClient(t *testing.T) {
 				}
 			}
 			if tt.name == "Sad case: unable to register matrix user" {
-				fakeMatrix.MockRegisterUserFn = func(ctx context.Context, auth *domain.MatrixAuth, registrationPayload *domain.MatrixUserRegistration) (*dto.MatrixUserRegistrationOutput, error) {
-					return nil, fmt.Errorf("unable to register matrix user")
+				fakeCommunity.MockRegisterMatrixUserFn = func(ctx context.Context, user *domain.User, admin bool) error {
+					return fmt.Errorf("unable to register matrix user")
 				}
 			}
 
@@ -2089,8 +2153,9 @@ func TestUseCasesUserImpl_AddClientFHIRID(t *testing.T) {
 	fakeSMS := smsMock.NewSMSServiceMock()
 	fakeTwilio := twilioMock.NewTwilioServiceMock()
 	fakeMatrix := matrixMock.NewMatrixMock()
+	fakeCommunity := communityMock.NewCommunityUsecaseMock()
 
-	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 	type args struct {
 		ctx   context.Context
@@ -2167,8 +2232,9 @@ func TestUseCasesUserImpl_GetUserProfile(t *testing.T) {
 	fakeSMS := smsMock.NewSMSServiceMock()
 	fakeTwilio := twilioMock.NewTwilioServiceMock()
 	fakeMatrix := matrixMock.NewMatrixMock()
+	fakeCommunity := communityMock.NewCommunityUsecaseMock()
 
-	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 	type args struct {
 		ctx    context.Context
@@ -2219,7 +2285,8 @@ func TestUseCasesUserImpl_RegisterKenyaEMRPatients(t *testing.T) {
 	fakeSMS := smsMock.NewSMSServiceMock()
 	fakeTwilio := twilioMock.NewTwilioServiceMock()
 	fakeMatrix := matrixMock.NewMatrixMock()
-	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+	fakeCommunity := communityMock.NewCommunityUsecaseMock()
+	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 	ctx := context.Background()
 	input := []*dto.PatientRegistrationPayload{
@@ -2749,7 +2816,8 @@ func TestUseCasesUserImpl_RegisteredFacilityPatients(t *testing.T) {
 	fakeSMS := smsMock.NewSMSServiceMock()
 	fakeTwilio := twilioMock.NewTwilioServiceMock()
 	fakeMatrix := matrixMock.NewMatrixMock()
-	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+	fakeCommunity := communityMock.NewCommunityUsecaseMock()
+	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 	ctx := context.Background()
 	syncTime := time.Now()
@@ -3019,7 +3087,8 @@ func TestUseCasesUserImpl_SearchStaffByStaffNumber(t *testing.T) {
 	fakeSMS := smsMock.NewSMSServiceMock()
 	fakeTwilio := twilioMock.NewTwilioServiceMock()
 	fakeMatrix := matrixMock.NewMatrixMock()
-	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+	fakeCommunity := communityMock.NewCommunityUsecaseMock()
+	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 	type args struct {
 		ctx         context.Context
@@ -3096,7 +3165,8 @@ func TestUseCasesUserImpl_SearchClientByCCCNumber(t *testing.T) {
 	fakeSMS := smsMock.NewSMSServiceMock()
 	fakeTwilio := twilioMock.NewTwilioServiceMock()
 	fakeMatrix := matrixMock.NewMatrixMock()
-	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+	fakeCommunity := communityMock.NewCommunityUsecaseMock()
+	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 	type args struct {
 		ctx             context.Context
@@ -3180,6 +3250,26 @@ func TestUseCasesUserImpl_Consent(t *testing.T) {
 			want:    true,
 			wantErr: false,
 		},
+		{
+			name: "Sad Case - withdrawing another user's consent",
+			args: args{
+				ctx:         ctx,
+				phoneNumber: gofakeit.Phone(),
+				flavour:     feedlib.FlavourConsumer,
+			},
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - Fail to get user profile",
+			args: args{
+				ctx:         ctx,
+				phoneNumber: gofakeit.Phone(),
+				flavour:     feedlib.FlavourConsumer,
+			},
+			want:    false,
+			wantErr: true,
+		},
 		{
 			name: "Sad Case - Fail to purge user details",
 			args: args{
@@ -3202,7 +3292,26 @@ func TestUseCasesUserImpl_Consent(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
+
+			userID := uuid.New().String()
+			fakeDB.MockGetUserProfileByPhoneNumberFn = func(ctx context.Context, phoneNumber string) (*domain.User, error) {
+				return &domain.User{ID: &userID}, nil
+			}
+			fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+				return userID, nil
+			}
+			if tt.name == "Sad Case - withdrawing another user's consent" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return uuid.New().String(), nil
+				}
+			}
+			if tt.name == "Sad Case - Fail to get user profile" {
+				fakeDB.MockGetUserProfileByPhoneNumberFn = func(ctx context.Context, phoneNumber string) (*domain.User, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
 
 			if tt.name == "Sad Case - Fail to purge user details" {
 				fakeDB.MockDeleteUserFn = func(ctx context.Context, userID string, clientID *string, staffID *string, flavour feedlib.Flavour) error {
@@ -3282,7 +3391,8 @@ func TestUseCasesUserImpl_RegisterPushToken(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad Case - Fail to get logged in user" {
 				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
@@ -3318,7 +3428,8 @@ func TestUseCasesUserImpl_GetClientProfileByCCCNumber(t *testing.T) {
 	fakeSMS := smsMock.NewSMSServiceMock()
 	fakeTwilio := twilioMock.NewTwilioServiceMock()
 	fakeMatrix := matrixMock.NewMatrixMock()
-	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+	fakeCommunity := communityMock.NewCommunityUsecaseMock()
+	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 	type args struct {
 		ctx       context.Context
@@ -3512,7 +3623,8 @@ func TestUseCasesUserImpl_DeleteUser(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Happy Case - Successfully delete client" {
 				fakeExtension.MockMakeRequestFn = func(ctx context.Context, method string, path string, body interface{}) (*http.Response, error) {
@@ -3605,7 +3717,8 @@ func TestUseCasesUserImpl_TransferClientToFacility(t *testing.T) {
 	fakeSMS := smsMock.NewSMSServiceMock()
 	fakeTwilio := twilioMock.NewTwilioServiceMock()
 	fakeMatrix := matrixMock.NewMatrixMock()
-	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+	fakeCommunity := communityMock.NewCommunityUsecaseMock()
+	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 	type args struct {
 		ctx        context.Context
@@ -3628,6 +3741,16 @@ func TestUseCasesUserImpl_TransferClientToFacility(t *testing.T) {
 			want:    true,
 			wantErr: false,
 		},
+		{
+			name: "Happy Case - Transfer client when syncing their communities fails",
+			args: args{
+				ctx:        ctx,
+				clientID:   &ID,
+				facilityID: &ID,
+			},
+			want:    true,
+			wantErr: false,
+		},
 		{
 			name: "Sad Case - Missing client ID or facility ID",
 			args: args{
@@ -3680,6 +3803,11 @@ func TestUseCasesUserImpl_TransferClientToFacility(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 
+			if tt.name == "Happy Case - Transfer client when syncing their communities fails" {
+				fakeCommunity.MockSyncClientCommunitiesFn = func(ctx context.Context, clientID string) error {
+					return fmt.Errorf("failed to sync client communities")
+				}
+			}
 			if tt.name == "Sad Case - Failed to get client profile by clientID" {
 				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
 					return nil, fmt.Errorf("failed to get client profile by clientID")
@@ -3877,6 +4005,14 @@ func TestUseCasesUserImpl_RegisterStaffProfile(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Sad Case - unable to assign the default staff role",
+			args: args{
+				ctx:   ctx,
+				input: *payload,
+			},
+			wantErr: true,
+		},
 		{
 			name: "Sad Case - unable to invite staff",
 			args: args{
@@ -3907,6 +4043,7 @@ func TestUseCasesUserImpl_RegisterStaffProfile(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
 
 			if tt.name == "Sad Case - Unable to check identifier exists" {
 				fakeDB.MockCheckIdentifierExists = func(ctx context.Context, identifierType enums.UserIdentifierType, identifierValue string) (bool, error) {
@@ -3955,6 +4092,11 @@ func TestUseCasesUserImpl_RegisterStaffProfile(t *testing.T) {
 					return nil, fmt.Errorf("failed to register staff")
 				}
 			}
+			if tt.name == "Sad Case - unable to assign the default staff role" {
+				fakeAuthority.MockAssignSystemRoleFn = func(ctx context.Context, staffID string, organisationID string, systemRole authorization.DefaultRole) error {
+					return fmt.Errorf("failed to assign role")
+				}
+			}
 			if tt.name == "Sad Case - unable to invite staff" {
 
 				fakeUser.MockInviteUserFn = func(ctx context.Context, userID, phoneNumber string, flavour feedlib.Flavour, reinvite bool) (bool, error) {
@@ -3967,7 +4109,7 @@ func TestUseCasesUserImpl_RegisterStaffProfile(t *testing.T) {
 				}
 			}
 
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			_, err := us.RegisterStaffProfile(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -4060,6 +4202,7 @@ func TestUseCasesUserImpl_RegisterStaff(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
 
 			if tt.name == "Sad case: unable to get logged in user id" {
 				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
@@ -4073,8 +4216,8 @@ func TestUseCasesUserImpl_RegisterStaff(t *testing.T) {
 				}
 			}
 			if tt.name == "Sad case: unable to register matrix user" {
-				fakeMatrix.MockRegisterUserFn = func(ctx context.Context, auth *domain.MatrixAuth, registrationPayload *domain.MatrixUserRegistration) (*dto.MatrixUserRegistrationOutput, error) {
-					return nil, fmt.Errorf("failed to register matrix user")
+				fakeCommunity.MockRegisterMatrixUserFn = func(ctx context.Context, user *domain.User, admin bool) error {
+					return fmt.Errorf("failed to register matrix user")
 				}
 			}
 			if tt.name == "sad case: unable to check whether matrix user is an admin" {
@@ -4083,7 +4226,7 @@ func TestUseCasesUserImpl_RegisterStaff(t *testing.T) {
 				}
 			}
 
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			_, err := us.RegisterStaff(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -4138,6 +4281,14 @@ func TestUseCasesUserImpl_RegisterOrganisationAdmin(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Sad case: unable to assign the default admin role",
+			args: args{
+				ctx:   context.Background(),
+				input: *payload,
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -4151,6 +4302,7 @@ func TestUseCasesUserImpl_RegisterOrganisationAdmin(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
 
 			if tt.name == "Sad case: unable to get staff profile by id" {
 				fakeDB.MockGetProgramByIDFn = func(ctx context.Context, programID string) (*domain.Program, error) {
@@ -4158,7 +4310,16 @@ func TestUseCasesUserImpl_RegisterOrganisationAdmin(t *testing.T) {
 				}
 			}
 
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			if tt.name == "Sad case: unable to assign the default admin role" {
+				fakeAuthority.MockAssignSystemRoleFn = func(ctx context.Context, staffID string, organisationID string, systemRole authorization.DefaultRole) error {
+					if systemRole == authorization.DefaultRoleAdmin {
+						return fmt.Errorf("failed to assign role")
+					}
+					return nil
+				}
+			}
+
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			_, err := us.RegisterOrganisationAdmin(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -4246,7 +4407,8 @@ func TestUseCasesUserImpl_SetStaffDefaultFacility(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad case: failed to get staff profile by staff id" {
 				fakeDB.MockGetStaffProfileByStaffIDFn = func(ctx context.Context, staffID string) (*domain.StaffProfile, error) {
@@ -4366,7 +4528,8 @@ func TestUseCasesUserImpl_SetClientDefaultFacility(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad case: failed to get client profile by client" {
 				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
@@ -4472,7 +4635,8 @@ func TestUseCasesUserImpl_AddFacilitiesToStaffProfile(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad case: failed to assign facilities to staff" {
 				fakeDB.MockAddFacilitiesToStaffProfileFn = func(ctx context.Context, staffID string, facilities []string) error {
@@ -4553,7 +4717,8 @@ func TestUseCasesUserImpl_AddFacilitiesToClientProfile(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad case: failed to assign facilities to clients" {
 				fakeDB.MockAddFacilitiesToClientProfileFn = func(ctx context.Context, clientID string, facilities []string) error {
@@ -4841,7 +5006,8 @@ func TestUseCasesUserImpl_RegisterCaregiver(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "sad case: username check error" {
 				fakeDB.MockCheckIfUsernameExistsFn = func(ctx context.Context, username string) (bool, error) {
@@ -4893,8 +5059,8 @@ func TestUseCasesUserImpl_RegisterCaregiver(t *testing.T) {
 				}
 			}
 			if tt.name == "sad case: unable to register matrix user" {
-				fakeMatrix.MockRegisterUserFn = func(ctx context.Context, auth *domain.MatrixAuth, registrationPayload *domain.MatrixUserRegistration) (*dto.MatrixUserRegistrationOutput, error) {
-					return nil, fmt.Errorf("failed to register matrix user")
+				fakeCommunity.MockRegisterMatrixUserFn = func(ctx context.Context, user *domain.User, admin bool) error {
+					return fmt.Errorf("failed to register matrix user")
 				}
 			}
 
@@ -4964,7 +5130,8 @@ func TestUseCasesUserImpl_RegisterClientAsCaregiver(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "sad case: get client error" {
 				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
@@ -5030,7 +5197,8 @@ func TestUseCasesUserImpl_SearchCaregiverUser(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad case: unable to search caregiver user" {
 				fakeDB.MockSearchCaregiverUserFn = func(ctx context.Context, searchParameter string) ([]*domain.CaregiverProfile, error) {
@@ -5124,7 +5292,8 @@ func TestUseCasesUserImpl_RemoveFacilitiesFromClientProfile(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad Case: failed to get client profile by client id" {
 				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
@@ -5254,7 +5423,8 @@ func TestUseCasesUserImpl_AssignCaregiver(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "sad case: unable to add caregiver to client" {
 				fakeDB.MockAddCaregiverToClientFn = func(ctx context.Context, clientCaregiver *domain.CaregiverClient) error {
@@ -5364,7 +5534,8 @@ func TestUseCasesUserImpl_RemoveFacilitiesFromStaffProfile(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad Case: failed to get staff profile by staff id" {
 				fakeDB.MockGetStaffProfileByStaffIDFn = func(ctx context.Context, staffID string) (*domain.StaffProfile, error) {
@@ -5453,7 +5624,8 @@ func TestUseCasesUserImpl_GetCaregiverManagedClients(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad case: failed to get managed clients" {
 				fakeDB.MockGetCaregiverManagedClientsFn = func(ctx context.Context, userID string, pagination *domain.Pagination) ([]*domain.ManagedClient, *domain.Pagination, error) {
@@ -5520,7 +5692,8 @@ func TestUseCasesUserImpl_ListClientsCaregivers(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad Case: unable to list clients caregivers" {
 				fakeDB.MockListClientsCaregiversFn = func(ctx context.Context, clientID string, pagination *domain.Pagination) (*domain.ClientCaregivers, *domain.Pagination, error) {
@@ -5565,6 +5738,28 @@ func TestUseCasesUserImpl_ConsentToAClientCaregiver(t *testing.T) {
 			want:    true,
 			wantErr: false,
 		},
+		{
+			name: "Sad Case: consenting on behalf of another client",
+			args: args{
+				ctx:         context.Background(),
+				clientID:    uuid.NewString(),
+				caregiverID: uuid.NewString(),
+				consent:     true,
+			},
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name: "Sad Case: unable to get client profile",
+			args: args{
+				ctx:         context.Background(),
+				clientID:    uuid.NewString(),
+				caregiverID: uuid.NewString(),
+				consent:     true,
+			},
+			want:    false,
+			wantErr: true,
+		},
 		{
 			name: "Sad Case: client unable to consent",
 			args: args{
@@ -5575,6 +5770,17 @@ func TestUseCasesUserImpl_ConsentToAClientCaregiver(t *testing.T) {
 			want:    false,
 			wantErr: true,
 		},
+		{
+			name: "Sad Case: unable to get client caregiver",
+			args: args{
+				ctx:         context.Background(),
+				clientID:    uuid.NewString(),
+				caregiverID: uuid.NewString(),
+				consent:     true,
+			},
+			want:    false,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -5587,13 +5793,37 @@ func TestUseCasesUserImpl_ConsentToAClientCaregiver(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
+
+			userID := uuid.NewString()
+			fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+				return &domain.ClientProfile{ID: &clientID, UserID: userID}, nil
+			}
+			fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+				return userID, nil
+			}
+			if tt.name == "Sad Case: consenting on behalf of another client" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return uuid.NewString(), nil
+				}
+			}
+			if tt.name == "Sad Case: unable to get client profile" {
+				fakeDB.MockGetClientProfileByClientIDFn = func(ctx context.Context, clientID string) (*domain.ClientProfile, error) {
+					return nil, fmt.Errorf("failed to get client profile")
+				}
+			}
 
 			if tt.name == "Sad Case: client unable to consent" {
 				fakeDB.MockUpdateCaregiverClientFn = func(ctx context.Context, caregiverClient *domain.CaregiverClient, updateData map[string]interface{}) error {
 					return fmt.Errorf("failed to update caregiver client")
 				}
 			}
+			if tt.name == "Sad Case: unable to get client caregiver" {
+				fakeDB.MockGetCaregiversClientFn = func(ctx context.Context, caregiverClient domain.CaregiverClient) ([]*domain.CaregiverClient, error) {
+					return nil, fmt.Errorf("failed to get caregiver client")
+				}
+			}
 			got, err := us.ConsentToAClientCaregiver(tt.args.ctx, tt.args.clientID, tt.args.caregiverID, tt.args.consent)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UseCasesUserImpl.TestUseCasesUserImpl_ConsentToAClientCaregiver() error = %v, wantErr %v", err, tt.wantErr)
@@ -5630,6 +5860,28 @@ func TestUseCasesUserImpl_ConsentToManagingClient(t *testing.T) {
 			want:    true,
 			wantErr: false,
 		},
+		{
+			name: "Sad Case: consenting on behalf of another caregiver",
+			args: args{
+				ctx:         context.Background(),
+				caregiverID: uuid.NewString(),
+				clientID:    uuid.NewString(),
+				consent:     true,
+			},
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name: "Sad Case: unable to get caregiver profile",
+			args: args{
+				ctx:         context.Background(),
+				caregiverID: uuid.NewString(),
+				clientID:    uuid.NewString(),
+				consent:     true,
+			},
+			want:    false,
+			wantErr: true,
+		},
 		{
 			name: "Sad Case: unable to consent to managing client",
 			args: args{
@@ -5641,6 +5893,17 @@ func TestUseCasesUserImpl_ConsentToManagingClient(t *testing.T) {
 			want:    false,
 			wantErr: true,
 		},
+		{
+			name: "Sad Case: unable to get caregiver client",
+			args: args{
+				ctx:         context.Background(),
+				caregiverID: uuid.NewString(),
+				clientID:    uuid.NewString(),
+				consent:     true,
+			},
+			want:    false,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -5653,13 +5916,37 @@ func TestUseCasesUserImpl_ConsentToManagingClient(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
+
+			userID := uuid.NewString()
+			fakeDB.MockGetCaregiverProfileByCaregiverIDFn = func(ctx context.Context, caregiverID string) (*domain.CaregiverProfile, error) {
+				return &domain.CaregiverProfile{ID: caregiverID, UserID: userID}, nil
+			}
+			fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+				return userID, nil
+			}
+			if tt.name == "Sad Case: consenting on behalf of another caregiver" {
+				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return uuid.NewString(), nil
+				}
+			}
+			if tt.name == "Sad Case: unable to get caregiver profile" {
+				fakeDB.MockGetCaregiverProfileByCaregiverIDFn = func(ctx context.Context, caregiverID string) (*domain.CaregiverProfile, error) {
+					return nil, fmt.Errorf("failed to get caregiver profile")
+				}
+			}
 
 			if tt.name == "Sad Case: unable to consent to managing client" {
 				fakeDB.MockUpdateCaregiverClientFn = func(ctx context.Context, caregiverClient *domain.CaregiverClient, updateData map[string]interface{}) error {
 					return fmt.Errorf("unable to consent to managing client")
 				}
 			}
+			if tt.name == "Sad Case: unable to get caregiver client" {
+				fakeDB.MockGetCaregiversClientFn = func(ctx context.Context, caregiverClient domain.CaregiverClient) ([]*domain.CaregiverClient, error) {
+					return nil, fmt.Errorf("failed to get caregiver client")
+				}
+			}
 			got, err := us.ConsentToManagingClient(tt.args.ctx, tt.args.caregiverID, tt.args.clientID, tt.args.consent)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UseCasesUserImpl.ConsentToManagingClient() error = %v, wantErr %v", err, tt.wantErr)
@@ -5750,7 +6037,8 @@ func TestUseCasesUserImpl_FetchContactOrganisations(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "sad case: fail to find contacts" {
 				fakeDB.MockFindContactsFn = func(ctx context.Context, contactType, contactValue string) ([]*domain.Contact, error) {
@@ -5809,7 +6097,8 @@ func TestUseCasesUserImpl_GetStaffFacilities(t *testing.T) {
 	fakeSMS := smsMock.NewSMSServiceMock()
 	fakeTwilio := twilioMock.NewTwilioServiceMock()
 	fakeMatrix := matrixMock.NewMatrixMock()
-	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+	fakeCommunity := communityMock.NewCommunityUsecaseMock()
+	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 	type args struct {
 		ctx             context.Context
@@ -6001,7 +6290,8 @@ func TestUseCasesUserImpl_RegisterExistingUserAsClient(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "sad case: unable to register existing user as client" {
 				fakeDB.MockRegisterExistingUserAsClientFn = func(ctx context.Context, payload *domain.ClientRegistrationPayload) (*domain.ClientProfile, error) {
@@ -6046,7 +6336,8 @@ func TestUseCasesUserImpl_GetClientFacilities(t *testing.T) {
 	fakeSMS := smsMock.NewSMSServiceMock()
 	fakeTwilio := twilioMock.NewTwilioServiceMock()
 	fakeMatrix := matrixMock.NewMatrixMock()
-	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+	fakeCommunity := communityMock.NewCommunityUsecaseMock()
+	us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 	type args struct {
 		ctx             context.Context
@@ -6201,7 +6492,8 @@ func TestUseCasesUserImpl_SetCaregiverCurrentClient(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "sad case: failed to get logged in user" {
 				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
@@ -6368,7 +6660,8 @@ func TestUseCasesUserImpl_RegisterExistingUserAsStaff(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad case: unable to get logged in user id" {
 				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
@@ -6479,7 +6772,8 @@ func TestUseCasesUserImpl_SetCaregiverCurrentFacility(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad case: unable to get logged in user id" {
 				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
@@ -6583,7 +6877,8 @@ func TestUseCasesUserImpl_RegisterExistingUserAsCaregiver(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "sad case: unable to get logged in user id" {
 				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
@@ -6838,7 +7133,8 @@ func TestUseCasesUserImpl_UpdateUserProfile(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "sad case: unable to get logged in user" {
 				fakeExtension.MockGetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
@@ -7089,8 +7385,9 @@ func TestUseCasesUserImpl_CreateSuperUser(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
 			fakeUser := mock.NewUserUseCaseMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Happy Case - Register Staff" {
 				fakeDB.MockCheckIfSuperUserExistsFn = func(ctx context.Context) (bool, error) {
@@ -7162,8 +7459,8 @@ func TestUseCasesUserImpl_CreateSuperUser(t *testing.T) {
 			}
 			if tt.name == "Sad case: failed to register user in matrix" {
 
-				fakeMatrix.MockRegisterUserFn = func(ctx context.Context, auth *domain.MatrixAuth, registrationPayload *domain.MatrixUserRegistration) (*dto.MatrixUserRegistrationOutput, error) {
-					return nil, fmt.Errorf("an error occured")
+				fakeCommunity.MockRegisterMatrixUserFn = func(ctx context.Context, user *domain.User, admin bool) error {
+					return fmt.Errorf("an error occured")
 				}
 			}
 
@@ -7223,7 +7520,8 @@ func TestUseCasesUserImpl_CheckIdentifierExists(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad case: unable to check identifier exists" {
 				fakeDB.MockCheckIdentifierExists = func(ctx context.Context, identifierType enums.UserIdentifierType, identifierValue string) (bool, error) {
@@ -7292,7 +7590,8 @@ func TestUseCasesUserImpl_CheckIfPhoneExists(t *testing.T) {
 			fakeSMS := smsMock.NewSMSServiceMock()
 			fakeTwilio := twilioMock.NewTwilioServiceMock()
 			fakeMatrix := matrixMock.NewMatrixMock()
-			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix)
+			fakeCommunity := communityMock.NewCommunityUsecaseMock()
+			us := user.NewUseCasesUserImpl(fakeDB, fakeDB, fakeDB, fakeDB, fakeExtension, fakeOTP, fakeAuthority, fakePubsub, fakeClinical, fakeSMS, fakeTwilio, fakeMatrix, fakeCommunity)
 
 			if tt.name == "Sad case: failed to check if phone exists" {
 				fakeDB.MockCheckPhoneExistsFn = func(ctx context.Context, phone string) (bool, error) {
@@ -18,6 +18,7 @@ import (
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/matrix"
 	pubsubmessaging "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/pubsub"
 	serviceSMS "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/sms"
+	"github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/smsgateway"
 	surveyInstance "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/surveys"
 	serviceTwilio "github.com/savannahghi/mycarehub/pkg/mycarehub/infrastructure/services/twilio"
 	"github.com/savannahghi/mycarehub/pkg/mycarehub/usecases"
@@ -45,6 +46,10 @@ import (
 
 const (
 	twilioHTTPClientTimeoutSeconds = 10
+
+	smsProviderTimeoutSeconds = 15
+
+	kenyaCountryCode = "+254"
 )
 
 var (
@@ -89,7 +94,13 @@ func ProviderUseCases() (*usecases.MyCareHub, error) {
 	twilioMessageObj := twilioClient.Messages
 	twilioService := serviceTwilio.NewServiceTwilio(twilioMessageObj)
 
-	otpUseCase := otp.NewOTPUseCase(db, db, externalExt, smsService, twilioService)
+	// SMS gateway. Kenyan numbers are sent via SILComms and fall back to Twilio, while Twilio is used for all other numbers
+	silCommsProvider := smsgateway.NewSILCommsProvider(smsService)
+	twilioProvider := smsgateway.NewTwilioProvider(twilioService)
+	smsGateway := smsgateway.NewSMSGateway(db, db, time.Second*smsProviderTimeoutSeconds, twilioProvider)
+	smsGateway.RegisterChain(kenyaCountryCode, silCommsProvider, twilioProvider)
+
+	otpUseCase := otp.NewOTPUseCase(db, db, externalExt, smsGateway)
 
 	pubSub, err := pubsubmessaging.NewServicePubSubMessaging(externalExt, db, fcmService)
 	if err != nil {
@@ -102,7 +113,7 @@ func ProviderUseCases() (*usecases.MyCareHub, error) {
 	// Initialize user usecase
 	notificationUseCase := notification.NewNotificationUseCaseImpl(fcmService, db, db, db, externalExt)
 
-	authorityUseCase := authority.NewUsecaseAuthority(db, db, externalExt, notificationUseCase)
+	authorityUseCase := authority.NewUsecaseAuthority(db, db, db, db, externalExt, notificationUseCase)
 
 	clinicalClient := externalExtension.NewInterServiceClient(clinicalDepsName, externalExt)
 	clinicalService := clinical.NewServiceClinical(clinicalClient)
@@ -111,9 +122,11 @@ func ProviderUseCases() (*usecases.MyCareHub, error) {
 		BaseURL: matrixBaseURL,
 	}
 
-	userUsecase := user.NewUseCasesUserImpl(db, db, db, db, externalExt, otpUseCase, authorityUseCase, pubSub, clinicalService, smsService, twilioService, &matrixClient)
+	communityUsecase := communities.NewUseCaseCommunitiesImpl(db, db, db, externalExt, &matrixClient, notificationUseCase)
 
-	termsUsecase := terms.NewUseCasesTermsOfService(db, db, db)
+	userUsecase := user.NewUseCasesUserImpl(db, db, db, db, externalExt, otpUseCase, authorityUseCase, pubSub, clinicalService, smsService, twilioService, &matrixClient, communityUsecase)
+
+	termsUsecase := terms.NewUseCasesTermsOfService(db, db, db, externalExt)
 
 	securityQuestionsUsecase := securityquestions.NewSecurityQuestionsUsecase(db, db, db, externalExt)
 
@@ -127,9 +140,9 @@ func ProviderUseCases() (*usecases.MyCareHub, error) {
 
 	serviceRequestUseCase := servicerequest.NewUseCaseServiceRequestImpl(db, db, db, externalExt, userUsecase, notificationUseCase, smsService)
 
-	appointmentUsecase := appointment.NewUseCaseAppointmentsImpl(externalExt, db, db, db, pubSub, notificationUseCase)
+	appointmentUsecase := appointment.NewUseCaseAppointmentsImpl(externalExt, db, db, db, pubSub, notificationUseCase, smsGateway)
 
-	healthDiaryUseCase := healthdiary.NewUseCaseHealthDiaryImpl(db, db, db, serviceRequestUseCase)
+	healthDiaryUseCase := healthdiary.NewUseCaseHealthDiaryImpl(db, db, db, serviceRequestUseCase, externalExt)
 
 	surveysClient := surveyInstance.ODKClient{
 		BaseURL:    surveysBaseURL,
@@ -142,13 +155,11 @@ func ProviderUseCases() (*usecases.MyCareHub, error) {
 	matrixSvc := matrix.NewMatrixImpl(matrixClient.BaseURL)
 
 	metricsUsecase := metrics.NewUsecaseMetricsImpl(db)
-	questionnaireUsecase := questionnaires.NewUseCaseQuestionnaire(db, db, db, db, externalExt)
-	programsUsecase := programs.NewUsecasePrograms(db, db, db, externalExt, pubSub, matrixSvc)
+	questionnaireUsecase := questionnaires.NewUseCaseQuestionnaire(db, db, db, db, externalExt, notificationUseCase)
+	programsUsecase := programs.NewUsecasePrograms(db, db, db, externalExt, pubSub, matrixSvc, communityUsecase)
 
 	organisationUsecase := organisation.NewUseCaseOrganisationImpl(db, db, db, externalExt, pubSub)
 
-	communityUsecase := communities.NewUseCaseCommunitiesImpl(db, db, externalExt, matrixSvc)
-
 	useCase := usecases.NewMyCareHubUseCase(
 		userUsecase, termsUsecase, facilityUseCase,
 		securityQuestionsUsecase, otpUseCase, contentUseCase, feedbackUsecase, healthDiaryUseCase,